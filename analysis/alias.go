@@ -0,0 +1,170 @@
+package analysis
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/umaumax/llvm/ir"
+	"github.com/umaumax/llvm/ir/constant"
+	"github.com/umaumax/llvm/ir/types"
+	"github.com/umaumax/llvm/ir/value"
+)
+
+// === [ Alias analysis ] ======================================================
+
+// AliasAnalysis is an alias analysis.
+type AliasAnalysis interface {
+	// Alias returns the alias result of the given memory locations.
+	Alias(a, b MemoryLocation) AliasResult
+}
+
+// AliasResult is the result of an alias query.
+type AliasResult uint8
+
+// Alias results.
+const (
+	// The memory locations never overlap.
+	NoAlias AliasResult = iota
+	// The memory locations may overlap.
+	MayAlias
+	// The memory locations overlap partially, or one is contained in the
+	// other.
+	PartialAlias
+	// The memory locations start at the same address.
+	MustAlias
+)
+
+// String returns the string representation of the alias result.
+func (r AliasResult) String() string {
+	switch r {
+	case NoAlias:
+		return "NoAlias"
+	case MayAlias:
+		return "MayAlias"
+	case PartialAlias:
+		return "PartialAlias"
+	case MustAlias:
+		return "MustAlias"
+	}
+	return fmt.Sprintf("AliasResult(%d)", uint8(r))
+}
+
+// UnknownSize denotes a memory location of unknown size.
+const UnknownSize = math.MaxUint64
+
+// MemoryLocation is a location in memory accessed by an instruction.
+type MemoryLocation struct {
+	// Pointer to the start of the memory location.
+	Ptr value.Value
+	// Size in bytes of the memory location; or UnknownSize if unknown.
+	Size uint64
+	// (optional) Type of the accessed value; or nil if unknown. The size of
+	// memory locations with unknown size may be computed from the type, based on
+	// a data layout.
+	Typ types.Type
+	// (optional) TBAA access tag of the memory access; or nil if not present.
+	TBAA *ir.TBAAAccessTag
+}
+
+// MemoryLocations returns the memory locations accessed by the given
+// instruction, and a boolean indicating if the memory accesses of the
+// instruction are known. Instructions which do not access memory have no
+// memory locations.
+//
+// The memory accesses of load, store, cmpxchg and atomicrmw instructions and
+// calls to memory intrinsics (llvm.memcpy, llvm.memmove and llvm.memset) are
+// known. The memory accesses of other calls, fence and va_arg instructions are
+// unknown.
+//
+// The size of memory locations of memory intrinsics with constant length is
+// known; the size of other memory locations is UnknownSize, and their type is
+// the type of the loaded or stored value.
+func MemoryLocations(inst ir.Instruction) ([]MemoryLocation, bool) {
+	loc := func(ptr value.Value, typ types.Type, mds ir.Metadata) MemoryLocation {
+		// Malformed access tags are ignored.
+		tag, _ := mds.TBAA()
+		return MemoryLocation{Ptr: ptr, Size: UnknownSize, Typ: typ, TBAA: tag}
+	}
+	switch inst := inst.(type) {
+	case *ir.InstLoad:
+		return []MemoryLocation{loc(inst.Src, inst.Typ, inst.Metadata)}, true
+	case *ir.InstStore:
+		return []MemoryLocation{loc(inst.Dst, inst.Src.Type(), inst.Metadata)}, true
+	case *ir.InstCmpXchg:
+		return []MemoryLocation{loc(inst.Ptr, inst.New.Type(), inst.Metadata)}, true
+	case *ir.InstAtomicRMW:
+		return []MemoryLocation{loc(inst.Dst, inst.X.Type(), inst.Metadata)}, true
+	case *ir.InstCall:
+		callee, ok := inst.Callee.(*ir.Func)
+		if !ok {
+			return nil, false
+		}
+		var ptrs []value.Value
+		switch name := callee.Name(); {
+		case strings.HasPrefix(name, "llvm.memcpy."), strings.HasPrefix(name, "llvm.memmove."):
+			if len(inst.Args) < 3 {
+				return nil, false
+			}
+			ptrs = []value.Value{inst.Args[0], inst.Args[1]}
+		case strings.HasPrefix(name, "llvm.memset."):
+			if len(inst.Args) < 3 {
+				return nil, false
+			}
+			ptrs = []value.Value{inst.Args[0]}
+		default:
+			return nil, false
+		}
+		size := uint64(UnknownSize)
+		if n, ok := argValue(inst.Args[2]).(*constant.Int); ok && n.X.IsUint64() {
+			size = n.X.Uint64()
+		}
+		var locs []MemoryLocation
+		for _, ptr := range ptrs {
+			l := loc(argValue(ptr), nil, inst.Metadata)
+			l.Size = size
+			locs = append(locs, l)
+		}
+		return locs, true
+	case *ir.InstFence, *ir.InstVAArg:
+		return nil, false
+	}
+	return nil, true
+}
+
+// AliasInsts returns the alias result of the memory locations accessed by the
+// given instructions, based on the given alias analysis. The result is NoAlias
+// if either instruction does not access memory, and MayAlias if the memory
+// accesses of either instruction are unknown. If the instructions access
+// several memory locations, the result is the common alias result of each pair
+// of memory locations; or MayAlias if they differ.
+func AliasInsts(aa AliasAnalysis, a, b ir.Instruction) AliasResult {
+	locsA, okA := MemoryLocations(a)
+	locsB, okB := MemoryLocations(b)
+	if okA && len(locsA) == 0 || okB && len(locsB) == 0 {
+		return NoAlias
+	}
+	if !okA || !okB {
+		return MayAlias
+	}
+	result := aa.Alias(locsA[0], locsB[0])
+	for _, locA := range locsA {
+		for _, locB := range locsB {
+			if aa.Alias(locA, locB) != result {
+				return MayAlias
+			}
+		}
+	}
+	return result
+}
+
+// ### [ Helper functions ] ####################################################
+
+// argValue returns the value of the given function argument, stripping
+// parameter attributes.
+func argValue(arg value.Value) value.Value {
+	if arg, ok := arg.(*ir.Arg); ok {
+		return arg.Value
+	}
+	return arg
+}
@@ -0,0 +1,2 @@
+// Package analysis implements analysis passes on LLVM IR modules.
+package analysis
@@ -0,0 +1,488 @@
+package analysis
+
+import (
+	"fmt"
+
+	"github.com/umaumax/llvm/ir"
+	"github.com/umaumax/llvm/ir/constant"
+	"github.com/umaumax/llvm/ir/enum"
+	"github.com/umaumax/llvm/ir/types"
+	"github.com/umaumax/llvm/ir/value"
+)
+
+// --- [ Basic alias analysis ] ------------------------------------------------
+
+// maxLookupDepth is the maximum number of casts and getelementptr instructions
+// and expressions traversed when decomposing a pointer.
+const maxLookupDepth = 6
+
+// BasicAA is a basic alias analysis, based on the definitions of pointers.
+//
+// Pointers are decomposed into a base pointer and an offset by traversing
+// chains of getelementptr instructions and expressions and pointer casts. Memory
+// locations of the same base pointer alias based on their offsets and sizes.
+// Memory locations of distinct identified objects never alias; i.e. of
+// different allocas, global variables, functions, noalias calls, and noalias
+// or byval parameters. Parameters never alias function-local objects, and
+// memory locations larger than an identified object of known size never alias
+// the object.
+type BasicAA struct {
+	// Data layout used to compute offsets and sizes.
+	DataLayout *DataLayout
+}
+
+// NewBasicAA returns a new basic alias analysis based on the given data layout.
+func NewBasicAA(dl *DataLayout) *BasicAA {
+	return &BasicAA{DataLayout: dl}
+}
+
+// Alias returns the alias result of the given memory locations.
+func (aa *BasicAA) Alias(a, b MemoryLocation) AliasResult {
+	sizeA, sizeB := aa.locSize(a), aa.locSize(b)
+	// Empty memory locations never alias.
+	if sizeA == 0 || sizeB == 0 {
+		return NoAlias
+	}
+	pa, pb := aa.decompose(a.Ptr), aa.decompose(b.Ptr)
+	if pa.stripped == pb.stripped {
+		return MustAlias
+	}
+	if pa.base == pb.base {
+		if pa.variable || pb.variable {
+			return MayAlias
+		}
+		return offsetAlias(pb.offset-pa.offset, sizeA, sizeB)
+	}
+	if distinctObjects(pa.base, pb.base) {
+		return NoAlias
+	}
+	// Memory locations larger than an identified object cannot be within the
+	// object.
+	if aa.objectSmallerThan(pb.base, sizeA) || aa.objectSmallerThan(pa.base, sizeB) {
+		return NoAlias
+	}
+	return MayAlias
+}
+
+// locSize returns the size in bytes of the given memory location; or
+// UnknownSize if unknown.
+func (aa *BasicAA) locSize(loc MemoryLocation) uint64 {
+	if loc.Size != UnknownSize || loc.Typ == nil || aa.DataLayout == nil {
+		return loc.Size
+	}
+	size, err := aa.DataLayout.StoreSize(loc.Typ)
+	if err != nil {
+		return UnknownSize
+	}
+	return size
+}
+
+// offsetAlias returns the alias result of memory locations a and b of the
+// given sizes and the same base pointer, where b starts at the given offset
+// relative to a.
+func offsetAlias(offset int64, sizeA, sizeB uint64) AliasResult {
+	if offset == 0 {
+		return MustAlias
+	}
+	// The size of the memory location starting first determines if the memory
+	// locations overlap.
+	size := sizeA
+	if offset < 0 {
+		offset, size = -offset, sizeB
+	}
+	if size == UnknownSize {
+		return MayAlias
+	}
+	if uint64(offset) < size {
+		return PartialAlias
+	}
+	return NoAlias
+}
+
+// objectSmallerThan reports whether the given base pointer identifies an object
+// of known size smaller than size bytes.
+func (aa *BasicAA) objectSmallerThan(base value.Value, size uint64) bool {
+	if size == UnknownSize || aa.DataLayout == nil {
+		return false
+	}
+	objSize, ok := aa.objectSize(base)
+	return ok && objSize < size
+}
+
+// objectSize returns the size in bytes (rounded up to the alignment) of the
+// object identified by the given base pointer, and a boolean indicating if
+// known.
+func (aa *BasicAA) objectSize(base value.Value) (uint64, bool) {
+	dl := aa.DataLayout
+	var typ types.Type
+	count, align := uint64(1), uint64(0)
+	switch v := base.(type) {
+	case *ir.InstAlloca:
+		if v.NElems != nil {
+			n, ok := constIndex(v.NElems)
+			if !ok || n < 0 {
+				return 0, false
+			}
+			count = uint64(n)
+		}
+		typ, align = v.ElemType, uint64(v.Align)
+		if align == 0 {
+			a, err := dl.ABIAlign(typ)
+			if err != nil {
+				return 0, false
+			}
+			align = a
+		}
+	case *ir.Global:
+		// The initializer of interposable global variables may be replaced.
+		switch v.Linkage {
+		case enum.LinkageCommon, enum.LinkageLinkOnce, enum.LinkageWeak, enum.LinkageExternWeak:
+			return 0, false
+		}
+		if v.Init == nil || v.ExternallyInitialized {
+			return 0, false
+		}
+		typ, align = v.ContentType, uint64(v.Align)
+	case *ir.Param:
+		for _, attr := range v.Attrs {
+			if byval, ok := attr.(ir.Byval); ok {
+				typ = byval.Typ
+				if typ == nil {
+					if t, ok := v.Typ.(*types.PointerType); ok {
+						typ = t.ElemType
+					}
+				}
+			}
+		}
+	}
+	if typ == nil {
+		return 0, false
+	}
+	size, err := dl.AllocSize(typ)
+	if err != nil {
+		return 0, false
+	}
+	return alignTo(size*count, align), true
+}
+
+// decomposedPtr is a pointer decomposed into a base pointer and an offset.
+type decomposedPtr struct {
+	// Pointer stripped of pointer casts.
+	stripped value.Value
+	// Base pointer.
+	base value.Value
+	// Offset in bytes relative to the base pointer; excluding variable indices.
+	offset int64
+	// The offset depends on variable indices.
+	variable bool
+}
+
+// decompose decomposes the given pointer into a base pointer and an offset.
+func (aa *BasicAA) decompose(ptr value.Value) decomposedPtr {
+	p := decomposedPtr{stripped: ptr}
+	stripping := true
+	for i := 0; i < maxLookupDepth; i++ {
+		var src value.Value
+		switch v := ptr.(type) {
+		case *ir.InstBitCast:
+			src = v.From
+		case *ir.InstAddrSpaceCast:
+			src = v.From
+		case *constant.ExprBitCast:
+			src = v.From
+		case *constant.ExprAddrSpaceCast:
+			src = v.From
+		case *ir.InstGetElementPtr:
+			if !aa.gepOffset(&p, v.Typ, v.ElemType, v.Indices) {
+				break
+			}
+			src, stripping = v.Src, false
+		case *constant.ExprGetElementPtr:
+			indices := make([]value.Value, len(v.Indices))
+			for j, index := range v.Indices {
+				indices[j] = index
+			}
+			if !aa.gepOffset(&p, v.Typ, v.ElemType, indices) {
+				break
+			}
+			src, stripping = v.Src, false
+		}
+		if src == nil {
+			break
+		}
+		if stripping {
+			p.stripped = src
+		}
+		ptr = src
+	}
+	p.base = ptr
+	return p
+}
+
+// gepOffset adds the offset of the given getelementptr indices to p, and
+// reports whether the offset was computed.
+func (aa *BasicAA) gepOffset(p *decomposedPtr, typ, elemType types.Type, indices []value.Value) bool {
+	// Vectors of pointers are not decomposed.
+	if _, ok := typ.(*types.PointerType); !ok || aa.DataLayout == nil || len(indices) == 0 {
+		return false
+	}
+	var offset int64
+	variable := false
+	// stride adds the offset of index into an array of elements of type t.
+	stride := func(t types.Type, index value.Value) bool {
+		size, err := aa.DataLayout.AllocSize(t)
+		if err != nil {
+			return false
+		}
+		if x, ok := constIndex(index); ok {
+			offset += x * int64(size)
+		} else {
+			variable = true
+		}
+		return true
+	}
+	if !stride(elemType, indices[0]) {
+		return false
+	}
+	t := elemType
+	for _, index := range indices[1:] {
+		switch tt := t.(type) {
+		case *types.StructType:
+			x, ok := constIndex(index)
+			if !ok || x < 0 || x >= int64(len(tt.Fields)) {
+				return false
+			}
+			fieldOffset, err := aa.DataLayout.FieldOffset(tt, int(x))
+			if err != nil {
+				return false
+			}
+			offset += int64(fieldOffset)
+			t = tt.Fields[x]
+		case *types.ArrayType:
+			if !stride(tt.ElemType, index) {
+				return false
+			}
+			t = tt.ElemType
+		case *types.VectorType:
+			if !stride(tt.ElemType, index) {
+				return false
+			}
+			t = tt.ElemType
+		default:
+			return false
+		}
+	}
+	p.offset += offset
+	p.variable = p.variable || variable
+	return true
+}
+
+// constIndex returns the sign-extended value of the given constant integer
+// index, and a boolean indicating if the index is constant.
+func constIndex(index value.Value) (int64, bool) {
+	if i, ok := index.(*constant.Index); ok {
+		index = i.Constant
+	}
+	c, ok := index.(*constant.Int)
+	if !ok || !c.X.IsInt64() {
+		return 0, false
+	}
+	x := c.X.Int64()
+	if bits := c.Typ.BitSize; bits < 64 && x >= 1<<(bits-1) {
+		x -= 1 << bits
+	}
+	return x, true
+}
+
+// distinctObjects reports whether the given base pointers are known to point
+// to distinct objects.
+func distinctObjects(a, b value.Value) bool {
+	// Null pointers of address space 0 are not accessible.
+	if isNull(a) || isNull(b) {
+		return true
+	}
+	if isIdentifiedObject(a) && isIdentifiedObject(b) {
+		return true
+	}
+	// Parameters cannot point to function-local objects.
+	_, paramA := a.(*ir.Param)
+	_, paramB := b.(*ir.Param)
+	return paramA && isFuncLocalObject(b) || paramB && isFuncLocalObject(a)
+}
+
+// isNull reports whether the given pointer is a null pointer of address space
+// 0.
+func isNull(v value.Value) bool {
+	null, ok := v.(*constant.Null)
+	return ok && null.Typ != nil && null.Typ.AddrSpace == 0
+}
+
+// isIdentifiedObject reports whether the given pointer identifies an object
+// distinct from every other identified object; i.e. an alloca, a global
+// variable, a function, a noalias call, or a noalias or byval parameter.
+func isIdentifiedObject(v value.Value) bool {
+	switch v.(type) {
+	case *ir.Global, *ir.Func:
+		return true
+	}
+	return isFuncLocalObject(v)
+}
+
+// isFuncLocalObject reports whether the given pointer identifies an object
+// local to a function; i.e. an alloca, a noalias call, or a noalias or byval
+// parameter.
+func isFuncLocalObject(v value.Value) bool {
+	switch v := v.(type) {
+	case *ir.InstAlloca:
+		return true
+	case *ir.InstCall:
+		for _, attr := range v.ReturnAttrs {
+			if attr == enum.ReturnAttrNoAlias {
+				return true
+			}
+		}
+	case *ir.Param:
+		for _, attr := range v.Attrs {
+			switch attr.(type) {
+			case ir.Byval:
+				return true
+			}
+			if attr == enum.ParamAttrNoAlias {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// --- [ Mod/ref information ] -------------------------------------------------
+
+// ModRefInfo specifies whether an instruction may read (ref) or write (mod) a
+// memory location.
+type ModRefInfo uint8
+
+// Mod/ref information.
+const (
+	// The instruction neither reads nor writes the memory location.
+	NoModRef ModRefInfo = 0
+	// The instruction may read the memory location.
+	Ref ModRefInfo = 1
+	// The instruction may write the memory location.
+	Mod ModRefInfo = 2
+	// The instruction may read or write the memory location.
+	ModRef = Ref | Mod
+)
+
+// String returns the string representation of the mod/ref information.
+func (mr ModRefInfo) String() string {
+	switch mr {
+	case NoModRef:
+		return "NoModRef"
+	case Ref:
+		return "Ref"
+	case Mod:
+		return "Mod"
+	case ModRef:
+		return "ModRef"
+	}
+	return fmt.Sprintf("ModRefInfo(%d)", uint8(mr))
+}
+
+// ModRef returns the mod/ref information of the given call for the memory
+// location.
+//
+// The mod/ref behaviour of calls is based on the function attributes of the
+// call and callee (readnone, readonly, writeonly, argmemonly,
+// inaccessiblememonly and inaccessiblemem_or_argmemonly), and the parameter
+// attributes of pointer arguments (readnone, readonly and writeonly). Calls to
+// memory intrinsics write their destination and read their source.
+func (aa *BasicAA) ModRef(call *ir.InstCall, loc MemoryLocation) ModRefInfo {
+	callee, _ := call.Callee.(*ir.Func)
+	attrs := call.FuncAttrs
+	if callee != nil {
+		attrs = append(attrs[:len(attrs):len(attrs)], callee.FuncAttrs...)
+	}
+	mr := ModRef
+	switch {
+	case hasFuncAttr(attrs, enum.FuncAttrReadNone):
+		return NoModRef
+	case hasFuncAttr(attrs, enum.FuncAttrReadOnly):
+		mr = Ref
+	case hasFuncAttr(attrs, enum.FuncAttrWriteOnly):
+		mr = Mod
+	}
+	// Memory not accessible from the module.
+	if hasFuncAttr(attrs, enum.FuncAttrInaccessibleMemOnly) {
+		return NoModRef
+	}
+	// Memory intrinsics write the memory location of the destination (first
+	// memory location) and read the memory location of the source.
+	if locs, ok := MemoryLocations(call); ok {
+		result := NoModRef
+		for i, l := range locs {
+			if aa.Alias(l, loc) == NoAlias {
+				continue
+			}
+			if i == 0 {
+				result |= Mod
+			} else {
+				result |= Ref
+			}
+		}
+		return result & mr
+	}
+	if !hasFuncAttr(attrs, enum.FuncAttrArgMemOnly) && !hasFuncAttr(attrs, enum.FuncAttrInaccessibleMemOrArgMemOnly) {
+		return mr
+	}
+	// Only memory pointed to by pointer arguments is accessed.
+	result := NoModRef
+	for i, arg := range call.Args {
+		ptr := argValue(arg)
+		if _, ok := ptr.Type().(*types.PointerType); !ok {
+			continue
+		}
+		argMR := ModRef
+		var paramAttrs []ir.ParamAttribute
+		if arg, ok := arg.(*ir.Arg); ok {
+			paramAttrs = append(paramAttrs, arg.Attrs...)
+		}
+		if callee != nil && i < len(callee.Params) {
+			paramAttrs = append(paramAttrs, callee.Params[i].Attrs...)
+		}
+		for _, attr := range paramAttrs {
+			switch attr {
+			case enum.ParamAttrReadNone:
+				argMR = NoModRef
+			case enum.ParamAttrReadOnly:
+				argMR &= Ref
+			case enum.ParamAttrWriteOnly:
+				argMR &= Mod
+			}
+		}
+		if argMR == NoModRef {
+			continue
+		}
+		if aa.Alias(MemoryLocation{Ptr: ptr, Size: UnknownSize}, loc) != NoAlias {
+			result |= argMR
+		}
+	}
+	return result & mr
+}
+
+// hasFuncAttr reports whether the given function attributes (including
+// attribute groups) contain the given function attribute.
+func hasFuncAttr(attrs []ir.FuncAttribute, attr enum.FuncAttr) bool {
+	for _, a := range attrs {
+		switch a := a.(type) {
+		case enum.FuncAttr:
+			if a == attr {
+				return true
+			}
+		case *ir.AttrGroupDef:
+			if hasFuncAttr(a.FuncAttrs, attr) {
+				return true
+			}
+		}
+	}
+	return false
+}
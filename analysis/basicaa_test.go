@@ -0,0 +1,158 @@
+package analysis_test
+
+import (
+	"testing"
+
+	"github.com/umaumax/llvm/analysis"
+	"github.com/umaumax/llvm/asm"
+	"github.com/umaumax/llvm/ir"
+	"github.com/umaumax/llvm/ir/types"
+	"github.com/umaumax/llvm/ir/value"
+)
+
+func TestBasicAA(t *testing.T) {
+	const input = `
+target datalayout = "e-m:e-i64:64-f80:128-n8:16:32:64-S128"
+
+%S = type { i8, i32, i64 }
+
+@g = global [4 x i32] zeroinitializer
+@h = global i32 0
+
+declare void @r(i32*) readonly
+
+declare void @w(i32* readonly, i32* writeonly) argmemonly
+
+declare void @n() readnone
+
+declare void @u()
+
+declare void @llvm.memset.p0i8.i64(i8*, i8, i64, i1)
+
+define void @f(i32* noalias %p, i32* %q, %S* %s, i64 %i) {
+entry:
+	%a = alloca i32
+	%b = alloca i64
+	%s0 = getelementptr %S, %S* %s, i64 0, i32 0
+	%s1 = getelementptr %S, %S* %s, i64 0, i32 1
+	%s2 = getelementptr %S, %S* %s, i64 0, i32 2
+	%s2c = bitcast i64* %s2 to i32*
+	%s3 = getelementptr %S, %S* %s, i64 1
+	%g1 = getelementptr [4 x i32], [4 x i32]* @g, i64 0, i64 1
+	%gi = getelementptr [4 x i32], [4 x i32]* @g, i64 0, i64 %i
+	%b8 = bitcast i64* %b to i8*
+	call void @r(i32* %a)
+	call void @w(i32* %a, i32* %p)
+	call void @n()
+	call void @u()
+	call void @llvm.memset.p0i8.i64(i8* %b8, i8 0, i64 4, i1 false)
+	ret void
+}
+`
+	m, err := asm.ParseString("<stdin>", input)
+	if err != nil {
+		t.Fatalf("unable to parse module; %+v", err)
+	}
+	dl, err := analysis.NewDataLayout(m.DataLayout)
+	if err != nil {
+		t.Fatalf("unable to parse data layout; %+v", err)
+	}
+	aa := analysis.NewBasicAA(dl)
+	f := m.Funcs[len(m.Funcs)-1]
+	values := make(map[string]value.Value)
+	for _, g := range m.Globals {
+		values[g.Ident()] = g
+	}
+	for _, param := range f.Params {
+		values[param.Ident()] = param
+	}
+	var calls []*ir.InstCall
+	for _, inst := range f.Blocks[0].Insts {
+		if call, ok := inst.(*ir.InstCall); ok {
+			calls = append(calls, call)
+			continue
+		}
+		v := inst.(value.Value)
+		values[v.Ident()] = v
+	}
+	// loc returns the memory location of the pointee of the given pointer.
+	loc := func(name string) analysis.MemoryLocation {
+		v, ok := values[name]
+		if !ok {
+			t.Fatalf("unable to locate value %q", name)
+		}
+		elemType := v.Type().(*types.PointerType).ElemType
+		return analysis.MemoryLocation{Ptr: v, Size: analysis.UnknownSize, Typ: elemType}
+	}
+	// Alias results agree with opt -aa-pipeline=basic-aa -passes=aa-eval,
+	// except for %gi <-> @g (PartialAlias in LLVM).
+	golden := []struct {
+		a, b string
+		want analysis.AliasResult
+	}{
+		// noalias parameters.
+		{a: "%p", b: "%q", want: analysis.NoAlias},
+		{a: "%p", b: "%s", want: analysis.NoAlias},
+		{a: "%q", b: "%s", want: analysis.MayAlias},
+		// allocas and globals.
+		{a: "%a", b: "%b", want: analysis.NoAlias},
+		{a: "%a", b: "%q", want: analysis.NoAlias},
+		{a: "%a", b: "@g", want: analysis.NoAlias},
+		{a: "%g1", b: "@h", want: analysis.NoAlias},
+		{a: "%q", b: "@h", want: analysis.MayAlias},
+		// object sizes.
+		{a: "%s", b: "@h", want: analysis.NoAlias},
+		{a: "%s2", b: "@h", want: analysis.NoAlias},
+		{a: "%s1", b: "@h", want: analysis.MayAlias},
+		// offsets.
+		{a: "%s", b: "%s0", want: analysis.MustAlias},
+		{a: "%s", b: "%s1", want: analysis.PartialAlias},
+		{a: "%s0", b: "%s1", want: analysis.NoAlias},
+		{a: "%s1", b: "%s2", want: analysis.NoAlias},
+		{a: "%s2", b: "%s", want: analysis.PartialAlias},
+		{a: "%s2c", b: "%s2", want: analysis.MustAlias},
+		{a: "%s3", b: "%s", want: analysis.NoAlias},
+		{a: "%b8", b: "%b", want: analysis.MustAlias},
+		{a: "%g1", b: "@g", want: analysis.PartialAlias},
+		// variable indices.
+		{a: "%gi", b: "%g1", want: analysis.MayAlias},
+		{a: "%gi", b: "@g", want: analysis.MayAlias},
+		{a: "%gi", b: "%s1", want: analysis.MayAlias},
+	}
+	for _, g := range golden {
+		if got := aa.Alias(loc(g.a), loc(g.b)); got != g.want {
+			t.Errorf("alias result of %s <-> %s mismatch; expected %v, got %v", g.a, g.b, g.want, got)
+		}
+	}
+	// Mod/ref results agree with opt -aa-pipeline=basic-aa -passes=aa-eval,
+	// except for non-escaping allocas (e.g. %b <-> call @r is NoModRef in
+	// LLVM).
+	r, w, n, u, memset := calls[0], calls[1], calls[2], calls[3], calls[4]
+	modRefGolden := []struct {
+		call *ir.InstCall
+		ptr  string
+		want analysis.ModRefInfo
+	}{
+		// readonly.
+		{call: r, ptr: "%a", want: analysis.Ref},
+		{call: r, ptr: "%b", want: analysis.Ref},
+		// argmemonly with readonly and writeonly parameters.
+		{call: w, ptr: "%a", want: analysis.Ref},
+		{call: w, ptr: "%p", want: analysis.Mod},
+		{call: w, ptr: "%q", want: analysis.NoModRef},
+		{call: w, ptr: "@h", want: analysis.NoModRef},
+		// readnone.
+		{call: n, ptr: "%q", want: analysis.NoModRef},
+		// unknown.
+		{call: u, ptr: "%q", want: analysis.ModRef},
+		// memory intrinsics.
+		{call: memset, ptr: "%b", want: analysis.Mod},
+		{call: memset, ptr: "%a", want: analysis.NoModRef},
+		{call: memset, ptr: "%q", want: analysis.NoModRef},
+	}
+	for _, g := range modRefGolden {
+		if got := aa.ModRef(g.call, loc(g.ptr)); got != g.want {
+			t.Errorf("mod/ref result of %s <-> %q mismatch; expected %v, got %v", g.ptr, g.call.LLString(), g.want, got)
+		}
+	}
+}
@@ -0,0 +1,357 @@
+package analysis
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/umaumax/llvm/ir/types"
+	"github.com/pkg/errors"
+)
+
+// --- [ Data layout ] ---------------------------------------------------------
+
+// DataLayout is a data layout, as specified by the target datalayout string of
+// a module (e.g. "e-m:e-i64:64-f80:128-n8:16:32:64-S128"). It specifies the
+// size and alignment of types in memory.
+type DataLayout struct {
+	// Big-endian byte order.
+	BigEndian bool
+	// pointers maps from address space to pointer layout.
+	pointers map[types.AddrSpace]pointerLayout
+	// ints, floats and vectors map from bit width to ABI alignment in bytes of
+	// integer, floating-point and vector types respectively.
+	ints, floats, vectors map[uint64]uint64
+	// ABI alignment in bytes of aggregate types.
+	aggregateAlign uint64
+}
+
+// pointerLayout is the layout of pointers in a given address space.
+type pointerLayout struct {
+	// Size in bytes.
+	size uint64
+	// ABI alignment in bytes.
+	align uint64
+}
+
+// NewDataLayout returns a new data layout based on the given target datalayout
+// string. Specifications not present in the datalayout string default to the
+// ones of LLVM (e.g. 64-bit pointers and little-endian byte order).
+func NewDataLayout(layout string) (*DataLayout, error) {
+	dl := &DataLayout{
+		pointers:       map[types.AddrSpace]pointerLayout{0: {size: 8, align: 8}},
+		ints:           map[uint64]uint64{1: 1, 8: 1, 16: 2, 32: 4, 64: 4},
+		floats:         map[uint64]uint64{16: 2, 32: 4, 64: 8, 128: 16},
+		vectors:        map[uint64]uint64{64: 8, 128: 16},
+		aggregateAlign: 1,
+	}
+	if len(layout) == 0 {
+		return dl, nil
+	}
+	for _, spec := range strings.Split(layout, "-") {
+		if err := dl.parseSpec(spec); err != nil {
+			return nil, errors.WithStack(err)
+		}
+	}
+	return dl, nil
+}
+
+// parseSpec parses the given specification of a target datalayout string.
+func (dl *DataLayout) parseSpec(spec string) error {
+	if len(spec) == 0 {
+		return errors.Errorf("invalid empty data layout specification")
+	}
+	parts := strings.Split(spec[1:], ":")
+	switch kind := spec[0]; kind {
+	case 'e', 'E':
+		if len(spec) != 1 {
+			return errors.Errorf("invalid endianness specification %q", spec)
+		}
+		dl.BigEndian = kind == 'E'
+	case 'p':
+		// p[n]:<size>:<abi>[:<pref>][:<idx>]
+		if len(parts) < 3 || len(parts) > 5 {
+			return errors.Errorf("invalid pointer specification %q", spec)
+		}
+		var addrSpace uint64
+		if len(parts[0]) > 0 {
+			var err error
+			if addrSpace, err = strconv.ParseUint(parts[0], 10, 64); err != nil {
+				return errors.Errorf("invalid address space of pointer specification %q", spec)
+			}
+		}
+		size, err := parseBits(spec, parts[1])
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		align, err := parseBits(spec, parts[2])
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		if size == 0 || align == 0 {
+			return errors.Errorf("invalid zero size or alignment of pointer specification %q", spec)
+		}
+		dl.pointers[types.AddrSpace(addrSpace)] = pointerLayout{size: size / 8, align: align / 8}
+	case 'i', 'v', 'f', 'a':
+		// i<size>:<abi>[:<pref>]
+		// a:<abi>[:<pref>]
+		if len(parts) < 2 || len(parts) > 3 {
+			return errors.Errorf("invalid alignment specification %q", spec)
+		}
+		var size uint64
+		if len(parts[0]) > 0 {
+			var err error
+			if size, err = strconv.ParseUint(parts[0], 10, 64); err != nil {
+				return errors.Errorf("invalid size of alignment specification %q", spec)
+			}
+		}
+		align, err := parseBits(spec, parts[1])
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		switch kind {
+		case 'i':
+			if size == 0 || (size == 8 && align != 8) {
+				return errors.Errorf("invalid integer alignment specification %q", spec)
+			}
+			dl.ints[size] = align / 8
+		case 'v':
+			dl.vectors[size] = align / 8
+		case 'f':
+			dl.floats[size] = align / 8
+		case 'a':
+			if align == 0 {
+				align = 8
+			}
+			dl.aggregateAlign = align / 8
+		}
+	case 'm', 'n', 'S', 'F', 'P', 'A', 'G':
+		// Name mangling, native integer widths, stack alignment, function
+		// pointer alignment and address spaces of programs, allocas and globals
+		// do not affect the layout of types in memory.
+	default:
+		return errors.Errorf("unknown data layout specification %q", spec)
+	}
+	return nil
+}
+
+// parseBits parses the given size or alignment in bits of the data layout
+// specification; which must be a multiple of 8.
+func parseBits(spec, s string) (uint64, error) {
+	bits, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return 0, errors.Errorf("invalid size or alignment %q of data layout specification %q", s, spec)
+	}
+	if bits%8 != 0 {
+		return 0, errors.Errorf("invalid size or alignment %q of data layout specification %q; expected multiple of 8", s, spec)
+	}
+	return bits, nil
+}
+
+// PointerSize returns the size in bytes of pointers in the given address
+// space.
+func (dl *DataLayout) PointerSize(addrSpace types.AddrSpace) uint64 {
+	return dl.pointerLayout(addrSpace).size
+}
+
+// pointerLayout returns the layout of pointers in the given address space,
+// defaulting to the layout of address space 0.
+func (dl *DataLayout) pointerLayout(addrSpace types.AddrSpace) pointerLayout {
+	if p, ok := dl.pointers[addrSpace]; ok {
+		return p
+	}
+	return dl.pointers[0]
+}
+
+// SizeInBits returns the size in bits of the given type.
+func (dl *DataLayout) SizeInBits(t types.Type) (uint64, error) {
+	switch t := t.(type) {
+	case *types.IntType:
+		return t.BitSize, nil
+	case *types.FloatType:
+		switch t.Kind {
+		case types.FloatKindHalf:
+			return 16, nil
+		case types.FloatKindFloat:
+			return 32, nil
+		case types.FloatKindDouble:
+			return 64, nil
+		case types.FloatKindX86_FP80:
+			return 80, nil
+		case types.FloatKindFP128, types.FloatKindPPC_FP128:
+			return 128, nil
+		}
+	case *types.MMXType:
+		return 64, nil
+	case *types.PointerType:
+		return 8 * dl.PointerSize(t.AddrSpace), nil
+	case *types.VectorType:
+		if t.Scalable {
+			return 0, errors.Errorf("unable to compute size of scalable vector type %v", t)
+		}
+		bits, err := dl.SizeInBits(t.ElemType)
+		if err != nil {
+			return 0, errors.WithStack(err)
+		}
+		return t.Len * bits, nil
+	case *types.ArrayType:
+		size, err := dl.AllocSize(t.ElemType)
+		if err != nil {
+			return 0, errors.WithStack(err)
+		}
+		return 8 * t.Len * size, nil
+	case *types.StructType:
+		_, size, _, err := dl.structLayout(t)
+		if err != nil {
+			return 0, errors.WithStack(err)
+		}
+		return 8 * size, nil
+	}
+	return 0, errors.Errorf("unable to compute size of unsized type %v", t)
+}
+
+// StoreSize returns the maximum number of bytes written when storing a value of
+// the given type.
+func (dl *DataLayout) StoreSize(t types.Type) (uint64, error) {
+	bits, err := dl.SizeInBits(t)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	return (bits + 7) / 8, nil
+}
+
+// AllocSize returns the offset in bytes between successive values of the given
+// type (e.g. elements of arrays); i.e. the store size including alignment
+// padding.
+func (dl *DataLayout) AllocSize(t types.Type) (uint64, error) {
+	size, err := dl.StoreSize(t)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	align, err := dl.ABIAlign(t)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	return alignTo(size, align), nil
+}
+
+// ABIAlign returns the ABI alignment in bytes of the given type.
+func (dl *DataLayout) ABIAlign(t types.Type) (uint64, error) {
+	switch t := t.(type) {
+	case *types.IntType:
+		// Use the alignment of the smallest integer type at least as wide, or
+		// of the widest integer type.
+		var align, min, max uint64
+		for bits, a := range dl.ints {
+			if bits >= t.BitSize && (min == 0 || bits < min) {
+				min, align = bits, a
+			}
+			if bits > max {
+				max = bits
+			}
+		}
+		if min == 0 {
+			align = dl.ints[max]
+		}
+		return align, nil
+	case *types.FloatType:
+		bits, err := dl.SizeInBits(t)
+		if err != nil {
+			return 0, errors.WithStack(err)
+		}
+		if align, ok := dl.floats[bits]; ok {
+			return align, nil
+		}
+		return powerOf2Ceil((bits + 7) / 8), nil
+	case *types.MMXType:
+		if align, ok := dl.vectors[64]; ok {
+			return align, nil
+		}
+		return 8, nil
+	case *types.PointerType:
+		return dl.pointerLayout(t.AddrSpace).align, nil
+	case *types.VectorType:
+		bits, err := dl.SizeInBits(t)
+		if err != nil {
+			return 0, errors.WithStack(err)
+		}
+		if align, ok := dl.vectors[bits]; ok {
+			return align, nil
+		}
+		// Natural alignment.
+		return powerOf2Ceil((bits + 7) / 8), nil
+	case *types.ArrayType:
+		return dl.ABIAlign(t.ElemType)
+	case *types.StructType:
+		if t.Packed {
+			return 1, nil
+		}
+		_, _, align, err := dl.structLayout(t)
+		if err != nil {
+			return 0, errors.WithStack(err)
+		}
+		if dl.aggregateAlign > align {
+			return dl.aggregateAlign, nil
+		}
+		return align, nil
+	}
+	return 0, errors.Errorf("unable to compute alignment of unsized type %v", t)
+}
+
+// FieldOffset returns the offset in bytes of the given field of the struct
+// type.
+func (dl *DataLayout) FieldOffset(t *types.StructType, field int) (uint64, error) {
+	if field < 0 || field >= len(t.Fields) {
+		return 0, errors.Errorf("invalid field index %d of struct type %v with %d fields", field, t, len(t.Fields))
+	}
+	offsets, _, _, err := dl.structLayout(t)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	return offsets[field], nil
+}
+
+// structLayout returns the field offsets, size and alignment (excluding the
+// alignment of aggregates) in bytes of the given struct type.
+func (dl *DataLayout) structLayout(t *types.StructType) (offsets []uint64, size, align uint64, err error) {
+	if t.Opaque {
+		return nil, 0, 0, errors.Errorf("unable to compute layout of opaque struct type %v", t)
+	}
+	align = 1
+	for _, field := range t.Fields {
+		fieldAlign := uint64(1)
+		if !t.Packed {
+			if fieldAlign, err = dl.ABIAlign(field); err != nil {
+				return nil, 0, 0, errors.WithStack(err)
+			}
+		}
+		fieldSize, err := dl.AllocSize(field)
+		if err != nil {
+			return nil, 0, 0, errors.WithStack(err)
+		}
+		size = alignTo(size, fieldAlign)
+		if fieldAlign > align {
+			align = fieldAlign
+		}
+		offsets = append(offsets, size)
+		size += fieldSize
+	}
+	// Pad the end of the struct for it to be aligned in arrays.
+	return offsets, alignTo(size, align), align, nil
+}
+
+// alignTo returns x rounded up to a multiple of the given alignment.
+func alignTo(x, align uint64) uint64 {
+	if align == 0 {
+		return x
+	}
+	return (x + align - 1) / align * align
+}
+
+// powerOf2Ceil returns the smallest power of two greater than or equal to x.
+func powerOf2Ceil(x uint64) uint64 {
+	p := uint64(1)
+	for p < x {
+		p <<= 1
+	}
+	return p
+}
@@ -0,0 +1,88 @@
+package analysis_test
+
+import (
+	"testing"
+
+	"github.com/umaumax/llvm/analysis"
+	"github.com/umaumax/llvm/ir/types"
+)
+
+func TestDataLayout(t *testing.T) {
+	s := types.NewStruct(types.I8, types.I32, types.I64)
+	packed := &types.StructType{Packed: true, Fields: []types.Type{types.I8, types.I32}}
+	golden := []struct {
+		layout    string
+		typ       types.Type
+		storeSize uint64
+		allocSize uint64
+		align     uint64
+	}{
+		// x86_64.
+		{layout: "e-m:e-i64:64-f80:128-n8:16:32:64-S128", typ: types.I64, storeSize: 8, allocSize: 8, align: 8},
+		{layout: "e-m:e-i64:64-f80:128-n8:16:32:64-S128", typ: types.X86_FP80, storeSize: 10, allocSize: 16, align: 16},
+		{layout: "e-m:e-i64:64-f80:128-n8:16:32:64-S128", typ: s, storeSize: 16, allocSize: 16, align: 8},
+		{layout: "e-m:e-i64:64-f80:128-n8:16:32:64-S128", typ: types.NewPointer(types.I8), storeSize: 8, allocSize: 8, align: 8},
+		// Default data layout.
+		{layout: "", typ: types.I64, storeSize: 8, allocSize: 8, align: 4},
+		{layout: "", typ: types.NewInt(128), storeSize: 16, allocSize: 16, align: 4},
+		{layout: "", typ: types.I1, storeSize: 1, allocSize: 1, align: 1},
+		{layout: "", typ: types.NewInt(24), storeSize: 3, allocSize: 4, align: 4},
+		{layout: "", typ: s, storeSize: 16, allocSize: 16, align: 4},
+		{layout: "", typ: packed, storeSize: 5, allocSize: 5, align: 1},
+		{layout: "", typ: types.NewArray(3, types.I16), storeSize: 6, allocSize: 6, align: 2},
+		{layout: "", typ: types.NewVector(3, types.I32), storeSize: 12, allocSize: 16, align: 16},
+		// 32-bit pointers.
+		{layout: "e-p:32:32-i64:64", typ: types.NewPointer(types.I8), storeSize: 4, allocSize: 4, align: 4},
+		{layout: "e-p:32:32-p1:64:64-i64:64", typ: &types.PointerType{ElemType: types.I8, AddrSpace: 1}, storeSize: 8, allocSize: 8, align: 8},
+	}
+	for _, g := range golden {
+		dl, err := analysis.NewDataLayout(g.layout)
+		if err != nil {
+			t.Errorf("unable to parse data layout %q; %+v", g.layout, err)
+			continue
+		}
+		storeSize, err := dl.StoreSize(g.typ)
+		if err != nil {
+			t.Errorf("unable to compute store size of %v; %+v", g.typ, err)
+			continue
+		}
+		allocSize, err := dl.AllocSize(g.typ)
+		if err != nil {
+			t.Errorf("unable to compute alloc size of %v; %+v", g.typ, err)
+			continue
+		}
+		align, err := dl.ABIAlign(g.typ)
+		if err != nil {
+			t.Errorf("unable to compute alignment of %v; %+v", g.typ, err)
+			continue
+		}
+		if storeSize != g.storeSize || allocSize != g.allocSize || align != g.align {
+			t.Errorf("layout of %v in %q mismatch; expected (store size %d, alloc size %d, align %d), got (store size %d, alloc size %d, align %d)", g.typ, g.layout, g.storeSize, g.allocSize, g.align, storeSize, allocSize, align)
+		}
+	}
+	// Field offsets.
+	dl, err := analysis.NewDataLayout("e-m:e-i64:64-f80:128-n8:16:32:64-S128")
+	if err != nil {
+		t.Fatalf("unable to parse data layout; %+v", err)
+	}
+	for i, want := range []uint64{0, 4, 8} {
+		offset, err := dl.FieldOffset(s, i)
+		if err != nil {
+			t.Errorf("unable to compute offset of field %d; %+v", i, err)
+			continue
+		}
+		if offset != want {
+			t.Errorf("offset of field %d mismatch; expected %d, got %d", i, want, offset)
+		}
+	}
+	// Invalid data layouts.
+	for _, layout := range []string{"e-x", "p:12:8", "i8:16", "e-i64", "p:64"} {
+		if _, err := analysis.NewDataLayout(layout); err == nil {
+			t.Errorf("expected error for invalid data layout %q", layout)
+		}
+	}
+	// Unsized types.
+	if _, err := dl.StoreSize(types.Void); err == nil {
+		t.Errorf("expected error for size of unsized type void")
+	}
+}
@@ -0,0 +1,179 @@
+package analysis
+
+import (
+	"github.com/umaumax/llvm/ir"
+	"github.com/umaumax/llvm/ir/constant"
+	"github.com/umaumax/llvm/ir/metadata"
+)
+
+// --- [ Type-based alias analysis ] -------------------------------------------
+
+// TBAA is a type-based alias analysis, based on the struct-path TBAA access
+// tags of memory accesses (!tbaa metadata).
+//
+// The type descriptors of access tags form a DAG of metadata tuples; scalar
+// type descriptors (e.g. !{!"int", !char, i64 0}) refer to their parent type,
+// struct type descriptors (e.g. !{!"S", !int, i64 0, !int, i64 4}) refer to
+// the types of their fields at the given offsets, and the root (e.g.
+// !{!"Simple C/C++ TBAA"}) has no parent.
+//
+// Type descriptors are compared by identity; use Module.CollectMetadata to
+// merge structurally equal type descriptors.
+type TBAA struct{}
+
+// NewTBAA returns a new type-based alias analysis.
+func NewTBAA() *TBAA {
+	return &TBAA{}
+}
+
+// Alias returns the alias result of the given memory locations; NoAlias if
+// their access tags prove that the memory locations do not overlap, and
+// MayAlias otherwise.
+func (aa *TBAA) Alias(a, b MemoryLocation) AliasResult {
+	if mayAliasTags(a.TBAA, b.TBAA) {
+		return MayAlias
+	}
+	return NoAlias
+}
+
+// mayAliasTags reports whether memory accesses with the given TBAA access tags
+// may alias. Memory accesses without access tag may alias any other access.
+func mayAliasTags(a, b *ir.TBAAAccessTag) bool {
+	if a == nil || b == nil {
+		return true
+	}
+	if a.BaseType == b.BaseType && a.AccessType == b.AccessType && a.Offset == b.Offset {
+		return true
+	}
+	common := leastCommonType(a.AccessType, b.AccessType)
+	// Access types of different type systems (i.e. roots) may alias.
+	if common == nil {
+		return true
+	}
+	// Accesses may alias if either accessed object may be a subobject of the
+	// other.
+	if mayAlias, ok := accessToSubobjectOf(a, b, common); ok {
+		return mayAlias
+	}
+	if mayAlias, ok := accessToSubobjectOf(b, a, common); ok {
+		return mayAlias
+	}
+	return false
+}
+
+// accessToSubobjectOf reports whether the access of sub may be an access to a
+// subobject of the object accessed by base, and if so, whether the accesses may
+// alias.
+func accessToSubobjectOf(base, sub *ir.TBAAAccessTag, common *metadata.Tuple) (mayAlias, ok bool) {
+	// Accesses of the least common type may access any of its subobjects.
+	if base.AccessType == base.BaseType && base.AccessType == common {
+		return true, true
+	}
+	// Follow the fields of the base type at the offset of the access, and
+	// check if any is the base type of sub.
+	offset := base.Offset
+	visited := make(map[*metadata.Tuple]bool)
+	for t := base.BaseType; t != nil; t = tbaaField(t, &offset) {
+		if visited[t] {
+			// Cyclic type descriptors; be conservative.
+			return true, true
+		}
+		visited[t] = true
+		if t == sub.BaseType {
+			return offset == sub.Offset, true
+		}
+	}
+	return false, false
+}
+
+// leastCommonType returns the least common ancestor of the given scalar type
+// descriptors; or nil if not present (e.g. different roots).
+func leastCommonType(a, b *metadata.Tuple) *metadata.Tuple {
+	if a == b {
+		return a
+	}
+	pathA, okA := tbaaPath(a)
+	pathB, okB := tbaaPath(b)
+	if !okA || !okB {
+		return nil
+	}
+	var common *metadata.Tuple
+	for i, j := len(pathA)-1, len(pathB)-1; i >= 0 && j >= 0; i, j = i-1, j-1 {
+		if pathA[i] != pathB[j] {
+			break
+		}
+		common = pathA[i]
+	}
+	return common
+}
+
+// tbaaPath returns the path from the given scalar type descriptor to its root,
+// and a boolean indicating if the path is acyclic.
+func tbaaPath(t *metadata.Tuple) ([]*metadata.Tuple, bool) {
+	var path []*metadata.Tuple
+	visited := make(map[*metadata.Tuple]bool)
+	for t != nil {
+		if visited[t] {
+			return nil, false
+		}
+		visited[t] = true
+		path = append(path, t)
+		t = tbaaParent(t)
+	}
+	return path, true
+}
+
+// tbaaParent returns the parent of the given scalar type descriptor; or nil if
+// root.
+func tbaaParent(t *metadata.Tuple) *metadata.Tuple {
+	if len(t.Fields) < 2 {
+		return nil
+	}
+	parent, _ := t.Fields[1].(*metadata.Tuple)
+	return parent
+}
+
+// tbaaField returns the type descriptor of the field at the given offset of
+// the type descriptor t, and updates offset to be relative to the field; or nil
+// if t has no fields (i.e. root). The parent of a scalar type descriptor is
+// treated as a field at offset 0.
+func tbaaField(t *metadata.Tuple, offset *uint64) *metadata.Tuple {
+	n := len(t.Fields)
+	if n < 2 {
+		return nil
+	}
+	// fieldOffset returns the offset of the field at the given index.
+	fieldOffset := func(i int) uint64 {
+		if i+1 >= n {
+			return 0
+		}
+		if x, ok := t.Fields[i+1].(*constant.Int); ok && x.X.IsUint64() {
+			return x.X.Uint64()
+		}
+		return 0
+	}
+	// Fields are assumed to be in order of offset; use the last field at an
+	// offset not exceeding the given offset.
+	idx := n - 2
+	if n <= 3 {
+		idx = 1
+	} else {
+		for i := 1; i+1 < n; i += 2 {
+			if fieldOffset(i) > *offset {
+				if i >= 3 {
+					idx = i - 2
+				} else {
+					idx = i
+				}
+				break
+			}
+			idx = i
+		}
+	}
+	if fieldOffset(idx) > *offset {
+		return nil
+	}
+	*offset -= fieldOffset(idx)
+	field, _ := t.Fields[idx].(*metadata.Tuple)
+	return field
+}
@@ -0,0 +1,142 @@
+package analysis_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/umaumax/llvm/analysis"
+	"github.com/umaumax/llvm/asm"
+	"github.com/umaumax/llvm/ir"
+)
+
+func TestTBAA(t *testing.T) {
+	const input = `
+define void @f(i32* %p, float* %q, i32* %r, float* %s, i32* %u, i32* %v, i8* %w, i32* %x) {
+entry:
+	store i32 0, i32* %p, !tbaa !6
+	store float 0.0, float* %q, !tbaa !7
+	store i32 0, i32* %r, !tbaa !8
+	store float 0.0, float* %s, !tbaa !9
+	store i32 0, i32* %u, !tbaa !10
+	store i32 0, i32* %v, !tbaa !11
+	store i8 0, i8* %w, !tbaa !12
+	store i32 0, i32* %x, !tbaa !15
+	ret void
+}
+
+!0 = !{!"Simple C/C++ TBAA"}
+!1 = !{!"omnipotent char", !0, i64 0}
+!2 = !{!"int", !1, i64 0}
+!3 = !{!"float", !1, i64 0}
+!4 = !{!"S", !2, i64 0, !3, i64 4}
+!5 = !{!"T", !4, i64 0, !2, i64 8}
+!6 = !{!2, !2, i64 0}
+!7 = !{!3, !3, i64 0}
+!8 = !{!4, !2, i64 0}
+!9 = !{!4, !3, i64 4}
+!10 = !{!5, !2, i64 0}
+!11 = !{!5, !2, i64 8}
+!12 = !{!1, !1, i64 0}
+!13 = !{!"Other TBAA"}
+!14 = !{!"int", !13, i64 0}
+!15 = !{!14, !14, i64 0}
+`
+	// Alias results of opt -aa-pipeline=tbaa -passes=aa-eval
+	// -evaluate-aa-metadata.
+	const want = `NoAlias: %q <-> %p
+MayAlias: %r <-> %p
+NoAlias: %r <-> %q
+NoAlias: %s <-> %p
+MayAlias: %s <-> %q
+NoAlias: %s <-> %r
+MayAlias: %u <-> %p
+NoAlias: %u <-> %q
+MayAlias: %u <-> %r
+NoAlias: %u <-> %s
+MayAlias: %v <-> %p
+NoAlias: %v <-> %q
+NoAlias: %v <-> %r
+NoAlias: %v <-> %s
+NoAlias: %v <-> %u
+MayAlias: %w <-> %p
+MayAlias: %w <-> %q
+MayAlias: %w <-> %r
+MayAlias: %w <-> %s
+MayAlias: %w <-> %u
+MayAlias: %w <-> %v
+MayAlias: %x <-> %p
+MayAlias: %x <-> %q
+MayAlias: %x <-> %r
+MayAlias: %x <-> %s
+MayAlias: %x <-> %u
+MayAlias: %x <-> %v
+MayAlias: %x <-> %w
+`
+	m, err := asm.ParseString("<stdin>", input)
+	if err != nil {
+		t.Fatalf("unable to parse module; %+v", err)
+	}
+	aa := analysis.NewTBAA()
+	insts := m.Funcs[0].Blocks[0].Insts
+	buf := &strings.Builder{}
+	for i, a := range insts {
+		for _, b := range insts[:i] {
+			result := analysis.AliasInsts(aa, a, b)
+			fmt.Fprintf(buf, "%s: %s <-> %s\n", result, a.(*ir.InstStore).Dst.Ident(), b.(*ir.InstStore).Dst.Ident())
+		}
+	}
+	if got := buf.String(); got != want {
+		t.Errorf("alias results mismatch; expected %q, got %q", want, got)
+	}
+}
+
+func TestAliasInsts(t *testing.T) {
+	const input = `
+define void @f(i8* %p, i8* %q, i32* %r) {
+entry:
+	call void @llvm.memcpy.p0i8.p0i8.i64(i8* %p, i8* %q, i64 16, i1 false), !tbaa !3
+	store i32 0, i32* %r, !tbaa !4
+	store i32 0, i32* %r
+	call void @g()
+	%x = add i32 1, 2
+	ret void
+}
+
+declare void @llvm.memcpy.p0i8.p0i8.i64(i8*, i8*, i64, i1)
+
+declare void @g()
+
+!0 = !{!"Simple C/C++ TBAA"}
+!1 = !{!"int", !0, i64 0}
+!2 = !{!"float", !0, i64 0}
+!3 = !{!2, !2, i64 0}
+!4 = !{!1, !1, i64 0}
+`
+	m, err := asm.ParseString("<stdin>", input)
+	if err != nil {
+		t.Fatalf("unable to parse module; %+v", err)
+	}
+	insts := m.Funcs[0].Blocks[0].Insts
+	memcpy, tagged, untagged, call, add := insts[0], insts[1], insts[2], insts[3], insts[4]
+	locs, ok := analysis.MemoryLocations(memcpy)
+	if !ok || len(locs) != 2 || locs[0].Ptr.Ident() != "%p" || locs[1].Ptr.Ident() != "%q" || locs[0].Size != 16 || locs[0].TBAA == nil {
+		t.Errorf("memory locations of memcpy mismatch; got %v (ok=%v)", locs, ok)
+	}
+	golden := []struct {
+		a, b ir.Instruction
+		want analysis.AliasResult
+	}{
+		{a: memcpy, b: tagged, want: analysis.NoAlias},
+		{a: memcpy, b: untagged, want: analysis.MayAlias},
+		{a: tagged, b: call, want: analysis.MayAlias},
+		{a: tagged, b: add, want: analysis.NoAlias},
+		{a: call, b: add, want: analysis.NoAlias},
+	}
+	aa := analysis.NewTBAA()
+	for i, g := range golden {
+		if got := analysis.AliasInsts(aa, g.a, g.b); got != g.want {
+			t.Errorf("%d: alias result mismatch; expected %v, got %v", i, g.want, got)
+		}
+	}
+}
@@ -7,7 +7,7 @@ import (
 	"log"
 	"time"
 
-	"github.com/llir/ll/ast"
+	"github.com/umaumax/llvm/internal/ll/ast"
 	"github.com/umaumax/llvm/ir"
 	"github.com/pkg/errors"
 )
@@ -51,7 +51,16 @@ func ParseBytes(path string, b []byte) (*ir.Module, error) {
 // ParseString parses the given LLVM IR assembly file into an LLVM IR module,
 // reading from content. An optional path to the source file may be specified
 // for error reporting.
-func ParseString(path, content string) (*ir.Module, error) {
+func ParseString(path, content string) (m *ir.Module, err error) {
+	// Malformed input is reported as errors by the translation. Remaining panics
+	// are recovered as a last resort; the stack trace of the error (printed
+	// using %+v) includes the location of the panic.
+	defer func() {
+		if e := recover(); e != nil {
+			m = nil
+			err = errors.Errorf("unable to parse %q; panic: %v", path, e)
+		}
+	}()
 	parseStart := time.Now()
 	tree, err := ast.Parse(path, content)
 	if err != nil {
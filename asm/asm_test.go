@@ -4,6 +4,7 @@ import (
 	"io/ioutil"
 	"log"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/mewkiz/pkg/diffutil"
@@ -40,6 +41,15 @@ func TestParseFile(t *testing.T) {
 		// parameter attributes.
 		{path: "testdata/param_attrs.ll"},
 
+		// opaque pointers.
+		{path: "testdata/opaque_ptr.ll"},
+
+		// freeze and callbr.
+		{path: "testdata/freeze_callbr.ll"},
+
+		// scalable vectors.
+		{path: "testdata/scalable_vector.ll"},
+
 		// LLVM IR compatibility.
 		{path: "../testdata/llvm/test/Bitcode/compatibility.ll"},
 
@@ -553,3 +563,49 @@ func TestParseFile(t *testing.T) {
 		}
 	}
 }
+
+func TestParseStringInvalid(t *testing.T) {
+	golden := []struct {
+		content string
+	}{
+		// Non-constant structure index.
+		{content: "define void @f(i32 %x) {\n\t%a = getelementptr { i32 }, { i32 }* null, i64 0, i32 %x\n\tret void\n}\n"},
+		// Out of bounds structure index.
+		{content: "@g = global i32* getelementptr ({ i32 }, { i32 }* null, i64 0, i32 4)\n"},
+		// Indexing into non-aggregate type.
+		{content: "define void @f() {\n\t%a = getelementptr i32, i32* null, i64 0, i32 0\n\tret void\n}\n"},
+		// extractvalue from non-aggregate type.
+		{content: "define void @f() {\n\t%a = extractvalue i32 1, 0\n\tret void\n}\n"},
+		{content: "@g = global i32 extractvalue (i32 1, 0)\n"},
+		// insertvalue element type mismatch.
+		{content: "define void @f() {\n\t%a = insertvalue { i32 } zeroinitializer, i64 1, 0\n\tret void\n}\n"},
+		// extractelement from non-vector type.
+		{content: "define void @f() {\n\t%a = extractelement i32 1, i32 0\n\tret void\n}\n"},
+		{content: "@g = global i32 extractelement (i32 1, i32 0)\n"},
+		// icmp and fcmp of invalid operand types.
+		{content: "define void @f() {\n\t%a = icmp eq float 1.0, 1.0\n\tret void\n}\n"},
+		{content: "@g = global i1 fcmp oeq (i32 1, i32 1)\n"},
+		// Redefinition of local variable.
+		{content: "define i32 @f(i32 %x) {\n\t%y = add i32 %x, 1\n\t%y = add i32 %x, 2\n\tret i32 %y\n}\n"},
+		// Redefinition of function parameter.
+		{content: "define i32 @f(i32 %x) {\n\t%x = add i32 %x, 1\n\tret i32 %x\n}\n"},
+		// Syntax error.
+		{content: "define void @f( {\n"},
+	}
+	for _, g := range golden {
+		func() {
+			defer func() {
+				if e := recover(); e != nil {
+					t.Errorf("unexpected panic when parsing %q; %v", g.content, e)
+				}
+			}()
+			_, err := ParseString("", g.content)
+			switch {
+			case err == nil:
+				t.Errorf("expected error when parsing %q, got nil", g.content)
+			case strings.Contains(err.Error(), "panic:"):
+				t.Errorf("unexpected recovered panic when parsing %q; %v", g.content, err)
+			}
+		}()
+	}
+}
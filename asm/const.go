@@ -3,8 +3,8 @@ package asm
 import (
 	"fmt"
 
-	"github.com/llir/ll/ast"
 	"github.com/umaumax/llvm/internal/enc"
+	"github.com/umaumax/llvm/internal/ll/ast"
 	"github.com/umaumax/llvm/ir"
 	"github.com/umaumax/llvm/ir/constant"
 	"github.com/umaumax/llvm/ir/types"
@@ -204,6 +204,9 @@ func (gen *generator) irVectorConst(t types.Type, old *ast.VectorConst) (*consta
 	if !ok {
 		return nil, errors.Errorf("invalid type of vector constant; expected *types.VectorType, got %T", t)
 	}
+	if typ.Scalable {
+		return nil, errors.Errorf("invalid type of vector constant; expected fixed-length vector type, got scalable vector type %q", typ)
+	}
 	oldElems := old.Elems()
 	if len(oldElems) == 0 {
 		return nil, errors.New("zero element vector is illegal")
@@ -229,7 +232,7 @@ func (gen *generator) irBlockAddressConst(t types.Type, old *ast.BlockAddressCon
 	funcName := globalIdent(old.Func())
 	v, ok := gen.new.globals[funcName]
 	if !ok {
-		return nil, errors.Errorf("unable to locate global identifier %q", funcName)
+		return nil, errors.Errorf("unable to locate global identifier %q", funcName.Ident())
 	}
 	f, ok := v.(*ir.Func)
 	if !ok {
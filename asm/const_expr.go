@@ -2,9 +2,10 @@ package asm
 
 import (
 	"fmt"
+	"math/big"
 
-	"github.com/llir/ll/ast"
 	asmenum "github.com/umaumax/llvm/asm/enum"
+	"github.com/umaumax/llvm/internal/ll/ast"
 	"github.com/umaumax/llvm/ir/constant"
 	"github.com/umaumax/llvm/ir/types"
 	"github.com/pkg/errors"
@@ -563,6 +564,9 @@ func (gen *generator) irExtractElementExpr(t types.Type, old *ast.ExtractElement
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
+	if _, err := vectorType(x.Type()); err != nil {
+		return nil, errors.WithStack(err)
+	}
 	expr := constant.NewExtractElement(x, index)
 	if !t.Equal(expr.Typ) {
 		return nil, errors.Errorf("constant expression type mismatch; expected %q, got %q", expr.Typ, t)
@@ -590,6 +594,9 @@ func (gen *generator) irInsertElementExpr(t types.Type, old *ast.InsertElementEx
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
+	if _, err := vectorType(x.Type()); err != nil {
+		return nil, errors.WithStack(err)
+	}
 	expr := constant.NewInsertElement(x, elem, index)
 	if !t.Equal(expr.Typ) {
 		return nil, errors.Errorf("constant expression type mismatch; expected %q, got %q", expr.Typ, t)
@@ -617,6 +624,12 @@ func (gen *generator) irShuffleVectorExpr(t types.Type, old *ast.ShuffleVectorEx
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
+	if _, err := vectorType(x.Type()); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if _, err := vectorType(mask.Type()); err != nil {
+		return nil, errors.WithStack(err)
+	}
 	expr := constant.NewShuffleVector(x, y, mask)
 	if !t.Equal(expr.Typ) {
 		return nil, errors.Errorf("constant expression type mismatch; expected %q, got %q", expr.Typ, t)
@@ -638,6 +651,9 @@ func (gen *generator) irExtractValueExpr(t types.Type, old *ast.ExtractValueExpr
 	}
 	// Element indices.
 	indices := uintSlice(old.Indices())
+	if _, err := aggregateElemType(x.Type(), indices); err != nil {
+		return nil, errors.WithStack(err)
+	}
 	expr := constant.NewExtractValue(x, indices...)
 	if !t.Equal(expr.Typ) {
 		return nil, errors.Errorf("constant expression type mismatch; expected %q, got %q", expr.Typ, t)
@@ -662,6 +678,9 @@ func (gen *generator) irInsertValueExpr(t types.Type, old *ast.InsertValueExpr)
 	}
 	// Element indices.
 	indices := uintSlice(old.Indices())
+	if err := checkInsertValueElem(x.Type(), elem.Type(), indices); err != nil {
+		return nil, errors.WithStack(err)
+	}
 	expr := constant.NewInsertValue(x, elem, indices...)
 	if !t.Equal(expr.Typ) {
 		return nil, errors.Errorf("constant expression type mismatch; expected %q, got %q", expr.Typ, t)
@@ -698,11 +717,14 @@ func (gen *generator) irGetElementPtrExpr(t types.Type, old *ast.GetElementPtrEx
 			indices[i] = index
 		}
 	}
-	expr := constant.NewGetElementPtr(src, indices...)
+	if err := checkGEPExprIndices(elemType, indices); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	expr := constant.NewGetElementPtr(elemType, src, indices...)
 	// (optional) In-bounds.
 	_, expr.InBounds = old.InBounds()
-	if !elemType.Equal(expr.ElemType) {
-		return nil, errors.Errorf("constant expression element type mismatch; expected %q, got %q", expr.ElemType, elemType)
+	if srcType, ok := src.Type().(*types.PointerType); ok && !srcType.IsOpaque() && !elemType.Equal(srcType.ElemType) {
+		return nil, errors.Errorf("constant expression element type mismatch; expected %q, got %q", srcType.ElemType, elemType)
 	}
 	if !t.Equal(expr.Typ) {
 		return nil, errors.Errorf("constant expression type mismatch; expected %q, got %q", expr.Typ, t)
@@ -724,6 +746,63 @@ func (gen *generator) irGEPIndex(old ast.GEPIndex) (*constant.Index, error) {
 	return index, nil
 }
 
+// checkGEPExprIndices reports an error if the given indices of a getelementptr
+// constant expression do not index into the element type (e.g. non-constant or
+// out of bounds structure index).
+func checkGEPExprIndices(elemType types.Type, indices []constant.Constant) error {
+	e := elemType
+	for i, index := range indices {
+		if i == 0 {
+			// Ignore checking the 0th index as it simply follows the pointer of
+			// src.
+			continue
+		}
+		if idx, ok := index.(*constant.Index); ok {
+			index = idx.Constant
+		}
+		switch t := e.(type) {
+		case *types.PointerType:
+			// ref: http://llvm.org/docs/GetElementPtr.html#what-is-dereferenced-by-gep
+			return errors.Errorf("unable to index into element of pointer type `%v`; for more information, see http://llvm.org/docs/GetElementPtr.html#what-is-dereferenced-by-gep", elemType)
+		case *types.VectorType:
+			e = t.ElemType
+		case *types.ArrayType:
+			e = t.ElemType
+		case *types.StructType:
+			var x *big.Int
+			switch index := index.(type) {
+			case *constant.Int:
+				x = index.X
+			case *constant.Vector:
+				// All vector elements must be integers, and must have the same
+				// value.
+				for j, elem := range index.Elems {
+					idx, ok := elem.(*constant.Int)
+					if !ok {
+						return errors.Errorf("invalid index type for structure element; expected *constant.Int, got %T", elem)
+					}
+					if j == 0 {
+						x = idx.X
+					} else if x.Cmp(idx.X) != 0 {
+						return errors.Errorf("struct index mismatch; vector elements %d and %d differ", x, idx.X)
+					}
+				}
+			case *constant.ZeroInitializer:
+				x = new(big.Int)
+			default:
+				return errors.Errorf("invalid index type for structure element; expected *constant.Int, *constant.Vector or *constant.ZeroInitializer, got %T", index)
+			}
+			if x == nil || !x.IsInt64() || x.Int64() < 0 || x.Int64() >= int64(len(t.Fields)) {
+				return errors.Errorf("structure index %v out of bounds of type `%s`", x, t)
+			}
+			e = t.Fields[x.Int64()]
+		default:
+			return errors.Errorf("unable to index into element of non-aggregate type `%s`", e)
+		}
+	}
+	return nil
+}
+
 // --- [ Conversion expressions ] ----------------------------------------------
 
 // ~~~ [ trunc ] ~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~
@@ -1031,6 +1110,9 @@ func (gen *generator) irICmpExpr(t types.Type, old *ast.ICmpExpr) (*constant.Exp
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
+	if _, err := icmpType(x.Type()); err != nil {
+		return nil, errors.WithStack(err)
+	}
 	expr := constant.NewICmp(pred, x, y)
 	if !t.Equal(expr.Typ) {
 		return nil, errors.Errorf("constant expression type mismatch; expected %q, got %q", expr.Typ, t)
@@ -1055,6 +1137,9 @@ func (gen *generator) irFCmpExpr(t types.Type, old *ast.FCmpExpr) (*constant.Exp
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
+	if _, err := fcmpType(x.Type()); err != nil {
+		return nil, errors.WithStack(err)
+	}
 	expr := constant.NewFCmp(pred, x, y)
 	if !t.Equal(expr.Typ) {
 		return nil, errors.Errorf("constant expression type mismatch; expected %q, got %q", expr.Typ, t)
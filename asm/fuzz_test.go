@@ -0,0 +1,56 @@
+// +build go1.18
+
+package asm
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/umaumax/llvm/ir"
+	"github.com/umaumax/llvm/stress"
+)
+
+// addSeedCorpus adds the LLVM IR assembly files of the asm test data and
+// randomly generated LLVM IR modules to the seed corpus of the fuzz target.
+func addSeedCorpus(f *testing.F) {
+	paths, err := filepath.Glob("testdata/*.ll")
+	if err != nil {
+		f.Fatalf("unable to locate test data; %+v", err)
+	}
+	for _, path := range paths {
+		buf, err := ioutil.ReadFile(path)
+		if err != nil {
+			f.Fatalf("unable to read %q; %+v", path, err)
+		}
+		f.Add(string(buf))
+	}
+	for seed := int64(0); seed < 16; seed++ {
+		f.Add(stress.Generate(seed, stress.DefaultConfig).String())
+	}
+}
+
+// FuzzParseString asserts that ParseString never panics on arbitrary input,
+// and that parsed modules are stable across a round-trip (parse -> print ->
+// parse); both structurally and in their LLVM IR assembly.
+func FuzzParseString(f *testing.F) {
+	addSeedCorpus(f)
+	f.Fuzz(func(t *testing.T, content string) {
+		m, err := ParseString("", content)
+		if err != nil {
+			return
+		}
+		want := m.String()
+		m2, err := ParseString("", want)
+		if err != nil {
+			t.Fatalf("unable to parse printed module; %v\n%s", err, want)
+		}
+		if !ir.EqualModule(m, m2) {
+			t.Fatalf("module mismatch after round-trip; modules not structurally equal\n%s", want)
+		}
+		got := m2.String()
+		if want != got {
+			t.Fatalf("module mismatch after round-trip; expected `%s`, got `%s`", want, got)
+		}
+	})
+}
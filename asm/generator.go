@@ -1,7 +1,7 @@
 package asm
 
 import (
-	"github.com/llir/ll/ast"
+	"github.com/umaumax/llvm/internal/ll/ast"
 	"github.com/umaumax/llvm/ir"
 	"github.com/umaumax/llvm/ir/constant"
 	"github.com/umaumax/llvm/ir/metadata"
@@ -3,9 +3,9 @@ package asm
 import (
 	"fmt"
 
-	"github.com/llir/ll/ast"
 	asmenum "github.com/umaumax/llvm/asm/enum"
 	"github.com/umaumax/llvm/internal/enc"
+	"github.com/umaumax/llvm/internal/ll/ast"
 	"github.com/umaumax/llvm/ir"
 	"github.com/umaumax/llvm/ir/constant"
 	"github.com/umaumax/llvm/ir/types"
@@ -65,11 +65,12 @@ func (gen *generator) newGlobal(ident ir.GlobalIdent, oldContentType ast.Type, o
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	typ := types.NewPointer(contentType)
 	// (optional) Address space.
+	var addrSpace types.AddrSpace
 	if oldAddrSpace.IsValid() {
-		typ.AddrSpace = irAddrSpace(oldAddrSpace)
+		addrSpace = irAddrSpace(oldAddrSpace)
 	}
+	typ := gen.m.TypeContext.Pointer(contentType, addrSpace)
 	return &ir.Global{GlobalIdent: ident, ContentType: contentType, Typ: typ}, nil
 }
 
@@ -81,14 +82,22 @@ func (gen *generator) newIndirectSymbol(ident ir.GlobalIdent, old *ast.IndirectS
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	typ := types.NewPointer(contentType)
+	typ := gen.m.TypeContext.Pointer(contentType, 0)
 	// Indirect symbol kind.
 	kind := old.IndirectSymbolKind().Text()
 	switch kind {
 	case "alias":
-		return &ir.Alias{GlobalIdent: ident, Typ: typ}, nil
+		alias := &ir.Alias{GlobalIdent: ident, Typ: typ}
+		if typ.IsOpaque() {
+			alias.ContentType = contentType
+		}
+		return alias, nil
 	case "ifunc":
-		return &ir.IFunc{GlobalIdent: ident, Typ: typ}, nil
+		ifunc := &ir.IFunc{GlobalIdent: ident, Typ: typ}
+		if typ.IsOpaque() {
+			ifunc.ContentType = contentType
+		}
+		return ifunc, nil
 	default:
 		panic(fmt.Errorf("support for indirect symbol kind %q not yet implemented", kind))
 	}
@@ -102,11 +111,12 @@ func (gen *generator) newFunc(ident ir.GlobalIdent, hdr ast.FuncHeader) (*ir.Fun
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	typ := types.NewPointer(sig)
 	// (optional) Address space.
+	var addrSpace types.AddrSpace
 	if n, ok := hdr.AddrSpace(); ok {
-		typ.AddrSpace = irAddrSpace(n)
+		addrSpace = irAddrSpace(n)
 	}
+	typ := gen.m.TypeContext.Pointer(sig, addrSpace)
 	return &ir.Func{GlobalIdent: ident, Sig: sig, Typ: typ, Parent: gen.m}, nil
 }
 
@@ -136,7 +146,7 @@ func (gen *generator) irSigFromHeader(old ast.FuncHeader) (*types.FuncType, erro
 	}
 	// Variadic.
 	_, sig.Variadic = ps.Variadic()
-	return sig, nil
+	return gen.m.TypeContext.Intern(sig).(*types.FuncType), nil
 }
 
 // === [ Translate AST to IR ] =================================================
@@ -250,31 +260,34 @@ func (gen *generator) irGlobal(new *ir.Global, old *ast.GlobalDecl) error {
 		}
 		new.Init = init
 	}
-	// (optional) Section name.
-	if n, ok := old.Section(); ok {
-		new.Section = stringLit(n.Name())
-	}
-	// (optional) Partition name.
-	if n, ok := old.Partition(); ok {
-		new.Partition = stringLit(n.Name())
-	}
-	// (optional) Comdat.
-	if n, ok := old.Comdat(); ok {
-		// When comdat name is omitted, the global name is used as an implicit
-		// comdat name.
-		name := new.Name()
-		if n, ok := n.Name(); ok {
-			name = comdatName(n)
-		}
-		def, ok := gen.new.comdatDefs[name]
-		if !ok {
-			return errors.Errorf("unable to locate comdat identifier %q used in global declaration of %q", enc.Comdat(name), new.Ident())
+	// (optional) Global fields.
+	for _, field := range old.GlobalFields() {
+		switch field := field.(type) {
+		// Section name.
+		case *ast.Section:
+			new.Section = stringLit(field.Name())
+		// Partition name.
+		case *ast.Partition:
+			new.Partition = stringLit(field.Name())
+		// Comdat.
+		case *ast.Comdat:
+			// When comdat name is omitted, the global name is used as an implicit
+			// comdat name.
+			name := new.Name()
+			if n, ok := field.Name(); ok {
+				name = comdatName(n)
+			}
+			def, ok := gen.new.comdatDefs[name]
+			if !ok {
+				return errors.Errorf("unable to locate comdat identifier %q used in global declaration of %q", enc.Comdat(name), new.Ident())
+			}
+			new.Comdat = def
+		// Alignment.
+		case *ast.Align:
+			new.Align = irAlign(*field)
+		default:
+			panic(fmt.Errorf("support for global field %T not yet implemented", field))
 		}
-		new.Comdat = def
-	}
-	// (optional) Alignment.
-	if n, ok := old.Align(); ok {
-		new.Align = irAlign(n)
 	}
 	// (optional) Metadata.
 	md, err := gen.irMetadataAttachments(old.Metadata())
@@ -507,64 +520,61 @@ func (gen *generator) irFuncHeader(new *ir.Func, old ast.FuncHeader) error {
 		new.UnnamedAddr = asmenum.UnnamedAddrFromString(n.Text())
 	}
 	// (optional) Address space: handled in newGlobalEntity.
-	// (optional) Function attributes.
-	if oldFuncAttrs := old.FuncAttrs(); len(oldFuncAttrs) > 0 {
-		new.FuncAttrs = make([]ir.FuncAttribute, len(oldFuncAttrs))
-		for i, oldFuncAttr := range oldFuncAttrs {
-			funcAttr := gen.irFuncAttribute(oldFuncAttr)
-			new.FuncAttrs[i] = funcAttr
-		}
-	}
-
-	// (optional) Section name.
-	if n, ok := old.Section(); ok {
-		new.Section = stringLit(n.Name())
-	}
-	// (optional) Partition name.
-	if n, ok := old.Partition(); ok {
-		new.Partition = stringLit(n.Name())
-	}
-	// (optional) Comdat.
-	if n, ok := old.Comdat(); ok {
-		// When comdat name is omitted, the function name is used as an implicit
-		// comdat name.
-		name := new.Name()
-		if n, ok := n.Name(); ok {
-			name = comdatName(n)
-		}
-		def, ok := gen.new.comdatDefs[name]
-		if !ok {
-			return errors.Errorf("unable to locate comdat identifier %q used in function header of %q", enc.Comdat(name), new.Ident())
-		}
-		new.Comdat = def
-	}
-	// (optional) Garbage collection.
-	if n, ok := old.GCNode(); ok {
-		new.GC = stringLit(n.Name())
-	}
-	// (optional) Prefix.
-	if n, ok := old.Prefix(); ok {
-		prefix, err := gen.irTypeConst(n.TypeConst())
-		if err != nil {
-			return errors.WithStack(err)
-		}
-		new.Prefix = prefix
-	}
-	// (optional) Prologue.
-	if n, ok := old.Prologue(); ok {
-		prologue, err := gen.irTypeConst(n.TypeConst())
-		if err != nil {
-			return errors.WithStack(err)
-		}
-		new.Prologue = prologue
-	}
-	// (optional) Personality.
-	if n, ok := old.Personality(); ok {
-		personality, err := gen.irTypeConst(n.TypeConst())
-		if err != nil {
-			return errors.WithStack(err)
+	// (optional) Function header fields.
+	for _, field := range old.FuncHdrFields() {
+		switch field := field.(type) {
+		// Section name.
+		case *ast.Section:
+			new.Section = stringLit(field.Name())
+		// Partition name.
+		case *ast.Partition:
+			new.Partition = stringLit(field.Name())
+		// Comdat.
+		case *ast.Comdat:
+			// When comdat name is omitted, the function name is used as an
+			// implicit comdat name.
+			name := new.Name()
+			if n, ok := field.Name(); ok {
+				name = comdatName(n)
+			}
+			def, ok := gen.new.comdatDefs[name]
+			if !ok {
+				return errors.Errorf("unable to locate comdat identifier %q used in function header of %q", enc.Comdat(name), new.Ident())
+			}
+			new.Comdat = def
+		// Alignment.
+		case *ast.Align:
+			new.FuncAttrs = append(new.FuncAttrs, ir.Align(uintLit(field.N())))
+		// Garbage collection.
+		case *ast.GCNode:
+			new.GC = stringLit(field.Name())
+		// Prefix.
+		case *ast.Prefix:
+			prefix, err := gen.irTypeConst(field.TypeConst())
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			new.Prefix = prefix
+		// Prologue.
+		case *ast.Prologue:
+			prologue, err := gen.irTypeConst(field.TypeConst())
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			new.Prologue = prologue
+		// Personality.
+		case *ast.Personality:
+			personality, err := gen.irTypeConst(field.TypeConst())
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			new.Personality = personality
+		// Function attributes.
+		case ast.FuncAttribute:
+			new.FuncAttrs = append(new.FuncAttrs, gen.irFuncAttribute(field))
+		default:
+			panic(fmt.Errorf("support for function header field %T not yet implemented", field))
 		}
-		new.Personality = personality
 	}
 	return nil
 }
@@ -5,9 +5,9 @@ import (
 	"strconv"
 	"strings"
 
-	"github.com/llir/ll/ast"
 	asmenum "github.com/umaumax/llvm/asm/enum"
 	"github.com/umaumax/llvm/internal/enc"
+	"github.com/umaumax/llvm/internal/ll/ast"
 	"github.com/umaumax/llvm/ir"
 	"github.com/umaumax/llvm/ir/constant"
 	"github.com/umaumax/llvm/ir/enum"
@@ -327,8 +327,8 @@ func (fgen *funcGen) irExceptionArg(old ast.ExceptionArg) (value.Value, error) {
 }
 
 // irExceptionScope returns the IR exception scope corresponding to the given
-// AST exception scope.
-func (fgen *funcGen) irExceptionScope(old ast.ExceptionScope) (ir.ExceptionScope, error) {
+// AST exception pad.
+func (fgen *funcGen) irExceptionScope(old ast.ExceptionPad) (ir.ExceptionScope, error) {
 	switch old := old.(type) {
 	case *ast.NoneConst:
 		return constant.None, nil
@@ -582,8 +582,6 @@ func irReturnAttribute(old ast.ReturnAttribute) ir.ReturnAttribute {
 	//		Key:   unquote(old.Key().Text()),
 	//		Value: unquote(old.Val().Text()),
 	//	}
-	case *ast.Align:
-		return ir.Align(uintLit(old.N()))
 	case *ast.Dereferenceable:
 		return ir.Dereferenceable{N: uintLit(old.N())}
 	case *ast.DereferenceableOrNull:
@@ -592,6 +590,9 @@ func irReturnAttribute(old ast.ReturnAttribute) ir.ReturnAttribute {
 			DerefOrNull: true,
 		}
 	case *ast.ReturnAttr:
+		if n, ok := old.Align(); ok {
+			return irAlign(n)
+		}
 		return asmenum.ReturnAttrFromString(old.Text())
 	default:
 		panic(fmt.Errorf("support for return attribute %T not yet implemented", old))
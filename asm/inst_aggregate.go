@@ -3,7 +3,7 @@ package asm
 import (
 	"fmt"
 
-	"github.com/llir/ll/ast"
+	"github.com/umaumax/llvm/internal/ll/ast"
 	"github.com/umaumax/llvm/ir"
 	"github.com/umaumax/llvm/ir/types"
 	"github.com/pkg/errors"
@@ -19,7 +19,10 @@ func (fgen *funcGen) newExtractValueInst(ident ir.LocalIdent, old *ast.ExtractVa
 		return nil, errors.WithStack(err)
 	}
 	indices := uintSlice(old.Indices())
-	typ := aggregateElemType(xType, indices)
+	typ, err := aggregateElemType(xType, indices)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
 	return &ir.InstExtractValue{LocalIdent: ident, Typ: typ}, nil
 }
 
@@ -30,6 +33,10 @@ func (fgen *funcGen) newInsertValueInst(ident ir.LocalIdent, old *ast.InsertValu
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
+	// Validate element indices.
+	if _, err := aggregateElemType(typ, uintSlice(old.Indices())); err != nil {
+		return nil, errors.WithStack(err)
+	}
 	return &ir.InstInsertValue{LocalIdent: ident, Typ: typ}, nil
 }
 
@@ -84,6 +91,9 @@ func (fgen *funcGen) irInsertValueInst(new ir.Instruction, old *ast.InsertValueI
 	inst.Elem = elem
 	// Element indices.
 	inst.Indices = uintSlice(old.Indices())
+	if err := checkInsertValueElem(x.Type(), elem.Type(), inst.Indices); err != nil {
+		return errors.WithStack(err)
+	}
 	// (optional) Metadata.
 	md, err := fgen.gen.irMetadataAttachments(old.Metadata())
 	if err != nil {
@@ -97,17 +107,37 @@ func (fgen *funcGen) irInsertValueInst(new ir.Instruction, old *ast.InsertValueI
 
 // aggregateElemType returns the element type at the position in the aggregate
 // type specified by the given indices.
-func aggregateElemType(t types.Type, indices []uint64) types.Type {
+func aggregateElemType(t types.Type, indices []uint64) (types.Type, error) {
 	// Base case.
 	if len(indices) == 0 {
-		return t
+		return t, nil
 	}
 	switch t := t.(type) {
 	case *types.ArrayType:
+		if indices[0] >= t.Len {
+			return nil, errors.Errorf("array index %d out of bounds of type `%s`", indices[0], t)
+		}
 		return aggregateElemType(t.ElemType, indices[1:])
 	case *types.StructType:
+		if indices[0] >= uint64(len(t.Fields)) {
+			return nil, errors.Errorf("structure index %d out of bounds of type `%s`", indices[0], t)
+		}
 		return aggregateElemType(t.Fields[indices[0]], indices[1:])
 	default:
-		panic(fmt.Errorf("support for aggregate type %T not yet implemented", t))
+		return nil, errors.Errorf("invalid aggregate type; expected *types.ArrayType or *types.StructType, got %T", t)
 	}
 }
+
+// checkInsertValueElem reports an error if the type of the element inserted by
+// an insertvalue instruction or constant expression does not match the element
+// type at the position in the aggregate type specified by the given indices.
+func checkInsertValueElem(aggType, elemType types.Type, indices []uint64) error {
+	want, err := aggregateElemType(aggType, indices)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if !elemType.Equal(want) {
+		return errors.Errorf("insertvalue element type mismatch; expected `%s`, got `%s`", want, elemType)
+	}
+	return nil
+}
@@ -4,8 +4,8 @@ import (
 	"fmt"
 	"strconv"
 
-	"github.com/llir/ll/ast"
 	asmenum "github.com/umaumax/llvm/asm/enum"
+	"github.com/umaumax/llvm/internal/ll/ast"
 	"github.com/umaumax/llvm/ir"
 	"github.com/umaumax/llvm/ir/types"
 	"github.com/umaumax/llvm/ir/value"
@@ -21,10 +21,14 @@ func (fgen *funcGen) newAllocaInst(ident ir.LocalIdent, old *ast.AllocaInst) (*i
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	inst := &ir.InstAlloca{LocalIdent: ident, ElemType: elemType}
-	// Cache inst.Typ.
-	inst.Type()
-	return inst, nil
+	// (optional) Address space.
+	var addrSpace types.AddrSpace
+	if n, ok := old.AddrSpace(); ok {
+		addrSpace = irAddrSpace(n)
+	}
+	// The result is of opaque pointer type if the module uses opaque pointers.
+	typ := fgen.gen.m.TypeContext.Pointer(elemType, addrSpace)
+	return &ir.InstAlloca{LocalIdent: ident, ElemType: elemType, Typ: typ}, nil
 }
 
 // newLoadInst returns a new IR load instruction (without body but with type)
@@ -51,15 +55,13 @@ func (fgen *funcGen) newCmpXchgInst(ident ir.LocalIdent, old *ast.CmpXchgInst) (
 // newAtomicRMWInst returns a new IR atomicrmw instruction (without body but
 // with type) based on the given AST atomicrmw instruction.
 func (fgen *funcGen) newAtomicRMWInst(ident ir.LocalIdent, old *ast.AtomicRMWInst) (*ir.InstAtomicRMW, error) {
-	dstType, err := fgen.gen.irType(old.Dst().Typ())
+	// The result type is the type of the operand, which is also known for
+	// destination addresses of opaque pointer type.
+	typ, err := fgen.gen.irType(old.X().Typ())
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	dt, ok := dstType.(*types.PointerType)
-	if !ok {
-		panic(fmt.Errorf("invalid pointer type; expected *types.PointerType, got %T", dstType))
-	}
-	return &ir.InstAtomicRMW{LocalIdent: ident, Typ: dt.ElemType}, nil
+	return &ir.InstAtomicRMW{LocalIdent: ident, Typ: typ}, nil
 }
 
 // newGetElementPtrInst returns a new IR getelementptr instruction (without body
@@ -70,7 +72,11 @@ func (fgen *funcGen) newGetElementPtrInst(ident ir.LocalIdent, old *ast.GetEleme
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	typ, err := fgen.gen.gepType(elemType, old.Indices())
+	srcType, err := fgen.gen.irType(old.Src().Typ())
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	typ, err := fgen.gen.gepType(srcType, elemType, old.Indices())
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
@@ -103,17 +109,14 @@ func (fgen *funcGen) irAllocaInst(new ir.Instruction, old *ast.AllocaInst) error
 		inst.NElems = nelems
 	}
 	// (optional) In-alloca.
-	_, inst.InAlloca = old.InAlloca()
+	_, inst.InAlloca = old.InAllocatok()
 	// (optional) Swift error.
 	_, inst.SwiftError = old.SwiftError()
 	// (optional) Alignment.
 	if n, ok := old.Align(); ok {
 		inst.Align = irAlign(n)
 	}
-	// (optional) Address space; stored in i.Typ.
-	if n, ok := old.AddrSpace(); ok {
-		inst.Typ.AddrSpace = irAddrSpace(n)
-	}
+	// (optional) Address space; stored in inst.Typ by newAllocaInst.
 	// (optional) Metadata.
 	md, err := fgen.gen.irMetadataAttachments(old.Metadata())
 	if err != nil {
@@ -363,8 +366,9 @@ func (fgen *funcGen) irGetElementPtrInst(new ir.Instruction, old *ast.GetElement
 
 // gepType returns the pointer type or vector of pointers type to the element at
 // the position in the type specified by the given indices, as calculated by the
-// getelementptr instruction.
-func (gen *generator) gepType(elemType types.Type, indices []ast.TypeValue) (types.Type, error) {
+// getelementptr instruction. The result is of opaque pointer type if the source
+// type is.
+func (gen *generator) gepType(srcType, elemType types.Type, indices []ast.TypeValue) (types.Type, error) {
 	e := elemType
 	for i, index := range indices {
 		if i == 0 {
@@ -377,54 +381,51 @@ func (gen *generator) gepType(elemType types.Type, indices []ast.TypeValue) (typ
 		switch t := e.(type) {
 		case *types.PointerType:
 			// ref: http://llvm.org/docs/GetElementPtr.html#what-is-dereferenced-by-gep
-			panic(fmt.Errorf("unable to index into element of pointer type `%v`; for more information, see http://llvm.org/docs/GetElementPtr.html#what-is-dereferenced-by-gep", elemType))
+			return nil, errors.Errorf("unable to index into element of pointer type `%v`; for more information, see http://llvm.org/docs/GetElementPtr.html#what-is-dereferenced-by-gep", elemType)
 		case *types.VectorType:
 			e = t.ElemType
 		case *types.ArrayType:
 			e = t.ElemType
 		case *types.StructType:
+			var i int64
 			switch index := index.Val().(type) {
 			case *ast.IntConst:
-				i, err := strconv.ParseInt(index.Text(), 10, 64)
+				x, err := strconv.ParseInt(index.Text(), 10, 64)
 				if err != nil {
-					panic(fmt.Errorf("unable to parse integer %q; %v", index.Text(), err))
+					return nil, errors.Errorf("unable to parse integer %q; %v", index.Text(), err)
 				}
-				e = t.Fields[i]
+				i = x
 			case *ast.VectorConst:
 				// TODO: Validate how index vectors in gep are supposed to work.
-				elems := index.Elems()
-				elem := elems[0].Val()
-				idx, ok := elem.(*ast.IntConst)
-				if !ok {
-					panic(fmt.Errorf("invalid index type for structure element; expected *ast.IntConst, got %T", elem))
-				}
-				i, err := strconv.ParseInt(idx.Text(), 10, 64)
-				if err != nil {
-					panic(fmt.Errorf("unable to parse integer %q; %v", idx.Text(), err))
-				}
+				//
 				// Sanity check. All vector elements must be integers, and must have
 				// the same value.
-				for _, elem := range elems {
+				for j, elem := range index.Elems() {
 					idx, ok := elem.Val().(*ast.IntConst)
 					if !ok {
-						panic(fmt.Errorf("invalid index type for structure element; expected *ast.IntConst, got %T", elem.Val()))
+						return nil, errors.Errorf("invalid index type for structure element; expected *ast.IntConst, got %T", elem.Val())
 					}
-					j, err := strconv.ParseInt(idx.Text(), 10, 64)
+					x, err := strconv.ParseInt(idx.Text(), 10, 64)
 					if err != nil {
-						panic(fmt.Errorf("unable to parse integer %q; %v", idx.Text(), err))
+						return nil, errors.Errorf("unable to parse integer %q; %v", idx.Text(), err)
 					}
-					if i != j {
-						return nil, errors.Errorf("struct index mismatch; vector elements %d and %d differ", i, j)
+					if j == 0 {
+						i = x
+					} else if i != x {
+						return nil, errors.Errorf("struct index mismatch; vector elements %d and %d differ", i, x)
 					}
 				}
-				e = t.Fields[i]
 			case *ast.ZeroInitializerConst:
-				e = t.Fields[0]
+				i = 0
 			default:
-				panic(fmt.Errorf("invalid index type for structure element; expected *ast.IntConst, *ast.VectorConst or *ast.ZeroInitializerConst, got %T", index))
+				return nil, errors.Errorf("invalid index type for structure element; expected *ast.IntConst, *ast.VectorConst or *ast.ZeroInitializerConst, got %T", index)
 			}
+			if i < 0 || i >= int64(len(t.Fields)) {
+				return nil, errors.Errorf("structure index %d out of bounds of type `%s`", i, t)
+			}
+			e = t.Fields[i]
 		default:
-			panic(fmt.Errorf("support for indexing element type %T not yet implemented", e))
+			return nil, errors.Errorf("unable to index into element of non-aggregate type `%s`", e)
 		}
 	}
 	// TODO: Validate how index vectors in gep are supposed to work.
@@ -433,14 +434,25 @@ func (gen *generator) gepType(elemType types.Type, indices []ast.TypeValue) (typ
 	//    %113 = getelementptr inbounds %struct.fileinfo, %struct.fileinfo* %96, <2 x i64> %110, !dbg !4736
 	//    %116 = bitcast i8** %115 to <2 x %struct.fileinfo*>*, !dbg !4738
 	//    store <2 x %struct.fileinfo*> %113, <2 x %struct.fileinfo*>* %116, align 8, !dbg !4738, !tbaa !1793
+	ptr := types.NewPointer(e)
+	srcPtr := srcType
+	if t, ok := srcType.(*types.VectorType); ok {
+		srcPtr = t.ElemType
+	}
+	if t, ok := srcPtr.(*types.PointerType); ok && t.IsOpaque() {
+		ptr = types.NewOpaquePointer(t.AddrSpace)
+	}
 	if len(indices) > 0 {
 		t, err := gen.irType(indices[0].Typ())
 		if err != nil {
 			return nil, errors.WithStack(err)
 		}
 		if t, ok := t.(*types.VectorType); ok {
-			return types.NewVector(t.Len, types.NewPointer(e)), nil
+			return &types.VectorType{Scalable: t.Scalable, Len: t.Len, ElemType: ptr}, nil
 		}
 	}
-	return types.NewPointer(e), nil
+	if t, ok := srcType.(*types.VectorType); ok {
+		return &types.VectorType{Scalable: t.Scalable, Len: t.Len, ElemType: ptr}, nil
+	}
+	return ptr, nil
 }
@@ -3,8 +3,8 @@ package asm
 import (
 	"fmt"
 
-	"github.com/llir/ll/ast"
 	asmenum "github.com/umaumax/llvm/asm/enum"
+	"github.com/umaumax/llvm/internal/ll/ast"
 	"github.com/umaumax/llvm/ir"
 	"github.com/umaumax/llvm/ir/types"
 	"github.com/umaumax/llvm/ir/value"
@@ -20,14 +20,9 @@ func (fgen *funcGen) newICmpInst(ident ir.LocalIdent, old *ast.ICmpInst) (*ir.In
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	var typ types.Type
-	switch xType := xType.(type) {
-	case *types.IntType, *types.PointerType:
-		typ = types.I1
-	case *types.VectorType:
-		typ = types.NewVector(xType.Len, types.I1)
-	default:
-		panic(fmt.Errorf("invalid icmp operand type; expected *types.IntType, *types.PointerType or *types.VectorType, got %T", xType))
+	typ, err := icmpType(xType)
+	if err != nil {
+		return nil, errors.WithStack(err)
 	}
 	return &ir.InstICmp{LocalIdent: ident, Typ: typ}, nil
 }
@@ -39,14 +34,9 @@ func (fgen *funcGen) newFCmpInst(ident ir.LocalIdent, old *ast.FCmpInst) (*ir.In
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	var typ types.Type
-	switch xType := xType.(type) {
-	case *types.FloatType:
-		typ = types.I1
-	case *types.VectorType:
-		typ = types.NewVector(xType.Len, types.I1)
-	default:
-		panic(fmt.Errorf("invalid fcmp operand type; expected *types.FloatType or *types.VectorType, got %T", xType))
+	typ, err := fcmpType(xType)
+	if err != nil {
+		return nil, errors.WithStack(err)
 	}
 	return &ir.InstFCmp{LocalIdent: ident, Typ: typ}, nil
 }
@@ -64,13 +54,23 @@ func (fgen *funcGen) newPhiInst(ident ir.LocalIdent, old *ast.PhiInst) (*ir.Inst
 // newSelectInst returns a new IR select instruction (without body but with
 // type) based on the given AST select instruction.
 func (fgen *funcGen) newSelectInst(ident ir.LocalIdent, old *ast.SelectInst) (*ir.InstSelect, error) {
-	typ, err := fgen.gen.irType(old.X().Typ())
+	typ, err := fgen.gen.irType(old.ValueTrue().Typ())
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
 	return &ir.InstSelect{LocalIdent: ident, Typ: typ}, nil
 }
 
+// newFreezeInst returns a new IR freeze instruction (without body but with
+// type) based on the given AST freeze instruction.
+func (fgen *funcGen) newFreezeInst(ident ir.LocalIdent, old *ast.FreezeInst) (*ir.InstFreeze, error) {
+	typ, err := fgen.gen.irType(old.X().Typ())
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &ir.InstFreeze{LocalIdent: ident, Typ: typ}, nil
+}
+
 // newCallInst returns a new IR call instruction (without body but with type)
 // based on the given AST call instruction.
 func (fgen *funcGen) newCallInst(ident ir.LocalIdent, old *ast.CallInst) (*ir.InstCall, error) {
@@ -221,13 +221,13 @@ func (fgen *funcGen) irSelectInst(new ir.Instruction, old *ast.SelectInst) error
 	}
 	inst.Cond = cond
 	// X operand.
-	x, err := fgen.irTypeValue(old.X())
+	x, err := fgen.irTypeValue(old.ValueTrue())
 	if err != nil {
 		return errors.WithStack(err)
 	}
 	inst.X = x
 	// Y operand.
-	y, err := fgen.irTypeValue(old.Y())
+	y, err := fgen.irTypeValue(old.ValueFalse())
 	if err != nil {
 		return errors.WithStack(err)
 	}
@@ -243,6 +243,30 @@ func (fgen *funcGen) irSelectInst(new ir.Instruction, old *ast.SelectInst) error
 	return nil
 }
 
+// --- [ freeze ] --------------------------------------------------------------
+
+// irFreezeInst translates the given AST freeze instruction into an equivalent
+// IR instruction.
+func (fgen *funcGen) irFreezeInst(new ir.Instruction, old *ast.FreezeInst) error {
+	inst, ok := new.(*ir.InstFreeze)
+	if !ok {
+		panic(fmt.Errorf("invalid IR instruction for AST instruction; expected *ir.InstFreeze, got %T", new))
+	}
+	// X operand.
+	x, err := fgen.irTypeValue(old.X())
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	inst.X = x
+	// (optional) Metadata.
+	md, err := fgen.gen.irMetadataAttachments(old.Metadata())
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	inst.Metadata = md
+	return nil
+}
+
 // --- [ call ] ----------------------------------------------------------------
 
 // irCallInst translates the given AST call instruction into an equivalent IR
@@ -413,7 +437,7 @@ func (fgen *funcGen) irCatchPadInst(new ir.Instruction, old *ast.CatchPadInst) e
 		panic(fmt.Errorf("invalid IR instruction for AST instruction; expected *ir.InstCatchPad, got %T", new))
 	}
 	// Exception scope.
-	ident := localIdent(old.Scope())
+	ident := localIdent(old.CatchSwitch())
 	v, ok := fgen.locals[ident]
 	if !ok {
 		return errors.Errorf("unable to locate local identifier %q", ident.Ident())
@@ -453,7 +477,7 @@ func (fgen *funcGen) irCleanupPadInst(new ir.Instruction, old *ast.CleanupPadIns
 		panic(fmt.Errorf("invalid IR instruction for AST instruction; expected *ir.InstCleanupPad, got %T", new))
 	}
 	// Exception scope.
-	scope, err := fgen.irExceptionScope(old.Scope())
+	scope, err := fgen.irExceptionScope(old.ParentPad())
 	if err != nil {
 		return errors.WithStack(err)
 	}
@@ -477,3 +501,31 @@ func (fgen *funcGen) irCleanupPadInst(new ir.Instruction, old *ast.CleanupPadIns
 	inst.Metadata = md
 	return nil
 }
+
+// ### [ Helper functions ] ####################################################
+
+// icmpType returns the result type of an icmp instruction or constant
+// expression based on the given operand type.
+func icmpType(xType types.Type) (types.Type, error) {
+	switch xType := xType.(type) {
+	case *types.IntType, *types.PointerType:
+		return types.I1, nil
+	case *types.VectorType:
+		return &types.VectorType{Scalable: xType.Scalable, Len: xType.Len, ElemType: types.I1}, nil
+	default:
+		return nil, errors.Errorf("invalid icmp operand type; expected *types.IntType, *types.PointerType or *types.VectorType, got %T", xType)
+	}
+}
+
+// fcmpType returns the result type of an fcmp instruction or constant
+// expression based on the given operand type.
+func fcmpType(xType types.Type) (types.Type, error) {
+	switch xType := xType.(type) {
+	case *types.FloatType:
+		return types.I1, nil
+	case *types.VectorType:
+		return &types.VectorType{Scalable: xType.Scalable, Len: xType.Len, ElemType: types.I1}, nil
+	default:
+		return nil, errors.Errorf("invalid fcmp operand type; expected *types.FloatType or *types.VectorType, got %T", xType)
+	}
+}
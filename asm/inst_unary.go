@@ -3,7 +3,7 @@ package asm
 import (
 	"fmt"
 
-	"github.com/llir/ll/ast"
+	"github.com/umaumax/llvm/internal/ll/ast"
 	"github.com/umaumax/llvm/ir"
 	"github.com/pkg/errors"
 )
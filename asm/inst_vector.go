@@ -3,7 +3,7 @@ package asm
 import (
 	"fmt"
 
-	"github.com/llir/ll/ast"
+	"github.com/umaumax/llvm/internal/ll/ast"
 	"github.com/umaumax/llvm/ir"
 	"github.com/umaumax/llvm/ir/types"
 	"github.com/pkg/errors"
@@ -18,9 +18,9 @@ func (fgen *funcGen) newExtractElementInst(ident ir.LocalIdent, old *ast.Extract
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	xt, ok := xType.(*types.VectorType)
-	if !ok {
-		panic(fmt.Errorf("invalid vector type; expected *types.VectorType, got %T", xType))
+	xt, err := vectorType(xType)
+	if err != nil {
+		return nil, errors.WithStack(err)
 	}
 	return &ir.InstExtractElement{LocalIdent: ident, Typ: xt.ElemType}, nil
 }
@@ -32,9 +32,9 @@ func (fgen *funcGen) newInsertElementInst(ident ir.LocalIdent, old *ast.InsertEl
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	xt, ok := xType.(*types.VectorType)
-	if !ok {
-		panic(fmt.Errorf("invalid vector type; expected *types.VectorType, got %T", xType))
+	xt, err := vectorType(xType)
+	if err != nil {
+		return nil, errors.WithStack(err)
 	}
 	return &ir.InstInsertElement{LocalIdent: ident, Typ: xt}, nil
 }
@@ -46,19 +46,19 @@ func (fgen *funcGen) newShuffleVectorInst(ident ir.LocalIdent, old *ast.ShuffleV
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	xt, ok := xType.(*types.VectorType)
-	if !ok {
-		panic(fmt.Errorf("invalid vector type; expected *types.VectorType, got %T", xType))
+	xt, err := vectorType(xType)
+	if err != nil {
+		return nil, errors.WithStack(err)
 	}
 	maskType, err := fgen.gen.irType(old.Mask().Typ())
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	mt, ok := maskType.(*types.VectorType)
-	if !ok {
-		panic(fmt.Errorf("invalid vector type; expected *types.VectorType, got %T", maskType))
+	mt, err := vectorType(maskType)
+	if err != nil {
+		return nil, errors.WithStack(err)
 	}
-	typ := types.NewVector(mt.Len, xt.ElemType)
+	typ := &types.VectorType{Scalable: mt.Scalable, Len: mt.Len, ElemType: xt.ElemType}
 	return &ir.InstShuffleVector{LocalIdent: ident, Typ: typ}, nil
 }
 
@@ -165,3 +165,15 @@ func (fgen *funcGen) irShuffleVectorInst(new ir.Instruction, old *ast.ShuffleVec
 	inst.Metadata = md
 	return nil
 }
+
+// ### [ Helper functions ] ####################################################
+
+// vectorType returns the given type as a vector type, or an error if not a
+// vector type.
+func vectorType(t types.Type) (*types.VectorType, error) {
+	vt, ok := t.(*types.VectorType)
+	if !ok {
+		return nil, errors.Errorf("invalid vector type; expected *types.VectorType, got %T", t)
+	}
+	return vt, nil
+}
@@ -20,7 +20,7 @@
 package asm
 
 import (
-	"github.com/llir/ll/ast"
+	"github.com/umaumax/llvm/internal/ll/ast"
 	"github.com/umaumax/llvm/ir"
 	"github.com/umaumax/llvm/ir/types"
 	"github.com/umaumax/llvm/ir/value"
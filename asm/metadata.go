@@ -3,8 +3,8 @@ package asm
 import (
 	"fmt"
 
-	"github.com/llir/ll/ast"
 	"github.com/umaumax/llvm/internal/enc"
+	"github.com/umaumax/llvm/internal/ll/ast"
 	"github.com/umaumax/llvm/ir/metadata"
 	"github.com/pkg/errors"
 )
@@ -3,9 +3,9 @@ package asm
 import (
 	"fmt"
 
-	"github.com/llir/ll/ast"
 	asmenum "github.com/umaumax/llvm/asm/enum"
 	"github.com/umaumax/llvm/internal/enc"
+	"github.com/umaumax/llvm/internal/ll/ast"
 	"github.com/umaumax/llvm/ir"
 	"github.com/umaumax/llvm/ir/metadata"
 	"github.com/pkg/errors"
@@ -15,15 +15,21 @@ import (
 
 // indexTopLevelEntities indexes the AST top-level entities of the given module.
 func (gen *generator) indexTopLevelEntities(old *ast.Module) error {
-	// 1. Index AST top-level entities.
-	for _, entity := range old.TopLevelEntities() {
-		switch entity := entity.(type) {
+	// 1. Index AST target definitions and top-level entities.
+	for _, def := range old.TargetDefs() {
+		switch def := def.(type) {
 		case *ast.SourceFilename:
-			gen.m.SourceFilename = unquote(entity.Name().Text())
+			gen.m.SourceFilename = unquote(def.Name().Text())
 		case *ast.TargetDataLayout:
-			gen.m.DataLayout = unquote(entity.DataLayout().Text())
+			gen.m.DataLayout = unquote(def.DataLayout().Text())
 		case *ast.TargetTriple:
-			gen.m.TargetTriple = unquote(entity.TargetTriple().Text())
+			gen.m.TargetTriple = unquote(def.TargetTriple().Text())
+		default:
+			panic(fmt.Errorf("support for target definition %T not yet implemented", def))
+		}
+	}
+	for _, entity := range old.TopLevelEntities() {
+		switch entity := entity.(type) {
 		case *ast.ModuleAsm:
 			asm := unquote(entity.Asm().Text())
 			gen.m.ModuleAsms = append(gen.m.ModuleAsms, asm)
@@ -0,0 +1,24 @@
+package asm
+
+import (
+	"github.com/umaumax/llvm/internal/ll"
+	"github.com/umaumax/llvm/internal/ll/ast"
+	"github.com/umaumax/llvm/internal/ll/selector"
+)
+
+// usesOpaquePointers reports whether the given AST node contains an opaque
+// pointer type (e.g. `ptr`); in which case the module is translated in opaque
+// pointer mode (see types.Context.SetOpaquePointers).
+func usesOpaquePointers(n *ast.Node) bool {
+	if n.Type() == ll.PointerType {
+		if _, ok := (ast.PointerType{Node: n}).Elem(); !ok {
+			return true
+		}
+	}
+	for _, child := range n.Children(selector.Any) {
+		if usesOpaquePointers(child) {
+			return true
+		}
+	}
+	return false
+}
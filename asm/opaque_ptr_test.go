@@ -0,0 +1,29 @@
+package asm
+
+import "testing"
+
+func TestUsesOpaquePointers(t *testing.T) {
+	golden := []struct {
+		in   string
+		want bool
+	}{
+		{in: "declare ptr @f(ptr)", want: true},
+		{in: "@x = global ptr addrspace(1) null", want: true},
+		{in: "@x = global <2 x ptr> zeroinitializer", want: true},
+		{in: "%t = type { i32, ptr }", want: true},
+		// Identifiers, strings and labels.
+		{in: "@ptr = global i8* null", want: false},
+		{in: `@s = global [4 x i8] c"ptr\00"`, want: false},
+		{in: "define void @f() {\nptr:\n\tret void\n}", want: false},
+	}
+	for _, g := range golden {
+		m, err := ParseString("<stdin>", g.in)
+		if err != nil {
+			t.Errorf("unable to parse %q; %+v", g.in, err)
+			continue
+		}
+		if got := m.TypeContext.OpaquePointers(); got != g.want {
+			t.Errorf("opaque pointer mode mismatch of %q; expected %v, got %v", g.in, g.want, got)
+		}
+	}
+}
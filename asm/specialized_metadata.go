@@ -4,8 +4,8 @@ import (
 	"fmt"
 	"strconv"
 
-	"github.com/llir/ll/ast"
 	asmenum "github.com/umaumax/llvm/asm/enum"
+	"github.com/umaumax/llvm/internal/ll/ast"
 	"github.com/umaumax/llvm/ir/enum"
 	"github.com/umaumax/llvm/ir/metadata"
 	"github.com/pkg/errors"
@@ -223,8 +223,8 @@ func (gen *generator) irDICompileUnit(new metadata.SpecializedNode, old *ast.DIC
 			md.DebugInfoForProfiling = boolLit(oldField.DebugInfoForProfiling())
 		case *ast.NameTableKindField:
 			md.NameTableKind = irNameTableKind(oldField.NameTableKind())
-		case *ast.DebugBaseAddressField:
-			md.DebugBaseAddress = boolLit(oldField.DebugBaseAddress())
+		case *ast.RangesBaseAddressField:
+			md.DebugBaseAddress = boolLit(oldField.RangesBaseAddress())
 		default:
 			panic(fmt.Errorf("support for DICompileUnit field %T not yet implemented", old))
 		}
@@ -489,6 +489,8 @@ func (gen *generator) irDIExpressionField(old ast.DIExpressionField) (metadata.D
 		return metadata.UintLit(uintLit(*old)), nil
 	case *ast.DwarfOp:
 		return asmenum.DwarfOpFromString(old.Text()), nil
+	case *ast.DwarfAttEncodingEnum:
+		return asmenum.DwarfAttEncodingFromString(old.Text()), nil
 	default:
 		panic(fmt.Errorf("support for DIExpression field %T not yet implemented", old))
 	}
@@ -1053,8 +1055,6 @@ func (gen *generator) irDIModule(new metadata.SpecializedNode, old *ast.DIModule
 			md.ConfigMacros = stringLit(oldField.ConfigMacros())
 		case *ast.IncludePathField:
 			md.IncludePath = stringLit(oldField.IncludePath())
-		case *ast.IsysrootField:
-			md.Isysroot = stringLit(oldField.Isysroot())
 		default:
 			panic(fmt.Errorf("support for DIModule field %T not yet implemented", old))
 		}
@@ -1302,7 +1302,11 @@ func (gen *generator) irDISubrange(new metadata.SpecializedNode, old *ast.DISubr
 			}
 			md.Count = count
 		case *ast.LowerBoundField:
-			md.LowerBound = intLit(oldField.LowerBound())
+			lowerBound, ok := oldField.LowerBound().(*ast.IntLit)
+			if !ok {
+				panic(fmt.Errorf("support for DISubrange lower bound %T not yet implemented", oldField.LowerBound()))
+			}
+			md.LowerBound = intLit(*lowerBound)
 		default:
 			panic(fmt.Errorf("support for DISubrange field %T not yet implemented", old))
 		}
@@ -1518,7 +1522,7 @@ func irDISPFlag(old ast.DISPFlag) enum.DISPFlag {
 
 // irDwarfAttEncoding returns the IR Dwarf attribute encoding corresponding to
 // the given AST Dwarf attribute encoding.
-func irDwarfAttEncoding(old ast.DwarfAttEncoding) enum.DwarfAttEncoding {
+func irDwarfAttEncoding(old ast.DwarfAttEncodingOrUint) enum.DwarfAttEncoding {
 	switch old := old.(type) {
 	case *ast.DwarfAttEncodingEnum:
 		return asmenum.DwarfAttEncodingFromString(old.Text())
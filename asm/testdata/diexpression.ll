@@ -1,3 +1,8 @@
+!arg = !{!DIExpression(DW_OP_LLVM_arg, 0, DW_OP_LLVM_arg, 1, DW_OP_plus, DW_OP_stack_value)}
 !bar = !{!DIExpression(42)}
 !baz = !{!DIExpression(42, DW_OP_addr)}
+!convert = !{!DIExpression(DW_OP_LLVM_convert, 8, DW_ATE_unsigned, DW_OP_LLVM_convert, 32, DW_ATE_signed, DW_OP_stack_value)}
+!entry_value = !{!DIExpression(DW_OP_LLVM_entry_value, 1)}
 !foo = !{!DIExpression()}
+!implicit_pointer = !{!DIExpression(DW_OP_LLVM_implicit_pointer, DW_OP_LLVM_fragment, 0, 64)}
+!tag_offset = !{!DIExpression(DW_OP_LLVM_tag_offset, 128)}
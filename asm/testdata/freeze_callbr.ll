@@ -0,0 +1,28 @@
+define i32 @f(i32 %x, <2 x i8> %y) {
+; <label>:0
+	%1 = freeze i32 %x
+	%2 = freeze <2 x i8> %y, !foo !0
+	%3 = add i32 %1, 1
+	ret i32 %3
+}
+
+define i32 @g(i32 %x) {
+entry:
+	callbr void asm "", "r,X,X"(i32 %x, i8* blockaddress(@g, %foo), i8* blockaddress(@g, %bar))
+		to label %normal [label %foo, label %bar]
+
+normal:
+	%0 = callbr i32 asm "", "=r,r,X"(i32 %x, i8* blockaddress(@g, %foo)) [ "foo"(i32 %x) ]
+		to label %done []
+
+done:
+	ret i32 %0
+
+foo:
+	ret i32 1
+
+bar:
+	ret i32 2
+}
+
+!0 = !{}
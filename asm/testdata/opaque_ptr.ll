@@ -0,0 +1,39 @@
+%list = type { i32, ptr }
+
+@x = global i32 0
+@p = global ptr @x
+@q = global ptr addrspace(1) null
+@g = global ptr getelementptr (i32, ptr @x, i64 1)
+@ptr = global [4 x i8] c"ptr\00"
+
+@a = alias i32, ptr @x
+
+@i = ifunc void (), ptr @r
+
+declare i32 @callee(i32, ...)
+
+define ptr @r() {
+; <label>:0
+	ret ptr null
+}
+
+define i32 @f(ptr %ptr, ptr addrspace(1) %q) {
+entry:
+	%a = load i32, ptr %ptr
+	%b = getelementptr %list, ptr %ptr, i64 0, i32 1
+	%c = load ptr, ptr %b
+	store i32 %a, ptr %c
+	%d = call i32 (i32, ...) @callee(i32 %a, ptr %c)
+	%e = call i32 %ptr(i32 1)
+	%f = atomicrmw add ptr %ptr, i32 1 seq_cst
+	%g = cmpxchg ptr %ptr, i32 0, i32 1 seq_cst seq_cst
+	%h = getelementptr i8, ptr addrspace(1) %q, i64 4
+	%v = getelementptr i32, <2 x ptr> zeroinitializer, <2 x i64> zeroinitializer
+	%w = ptrtoint ptr %ptr to i64
+	%z = inttoptr i64 %w to ptr
+	%s = alloca i32
+	store i32 0, ptr %s
+	%t = alloca ptr, addrspace(5)
+	store ptr %s, ptr addrspace(5) %t
+	ret i32 %a
+}
@@ -9,3 +9,5 @@ define void @g(%struct.T* byval align 4) {
 ; <label>:1
 	ret void
 }
+
+declare noalias align 8 %struct.T* @h(%struct.T* align 4)
@@ -0,0 +1,17 @@
+define <vscale x 4 x i32> @f(<vscale x 4 x i32> %x, <vscale x 4 x i1> %m) {
+; <label>:0
+	%1 = add <vscale x 4 x i32> %x, shufflevector (<vscale x 4 x i32> insertelement (<vscale x 4 x i32> undef, i32 1, i32 0), <vscale x 4 x i32> undef, <vscale x 4 x i32> zeroinitializer)
+	%2 = insertelement <vscale x 4 x i32> undef, i32 7, i32 0
+	%3 = shufflevector <vscale x 4 x i32> %2, <vscale x 4 x i32> undef, <vscale x 4 x i32> zeroinitializer
+	%4 = select <vscale x 4 x i1> %m, <vscale x 4 x i32> %1, <vscale x 4 x i32> %3
+	%5 = icmp eq <vscale x 4 x i32> %4, zeroinitializer
+	%6 = extractelement <vscale x 4 x i32> %4, i64 0
+	%7 = getelementptr i32, i32* null, <vscale x 4 x i64> zeroinitializer
+	%8 = zext <vscale x 4 x i32> %4 to <vscale x 4 x i64>
+	%9 = alloca <vscale x 4 x i32>
+	%10 = load <vscale x 4 x i32>, <vscale x 4 x i32>* %9
+	%11 = call i64 @llvm.vscale.i64()
+	ret <vscale x 4 x i32> %4
+}
+
+declare i64 @llvm.vscale.i64()
@@ -87,8 +87,8 @@ import (
 	"sort"
 	"time"
 
-	"github.com/llir/ll/ast"
 	"github.com/umaumax/llvm/internal/enc"
+	"github.com/umaumax/llvm/internal/ll/ast"
 	"github.com/umaumax/llvm/internal/natsort"
 	"github.com/umaumax/llvm/ir"
 	"github.com/umaumax/llvm/ir/constant"
@@ -100,6 +100,9 @@ import (
 // translate translates the given AST module into an equivalent IR module.
 func translate(old *ast.Module) (*ir.Module, error) {
 	gen := newGenerator()
+	if usesOpaquePointers(old.Node) {
+		gen.m.TypeContext.SetOpaquePointers(true)
+	}
 	// 1. Index AST top-level entities.
 	indexStart := time.Now()
 	if err := gen.indexTopLevelEntities(old); err != nil {
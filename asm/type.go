@@ -6,9 +6,9 @@ import (
 	"strconv"
 	"strings"
 
-	"github.com/llir/ll/ast"
 	asmenum "github.com/umaumax/llvm/asm/enum"
 	"github.com/umaumax/llvm/internal/enc"
+	"github.com/umaumax/llvm/internal/ll/ast"
 	"github.com/umaumax/llvm/ir"
 	"github.com/umaumax/llvm/ir/types"
 	"github.com/pkg/errors"
@@ -128,6 +128,11 @@ func (gen *generator) translateTypeDefs() error {
 			return errors.WithStack(err)
 		}
 	}
+	// Add identified struct types to the type context of the module, and intern
+	// the component types of type definitions.
+	for _, t := range gen.new.typeDefs {
+		gen.m.TypeContext.Intern(t)
+	}
 	return nil
 }
 
@@ -301,12 +306,14 @@ func (gen *generator) irPointerType(t types.Type, old *ast.PointerType) (types.T
 	} else if !ok {
 		panic(fmt.Errorf("invalid IR type for AST pointer type; expected *types.PointerType, got %T", t))
 	}
-	// Element type.
-	elemType, err := gen.irType(old.Elem())
-	if err != nil {
-		return nil, errors.WithStack(err)
+	// Element type; not present for opaque pointer types.
+	if n, ok := old.Elem(); ok {
+		elemType, err := gen.irType(n)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		typ.ElemType = elemType
 	}
-	typ.ElemType = elemType
 	// Address space.
 	if n, ok := old.AddrSpace(); ok {
 		typ.AddrSpace = irAddrSpace(n)
@@ -523,7 +530,11 @@ func (gen *generator) irNamedType(t types.Type, old *ast.NamedType) (types.Type,
 
 // irType returns the IR type corresponding to the given AST type.
 func (gen *generator) irType(old ast.LlvmNode) (types.Type, error) {
-	return gen.irTypeDef(nil, old)
+	t, err := gen.irTypeDef(nil, old)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return gen.m.TypeContext.Intern(t), nil
 }
 
 // getTypeName returns the identifier (without '%' prefix) of the given type
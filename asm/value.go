@@ -3,7 +3,7 @@ package asm
 import (
 	"fmt"
 
-	"github.com/llir/ll/ast"
+	"github.com/umaumax/llvm/internal/ll/ast"
 	"github.com/umaumax/llvm/ir/types"
 	"github.com/umaumax/llvm/ir/value"
 	"github.com/pkg/errors"
@@ -43,5 +43,17 @@ func (fgen *funcGen) irTypeValue(old ast.TypeValue) (value.Value, error) {
 		return nil, errors.WithStack(err)
 	}
 	// Value.
-	return fgen.irValue(typ, old.Val())
+	v, err := fgen.irValue(typ, old.Val())
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	// Verify that the type of identifiers matches the type of the type-value
+	// pair; the type of constants is already given by the type-value pair.
+	switch old.Val().(type) {
+	case *ast.GlobalIdent, *ast.LocalIdent:
+		if !v.Type().Equal(typ) {
+			return nil, errors.Errorf("type mismatch of %q; expected `%s`, got `%s`", v.Ident(), typ, v.Type())
+		}
+	}
+	return v, nil
 }
@@ -0,0 +1,106 @@
+// l-diff is a tool which structurally compares two LLVM IR modules.
+//
+// Functions, global variables and other top-level entities are paired by name,
+// and the basic blocks and instructions of functions are aligned based on the
+// structure of the control flow graph; thus the reported differences are not
+// affected by the renumbering of local identifiers.
+//
+// The exit status is 0 if the modules are equivalent, 1 if they differ and 2
+// on error.
+//
+// Usage:
+//
+//    l-diff [OPTION]... OLD.ll NEW.ll
+//
+// Flags:
+//
+//    -o string
+//          output file (default standard output)
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/umaumax/llvm/asm"
+	"github.com/umaumax/llvm/diff"
+)
+
+func usage() {
+	const use = `
+Structurally compare two LLVM IR modules.
+
+Usage:
+
+	l-diff [OPTION]... OLD.ll NEW.ll
+
+Flags:
+`
+	fmt.Fprint(os.Stderr, use[1:])
+	flag.PrintDefaults()
+}
+
+func main() {
+	// Parse command line flags.
+	var (
+		// output specifies the output file.
+		output string
+	)
+	flag.StringVar(&output, "o", "", "output file (default standard output)")
+	flag.Usage = usage
+	flag.Parse()
+	if flag.NArg() != 2 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	oldPath, newPath := flag.Arg(0), flag.Arg(1)
+	n, err := llDiff(output, oldPath, newPath)
+	if err != nil {
+		log.Printf("%+v", err)
+		os.Exit(2)
+	}
+	if n > 0 {
+		os.Exit(1)
+	}
+}
+
+// llDiff compares the LLVM IR modules of the given files, writing the
+// differences to output. The number of differences is returned.
+func llDiff(output, oldPath, newPath string) (int, error) {
+	a, err := asm.ParseFile(oldPath)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	b, err := asm.ParseFile(newPath)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	diffs, err := diff.Modules(a, b)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	w := io.Writer(os.Stdout)
+	if len(output) > 0 {
+		f, err := os.Create(output)
+		if err != nil {
+			return 0, errors.WithStack(err)
+		}
+		defer f.Close()
+		w = f
+	}
+	for i, d := range diffs {
+		if i != 0 {
+			if _, err := fmt.Fprintln(w); err != nil {
+				return 0, errors.WithStack(err)
+			}
+		}
+		if _, err := fmt.Fprintln(w, d); err != nil {
+			return 0, errors.WithStack(err)
+		}
+	}
+	return len(diffs), nil
+}
@@ -0,0 +1,171 @@
+package main
+
+import (
+	"sort"
+
+	"github.com/umaumax/llvm/ir"
+	"github.com/umaumax/llvm/ir/constant"
+	"github.com/umaumax/llvm/ir/enum"
+	"github.com/umaumax/llvm/ir/types"
+	"github.com/umaumax/llvm/ir/value"
+)
+
+// replaceUses replaces uses of old with new in the operands of instructions and
+// terminators of the module, and in the initializers of global variables and
+// aliasees of aliases.
+func replaceUses(m *ir.Module, old, new value.Value) {
+	if new == nil {
+		return
+	}
+	for _, f := range m.Funcs {
+		for _, block := range f.Blocks {
+			for _, inst := range block.Insts {
+				replaceOperands(inst.Operands(), old, new)
+			}
+			if block.Term != nil {
+				replaceOperands(block.Term.Operands(), old, new)
+			}
+		}
+	}
+	c, ok := new.(constant.Constant)
+	if !ok {
+		return
+	}
+	for _, g := range m.Globals {
+		if g.Init == old {
+			g.Init = c
+		}
+	}
+	for _, a := range m.Aliases {
+		if a.Aliasee == old {
+			a.Aliasee = c
+		}
+	}
+}
+
+// replaceOperands replaces occurrences of old with new in the given operands.
+func replaceOperands(ops []*value.Value, old, new value.Value) {
+	for _, op := range ops {
+		if *op == old {
+			*op = new
+		}
+	}
+}
+
+// zeroValue returns the zero value of the given type; or undef if the type has
+// no simple zero value. A nil value is returned for types which may not be
+// replaced (e.g. label, metadata and token types).
+func zeroValue(t types.Type) constant.Constant {
+	switch t := t.(type) {
+	case *types.IntType:
+		return constant.NewInt(t, 0)
+	case *types.FloatType:
+		return constant.NewFloat(t, 0)
+	case *types.PointerType:
+		return constant.NewNull(t)
+	case *types.VectorType, *types.ArrayType, *types.StructType:
+		return constant.NewZeroInitializer(t)
+	}
+	return undefValue(t)
+}
+
+// undefValue returns an undefined value of the given type. A nil value is
+// returned for types which may not be replaced (e.g. label, metadata and token
+// types).
+func undefValue(t types.Type) constant.Constant {
+	switch t.(type) {
+	case *types.VoidType, *types.LabelType, *types.MetadataType, *types.TokenType, *types.FuncType:
+		return nil
+	}
+	return constant.NewUndef(t)
+}
+
+// isSimpleConst reports whether the given value is a simple constant; i.e. a
+// constant which may not be reduced further.
+func isSimpleConst(v value.Value) bool {
+	switch v.(type) {
+	case *constant.Int, *constant.Float, *constant.Null, *constant.Undef, *constant.ZeroInitializer, *constant.NoneToken:
+		return true
+	}
+	return false
+}
+
+// isVoid reports whether the given value has void type (e.g. call instructions
+// with void return type).
+func isVoid(v value.Value) bool {
+	return types.IsVoid(v.Type())
+}
+
+// declLinkage returns the linkage to use for a declaration, based on the
+// linkage of the original definition. Only external and extern_weak linkage
+// are valid for declarations.
+func declLinkage(linkage enum.Linkage) enum.Linkage {
+	if linkage == enum.LinkageExternWeak {
+		return linkage
+	}
+	return enum.LinkageNone
+}
+
+// sortedNamedMetadata returns the names of the named metadata definitions of
+// the module in sorted order.
+func sortedNamedMetadata(m *ir.Module) []string {
+	var names []string
+	for name := range m.NamedMetadataDefs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// local is a local identifier.
+type local interface {
+	// IsUnnamed reports whether the local identifier is unnamed.
+	IsUnnamed() bool
+	// SetID sets the ID of the local identifier.
+	SetID(id int64)
+}
+
+// resetIDs resets the IDs of unnamed local identifiers of function definitions
+// (to be reassigned when printed), and reassigns IDs of unnamed global
+// identifiers in order of occurrence in the output.
+func resetIDs(m *ir.Module) {
+	reset := func(v interface{}) {
+		if n, ok := v.(local); ok && n.IsUnnamed() {
+			n.SetID(0)
+		}
+	}
+	for _, f := range m.Funcs {
+		if len(f.Blocks) == 0 {
+			continue
+		}
+		for _, param := range f.Params {
+			reset(param)
+		}
+		for _, block := range f.Blocks {
+			reset(block)
+			for _, inst := range block.Insts {
+				reset(inst)
+			}
+			reset(block.Term)
+		}
+	}
+	id := int64(0)
+	assign := func(ident *ir.GlobalIdent) {
+		if ident.IsUnnamed() {
+			ident.SetID(id)
+			id++
+		}
+	}
+	for _, g := range m.Globals {
+		assign(&g.GlobalIdent)
+	}
+	for _, a := range m.Aliases {
+		assign(&a.GlobalIdent)
+	}
+	for _, ifunc := range m.IFuncs {
+		assign(&ifunc.GlobalIdent)
+	}
+	for _, f := range m.Funcs {
+		assign(&f.GlobalIdent)
+	}
+}
@@ -0,0 +1,128 @@
+// l-reduce is a tool which reduces LLVM IR test cases.
+//
+// The input LLVM IR assembly file is repeatedly simplified (by deleting
+// functions, global variables, basic blocks, instructions, arguments, metadata,
+// attributes and operands) and each candidate reduction is kept only if the
+// interestingness test still succeeds on the reduced file.
+//
+// The interestingness test is an executable invoked with the test arguments
+// followed by the path to the candidate LLVM IR assembly file; an exit status of
+// 0 indicates that the candidate is interesting.
+//
+// Usage:
+//
+//    l-reduce [OPTION]... -test SCRIPT FILE.ll
+//
+// Flags:
+//
+//    -o string
+//          output file (default "reduced.ll")
+//    -test string
+//          interestingness test
+//    -test-arg value
+//          argument passed to the interestingness test (may be repeated)
+//    -timeout duration
+//          timeout of each run of the interestingness test (default 1m0s)
+//    -v    verbose output
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// dbg is a logger which logs debug messages to standard error, prepending the
+// "l-reduce:" prefix.
+var dbg = log.New(os.Stderr, "l-reduce: ", 0)
+
+func usage() {
+	const use = `
+Reduce LLVM IR test cases.
+
+Usage:
+
+	l-reduce [OPTION]... -test SCRIPT FILE.ll
+
+Flags:
+`
+	fmt.Fprint(os.Stderr, use[1:])
+	flag.PrintDefaults()
+}
+
+// stringsFlag is a repeatable string flag.
+type stringsFlag []string
+
+// String returns the string representation of the flag.
+func (f *stringsFlag) String() string {
+	return strings.Join(*f, " ")
+}
+
+// Set appends the given value to the flag.
+func (f *stringsFlag) Set(s string) error {
+	*f = append(*f, s)
+	return nil
+}
+
+func main() {
+	// Parse command line flags.
+	var (
+		// output specifies the output file.
+		output string
+		// test specifies the interestingness test.
+		test string
+		// testArgs specifies arguments passed to the interestingness test.
+		testArgs stringsFlag
+		// timeout specifies the timeout of each run of the interestingness test.
+		timeout time.Duration
+		// verbose specifies whether to output verbose messages.
+		verbose bool
+	)
+	flag.StringVar(&output, "o", "reduced.ll", "output file")
+	flag.StringVar(&test, "test", "", "interestingness test")
+	flag.Var(&testArgs, "test-arg", "argument passed to the interestingness test (may be repeated)")
+	flag.DurationVar(&timeout, "timeout", 1*time.Minute, "timeout of each run of the interestingness test")
+	flag.BoolVar(&verbose, "v", false, "verbose output")
+	flag.Usage = usage
+	flag.Parse()
+	if flag.NArg() != 1 || len(test) == 0 {
+		flag.Usage()
+		os.Exit(1)
+	}
+	if !verbose {
+		dbg.SetOutput(ioutil.Discard)
+	}
+	llPath := flag.Arg(0)
+	if err := reduceFile(llPath, output, test, testArgs, timeout); err != nil {
+		log.Fatalf("%+v", err)
+	}
+}
+
+// reduceFile reduces the given LLVM IR assembly file, writing the reduced test
+// case to output.
+func reduceFile(llPath, output, test string, testArgs []string, timeout time.Duration) error {
+	buf, err := ioutil.ReadFile(llPath)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	r := &reducer{
+		test:     test,
+		testArgs: testArgs,
+		timeout:  timeout,
+	}
+	reduced, err := r.reduce(string(buf))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if err := ioutil.WriteFile(output, []byte(reduced), 0644); err != nil {
+		return errors.WithStack(err)
+	}
+	dbg.Printf("reduced %q from %d to %d bytes (%d runs of interestingness test)", llPath, len(buf), len(reduced), r.nruns)
+	return nil
+}
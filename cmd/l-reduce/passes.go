@@ -0,0 +1,511 @@
+package main
+
+import (
+	"github.com/umaumax/llvm/ir"
+	"github.com/umaumax/llvm/ir/types"
+	"github.com/umaumax/llvm/ir/value"
+)
+
+// pass is a reduction pass.
+type pass struct {
+	// Pass name.
+	name string
+	// targets returns the reduction targets of the given module in a
+	// deterministic order. Each reduction target is a function which applies
+	// the reduction to the module when invoked.
+	//
+	// Reduction targets locate the entity to reduce by identity (not by index),
+	// so that any subset of the targets may be applied in sequence.
+	targets func(m *ir.Module) []func()
+}
+
+// passes specifies the reduction passes in order of application; coarse
+// grained reductions are tried first.
+var passes = []*pass{
+	{name: "functions", targets: funcTargets},
+	{name: "function bodies", targets: funcBodyTargets},
+	{name: "global variables", targets: globalTargets},
+	{name: "global initializers", targets: globalInitTargets},
+	{name: "aliases", targets: aliasTargets},
+	{name: "basic blocks", targets: blockTargets},
+	{name: "instructions", targets: instTargets},
+	{name: "arguments", targets: argTargets},
+	{name: "metadata", targets: metadataTargets},
+	{name: "attributes", targets: attrTargets},
+	{name: "operands", targets: operandTargets},
+}
+
+// --- [ Functions ] -----------------------------------------------------------
+
+// funcTargets returns reduction targets which remove functions from the module.
+// Uses of a removed function are replaced by null.
+func funcTargets(m *ir.Module) []func() {
+	var targets []func()
+	for _, f := range m.Funcs {
+		f := f
+		targets = append(targets, func() {
+			replaceUses(m, f, zeroValue(f.Type()))
+			for i, g := range m.Funcs {
+				if g == f {
+					m.Funcs = append(m.Funcs[:i], m.Funcs[i+1:]...)
+					break
+				}
+			}
+		})
+	}
+	return targets
+}
+
+// funcBodyTargets returns reduction targets which turn function definitions
+// into function declarations.
+func funcBodyTargets(m *ir.Module) []func() {
+	var targets []func()
+	for _, f := range m.Funcs {
+		if len(f.Blocks) == 0 {
+			continue
+		}
+		f := f
+		targets = append(targets, func() {
+			f.Blocks = nil
+			f.UseListOrders = nil
+			f.Personality = nil
+			f.Prefix = nil
+			f.Prologue = nil
+			f.Comdat = nil
+			f.Linkage = declLinkage(f.Linkage)
+		})
+	}
+	return targets
+}
+
+// --- [ Global variables ] ----------------------------------------------------
+
+// globalTargets returns reduction targets which remove global variables from
+// the module. Uses of a removed global variable are replaced by null.
+func globalTargets(m *ir.Module) []func() {
+	var targets []func()
+	for _, g := range m.Globals {
+		g := g
+		targets = append(targets, func() {
+			replaceUses(m, g, zeroValue(g.Type()))
+			for i, h := range m.Globals {
+				if h == g {
+					m.Globals = append(m.Globals[:i], m.Globals[i+1:]...)
+					break
+				}
+			}
+		})
+	}
+	return targets
+}
+
+// globalInitTargets returns reduction targets which turn global variable
+// definitions into global variable declarations.
+func globalInitTargets(m *ir.Module) []func() {
+	var targets []func()
+	for _, g := range m.Globals {
+		if g.Init == nil {
+			continue
+		}
+		g := g
+		targets = append(targets, func() {
+			g.Init = nil
+			g.Comdat = nil
+			g.Linkage = declLinkage(g.Linkage)
+		})
+	}
+	return targets
+}
+
+// --- [ Aliases ] -------------------------------------------------------------
+
+// aliasTargets returns reduction targets which remove aliases and IFuncs from
+// the module. Uses of a removed alias or IFunc are replaced by null.
+func aliasTargets(m *ir.Module) []func() {
+	var targets []func()
+	for _, a := range m.Aliases {
+		a := a
+		targets = append(targets, func() {
+			replaceUses(m, a, zeroValue(a.Type()))
+			for i, b := range m.Aliases {
+				if b == a {
+					m.Aliases = append(m.Aliases[:i], m.Aliases[i+1:]...)
+					break
+				}
+			}
+		})
+	}
+	for _, ifunc := range m.IFuncs {
+		ifunc := ifunc
+		targets = append(targets, func() {
+			replaceUses(m, ifunc, zeroValue(ifunc.Type()))
+			for i, b := range m.IFuncs {
+				if b == ifunc {
+					m.IFuncs = append(m.IFuncs[:i], m.IFuncs[i+1:]...)
+					break
+				}
+			}
+		})
+	}
+	return targets
+}
+
+// --- [ Basic blocks ] --------------------------------------------------------
+
+// blockTargets returns reduction targets which remove basic blocks (except
+// entry basic blocks) from functions. Terminators branching to a removed basic
+// block are replaced by unreachable, and uses of values defined in the removed
+// basic block are replaced by undef.
+func blockTargets(m *ir.Module) []func() {
+	var targets []func()
+	for _, f := range m.Funcs {
+		if len(f.Blocks) < 2 {
+			continue
+		}
+		f := f
+		for _, block := range f.Blocks[1:] {
+			block := block
+			targets = append(targets, func() {
+				removeBlock(m, f, block)
+			})
+		}
+	}
+	return targets
+}
+
+// removeBlock removes the given basic block from the function.
+func removeBlock(m *ir.Module, f *ir.Func, block *ir.Block) {
+	index := -1
+	for i, b := range f.Blocks {
+		if b == block {
+			index = i
+			break
+		}
+	}
+	if index == -1 {
+		// Basic block already removed.
+		return
+	}
+	f.Blocks = append(f.Blocks[:index], f.Blocks[index+1:]...)
+	for _, inst := range block.Insts {
+		if v, ok := inst.(value.Named); ok {
+			replaceUses(m, v, undefValue(v.Type()))
+		}
+	}
+	if v, ok := block.Term.(value.Named); ok {
+		replaceUses(m, v, undefValue(v.Type()))
+	}
+	for _, b := range f.Blocks {
+		for _, succ := range b.Term.Succs() {
+			if succ == block {
+				b.Term = ir.NewUnreachable()
+				break
+			}
+		}
+		removeIncomings(m, b, block)
+	}
+}
+
+// removeIncomings removes incoming values from pred in the phi instructions of
+// the given basic block. Phi instructions left without incoming values are
+// removed.
+func removeIncomings(m *ir.Module, block, pred *ir.Block) {
+	insts := block.Insts[:0]
+	for _, inst := range block.Insts {
+		phi, ok := inst.(*ir.InstPhi)
+		if !ok {
+			insts = append(insts, inst)
+			continue
+		}
+		incs := phi.Incs[:0]
+		for _, inc := range phi.Incs {
+			if inc.Pred != pred {
+				incs = append(incs, inc)
+			}
+		}
+		phi.Incs = incs
+		if len(phi.Incs) == 0 {
+			replaceUses(m, phi, undefValue(phi.Type()))
+			continue
+		}
+		insts = append(insts, inst)
+	}
+	block.Insts = insts
+}
+
+// --- [ Instructions ] --------------------------------------------------------
+
+// instTargets returns reduction targets which remove instructions from basic
+// blocks. Uses of a removed instruction are replaced by undef.
+func instTargets(m *ir.Module) []func() {
+	var targets []func()
+	for _, f := range m.Funcs {
+		for _, block := range f.Blocks {
+			block := block
+			for _, inst := range block.Insts {
+				inst := inst
+				targets = append(targets, func() {
+					if v, ok := inst.(value.Named); ok && !isVoid(v) {
+						replaceUses(m, v, undefValue(v.Type()))
+					}
+					for i, x := range block.Insts {
+						if x == inst {
+							block.Insts = append(block.Insts[:i], block.Insts[i+1:]...)
+							break
+						}
+					}
+				})
+			}
+		}
+	}
+	return targets
+}
+
+// --- [ Arguments ] -----------------------------------------------------------
+
+// argTargets returns reduction targets which remove parameters from function
+// definitions. Uses of a removed parameter are replaced by undef, and the
+// corresponding argument is removed from direct calls of the function.
+func argTargets(m *ir.Module) []func() {
+	var targets []func()
+	for _, f := range m.Funcs {
+		if len(f.Blocks) == 0 || f.Sig.Variadic {
+			continue
+		}
+		f := f
+		for _, param := range f.Params {
+			param := param
+			targets = append(targets, func() {
+				removeParam(m, f, param)
+			})
+		}
+	}
+	return targets
+}
+
+// removeParam removes the given parameter from the function.
+func removeParam(m *ir.Module, f *ir.Func, param *ir.Param) {
+	index := -1
+	for i, p := range f.Params {
+		if p == param {
+			index = i
+			break
+		}
+	}
+	if index == -1 {
+		// Parameter already removed.
+		return
+	}
+	replaceUses(m, param, undefValue(param.Type()))
+	f.Params = append(f.Params[:index], f.Params[index+1:]...)
+	paramTypes := make([]types.Type, len(f.Params))
+	for i, p := range f.Params {
+		paramTypes[i] = p.Type()
+	}
+	sig := types.NewFunc(f.Sig.RetType, paramTypes...)
+	var addrSpace types.AddrSpace
+	if f.Typ != nil {
+		addrSpace = f.Typ.AddrSpace
+	}
+	f.Sig = sig
+	f.Typ = nil
+	f.Type()
+	f.Typ.AddrSpace = addrSpace
+	// Update call sites.
+	for _, g := range m.Funcs {
+		for _, block := range g.Blocks {
+			for _, inst := range block.Insts {
+				if call, ok := inst.(*ir.InstCall); ok && call.Callee == f && index < len(call.Args) {
+					call.Args = append(call.Args[:index], call.Args[index+1:]...)
+					call.Typ = nil
+					call.Type()
+				}
+			}
+			switch term := block.Term.(type) {
+			case *ir.TermInvoke:
+				if term.Invokee == f && index < len(term.Args) {
+					term.Args = append(term.Args[:index], term.Args[index+1:]...)
+					term.Typ = nil
+					term.Type()
+				}
+			case *ir.TermCallBr:
+				if term.Callee == f && index < len(term.Args) {
+					term.Args = append(term.Args[:index], term.Args[index+1:]...)
+					term.Typ = nil
+					term.Type()
+				}
+			}
+		}
+	}
+}
+
+// --- [ Metadata ] ------------------------------------------------------------
+
+// metadataTargets returns reduction targets which remove named metadata
+// definitions, metadata definitions and metadata attachments of functions,
+// global variables and instructions.
+func metadataTargets(m *ir.Module) []func() {
+	var targets []func()
+	for _, name := range sortedNamedMetadata(m) {
+		name := name
+		targets = append(targets, func() {
+			delete(m.NamedMetadataDefs, name)
+		})
+	}
+	for _, md := range m.MetadataDefs {
+		md := md
+		targets = append(targets, func() {
+			for i, x := range m.MetadataDefs {
+				if x == md {
+					m.MetadataDefs = append(m.MetadataDefs[:i], m.MetadataDefs[i+1:]...)
+					break
+				}
+			}
+		})
+	}
+	// attachmentTargets appends reduction targets removing each metadata
+	// attachment of the given value.
+	attachmentTargets := func(v interface{}) {
+		x, ok := v.(ir.MetadataAttacher)
+		if !ok {
+			return
+		}
+		for _, md := range x.MDAttachments() {
+			md := md
+			targets = append(targets, func() {
+				mds := x.MDAttachments()
+				for i, y := range mds {
+					if y == md {
+						x.SetMDAttachments(append(mds[:i], mds[i+1:]...))
+						break
+					}
+				}
+			})
+		}
+	}
+	for _, g := range m.Globals {
+		attachmentTargets(g)
+	}
+	for _, f := range m.Funcs {
+		attachmentTargets(f)
+		for _, block := range f.Blocks {
+			for _, inst := range block.Insts {
+				attachmentTargets(inst)
+			}
+			attachmentTargets(block.Term)
+		}
+	}
+	return targets
+}
+
+// --- [ Attributes ] ----------------------------------------------------------
+
+// attrTargets returns reduction targets which remove attribute group
+// definitions, and the function, return and parameter attributes of functions
+// and call sites.
+func attrTargets(m *ir.Module) []func() {
+	var targets []func()
+	for _, def := range m.AttrGroupDefs {
+		def := def
+		targets = append(targets, func() {
+			for i, x := range m.AttrGroupDefs {
+				if x == def {
+					m.AttrGroupDefs = append(m.AttrGroupDefs[:i], m.AttrGroupDefs[i+1:]...)
+					break
+				}
+			}
+		})
+	}
+	for _, g := range m.Globals {
+		if len(g.FuncAttrs) > 0 {
+			g := g
+			targets = append(targets, func() { g.FuncAttrs = nil })
+		}
+	}
+	for _, f := range m.Funcs {
+		f := f
+		if len(f.FuncAttrs) > 0 {
+			targets = append(targets, func() { f.FuncAttrs = nil })
+		}
+		if len(f.ReturnAttrs) > 0 {
+			targets = append(targets, func() { f.ReturnAttrs = nil })
+		}
+		for _, param := range f.Params {
+			if len(param.Attrs) > 0 {
+				param := param
+				targets = append(targets, func() { param.Attrs = nil })
+			}
+		}
+		for _, block := range f.Blocks {
+			for _, inst := range block.Insts {
+				call, ok := inst.(*ir.InstCall)
+				if !ok {
+					continue
+				}
+				if len(call.FuncAttrs) > 0 {
+					targets = append(targets, func() { call.FuncAttrs = nil })
+				}
+				if len(call.ReturnAttrs) > 0 {
+					targets = append(targets, func() { call.ReturnAttrs = nil })
+				}
+				for _, arg := range call.Args {
+					if arg, ok := arg.(*ir.Arg); ok && len(arg.Attrs) > 0 {
+						targets = append(targets, func() { arg.Attrs = nil })
+					}
+				}
+			}
+			switch term := block.Term.(type) {
+			case *ir.TermInvoke:
+				if len(term.FuncAttrs) > 0 {
+					targets = append(targets, func() { term.FuncAttrs = nil })
+				}
+				if len(term.ReturnAttrs) > 0 {
+					targets = append(targets, func() { term.ReturnAttrs = nil })
+				}
+			case *ir.TermCallBr:
+				if len(term.FuncAttrs) > 0 {
+					targets = append(targets, func() { term.FuncAttrs = nil })
+				}
+				if len(term.ReturnAttrs) > 0 {
+					targets = append(targets, func() { term.ReturnAttrs = nil })
+				}
+			}
+		}
+	}
+	return targets
+}
+
+// --- [ Operands ] ------------------------------------------------------------
+
+// operandTargets returns reduction targets which replace operands of
+// instructions and terminators by zero (or undef for types without a simple
+// zero value). Operands which are already simple constants are left as is.
+func operandTargets(m *ir.Module) []func() {
+	var targets []func()
+	// addOperands appends reduction targets replacing each operand of the given
+	// operand list.
+	addOperands := func(ops []*value.Value) {
+		for _, op := range ops {
+			if isSimpleConst(*op) {
+				continue
+			}
+			v := zeroValue((*op).Type())
+			if v == nil {
+				continue
+			}
+			op := op
+			targets = append(targets, func() { *op = v })
+		}
+	}
+	for _, f := range m.Funcs {
+		for _, block := range f.Blocks {
+			for _, inst := range block.Insts {
+				addOperands(inst.Operands())
+			}
+			if block.Term != nil {
+				addOperands(block.Term.Operands())
+			}
+		}
+	}
+	return targets
+}
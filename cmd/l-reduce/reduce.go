@@ -0,0 +1,190 @@
+package main
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/umaumax/llvm/asm"
+	"github.com/umaumax/llvm/ir"
+)
+
+// reducer reduces LLVM IR test cases based on an interestingness test.
+type reducer struct {
+	// Interestingness test.
+	test string
+	// Arguments passed to the interestingness test.
+	testArgs []string
+	// Timeout of each run of the interestingness test.
+	timeout time.Duration
+	// Number of runs of the interestingness test.
+	nruns int
+}
+
+// reduce reduces the given LLVM IR assembly, returning the smallest test case
+// found for which the interestingness test still succeeds.
+//
+// Each reduction pass enumerates the reduction targets of the module, and tries
+// to apply chunks of targets at once; starting with all targets and halving the
+// chunk size until individual targets are tried (as in delta debugging). The
+// passes are repeated until a fixed point is reached.
+func (r *reducer) reduce(input string) (string, error) {
+	// Canonicalize the input and verify that the initial test case is
+	// interesting.
+	m, err := parse(input)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	cur, err := render(m)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	ok, err := r.interesting(cur)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	if !ok {
+		if ok, err = r.interesting(input); err != nil {
+			return "", errors.WithStack(err)
+		}
+		if !ok {
+			return "", errors.New("input is not interesting")
+		}
+		return "", errors.New("input is interesting but its canonical printed form is not; unable to reduce")
+	}
+	for changed := true; changed; {
+		changed = false
+		for _, p := range passes {
+			reduced, progress, err := r.runPass(p, cur)
+			if err != nil {
+				return "", errors.WithStack(err)
+			}
+			if progress {
+				dbg.Printf("pass %q: %d -> %d bytes", p.name, len(cur), len(reduced))
+				cur = reduced
+				changed = true
+			}
+		}
+	}
+	return cur, nil
+}
+
+// runPass runs the given reduction pass on the LLVM IR assembly, and reports
+// whether any reduction was made.
+func (r *reducer) runPass(p *pass, cur string) (string, bool, error) {
+	m, err := parse(cur)
+	if err != nil {
+		return "", false, errors.WithStack(err)
+	}
+	n := len(p.targets(m))
+	progress := false
+	for chunk := n; chunk >= 1; chunk /= 2 {
+		for i := 0; i < n; i += chunk {
+			// Parse a fresh copy of the module, as the previous candidate may have
+			// been rejected after mutating the module.
+			m, err := parse(cur)
+			if err != nil {
+				return "", false, errors.WithStack(err)
+			}
+			targets := p.targets(m)
+			if i >= len(targets) {
+				break
+			}
+			end := i + chunk
+			if end > len(targets) {
+				end = len(targets)
+			}
+			for _, apply := range targets[i:end] {
+				apply()
+			}
+			candidate, err := render(m)
+			if err != nil || candidate == cur {
+				continue
+			}
+			// Skip candidates which may not be parsed back.
+			if _, err := parse(candidate); err != nil {
+				continue
+			}
+			ok, err := r.interesting(candidate)
+			if err != nil {
+				return "", false, errors.WithStack(err)
+			}
+			if !ok {
+				continue
+			}
+			cur = candidate
+			progress = true
+			// The reduction targets of the module may have changed, so recount
+			// them and retry the current index.
+			m, err = parse(cur)
+			if err != nil {
+				return "", false, errors.WithStack(err)
+			}
+			n = len(p.targets(m))
+			i -= chunk
+		}
+	}
+	return cur, progress, nil
+}
+
+// interesting reports whether the interestingness test succeeds on the given
+// LLVM IR assembly.
+func (r *reducer) interesting(content string) (bool, error) {
+	r.nruns++
+	f, err := ioutil.TempFile("", "l-reduce-*.ll")
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.WriteString(content); err != nil {
+		f.Close()
+		return false, errors.WithStack(err)
+	}
+	if err := f.Close(); err != nil {
+		return false, errors.WithStack(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+	defer cancel()
+	test := r.test
+	if !strings.ContainsRune(test, filepath.Separator) {
+		// Allow running interestingness tests located in the current directory
+		// without the "./" prefix.
+		if _, err := os.Stat(test); err == nil {
+			test = "." + string(filepath.Separator) + test
+		}
+	}
+	args := append(append([]string(nil), r.testArgs...), f.Name())
+	cmd := exec.CommandContext(ctx, test, args...)
+	if err := cmd.Run(); err != nil {
+		if _, ok := err.(*exec.ExitError); ok {
+			return false, nil
+		}
+		return false, errors.WithStack(err)
+	}
+	return true, nil
+}
+
+// render returns the LLVM IR assembly of the given module. IDs of unnamed
+// local and global identifiers are reassigned, as reductions may have removed
+// identifiers from the sequence of IDs.
+func render(m *ir.Module) (s string, err error) {
+	// Printing of an invalid module may panic (e.g. terminator missing after
+	// the removal of a basic block).
+	defer func() {
+		if e := recover(); e != nil {
+			err = errors.Errorf("unable to print module; %v", e)
+		}
+	}()
+	resetIDs(m)
+	return m.String(), nil
+}
+
+// parse parses the given LLVM IR assembly into a module.
+func parse(content string) (*ir.Module, error) {
+	return asm.ParseString("", content)
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+)
+
+func TestReduce(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("interestingness test requires a POSIX shell")
+	}
+	const input = `
+@g = global i32 42, !foo !0
+@h = global [2 x i32] [i32 1, i32 2]
+
+declare void @crash(i32)
+
+define i32 @f(i32 %x, i32 %y) #0 {
+entry:
+	%a = add i32 %x, %y
+	%b = mul i32 %a, 2
+	%c = load i32, i32* @g
+	br label %exit
+
+exit:
+	call void @crash(i32 %c), !bar !1
+	ret i32 %b
+}
+
+define void @unused() {
+	ret void
+}
+
+attributes #0 = { nounwind }
+
+!0 = !{!"foo"}
+!1 = !{!"bar"}
+`
+	dir, err := ioutil.TempDir("", "l-reduce")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	// The test case is interesting as long as it calls @crash.
+	test := filepath.Join(dir, "test.sh")
+	const script = "#!/bin/sh\ngrep -q 'call void @crash(' \"$1\"\n"
+	if err := ioutil.WriteFile(test, []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	dbg.SetOutput(ioutil.Discard)
+	r := &reducer{test: test, timeout: 10 * time.Second}
+	got, err := r.reduce(input)
+	if err != nil {
+		t.Fatalf("unable to reduce test case; %+v", err)
+	}
+	const want = `declare void @crash(i32)
+
+define i32 @f() {
+entry:
+	br label %exit
+
+exit:
+	call void @crash(i32 undef)
+	ret i32 undef
+}
+`
+	if got != want {
+		t.Errorf("reduced test case mismatch; expected %q, got %q", want, got)
+	}
+}
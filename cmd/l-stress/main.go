@@ -0,0 +1,97 @@
+// l-stress is a tool which generates random well-typed LLVM IR modules.
+//
+// The generated modules are intended for fuzzing LLVM IR tools and back-ends;
+// the same seed and options always produce the same module.
+//
+// Usage:
+//
+//    l-stress [OPTION]...
+//
+// Flags:
+//
+//    -blocks int
+//          maximum number of basic blocks per function (default 8)
+//    -funcs int
+//          maximum number of functions (default 6)
+//    -globals int
+//          maximum number of global variables (default 8)
+//    -insts int
+//          maximum number of instructions per basic block (default 12)
+//    -o string
+//          output file (default standard output)
+//    -seed int
+//          seed of the pseudo-random number generator (default current time)
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/umaumax/llvm/stress"
+)
+
+func usage() {
+	const use = `
+Generate random well-typed LLVM IR modules.
+
+Usage:
+
+	l-stress [OPTION]...
+
+Flags:
+`
+	fmt.Fprint(os.Stderr, use[1:])
+	flag.PrintDefaults()
+}
+
+func main() {
+	// Parse command line flags.
+	var (
+		// output specifies the output file.
+		output string
+		// seed specifies the seed of the pseudo-random number generator.
+		seed int64
+	)
+	cfg := stress.DefaultConfig
+	flag.StringVar(&output, "o", "", "output file (default standard output)")
+	flag.Int64Var(&seed, "seed", 0, "seed of the pseudo-random number generator (default current time)")
+	flag.IntVar(&cfg.MaxGlobals, "globals", cfg.MaxGlobals, "maximum number of global variables")
+	flag.IntVar(&cfg.MaxFuncs, "funcs", cfg.MaxFuncs, "maximum number of functions")
+	flag.IntVar(&cfg.MaxBlocks, "blocks", cfg.MaxBlocks, "maximum number of basic blocks per function")
+	flag.IntVar(&cfg.MaxInsts, "insts", cfg.MaxInsts, "maximum number of instructions per basic block")
+	flag.Usage = usage
+	flag.Parse()
+	if flag.NArg() != 0 {
+		flag.Usage()
+		os.Exit(1)
+	}
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	if cfg.MaxFuncs < 1 || cfg.MaxBlocks < 1 {
+		log.Fatal("invalid configuration; at least one function and one basic block required")
+	}
+	if err := stressGen(output, seed, cfg); err != nil {
+		log.Fatalf("%+v", err)
+	}
+}
+
+// stressGen generates a random LLVM IR module based on the given seed and
+// configuration, writing the LLVM IR assembly to output.
+func stressGen(output string, seed int64, cfg stress.Config) error {
+	m := stress.Generate(seed, cfg)
+	s := fmt.Sprintf("; seed = %d\n%s", seed, m)
+	if len(output) == 0 {
+		_, err := fmt.Print(s)
+		return errors.WithStack(err)
+	}
+	if err := ioutil.WriteFile(output, []byte(s), 0644); err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
@@ -0,0 +1,264 @@
+// Package diff implements structural comparison of LLVM IR modules.
+//
+// Top-level entities of the two modules (type definitions, global variables,
+// functions, aliases and IFuncs) are paired by name. The basic blocks of paired
+// functions are aligned based on the structure of the control flow graphs,
+// starting at the entry blocks, and the instructions of paired basic blocks are
+// aligned based on structural equality; thus the differences reported are
+// unaffected by the naming and numbering of local identifiers.
+//
+// Example usage:
+//
+//    diffs, err := diff.Modules(a, b)
+//    if err != nil {
+//       // handle error
+//    }
+//    for _, d := range diffs {
+//       fmt.Println(d)
+//    }
+package diff
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/umaumax/llvm/internal/enc"
+	"github.com/umaumax/llvm/ir"
+	"github.com/umaumax/llvm/ir/types"
+)
+
+// Kind is the kind of a difference.
+type Kind uint8
+
+// Kinds of differences.
+const (
+	// Entity present only in the second module.
+	KindAdded Kind = iota + 1
+	// Entity present only in the first module.
+	KindRemoved
+	// Entity present in both modules, but with differing definitions.
+	KindChanged
+)
+
+// String returns the string representation of the kind of difference.
+func (kind Kind) String() string {
+	switch kind {
+	case KindAdded:
+		return "added"
+	case KindRemoved:
+		return "removed"
+	case KindChanged:
+		return "changed"
+	}
+	return fmt.Sprintf("Kind(%d)", uint8(kind))
+}
+
+// Difference is a difference between two modules.
+type Difference struct {
+	// Kind of difference.
+	Kind Kind
+	// Entity containing the difference; e.g. "module", "function @f" or
+	// "function @f, block %entry".
+	Context string
+	// LLVM IR assembly of the entity in the first module; or empty if added.
+	Old string
+	// LLVM IR assembly of the entity in the second module; or empty if removed.
+	New string
+}
+
+// String returns a human-readable representation of the difference, in which
+// lines of the first module are prefixed with "<" and lines of the second
+// module are prefixed with ">".
+func (d *Difference) String() string {
+	buf := &strings.Builder{}
+	fmt.Fprintf(buf, "%s: %s", d.Context, d.Kind)
+	for _, line := range lines(d.Old) {
+		fmt.Fprintf(buf, "\n< %s", line)
+	}
+	for _, line := range lines(d.New) {
+		fmt.Fprintf(buf, "\n> %s", line)
+	}
+	return buf.String()
+}
+
+// Modules returns the differences between the given modules.
+//
+// Metadata definitions, attribute group definitions and use-list orders are
+// not compared directly, as they are identified by number.
+func Modules(a, b *ir.Module) ([]*Difference, error) {
+	var diffs []*Difference
+	// Module header.
+	header := func(format, x, y string) {
+		if x == y {
+			return
+		}
+		d := &Difference{Context: "module"}
+		switch {
+		case len(x) == 0:
+			d.Kind = KindAdded
+		case len(y) == 0:
+			d.Kind = KindRemoved
+		default:
+			d.Kind = KindChanged
+		}
+		if len(x) > 0 {
+			d.Old = fmt.Sprintf(format, enc.Quote([]byte(x)))
+		}
+		if len(y) > 0 {
+			d.New = fmt.Sprintf(format, enc.Quote([]byte(y)))
+		}
+		diffs = append(diffs, d)
+	}
+	header("source_filename = %s", a.SourceFilename, b.SourceFilename)
+	header("target datalayout = %s", a.DataLayout, b.DataLayout)
+	header("target triple = %s", a.TargetTriple, b.TargetTriple)
+	header("module asm %s", strings.Join(a.ModuleAsms, "\n"), strings.Join(b.ModuleAsms, "\n"))
+	// Type definitions.
+	typeDefs := func(m *ir.Module) []entity {
+		var es []entity
+		for _, t := range m.TypeDefs {
+			es = append(es, entity{name: t.String(), def: typeDefString(t)})
+		}
+		return es
+	}
+	diffs = append(diffs, entities("type", typeDefs(a), typeDefs(b), equalDef)...)
+	// Comdat definitions.
+	comdatDefs := func(m *ir.Module) []entity {
+		var es []entity
+		for _, def := range m.ComdatDefs {
+			es = append(es, entity{name: enc.Comdat(def.Name), def: def.LLString()})
+		}
+		return es
+	}
+	diffs = append(diffs, entities("comdat", comdatDefs(a), comdatDefs(b), equalDef)...)
+	// Global variables.
+	globals := func(m *ir.Module) []entity {
+		var es []entity
+		for _, g := range m.Globals {
+			es = append(es, entity{name: g.Ident(), def: g.LLString(), v: g})
+		}
+		return es
+	}
+	equalGlobal := func(x, y entity) bool {
+		return ir.EqualGlobal(x.v.(*ir.Global), y.v.(*ir.Global))
+	}
+	diffs = append(diffs, entities("global", globals(a), globals(b), equalGlobal)...)
+	// Aliases.
+	aliases := func(m *ir.Module) []entity {
+		var es []entity
+		for _, alias := range m.Aliases {
+			es = append(es, entity{name: alias.Ident(), def: alias.LLString(), v: alias})
+		}
+		return es
+	}
+	equalAlias := func(x, y entity) bool {
+		return ir.EqualAlias(x.v.(*ir.Alias), y.v.(*ir.Alias))
+	}
+	diffs = append(diffs, entities("alias", aliases(a), aliases(b), equalAlias)...)
+	// IFuncs.
+	ifuncs := func(m *ir.Module) []entity {
+		var es []entity
+		for _, ifunc := range m.IFuncs {
+			es = append(es, entity{name: ifunc.Ident(), def: ifunc.LLString(), v: ifunc})
+		}
+		return es
+	}
+	equalIFunc := func(x, y entity) bool {
+		return ir.EqualIFunc(x.v.(*ir.IFunc), y.v.(*ir.IFunc))
+	}
+	diffs = append(diffs, entities("ifunc", ifuncs(a), ifuncs(b), equalIFunc)...)
+	// Functions.
+	bfuncs := make(map[string]*ir.Func)
+	for _, g := range b.Funcs {
+		bfuncs[g.Ident()] = g
+	}
+	afuncs := make(map[string]bool)
+	for _, f := range a.Funcs {
+		afuncs[f.Ident()] = true
+		g, ok := bfuncs[f.Ident()]
+		if !ok {
+			old, err := funcHeaderString(f)
+			if err != nil {
+				return nil, errors.WithStack(err)
+			}
+			diffs = append(diffs, &Difference{Kind: KindRemoved, Context: "function " + f.Ident(), Old: old})
+			continue
+		}
+		ds, err := Funcs(f, g)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		diffs = append(diffs, ds...)
+	}
+	for _, g := range b.Funcs {
+		if afuncs[g.Ident()] {
+			continue
+		}
+		new, err := funcHeaderString(g)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		diffs = append(diffs, &Difference{Kind: KindAdded, Context: "function " + g.Ident(), New: new})
+	}
+	return diffs, nil
+}
+
+// entity is a top-level entity of a module.
+type entity struct {
+	// Name of the entity.
+	name string
+	// LLVM IR assembly of the entity definition.
+	def string
+	// (optional) IR value of the entity.
+	v interface{}
+}
+
+// equalDef reports whether the given entities have the same LLVM IR assembly.
+func equalDef(x, y entity) bool {
+	return x.def == y.def
+}
+
+// entities returns the differences between the given top-level entities,
+// which are paired by name and compared using equal.
+func entities(kind string, as, bs []entity, equal func(x, y entity) bool) []*Difference {
+	var diffs []*Difference
+	bentities := make(map[string]entity)
+	for _, e := range bs {
+		bentities[e.name] = e
+	}
+	anames := make(map[string]bool)
+	for _, e := range as {
+		anames[e.name] = true
+		context := fmt.Sprintf("%s %s", kind, e.name)
+		be, ok := bentities[e.name]
+		switch {
+		case !ok:
+			diffs = append(diffs, &Difference{Kind: KindRemoved, Context: context, Old: e.def})
+		case !equal(e, be):
+			diffs = append(diffs, &Difference{Kind: KindChanged, Context: context, Old: e.def, New: be.def})
+		}
+	}
+	for _, e := range bs {
+		if !anames[e.name] {
+			context := fmt.Sprintf("%s %s", kind, e.name)
+			diffs = append(diffs, &Difference{Kind: KindAdded, Context: context, New: e.def})
+		}
+	}
+	return diffs
+}
+
+// ### [ Helper functions ] ####################################################
+
+// typeDefString returns the LLVM IR assembly of the given type definition.
+func typeDefString(t types.Type) string {
+	return fmt.Sprintf("%s = type %s", t, t.LLString())
+}
+
+// lines returns the lines of the given string; or nil if empty.
+func lines(s string) []string {
+	if len(s) == 0 {
+		return nil
+	}
+	return strings.Split(s, "\n")
+}
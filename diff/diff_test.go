@@ -0,0 +1,205 @@
+package diff_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/umaumax/llvm/asm"
+	"github.com/umaumax/llvm/diff"
+	"github.com/umaumax/llvm/ir"
+	"github.com/umaumax/llvm/ir/types"
+	"github.com/umaumax/llvm/ir/value"
+	"github.com/umaumax/llvm/stress"
+)
+
+func TestModules(t *testing.T) {
+	const old = "" +
+		"@g = global i32 0\n" +
+		"\n" +
+		"define i32 @f(i32 %n) {\n" +
+		"entry:\n" +
+		"  %c = icmp sgt i32 %n, 0\n" +
+		"  br i1 %c, label %then, label %exit\n" +
+		"then:\n" +
+		"  %x = add i32 %n, 1\n" +
+		"  %y = mul i32 %x, 2\n" +
+		"  br label %exit\n" +
+		"exit:\n" +
+		"  %r = phi i32 [ 0, %entry ], [ %y, %then ]\n" +
+		"  ret i32 %r\n" +
+		"}\n" +
+		"\n" +
+		"define void @h() {\n" +
+		"  ret void\n" +
+		"}\n"
+	const new = "" +
+		"@g = internal global i32 1\n" +
+		"\n" +
+		"define i32 @f(i32) {\n" +
+		"  %2 = icmp sgt i32 %0, 0\n" +
+		"  br i1 %2, label %3, label %7\n" +
+		"; <label>:3\n" +
+		"  %4 = add i32 %0, 1\n" +
+		"  %5 = mul i32 %4, 3\n" +
+		"  store i32 %5, i32* @g\n" +
+		"  %6 = add i32 %5, 1\n" +
+		"  br label %7\n" +
+		"; <label>:7\n" +
+		"  %8 = phi i32 [ 0, %1 ], [ %6, %3 ]\n" +
+		"  ret i32 %8\n" +
+		"}\n" +
+		"\n" +
+		"define i32 @k() {\n" +
+		"  ret i32 0\n" +
+		"}\n"
+	const want = "" +
+		"global @g: changed\n" +
+		"< @g = global i32 0\n" +
+		"> @g = internal global i32 1\n" +
+		"\n" +
+		"function @f, block %then / %3: changed\n" +
+		"< %y = mul i32 %x, 2\n" +
+		"> %5 = mul i32 %4, 3\n" +
+		"> store i32 %5, i32* @g\n" +
+		"> %6 = add i32 %5, 1\n" +
+		"\n" +
+		"function @f, block %exit / %7: changed\n" +
+		"< %r = phi i32 [ 0, %entry ], [ %y, %then ]\n" +
+		"> %8 = phi i32 [ 0, %1 ], [ %6, %3 ]\n" +
+		"\n" +
+		"function @h: removed\n" +
+		"< define void @h()\n" +
+		"\n" +
+		"function @k: added\n" +
+		"> define i32 @k()\n"
+	a, err := asm.ParseString("<old>", old)
+	if err != nil {
+		t.Fatalf("unable to parse old module; %+v", err)
+	}
+	b, err := asm.ParseString("<new>", new)
+	if err != nil {
+		t.Fatalf("unable to parse new module; %+v", err)
+	}
+	diffs, err := diff.Modules(a, b)
+	if err != nil {
+		t.Fatalf("unable to compare modules; %+v", err)
+	}
+	buf := &strings.Builder{}
+	for i, d := range diffs {
+		if i != 0 {
+			buf.WriteString("\n")
+		}
+		fmt.Fprintln(buf, d)
+	}
+	got := buf.String()
+	if got != want {
+		t.Errorf("differences mismatch; expected %q, got %q", want, got)
+	}
+}
+
+func TestModulesEqual(t *testing.T) {
+	// Generated modules contain no differences to themselves with renamed local
+	// identifiers.
+	for seed := int64(1); seed <= 50; seed++ {
+		m := stress.Generate(seed, stress.DefaultConfig)
+		a, err := asm.ParseString("<a>", m.String())
+		if err != nil {
+			t.Fatalf("seed %d: unable to parse module; %+v", seed, err)
+		}
+		b, err := asm.ParseString("<b>", m.String())
+		if err != nil {
+			t.Fatalf("seed %d: unable to parse module; %+v", seed, err)
+		}
+		renameLocals(b)
+		diffs, err := diff.Modules(a, b)
+		if err != nil {
+			t.Fatalf("seed %d: unable to compare modules; %+v", seed, err)
+		}
+		for _, d := range diffs {
+			t.Errorf("seed %d: unexpected difference; %v", seed, d)
+		}
+	}
+}
+
+func TestModulesMetadataIDs(t *testing.T) {
+	// Metadata attachments are compared structurally, not by metadata ID.
+	const old = "" +
+		"@g = global i32 0, !foo !0\n" +
+		"\n" +
+		"!0 = !{i32 1}\n"
+	const new = "" +
+		"@g = global i32 0, !foo !1\n" +
+		"@h = global i32 0, !foo !0\n" +
+		"\n" +
+		"!0 = !{i32 2}\n" +
+		"!1 = !{i32 1}\n"
+	a, err := asm.ParseString("<old>", old)
+	if err != nil {
+		t.Fatalf("unable to parse module; %+v", err)
+	}
+	b, err := asm.ParseString("<new>", new)
+	if err != nil {
+		t.Fatalf("unable to parse module; %+v", err)
+	}
+	diffs, err := diff.Modules(a, b)
+	if err != nil {
+		t.Fatalf("unable to compare modules; %+v", err)
+	}
+	if len(diffs) != 1 || diffs[0].Context != "global @h" || diffs[0].Kind != diff.KindAdded {
+		t.Errorf("differences mismatch; expected global @h added, got %v", diffs)
+	}
+}
+
+func TestFuncsIDs(t *testing.T) {
+	// The IDs of unnamed local variables are left unchanged.
+	newFunc := func() *ir.Func {
+		f := ir.NewFunc("f", types.I32, ir.NewParam("", types.I32))
+		entry := f.NewBlock("")
+		x := entry.NewAdd(f.Params[0], f.Params[0])
+		entry.NewRet(x)
+		return f
+	}
+	a, b := newFunc(), newFunc()
+	if _, err := diff.Funcs(a, b); err != nil {
+		t.Fatalf("unable to compare functions; %+v", err)
+	}
+	for _, f := range []*ir.Func{a, b} {
+		if id := f.Blocks[0].ID(); id != 0 {
+			t.Errorf("ID mismatch; expected 0, got %d", id)
+		}
+		if id := f.Blocks[0].Insts[0].(*ir.InstAdd).ID(); id != 0 {
+			t.Errorf("ID mismatch; expected 0, got %d", id)
+		}
+	}
+}
+
+// renameLocals assigns new names to the local identifiers of the given module.
+func renameLocals(m *ir.Module) {
+	type namer interface {
+		SetName(name string)
+	}
+	for _, f := range m.Funcs {
+		n := 0
+		rename := func(v interface{}) {
+			if v, ok := v.(namer); ok {
+				v.SetName(fmt.Sprintf("v%d", n))
+				n++
+			}
+		}
+		for _, param := range f.Params {
+			rename(param)
+		}
+		for _, block := range f.Blocks {
+			rename(block)
+			for _, inst := range block.Insts {
+				if v, ok := inst.(value.Value); ok && !types.IsVoid(v.Type()) {
+					rename(inst)
+				}
+			}
+			if v, ok := block.Term.(value.Value); ok && !types.IsVoid(v.Type()) {
+				rename(block.Term)
+			}
+		}
+	}
+}
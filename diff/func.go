@@ -0,0 +1,307 @@
+package diff
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/umaumax/llvm/ir"
+	"github.com/umaumax/llvm/ir/value"
+)
+
+// Funcs returns the differences between the given functions.
+//
+// The basic blocks of the functions are paired by traversing the control flow
+// graphs in parallel, starting at the entry blocks; the successors of paired
+// basic blocks are paired by position. The instructions of paired basic blocks
+// are aligned as the longest common subsequence of structurally equal
+// instructions, where local operands are equal if paired.
+//
+// The IDs of unnamed local variables of the given functions are left
+// unchanged.
+func Funcs(a, b *ir.Func) ([]*Difference, error) {
+	restore, err := assignIDs(a, b)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer restore()
+	fd := newFuncDiff(a, b)
+	// Function header.
+	if !ir.EqualFuncHeader(a, b) {
+		old, _ := funcHeaderString(a)
+		new, _ := funcHeaderString(b)
+		fd.diffs = append(fd.diffs, &Difference{Kind: KindChanged, Context: fd.context, Old: old, New: new})
+	}
+	// Function body.
+	pairs := fd.pairBlocks()
+	for _, pair := range pairs {
+		fd.diffBlocks(pair[0], pair[1])
+	}
+	// Unpaired basic blocks.
+	for _, block := range a.Blocks {
+		if _, ok := fd.values[block]; !ok {
+			fd.diffs = append(fd.diffs, &Difference{Kind: KindRemoved, Context: fd.context, Old: block.LLString()})
+		}
+	}
+	for _, block := range b.Blocks {
+		if _, ok := fd.rvalues[block]; !ok {
+			fd.diffs = append(fd.diffs, &Difference{Kind: KindAdded, Context: fd.context, New: block.LLString()})
+		}
+	}
+	return fd.diffs, nil
+}
+
+// funcDiff tracks the state of the comparison of two functions.
+type funcDiff struct {
+	// Functions being compared.
+	a, b *ir.Func
+	// Context of differences within the functions.
+	context string
+	// values maps from local values (function parameters, basic blocks,
+	// instructions and terminators) of a to paired local values of b.
+	values map[interface{}]interface{}
+	// rvalues maps from local values of b to paired local values of a.
+	rvalues map[interface{}]interface{}
+	// Differences between the functions.
+	diffs []*Difference
+}
+
+// newFuncDiff returns a new comparison of the given functions.
+func newFuncDiff(a, b *ir.Func) *funcDiff {
+	return &funcDiff{
+		a:       a,
+		b:       b,
+		context: "function " + a.Ident(),
+		values:  make(map[interface{}]interface{}),
+		rvalues: make(map[interface{}]interface{}),
+	}
+}
+
+// pair records x of a as paired with y of b, and reports whether x and y were
+// unpaired.
+func (fd *funcDiff) pair(x, y interface{}) bool {
+	if _, ok := fd.values[x]; ok {
+		return false
+	}
+	if _, ok := fd.rvalues[y]; ok {
+		return false
+	}
+	fd.values[x] = y
+	fd.rvalues[y] = x
+	return true
+}
+
+// pairBlocks pairs the basic blocks of the functions and their instructions,
+// and returns the pairs of basic blocks in traversal order.
+func (fd *funcDiff) pairBlocks() [][2]*ir.Block {
+	if len(fd.a.Blocks) == 0 || len(fd.b.Blocks) == 0 {
+		return nil
+	}
+	for i := 0; i < len(fd.a.Params) && i < len(fd.b.Params); i++ {
+		fd.pair(fd.a.Params[i], fd.b.Params[i])
+	}
+	entry := [2]*ir.Block{fd.a.Blocks[0], fd.b.Blocks[0]}
+	fd.pair(entry[0], entry[1])
+	var pairs [][2]*ir.Block
+	queue := [][2]*ir.Block{entry}
+	for len(queue) > 0 {
+		pair := queue[0]
+		queue = queue[1:]
+		pairs = append(pairs, pair)
+		x, y := pair[0], pair[1]
+		// Pair instructions based on optimistic structural equality, as local
+		// operands defined later in the traversal are not yet paired.
+		xs, ys := blockInsts(x), blockInsts(y)
+		for _, match := range align(len(xs), len(ys), func(i, j int) bool {
+			return ir.EqualInst(xs[i], ys[j], fd.eqLocal(false))
+		}) {
+			fd.pair(xs[match[0]], ys[match[1]])
+		}
+		// Pair successor basic blocks.
+		if x.Term == nil || y.Term == nil {
+			continue
+		}
+		xsuccs, ysuccs := x.Term.Succs(), y.Term.Succs()
+		if len(xsuccs) != len(ysuccs) {
+			continue
+		}
+		for i := range xsuccs {
+			if fd.pair(xsuccs[i], ysuccs[i]) {
+				queue = append(queue, [2]*ir.Block{xsuccs[i], ysuccs[i]})
+			}
+		}
+	}
+	return pairs
+}
+
+// diffBlocks records the differences between the instructions of the given
+// paired basic blocks.
+func (fd *funcDiff) diffBlocks(x, y *ir.Block) {
+	context := fmt.Sprintf("%s, block %s", fd.context, x.Ident())
+	if x.Ident() != y.Ident() {
+		context += " / " + y.Ident()
+	}
+	xs, ys := blockInsts(x), blockInsts(y)
+	i, j := 0, 0
+	hunk := func(xend, yend int) {
+		if i == xend && j == yend {
+			return
+		}
+		d := &Difference{Kind: KindChanged, Context: context}
+		switch {
+		case i == xend:
+			d.Kind = KindAdded
+		case j == yend:
+			d.Kind = KindRemoved
+		}
+		d.Old = instsString(xs[i:xend])
+		d.New = instsString(ys[j:yend])
+		fd.diffs = append(fd.diffs, d)
+	}
+	for _, match := range align(len(xs), len(ys), func(i, j int) bool {
+		return ir.EqualInst(xs[i], ys[j], fd.eqLocal(true))
+	}) {
+		hunk(match[0], match[1])
+		i, j = match[0]+1, match[1]+1
+	}
+	hunk(len(xs), len(ys))
+}
+
+// eqLocal returns a function which reports whether the given local values are
+// equal; i.e. paired. If not strict, unpaired local values of the same type are
+// considered equal.
+func (fd *funcDiff) eqLocal(strict bool) func(x, y value.Value) bool {
+	return func(x, y value.Value) bool {
+		if v, ok := fd.values[x]; ok {
+			return v == y
+		}
+		if _, ok := fd.rvalues[y]; ok {
+			return false
+		}
+		return !strict && x.Type().Equal(y.Type())
+	}
+}
+
+// align returns the longest common subsequence of two sequences of length n and
+// m respectively, as pairs of indices of equal elements in increasing order.
+func align(n, m int, eq func(i, j int) bool) [][2]int {
+	// lcs[i][j] is the length of the longest common subsequence of the suffixes
+	// starting at i and j.
+	lcs := make([][]int, n+1)
+	equal := make([][]bool, n)
+	for i := range lcs {
+		lcs[i] = make([]int, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		equal[i] = make([]bool, m)
+		for j := m - 1; j >= 0; j-- {
+			switch {
+			case eq(i, j):
+				equal[i][j] = true
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			case lcs[i+1][j] >= lcs[i][j+1]:
+				lcs[i][j] = lcs[i+1][j]
+			default:
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+	var matches [][2]int
+	for i, j := 0, 0; i < n && j < m; {
+		switch {
+		case equal[i][j]:
+			matches = append(matches, [2]int{i, j})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			i++
+		default:
+			j++
+		}
+	}
+	return matches
+}
+
+// ### [ Helper functions ] ####################################################
+
+// blockInsts returns the instructions and terminator of the given basic block.
+func blockInsts(block *ir.Block) []ir.LLStringer {
+	var insts []ir.LLStringer
+	for _, inst := range block.Insts {
+		insts = append(insts, inst)
+	}
+	if block.Term != nil {
+		insts = append(insts, block.Term)
+	}
+	return insts
+}
+
+// instsString returns the LLVM IR assembly of the given instructions, one per
+// line.
+func instsString(insts []ir.LLStringer) string {
+	var ss []string
+	for _, inst := range insts {
+		ss = append(ss, inst.LLString())
+	}
+	return strings.Join(ss, "\n")
+}
+
+// funcHeaderString returns the LLVM IR assembly of the given function header;
+// i.e. the function declaration or the first line of the function definition.
+func funcHeaderString(f *ir.Func) (string, error) {
+	restore, err := assignIDs(f)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	defer restore()
+	s := f.LLString()
+	if len(f.Blocks) == 0 {
+		return s, nil
+	}
+	if pos := strings.Index(s, "\n"); pos != -1 {
+		s = s[:pos]
+	}
+	return strings.TrimSuffix(s, " {"), nil
+}
+
+// assignIDs assigns IDs to the unnamed local variables of the given functions,
+// and returns a function which restores their original IDs. The IDs are
+// required to print the local variables of the functions being compared,
+// without modifying the functions of the caller.
+func assignIDs(fs ...*ir.Func) (restore func(), err error) {
+	type local interface {
+		ID() int64
+		SetID(id int64)
+		IsUnnamed() bool
+	}
+	ids := make(map[local]int64)
+	save := func(v interface{}) {
+		if n, ok := v.(local); ok && n.IsUnnamed() {
+			ids[n] = n.ID()
+		}
+	}
+	for _, f := range fs {
+		for _, param := range f.Params {
+			save(param)
+		}
+		for _, block := range f.Blocks {
+			save(block)
+			for _, inst := range block.Insts {
+				save(inst)
+			}
+			save(block.Term)
+		}
+	}
+	restore = func() {
+		for n, id := range ids {
+			n.SetID(id)
+		}
+	}
+	for _, f := range fs {
+		if err := f.AssignIDs(); err != nil {
+			restore()
+			return nil, errors.WithStack(err)
+		}
+	}
+	return restore, nil
+}
@@ -4,9 +4,7 @@ go 1.13
 
 require (
 	github.com/kr/pretty v0.1.0
-	github.com/llir/ll v0.0.0-20191101161447-d0948609af9a
 	github.com/mewkiz/pkg v0.0.0-20190919212034-518ade7978e2
-	github.com/mewmew/float v0.0.0-20181121163145-c0f786d7da73
 	github.com/pkg/errors v0.8.1
 	golang.org/x/tools v0.0.0-20191028143239-8715e36070db
 )
@@ -0,0 +1,916 @@
+// The llgen tool generates the LALR(1) parser tables of the LLVM IR assembly
+// parser from the productions of the LLVM IR grammar.
+//
+// The productions are read from a grammar file, one production per line:
+//
+//    LHS : RHS... -> NodeType
+//
+// where terminals of the right-hand side are either quoted literals (e.g.
+// 'call') or lowercase token names (e.g. global_ident_tok), as defined by
+// token.go; and the optional node type is the AST node type created on
+// reduction of the production. Empty lines and lines starting with '#' are
+// ignored, and the `%input Name` directive specifies the start symbol.
+//
+// Shift-reduce conflicts are resolved by the precedence of terminals, declared
+// in increasing order of precedence by the `%left`, `%right` and `%nonassoc`
+// directives (e.g. `%left 'ptr'`); the precedence of a production is that of
+// its last terminal. Any other conflict is reported as an error.
+//
+// The parser tables are written in the format of Textmapper, as used by
+// parser.go.
+//
+// Usage:
+//
+//    llgen [OPTION]... GRAMMAR
+//
+// Flags:
+//
+//   -o string
+//         output path of parser tables (default "parser_tables.go")
+//   -parser string
+//         path of parser.go, updated with the final state (default "parser.go")
+//   -token string
+//         path of token.go (default "token.go")
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+func usage() {
+	const use = `
+Generate the LALR(1) parser tables of the LLVM IR grammar.
+
+Usage:
+
+	llgen [OPTION]... GRAMMAR
+
+Flags:
+`
+	fmt.Fprintln(os.Stderr, use[1:])
+	flag.PrintDefaults()
+}
+
+func main() {
+	// Parse command line arguments.
+	var (
+		// Output path of parser tables.
+		output string
+		// Path of parser.go.
+		parserPath string
+		// Path of token.go.
+		tokenPath string
+	)
+	flag.StringVar(&output, "o", "parser_tables.go", "output path of parser tables")
+	flag.StringVar(&parserPath, "parser", "parser.go", "path of parser.go, updated with the final state")
+	flag.StringVar(&tokenPath, "token", "token.go", "path of token.go")
+	flag.Usage = usage
+	flag.Parse()
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(1)
+	}
+	grammarPath := flag.Arg(0)
+	if err := llgen(grammarPath, tokenPath, output, parserPath); err != nil {
+		log.Fatalf("%+v", err)
+	}
+}
+
+// llgen generates the parser tables of the given grammar.
+func llgen(grammarPath, tokenPath, output, parserPath string) error {
+	tokens, err := parseTokens(tokenPath)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	g, err := parseGrammar(grammarPath, tokens)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	t, err := g.tables()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	buf, err := t.format()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if err := ioutil.WriteFile(output, buf, 0644); err != nil {
+		return errors.WithStack(err)
+	}
+	return updateParser(parserPath, t.finalState)
+}
+
+// ### [ Grammar ] #############################################################
+
+// tokenSet is the set of terminals of the grammar.
+type tokenSet struct {
+	// Terminal names, indexed by token ID (e.g. "EOI").
+	names []string
+	// Token ID of terminal references of productions (e.g. "'call'" and
+	// "global_ident_tok").
+	ids map[string]int
+}
+
+// punctuators maps the names of punctuator tokens to their literals, as named
+// by Textmapper.
+var punctuators = map[string]string{
+	"COMMA":  ",",
+	"EXCL":   "!",
+	"LPAREN": "(",
+	"RPAREN": ")",
+	"LBRACK": "[",
+	"RBRACK": "]",
+	"LBRACE": "{",
+	"RBRACE": "}",
+	"MULT":   "*",
+	"LT":     "<",
+	"ASSIGN": "=",
+	"GT":     ">",
+}
+
+// tokenRegexp matches the declaration of a token in token.go.
+var tokenRegexp = regexp.MustCompile(`^\t([A-Z_0-9]+)(?:\s*// (.*))?$`)
+
+// parseTokens parses the terminals of the given token.go file.
+func parseTokens(tokenPath string) (*tokenSet, error) {
+	buf, err := ioutil.ReadFile(tokenPath)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	tokens := &tokenSet{ids: make(map[string]int)}
+	s := bufio.NewScanner(bytes.NewReader(buf))
+	inConst := false
+	for s.Scan() {
+		line := s.Text()
+		switch {
+		case strings.HasPrefix(line, "\tUNAVAILABLE Token = iota - 1"):
+			inConst = true
+			continue
+		case !inConst || len(line) == 0:
+			continue
+		case strings.HasPrefix(line, "\tNumTokens"):
+			return tokens, nil
+		}
+		m := tokenRegexp.FindStringSubmatch(line)
+		if m == nil {
+			return nil, errors.Errorf("invalid token declaration %q", line)
+		}
+		id := len(tokens.names)
+		tokens.names = append(tokens.names, m[1])
+		tokens.ids[strings.ToLower(m[1])] = id
+		if lit, ok := punctuators[m[1]]; ok {
+			tokens.ids["'"+lit+"'"] = id
+		} else if m[2] != "" {
+			tokens.ids["'"+m[2]+"'"] = id
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return nil, errors.Errorf("unable to locate NumTokens in %q", tokenPath)
+}
+
+// grammar is a context-free grammar.
+type grammar struct {
+	// Number of terminals; symbols below nterms are terminals, and remaining
+	// symbols are nonterminals.
+	nterms int
+	// Terminal names, indexed by symbol.
+	terms []string
+	// Nonterminal names, indexed by symbol - nterms.
+	nonterms []string
+	// Productions, in order of occurrence.
+	rules []*rule
+	// Start symbol.
+	input int
+	// Precedence of terminals, indexed by symbol.
+	prec map[int]precedence
+}
+
+// precedence is the precedence of a terminal.
+type precedence struct {
+	// Precedence level; higher levels take precedence.
+	level int
+	// Associativity (%left, %right or %nonassoc).
+	assoc string
+}
+
+// rule is a production of a grammar.
+type rule struct {
+	// Left-hand side nonterminal.
+	lhs int
+	// Right-hand side symbols.
+	rhs []int
+	// AST node type created on reduction; or empty if none.
+	nodeType string
+	// Production in textual form (e.g. "LocalIdent : local_ident_tok").
+	text string
+}
+
+// parseGrammar parses the productions of the given grammar file.
+func parseGrammar(grammarPath string, tokens *tokenSet) (*grammar, error) {
+	buf, err := ioutil.ReadFile(grammarPath)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	type production struct {
+		lineNum  int
+		lhs      string
+		rhs      []string
+		nodeType string
+	}
+	var (
+		prods []production
+		input string
+	)
+	// Nonterminals are numbered in order of first definition.
+	nontermIDs := make(map[string]int)
+	g := &grammar{nterms: len(tokens.names), terms: tokens.names, prec: make(map[int]precedence)}
+	level := 0
+	s := bufio.NewScanner(bytes.NewReader(buf))
+	for lineNum := 1; s.Scan(); lineNum++ {
+		line := strings.TrimSpace(s.Text())
+		switch {
+		case len(line) == 0 || strings.HasPrefix(line, "#"):
+			continue
+		case strings.HasPrefix(line, "%input "):
+			input = strings.TrimSpace(line[len("%input "):])
+			continue
+		case strings.HasPrefix(line, "%left ") || strings.HasPrefix(line, "%right ") || strings.HasPrefix(line, "%nonassoc "):
+			fields := strings.Fields(line)
+			level++
+			for _, name := range fields[1:] {
+				id, ok := tokens.ids[name]
+				if !ok {
+					return nil, errors.Errorf("%s:%d: undefined terminal %q", grammarPath, lineNum, name)
+				}
+				g.prec[id] = precedence{level: level, assoc: fields[0]}
+			}
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) < 2 || fields[1] != ":" {
+			return nil, errors.Errorf("%s:%d: invalid production %q", grammarPath, lineNum, line)
+		}
+		prod := production{lineNum: lineNum, lhs: fields[0]}
+		rhs := fields[2:]
+		if n := len(rhs); n >= 2 && rhs[n-2] == "->" {
+			prod.nodeType = rhs[n-1]
+			rhs = rhs[:n-2]
+		}
+		prod.rhs = rhs
+		if _, ok := nontermIDs[prod.lhs]; !ok {
+			nontermIDs[prod.lhs] = g.nterms + len(g.nonterms)
+			g.nonterms = append(g.nonterms, prod.lhs)
+		}
+		prods = append(prods, prod)
+	}
+	if err := s.Err(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	for _, prod := range prods {
+		r := &rule{lhs: nontermIDs[prod.lhs], nodeType: prod.nodeType}
+		r.text = strings.TrimSpace(prod.lhs + " : " + strings.Join(prod.rhs, " "))
+		for _, name := range prod.rhs {
+			if id, ok := tokens.ids[name]; ok {
+				r.rhs = append(r.rhs, id)
+				continue
+			}
+			id, ok := nontermIDs[name]
+			if !ok {
+				return nil, errors.Errorf("%s:%d: undefined symbol %q", grammarPath, prod.lineNum, name)
+			}
+			r.rhs = append(r.rhs, id)
+		}
+		g.rules = append(g.rules, r)
+	}
+	input = strings.TrimSpace(input)
+	id, ok := nontermIDs[input]
+	if !ok {
+		return nil, errors.Errorf("%s: undefined input nonterminal %q", grammarPath, input)
+	}
+	g.input = id
+	return g, nil
+}
+
+// nsyms returns the number of symbols of the grammar.
+func (g *grammar) nsyms() int {
+	return g.nterms + len(g.nonterms)
+}
+
+// symbolName returns the name of the given symbol.
+func (g *grammar) symbolName(sym int) string {
+	if sym < g.nterms {
+		return g.terms[sym]
+	}
+	return g.nonterms[sym-g.nterms]
+}
+
+// resolve resolves the shift-reduce conflict between shifting the given
+// terminal and reducing the given production, based on precedence. The
+// returned action is -1 to shift, -2 on error, or the production to reduce.
+// The boolean result indicates success.
+func (g *grammar) resolve(sym, r int) (int, bool) {
+	// The precedence of a production is that of its last terminal.
+	rhs := g.rules[r].rhs
+	last := -1
+	for i := len(rhs) - 1; i >= 0; i-- {
+		if rhs[i] < g.nterms {
+			last = rhs[i]
+			break
+		}
+	}
+	rulePrec, ok := g.prec[last]
+	if !ok {
+		return 0, false
+	}
+	symPrec, ok := g.prec[sym]
+	if !ok {
+		return 0, false
+	}
+	switch {
+	case symPrec.level > rulePrec.level:
+		return -1, true
+	case symPrec.level < rulePrec.level:
+		return r, true
+	}
+	switch symPrec.assoc {
+	case "%left":
+		return r, true
+	case "%right":
+		return -1, true
+	default:
+		// %nonassoc
+		return -2, true
+	}
+}
+
+// ### [ LALR(1) automaton ] ###################################################
+
+// bitset is a set of terminals.
+type bitset []uint64
+
+// newBitset returns a new empty set of n terminals.
+func newBitset(n int) bitset {
+	return make(bitset, (n+63)/64)
+}
+
+// has reports whether the set contains the given terminal.
+func (s bitset) has(i int) bool {
+	return s[i/64]&(1<<uint(i%64)) != 0
+}
+
+// add adds the given terminal to the set.
+func (s bitset) add(i int) {
+	s[i/64] |= 1 << uint(i%64)
+}
+
+// union adds the terminals of t to the set, and reports whether the set
+// changed.
+func (s bitset) union(t bitset) bool {
+	changed := false
+	for i, w := range t {
+		if s[i]|w != s[i] {
+			s[i] |= w
+			changed = true
+		}
+	}
+	return changed
+}
+
+// item is an LR(0) item, a production with a position in its right-hand side.
+type item struct {
+	// Production index; or len(g.rules) for the augmented production
+	//
+	//    S' : Input EOI
+	rule int
+	// Position in the right-hand side.
+	dot int
+}
+
+// state is a state of the LR(0) automaton.
+type state struct {
+	// Kernel items, sorted.
+	kernel []item
+	// Lookaheads of kernel items.
+	la []bitset
+	// Successor states by symbol.
+	gotos map[int]int
+	// Nonterminals whose productions are added by closure, in order of
+	// addition.
+	closure []int
+}
+
+// automaton is the LALR(1) automaton of a grammar.
+type automaton struct {
+	g *grammar
+	// Productions, including the augmented production.
+	rules []*rule
+	// States of the automaton; state 0 is the start state.
+	states []*state
+	// Nullable nonterminals, indexed by symbol.
+	nullable []bool
+	// FIRST sets of nonterminals, indexed by symbol.
+	first []bitset
+	// Productions by left-hand side nonterminal.
+	rulesOf map[int][]int
+}
+
+// newAutomaton returns the LALR(1) automaton of the given grammar.
+func newAutomaton(g *grammar) *automaton {
+	a := &automaton{g: g, rulesOf: make(map[int][]int)}
+	a.rules = append(a.rules, g.rules...)
+	a.rules = append(a.rules, &rule{lhs: -1, rhs: []int{g.input, 0}})
+	for i, r := range g.rules {
+		a.rulesOf[r.lhs] = append(a.rulesOf[r.lhs], i)
+	}
+	a.initFirst()
+	a.initStates()
+	a.initLookaheads()
+	return a
+}
+
+// initFirst computes the nullable nonterminals and FIRST sets of the grammar.
+func (a *automaton) initFirst() {
+	g := a.g
+	a.nullable = make([]bool, g.nsyms())
+	a.first = make([]bitset, g.nsyms())
+	for sym := range a.first {
+		a.first[sym] = newBitset(g.nterms)
+		if sym < g.nterms {
+			a.first[sym].add(sym)
+		}
+	}
+	for changed := true; changed; {
+		changed = false
+		for _, r := range g.rules {
+			nullable := true
+			for _, sym := range r.rhs {
+				if a.first[r.lhs].union(a.first[sym]) {
+					changed = true
+				}
+				if !a.nullable[sym] {
+					nullable = false
+					break
+				}
+			}
+			if nullable && !a.nullable[r.lhs] {
+				a.nullable[r.lhs] = true
+				changed = true
+			}
+		}
+	}
+}
+
+// next returns the symbol after the position of the given item; or -1 if the
+// item is complete.
+func (a *automaton) next(it item) int {
+	rhs := a.rules[it.rule].rhs
+	if it.dot >= len(rhs) {
+		return -1
+	}
+	return rhs[it.dot]
+}
+
+// initStates computes the states of the LR(0) automaton. The successors of a
+// state are numbered in order of symbols, and the two states of the augmented
+// production after the start state are numbered last.
+func (a *automaton) initStates() {
+	g := a.g
+	aug := len(a.rules) - 1
+	index := make(map[string]int)
+	key := func(kernel []item) string {
+		var buf bytes.Buffer
+		for _, it := range kernel {
+			fmt.Fprintf(&buf, "%d.%d,", it.rule, it.dot)
+		}
+		return buf.String()
+	}
+	addState := func(kernel []item) int {
+		k := key(kernel)
+		if i, ok := index[k]; ok {
+			return i
+		}
+		i := len(a.states)
+		index[k] = i
+		a.states = append(a.states, &state{kernel: kernel, gotos: make(map[int]int)})
+		return i
+	}
+	addState([]item{{rule: aug}})
+	var accept []int
+	for i := 0; i < len(a.states); i++ {
+		s := a.states[i]
+		// Closure.
+		added := make(map[int]bool)
+		items := append([]item(nil), s.kernel...)
+		for j := 0; j < len(items); j++ {
+			sym := a.next(items[j])
+			if sym < g.nterms || added[sym] {
+				continue
+			}
+			added[sym] = true
+			s.closure = append(s.closure, sym)
+			for _, r := range a.rulesOf[sym] {
+				items = append(items, item{rule: r})
+			}
+		}
+		// Successors.
+		succs := make(map[int][]item)
+		for _, it := range items {
+			if sym := a.next(it); sym != -1 {
+				succs[sym] = append(succs[sym], item{rule: it.rule, dot: it.dot + 1})
+			}
+		}
+		var syms []int
+		for sym := range succs {
+			syms = append(syms, sym)
+		}
+		sort.Ints(syms)
+		for _, sym := range syms {
+			kernel := succs[sym]
+			sort.Slice(kernel, func(i, j int) bool {
+				if kernel[i].rule != kernel[j].rule {
+					return kernel[i].rule < kernel[j].rule
+				}
+				return kernel[i].dot < kernel[j].dot
+			})
+			if kernel[0].rule == aug {
+				// States of the augmented production are added last.
+				accept = append(accept, i, sym)
+				continue
+			}
+			s.gotos[sym] = addState(kernel)
+		}
+	}
+	// S' : Input . EOI
+	// S' : Input EOI .
+	from, sym := accept[0], accept[1]
+	s1 := addState([]item{{rule: aug, dot: 1}})
+	a.states[from].gotos[sym] = s1
+	s2 := addState([]item{{rule: aug, dot: 2}})
+	a.states[s1].gotos[0] = s2
+}
+
+// initLookaheads computes the lookaheads of kernel items by propagation.
+func (a *automaton) initLookaheads() {
+	g := a.g
+	for _, s := range a.states {
+		s.la = make([]bitset, len(s.kernel))
+		for i := range s.la {
+			s.la[i] = newBitset(g.nterms)
+		}
+	}
+	queued := make([]bool, len(a.states))
+	var queue []int
+	for i := range a.states {
+		queue = append(queue, i)
+		queued[i] = true
+	}
+	for len(queue) > 0 {
+		i := queue[0]
+		queue = queue[1:]
+		queued[i] = false
+		s := a.states[i]
+		closureLA := a.closureLookaheads(s)
+		propagate := func(it item, la bitset) {
+			sym := a.next(it)
+			if sym == -1 {
+				return
+			}
+			t := a.states[s.gotos[sym]]
+			succ := item{rule: it.rule, dot: it.dot + 1}
+			for k, kit := range t.kernel {
+				if kit == succ {
+					if t.la[k].union(la) && !queued[s.gotos[sym]] {
+						queued[s.gotos[sym]] = true
+						queue = append(queue, s.gotos[sym])
+					}
+					return
+				}
+			}
+			panic(fmt.Errorf("unable to locate successor of item %v in state %d", it, i))
+		}
+		for k, it := range s.kernel {
+			propagate(it, s.la[k])
+		}
+		for _, sym := range s.closure {
+			for _, r := range a.rulesOf[sym] {
+				propagate(item{rule: r}, closureLA[sym])
+			}
+		}
+	}
+}
+
+// closureLookaheads returns the lookaheads of the productions added by closure
+// to the given state, indexed by left-hand side nonterminal.
+func (a *automaton) closureLookaheads(s *state) map[int]bitset {
+	g := a.g
+	la := make(map[int]bitset)
+	for _, sym := range s.closure {
+		la[sym] = newBitset(g.nterms)
+	}
+	// follow adds the lookaheads of B in A : α . B β with lookaheads l.
+	follow := func(it item, l bitset) bool {
+		sym := a.next(it)
+		if sym < g.nterms {
+			return false
+		}
+		changed := false
+		rhs := a.rules[it.rule].rhs
+		nullable := true
+		for _, x := range rhs[it.dot+1:] {
+			if la[sym].union(a.first[x]) {
+				changed = true
+			}
+			if !a.nullable[x] {
+				nullable = false
+				break
+			}
+		}
+		if nullable && la[sym].union(l) {
+			changed = true
+		}
+		return changed
+	}
+	for k, it := range s.kernel {
+		follow(it, s.la[k])
+	}
+	for changed := true; changed; {
+		changed = false
+		for _, sym := range s.closure {
+			for _, r := range a.rulesOf[sym] {
+				if follow(item{rule: r}, la[sym]) {
+					changed = true
+				}
+			}
+		}
+	}
+	return la
+}
+
+// reductions returns the lookaheads of the productions reduced in the given
+// state, indexed by production.
+func (a *automaton) reductions(s *state) map[int]bitset {
+	reds := make(map[int]bitset)
+	add := func(r int, la bitset) {
+		if _, ok := reds[r]; !ok {
+			reds[r] = newBitset(a.g.nterms)
+		}
+		reds[r].union(la)
+	}
+	for k, it := range s.kernel {
+		if it.rule < len(a.g.rules) && a.next(it) == -1 {
+			add(it.rule, s.la[k])
+		}
+	}
+	closureLA := a.closureLookaheads(s)
+	for _, sym := range s.closure {
+		for _, r := range a.rulesOf[sym] {
+			if len(a.rules[r].rhs) == 0 {
+				add(r, closureLA[sym])
+			}
+		}
+	}
+	return reds
+}
+
+// ### [ Parser tables ] #######################################################
+
+// tables are the parser tables of a grammar, in the format of Textmapper.
+type tables struct {
+	g *grammar
+	// Parser action of each state; a production to reduce, -1 to shift, -2 on
+	// error, or -3-i for the lookahead actions at tmLalr[i].
+	action []int32
+	// Lookahead actions; pairs of terminal and action, terminated by -1 and the
+	// default action.
+	lalr []int32
+	// Index of the successor states of each symbol in fromTo.
+	gotoIndex []int32
+	// Pairs of from and to states, sorted by from state for each symbol.
+	fromTo []int16
+	// Final state.
+	finalState int
+}
+
+// tables returns the parser tables of the grammar.
+func (g *grammar) tables() (*tables, error) {
+	a := newAutomaton(g)
+	t := &tables{g: g, finalState: len(a.states) - 1}
+	if len(a.states) > 1<<15-1 {
+		return nil, errors.Errorf("too many states (%d)", len(a.states))
+	}
+	var conflicts []string
+	lalrIndex := make(map[string]int)
+	for i, s := range a.states {
+		if i == t.finalState {
+			t.action = append(t.action, -2)
+			continue
+		}
+		reds := a.reductions(s)
+		var shifts []int
+		for sym := range s.gotos {
+			if sym < g.nterms {
+				shifts = append(shifts, sym)
+			}
+		}
+		sort.Ints(shifts)
+		switch {
+		case len(reds) == 0:
+			t.action = append(t.action, -1)
+			continue
+		case len(reds) == 1 && len(shifts) == 0:
+			for r := range reds {
+				t.action = append(t.action, int32(r))
+			}
+			continue
+		}
+		// Parser action by terminal; -1 to shift, or a production to reduce.
+		actions := make(map[int]int)
+		for _, sym := range shifts {
+			actions[sym] = -1
+		}
+		var rs []int
+		for r := range reds {
+			rs = append(rs, r)
+		}
+		sort.Ints(rs)
+		for _, r := range rs {
+			for sym := 0; sym < g.nterms; sym++ {
+				if !reds[r].has(sym) {
+					continue
+				}
+				prev, ok := actions[sym]
+				if !ok {
+					actions[sym] = r
+					continue
+				}
+				if prev == -1 {
+					// Shift-reduce conflict.
+					if action, ok := g.resolve(sym, r); ok {
+						if action == -2 {
+							delete(actions, sym)
+						} else {
+							actions[sym] = action
+						}
+						continue
+					}
+					conflicts = append(conflicts, fmt.Sprintf("state %d: shift-reduce conflict on %s between shift and reduce of %q", i, g.symbolName(sym), g.rules[r].text))
+					continue
+				}
+				conflicts = append(conflicts, fmt.Sprintf("state %d: reduce-reduce conflict on %s between reduce of %q and reduce of %q", i, g.symbolName(sym), g.rules[prev].text, g.rules[r].text))
+			}
+		}
+		// Lookahead actions; shifts first, followed by reductions in order of
+		// production.
+		var lalr []int32
+		for _, sym := range shifts {
+			if actions[sym] == -1 {
+				lalr = append(lalr, int32(sym), -1)
+			}
+		}
+		for _, r := range rs {
+			for sym := 0; sym < g.nterms; sym++ {
+				if action, ok := actions[sym]; ok && action == r {
+					lalr = append(lalr, int32(sym), int32(r))
+				}
+			}
+		}
+		lalr = append(lalr, -1, -2)
+		k := fmt.Sprint(lalr)
+		j, ok := lalrIndex[k]
+		if !ok {
+			j = len(t.lalr)
+			lalrIndex[k] = j
+			t.lalr = append(t.lalr, lalr...)
+		}
+		t.action = append(t.action, int32(-3-j))
+	}
+	if len(conflicts) > 0 {
+		return nil, errors.Errorf("%d conflicts:\n\t%s", len(conflicts), strings.Join(conflicts, "\n\t"))
+	}
+	// Successor states.
+	for sym := 0; sym < g.nsyms(); sym++ {
+		t.gotoIndex = append(t.gotoIndex, int32(len(t.fromTo)))
+		for i, s := range a.states {
+			if j, ok := s.gotos[sym]; ok {
+				t.fromTo = append(t.fromTo, int16(i), int16(j))
+			}
+		}
+	}
+	t.gotoIndex = append(t.gotoIndex, int32(len(t.fromTo)))
+	return t, nil
+}
+
+// format returns the Go source code of the parser tables.
+func (t *tables) format() ([]byte, error) {
+	g := t.g
+	buf := &bytes.Buffer{}
+	buf.WriteString(`// generated by llgen; DO NOT EDIT
+
+package ll
+
+import (
+	"fmt"
+)
+
+var tmNonterminals = [...]string{
+`)
+	for _, name := range g.nonterms {
+		fmt.Fprintf(buf, "\t%s,\n", strconv.Quote(name))
+	}
+	buf.WriteString(`}
+
+func symbolName(sym int32) string {
+	if sym < int32(NumTokens) {
+		return Token(sym).String()
+	}
+	if i := int(sym) - int(NumTokens); i < len(tmNonterminals) {
+		return tmNonterminals[i]
+	}
+	return fmt.Sprintf("nonterminal(%d)", sym)
+}
+`)
+	writeInts(buf, "tmAction", "int32", int32s(t.action))
+	writeInts(buf, "tmLalr", "int32", int32s(t.lalr))
+	writeInts(buf, "tmGoto", "int32", int32s(t.gotoIndex))
+	var fromTo []string
+	for _, v := range t.fromTo {
+		fromTo = append(fromTo, strconv.Itoa(int(v)))
+	}
+	writeInts(buf, "tmFromTo", "int16", fromTo)
+	var ruleLen, ruleSymbol []string
+	for _, r := range g.rules {
+		ruleLen = append(ruleLen, strconv.Itoa(len(r.rhs)))
+		ruleSymbol = append(ruleSymbol, strconv.Itoa(r.lhs))
+	}
+	writeInts(buf, "tmRuleLen", "int8", ruleLen)
+	writeInts(buf, "tmRuleSymbol", "int32", ruleSymbol)
+	buf.WriteString("\nvar tmRuleType = [...]NodeType{\n")
+	for _, r := range g.rules {
+		nodeType := r.nodeType
+		if len(nodeType) == 0 {
+			nodeType = "0"
+		}
+		fmt.Fprintf(buf, "\t%s, // %s\n", nodeType, r.text)
+	}
+	buf.WriteString("}\n")
+	return format.Source(buf.Bytes())
+}
+
+// int32s returns the string representation of the given integers.
+func int32s(vs []int32) []string {
+	var ss []string
+	for _, v := range vs {
+		ss = append(ss, strconv.Itoa(int(v)))
+	}
+	return ss
+}
+
+// writeInts writes the declaration of an integer slice with the given name,
+// element type and values, wrapping lines at 78 columns.
+func writeInts(buf *bytes.Buffer, name, typ string, vs []string) {
+	fmt.Fprintf(buf, "\nvar %s = []%s{\n", name, typ)
+	line := "\t"
+	for _, v := range vs {
+		if len(line) > 1 && len(line)+len(v)+2 > 78 {
+			buf.WriteString(strings.TrimRight(line, " ") + "\n")
+			line = "\t"
+		}
+		line += v + ", "
+	}
+	if len(line) > 1 {
+		buf.WriteString(strings.TrimRight(line, " ") + "\n")
+	}
+	buf.WriteString("}\n")
+}
+
+// parseRegexp matches the entry point of the parser in parser.go.
+var parseRegexp = regexp.MustCompile(`p\.parse\(0, [0-9]+, lexer\)`)
+
+// updateParser updates the final state of the entry point of the parser in
+// the given parser.go file.
+func updateParser(parserPath string, finalState int) error {
+	buf, err := ioutil.ReadFile(parserPath)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if !parseRegexp.Match(buf) {
+		return errors.Errorf("unable to locate entry point of parser in %q", parserPath)
+	}
+	buf = parseRegexp.ReplaceAll(buf, []byte(fmt.Sprintf("p.parse(0, %d, lexer)", finalState)))
+	return errors.WithStack(ioutil.WriteFile(parserPath, buf, 0644))
+}
@@ -0,0 +1,12 @@
+package ll
+
+// The parser tables of parser_tables.go are generated by llgen from the
+// productions of ll.grammar, which extends the Textmapper grammar of this
+// parser snapshot with the following productions:
+//
+//    PointerType : 'ptr'
+//    PointerType : 'ptr' AddrSpace
+//    ReturnAttr  : Align
+//    FreezeInst  : 'freeze' TypeValue list_of_','_and_1_elements1
+
+//go:generate go run ./cmd/llgen -o parser_tables.go ll.grammar
@@ -0,0 +1,8060 @@
+// generated by llgen; DO NOT EDIT
+
+package ll
+
+import (
+	"fmt"
+)
+
+var tmNonterminals = [...]string{
+	"GlobalIdent",
+	"LocalIdent",
+	"LabelIdent",
+	"AttrGroupID",
+	"ComdatName",
+	"MetadataName",
+	"MetadataID",
+	"BoolLit",
+	"IntLit",
+	"UintLit",
+	"FloatLit",
+	"StringLit",
+	"NullLit",
+	"Module",
+	"TargetDef_optlist",
+	"TopLevelEntity_optlist",
+	"TopLevelEntity",
+	"TargetDef",
+	"SourceFilename",
+	"TargetDataLayout",
+	"TargetTriple",
+	"ModuleAsm",
+	"TypeDef",
+	"ComdatDef",
+	"SelectionKind",
+	"FuncAttribute_list",
+	"GlobalDecl",
+	"list_of_','_and_1_elements",
+	"list_of_','_and_1_elements1",
+	"GlobalField",
+	"ExternallyInitialized",
+	"Immutable",
+	"IndirectSymbolDef",
+	"list_of_','_and_1_elements2",
+	"IndirectSymbolKind",
+	"IndirectSymbol",
+	"FuncDecl",
+	"MetadataAttachment_optlist",
+	"FuncDef",
+	"FuncHdrField_optlist",
+	"FuncHeader",
+	"ReturnAttribute_optlist",
+	"FuncHdrField",
+	"GC",
+	"Prefix",
+	"Prologue",
+	"Personality",
+	"BasicBlock_list",
+	"FuncBody",
+	"UseListOrder_optlist",
+	"AttrGroupDef",
+	"FuncAttribute_optlist",
+	"MetadataNode_list_withsep",
+	"MetadataNode_list_withsep_opt",
+	"NamedMetadataDef",
+	"MetadataNode",
+	"MetadataDef",
+	"Distinct",
+	"UintLit_list_withsep",
+	"UseListOrder",
+	"UseListOrderBB",
+	"Type",
+	"FirstClassType",
+	"ConcreteType",
+	"VoidType",
+	"FuncType",
+	"IntType",
+	"FloatType",
+	"FloatKind",
+	"MMXType",
+	"PointerType",
+	"VectorType",
+	"LabelType",
+	"TokenType",
+	"MetadataType",
+	"ArrayType",
+	"StructType",
+	"Type_list_withsep",
+	"OpaqueType",
+	"NamedType",
+	"Value",
+	"InlineAsm",
+	"SideEffect",
+	"AlignStackTok",
+	"IntelDialect",
+	"Unwind",
+	"Constant",
+	"BoolConst",
+	"IntConst",
+	"FloatConst",
+	"NullConst",
+	"NoneConst",
+	"StructConst",
+	"TypeConst_list_withsep",
+	"ArrayConst",
+	"TypeConst_list_withsep_opt",
+	"VectorConst",
+	"ZeroInitializerConst",
+	"UndefConst",
+	"PoisonConst",
+	"BlockAddressConst",
+	"DSOLocalEquivalentConst",
+	"NoCFIConst",
+	"ConstantExpr",
+	"FNegExpr",
+	"AddExpr",
+	"OverflowFlag_optlist",
+	"FAddExpr",
+	"SubExpr",
+	"FSubExpr",
+	"MulExpr",
+	"FMulExpr",
+	"UDivExpr",
+	"SDivExpr",
+	"FDivExpr",
+	"URemExpr",
+	"SRemExpr",
+	"FRemExpr",
+	"ShlExpr",
+	"LShrExpr",
+	"AShrExpr",
+	"AndExpr",
+	"OrExpr",
+	"XorExpr",
+	"ExtractElementExpr",
+	"InsertElementExpr",
+	"ShuffleVectorExpr",
+	"ExtractValueExpr",
+	"list_of_','_and_1_elements3",
+	"InsertValueExpr",
+	"GetElementPtrExpr",
+	"list_of_','_and_1_elements4",
+	"GEPIndex",
+	"InRange",
+	"TruncExpr",
+	"ZExtExpr",
+	"SExtExpr",
+	"FPTruncExpr",
+	"FPExtExpr",
+	"FPToUIExpr",
+	"FPToSIExpr",
+	"UIToFPExpr",
+	"SIToFPExpr",
+	"PtrToIntExpr",
+	"IntToPtrExpr",
+	"BitCastExpr",
+	"AddrSpaceCastExpr",
+	"ICmpExpr",
+	"FCmpExpr",
+	"SelectExpr",
+	"BasicBlock",
+	"Instruction_optlist",
+	"Instruction",
+	"LocalDefInst",
+	"ValueInstruction",
+	"FNegInst",
+	"FastMathFlag_optlist",
+	"AddInst",
+	"FAddInst",
+	"SubInst",
+	"FSubInst",
+	"MulInst",
+	"FMulInst",
+	"UDivInst",
+	"SDivInst",
+	"FDivInst",
+	"URemInst",
+	"SRemInst",
+	"FRemInst",
+	"ShlInst",
+	"LShrInst",
+	"AShrInst",
+	"AndInst",
+	"OrInst",
+	"XorInst",
+	"ExtractElementInst",
+	"InsertElementInst",
+	"ShuffleVectorInst",
+	"ExtractValueInst",
+	"list_of_','_and_1_elements5",
+	"InsertValueInst",
+	"AllocaInst",
+	"InAllocatok",
+	"SwiftError",
+	"LoadInst",
+	"StoreInst",
+	"FenceInst",
+	"CmpXchgInst",
+	"Weak",
+	"AtomicRMWInst",
+	"AtomicOp",
+	"GetElementPtrInst",
+	"list_of_','_and_1_elements6",
+	"TruncInst",
+	"ZExtInst",
+	"SExtInst",
+	"FPTruncInst",
+	"FPExtInst",
+	"FPToUIInst",
+	"FPToSIInst",
+	"UIToFPInst",
+	"SIToFPInst",
+	"PtrToIntInst",
+	"IntToPtrInst",
+	"BitCastInst",
+	"AddrSpaceCastInst",
+	"ICmpInst",
+	"FCmpInst",
+	"Inc_list_withsep",
+	"PhiInst",
+	"Inc",
+	"SelectInst",
+	"FreezeInst",
+	"CallInst",
+	"OperandBundle_list_withsep",
+	"Tail",
+	"VAArgInst",
+	"Clause_optlist",
+	"LandingPadInst",
+	"Cleanup",
+	"Clause",
+	"ClauseType",
+	"CatchPadInst",
+	"ExceptionArg_list_withsep",
+	"ExceptionArg_list_withsep_opt",
+	"CleanupPadInst",
+	"Terminator",
+	"LocalDefTerm",
+	"ValueTerminator",
+	"RetTerm",
+	"BrTerm",
+	"CondBrTerm",
+	"Case_optlist",
+	"SwitchTerm",
+	"Case",
+	"IndirectBrTerm",
+	"Label_list_withsep",
+	"Label_list_withsep_opt",
+	"InvokeTerm",
+	"CallBrTerm",
+	"ResumeTerm",
+	"CatchSwitchTerm",
+	"Handlers",
+	"CatchRetTerm",
+	"CleanupRetTerm",
+	"UnreachableTerm",
+	"MDField_list_withsep",
+	"MDField_list_withsep_opt",
+	"MDTuple",
+	"MDField",
+	"Metadata",
+	"MDString",
+	"MetadataAttachment",
+	"MDNode",
+	"DIArgList",
+	"TypeValue_list_withsep",
+	"TypeValue_list_withsep_opt",
+	"SpecializedMDNode",
+	"DIBasicType",
+	"DIBasicTypeField_list_withsep",
+	"DIBasicTypeField_list_withsep_opt",
+	"DIBasicTypeField",
+	"DIStringType",
+	"DIStringTypeField_list_withsep",
+	"DIStringTypeField_list_withsep_opt",
+	"DIStringTypeField",
+	"DICommonBlock",
+	"DICommonBlockField_list_withsep",
+	"DICommonBlockField_list_withsep_opt",
+	"DICommonBlockField",
+	"DICompileUnit",
+	"DICompileUnitField_list_withsep",
+	"DICompileUnitField_list_withsep_opt",
+	"DICompileUnitField",
+	"DICompositeType",
+	"DICompositeTypeField_list_withsep",
+	"DICompositeTypeField_list_withsep_opt",
+	"DICompositeTypeField",
+	"DIDerivedType",
+	"DIDerivedTypeField_list_withsep",
+	"DIDerivedTypeField_list_withsep_opt",
+	"DIDerivedTypeField",
+	"DIEnumerator",
+	"DIEnumeratorField_list_withsep",
+	"DIEnumeratorField_list_withsep_opt",
+	"DIEnumeratorField",
+	"DIExpression",
+	"DIExpressionField_list_withsep",
+	"DIExpressionField_list_withsep_opt",
+	"DIExpressionField",
+	"DIFile",
+	"DIFileField_list_withsep",
+	"DIFileField_list_withsep_opt",
+	"DIFileField",
+	"DIGlobalVariable",
+	"DIGlobalVariableField_list_withsep",
+	"DIGlobalVariableField_list_withsep_opt",
+	"DIGlobalVariableField",
+	"DIGlobalVariableExpression",
+	"DIGlobalVariableExpressionField_list_withsep",
+	"DIGlobalVariableExpressionField_list_withsep_opt",
+	"DIGlobalVariableExpressionField",
+	"DIImportedEntity",
+	"DIImportedEntityField_list_withsep",
+	"DIImportedEntityField_list_withsep_opt",
+	"DIImportedEntityField",
+	"DILabel",
+	"DILabelField_list_withsep",
+	"DILabelField_list_withsep_opt",
+	"DILabelField",
+	"DILexicalBlock",
+	"DILexicalBlockField_list_withsep",
+	"DILexicalBlockField_list_withsep_opt",
+	"DILexicalBlockField",
+	"DILexicalBlockFile",
+	"DILexicalBlockFileField_list_withsep",
+	"DILexicalBlockFileField_list_withsep_opt",
+	"DILexicalBlockFileField",
+	"DILocalVariable",
+	"DILocalVariableField_list_withsep",
+	"DILocalVariableField_list_withsep_opt",
+	"DILocalVariableField",
+	"DILocation",
+	"DILocationField_list_withsep",
+	"DILocationField_list_withsep_opt",
+	"DILocationField",
+	"DIMacro",
+	"DIMacroField_list_withsep",
+	"DIMacroField_list_withsep_opt",
+	"DIMacroField",
+	"DIMacroFile",
+	"DIMacroFileField_list_withsep",
+	"DIMacroFileField_list_withsep_opt",
+	"DIMacroFileField",
+	"DIModule",
+	"DIModuleField_list_withsep",
+	"DIModuleField_list_withsep_opt",
+	"DIModuleField",
+	"DINamespace",
+	"DINamespaceField_list_withsep",
+	"DINamespaceField_list_withsep_opt",
+	"DINamespaceField",
+	"DIObjCProperty",
+	"DIObjCPropertyField_list_withsep",
+	"DIObjCPropertyField_list_withsep_opt",
+	"DIObjCPropertyField",
+	"DISubprogram",
+	"DISubprogramField_list_withsep",
+	"DISubprogramField_list_withsep_opt",
+	"DISubprogramField",
+	"DISubrange",
+	"DISubrangeField_list_withsep",
+	"DISubrangeField_list_withsep_opt",
+	"DISubrangeField",
+	"DISubroutineType",
+	"DISubroutineTypeField_list_withsep",
+	"DISubroutineTypeField_list_withsep_opt",
+	"DISubroutineTypeField",
+	"DITemplateTypeParameter",
+	"DITemplateTypeParameterField_list_withsep",
+	"DITemplateTypeParameterField_list_withsep_opt",
+	"DITemplateTypeParameterField",
+	"DITemplateValueParameter",
+	"DITemplateValueParameterField_list_withsep",
+	"DITemplateValueParameterField_list_withsep_opt",
+	"DITemplateValueParameterField",
+	"GenericDINode",
+	"GenericDINodeField_list_withsep",
+	"GenericDINodeField_list_withsep_opt",
+	"GenericDINodeField",
+	"AlignField",
+	"AllocatedField",
+	"AnnotationsField",
+	"ArgField",
+	"AssociatedField",
+	"AttributesField",
+	"BaseTypeField",
+	"CCField",
+	"ChecksumField",
+	"ChecksumkindField",
+	"ColumnField",
+	"ConfigMacrosField",
+	"ContainingTypeField",
+	"CountField",
+	"DebugInfoForProfilingField",
+	"DeclarationField",
+	"DirectoryField",
+	"DiscriminatorField",
+	"DataLocationField",
+	"DefaultedField",
+	"DiscriminatorIntField",
+	"DwarfAddressSpaceField",
+	"DwoIdField",
+	"ElementsField",
+	"EmissionKindField",
+	"EncodingField",
+	"EntityField",
+	"EnumsField",
+	"ExportSymbolsField",
+	"ExprField",
+	"ExtraDataField",
+	"FileField",
+	"FilenameField",
+	"FlagsField",
+	"FlagsStringField",
+	"GetterField",
+	"GlobalsField",
+	"HeaderField",
+	"IdentifierField",
+	"ImportsField",
+	"IncludePathField",
+	"InlinedAtField",
+	"IsDeclField",
+	"IsDefinitionField",
+	"IsImplicitCodeField",
+	"IsLocalField",
+	"IsOptimizedField",
+	"IsUnsignedField",
+	"APINotesField",
+	"LanguageField",
+	"LineField",
+	"LinkageNameField",
+	"LowerBoundField",
+	"MacrosField",
+	"NameField",
+	"NameTableKindField",
+	"NodesField",
+	"OffsetField",
+	"OperandsField",
+	"ProducerField",
+	"RangesBaseAddressField",
+	"RankField",
+	"RetainedNodesField",
+	"RetainedTypesField",
+	"RuntimeLangField",
+	"RuntimeVersionField",
+	"ScopeField",
+	"ScopeLineField",
+	"SDKField",
+	"SetterField",
+	"SizeField",
+	"SourceField",
+	"SPFlagsField",
+	"SplitDebugFilenameField",
+	"SplitDebugInliningField",
+	"StrideField",
+	"StringLengthField",
+	"StringLengthExpressionField",
+	"StringLocationExpressionField",
+	"SysrootField",
+	"TagField",
+	"TemplateParamsField",
+	"ThisAdjustmentField",
+	"ThrownTypesField",
+	"TypeField",
+	"TypeMacinfoField",
+	"TypesField",
+	"UnitField",
+	"UpperBoundField",
+	"ValueField",
+	"ValueIntField",
+	"ValueStringField",
+	"VarField",
+	"VirtualIndexField",
+	"VirtualityField",
+	"VtableHolderField",
+	"MDFieldOrInt",
+	"ChecksumKind",
+	"DIFlag_list_withsep",
+	"DIFlags",
+	"DIFlag",
+	"DISPFlag_list_withsep",
+	"DISPFlags",
+	"DISPFlag",
+	"DwarfAttEncoding",
+	"DwarfAttEncodingOrUint",
+	"DwarfCC",
+	"DwarfLang",
+	"DwarfMacinfo",
+	"DwarfOp",
+	"DwarfTag",
+	"DwarfVirtuality",
+	"EmissionKind",
+	"NameTableKind",
+	"AddrSpace",
+	"Align",
+	"AlignPair",
+	"AlignStack",
+	"AlignStackPair",
+	"AllocSize",
+	"Arg_list_withsep",
+	"Args",
+	"Arg",
+	"ParamAttribute_optlist",
+	"Atomic",
+	"AtomicOrdering",
+	"AttrPair",
+	"AttrString",
+	"Byval",
+	"CallingConv",
+	"CallingConvEnum",
+	"CallingConvInt",
+	"Comdat",
+	"Dereferenceable",
+	"ElementType",
+	"DLLStorageClass",
+	"Ellipsis",
+	"Exact",
+	"ExceptionArg",
+	"ExceptionPad",
+	"FastMathFlag",
+	"FPred",
+	"FuncAttribute",
+	"FuncAttr",
+	"InBounds",
+	"InAlloca",
+	"IPred",
+	"Label",
+	"Linkage",
+	"ExternLinkage",
+	"OperandBundle",
+	"OverflowFlag",
+	"Param_list_withsep",
+	"Params",
+	"Param",
+	"ParamAttribute",
+	"ParamAttr",
+	"ByRefAttr",
+	"Partition",
+	"Preallocated",
+	"Preemption",
+	"StructRetAttr",
+	"ReturnAttribute",
+	"ReturnAttr",
+	"Section",
+	"SyncScope",
+	"ThreadLocal",
+	"TLSModel",
+	"TypeConst",
+	"TypeValue",
+	"UnnamedAddr",
+	"UnwindTarget",
+	"UnwindToCaller",
+	"Visibility",
+	"Volatile",
+	"VScaleRangetok",
+	"VScaleRange",
+	"Preemptionopt",
+	"Visibilityopt",
+	"DLLStorageClassopt",
+	"ThreadLocalopt",
+	"UnnamedAddropt",
+	"AddrSpaceopt",
+	"ExternallyInitializedopt",
+	"Linkageopt",
+	"CallingConvopt",
+	"Distinctopt",
+	"SideEffectopt",
+	"AlignStackTokopt",
+	"IntelDialectopt",
+	"Unwindopt",
+	"Exactopt",
+	"InBoundsopt",
+	"InRangeopt",
+	"LabelIdentopt",
+	"InAllocatokopt",
+	"SwiftErroropt",
+	"Volatileopt",
+	"SyncScopeopt",
+	"Weakopt",
+	"Tailopt",
+	"Cleanupopt",
+	"Ellipsisopt",
+}
+
+func symbolName(sym int32) string {
+	if sym < int32(NumTokens) {
+		return Token(sym).String()
+	}
+	if i := int(sym) - int(NumTokens); i < len(tmNonterminals) {
+		return tmNonterminals[i]
+	}
+	return fmt.Sprintf("nonterminal(%d)", sym)
+}
+
+var tmAction = []int32{
+	91, -3, -1, -1, -33, 90, 106, 107, 108, -1, -1, -1, 0, 1, 79, 80, 81, -1,
+	155, -59, -1, -1, -1, -1, -1, -1, -1, -1, 92, 94, 95, 96, 97, 98, 99, 100,
+	101, 102, 103, 104, 105, 87, 109, -1, -1, 78, -1, -243, 1377, 1378, 1379,
+	1430, 1431, 1386, 1387, 1380, 1381, 1382, 1383, 1384, 1385, 155, -429, -429,
+	1463, -591, -1, 216, 219, 221, 220, 223, 218, 231, 233, 224, -749, 232, 214,
+	222, 225, -1, -1, -1, 242, -1127, -1135, 202, 199, 200, 204, 205, 217, 212,
+	206, 207, 208, 213, 203, 209, 210, 211, -1, -1, -1263, -1, -1, -1, -1319,
+	110, 111, -1, -1, 153, 154, -1, -591, -591, 1456, 1457, 1458, 1465, -1381,
+	112, -1, 228, 85, -1, 236, -1533, 201, -1, -1, -1, -1, -1545, 1473, -1, 84,
+	86, 333, -1, -1, -1587, -1593, -1, -1, -1, -1, -1, -1, -1, 83, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1605, -1, -1, -1, -1, -1587, 333, -1, 271, 88,
+	-1, 285, -1, -1587, -1, -1, 333, -1, -1, -1, 333, 82, -1, -1587, -1, 284,
+	-1, -1, 283, -1, -1611, -1, -1651, 260, 244, 267, 268, 269, 270, 1450, 245,
+	243, 251, 252, 253, 254, 255, 256, 257, 258, 259, 261, 262, 263, 264, 265,
+	266, 289, 290, 291, 292, 293, 294, 295, 296, 297, 298, 299, 300, 301, 302,
+	303, 304, 305, 306, 307, 308, 309, 310, 311, 312, 313, 314, 315, 316, 317,
+	318, 319, 320, 321, 322, 323, 324, 325, 326, 327, 328, 329, -1, -1, 1477,
+	-1691, -1691, -1, -1, -1, 194, 1481, -1, 184, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, 688, 687, 686, 689, 695, 716, 696, 697, 698, 699, 700, 701, 702,
+	703, 704, 705, 706, 707, 708, 709, 710, 711, 712, 713, 714, 715, 717, 718,
+	719, 720, 721, 722, -1725, 156, -1381, -1381, 1268, 1269, 1467, -2017, -1,
+	-1, -1, 235, -1, -1, -1, -1, 1270, -2165, 1513, -2235, -1, 1392, 1393, 226,
+	-1, -1, -1, 1271, 1491, -1, 247, 1483, -2241, -1, -1, 279, 287, -1, -1, -1,
+	1284, 1285, 1286, 1287, 1288, 1289, 1290, 1291, 1292, 1293, 1294, 1295,
+	1296, 1297, 1298, 1299, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 1364, 1493,
+	-1, 1366, 1367, 1368, 1369, 1370, 1371, 1372, 1373, 1374, 1375, -1, -1, -1,
+	-1, -1, -1, 288, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -2251, -2379, -1, 277, 273, -1, -1, -1, -1, -1, -2387, -2387, 241,
+	-2417, 113, 116, 117, 118, 119, 120, 115, -2449, 192, 193, -1, -2457, -2473,
+	-2487, -2531, -2577, -2607, -2617, -2627, -2641, -2669, -2677, -2695, -2707,
+	-2719, -2729, -2751, -2765, -2777, -2789, -2809, -2819, -2837, -2857, -2905,
+	-2917, -2927, -2937, -2951, -2961, 2, 3, 43, 4, 5, 6, 7, 8, 9, 10, 11, 12,
+	13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31,
+	32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 44, 45, 46, 47, 48, 49, 50, 51,
+	52, 53, 54, 55, 56, 57, 58, 59, 60, 61, 62, 63, 64, 65, 66, 67, 68, 69, 70,
+	71, 72, 73, 74, 75, 76, 77, 1497, -3065, 178, 381, -2017, -2017, 1217, 1218,
+	1219, 1220, 1221, 1222, 1223, 1224, 1225, 1226, 1227, 1228, 1229, 1230,
+	1231, 1232, 1233, -1, 1234, 1235, 1236, 1237, 1238, 1239, 1240, 1241, 1242,
+	1243, 1244, 1245, 1246, 1247, 1248, 1249, 1250, 1251, 1252, 1253, 1254,
+	1255, 1256, 1257, 1258, 1259, 1260, 1261, 1479, 1215, 1216, 163, -1, -3361,
+	-3371, -1, 238, -1, -3383, -3393, -1, 215, 1389, 1390, -1, 332, -1, -1, -1,
+	248, 1485, -3457, -1, -1, -1, 356, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, 1449, -1, 278, 272, -3465, -1, 282, 196, -1, -1, -3591,
+	-3591, 190, -3615, -1, 186, 191, 1340, -1, -1, -1, 1311, 1312, 1313, 1314,
+	1315, 1316, 1317, 1318, 1319, 1320, 1321, 1322, 1323, 1324, 1325, 1326,
+	1327, 1328, 1329, 1330, 1331, 1332, 1333, 1334, 1335, 1336, 1337, 1338,
+	1339, 1341, 1342, 1343, 1344, -1, 1345, 1346, 1347, 1348, 1349, 1350, 1351,
+	1352, 1353, 1354, 1355, 1356, 1357, 1358, 1359, 1360, 1361, -3621, 1362,
+	1363, 182, 1302, -3929, 1303, 1304, 1305, 1306, 1301, 1300, 183, 1307, 1308,
+	1310, 1309, -1, -1, -1, -1, -1, -1, -4309, -1, 725, 731, 732, 733, 729, 730,
+	728, -1, -1, -1, -1, -4315, -1, 749, 753, 755, 756, 754, 752, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -4321, -1,
+	759, 777, 775, 769, 770, 763, 766, 772, 773, 765, 762, 774, 778, 764, 779,
+	771, 767, 781, 768, 776, 780, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -4327, -1, 784, 794, 805, 807, 804, 792, 802, 803, 797, 790, 796,
+	801, 791, 788, 795, 806, 798, 789, 793, 787, 800, 799, -1, -1, -4333, -1,
+	810, 820, 825, 818, 824, 823, 816, 822, 817, 814, 821, 815, 819, 813, -1,
+	-1, -4339, -1, 828, 833, 831, 832, 1168, 1177, 839, -4345, -1, 836, 840,
+	841, -1, -1, -1, -1, -1, -4351, -1, 844, 850, 849, 848, 847, 851, -1, -1,
+	-1, -1, -4357, -1, 854, 867, 868, 866, 860, 864, 863, 861, 859, 857, 858,
+	865, 862, -1, -1, -4363, -1, 871, 875, 874, -1, -4369, -1, 878, 887, 883,
+	884, 885, 886, 882, 881, -4375, -1, 890, 895, 896, 894, 893, -1, -4381, -1,
+	899, 905, 903, 904, 902, -1, -4387, -1, 908, 913, 912, 911, -1, -4393, -1,
+	916, 926, 927, 921, 922, 925, 923, 920, 919, 924, -1, -1, -4399, -1, 930,
+	934, 936, 937, 933, 935, -1, -1, -4405, -1, 940, 944, 945, 943, 946, -1,
+	-4411, -1, 949, 954, 953, 955, 952, -1, -1, -1, -1, -4417, -1, 958, 963,
+	966, 964, 968, 965, 967, 962, 961, -1, -4423, -1, 971, 976, 975, 974, -1,
+	-1, -1, -4429, -1, 979, 987, 983, 986, 984, 982, 985, 988, -1, -1, -1,
+	-4435, -1, 736, 745, 746, 740, 744, 741, 742, 743, 739, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -4441, -1, 991, 1015, 1003, 1012, 997, 1007, 1001, 1000,
+	1009, 998, 996, 995, 1013, 994, 1002, 1008, 1011, 1006, 1014, 999, 1010,
+	1005, 1004, -1, -1, -1, -1, -4447, -1, 1018, 1021, 1022, 1024, 1023, -1, -1,
+	-4453, -1, 1027, 1031, 1030, 1032, -1, -4459, -1, 1035, 1040, 1038, 1039,
+	-1, -4465, -1, 1043, 1049, 1047, 1046, 1048, 1050, -1, -1, -4471, -1, 1053,
+	1057, 1058, 1056, -1, -1, 682, 677, -4477, -1, 681, 673, 678, 680, 683, 684,
+	679, -1, 177, -4483, 163, 163, 1262, -1, 1186, 234, -1, 237, 229, -1, -1,
+	-1, -4623, -1, -1, -1, 1410, -1, 1411, 1412, 1413, 1414, 1415, 1416, 1417,
+	1418, 1419, 1420, 1421, -1, 1422, 1423, 1424, 1425, 1426, 1396, 1400, 1401,
+	1399, 1398, 1403, 1404, 1405, 1406, 1202, 1407, 1402, 1408, 1409, 1394,
+	1391, -1, -1, -1, -1, 249, 1487, -4807, -1, -1, -1, -1, -1, -1, -1, -1, 330,
+	-1, -1, -1, -1, -1, -1, -4813, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 276, 275, -1, -1, 197, -1,
+	-4823, -4823, -1, 189, -1, -1, -1, -1, -1, -1, -1, 1059, 1170, 1169, 1084,
+	1161, 1162, -4843, 1092, 1159, 1113, 1129, 1178, 1179, 1139, -1, 723, 1074,
+	1090, 1109, 1125, -1, 747, 1073, 1081, 1182, 1183, 1083, 1086, 1093, 1095,
+	1098, 1105, 1173, 1174, 1108, 1112, 1184, 1185, 1114, 1118, 1119, 1122,
+	1124, 1127, 1132, 1133, 1138, -1, 757, 1060, 1061, 1063, 1065, 1077, 1076,
+	1082, 1097, 1116, 1156, 1155, 1120, 1123, 1140, 1154, -1, 782, 1080, 1089,
+	-1, 808, 1106, 1149, -1, 826, -1, 834, 1067, 1157, 1068, 1075, 1091, 1130,
+	-1, 842, 1102, 1104, 1110, 1143, -1, 852, 1088, 1151, -1, 869, 1085, -1,
+	876, -1, 888, 1069, -1, 897, 1079, -1, 906, 1062, -1, 914, 1100, 1103, -1,
+	928, 1175, 1176, 1144, 1150, -1, 938, 1115, -1, 947, 1107, 1070, 1099, 1101,
+	-1, 956, 1087, -1, 969, 1064, 1094, 1128, -1, 977, 1135, 1136, 1137, -1,
+	734, 1071, 1121, 1126, 1166, 1167, -4851, 1131, 1164, 1141, 1142, 1146,
+	1152, 1180, 1181, 1153, -1, 989, 1072, 1111, 1134, 1147, -1, 1016, 1171,
+	1172, 1066, 1145, -1, 1025, 1078, -1, 1033, 1148, -1, 1041, 1096, -2961, -1,
+	1051, -4859, 685, -1, 676, 179, 180, 333, -1, -4899, -1, -4941, -4981, -1,
+	-1, -5011, -1, -1, -1, -1, -1, -5161, -1, -1, 442, 442, 442, -5203, 442,
+	442, -1, -1, -1, -1, -1, 442, 442, -5219, -1, -1, -1, -1, -1, -5011, -1,
+	-5259, -4941, 333, 598, 599, -1, 442, -1, -1, -1, -4941, 442, -1, 333, -1,
+	-1, -1, -5259, 333, -1, 600, -1, -4941, -1, -5301, -1, -1, -1, -1, -1, 380,
+	382, 383, 387, 388, 389, 390, 391, 392, 393, 394, 395, 396, 397, 398, 399,
+	400, 401, 402, 403, 404, 405, 406, 407, 408, 409, 410, 411, 412, 384, 385,
+	413, 414, 415, 416, 417, 418, 419, 420, 421, 422, 423, 424, 425, 426, 427,
+	428, 429, 430, 431, 432, 433, 434, 1509, 435, 436, 437, 438, 379, 619, 620,
+	621, 622, 623, 624, 625, 631, 632, 626, 633, 627, 628, 629, -1, -1, -1,
+	1436, 1437, 1438, 1439, 1440, 1441, -5599, 1435, 1433, 162, 1434, -3383, -1,
+	1187, -1, -1, -1, -1, -1, -1, -1, -1, -5609, -1, -1, 250, 1489, -1, -5609,
+	-1, -1, -1, 354, -1, -1, -1, -1, -5609, -5609, -5609, -5609, -1, -1, -1, -1,
+	-1, 356, -5609, -1, -1, -1, -5609, -1, -1, -5609, -1, -1, -5609, -1, -1,
+	-5609, -1, -5609, -1, -1, -5609, 274, 195, -1, -5619, 1469, -5639, -5639,
+	185, 1189, -1, 1191, -1, -5609, -1, 1211, -1, 724, 748, 758, 783, 809, 827,
+	835, 843, 853, 870, 877, 889, 898, 907, 915, 929, 939, 948, 957, 970, 978,
+	735, -1, 990, 1017, 1026, 1034, 1042, -1, 1052, -5657, -1, 692, 672, -1, -1,
+	507, 1499, -5663, -1, -1, 1459, 1503, -1, -1, -1, -1, -5703, 163, -1, -1,
+	-1, -1, -1, 531, 1507, -6001, -1, -1, -1, -1, -1, -1, 1505, -1, -1, -1, -1,
+	-1, -1, -1, -6041, -1, -1, -1, -1, -1, -1, -1, -1, 163, -6183, 1204, -6001,
+	-1, -1, -1, -1, -1, -1, -6337, -6635, -6763, -1, -1, -1, -1, -1, -1, -1,
+	-6001, -1, -1, -1, -1, -1, -1, -1, -7067, -1, -1, -1, -1, -7365, 442, -5599,
+	-5599, -1, 230, -1, -5609, -5609, -1, -1, -5609, -5609, -5609, -1, 375, 348,
+	-1, -1, 374, 286, 351, 355, 334, -1, 341, 338, 367, 369, 368, 366, 344, 336,
+	360, -1, -1, -1, 373, -1, -1, 349, 372, -1, -1, 365, -1, -1, 371, 343, -1,
+	363, -1, 370, 342, 350, 364, 198, -1, 1451, 1452, 1471, -7483, -7483, 1190,
+	-1, 1192, 1429, -1, 1461, 1158, 1163, 1117, -1, 690, -1, -1, 508, 1501, -1,
+	-1, -1, 536, 537, 538, 539, 540, 541, 542, 543, 544, 545, 546, 547, 548, -1,
+	-1, -1, 1376, -7497, -7795, -1, -1, 1275, 1274, -1, -1, -1, -1, -1, -1,
+	-7853, 1276, 1277, 1278, 1279, 1280, 1281, 1282, 1283, 441, -1, -1, -1, -1,
+	1205, 1206, 1207, 1208, 1209, 1210, -7995, -1, -8137, -1, -1, -1, -1, -8279,
+	-1, -1, -4813, -1, -1, -1, -1, -1, -7795, 607, 1511, 604, -1, -4813, -1, -1,
+	-1, -8421, -1, -8431, -8729, -9027, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, 134, -1, -1, -1, -1, -1, 386, 630, -9325, -1, -1, -1545,
+	1188, 1427, 1214, 1265, 1266, 1267, 1365, 1432, 331, 347, -1, 377, -1, 376,
+	-1, 357, 346, 337, 340, -1, 345, -1, 335, 339, 1446, 1447, 1448, -1, 146,
+	147, -1, -9491, -1, -9491, -1, -1, 691, -1, -9499, -9647, -9795, -1, -1,
+	-9937, -1, -1, -10085, -1, -1, -10085, -1, -1, -10125, 488, -1, -10267, -1,
+	-1, -1, -1, -10409, -1, -10551, -10693, -10841, -10989, -11137, -1, -1, -1,
+	-1, -11285, -1, -1, -11291, -1, -11439, -4813, -1, -1, -1, -11585, -1,
+	-11727, 584, -11869, -12017, -1, -1, -12315, -1, -1, -12463, -12611, -1, -1,
+	-1, -1, -12753, -1, -12901, 133, -13049, -13191, -13339, -13481, 163, -1545,
+	-1545, -1, 246, -13629, 358, 352, 378, 353, 1445, -4813, 139, 1475, -1,
+	-4813, -1, 1193, 1462, -13669, -13811, -1, -13953, -14095, -14237, -1,
+	-14379, -1, -14521, -6635, -14649, -14755, -1, 614, -14761, -15059, -1, 650,
+	-1, -1, 1454, -15065, 1453, -1, -15363, 487, -15505, -1, -15647, 1443,
+	-15789, -15931, -16073, -16215, -16357, -16499, -16641, 552, -16783, -16925,
+	-1, -1, -16931, -17073, -14521, 609, 610, -17215, 603, -1, -1, -17357,
+	-17499, -17641, -17783, -1, -1, -17925, -18067, -18209, -1, -18351, -18493,
+	-1, -18635, -18777, -1, -18919, -19061, 643, -19203, -19345, -19487, -19629,
+	-19771, -19913, -20055, -7795, -1, -1, -20197, 362, 359, 1495, -1, -1, 140,
+	141, -1, -1, -1, -20365, -20507, -20649, -20791, -20933, -5203, -1, -1,
+	1272, 1273, -1, -21075, -21217, -1, -1, -21515, 1455, -21657, -1, -21955,
+	-22097, -22239, -22381, -22523, -22665, -22807, -22949, -23091, -23389,
+	-23531, -1, 608, -5203, -1, -23673, -23815, -23957, -1, 583, -24099, -1,
+	-24241, -24383, -5203, -1, -24525, -24667, -1, -24809, -1, -20197, -20197,
+	-24951, 361, 145, 150, 152, 149, 151, 148, -25115, 145, -25269, -25393, -1,
+	-25535, -1, -25677, -1, -25819, -26117, 613, -26159, 649, -1, -26301, -1,
+	-26443, -1, -26585, -26727, -27025, -26117, -1, -27167, -1, -27309, -27451,
+	-1, -27593, -27735, 642, -1, -14521, -24951, -24951, 158, -28033, -28061,
+	-28209, 132, -28237, -28379, -28521, -28663, -28805, 1196, -29103, -14649,
+	-29287, -1, 1195, -29293, -5203, 551, -1, -29591, -29733, 587, -29875,
+	-30017, -30159, -30301, -1, -1, 158, 158, -30599, -1, -1, -30761, -30907,
+	122, -31055, -31203, -31345, -1, -31487, -1, -31629, -1, 1201, -1, 184,
+	-31771, -1, 184, -1, -32069, -1, -32211, 646, -26117, -32353, -32515, -1,
+	-32677, -1, -1, -1, -1, -1, -1, 157, 169, 170, 171, 172, 168, 167, 164, 166,
+	165, 144, 131, 138, 137, 136, 135, 121, -32843, -32989, -33135, -33283,
+	-33425, 1200, 1198, 1194, -1, -1, -1, -33567, -33709, -1, -1, 173, 1428,
+	176, 174, 175, 1442, -33851, -33997, -34139, -1, -1, -34281, -1, -1, -34423,
+	-34565, 184, -1, -1, -1, -1, 597, -1, -34707, -1, -1, -34849, 1264, -11285,
+	-4859, -1, -1, -35113, -1, -1, -1, -35255, -1, -1, 596, -1, -35397, -35539,
+	-1, -1, -35837, 1388, -1, -36135, -1, -36433, -36575, -11285, -1, -36873,
+	-1, -37015, -37313, -37611, -37909, -1, -2,
+}
+
+var tmLalr = []int32{
+	289, -1, 311, -1, 0, 93, 4, 93, 5, 93, 8, 93, 9, 93, 10, 93, 64, 93, 100,
+	93, 102, 93, 187, 93, 336, 93, 337, 93, -1, -2, 4, -1, 5, -1, 8, -1, 9, -1,
+	10, -1, 64, -1, 100, -1, 102, -1, 187, -1, 336, -1, 337, -1, 0, 89, -1, -2,
+	54, -1, 65, -1, 94, -1, 110, -1, 112, -1, 117, -1, 118, -1, 168, -1, 175,
+	-1, 176, -1, 251, -1, 346, -1, 347, -1, 5, 1464, 26, 1464, 28, 1464, 29,
+	1464, 37, 1464, 42, 1464, 43, 1464, 44, 1464, 45, 1464, 46, 1464, 47, 1464,
+	48, 1464, 49, 1464, 50, 1464, 53, 1464, 57, 1464, 58, 1464, 59, 1464, 66,
+	1464, 67, 1464, 68, 1464, 84, 1464, 85, 1464, 86, 1464, 92, 1464, 98, 1464,
+	101, 1464, 103, 1464, 104, 1464, 107, 1464, 108, 1464, 109, 1464, 125, 1464,
+	130, 1464, 133, 1464, 144, 1464, 146, 1464, 148, 1464, 149, 1464, 150, 1464,
+	163, 1464, 166, 1464, 172, 1464, 184, 1464, 189, 1464, 201, 1464, 214, 1464,
+	222, 1464, 246, 1464, 249, 1464, 250, 1464, 253, 1464, 254, 1464, 256, 1464,
+	257, 1464, 284, 1464, 292, 1464, 293, 1464, 303, 1464, 306, 1464, 310, 1464,
+	314, 1464, 342, 1464, 348, 1464, 350, 1464, 353, 1464, 354, 1464, 355, 1464,
+	356, 1464, 357, 1464, 358, 1464, 359, 1464, 360, 1464, 361, 1464, 365, 1464,
+	494, 1464, 496, 1464, 499, 1464, -1, -2, 9, -1, 54, -1, 65, -1, 94, -1, 110,
+	-1, 112, -1, 117, -1, 118, -1, 168, -1, 175, -1, 176, -1, 251, -1, 346, -1,
+	347, -1, 5, 1464, 26, 1464, 28, 1464, 29, 1464, 37, 1464, 42, 1464, 43,
+	1464, 44, 1464, 45, 1464, 46, 1464, 47, 1464, 48, 1464, 49, 1464, 50, 1464,
+	53, 1464, 57, 1464, 58, 1464, 59, 1464, 66, 1464, 67, 1464, 68, 1464, 84,
+	1464, 85, 1464, 86, 1464, 92, 1464, 98, 1464, 101, 1464, 103, 1464, 104,
+	1464, 107, 1464, 108, 1464, 109, 1464, 125, 1464, 130, 1464, 133, 1464, 144,
+	1464, 146, 1464, 148, 1464, 149, 1464, 150, 1464, 163, 1464, 166, 1464, 172,
+	1464, 184, 1464, 189, 1464, 201, 1464, 214, 1464, 222, 1464, 246, 1464, 249,
+	1464, 250, 1464, 253, 1464, 254, 1464, 256, 1464, 257, 1464, 284, 1464, 292,
+	1464, 293, 1464, 303, 1464, 306, 1464, 310, 1464, 314, 1464, 342, 1464, 348,
+	1464, 350, 1464, 353, 1464, 354, 1464, 355, 1464, 356, 1464, 357, 1464, 358,
+	1464, 359, 1464, 360, 1464, 361, 1464, 365, 1464, 494, 1464, 496, 1464, 499,
+	1464, -1, -2, 110, -1, 112, -1, 5, 1464, 26, 1464, 28, 1464, 29, 1464, 37,
+	1464, 42, 1464, 43, 1464, 44, 1464, 45, 1464, 46, 1464, 47, 1464, 48, 1464,
+	49, 1464, 50, 1464, 53, 1464, 57, 1464, 58, 1464, 59, 1464, 66, 1464, 67,
+	1464, 68, 1464, 84, 1464, 85, 1464, 86, 1464, 92, 1464, 98, 1464, 101, 1464,
+	103, 1464, 104, 1464, 107, 1464, 108, 1464, 109, 1464, 125, 1464, 130, 1464,
+	133, 1464, 144, 1464, 146, 1464, 148, 1464, 149, 1464, 150, 1464, 163, 1464,
+	166, 1464, 172, 1464, 184, 1464, 189, 1464, 201, 1464, 214, 1464, 222, 1464,
+	246, 1464, 249, 1464, 250, 1464, 253, 1464, 254, 1464, 256, 1464, 257, 1464,
+	284, 1464, 292, 1464, 293, 1464, 303, 1464, 306, 1464, 310, 1464, 314, 1464,
+	342, 1464, 348, 1464, 350, 1464, 353, 1464, 354, 1464, 355, 1464, 356, 1464,
+	357, 1464, 358, 1464, 359, 1464, 360, 1464, 361, 1464, 365, 1464, 494, 1464,
+	496, 1464, 499, 1464, -1, -2, 101, -1, 150, -1, 253, -1, 5, 1466, 26, 1466,
+	28, 1466, 29, 1466, 37, 1466, 42, 1466, 43, 1466, 44, 1466, 45, 1466, 46,
+	1466, 47, 1466, 48, 1466, 49, 1466, 50, 1466, 53, 1466, 57, 1466, 58, 1466,
+	59, 1466, 66, 1466, 67, 1466, 68, 1466, 84, 1466, 85, 1466, 86, 1466, 92,
+	1466, 98, 1466, 103, 1466, 104, 1466, 107, 1466, 108, 1466, 109, 1466, 125,
+	1466, 130, 1466, 133, 1466, 144, 1466, 146, 1466, 148, 1466, 149, 1466, 163,
+	1466, 166, 1466, 172, 1466, 184, 1466, 189, 1466, 201, 1466, 214, 1466, 222,
+	1466, 246, 1466, 249, 1466, 250, 1466, 254, 1466, 256, 1466, 257, 1466, 284,
+	1466, 292, 1466, 293, 1466, 303, 1466, 306, 1466, 310, 1466, 314, 1466, 342,
+	1466, 348, 1466, 350, 1466, 353, 1466, 354, 1466, 355, 1466, 356, 1466, 357,
+	1466, 358, 1466, 359, 1466, 360, 1466, 361, 1466, 365, 1466, 494, 1466, 496,
+	1466, 499, 1466, -1, -2, 33, -1, 0, 227, 4, 227, 5, 227, 7, 227, 8, 227, 9,
+	227, 10, 227, 23, 227, 24, 227, 25, 227, 27, 227, 32, 227, 34, 227, 37, 227,
+	38, 227, 39, 227, 40, 227, 41, 227, 51, 227, 56, 227, 60, 227, 61, 227, 63,
+	227, 64, 227, 69, 227, 70, 227, 72, 227, 73, 227, 74, 227, 75, 227, 76, 227,
+	77, 227, 78, 227, 80, 227, 81, 227, 82, 227, 83, 227, 87, 227, 88, 227, 89,
+	227, 90, 227, 91, 227, 97, 227, 100, 227, 102, 227, 103, 227, 104, 227, 105,
+	227, 111, 227, 113, 227, 120, 227, 121, 227, 122, 227, 123, 227, 126, 227,
+	127, 227, 128, 227, 129, 227, 131, 227, 132, 227, 134, 227, 135, 227, 136,
+	227, 137, 227, 138, 227, 139, 227, 141, 227, 143, 227, 151, 227, 152, 227,
+	154, 227, 155, 227, 156, 227, 157, 227, 159, 227, 161, 227, 163, 227, 164,
+	227, 165, 227, 169, 227, 170, 227, 171, 227, 173, 227, 177, 227, 181, 227,
+	186, 227, 187, 227, 190, 227, 192, 227, 193, 227, 194, 227, 197, 227, 200,
+	227, 201, 227, 202, 227, 203, 227, 204, 227, 205, 227, 207, 227, 208, 227,
+	209, 227, 210, 227, 211, 227, 212, 227, 213, 227, 214, 227, 215, 227, 216,
+	227, 217, 227, 218, 227, 219, 227, 220, 227, 221, 227, 222, 227, 225, 227,
+	226, 227, 235, 227, 236, 227, 237, 227, 238, 227, 244, 227, 245, 227, 247,
+	227, 255, 227, 258, 227, 259, 227, 262, 227, 263, 227, 264, 227, 265, 227,
+	266, 227, 268, 227, 269, 227, 270, 227, 271, 227, 272, 227, 273, 227, 275,
+	227, 277, 227, 280, 227, 281, 227, 282, 227, 284, 227, 286, 227, 290, 227,
+	291, 227, 294, 227, 295, 227, 296, 227, 297, 227, 298, 227, 299, 227, 300,
+	227, 301, 227, 302, 227, 304, 227, 305, 227, 307, 227, 309, 227, 316, 227,
+	317, 227, 319, 227, 323, 227, 328, 227, 333, 227, 335, 227, 336, 227, 337,
+	227, 338, 227, 339, 227, 345, 227, 349, 227, 352, 227, 364, 227, 365, 227,
+	366, 227, 367, 227, 489, 227, 492, 227, 493, 227, 494, 227, 495, 227, 496,
+	227, 497, 227, 498, 227, 499, 227, 501, 227, -1, -2, 33, -1, 492, -1, 498,
+	1474, -1, -2, 4, -1, 5, -1, 23, -1, 24, -1, 32, -1, 34, -1, 51, -1, 60, -1,
+	61, -1, 69, -1, 70, -1, 76, -1, 111, -1, 120, -1, 121, -1, 122, -1, 123, -1,
+	126, -1, 127, -1, 131, -1, 132, -1, 134, -1, 135, -1, 136, -1, 137, -1, 139,
+	-1, 141, -1, 143, -1, 152, -1, 164, -1, 165, -1, 169, -1, 181, -1, 190, -1,
+	200, -1, 212, -1, 225, -1, 238, -1, 245, -1, 255, -1, 273, -1, 275, -1, 277,
+	-1, 281, -1, 282, -1, 286, -1, 294, -1, 301, -1, 316, -1, 317, -1, 319, -1,
+	323, -1, 328, -1, 335, -1, 364, -1, 366, -1, 367, -1, 494, -1, 496, -1, 499,
+	-1, 33, 201, 492, 201, 498, 201, -1, -2, 54, -1, 65, -1, 94, -1, 117, -1,
+	118, -1, 168, -1, 175, -1, 176, -1, 251, -1, 346, -1, 347, -1, 33, 1478, 36,
+	1478, 95, 1478, 101, 1478, 107, 1478, 108, 1478, 110, 1478, 112, 1478, 119,
+	1478, 145, 1478, 150, 1478, 153, 1478, 178, 1478, 253, 1478, 312, 1478, 330,
+	1478, -1, -2, 106, -1, 369, 1482, 370, 1482, 371, 1482, 372, 1482, 373,
+	1482, 374, 1482, 375, 1482, 376, 1482, 377, 1482, 378, 1482, 379, 1482, 380,
+	1482, 381, 1482, 382, 1482, 383, 1482, 384, 1482, 385, 1482, 386, 1482, 387,
+	1482, 388, 1482, 389, 1482, 390, 1482, 391, 1482, 392, 1482, 393, 1482, 394,
+	1482, 395, 1482, 396, 1482, 490, 1482, -1, -2, 107, -1, 108, -1, 5, 1468,
+	26, 1468, 28, 1468, 29, 1468, 37, 1468, 42, 1468, 43, 1468, 44, 1468, 45,
+	1468, 46, 1468, 47, 1468, 48, 1468, 49, 1468, 50, 1468, 53, 1468, 57, 1468,
+	58, 1468, 59, 1468, 66, 1468, 67, 1468, 68, 1468, 84, 1468, 85, 1468, 86,
+	1468, 92, 1468, 98, 1468, 103, 1468, 104, 1468, 109, 1468, 125, 1468, 130,
+	1468, 133, 1468, 144, 1468, 146, 1468, 148, 1468, 149, 1468, 163, 1468, 166,
+	1468, 172, 1468, 184, 1468, 189, 1468, 201, 1468, 214, 1468, 222, 1468, 246,
+	1468, 249, 1468, 250, 1468, 254, 1468, 256, 1468, 257, 1468, 284, 1468, 292,
+	1468, 293, 1468, 303, 1468, 306, 1468, 310, 1468, 314, 1468, 342, 1468, 348,
+	1468, 350, 1468, 353, 1468, 354, 1468, 355, 1468, 356, 1468, 357, 1468, 358,
+	1468, 359, 1468, 360, 1468, 361, 1468, 365, 1468, 494, 1468, 496, 1468, 499,
+	1468, -1, -2, 33, -1, 492, -1, 489, 240, 497, 240, 498, 1474, -1, -2, 5, -1,
+	26, -1, 68, -1, 109, -1, 130, -1, 133, -1, 146, -1, 172, -1, 184, -1, 246,
+	-1, 254, -1, 314, -1, 342, -1, 355, -1, 357, -1, 491, -1, 494, -1, 496, -1,
+	499, -1, 493, 1514, -1, -2, 115, -1, 492, 1492, -1, -2, 283, -1, 25, 1484,
+	38, 1484, 167, 1484, 334, 1484, -1, -2, 158, -1, 492, 1494, -1, -2, 5, -1,
+	26, -1, 68, -1, 109, -1, 130, -1, 133, -1, 146, -1, 172, -1, 184, -1, 246,
+	-1, 254, -1, 314, -1, 342, -1, 355, -1, 357, -1, 494, -1, 496, -1, 499, -1,
+	495, 281, -1, -2, 5, -1, 26, -1, 68, -1, 109, -1, 130, -1, 133, -1, 146, -1,
+	172, -1, 184, -1, 246, -1, 254, -1, 314, -1, 342, -1, 355, -1, 357, -1, 494,
+	-1, 496, -1, 499, -1, 501, 281, -1, -2, 110, -1, 112, -1, 33, 1464, 36,
+	1464, 95, 1464, 101, 1464, 107, 1464, 108, 1464, 119, 1464, 145, 1464, 150,
+	1464, 153, 1464, 178, 1464, 253, 1464, 312, 1464, 330, 1464, -1, -2, 6, -1,
+	397, -1, 400, -1, 401, -1, 403, -1, 404, -1, 405, -1, 406, -1, 407, -1, 408,
+	-1, 409, -1, 410, -1, 411, -1, 414, -1, 415, -1, 417, -1, 418, -1, 419, -1,
+	420, -1, 421, -1, 422, -1, 423, -1, 424, -1, 425, -1, 426, -1, 427, -1, 428,
+	-1, 429, -1, 430, -1, 431, -1, 432, -1, 433, -1, 434, -1, 435, -1, 436, -1,
+	437, -1, 438, -1, 440, -1, 441, -1, 442, -1, 443, -1, 444, -1, 445, -1, 446,
+	-1, 447, -1, 448, -1, 449, -1, 450, -1, 451, -1, 452, -1, 453, -1, 454, -1,
+	455, -1, 458, -1, 459, -1, 460, -1, 461, -1, 462, -1, 463, -1, 465, -1, 466,
+	-1, 467, -1, 469, -1, 470, -1, 476, -1, 477, -1, 478, -1, 479, -1, 480, -1,
+	481, -1, 482, -1, 484, -1, 485, -1, 486, -1, 487, -1, 488, -1, 5, 1498, 32,
+	1498, 34, 1498, 39, 1498, 51, 1498, 60, 1498, 63, 1498, 69, 1498, 72, 1498,
+	77, 1498, 78, 1498, 81, 1498, 82, 1498, 83, 1498, 88, 1498, 89, 1498, 90,
+	1498, 120, 1498, 121, 1498, 122, 1498, 126, 1498, 127, 1498, 128, 1498, 131,
+	1498, 132, 1498, 134, 1498, 135, 1498, 136, 1498, 137, 1498, 138, 1498, 139,
+	1498, 141, 1498, 143, 1498, 152, 1498, 159, 1498, 164, 1498, 165, 1498, 169,
+	1498, 170, 1498, 173, 1498, 177, 1498, 181, 1498, 190, 1498, 193, 1498, 221,
+	1498, 238, 1498, 244, 1498, 255, 1498, 262, 1498, 263, 1498, 273, 1498, 275,
+	1498, 277, 1498, 281, 1498, 282, 1498, 286, 1498, 294, 1498, 299, 1498, 301,
+	1498, 307, 1498, 309, 1498, 317, 1498, 319, 1498, 323, 1498, 333, 1498, 335,
+	1498, 339, 1498, 364, 1498, 367, 1498, -1, -2, 28, -1, 29, -1, 42, -1, 43,
+	-1, 44, -1, 45, -1, 46, -1, 47, -1, 48, -1, 49, -1, 50, -1, 53, -1, 57, -1,
+	58, -1, 59, -1, 66, -1, 67, -1, 84, -1, 85, -1, 86, -1, 92, -1, 98, -1, 125,
+	-1, 144, -1, 148, -1, 149, -1, 166, -1, 189, -1, 249, -1, 250, -1, 256, -1,
+	257, -1, 292, -1, 293, -1, 303, -1, 306, -1, 310, -1, 348, -1, 350, -1, 353,
+	-1, 354, -1, 356, -1, 358, -1, 359, -1, 360, -1, 361, -1, 5, 1480, 26, 1480,
+	37, 1480, 68, 1480, 103, 1480, 104, 1480, 109, 1480, 130, 1480, 133, 1480,
+	146, 1480, 163, 1480, 172, 1480, 184, 1480, 201, 1480, 214, 1480, 222, 1480,
+	246, 1480, 254, 1480, 284, 1480, 314, 1480, 342, 1480, 355, 1480, 357, 1480,
+	365, 1480, 494, 1480, 496, 1480, 499, 1480, -1, -2, 33, -1, 492, -1, 5,
+	1203, 25, 1203, 37, 1203, 38, 1203, 74, 1203, 75, 1203, 103, 1203, 104,
+	1203, 113, 1203, 154, 1203, 157, 1203, 163, 1203, 197, 1203, 201, 1203, 204,
+	1203, 208, 1203, 214, 1203, 222, 1203, 247, 1203, 258, 1203, 259, 1203, 264,
+	1203, 284, 1203, 295, 1203, 302, 1203, 304, 1203, 305, 1203, 352, 1203, 365,
+	1203, 489, 1203, 493, 1203, 498, 1474, -1, -2, 489, -1, 493, 1395, -1, -2,
+	38, -1, 25, 1486, 167, 1486, 334, 1486, -1, -2, 4, -1, 23, -1, 24, -1, 32,
+	-1, 34, -1, 51, -1, 60, -1, 69, -1, 70, -1, 76, -1, 111, -1, 120, -1, 121,
+	-1, 122, -1, 123, -1, 126, -1, 127, -1, 131, -1, 132, -1, 134, -1, 135, -1,
+	136, -1, 137, -1, 139, -1, 141, -1, 143, -1, 152, -1, 164, -1, 165, -1, 169,
+	-1, 181, -1, 190, -1, 200, -1, 212, -1, 225, -1, 238, -1, 245, -1, 255, -1,
+	273, -1, 275, -1, 277, -1, 281, -1, 282, -1, 286, -1, 294, -1, 301, -1, 316,
+	-1, 317, -1, 319, -1, 323, -1, 328, -1, 335, -1, 364, -1, 366, -1, 367, -1,
+	494, -1, 496, -1, 499, -1, 33, 201, 489, 201, 492, 201, 497, 201, 498, 201,
+	-1, -2, 489, -1, 495, 280, 501, 280, -1, -2, 101, -1, 150, -1, 253, -1, 33,
+	1466, 36, 1466, 95, 1466, 107, 1466, 108, 1466, 119, 1466, 145, 1466, 153,
+	1466, 178, 1466, 312, 1466, 330, 1466, -1, -2, 33, -1, 492, -1, 0, 114, 4,
+	114, 5, 114, 8, 114, 9, 114, 10, 114, 64, 114, 100, 114, 102, 114, 187, 114,
+	336, 114, 337, 114, 498, 1474, -1, -2, 10, -1, 375, -1, 497, 188, -1, -2,
+	397, -1, 423, -1, 431, -1, 450, -1, 466, -1, 476, -1, 493, 727, -1, -2, 415,
+	-1, 429, -1, 446, -1, 450, -1, 462, -1, 493, 751, -1, -2, 414, -1, 420, -1,
+	422, -1, 425, -1, 429, -1, 431, -1, 433, -1, 436, -1, 443, -1, 445, -1, 449,
+	-1, 451, -1, 455, -1, 456, -1, 459, -1, 461, -1, 464, -1, 469, -1, 470, -1,
+	475, -1, 493, 761, -1, -2, 397, -1, 398, -1, 399, -1, 402, -1, 404, -1, 412,
+	-1, 418, -1, 421, -1, 429, -1, 431, -1, 435, -1, 446, -1, 450, -1, 453, -1,
+	457, -1, 460, -1, 462, -1, 466, -1, 476, -1, 477, -1, 488, -1, 493, 786, -1,
+	-2, 397, -1, 399, -1, 404, -1, 419, -1, 428, -1, 429, -1, 431, -1, 446, -1,
+	450, -1, 453, -1, 462, -1, 466, -1, 476, -1, 493, 812, -1, -2, 444, -1, 450,
+	-1, 484, -1, 493, 830, -1, -2, 12, -1, 20, -1, 23, -1, 493, 838, -1, -2,
+	406, -1, 407, -1, 417, -1, 430, -1, 467, -1, 493, 846, -1, -2, 397, -1, 399,
+	-1, 415, -1, 429, -1, 440, -1, 442, -1, 446, -1, 447, -1, 450, -1, 462, -1,
+	477, -1, 480, -1, 493, 856, -1, -2, 427, -1, 485, -1, 493, 873, -1, -2, 421,
+	-1, 424, -1, 429, -1, 446, -1, 450, -1, 462, -1, 476, -1, 493, 880, -1, -2,
+	429, -1, 446, -1, 450, -1, 462, -1, 493, 892, -1, -2, 408, -1, 429, -1, 446,
+	-1, 462, -1, 493, 901, -1, -2, 418, -1, 429, -1, 462, -1, 493, 910, -1, -2,
+	397, -1, 399, -1, 401, -1, 429, -1, 431, -1, 446, -1, 450, -1, 462, -1, 480,
+	-1, 493, 918, -1, -2, 408, -1, 438, -1, 441, -1, 446, -1, 462, -1, 493, 932,
+	-1, -2, 446, -1, 450, -1, 480, -1, 484, -1, 493, 942, -1, -2, 429, -1, 446,
+	-1, 452, -1, 480, -1, 493, 951, -1, -2, 400, -1, 409, -1, 429, -1, 437, -1,
+	439, -1, 446, -1, 450, -1, 462, -1, 493, 960, -1, -2, 426, -1, 450, -1, 462,
+	-1, 493, 973, -1, -2, 403, -1, 429, -1, 432, -1, 446, -1, 450, -1, 465, -1,
+	480, -1, 493, 981, -1, -2, 397, -1, 423, -1, 450, -1, 466, -1, 472, -1, 473,
+	-1, 474, -1, 476, -1, 493, 738, -1, -2, 399, -1, 410, -1, 415, -1, 429, -1,
+	431, -1, 440, -1, 442, -1, 443, -1, 446, -1, 447, -1, 450, -1, 458, -1, 462,
+	-1, 463, -1, 468, -1, 477, -1, 478, -1, 479, -1, 480, -1, 482, -1, 486, -1,
+	487, -1, 493, 993, -1, -2, 411, -1, 448, -1, 471, -1, 483, -1, 493, 1020,
+	-1, -2, 405, -1, 431, -1, 481, -1, 493, 1029, -1, -2, 416, -1, 450, -1, 480,
+	-1, 493, 1037, -1, -2, 416, -1, 450, -1, 476, -1, 480, -1, 484, -1, 493,
+	1045, -1, -2, 434, -1, 454, -1, 476, -1, 493, 1055, -1, -2, 5, -1, 10, -1,
+	26, -1, 68, -1, 109, -1, 130, -1, 133, -1, 146, -1, 172, -1, 184, -1, 225,
+	-1, 246, -1, 254, -1, 314, -1, 342, -1, 355, -1, 357, -1, 368, -1, 369, -1,
+	370, -1, 371, -1, 372, -1, 373, -1, 374, -1, 375, -1, 376, -1, 377, -1, 378,
+	-1, 379, -1, 380, -1, 381, -1, 382, -1, 383, -1, 384, -1, 385, -1, 386, -1,
+	387, -1, 388, -1, 389, -1, 390, -1, 391, -1, 392, -1, 393, -1, 394, -1, 395,
+	-1, 396, -1, 490, -1, 494, -1, 496, -1, 499, -1, 497, 675, -1, -2, 6, -1,
+	397, -1, 400, -1, 401, -1, 403, -1, 404, -1, 405, -1, 406, -1, 407, -1, 408,
+	-1, 409, -1, 410, -1, 411, -1, 414, -1, 415, -1, 417, -1, 418, -1, 419, -1,
+	420, -1, 421, -1, 422, -1, 423, -1, 424, -1, 425, -1, 426, -1, 427, -1, 428,
+	-1, 429, -1, 430, -1, 431, -1, 432, -1, 433, -1, 434, -1, 435, -1, 436, -1,
+	437, -1, 438, -1, 440, -1, 441, -1, 442, -1, 443, -1, 444, -1, 445, -1, 446,
+	-1, 447, -1, 448, -1, 449, -1, 450, -1, 451, -1, 452, -1, 453, -1, 454, -1,
+	455, -1, 458, -1, 459, -1, 460, -1, 461, -1, 462, -1, 463, -1, 465, -1, 466,
+	-1, 467, -1, 469, -1, 470, -1, 476, -1, 477, -1, 478, -1, 479, -1, 480, -1,
+	481, -1, 482, -1, 484, -1, 485, -1, 486, -1, 487, -1, 488, -1, 336, 181,
+	497, 181, 5, 1498, 32, 1498, 34, 1498, 39, 1498, 51, 1498, 60, 1498, 63,
+	1498, 69, 1498, 72, 1498, 77, 1498, 78, 1498, 81, 1498, 82, 1498, 83, 1498,
+	88, 1498, 89, 1498, 90, 1498, 120, 1498, 121, 1498, 122, 1498, 126, 1498,
+	127, 1498, 128, 1498, 131, 1498, 132, 1498, 134, 1498, 135, 1498, 136, 1498,
+	137, 1498, 138, 1498, 139, 1498, 141, 1498, 143, 1498, 152, 1498, 159, 1498,
+	164, 1498, 165, 1498, 169, 1498, 170, 1498, 173, 1498, 177, 1498, 181, 1498,
+	190, 1498, 193, 1498, 221, 1498, 238, 1498, 244, 1498, 255, 1498, 262, 1498,
+	263, 1498, 273, 1498, 275, 1498, 277, 1498, 281, 1498, 282, 1498, 286, 1498,
+	294, 1498, 299, 1498, 301, 1498, 307, 1498, 309, 1498, 317, 1498, 319, 1498,
+	323, 1498, 333, 1498, 335, 1498, 339, 1498, 364, 1498, 367, 1498, -1, -2,
+	33, -1, 492, -1, 495, -1, 498, 1474, -1, -2, 33, -1, 492, -1, 489, 239, 497,
+	239, 498, 1474, -1, -2, 33, -1, 492, -1, 501, -1, 498, 1474, -1, -2, 5, -1,
+	25, -1, 37, -1, 38, -1, 74, -1, 75, -1, 103, -1, 104, -1, 113, -1, 154, -1,
+	157, -1, 163, -1, 197, -1, 201, -1, 204, -1, 208, -1, 214, -1, 222, -1, 247,
+	-1, 258, -1, 259, -1, 264, -1, 284, -1, 295, -1, 302, -1, 304, -1, 305, -1,
+	352, -1, 365, -1, 489, 1397, 493, 1397, -1, -2, 167, -1, 25, 1488, 334,
+	1488, -1, -2, 501, -1, 4, 236, 23, 236, 24, 236, 32, 236, 33, 236, 34, 236,
+	51, 236, 60, 236, 69, 236, 70, 236, 76, 236, 111, 236, 120, 236, 121, 236,
+	122, 236, 123, 236, 126, 236, 127, 236, 131, 236, 132, 236, 134, 236, 135,
+	236, 136, 236, 137, 236, 139, 236, 141, 236, 143, 236, 152, 236, 164, 236,
+	165, 236, 169, 236, 181, 236, 190, 236, 200, 236, 212, 236, 225, 236, 238,
+	236, 245, 236, 255, 236, 273, 236, 275, 236, 277, 236, 281, 236, 282, 236,
+	286, 236, 294, 236, 301, 236, 316, 236, 317, 236, 319, 236, 323, 236, 328,
+	236, 335, 236, 364, 236, 366, 236, 367, 236, 492, 236, 494, 236, 496, 236,
+	498, 236, 499, 236, -1, -2, 107, -1, 108, -1, 33, 1468, 36, 1468, 95, 1468,
+	119, 1468, 145, 1468, 153, 1468, 178, 1468, 312, 1468, 330, 1468, -1, -2,
+	489, -1, 497, 187, -1, -2, 492, -1, 0, 1460, 4, 1460, 5, 1460, 7, 1460, 8,
+	1460, 9, 1460, 10, 1460, 25, 1460, 27, 1460, 32, 1460, 34, 1460, 37, 1460,
+	38, 1460, 39, 1460, 40, 1460, 41, 1460, 51, 1460, 56, 1460, 60, 1460, 63,
+	1460, 64, 1460, 69, 1460, 72, 1460, 73, 1460, 77, 1460, 78, 1460, 81, 1460,
+	82, 1460, 83, 1460, 88, 1460, 89, 1460, 90, 1460, 91, 1460, 93, 1460, 97,
+	1460, 100, 1460, 102, 1460, 105, 1460, 120, 1460, 121, 1460, 122, 1460, 126,
+	1460, 127, 1460, 128, 1460, 131, 1460, 132, 1460, 134, 1460, 135, 1460, 136,
+	1460, 137, 1460, 138, 1460, 139, 1460, 141, 1460, 142, 1460, 143, 1460, 151,
+	1460, 152, 1460, 155, 1460, 156, 1460, 159, 1460, 161, 1460, 164, 1460, 165,
+	1460, 169, 1460, 170, 1460, 171, 1460, 173, 1460, 177, 1460, 181, 1460, 186,
+	1460, 187, 1460, 190, 1460, 192, 1460, 193, 1460, 194, 1460, 202, 1460, 203,
+	1460, 205, 1460, 207, 1460, 208, 1460, 209, 1460, 210, 1460, 211, 1460, 213,
+	1460, 215, 1460, 216, 1460, 217, 1460, 218, 1460, 219, 1460, 220, 1460, 221,
+	1460, 226, 1460, 235, 1460, 236, 1460, 237, 1460, 238, 1460, 242, 1460, 243,
+	1460, 244, 1460, 247, 1460, 248, 1460, 252, 1460, 255, 1460, 258, 1460, 259,
+	1460, 262, 1460, 263, 1460, 265, 1460, 266, 1460, 268, 1460, 269, 1460, 270,
+	1460, 271, 1460, 272, 1460, 273, 1460, 274, 1460, 275, 1460, 277, 1460, 280,
+	1460, 281, 1460, 282, 1460, 286, 1460, 290, 1460, 291, 1460, 294, 1460, 296,
+	1460, 297, 1460, 298, 1460, 299, 1460, 300, 1460, 301, 1460, 307, 1460, 309,
+	1460, 313, 1460, 317, 1460, 319, 1460, 323, 1460, 333, 1460, 335, 1460, 336,
+	1460, 337, 1460, 338, 1460, 339, 1460, 345, 1460, 349, 1460, 352, 1460, 364,
+	1460, 367, 1460, 489, 1460, 494, 1460, 496, 1460, 497, 1460, -1, -2, 500,
+	-1, 0, 1212, 4, 1212, 5, 1212, 7, 1212, 8, 1212, 9, 1212, 10, 1212, 23,
+	1212, 24, 1212, 25, 1212, 27, 1212, 32, 1212, 34, 1212, 37, 1212, 38, 1212,
+	39, 1212, 40, 1212, 41, 1212, 51, 1212, 56, 1212, 60, 1212, 61, 1212, 63,
+	1212, 64, 1212, 69, 1212, 70, 1212, 72, 1212, 73, 1212, 74, 1212, 75, 1212,
+	76, 1212, 77, 1212, 78, 1212, 81, 1212, 82, 1212, 83, 1212, 88, 1212, 89,
+	1212, 90, 1212, 91, 1212, 93, 1212, 97, 1212, 100, 1212, 102, 1212, 103,
+	1212, 104, 1212, 105, 1212, 111, 1212, 113, 1212, 120, 1212, 121, 1212, 122,
+	1212, 123, 1212, 126, 1212, 127, 1212, 128, 1212, 131, 1212, 132, 1212, 134,
+	1212, 135, 1212, 136, 1212, 137, 1212, 138, 1212, 139, 1212, 141, 1212, 142,
+	1212, 143, 1212, 151, 1212, 152, 1212, 154, 1212, 155, 1212, 156, 1212, 157,
+	1212, 159, 1212, 161, 1212, 163, 1212, 164, 1212, 165, 1212, 169, 1212, 170,
+	1212, 171, 1212, 173, 1212, 177, 1212, 181, 1212, 186, 1212, 187, 1212, 190,
+	1212, 192, 1212, 193, 1212, 194, 1212, 197, 1212, 200, 1212, 201, 1212, 202,
+	1212, 203, 1212, 204, 1212, 205, 1212, 207, 1212, 208, 1212, 209, 1212, 210,
+	1212, 211, 1212, 212, 1212, 213, 1212, 214, 1212, 215, 1212, 216, 1212, 217,
+	1212, 218, 1212, 219, 1212, 220, 1212, 221, 1212, 222, 1212, 225, 1212, 226,
+	1212, 235, 1212, 236, 1212, 237, 1212, 238, 1212, 242, 1212, 243, 1212, 244,
+	1212, 245, 1212, 247, 1212, 248, 1212, 252, 1212, 255, 1212, 258, 1212, 259,
+	1212, 262, 1212, 263, 1212, 264, 1212, 265, 1212, 266, 1212, 268, 1212, 269,
+	1212, 270, 1212, 271, 1212, 272, 1212, 273, 1212, 274, 1212, 275, 1212, 277,
+	1212, 280, 1212, 281, 1212, 282, 1212, 284, 1212, 286, 1212, 290, 1212, 291,
+	1212, 294, 1212, 295, 1212, 296, 1212, 297, 1212, 298, 1212, 299, 1212, 300,
+	1212, 301, 1212, 302, 1212, 304, 1212, 305, 1212, 307, 1212, 309, 1212, 313,
+	1212, 316, 1212, 317, 1212, 319, 1212, 323, 1212, 328, 1212, 333, 1212, 335,
+	1212, 336, 1212, 337, 1212, 338, 1212, 339, 1212, 345, 1212, 349, 1212, 352,
+	1212, 364, 1212, 365, 1212, 366, 1212, 367, 1212, 489, 1212, 493, 1212, 494,
+	1212, 496, 1212, 497, 1212, 499, 1212, -1, -2, 489, -1, 493, 726, -1, -2,
+	489, -1, 493, 750, -1, -2, 489, -1, 493, 760, -1, -2, 489, -1, 493, 785, -1,
+	-2, 489, -1, 493, 811, -1, -2, 489, -1, 493, 829, -1, -2, 489, -1, 493, 837,
+	-1, -2, 489, -1, 493, 845, -1, -2, 489, -1, 493, 855, -1, -2, 489, -1, 493,
+	872, -1, -2, 489, -1, 493, 879, -1, -2, 489, -1, 493, 891, -1, -2, 489, -1,
+	493, 900, -1, -2, 489, -1, 493, 909, -1, -2, 489, -1, 493, 917, -1, -2, 489,
+	-1, 493, 931, -1, -2, 489, -1, 493, 941, -1, -2, 489, -1, 493, 950, -1, -2,
+	489, -1, 493, 959, -1, -2, 489, -1, 493, 972, -1, -2, 489, -1, 493, 980, -1,
+	-2, 489, -1, 493, 737, -1, -2, 489, -1, 493, 992, -1, -2, 489, -1, 493,
+	1019, -1, -2, 489, -1, 493, 1028, -1, -2, 489, -1, 493, 1036, -1, -2, 489,
+	-1, 493, 1044, -1, -2, 489, -1, 493, 1054, -1, -2, 489, -1, 497, 674, -1,
+	-2, 5, -1, 32, -1, 34, -1, 39, -1, 51, -1, 60, -1, 63, -1, 69, -1, 72, -1,
+	78, -1, 81, -1, 82, -1, 83, -1, 88, -1, 89, -1, 90, -1, 120, -1, 121, -1,
+	122, -1, 126, -1, 127, -1, 128, -1, 131, -1, 132, -1, 134, -1, 135, -1, 136,
+	-1, 137, -1, 138, -1, 139, -1, 141, -1, 143, -1, 152, -1, 159, -1, 164, -1,
+	165, -1, 169, -1, 170, -1, 173, -1, 177, -1, 181, -1, 190, -1, 193, -1, 221,
+	-1, 238, -1, 244, -1, 255, -1, 262, -1, 263, -1, 273, -1, 275, -1, 277, -1,
+	281, -1, 282, -1, 286, -1, 294, -1, 299, -1, 301, -1, 307, -1, 309, -1, 317,
+	-1, 319, -1, 323, -1, 333, -1, 335, -1, 339, -1, 364, -1, 367, -1, 77, 1510,
+	-1, -2, 492, -1, 4, 1213, 5, 1213, 23, 1213, 24, 1213, 25, 1213, 32, 1213,
+	34, 1213, 37, 1213, 38, 1213, 51, 1213, 60, 1213, 61, 1213, 69, 1213, 70,
+	1213, 74, 1213, 75, 1213, 76, 1213, 103, 1213, 104, 1213, 111, 1213, 113,
+	1213, 120, 1213, 121, 1213, 122, 1213, 123, 1213, 126, 1213, 127, 1213, 131,
+	1213, 132, 1213, 134, 1213, 135, 1213, 136, 1213, 137, 1213, 139, 1213, 141,
+	1213, 143, 1213, 152, 1213, 154, 1213, 157, 1213, 163, 1213, 164, 1213, 165,
+	1213, 169, 1213, 181, 1213, 190, 1213, 197, 1213, 200, 1213, 201, 1213, 204,
+	1213, 208, 1213, 212, 1213, 214, 1213, 222, 1213, 225, 1213, 238, 1213, 245,
+	1213, 247, 1213, 255, 1213, 258, 1213, 259, 1213, 264, 1213, 273, 1213, 275,
+	1213, 277, 1213, 281, 1213, 282, 1213, 284, 1213, 286, 1213, 294, 1213, 295,
+	1213, 301, 1213, 302, 1213, 304, 1213, 305, 1213, 316, 1213, 317, 1213, 319,
+	1213, 323, 1213, 328, 1213, 335, 1213, 352, 1213, 364, 1213, 365, 1213, 366,
+	1213, 367, 1213, 489, 1213, 493, 1213, 494, 1213, 496, 1213, 499, 1213, -1,
+	-2, 334, -1, 25, 1490, -1, -2, 33, -1, 489, -1, 492, -1, 498, 1474, -1, -2,
+	312, -1, 33, 1470, 36, 1470, 95, 1470, 119, 1470, 145, 1470, 153, 1470, 178,
+	1470, 330, 1470, -1, -2, 502, -1, 489, 1160, 493, 1160, -1, -2, 502, -1,
+	489, 1165, 493, 1165, -1, -2, 5, -1, 26, -1, 68, -1, 109, -1, 130, -1, 133,
+	-1, 146, -1, 172, -1, 184, -1, 246, -1, 254, -1, 314, -1, 342, -1, 355, -1,
+	357, -1, 494, -1, 496, -1, 499, -1, 493, 694, -1, -2, 157, -1, 5, 1500, 26,
+	1500, 68, 1500, 109, 1500, 130, 1500, 133, 1500, 146, 1500, 172, 1500, 184,
+	1500, 246, 1500, 254, 1500, 304, 1500, 314, 1500, 342, 1500, 355, 1500, 357,
+	1500, 494, 1500, 496, 1500, 499, 1500, -1, -2, 115, -1, 5, 1492, 26, 1492,
+	68, 1492, 109, 1492, 130, 1492, 133, 1492, 146, 1492, 172, 1492, 184, 1492,
+	246, 1492, 254, 1492, 314, 1492, 342, 1492, 355, 1492, 357, 1492, 494, 1492,
+	496, 1492, 499, 1492, -1, -2, 343, -1, 32, 1504, 51, 1504, 122, 1504, 141,
+	1504, 182, 1504, 185, 1504, 195, 1504, 238, 1504, 301, 1504, 326, 1504, 327,
+	1504, 363, 1504, 364, 1504, -1, -2, 28, -1, 29, -1, 42, -1, 43, -1, 44, -1,
+	45, -1, 46, -1, 47, -1, 48, -1, 49, -1, 50, -1, 53, -1, 57, -1, 58, -1, 59,
+	-1, 66, -1, 67, -1, 84, -1, 85, -1, 86, -1, 92, -1, 98, -1, 125, -1, 144,
+	-1, 148, -1, 149, -1, 166, -1, 189, -1, 249, -1, 250, -1, 256, -1, 257, -1,
+	292, -1, 293, -1, 303, -1, 306, -1, 310, -1, 348, -1, 350, -1, 353, -1, 354,
+	-1, 356, -1, 358, -1, 359, -1, 360, -1, 361, -1, 5, 1480, 26, 1480, 33,
+	1480, 37, 1480, 68, 1480, 103, 1480, 104, 1480, 109, 1480, 130, 1480, 133,
+	1480, 146, 1480, 163, 1480, 172, 1480, 184, 1480, 201, 1480, 214, 1480, 222,
+	1480, 246, 1480, 254, 1480, 284, 1480, 314, 1480, 342, 1480, 355, 1480, 357,
+	1480, 365, 1480, 494, 1480, 496, 1480, 499, 1480, -1, -2, 346, -1, 5, 1508,
+	26, 1508, 68, 1508, 109, 1508, 130, 1508, 133, 1508, 146, 1508, 172, 1508,
+	184, 1508, 246, 1508, 254, 1508, 314, 1508, 342, 1508, 343, 1508, 355, 1508,
+	357, 1508, 494, 1508, 496, 1508, 499, 1508, -1, -2, 308, -1, 30, 1506, 31,
+	1506, 188, 1506, 261, 1506, 276, 1506, 332, 1506, -1, -2, 158, -1, 5, 1494,
+	26, 1494, 68, 1494, 109, 1494, 130, 1494, 133, 1494, 146, 1494, 172, 1494,
+	184, 1494, 246, 1494, 254, 1494, 314, 1494, 342, 1494, 355, 1494, 357, 1494,
+	494, 1494, 496, 1494, 499, 1494, -1, -2, 62, -1, 343, -1, 5, 1504, 26, 1504,
+	68, 1504, 109, 1504, 130, 1504, 133, 1504, 146, 1504, 172, 1504, 184, 1504,
+	246, 1504, 254, 1504, 314, 1504, 342, 1504, 355, 1504, 357, 1504, 494, 1504,
+	496, 1504, 499, 1504, -1, -2, 489, -1, 5, 671, 6, 671, 32, 671, 34, 671, 39,
+	671, 51, 671, 60, 671, 63, 671, 69, 671, 72, 671, 77, 671, 78, 671, 81, 671,
+	82, 671, 83, 671, 88, 671, 89, 671, 90, 671, 120, 671, 121, 671, 122, 671,
+	126, 671, 127, 671, 128, 671, 131, 671, 132, 671, 134, 671, 135, 671, 136,
+	671, 137, 671, 138, 671, 139, 671, 141, 671, 143, 671, 152, 671, 159, 671,
+	164, 671, 165, 671, 169, 671, 170, 671, 173, 671, 177, 671, 181, 671, 190,
+	671, 193, 671, 221, 671, 238, 671, 244, 671, 255, 671, 262, 671, 263, 671,
+	273, 671, 275, 671, 277, 671, 281, 671, 282, 671, 286, 671, 294, 671, 299,
+	671, 301, 671, 307, 671, 309, 671, 317, 671, 319, 671, 323, 671, 333, 671,
+	335, 671, 336, 671, 339, 671, 364, 671, 367, 671, 397, 671, 400, 671, 401,
+	671, 403, 671, 404, 671, 405, 671, 406, 671, 407, 671, 408, 671, 409, 671,
+	410, 671, 411, 671, 414, 671, 415, 671, 417, 671, 418, 671, 419, 671, 420,
+	671, 421, 671, 422, 671, 423, 671, 424, 671, 425, 671, 426, 671, 427, 671,
+	428, 671, 429, 671, 430, 671, 431, 671, 432, 671, 433, 671, 434, 671, 435,
+	671, 436, 671, 437, 671, 438, 671, 440, 671, 441, 671, 442, 671, 443, 671,
+	444, 671, 445, 671, 446, 671, 447, 671, 448, 671, 449, 671, 450, 671, 451,
+	671, 452, 671, 453, 671, 454, 671, 455, 671, 458, 671, 459, 671, 460, 671,
+	461, 671, 462, 671, 463, 671, 465, 671, 466, 671, 467, 671, 469, 671, 470,
+	671, 476, 671, 477, 671, 478, 671, 479, 671, 480, 671, 481, 671, 482, 671,
+	484, 671, 485, 671, 486, 671, 487, 671, 488, 671, 497, 671, -1, -2, 4, -1,
+	33, -1, 492, -1, 498, 1474, -1, -2, 33, -1, 492, -1, 493, -1, 498, 1474, -1,
+	-2, 492, -1, 33, 1444, 36, 1444, 95, 1444, 119, 1444, 145, 1444, 153, 1444,
+	178, 1444, 330, 1444, -1, -2, 178, -1, 330, -1, 33, 1472, 36, 1472, 95,
+	1472, 119, 1472, 145, 1472, 153, 1472, -1, -2, 489, -1, 493, 693, -1, -2,
+	304, -1, 5, 1502, 26, 1502, 68, 1502, 109, 1502, 130, 1502, 133, 1502, 146,
+	1502, 172, 1502, 184, 1502, 246, 1502, 254, 1502, 314, 1502, 342, 1502, 355,
+	1502, 357, 1502, 494, 1502, 496, 1502, 499, 1502, -1, -2, 489, -1, 5, 639,
+	6, 639, 32, 639, 34, 639, 39, 639, 51, 639, 60, 639, 63, 639, 69, 639, 72,
+	639, 77, 639, 78, 639, 81, 639, 82, 639, 83, 639, 88, 639, 89, 639, 90, 639,
+	120, 639, 121, 639, 122, 639, 126, 639, 127, 639, 128, 639, 131, 639, 132,
+	639, 134, 639, 135, 639, 136, 639, 137, 639, 138, 639, 139, 639, 141, 639,
+	143, 639, 152, 639, 159, 639, 164, 639, 165, 639, 169, 639, 170, 639, 173,
+	639, 177, 639, 181, 639, 190, 639, 193, 639, 221, 639, 238, 639, 244, 639,
+	255, 639, 262, 639, 263, 639, 273, 639, 275, 639, 277, 639, 281, 639, 282,
+	639, 286, 639, 294, 639, 299, 639, 301, 639, 307, 639, 309, 639, 317, 639,
+	319, 639, 323, 639, 333, 639, 335, 639, 336, 639, 339, 639, 364, 639, 367,
+	639, 397, 639, 400, 639, 401, 639, 403, 639, 404, 639, 405, 639, 406, 639,
+	407, 639, 408, 639, 409, 639, 410, 639, 411, 639, 414, 639, 415, 639, 417,
+	639, 418, 639, 419, 639, 420, 639, 421, 639, 422, 639, 423, 639, 424, 639,
+	425, 639, 426, 639, 427, 639, 428, 639, 429, 639, 430, 639, 431, 639, 432,
+	639, 433, 639, 434, 639, 435, 639, 436, 639, 437, 639, 438, 639, 440, 639,
+	441, 639, 442, 639, 443, 639, 444, 639, 445, 639, 446, 639, 447, 639, 448,
+	639, 449, 639, 450, 639, 451, 639, 452, 639, 453, 639, 454, 639, 455, 639,
+	458, 639, 459, 639, 460, 639, 461, 639, 462, 639, 463, 639, 465, 639, 466,
+	639, 467, 639, 469, 639, 470, 639, 476, 639, 477, 639, 478, 639, 479, 639,
+	480, 639, 481, 639, 482, 639, 484, 639, 485, 639, 486, 639, 487, 639, 488,
+	639, 497, 639, -1, -2, 343, -1, 5, 1504, 26, 1504, 68, 1504, 109, 1504, 130,
+	1504, 133, 1504, 146, 1504, 172, 1504, 184, 1504, 246, 1504, 254, 1504, 314,
+	1504, 342, 1504, 355, 1504, 357, 1504, 494, 1504, 496, 1504, 499, 1504, -1,
+	-2, 489, -1, 5, 591, 32, 591, 34, 591, 39, 591, 51, 591, 60, 591, 63, 591,
+	69, 591, 72, 591, 77, 591, 78, 591, 81, 591, 82, 591, 83, 591, 88, 591, 89,
+	591, 90, 591, 120, 591, 121, 591, 122, 591, 126, 591, 127, 591, 128, 591,
+	131, 591, 132, 591, 134, 591, 135, 591, 136, 591, 137, 591, 138, 591, 139,
+	591, 141, 591, 143, 591, 152, 591, 159, 591, 164, 591, 165, 591, 169, 591,
+	170, 591, 173, 591, 177, 591, 181, 591, 190, 591, 193, 591, 221, 591, 238,
+	591, 244, 591, 255, 591, 262, 591, 263, 591, 273, 591, 275, 591, 277, 591,
+	281, 591, 282, 591, 286, 591, 294, 591, 299, 591, 301, 591, 307, 591, 309,
+	591, 317, 591, 319, 591, 323, 591, 333, 591, 335, 591, 339, 591, 364, 591,
+	367, 591, -1, -2, 33, -1, 87, -1, 492, -1, 498, 1474, 5, 1512, 32, 1512, 34,
+	1512, 39, 1512, 51, 1512, 60, 1512, 63, 1512, 69, 1512, 72, 1512, 77, 1512,
+	78, 1512, 80, 1512, 81, 1512, 82, 1512, 83, 1512, 88, 1512, 89, 1512, 90,
+	1512, 120, 1512, 121, 1512, 122, 1512, 126, 1512, 127, 1512, 128, 1512, 129,
+	1512, 131, 1512, 132, 1512, 134, 1512, 135, 1512, 136, 1512, 137, 1512, 138,
+	1512, 139, 1512, 141, 1512, 143, 1512, 152, 1512, 159, 1512, 164, 1512, 165,
+	1512, 169, 1512, 170, 1512, 173, 1512, 177, 1512, 181, 1512, 190, 1512, 193,
+	1512, 221, 1512, 238, 1512, 244, 1512, 255, 1512, 262, 1512, 263, 1512, 273,
+	1512, 275, 1512, 277, 1512, 281, 1512, 282, 1512, 286, 1512, 294, 1512, 299,
+	1512, 301, 1512, 307, 1512, 309, 1512, 317, 1512, 319, 1512, 323, 1512, 333,
+	1512, 335, 1512, 339, 1512, 364, 1512, 367, 1512, 489, 1512, -1, -2, 489,
+	-1, 5, 662, 6, 662, 32, 662, 34, 662, 39, 662, 51, 662, 60, 662, 63, 662,
+	69, 662, 72, 662, 77, 662, 78, 662, 81, 662, 82, 662, 83, 662, 88, 662, 89,
+	662, 90, 662, 120, 662, 121, 662, 122, 662, 126, 662, 127, 662, 128, 662,
+	131, 662, 132, 662, 134, 662, 135, 662, 136, 662, 137, 662, 138, 662, 139,
+	662, 141, 662, 143, 662, 152, 662, 159, 662, 164, 662, 165, 662, 169, 662,
+	170, 662, 173, 662, 177, 662, 181, 662, 190, 662, 193, 662, 221, 662, 238,
+	662, 244, 662, 255, 662, 262, 662, 263, 662, 273, 662, 275, 662, 277, 662,
+	281, 662, 282, 662, 286, 662, 294, 662, 299, 662, 301, 662, 307, 662, 309,
+	662, 317, 662, 319, 662, 323, 662, 333, 662, 335, 662, 336, 662, 339, 662,
+	364, 662, 367, 662, 397, 662, 400, 662, 401, 662, 403, 662, 404, 662, 405,
+	662, 406, 662, 407, 662, 408, 662, 409, 662, 410, 662, 411, 662, 414, 662,
+	415, 662, 417, 662, 418, 662, 419, 662, 420, 662, 421, 662, 422, 662, 423,
+	662, 424, 662, 425, 662, 426, 662, 427, 662, 428, 662, 429, 662, 430, 662,
+	431, 662, 432, 662, 433, 662, 434, 662, 435, 662, 436, 662, 437, 662, 438,
+	662, 440, 662, 441, 662, 442, 662, 443, 662, 444, 662, 445, 662, 446, 662,
+	447, 662, 448, 662, 449, 662, 450, 662, 451, 662, 452, 662, 453, 662, 454,
+	662, 455, 662, 458, 662, 459, 662, 460, 662, 461, 662, 462, 662, 463, 662,
+	465, 662, 466, 662, 467, 662, 469, 662, 470, 662, 476, 662, 477, 662, 478,
+	662, 479, 662, 480, 662, 481, 662, 482, 662, 484, 662, 485, 662, 486, 662,
+	487, 662, 488, 662, 497, 662, -1, -2, 4, -1, 5, -1, 23, -1, 24, -1, 32, -1,
+	34, -1, 51, -1, 60, -1, 61, -1, 69, -1, 70, -1, 76, -1, 111, -1, 120, -1,
+	121, -1, 122, -1, 123, -1, 126, -1, 127, -1, 131, -1, 132, -1, 134, -1, 135,
+	-1, 136, -1, 137, -1, 139, -1, 141, -1, 143, -1, 152, -1, 164, -1, 165, -1,
+	169, -1, 181, -1, 190, -1, 200, -1, 212, -1, 225, -1, 238, -1, 245, -1, 255,
+	-1, 273, -1, 275, -1, 277, -1, 281, -1, 282, -1, 286, -1, 294, -1, 301, -1,
+	316, -1, 317, -1, 319, -1, 323, -1, 328, -1, 335, -1, 364, -1, 366, -1, 367,
+	-1, 494, -1, 496, -1, 499, -1, 33, 202, 492, 202, 498, 202, -1, -2, 489, -1,
+	33, 199, 492, 199, 498, 199, 5, 635, 6, 635, 32, 635, 34, 635, 39, 635, 51,
+	635, 60, 635, 63, 635, 69, 635, 72, 635, 77, 635, 78, 635, 81, 635, 82, 635,
+	83, 635, 88, 635, 89, 635, 90, 635, 120, 635, 121, 635, 122, 635, 126, 635,
+	127, 635, 128, 635, 131, 635, 132, 635, 134, 635, 135, 635, 136, 635, 137,
+	635, 138, 635, 139, 635, 141, 635, 143, 635, 152, 635, 159, 635, 164, 635,
+	165, 635, 169, 635, 170, 635, 173, 635, 177, 635, 181, 635, 190, 635, 193,
+	635, 221, 635, 238, 635, 244, 635, 255, 635, 262, 635, 263, 635, 273, 635,
+	275, 635, 277, 635, 281, 635, 282, 635, 286, 635, 294, 635, 299, 635, 301,
+	635, 307, 635, 309, 635, 317, 635, 319, 635, 323, 635, 333, 635, 335, 635,
+	336, 635, 339, 635, 364, 635, 367, 635, 397, 635, 400, 635, 401, 635, 403,
+	635, 404, 635, 405, 635, 406, 635, 407, 635, 408, 635, 409, 635, 410, 635,
+	411, 635, 414, 635, 415, 635, 417, 635, 418, 635, 419, 635, 420, 635, 421,
+	635, 422, 635, 423, 635, 424, 635, 425, 635, 426, 635, 427, 635, 428, 635,
+	429, 635, 430, 635, 431, 635, 432, 635, 433, 635, 434, 635, 435, 635, 436,
+	635, 437, 635, 438, 635, 440, 635, 441, 635, 442, 635, 443, 635, 444, 635,
+	445, 635, 446, 635, 447, 635, 448, 635, 449, 635, 450, 635, 451, 635, 452,
+	635, 453, 635, 454, 635, 455, 635, 458, 635, 459, 635, 460, 635, 461, 635,
+	462, 635, 463, 635, 465, 635, 466, 635, 467, 635, 469, 635, 470, 635, 476,
+	635, 477, 635, 478, 635, 479, 635, 480, 635, 481, 635, 482, 635, 484, 635,
+	485, 635, 486, 635, 487, 635, 488, 635, 497, 635, -1, -2, 489, -1, 5, 670,
+	6, 670, 32, 670, 34, 670, 39, 670, 51, 670, 60, 670, 63, 670, 69, 670, 72,
+	670, 77, 670, 78, 670, 81, 670, 82, 670, 83, 670, 88, 670, 89, 670, 90, 670,
+	120, 670, 121, 670, 122, 670, 126, 670, 127, 670, 128, 670, 131, 670, 132,
+	670, 134, 670, 135, 670, 136, 670, 137, 670, 138, 670, 139, 670, 141, 670,
+	143, 670, 152, 670, 159, 670, 164, 670, 165, 670, 169, 670, 170, 670, 173,
+	670, 177, 670, 181, 670, 190, 670, 193, 670, 221, 670, 238, 670, 244, 670,
+	255, 670, 262, 670, 263, 670, 273, 670, 275, 670, 277, 670, 281, 670, 282,
+	670, 286, 670, 294, 670, 299, 670, 301, 670, 307, 670, 309, 670, 317, 670,
+	319, 670, 323, 670, 333, 670, 335, 670, 336, 670, 339, 670, 364, 670, 367,
+	670, 397, 670, 400, 670, 401, 670, 403, 670, 404, 670, 405, 670, 406, 670,
+	407, 670, 408, 670, 409, 670, 410, 670, 411, 670, 414, 670, 415, 670, 417,
+	670, 418, 670, 419, 670, 420, 670, 421, 670, 422, 670, 423, 670, 424, 670,
+	425, 670, 426, 670, 427, 670, 428, 670, 429, 670, 430, 670, 431, 670, 432,
+	670, 433, 670, 434, 670, 435, 670, 436, 670, 437, 670, 438, 670, 440, 670,
+	441, 670, 442, 670, 443, 670, 444, 670, 445, 670, 446, 670, 447, 670, 448,
+	670, 449, 670, 450, 670, 451, 670, 452, 670, 453, 670, 454, 670, 455, 670,
+	458, 670, 459, 670, 460, 670, 461, 670, 462, 670, 463, 670, 465, 670, 466,
+	670, 467, 670, 469, 670, 470, 670, 476, 670, 477, 670, 478, 670, 479, 670,
+	480, 670, 481, 670, 482, 670, 484, 670, 485, 670, 486, 670, 487, 670, 488,
+	670, 497, 670, -1, -2, 32, -1, 34, -1, 39, -1, 51, -1, 60, -1, 63, -1, 69,
+	-1, 78, -1, 81, -1, 83, -1, 88, -1, 90, -1, 120, -1, 121, -1, 122, -1, 126,
+	-1, 127, -1, 131, -1, 132, -1, 134, -1, 135, -1, 136, -1, 137, -1, 138, -1,
+	139, -1, 141, -1, 143, -1, 152, -1, 164, -1, 165, -1, 169, -1, 170, -1, 173,
+	-1, 177, -1, 181, -1, 190, -1, 193, -1, 221, -1, 238, -1, 244, -1, 255, -1,
+	273, -1, 275, -1, 277, -1, 281, -1, 282, -1, 286, -1, 294, -1, 301, -1, 309,
+	-1, 317, -1, 319, -1, 323, -1, 335, -1, 339, -1, 364, -1, 367, -1, 77, 1510,
+	-1, -2, 33, -1, 36, -1, 153, -1, 95, 1474, 119, 1474, 145, 1474, -1, -2,
+	489, -1, 5, 638, 6, 638, 32, 638, 34, 638, 39, 638, 51, 638, 60, 638, 63,
+	638, 69, 638, 72, 638, 77, 638, 78, 638, 81, 638, 82, 638, 83, 638, 88, 638,
+	89, 638, 90, 638, 120, 638, 121, 638, 122, 638, 126, 638, 127, 638, 128,
+	638, 131, 638, 132, 638, 134, 638, 135, 638, 136, 638, 137, 638, 138, 638,
+	139, 638, 141, 638, 143, 638, 152, 638, 159, 638, 164, 638, 165, 638, 169,
+	638, 170, 638, 173, 638, 177, 638, 181, 638, 190, 638, 193, 638, 221, 638,
+	238, 638, 244, 638, 255, 638, 262, 638, 263, 638, 273, 638, 275, 638, 277,
+	638, 281, 638, 282, 638, 286, 638, 294, 638, 299, 638, 301, 638, 307, 638,
+	309, 638, 317, 638, 319, 638, 323, 638, 333, 638, 335, 638, 336, 638, 339,
+	638, 364, 638, 367, 638, 397, 638, 400, 638, 401, 638, 403, 638, 404, 638,
+	405, 638, 406, 638, 407, 638, 408, 638, 409, 638, 410, 638, 411, 638, 414,
+	638, 415, 638, 417, 638, 418, 638, 419, 638, 420, 638, 421, 638, 422, 638,
+	423, 638, 424, 638, 425, 638, 426, 638, 427, 638, 428, 638, 429, 638, 430,
+	638, 431, 638, 432, 638, 433, 638, 434, 638, 435, 638, 436, 638, 437, 638,
+	438, 638, 440, 638, 441, 638, 442, 638, 443, 638, 444, 638, 445, 638, 446,
+	638, 447, 638, 448, 638, 449, 638, 450, 638, 451, 638, 452, 638, 453, 638,
+	454, 638, 455, 638, 458, 638, 459, 638, 460, 638, 461, 638, 462, 638, 463,
+	638, 465, 638, 466, 638, 467, 638, 469, 638, 470, 638, 476, 638, 477, 638,
+	478, 638, 479, 638, 480, 638, 481, 638, 482, 638, 484, 638, 485, 638, 486,
+	638, 487, 638, 488, 638, 497, 638, -1, -2, 33, -1, 37, -1, 103, -1, 104, -1,
+	163, -1, 201, -1, 214, -1, 222, -1, 284, -1, 365, -1, 5, 1474, 26, 1474, 68,
+	1474, 109, 1474, 130, 1474, 133, 1474, 146, 1474, 172, 1474, 184, 1474, 246,
+	1474, 254, 1474, 314, 1474, 342, 1474, 355, 1474, 357, 1474, 494, 1474, 496,
+	1474, 499, 1474, -1, -2, 489, -1, 5, 486, 32, 486, 34, 486, 39, 486, 51,
+	486, 60, 486, 63, 486, 69, 486, 72, 486, 77, 486, 78, 486, 81, 486, 82, 486,
+	83, 486, 88, 486, 89, 486, 90, 486, 120, 486, 121, 486, 122, 486, 126, 486,
+	127, 486, 128, 486, 131, 486, 132, 486, 134, 486, 135, 486, 136, 486, 137,
+	486, 138, 486, 139, 486, 141, 486, 143, 486, 152, 486, 159, 486, 164, 486,
+	165, 486, 169, 486, 170, 486, 173, 486, 177, 486, 181, 486, 190, 486, 193,
+	486, 221, 486, 238, 486, 244, 486, 255, 486, 262, 486, 263, 486, 273, 486,
+	275, 486, 277, 486, 281, 486, 282, 486, 286, 486, 294, 486, 299, 486, 301,
+	486, 307, 486, 309, 486, 317, 486, 319, 486, 323, 486, 333, 486, 335, 486,
+	339, 486, 364, 486, 367, 486, -1, -2, 489, -1, 5, 526, 32, 526, 34, 526, 39,
+	526, 51, 526, 60, 526, 63, 526, 69, 526, 72, 526, 77, 526, 78, 526, 81, 526,
+	82, 526, 83, 526, 88, 526, 89, 526, 90, 526, 120, 526, 121, 526, 122, 526,
+	126, 526, 127, 526, 128, 526, 131, 526, 132, 526, 134, 526, 135, 526, 136,
+	526, 137, 526, 138, 526, 139, 526, 141, 526, 143, 526, 152, 526, 159, 526,
+	164, 526, 165, 526, 169, 526, 170, 526, 173, 526, 177, 526, 181, 526, 190,
+	526, 193, 526, 221, 526, 238, 526, 244, 526, 255, 526, 262, 526, 263, 526,
+	273, 526, 275, 526, 277, 526, 281, 526, 282, 526, 286, 526, 294, 526, 299,
+	526, 301, 526, 307, 526, 309, 526, 317, 526, 319, 526, 323, 526, 333, 526,
+	335, 526, 339, 526, 364, 526, 367, 526, -1, -2, 489, -1, 5, 440, 32, 440,
+	34, 440, 39, 440, 51, 440, 60, 440, 63, 440, 69, 440, 72, 440, 77, 440, 78,
+	440, 81, 440, 82, 440, 83, 440, 88, 440, 89, 440, 90, 440, 120, 440, 121,
+	440, 122, 440, 126, 440, 127, 440, 128, 440, 131, 440, 132, 440, 134, 440,
+	135, 440, 136, 440, 137, 440, 138, 440, 139, 440, 141, 440, 143, 440, 152,
+	440, 159, 440, 164, 440, 165, 440, 169, 440, 170, 440, 173, 440, 177, 440,
+	181, 440, 190, 440, 193, 440, 221, 440, 238, 440, 244, 440, 255, 440, 262,
+	440, 263, 440, 273, 440, 275, 440, 277, 440, 281, 440, 282, 440, 286, 440,
+	294, 440, 299, 440, 301, 440, 307, 440, 309, 440, 317, 440, 319, 440, 323,
+	440, 333, 440, 335, 440, 339, 440, 364, 440, 367, 440, -1, -2, 489, -1, 5,
+	590, 32, 590, 34, 590, 39, 590, 51, 590, 60, 590, 63, 590, 69, 590, 72, 590,
+	77, 590, 78, 590, 81, 590, 82, 590, 83, 590, 88, 590, 89, 590, 90, 590, 120,
+	590, 121, 590, 122, 590, 126, 590, 127, 590, 128, 590, 131, 590, 132, 590,
+	134, 590, 135, 590, 136, 590, 137, 590, 138, 590, 139, 590, 141, 590, 143,
+	590, 152, 590, 159, 590, 164, 590, 165, 590, 169, 590, 170, 590, 173, 590,
+	177, 590, 181, 590, 190, 590, 193, 590, 221, 590, 238, 590, 244, 590, 255,
+	590, 262, 590, 263, 590, 273, 590, 275, 590, 277, 590, 281, 590, 282, 590,
+	286, 590, 294, 590, 299, 590, 301, 590, 307, 590, 309, 590, 317, 590, 319,
+	590, 323, 590, 333, 590, 335, 590, 339, 590, 364, 590, 367, 590, -1, -2, 33,
+	-1, 492, -1, 494, -1, 498, 1474, -1, -2, 489, -1, 5, 661, 6, 661, 32, 661,
+	34, 661, 39, 661, 51, 661, 60, 661, 63, 661, 69, 661, 72, 661, 77, 661, 78,
+	661, 81, 661, 82, 661, 83, 661, 88, 661, 89, 661, 90, 661, 120, 661, 121,
+	661, 122, 661, 126, 661, 127, 661, 128, 661, 131, 661, 132, 661, 134, 661,
+	135, 661, 136, 661, 137, 661, 138, 661, 139, 661, 141, 661, 143, 661, 152,
+	661, 159, 661, 164, 661, 165, 661, 169, 661, 170, 661, 173, 661, 177, 661,
+	181, 661, 190, 661, 193, 661, 221, 661, 238, 661, 244, 661, 255, 661, 262,
+	661, 263, 661, 273, 661, 275, 661, 277, 661, 281, 661, 282, 661, 286, 661,
+	294, 661, 299, 661, 301, 661, 307, 661, 309, 661, 317, 661, 319, 661, 323,
+	661, 333, 661, 335, 661, 336, 661, 339, 661, 364, 661, 367, 661, 397, 661,
+	400, 661, 401, 661, 403, 661, 404, 661, 405, 661, 406, 661, 407, 661, 408,
+	661, 409, 661, 410, 661, 411, 661, 414, 661, 415, 661, 417, 661, 418, 661,
+	419, 661, 420, 661, 421, 661, 422, 661, 423, 661, 424, 661, 425, 661, 426,
+	661, 427, 661, 428, 661, 429, 661, 430, 661, 431, 661, 432, 661, 433, 661,
+	434, 661, 435, 661, 436, 661, 437, 661, 438, 661, 440, 661, 441, 661, 442,
+	661, 443, 661, 444, 661, 445, 661, 446, 661, 447, 661, 448, 661, 449, 661,
+	450, 661, 451, 661, 452, 661, 453, 661, 454, 661, 455, 661, 458, 661, 459,
+	661, 460, 661, 461, 661, 462, 661, 463, 661, 465, 661, 466, 661, 467, 661,
+	469, 661, 470, 661, 476, 661, 477, 661, 478, 661, 479, 661, 480, 661, 481,
+	661, 482, 661, 484, 661, 485, 661, 486, 661, 487, 661, 488, 661, 497, 661,
+	-1, -2, 489, -1, 5, 637, 6, 637, 32, 637, 34, 637, 39, 637, 51, 637, 60,
+	637, 63, 637, 69, 637, 72, 637, 77, 637, 78, 637, 81, 637, 82, 637, 83, 637,
+	88, 637, 89, 637, 90, 637, 120, 637, 121, 637, 122, 637, 126, 637, 127, 637,
+	128, 637, 131, 637, 132, 637, 134, 637, 135, 637, 136, 637, 137, 637, 138,
+	637, 139, 637, 141, 637, 143, 637, 152, 637, 159, 637, 164, 637, 165, 637,
+	169, 637, 170, 637, 173, 637, 177, 637, 181, 637, 190, 637, 193, 637, 221,
+	637, 238, 637, 244, 637, 255, 637, 262, 637, 263, 637, 273, 637, 275, 637,
+	277, 637, 281, 637, 282, 637, 286, 637, 294, 637, 299, 637, 301, 637, 307,
+	637, 309, 637, 317, 637, 319, 637, 323, 637, 333, 637, 335, 637, 336, 637,
+	339, 637, 364, 637, 367, 637, 397, 637, 400, 637, 401, 637, 403, 637, 404,
+	637, 405, 637, 406, 637, 407, 637, 408, 637, 409, 637, 410, 637, 411, 637,
+	414, 637, 415, 637, 417, 637, 418, 637, 419, 637, 420, 637, 421, 637, 422,
+	637, 423, 637, 424, 637, 425, 637, 426, 637, 427, 637, 428, 637, 429, 637,
+	430, 637, 431, 637, 432, 637, 433, 637, 434, 637, 435, 637, 436, 637, 437,
+	637, 438, 637, 440, 637, 441, 637, 442, 637, 443, 637, 444, 637, 445, 637,
+	446, 637, 447, 637, 448, 637, 449, 637, 450, 637, 451, 637, 452, 637, 453,
+	637, 454, 637, 455, 637, 458, 637, 459, 637, 460, 637, 461, 637, 462, 637,
+	463, 637, 465, 637, 466, 637, 467, 637, 469, 637, 470, 637, 476, 637, 477,
+	637, 478, 637, 479, 637, 480, 637, 481, 637, 482, 637, 484, 637, 485, 637,
+	486, 637, 487, 637, 488, 637, 497, 637, -1, -2, 489, -1, 5, 634, 6, 634, 32,
+	634, 34, 634, 39, 634, 51, 634, 60, 634, 63, 634, 69, 634, 72, 634, 77, 634,
+	78, 634, 81, 634, 82, 634, 83, 634, 88, 634, 89, 634, 90, 634, 120, 634,
+	121, 634, 122, 634, 126, 634, 127, 634, 128, 634, 131, 634, 132, 634, 134,
+	634, 135, 634, 136, 634, 137, 634, 138, 634, 139, 634, 141, 634, 143, 634,
+	152, 634, 159, 634, 164, 634, 165, 634, 169, 634, 170, 634, 173, 634, 177,
+	634, 181, 634, 190, 634, 193, 634, 221, 634, 238, 634, 244, 634, 255, 634,
+	262, 634, 263, 634, 273, 634, 275, 634, 277, 634, 281, 634, 282, 634, 286,
+	634, 294, 634, 299, 634, 301, 634, 307, 634, 309, 634, 317, 634, 319, 634,
+	323, 634, 333, 634, 335, 634, 336, 634, 339, 634, 364, 634, 367, 634, 397,
+	634, 400, 634, 401, 634, 403, 634, 404, 634, 405, 634, 406, 634, 407, 634,
+	408, 634, 409, 634, 410, 634, 411, 634, 414, 634, 415, 634, 417, 634, 418,
+	634, 419, 634, 420, 634, 421, 634, 422, 634, 423, 634, 424, 634, 425, 634,
+	426, 634, 427, 634, 428, 634, 429, 634, 430, 634, 431, 634, 432, 634, 433,
+	634, 434, 634, 435, 634, 436, 634, 437, 634, 438, 634, 440, 634, 441, 634,
+	442, 634, 443, 634, 444, 634, 445, 634, 446, 634, 447, 634, 448, 634, 449,
+	634, 450, 634, 451, 634, 452, 634, 453, 634, 454, 634, 455, 634, 458, 634,
+	459, 634, 460, 634, 461, 634, 462, 634, 463, 634, 465, 634, 466, 634, 467,
+	634, 469, 634, 470, 634, 476, 634, 477, 634, 478, 634, 479, 634, 480, 634,
+	481, 634, 482, 634, 484, 634, 485, 634, 486, 634, 487, 634, 488, 634, 497,
+	634, -1, -2, 28, -1, 29, -1, 35, -1, 42, -1, 43, -1, 44, -1, 45, -1, 46, -1,
+	47, -1, 48, -1, 49, -1, 50, -1, 53, -1, 55, -1, 57, -1, 58, -1, 59, -1, 66,
+	-1, 67, -1, 84, -1, 85, -1, 86, -1, 92, -1, 96, -1, 98, -1, 124, -1, 125,
+	-1, 144, -1, 148, -1, 149, -1, 166, -1, 189, -1, 198, -1, 199, -1, 224, -1,
+	249, -1, 250, -1, 256, -1, 257, -1, 260, -1, 292, -1, 293, -1, 303, -1, 306,
+	-1, 310, -1, 348, -1, 350, -1, 353, -1, 354, -1, 356, -1, 358, -1, 359, -1,
+	360, -1, 361, -1, 5, 1480, 26, 1480, 33, 1480, 37, 1480, 68, 1480, 103,
+	1480, 104, 1480, 109, 1480, 130, 1480, 133, 1480, 146, 1480, 163, 1480, 172,
+	1480, 184, 1480, 201, 1480, 214, 1480, 222, 1480, 246, 1480, 254, 1480, 284,
+	1480, 314, 1480, 342, 1480, 355, 1480, 357, 1480, 365, 1480, 494, 1480, 496,
+	1480, 499, 1480, -1, -2, 119, -1, 95, 1476, 145, 1476, -1, -2, 33, -1, 489,
+	-1, 492, -1, 5, 578, 32, 578, 34, 578, 39, 578, 51, 578, 60, 578, 63, 578,
+	69, 578, 72, 578, 77, 578, 78, 578, 81, 578, 82, 578, 83, 578, 88, 578, 89,
+	578, 90, 578, 120, 578, 121, 578, 122, 578, 126, 578, 127, 578, 128, 578,
+	131, 578, 132, 578, 134, 578, 135, 578, 136, 578, 137, 578, 138, 578, 139,
+	578, 141, 578, 143, 578, 152, 578, 159, 578, 164, 578, 165, 578, 169, 578,
+	170, 578, 173, 578, 177, 578, 181, 578, 190, 578, 193, 578, 221, 578, 238,
+	578, 244, 578, 255, 578, 262, 578, 263, 578, 273, 578, 275, 578, 277, 578,
+	281, 578, 282, 578, 286, 578, 294, 578, 299, 578, 301, 578, 307, 578, 309,
+	578, 317, 578, 319, 578, 323, 578, 333, 578, 335, 578, 339, 578, 364, 578,
+	367, 578, 498, 1474, -1, -2, 33, -1, 489, -1, 492, -1, 5, 506, 32, 506, 34,
+	506, 39, 506, 51, 506, 60, 506, 63, 506, 69, 506, 72, 506, 77, 506, 78, 506,
+	81, 506, 82, 506, 83, 506, 88, 506, 89, 506, 90, 506, 120, 506, 121, 506,
+	122, 506, 126, 506, 127, 506, 128, 506, 131, 506, 132, 506, 134, 506, 135,
+	506, 136, 506, 137, 506, 138, 506, 139, 506, 141, 506, 143, 506, 152, 506,
+	159, 506, 164, 506, 165, 506, 169, 506, 170, 506, 173, 506, 177, 506, 181,
+	506, 190, 506, 193, 506, 221, 506, 238, 506, 244, 506, 255, 506, 262, 506,
+	263, 506, 273, 506, 275, 506, 277, 506, 281, 506, 282, 506, 286, 506, 294,
+	506, 299, 506, 301, 506, 307, 506, 309, 506, 317, 506, 319, 506, 323, 506,
+	333, 506, 335, 506, 339, 506, 364, 506, 367, 506, 498, 1474, -1, -2, 489,
+	-1, 5, 474, 32, 474, 34, 474, 39, 474, 51, 474, 60, 474, 63, 474, 69, 474,
+	72, 474, 77, 474, 78, 474, 81, 474, 82, 474, 83, 474, 88, 474, 89, 474, 90,
+	474, 120, 474, 121, 474, 122, 474, 126, 474, 127, 474, 128, 474, 131, 474,
+	132, 474, 134, 474, 135, 474, 136, 474, 137, 474, 138, 474, 139, 474, 141,
+	474, 143, 474, 152, 474, 159, 474, 164, 474, 165, 474, 169, 474, 170, 474,
+	173, 474, 177, 474, 181, 474, 190, 474, 193, 474, 221, 474, 238, 474, 244,
+	474, 255, 474, 262, 474, 263, 474, 273, 474, 275, 474, 277, 474, 281, 474,
+	282, 474, 286, 474, 294, 474, 299, 474, 301, 474, 307, 474, 309, 474, 317,
+	474, 319, 474, 323, 474, 333, 474, 335, 474, 339, 474, 364, 474, 367, 474,
+	-1, -2, 33, -1, 489, -1, 492, -1, 5, 576, 32, 576, 34, 576, 39, 576, 51,
+	576, 60, 576, 63, 576, 69, 576, 72, 576, 77, 576, 78, 576, 81, 576, 82, 576,
+	83, 576, 88, 576, 89, 576, 90, 576, 120, 576, 121, 576, 122, 576, 126, 576,
+	127, 576, 128, 576, 131, 576, 132, 576, 134, 576, 135, 576, 136, 576, 137,
+	576, 138, 576, 139, 576, 141, 576, 143, 576, 152, 576, 159, 576, 164, 576,
+	165, 576, 169, 576, 170, 576, 173, 576, 177, 576, 181, 576, 190, 576, 193,
+	576, 221, 576, 238, 576, 244, 576, 255, 576, 262, 576, 263, 576, 273, 576,
+	275, 576, 277, 576, 281, 576, 282, 576, 286, 576, 294, 576, 299, 576, 301,
+	576, 307, 576, 309, 576, 317, 576, 319, 576, 323, 576, 333, 576, 335, 576,
+	339, 576, 364, 576, 367, 576, 498, 1474, -1, -2, 5, -1, 26, -1, 68, -1, 109,
+	-1, 130, -1, 133, -1, 146, -1, 172, -1, 184, -1, 246, -1, 254, -1, 314, -1,
+	342, -1, 355, -1, 357, -1, 494, -1, 496, -1, 499, -1, 495, 616, -1, -2, 489,
+	-1, 5, 480, 32, 480, 34, 480, 39, 480, 51, 480, 60, 480, 63, 480, 69, 480,
+	72, 480, 77, 480, 78, 480, 81, 480, 82, 480, 83, 480, 88, 480, 89, 480, 90,
+	480, 120, 480, 121, 480, 122, 480, 126, 480, 127, 480, 128, 480, 131, 480,
+	132, 480, 134, 480, 135, 480, 136, 480, 137, 480, 138, 480, 139, 480, 141,
+	480, 143, 480, 152, 480, 159, 480, 164, 480, 165, 480, 169, 480, 170, 480,
+	173, 480, 177, 480, 181, 480, 190, 480, 193, 480, 221, 480, 238, 480, 244,
+	480, 255, 480, 262, 480, 263, 480, 273, 480, 275, 480, 277, 480, 281, 480,
+	282, 480, 286, 480, 294, 480, 299, 480, 301, 480, 307, 480, 309, 480, 317,
+	480, 319, 480, 323, 480, 333, 480, 335, 480, 339, 480, 364, 480, 367, 480,
+	-1, -2, 489, -1, 5, 485, 32, 485, 34, 485, 39, 485, 51, 485, 60, 485, 63,
+	485, 69, 485, 72, 485, 77, 485, 78, 485, 81, 485, 82, 485, 83, 485, 88, 485,
+	89, 485, 90, 485, 120, 485, 121, 485, 122, 485, 126, 485, 127, 485, 128,
+	485, 131, 485, 132, 485, 134, 485, 135, 485, 136, 485, 137, 485, 138, 485,
+	139, 485, 141, 485, 143, 485, 152, 485, 159, 485, 164, 485, 165, 485, 169,
+	485, 170, 485, 173, 485, 177, 485, 181, 485, 190, 485, 193, 485, 221, 485,
+	238, 485, 244, 485, 255, 485, 262, 485, 263, 485, 273, 485, 275, 485, 277,
+	485, 281, 485, 282, 485, 286, 485, 294, 485, 299, 485, 301, 485, 307, 485,
+	309, 485, 317, 485, 319, 485, 323, 485, 333, 485, 335, 485, 339, 485, 364,
+	485, 367, 485, -1, -2, 489, -1, 5, 525, 32, 525, 34, 525, 39, 525, 51, 525,
+	60, 525, 63, 525, 69, 525, 72, 525, 77, 525, 78, 525, 81, 525, 82, 525, 83,
+	525, 88, 525, 89, 525, 90, 525, 120, 525, 121, 525, 122, 525, 126, 525, 127,
+	525, 128, 525, 131, 525, 132, 525, 134, 525, 135, 525, 136, 525, 137, 525,
+	138, 525, 139, 525, 141, 525, 143, 525, 152, 525, 159, 525, 164, 525, 165,
+	525, 169, 525, 170, 525, 173, 525, 177, 525, 181, 525, 190, 525, 193, 525,
+	221, 525, 238, 525, 244, 525, 255, 525, 262, 525, 263, 525, 273, 525, 275,
+	525, 277, 525, 281, 525, 282, 525, 286, 525, 294, 525, 299, 525, 301, 525,
+	307, 525, 309, 525, 317, 525, 319, 525, 323, 525, 333, 525, 335, 525, 339,
+	525, 364, 525, 367, 525, -1, -2, 489, -1, 5, 439, 32, 439, 34, 439, 39, 439,
+	51, 439, 60, 439, 63, 439, 69, 439, 72, 439, 77, 439, 78, 439, 81, 439, 82,
+	439, 83, 439, 88, 439, 89, 439, 90, 439, 120, 439, 121, 439, 122, 439, 126,
+	439, 127, 439, 128, 439, 131, 439, 132, 439, 134, 439, 135, 439, 136, 439,
+	137, 439, 138, 439, 139, 439, 141, 439, 143, 439, 152, 439, 159, 439, 164,
+	439, 165, 439, 169, 439, 170, 439, 173, 439, 177, 439, 181, 439, 190, 439,
+	193, 439, 221, 439, 238, 439, 244, 439, 255, 439, 262, 439, 263, 439, 273,
+	439, 275, 439, 277, 439, 281, 439, 282, 439, 286, 439, 294, 439, 299, 439,
+	301, 439, 307, 439, 309, 439, 317, 439, 319, 439, 323, 439, 333, 439, 335,
+	439, 339, 439, 364, 439, 367, 439, -1, -2, 33, -1, 489, -1, 492, -1, 5, 562,
+	32, 562, 34, 562, 39, 562, 51, 562, 60, 562, 63, 562, 69, 562, 72, 562, 77,
+	562, 78, 562, 81, 562, 82, 562, 83, 562, 88, 562, 89, 562, 90, 562, 120,
+	562, 121, 562, 122, 562, 126, 562, 127, 562, 128, 562, 131, 562, 132, 562,
+	134, 562, 135, 562, 136, 562, 137, 562, 138, 562, 139, 562, 141, 562, 143,
+	562, 152, 562, 159, 562, 164, 562, 165, 562, 169, 562, 170, 562, 173, 562,
+	177, 562, 181, 562, 190, 562, 193, 562, 221, 562, 238, 562, 244, 562, 255,
+	562, 262, 562, 263, 562, 273, 562, 275, 562, 277, 562, 281, 562, 282, 562,
+	286, 562, 294, 562, 299, 562, 301, 562, 307, 562, 309, 562, 317, 562, 319,
+	562, 323, 562, 333, 562, 335, 562, 339, 562, 364, 562, 367, 562, 498, 1474,
+	-1, -2, 33, -1, 489, -1, 492, -1, 5, 566, 32, 566, 34, 566, 39, 566, 51,
+	566, 60, 566, 63, 566, 69, 566, 72, 566, 77, 566, 78, 566, 81, 566, 82, 566,
+	83, 566, 88, 566, 89, 566, 90, 566, 120, 566, 121, 566, 122, 566, 126, 566,
+	127, 566, 128, 566, 131, 566, 132, 566, 134, 566, 135, 566, 136, 566, 137,
+	566, 138, 566, 139, 566, 141, 566, 143, 566, 152, 566, 159, 566, 164, 566,
+	165, 566, 169, 566, 170, 566, 173, 566, 177, 566, 181, 566, 190, 566, 193,
+	566, 221, 566, 238, 566, 244, 566, 255, 566, 262, 566, 263, 566, 273, 566,
+	275, 566, 277, 566, 281, 566, 282, 566, 286, 566, 294, 566, 299, 566, 301,
+	566, 307, 566, 309, 566, 317, 566, 319, 566, 323, 566, 333, 566, 335, 566,
+	339, 566, 364, 566, 367, 566, 498, 1474, -1, -2, 33, -1, 489, -1, 492, -1,
+	5, 564, 32, 564, 34, 564, 39, 564, 51, 564, 60, 564, 63, 564, 69, 564, 72,
+	564, 77, 564, 78, 564, 81, 564, 82, 564, 83, 564, 88, 564, 89, 564, 90, 564,
+	120, 564, 121, 564, 122, 564, 126, 564, 127, 564, 128, 564, 131, 564, 132,
+	564, 134, 564, 135, 564, 136, 564, 137, 564, 138, 564, 139, 564, 141, 564,
+	143, 564, 152, 564, 159, 564, 164, 564, 165, 564, 169, 564, 170, 564, 173,
+	564, 177, 564, 181, 564, 190, 564, 193, 564, 221, 564, 238, 564, 244, 564,
+	255, 564, 262, 564, 263, 564, 273, 564, 275, 564, 277, 564, 281, 564, 282,
+	564, 286, 564, 294, 564, 299, 564, 301, 564, 307, 564, 309, 564, 317, 564,
+	319, 564, 323, 564, 333, 564, 335, 564, 339, 564, 364, 564, 367, 564, 498,
+	1474, -1, -2, 33, -1, 489, -1, 492, -1, 5, 560, 32, 560, 34, 560, 39, 560,
+	51, 560, 60, 560, 63, 560, 69, 560, 72, 560, 77, 560, 78, 560, 81, 560, 82,
+	560, 83, 560, 88, 560, 89, 560, 90, 560, 120, 560, 121, 560, 122, 560, 126,
+	560, 127, 560, 128, 560, 131, 560, 132, 560, 134, 560, 135, 560, 136, 560,
+	137, 560, 138, 560, 139, 560, 141, 560, 143, 560, 152, 560, 159, 560, 164,
+	560, 165, 560, 169, 560, 170, 560, 173, 560, 177, 560, 181, 560, 190, 560,
+	193, 560, 221, 560, 238, 560, 244, 560, 255, 560, 262, 560, 263, 560, 273,
+	560, 275, 560, 277, 560, 281, 560, 282, 560, 286, 560, 294, 560, 299, 560,
+	301, 560, 307, 560, 309, 560, 317, 560, 319, 560, 323, 560, 333, 560, 335,
+	560, 339, 560, 364, 560, 367, 560, 498, 1474, -1, -2, 172, -1, 495, 652, -1,
+	-2, 33, -1, 489, -1, 492, -1, 5, 574, 32, 574, 34, 574, 39, 574, 51, 574,
+	60, 574, 63, 574, 69, 574, 72, 574, 77, 574, 78, 574, 81, 574, 82, 574, 83,
+	574, 88, 574, 89, 574, 90, 574, 120, 574, 121, 574, 122, 574, 126, 574, 127,
+	574, 128, 574, 131, 574, 132, 574, 134, 574, 135, 574, 136, 574, 137, 574,
+	138, 574, 139, 574, 141, 574, 143, 574, 152, 574, 159, 574, 164, 574, 165,
+	574, 169, 574, 170, 574, 173, 574, 177, 574, 181, 574, 190, 574, 193, 574,
+	221, 574, 238, 574, 244, 574, 255, 574, 262, 574, 263, 574, 273, 574, 275,
+	574, 277, 574, 281, 574, 282, 574, 286, 574, 294, 574, 299, 574, 301, 574,
+	307, 574, 309, 574, 317, 574, 319, 574, 323, 574, 333, 574, 335, 574, 339,
+	574, 364, 574, 367, 574, 498, 1474, -1, -2, 80, -1, 129, -1, 489, -1, 5,
+	606, 32, 606, 34, 606, 39, 606, 51, 606, 60, 606, 63, 606, 69, 606, 72, 606,
+	77, 606, 78, 606, 81, 606, 82, 606, 83, 606, 88, 606, 89, 606, 90, 606, 120,
+	606, 121, 606, 122, 606, 126, 606, 127, 606, 128, 606, 131, 606, 132, 606,
+	134, 606, 135, 606, 136, 606, 137, 606, 138, 606, 139, 606, 141, 606, 143,
+	606, 152, 606, 159, 606, 164, 606, 165, 606, 169, 606, 170, 606, 173, 606,
+	177, 606, 181, 606, 190, 606, 193, 606, 221, 606, 238, 606, 244, 606, 255,
+	606, 262, 606, 263, 606, 273, 606, 275, 606, 277, 606, 281, 606, 282, 606,
+	286, 606, 294, 606, 299, 606, 301, 606, 307, 606, 309, 606, 317, 606, 319,
+	606, 323, 606, 333, 606, 335, 606, 339, 606, 364, 606, 367, 606, -1, -2,
+	489, -1, 5, 476, 32, 476, 34, 476, 39, 476, 51, 476, 60, 476, 63, 476, 69,
+	476, 72, 476, 77, 476, 78, 476, 81, 476, 82, 476, 83, 476, 88, 476, 89, 476,
+	90, 476, 120, 476, 121, 476, 122, 476, 126, 476, 127, 476, 128, 476, 131,
+	476, 132, 476, 134, 476, 135, 476, 136, 476, 137, 476, 138, 476, 139, 476,
+	141, 476, 143, 476, 152, 476, 159, 476, 164, 476, 165, 476, 169, 476, 170,
+	476, 173, 476, 177, 476, 181, 476, 190, 476, 193, 476, 221, 476, 238, 476,
+	244, 476, 255, 476, 262, 476, 263, 476, 273, 476, 275, 476, 277, 476, 281,
+	476, 282, 476, 286, 476, 294, 476, 299, 476, 301, 476, 307, 476, 309, 476,
+	317, 476, 319, 476, 323, 476, 333, 476, 335, 476, 339, 476, 364, 476, 367,
+	476, -1, -2, 489, -1, 5, 586, 32, 586, 34, 586, 39, 586, 51, 586, 60, 586,
+	63, 586, 69, 586, 72, 586, 77, 586, 78, 586, 81, 586, 82, 586, 83, 586, 88,
+	586, 89, 586, 90, 586, 120, 586, 121, 586, 122, 586, 126, 586, 127, 586,
+	128, 586, 131, 586, 132, 586, 134, 586, 135, 586, 136, 586, 137, 586, 138,
+	586, 139, 586, 141, 586, 143, 586, 152, 586, 159, 586, 164, 586, 165, 586,
+	169, 586, 170, 586, 173, 586, 177, 586, 181, 586, 190, 586, 193, 586, 221,
+	586, 238, 586, 244, 586, 255, 586, 262, 586, 263, 586, 273, 586, 275, 586,
+	277, 586, 281, 586, 282, 586, 286, 586, 294, 586, 299, 586, 301, 586, 307,
+	586, 309, 586, 317, 586, 319, 586, 323, 586, 333, 586, 335, 586, 339, 586,
+	364, 586, 367, 586, -1, -2, 33, -1, 489, -1, 492, -1, 5, 572, 32, 572, 34,
+	572, 39, 572, 51, 572, 60, 572, 63, 572, 69, 572, 72, 572, 77, 572, 78, 572,
+	81, 572, 82, 572, 83, 572, 88, 572, 89, 572, 90, 572, 120, 572, 121, 572,
+	122, 572, 126, 572, 127, 572, 128, 572, 131, 572, 132, 572, 134, 572, 135,
+	572, 136, 572, 137, 572, 138, 572, 139, 572, 141, 572, 143, 572, 152, 572,
+	159, 572, 164, 572, 165, 572, 169, 572, 170, 572, 173, 572, 177, 572, 181,
+	572, 190, 572, 193, 572, 221, 572, 238, 572, 244, 572, 255, 572, 262, 572,
+	263, 572, 273, 572, 275, 572, 277, 572, 281, 572, 282, 572, 286, 572, 294,
+	572, 299, 572, 301, 572, 307, 572, 309, 572, 317, 572, 319, 572, 323, 572,
+	333, 572, 335, 572, 339, 572, 364, 572, 367, 572, 498, 1474, -1, -2, 489,
+	-1, 5, 636, 6, 636, 32, 636, 34, 636, 39, 636, 51, 636, 60, 636, 63, 636,
+	69, 636, 72, 636, 77, 636, 78, 636, 81, 636, 82, 636, 83, 636, 88, 636, 89,
+	636, 90, 636, 120, 636, 121, 636, 122, 636, 126, 636, 127, 636, 128, 636,
+	131, 636, 132, 636, 134, 636, 135, 636, 136, 636, 137, 636, 138, 636, 139,
+	636, 141, 636, 143, 636, 152, 636, 159, 636, 164, 636, 165, 636, 169, 636,
+	170, 636, 173, 636, 177, 636, 181, 636, 190, 636, 193, 636, 221, 636, 238,
+	636, 244, 636, 255, 636, 262, 636, 263, 636, 273, 636, 275, 636, 277, 636,
+	281, 636, 282, 636, 286, 636, 294, 636, 299, 636, 301, 636, 307, 636, 309,
+	636, 317, 636, 319, 636, 323, 636, 333, 636, 335, 636, 336, 636, 339, 636,
+	364, 636, 367, 636, 397, 636, 400, 636, 401, 636, 403, 636, 404, 636, 405,
+	636, 406, 636, 407, 636, 408, 636, 409, 636, 410, 636, 411, 636, 414, 636,
+	415, 636, 417, 636, 418, 636, 419, 636, 420, 636, 421, 636, 422, 636, 423,
+	636, 424, 636, 425, 636, 426, 636, 427, 636, 428, 636, 429, 636, 430, 636,
+	431, 636, 432, 636, 433, 636, 434, 636, 435, 636, 436, 636, 437, 636, 438,
+	636, 440, 636, 441, 636, 442, 636, 443, 636, 444, 636, 445, 636, 446, 636,
+	447, 636, 448, 636, 449, 636, 450, 636, 451, 636, 452, 636, 453, 636, 454,
+	636, 455, 636, 458, 636, 459, 636, 460, 636, 461, 636, 462, 636, 463, 636,
+	465, 636, 466, 636, 467, 636, 469, 636, 470, 636, 476, 636, 477, 636, 478,
+	636, 479, 636, 480, 636, 481, 636, 482, 636, 484, 636, 485, 636, 486, 636,
+	487, 636, 488, 636, 497, 636, -1, -2, 33, -1, 489, -1, 492, -1, 5, 558, 32,
+	558, 34, 558, 39, 558, 51, 558, 60, 558, 63, 558, 69, 558, 72, 558, 77, 558,
+	78, 558, 81, 558, 82, 558, 83, 558, 88, 558, 89, 558, 90, 558, 120, 558,
+	121, 558, 122, 558, 126, 558, 127, 558, 128, 558, 131, 558, 132, 558, 134,
+	558, 135, 558, 136, 558, 137, 558, 138, 558, 139, 558, 141, 558, 143, 558,
+	152, 558, 159, 558, 164, 558, 165, 558, 169, 558, 170, 558, 173, 558, 177,
+	558, 181, 558, 190, 558, 193, 558, 221, 558, 238, 558, 244, 558, 255, 558,
+	262, 558, 263, 558, 273, 558, 275, 558, 277, 558, 281, 558, 282, 558, 286,
+	558, 294, 558, 299, 558, 301, 558, 307, 558, 309, 558, 317, 558, 319, 558,
+	323, 558, 333, 558, 335, 558, 339, 558, 364, 558, 367, 558, 498, 1474, -1,
+	-2, 33, -1, 489, -1, 492, -1, 5, 570, 32, 570, 34, 570, 39, 570, 51, 570,
+	60, 570, 63, 570, 69, 570, 72, 570, 77, 570, 78, 570, 81, 570, 82, 570, 83,
+	570, 88, 570, 89, 570, 90, 570, 120, 570, 121, 570, 122, 570, 126, 570, 127,
+	570, 128, 570, 131, 570, 132, 570, 134, 570, 135, 570, 136, 570, 137, 570,
+	138, 570, 139, 570, 141, 570, 143, 570, 152, 570, 159, 570, 164, 570, 165,
+	570, 169, 570, 170, 570, 173, 570, 177, 570, 181, 570, 190, 570, 193, 570,
+	221, 570, 238, 570, 244, 570, 255, 570, 262, 570, 263, 570, 273, 570, 275,
+	570, 277, 570, 281, 570, 282, 570, 286, 570, 294, 570, 299, 570, 301, 570,
+	307, 570, 309, 570, 317, 570, 319, 570, 323, 570, 333, 570, 335, 570, 339,
+	570, 364, 570, 367, 570, 498, 1474, -1, -2, 489, -1, 5, 464, 32, 464, 34,
+	464, 39, 464, 51, 464, 60, 464, 63, 464, 69, 464, 72, 464, 77, 464, 78, 464,
+	81, 464, 82, 464, 83, 464, 88, 464, 89, 464, 90, 464, 120, 464, 121, 464,
+	122, 464, 126, 464, 127, 464, 128, 464, 131, 464, 132, 464, 134, 464, 135,
+	464, 136, 464, 137, 464, 138, 464, 139, 464, 141, 464, 143, 464, 152, 464,
+	159, 464, 164, 464, 165, 464, 169, 464, 170, 464, 173, 464, 177, 464, 181,
+	464, 190, 464, 193, 464, 221, 464, 238, 464, 244, 464, 255, 464, 262, 464,
+	263, 464, 273, 464, 275, 464, 277, 464, 281, 464, 282, 464, 286, 464, 294,
+	464, 299, 464, 301, 464, 307, 464, 309, 464, 317, 464, 319, 464, 323, 464,
+	333, 464, 335, 464, 339, 464, 364, 464, 367, 464, -1, -2, 33, -1, 489, -1,
+	492, -1, 5, 554, 32, 554, 34, 554, 39, 554, 51, 554, 60, 554, 63, 554, 69,
+	554, 72, 554, 77, 554, 78, 554, 81, 554, 82, 554, 83, 554, 88, 554, 89, 554,
+	90, 554, 120, 554, 121, 554, 122, 554, 126, 554, 127, 554, 128, 554, 131,
+	554, 132, 554, 134, 554, 135, 554, 136, 554, 137, 554, 138, 554, 139, 554,
+	141, 554, 143, 554, 152, 554, 159, 554, 164, 554, 165, 554, 169, 554, 170,
+	554, 173, 554, 177, 554, 181, 554, 190, 554, 193, 554, 221, 554, 238, 554,
+	244, 554, 255, 554, 262, 554, 263, 554, 273, 554, 275, 554, 277, 554, 281,
+	554, 282, 554, 286, 554, 294, 554, 299, 554, 301, 554, 307, 554, 309, 554,
+	317, 554, 319, 554, 323, 554, 333, 554, 335, 554, 339, 554, 364, 554, 367,
+	554, 498, 1474, -1, -2, 33, -1, 489, -1, 492, -1, 5, 568, 32, 568, 34, 568,
+	39, 568, 51, 568, 60, 568, 63, 568, 69, 568, 72, 568, 77, 568, 78, 568, 81,
+	568, 82, 568, 83, 568, 88, 568, 89, 568, 90, 568, 120, 568, 121, 568, 122,
+	568, 126, 568, 127, 568, 128, 568, 131, 568, 132, 568, 134, 568, 135, 568,
+	136, 568, 137, 568, 138, 568, 139, 568, 141, 568, 143, 568, 152, 568, 159,
+	568, 164, 568, 165, 568, 169, 568, 170, 568, 173, 568, 177, 568, 181, 568,
+	190, 568, 193, 568, 221, 568, 238, 568, 244, 568, 255, 568, 262, 568, 263,
+	568, 273, 568, 275, 568, 277, 568, 281, 568, 282, 568, 286, 568, 294, 568,
+	299, 568, 301, 568, 307, 568, 309, 568, 317, 568, 319, 568, 323, 568, 333,
+	568, 335, 568, 339, 568, 364, 568, 367, 568, 498, 1474, -1, -2, 489, -1, 5,
+	462, 32, 462, 34, 462, 39, 462, 51, 462, 60, 462, 63, 462, 69, 462, 72, 462,
+	77, 462, 78, 462, 81, 462, 82, 462, 83, 462, 88, 462, 89, 462, 90, 462, 120,
+	462, 121, 462, 122, 462, 126, 462, 127, 462, 128, 462, 131, 462, 132, 462,
+	134, 462, 135, 462, 136, 462, 137, 462, 138, 462, 139, 462, 141, 462, 143,
+	462, 152, 462, 159, 462, 164, 462, 165, 462, 169, 462, 170, 462, 173, 462,
+	177, 462, 181, 462, 190, 462, 193, 462, 221, 462, 238, 462, 244, 462, 255,
+	462, 262, 462, 263, 462, 273, 462, 275, 462, 277, 462, 281, 462, 282, 462,
+	286, 462, 294, 462, 299, 462, 301, 462, 307, 462, 309, 462, 317, 462, 319,
+	462, 323, 462, 333, 462, 335, 462, 339, 462, 364, 462, 367, 462, -1, -2, 33,
+	-1, 489, -1, 492, -1, 5, 602, 32, 602, 34, 602, 39, 602, 51, 602, 60, 602,
+	63, 602, 69, 602, 72, 602, 77, 602, 78, 602, 81, 602, 82, 602, 83, 602, 88,
+	602, 89, 602, 90, 602, 120, 602, 121, 602, 122, 602, 126, 602, 127, 602,
+	128, 602, 131, 602, 132, 602, 134, 602, 135, 602, 136, 602, 137, 602, 138,
+	602, 139, 602, 141, 602, 143, 602, 152, 602, 159, 602, 164, 602, 165, 602,
+	169, 602, 170, 602, 173, 602, 177, 602, 181, 602, 190, 602, 193, 602, 221,
+	602, 238, 602, 244, 602, 255, 602, 262, 602, 263, 602, 273, 602, 275, 602,
+	277, 602, 281, 602, 282, 602, 286, 602, 294, 602, 299, 602, 301, 602, 307,
+	602, 309, 602, 317, 602, 319, 602, 323, 602, 333, 602, 335, 602, 339, 602,
+	364, 602, 367, 602, 498, 1474, -1, -2, 489, -1, 5, 478, 32, 478, 34, 478,
+	39, 478, 51, 478, 60, 478, 63, 478, 69, 478, 72, 478, 77, 478, 78, 478, 81,
+	478, 82, 478, 83, 478, 88, 478, 89, 478, 90, 478, 120, 478, 121, 478, 122,
+	478, 126, 478, 127, 478, 128, 478, 131, 478, 132, 478, 134, 478, 135, 478,
+	136, 478, 137, 478, 138, 478, 139, 478, 141, 478, 143, 478, 152, 478, 159,
+	478, 164, 478, 165, 478, 169, 478, 170, 478, 173, 478, 177, 478, 181, 478,
+	190, 478, 193, 478, 221, 478, 238, 478, 244, 478, 255, 478, 262, 478, 263,
+	478, 273, 478, 275, 478, 277, 478, 281, 478, 282, 478, 286, 478, 294, 478,
+	299, 478, 301, 478, 307, 478, 309, 478, 317, 478, 319, 478, 323, 478, 333,
+	478, 335, 478, 339, 478, 364, 478, 367, 478, -1, -2, 33, -1, 489, -1, 492,
+	-1, 5, 556, 32, 556, 34, 556, 39, 556, 51, 556, 60, 556, 63, 556, 69, 556,
+	72, 556, 77, 556, 78, 556, 81, 556, 82, 556, 83, 556, 88, 556, 89, 556, 90,
+	556, 120, 556, 121, 556, 122, 556, 126, 556, 127, 556, 128, 556, 131, 556,
+	132, 556, 134, 556, 135, 556, 136, 556, 137, 556, 138, 556, 139, 556, 141,
+	556, 143, 556, 152, 556, 159, 556, 164, 556, 165, 556, 169, 556, 170, 556,
+	173, 556, 177, 556, 181, 556, 190, 556, 193, 556, 221, 556, 238, 556, 244,
+	556, 255, 556, 262, 556, 263, 556, 273, 556, 275, 556, 277, 556, 281, 556,
+	282, 556, 286, 556, 294, 556, 299, 556, 301, 556, 307, 556, 309, 556, 317,
+	556, 319, 556, 323, 556, 333, 556, 335, 556, 339, 556, 364, 556, 367, 556,
+	498, 1474, -1, -2, 162, -1, 5, 1496, 26, 1496, 68, 1496, 109, 1496, 130,
+	1496, 133, 1496, 146, 1496, 172, 1496, 184, 1496, 246, 1496, 254, 1496, 314,
+	1496, 342, 1496, 355, 1496, 357, 1496, 494, 1496, 496, 1496, 499, 1496, -1,
+	-2, 489, -1, 5, 444, 32, 444, 34, 444, 39, 444, 51, 444, 60, 444, 63, 444,
+	69, 444, 72, 444, 77, 444, 78, 444, 81, 444, 82, 444, 83, 444, 88, 444, 89,
+	444, 90, 444, 120, 444, 121, 444, 122, 444, 126, 444, 127, 444, 128, 444,
+	131, 444, 132, 444, 134, 444, 135, 444, 136, 444, 137, 444, 138, 444, 139,
+	444, 141, 444, 143, 444, 152, 444, 159, 444, 164, 444, 165, 444, 169, 444,
+	170, 444, 173, 444, 177, 444, 181, 444, 190, 444, 193, 444, 221, 444, 238,
+	444, 244, 444, 255, 444, 262, 444, 263, 444, 273, 444, 275, 444, 277, 444,
+	281, 444, 282, 444, 286, 444, 294, 444, 299, 444, 301, 444, 307, 444, 309,
+	444, 317, 444, 319, 444, 323, 444, 333, 444, 335, 444, 339, 444, 364, 444,
+	367, 444, -1, -2, 489, -1, 5, 577, 32, 577, 34, 577, 39, 577, 51, 577, 60,
+	577, 63, 577, 69, 577, 72, 577, 77, 577, 78, 577, 81, 577, 82, 577, 83, 577,
+	88, 577, 89, 577, 90, 577, 120, 577, 121, 577, 122, 577, 126, 577, 127, 577,
+	128, 577, 131, 577, 132, 577, 134, 577, 135, 577, 136, 577, 137, 577, 138,
+	577, 139, 577, 141, 577, 143, 577, 152, 577, 159, 577, 164, 577, 165, 577,
+	169, 577, 170, 577, 173, 577, 177, 577, 181, 577, 190, 577, 193, 577, 221,
+	577, 238, 577, 244, 577, 255, 577, 262, 577, 263, 577, 273, 577, 275, 577,
+	277, 577, 281, 577, 282, 577, 286, 577, 294, 577, 299, 577, 301, 577, 307,
+	577, 309, 577, 317, 577, 319, 577, 323, 577, 333, 577, 335, 577, 339, 577,
+	364, 577, 367, 577, -1, -2, 489, -1, 5, 505, 32, 505, 34, 505, 39, 505, 51,
+	505, 60, 505, 63, 505, 69, 505, 72, 505, 77, 505, 78, 505, 81, 505, 82, 505,
+	83, 505, 88, 505, 89, 505, 90, 505, 120, 505, 121, 505, 122, 505, 126, 505,
+	127, 505, 128, 505, 131, 505, 132, 505, 134, 505, 135, 505, 136, 505, 137,
+	505, 138, 505, 139, 505, 141, 505, 143, 505, 152, 505, 159, 505, 164, 505,
+	165, 505, 169, 505, 170, 505, 173, 505, 177, 505, 181, 505, 190, 505, 193,
+	505, 221, 505, 238, 505, 244, 505, 255, 505, 262, 505, 263, 505, 273, 505,
+	275, 505, 277, 505, 281, 505, 282, 505, 286, 505, 294, 505, 299, 505, 301,
+	505, 307, 505, 309, 505, 317, 505, 319, 505, 323, 505, 333, 505, 335, 505,
+	339, 505, 364, 505, 367, 505, -1, -2, 489, -1, 5, 473, 32, 473, 34, 473, 39,
+	473, 51, 473, 60, 473, 63, 473, 69, 473, 72, 473, 77, 473, 78, 473, 81, 473,
+	82, 473, 83, 473, 88, 473, 89, 473, 90, 473, 120, 473, 121, 473, 122, 473,
+	126, 473, 127, 473, 128, 473, 131, 473, 132, 473, 134, 473, 135, 473, 136,
+	473, 137, 473, 138, 473, 139, 473, 141, 473, 143, 473, 152, 473, 159, 473,
+	164, 473, 165, 473, 169, 473, 170, 473, 173, 473, 177, 473, 181, 473, 190,
+	473, 193, 473, 221, 473, 238, 473, 244, 473, 255, 473, 262, 473, 263, 473,
+	273, 473, 275, 473, 277, 473, 281, 473, 282, 473, 286, 473, 294, 473, 299,
+	473, 301, 473, 307, 473, 309, 473, 317, 473, 319, 473, 323, 473, 333, 473,
+	335, 473, 339, 473, 364, 473, 367, 473, -1, -2, 489, -1, 5, 472, 32, 472,
+	34, 472, 39, 472, 51, 472, 60, 472, 63, 472, 69, 472, 72, 472, 77, 472, 78,
+	472, 81, 472, 82, 472, 83, 472, 88, 472, 89, 472, 90, 472, 120, 472, 121,
+	472, 122, 472, 126, 472, 127, 472, 128, 472, 131, 472, 132, 472, 134, 472,
+	135, 472, 136, 472, 137, 472, 138, 472, 139, 472, 141, 472, 143, 472, 152,
+	472, 159, 472, 164, 472, 165, 472, 169, 472, 170, 472, 173, 472, 177, 472,
+	181, 472, 190, 472, 193, 472, 221, 472, 238, 472, 244, 472, 255, 472, 262,
+	472, 263, 472, 273, 472, 275, 472, 277, 472, 281, 472, 282, 472, 286, 472,
+	294, 472, 299, 472, 301, 472, 307, 472, 309, 472, 317, 472, 319, 472, 323,
+	472, 333, 472, 335, 472, 339, 472, 364, 472, 367, 472, -1, -2, 489, -1, 5,
+	575, 32, 575, 34, 575, 39, 575, 51, 575, 60, 575, 63, 575, 69, 575, 72, 575,
+	77, 575, 78, 575, 81, 575, 82, 575, 83, 575, 88, 575, 89, 575, 90, 575, 120,
+	575, 121, 575, 122, 575, 126, 575, 127, 575, 128, 575, 131, 575, 132, 575,
+	134, 575, 135, 575, 136, 575, 137, 575, 138, 575, 139, 575, 141, 575, 143,
+	575, 152, 575, 159, 575, 164, 575, 165, 575, 169, 575, 170, 575, 173, 575,
+	177, 575, 181, 575, 190, 575, 193, 575, 221, 575, 238, 575, 244, 575, 255,
+	575, 262, 575, 263, 575, 273, 575, 275, 575, 277, 575, 281, 575, 282, 575,
+	286, 575, 294, 575, 299, 575, 301, 575, 307, 575, 309, 575, 317, 575, 319,
+	575, 323, 575, 333, 575, 335, 575, 339, 575, 364, 575, 367, 575, -1, -2, 4,
+	-1, 5, -1, 23, -1, 24, -1, 32, -1, 33, -1, 34, -1, 51, -1, 60, -1, 61, -1,
+	69, -1, 70, -1, 76, -1, 111, -1, 120, -1, 121, -1, 122, -1, 123, -1, 126,
+	-1, 127, -1, 131, -1, 132, -1, 134, -1, 135, -1, 136, -1, 137, -1, 139, -1,
+	141, -1, 143, -1, 152, -1, 164, -1, 165, -1, 169, -1, 181, -1, 190, -1, 200,
+	-1, 212, -1, 225, -1, 238, -1, 245, -1, 255, -1, 273, -1, 275, -1, 277, -1,
+	281, -1, 282, -1, 286, -1, 294, -1, 301, -1, 316, -1, 317, -1, 319, -1, 323,
+	-1, 328, -1, 335, -1, 364, -1, 366, -1, 367, -1, 492, -1, 494, -1, 496, -1,
+	499, -1, 498, 1474, -1, -2, 5, -1, 10, -1, 26, -1, 68, -1, 109, -1, 130, -1,
+	133, -1, 146, -1, 172, -1, 184, -1, 246, -1, 254, -1, 314, -1, 342, -1, 355,
+	-1, 357, -1, 368, -1, 369, -1, 370, -1, 371, -1, 372, -1, 373, -1, 374, -1,
+	375, -1, 376, -1, 377, -1, 378, -1, 379, -1, 380, -1, 381, -1, 382, -1, 383,
+	-1, 384, -1, 385, -1, 386, -1, 387, -1, 388, -1, 389, -1, 390, -1, 391, -1,
+	392, -1, 393, -1, 394, -1, 395, -1, 396, -1, 490, -1, 494, -1, 496, -1, 499,
+	-1, 33, 203, 492, 203, 498, 203, -1, -2, 489, -1, 495, 615, -1, -2, 489, -1,
+	5, 667, 6, 667, 32, 667, 34, 667, 39, 667, 51, 667, 60, 667, 63, 667, 69,
+	667, 72, 667, 77, 667, 78, 667, 81, 667, 82, 667, 83, 667, 88, 667, 89, 667,
+	90, 667, 120, 667, 121, 667, 122, 667, 126, 667, 127, 667, 128, 667, 131,
+	667, 132, 667, 134, 667, 135, 667, 136, 667, 137, 667, 138, 667, 139, 667,
+	141, 667, 143, 667, 152, 667, 159, 667, 164, 667, 165, 667, 169, 667, 170,
+	667, 173, 667, 177, 667, 181, 667, 190, 667, 193, 667, 221, 667, 238, 667,
+	244, 667, 255, 667, 262, 667, 263, 667, 273, 667, 275, 667, 277, 667, 281,
+	667, 282, 667, 286, 667, 294, 667, 299, 667, 301, 667, 307, 667, 309, 667,
+	317, 667, 319, 667, 323, 667, 333, 667, 335, 667, 336, 667, 339, 667, 364,
+	667, 367, 667, 397, 667, 400, 667, 401, 667, 403, 667, 404, 667, 405, 667,
+	406, 667, 407, 667, 408, 667, 409, 667, 410, 667, 411, 667, 414, 667, 415,
+	667, 417, 667, 418, 667, 419, 667, 420, 667, 421, 667, 422, 667, 423, 667,
+	424, 667, 425, 667, 426, 667, 427, 667, 428, 667, 429, 667, 430, 667, 431,
+	667, 432, 667, 433, 667, 434, 667, 435, 667, 436, 667, 437, 667, 438, 667,
+	440, 667, 441, 667, 442, 667, 443, 667, 444, 667, 445, 667, 446, 667, 447,
+	667, 448, 667, 449, 667, 450, 667, 451, 667, 452, 667, 453, 667, 454, 667,
+	455, 667, 458, 667, 459, 667, 460, 667, 461, 667, 462, 667, 463, 667, 465,
+	667, 466, 667, 467, 667, 469, 667, 470, 667, 476, 667, 477, 667, 478, 667,
+	479, 667, 480, 667, 481, 667, 482, 667, 484, 667, 485, 667, 486, 667, 487,
+	667, 488, 667, 497, 667, -1, -2, 489, -1, 495, 665, -1, -2, 489, -1, 5, 669,
+	6, 669, 32, 669, 34, 669, 39, 669, 51, 669, 60, 669, 63, 669, 69, 669, 72,
+	669, 77, 669, 78, 669, 81, 669, 82, 669, 83, 669, 88, 669, 89, 669, 90, 669,
+	120, 669, 121, 669, 122, 669, 126, 669, 127, 669, 128, 669, 131, 669, 132,
+	669, 134, 669, 135, 669, 136, 669, 137, 669, 138, 669, 139, 669, 141, 669,
+	143, 669, 152, 669, 159, 669, 164, 669, 165, 669, 169, 669, 170, 669, 173,
+	669, 177, 669, 181, 669, 190, 669, 193, 669, 221, 669, 238, 669, 244, 669,
+	255, 669, 262, 669, 263, 669, 273, 669, 275, 669, 277, 669, 281, 669, 282,
+	669, 286, 669, 294, 669, 299, 669, 301, 669, 307, 669, 309, 669, 317, 669,
+	319, 669, 323, 669, 333, 669, 335, 669, 336, 669, 339, 669, 364, 669, 367,
+	669, 397, 669, 400, 669, 401, 669, 403, 669, 404, 669, 405, 669, 406, 669,
+	407, 669, 408, 669, 409, 669, 410, 669, 411, 669, 414, 669, 415, 669, 417,
+	669, 418, 669, 419, 669, 420, 669, 421, 669, 422, 669, 423, 669, 424, 669,
+	425, 669, 426, 669, 427, 669, 428, 669, 429, 669, 430, 669, 431, 669, 432,
+	669, 433, 669, 434, 669, 435, 669, 436, 669, 437, 669, 438, 669, 440, 669,
+	441, 669, 442, 669, 443, 669, 444, 669, 445, 669, 446, 669, 447, 669, 448,
+	669, 449, 669, 450, 669, 451, 669, 452, 669, 453, 669, 454, 669, 455, 669,
+	458, 669, 459, 669, 460, 669, 461, 669, 462, 669, 463, 669, 465, 669, 466,
+	669, 467, 669, 469, 669, 470, 669, 476, 669, 477, 669, 478, 669, 479, 669,
+	480, 669, 481, 669, 482, 669, 484, 669, 485, 669, 486, 669, 487, 669, 488,
+	669, 497, 669, -1, -2, 489, -1, 5, 479, 32, 479, 34, 479, 39, 479, 51, 479,
+	60, 479, 63, 479, 69, 479, 72, 479, 77, 479, 78, 479, 81, 479, 82, 479, 83,
+	479, 88, 479, 89, 479, 90, 479, 120, 479, 121, 479, 122, 479, 126, 479, 127,
+	479, 128, 479, 131, 479, 132, 479, 134, 479, 135, 479, 136, 479, 137, 479,
+	138, 479, 139, 479, 141, 479, 143, 479, 152, 479, 159, 479, 164, 479, 165,
+	479, 169, 479, 170, 479, 173, 479, 177, 479, 181, 479, 190, 479, 193, 479,
+	221, 479, 238, 479, 244, 479, 255, 479, 262, 479, 263, 479, 273, 479, 275,
+	479, 277, 479, 281, 479, 282, 479, 286, 479, 294, 479, 299, 479, 301, 479,
+	307, 479, 309, 479, 317, 479, 319, 479, 323, 479, 333, 479, 335, 479, 339,
+	479, 364, 479, 367, 479, -1, -2, 489, -1, 5, 446, 32, 446, 34, 446, 39, 446,
+	51, 446, 60, 446, 63, 446, 69, 446, 72, 446, 77, 446, 78, 446, 81, 446, 82,
+	446, 83, 446, 88, 446, 89, 446, 90, 446, 120, 446, 121, 446, 122, 446, 126,
+	446, 127, 446, 128, 446, 131, 446, 132, 446, 134, 446, 135, 446, 136, 446,
+	137, 446, 138, 446, 139, 446, 141, 446, 143, 446, 152, 446, 159, 446, 164,
+	446, 165, 446, 169, 446, 170, 446, 173, 446, 177, 446, 181, 446, 190, 446,
+	193, 446, 221, 446, 238, 446, 244, 446, 255, 446, 262, 446, 263, 446, 273,
+	446, 275, 446, 277, 446, 281, 446, 282, 446, 286, 446, 294, 446, 299, 446,
+	301, 446, 307, 446, 309, 446, 317, 446, 319, 446, 323, 446, 333, 446, 335,
+	446, 339, 446, 364, 446, 367, 446, -1, -2, 489, -1, 5, 460, 32, 460, 34,
+	460, 39, 460, 51, 460, 60, 460, 63, 460, 69, 460, 72, 460, 77, 460, 78, 460,
+	81, 460, 82, 460, 83, 460, 88, 460, 89, 460, 90, 460, 120, 460, 121, 460,
+	122, 460, 126, 460, 127, 460, 128, 460, 131, 460, 132, 460, 134, 460, 135,
+	460, 136, 460, 137, 460, 138, 460, 139, 460, 141, 460, 143, 460, 152, 460,
+	159, 460, 164, 460, 165, 460, 169, 460, 170, 460, 173, 460, 177, 460, 181,
+	460, 190, 460, 193, 460, 221, 460, 238, 460, 244, 460, 255, 460, 262, 460,
+	263, 460, 273, 460, 275, 460, 277, 460, 281, 460, 282, 460, 286, 460, 294,
+	460, 299, 460, 301, 460, 307, 460, 309, 460, 317, 460, 319, 460, 323, 460,
+	333, 460, 335, 460, 339, 460, 364, 460, 367, 460, -1, -2, 489, -1, 5, 454,
+	32, 454, 34, 454, 39, 454, 51, 454, 60, 454, 63, 454, 69, 454, 72, 454, 77,
+	454, 78, 454, 81, 454, 82, 454, 83, 454, 88, 454, 89, 454, 90, 454, 120,
+	454, 121, 454, 122, 454, 126, 454, 127, 454, 128, 454, 131, 454, 132, 454,
+	134, 454, 135, 454, 136, 454, 137, 454, 138, 454, 139, 454, 141, 454, 143,
+	454, 152, 454, 159, 454, 164, 454, 165, 454, 169, 454, 170, 454, 173, 454,
+	177, 454, 181, 454, 190, 454, 193, 454, 221, 454, 238, 454, 244, 454, 255,
+	454, 262, 454, 263, 454, 273, 454, 275, 454, 277, 454, 281, 454, 282, 454,
+	286, 454, 294, 454, 299, 454, 301, 454, 307, 454, 309, 454, 317, 454, 319,
+	454, 323, 454, 333, 454, 335, 454, 339, 454, 364, 454, 367, 454, -1, -2,
+	489, -1, 5, 561, 32, 561, 34, 561, 39, 561, 51, 561, 60, 561, 63, 561, 69,
+	561, 72, 561, 77, 561, 78, 561, 81, 561, 82, 561, 83, 561, 88, 561, 89, 561,
+	90, 561, 120, 561, 121, 561, 122, 561, 126, 561, 127, 561, 128, 561, 131,
+	561, 132, 561, 134, 561, 135, 561, 136, 561, 137, 561, 138, 561, 139, 561,
+	141, 561, 143, 561, 152, 561, 159, 561, 164, 561, 165, 561, 169, 561, 170,
+	561, 173, 561, 177, 561, 181, 561, 190, 561, 193, 561, 221, 561, 238, 561,
+	244, 561, 255, 561, 262, 561, 263, 561, 273, 561, 275, 561, 277, 561, 281,
+	561, 282, 561, 286, 561, 294, 561, 299, 561, 301, 561, 307, 561, 309, 561,
+	317, 561, 319, 561, 323, 561, 333, 561, 335, 561, 339, 561, 364, 561, 367,
+	561, -1, -2, 489, -1, 5, 565, 32, 565, 34, 565, 39, 565, 51, 565, 60, 565,
+	63, 565, 69, 565, 72, 565, 77, 565, 78, 565, 81, 565, 82, 565, 83, 565, 88,
+	565, 89, 565, 90, 565, 120, 565, 121, 565, 122, 565, 126, 565, 127, 565,
+	128, 565, 131, 565, 132, 565, 134, 565, 135, 565, 136, 565, 137, 565, 138,
+	565, 139, 565, 141, 565, 143, 565, 152, 565, 159, 565, 164, 565, 165, 565,
+	169, 565, 170, 565, 173, 565, 177, 565, 181, 565, 190, 565, 193, 565, 221,
+	565, 238, 565, 244, 565, 255, 565, 262, 565, 263, 565, 273, 565, 275, 565,
+	277, 565, 281, 565, 282, 565, 286, 565, 294, 565, 299, 565, 301, 565, 307,
+	565, 309, 565, 317, 565, 319, 565, 323, 565, 333, 565, 335, 565, 339, 565,
+	364, 565, 367, 565, -1, -2, 489, -1, 5, 563, 32, 563, 34, 563, 39, 563, 51,
+	563, 60, 563, 63, 563, 69, 563, 72, 563, 77, 563, 78, 563, 81, 563, 82, 563,
+	83, 563, 88, 563, 89, 563, 90, 563, 120, 563, 121, 563, 122, 563, 126, 563,
+	127, 563, 128, 563, 131, 563, 132, 563, 134, 563, 135, 563, 136, 563, 137,
+	563, 138, 563, 139, 563, 141, 563, 143, 563, 152, 563, 159, 563, 164, 563,
+	165, 563, 169, 563, 170, 563, 173, 563, 177, 563, 181, 563, 190, 563, 193,
+	563, 221, 563, 238, 563, 244, 563, 255, 563, 262, 563, 263, 563, 273, 563,
+	275, 563, 277, 563, 281, 563, 282, 563, 286, 563, 294, 563, 299, 563, 301,
+	563, 307, 563, 309, 563, 317, 563, 319, 563, 323, 563, 333, 563, 335, 563,
+	339, 563, 364, 563, 367, 563, -1, -2, 489, -1, 5, 559, 32, 559, 34, 559, 39,
+	559, 51, 559, 60, 559, 63, 559, 69, 559, 72, 559, 77, 559, 78, 559, 81, 559,
+	82, 559, 83, 559, 88, 559, 89, 559, 90, 559, 120, 559, 121, 559, 122, 559,
+	126, 559, 127, 559, 128, 559, 131, 559, 132, 559, 134, 559, 135, 559, 136,
+	559, 137, 559, 138, 559, 139, 559, 141, 559, 143, 559, 152, 559, 159, 559,
+	164, 559, 165, 559, 169, 559, 170, 559, 173, 559, 177, 559, 181, 559, 190,
+	559, 193, 559, 221, 559, 238, 559, 244, 559, 255, 559, 262, 559, 263, 559,
+	273, 559, 275, 559, 277, 559, 281, 559, 282, 559, 286, 559, 294, 559, 299,
+	559, 301, 559, 307, 559, 309, 559, 317, 559, 319, 559, 323, 559, 333, 559,
+	335, 559, 339, 559, 364, 559, 367, 559, -1, -2, 489, -1, 5, 466, 32, 466,
+	34, 466, 39, 466, 51, 466, 60, 466, 63, 466, 69, 466, 72, 466, 77, 466, 78,
+	466, 81, 466, 82, 466, 83, 466, 88, 466, 89, 466, 90, 466, 120, 466, 121,
+	466, 122, 466, 126, 466, 127, 466, 128, 466, 131, 466, 132, 466, 134, 466,
+	135, 466, 136, 466, 137, 466, 138, 466, 139, 466, 141, 466, 143, 466, 152,
+	466, 159, 466, 164, 466, 165, 466, 169, 466, 170, 466, 173, 466, 177, 466,
+	181, 466, 190, 466, 193, 466, 221, 466, 238, 466, 244, 466, 255, 466, 262,
+	466, 263, 466, 273, 466, 275, 466, 277, 466, 281, 466, 282, 466, 286, 466,
+	294, 466, 299, 466, 301, 466, 307, 466, 309, 466, 317, 466, 319, 466, 323,
+	466, 333, 466, 335, 466, 339, 466, 364, 466, 367, 466, -1, -2, 489, -1, 5,
+	450, 32, 450, 34, 450, 39, 450, 51, 450, 60, 450, 63, 450, 69, 450, 72, 450,
+	77, 450, 78, 450, 81, 450, 82, 450, 83, 450, 88, 450, 89, 450, 90, 450, 120,
+	450, 121, 450, 122, 450, 126, 450, 127, 450, 128, 450, 131, 450, 132, 450,
+	134, 450, 135, 450, 136, 450, 137, 450, 138, 450, 139, 450, 141, 450, 143,
+	450, 152, 450, 159, 450, 164, 450, 165, 450, 169, 450, 170, 450, 173, 450,
+	177, 450, 181, 450, 190, 450, 193, 450, 221, 450, 238, 450, 244, 450, 255,
+	450, 262, 450, 263, 450, 273, 450, 275, 450, 277, 450, 281, 450, 282, 450,
+	286, 450, 294, 450, 299, 450, 301, 450, 307, 450, 309, 450, 317, 450, 319,
+	450, 323, 450, 333, 450, 335, 450, 339, 450, 364, 450, 367, 450, -1, -2,
+	489, -1, 5, 580, 32, 580, 34, 580, 39, 580, 51, 580, 60, 580, 63, 580, 69,
+	580, 72, 580, 77, 580, 78, 580, 81, 580, 82, 580, 83, 580, 88, 580, 89, 580,
+	90, 580, 120, 580, 121, 580, 122, 580, 126, 580, 127, 580, 128, 580, 131,
+	580, 132, 580, 134, 580, 135, 580, 136, 580, 137, 580, 138, 580, 139, 580,
+	141, 580, 143, 580, 152, 580, 159, 580, 164, 580, 165, 580, 169, 580, 170,
+	580, 173, 580, 177, 580, 181, 580, 190, 580, 193, 580, 221, 580, 238, 580,
+	244, 580, 255, 580, 262, 580, 263, 580, 273, 580, 275, 580, 277, 580, 281,
+	580, 282, 580, 286, 580, 294, 580, 299, 580, 301, 580, 307, 580, 309, 580,
+	317, 580, 319, 580, 323, 580, 333, 580, 335, 580, 339, 580, 364, 580, 367,
+	580, -1, -2, 489, -1, 495, 651, -1, -2, 489, -1, 5, 490, 32, 490, 34, 490,
+	39, 490, 51, 490, 60, 490, 63, 490, 69, 490, 72, 490, 77, 490, 78, 490, 81,
+	490, 82, 490, 83, 490, 88, 490, 89, 490, 90, 490, 120, 490, 121, 490, 122,
+	490, 126, 490, 127, 490, 128, 490, 131, 490, 132, 490, 134, 490, 135, 490,
+	136, 490, 137, 490, 138, 490, 139, 490, 141, 490, 143, 490, 152, 490, 159,
+	490, 164, 490, 165, 490, 169, 490, 170, 490, 173, 490, 177, 490, 181, 490,
+	190, 490, 193, 490, 221, 490, 238, 490, 244, 490, 255, 490, 262, 490, 263,
+	490, 273, 490, 275, 490, 277, 490, 281, 490, 282, 490, 286, 490, 294, 490,
+	299, 490, 301, 490, 307, 490, 309, 490, 317, 490, 319, 490, 323, 490, 333,
+	490, 335, 490, 339, 490, 364, 490, 367, 490, -1, -2, 489, -1, 5, 573, 32,
+	573, 34, 573, 39, 573, 51, 573, 60, 573, 63, 573, 69, 573, 72, 573, 77, 573,
+	78, 573, 81, 573, 82, 573, 83, 573, 88, 573, 89, 573, 90, 573, 120, 573,
+	121, 573, 122, 573, 126, 573, 127, 573, 128, 573, 131, 573, 132, 573, 134,
+	573, 135, 573, 136, 573, 137, 573, 138, 573, 139, 573, 141, 573, 143, 573,
+	152, 573, 159, 573, 164, 573, 165, 573, 169, 573, 170, 573, 173, 573, 177,
+	573, 181, 573, 190, 573, 193, 573, 221, 573, 238, 573, 244, 573, 255, 573,
+	262, 573, 263, 573, 273, 573, 275, 573, 277, 573, 281, 573, 282, 573, 286,
+	573, 294, 573, 299, 573, 301, 573, 307, 573, 309, 573, 317, 573, 319, 573,
+	323, 573, 333, 573, 335, 573, 339, 573, 364, 573, 367, 573, -1, -2, 489, -1,
+	5, 605, 32, 605, 34, 605, 39, 605, 51, 605, 60, 605, 63, 605, 69, 605, 72,
+	605, 77, 605, 78, 605, 81, 605, 82, 605, 83, 605, 88, 605, 89, 605, 90, 605,
+	120, 605, 121, 605, 122, 605, 126, 605, 127, 605, 128, 605, 131, 605, 132,
+	605, 134, 605, 135, 605, 136, 605, 137, 605, 138, 605, 139, 605, 141, 605,
+	143, 605, 152, 605, 159, 605, 164, 605, 165, 605, 169, 605, 170, 605, 173,
+	605, 177, 605, 181, 605, 190, 605, 193, 605, 221, 605, 238, 605, 244, 605,
+	255, 605, 262, 605, 263, 605, 273, 605, 275, 605, 277, 605, 281, 605, 282,
+	605, 286, 605, 294, 605, 299, 605, 301, 605, 307, 605, 309, 605, 317, 605,
+	319, 605, 323, 605, 333, 605, 335, 605, 339, 605, 364, 605, 367, 605, -1,
+	-2, 489, -1, 5, 512, 32, 512, 34, 512, 39, 512, 51, 512, 60, 512, 63, 512,
+	69, 512, 72, 512, 77, 512, 78, 512, 81, 512, 82, 512, 83, 512, 88, 512, 89,
+	512, 90, 512, 120, 512, 121, 512, 122, 512, 126, 512, 127, 512, 128, 512,
+	131, 512, 132, 512, 134, 512, 135, 512, 136, 512, 137, 512, 138, 512, 139,
+	512, 141, 512, 143, 512, 152, 512, 159, 512, 164, 512, 165, 512, 169, 512,
+	170, 512, 173, 512, 177, 512, 181, 512, 190, 512, 193, 512, 221, 512, 238,
+	512, 244, 512, 255, 512, 262, 512, 263, 512, 273, 512, 275, 512, 277, 512,
+	281, 512, 282, 512, 286, 512, 294, 512, 299, 512, 301, 512, 307, 512, 309,
+	512, 317, 512, 319, 512, 323, 512, 333, 512, 335, 512, 339, 512, 364, 512,
+	367, 512, -1, -2, 489, -1, 5, 470, 32, 470, 34, 470, 39, 470, 51, 470, 60,
+	470, 63, 470, 69, 470, 72, 470, 77, 470, 78, 470, 81, 470, 82, 470, 83, 470,
+	88, 470, 89, 470, 90, 470, 120, 470, 121, 470, 122, 470, 126, 470, 127, 470,
+	128, 470, 131, 470, 132, 470, 134, 470, 135, 470, 136, 470, 137, 470, 138,
+	470, 139, 470, 141, 470, 143, 470, 152, 470, 159, 470, 164, 470, 165, 470,
+	169, 470, 170, 470, 173, 470, 177, 470, 181, 470, 190, 470, 193, 470, 221,
+	470, 238, 470, 244, 470, 255, 470, 262, 470, 263, 470, 273, 470, 275, 470,
+	277, 470, 281, 470, 282, 470, 286, 470, 294, 470, 299, 470, 301, 470, 307,
+	470, 309, 470, 317, 470, 319, 470, 323, 470, 333, 470, 335, 470, 339, 470,
+	364, 470, 367, 470, -1, -2, 489, -1, 5, 452, 32, 452, 34, 452, 39, 452, 51,
+	452, 60, 452, 63, 452, 69, 452, 72, 452, 77, 452, 78, 452, 81, 452, 82, 452,
+	83, 452, 88, 452, 89, 452, 90, 452, 120, 452, 121, 452, 122, 452, 126, 452,
+	127, 452, 128, 452, 131, 452, 132, 452, 134, 452, 135, 452, 136, 452, 137,
+	452, 138, 452, 139, 452, 141, 452, 143, 452, 152, 452, 159, 452, 164, 452,
+	165, 452, 169, 452, 170, 452, 173, 452, 177, 452, 181, 452, 190, 452, 193,
+	452, 221, 452, 238, 452, 244, 452, 255, 452, 262, 452, 263, 452, 273, 452,
+	275, 452, 277, 452, 281, 452, 282, 452, 286, 452, 294, 452, 299, 452, 301,
+	452, 307, 452, 309, 452, 317, 452, 319, 452, 323, 452, 333, 452, 335, 452,
+	339, 452, 364, 452, 367, 452, -1, -2, 489, -1, 5, 475, 32, 475, 34, 475, 39,
+	475, 51, 475, 60, 475, 63, 475, 69, 475, 72, 475, 77, 475, 78, 475, 81, 475,
+	82, 475, 83, 475, 88, 475, 89, 475, 90, 475, 120, 475, 121, 475, 122, 475,
+	126, 475, 127, 475, 128, 475, 131, 475, 132, 475, 134, 475, 135, 475, 136,
+	475, 137, 475, 138, 475, 139, 475, 141, 475, 143, 475, 152, 475, 159, 475,
+	164, 475, 165, 475, 169, 475, 170, 475, 173, 475, 177, 475, 181, 475, 190,
+	475, 193, 475, 221, 475, 238, 475, 244, 475, 255, 475, 262, 475, 263, 475,
+	273, 475, 275, 475, 277, 475, 281, 475, 282, 475, 286, 475, 294, 475, 299,
+	475, 301, 475, 307, 475, 309, 475, 317, 475, 319, 475, 323, 475, 333, 475,
+	335, 475, 339, 475, 364, 475, 367, 475, -1, -2, 489, -1, 5, 585, 32, 585,
+	34, 585, 39, 585, 51, 585, 60, 585, 63, 585, 69, 585, 72, 585, 77, 585, 78,
+	585, 81, 585, 82, 585, 83, 585, 88, 585, 89, 585, 90, 585, 120, 585, 121,
+	585, 122, 585, 126, 585, 127, 585, 128, 585, 131, 585, 132, 585, 134, 585,
+	135, 585, 136, 585, 137, 585, 138, 585, 139, 585, 141, 585, 143, 585, 152,
+	585, 159, 585, 164, 585, 165, 585, 169, 585, 170, 585, 173, 585, 177, 585,
+	181, 585, 190, 585, 193, 585, 221, 585, 238, 585, 244, 585, 255, 585, 262,
+	585, 263, 585, 273, 585, 275, 585, 277, 585, 281, 585, 282, 585, 286, 585,
+	294, 585, 299, 585, 301, 585, 307, 585, 309, 585, 317, 585, 319, 585, 323,
+	585, 333, 585, 335, 585, 339, 585, 364, 585, 367, 585, -1, -2, 489, -1, 5,
+	571, 32, 571, 34, 571, 39, 571, 51, 571, 60, 571, 63, 571, 69, 571, 72, 571,
+	77, 571, 78, 571, 81, 571, 82, 571, 83, 571, 88, 571, 89, 571, 90, 571, 120,
+	571, 121, 571, 122, 571, 126, 571, 127, 571, 128, 571, 131, 571, 132, 571,
+	134, 571, 135, 571, 136, 571, 137, 571, 138, 571, 139, 571, 141, 571, 143,
+	571, 152, 571, 159, 571, 164, 571, 165, 571, 169, 571, 170, 571, 173, 571,
+	177, 571, 181, 571, 190, 571, 193, 571, 221, 571, 238, 571, 244, 571, 255,
+	571, 262, 571, 263, 571, 273, 571, 275, 571, 277, 571, 281, 571, 282, 571,
+	286, 571, 294, 571, 299, 571, 301, 571, 307, 571, 309, 571, 317, 571, 319,
+	571, 323, 571, 333, 571, 335, 571, 339, 571, 364, 571, 367, 571, -1, -2,
+	489, -1, 5, 458, 32, 458, 34, 458, 39, 458, 51, 458, 60, 458, 63, 458, 69,
+	458, 72, 458, 77, 458, 78, 458, 81, 458, 82, 458, 83, 458, 88, 458, 89, 458,
+	90, 458, 120, 458, 121, 458, 122, 458, 126, 458, 127, 458, 128, 458, 131,
+	458, 132, 458, 134, 458, 135, 458, 136, 458, 137, 458, 138, 458, 139, 458,
+	141, 458, 143, 458, 152, 458, 159, 458, 164, 458, 165, 458, 169, 458, 170,
+	458, 173, 458, 177, 458, 181, 458, 190, 458, 193, 458, 221, 458, 238, 458,
+	244, 458, 255, 458, 262, 458, 263, 458, 273, 458, 275, 458, 277, 458, 281,
+	458, 282, 458, 286, 458, 294, 458, 299, 458, 301, 458, 307, 458, 309, 458,
+	317, 458, 319, 458, 323, 458, 333, 458, 335, 458, 339, 458, 364, 458, 367,
+	458, -1, -2, 489, -1, 5, 557, 32, 557, 34, 557, 39, 557, 51, 557, 60, 557,
+	63, 557, 69, 557, 72, 557, 77, 557, 78, 557, 81, 557, 82, 557, 83, 557, 88,
+	557, 89, 557, 90, 557, 120, 557, 121, 557, 122, 557, 126, 557, 127, 557,
+	128, 557, 131, 557, 132, 557, 134, 557, 135, 557, 136, 557, 137, 557, 138,
+	557, 139, 557, 141, 557, 143, 557, 152, 557, 159, 557, 164, 557, 165, 557,
+	169, 557, 170, 557, 173, 557, 177, 557, 181, 557, 190, 557, 193, 557, 221,
+	557, 238, 557, 244, 557, 255, 557, 262, 557, 263, 557, 273, 557, 275, 557,
+	277, 557, 281, 557, 282, 557, 286, 557, 294, 557, 299, 557, 301, 557, 307,
+	557, 309, 557, 317, 557, 319, 557, 323, 557, 333, 557, 335, 557, 339, 557,
+	364, 557, 367, 557, -1, -2, 489, -1, 5, 468, 32, 468, 34, 468, 39, 468, 51,
+	468, 60, 468, 63, 468, 69, 468, 72, 468, 77, 468, 78, 468, 81, 468, 82, 468,
+	83, 468, 88, 468, 89, 468, 90, 468, 120, 468, 121, 468, 122, 468, 126, 468,
+	127, 468, 128, 468, 131, 468, 132, 468, 134, 468, 135, 468, 136, 468, 137,
+	468, 138, 468, 139, 468, 141, 468, 143, 468, 152, 468, 159, 468, 164, 468,
+	165, 468, 169, 468, 170, 468, 173, 468, 177, 468, 181, 468, 190, 468, 193,
+	468, 221, 468, 238, 468, 244, 468, 255, 468, 262, 468, 263, 468, 273, 468,
+	275, 468, 277, 468, 281, 468, 282, 468, 286, 468, 294, 468, 299, 468, 301,
+	468, 307, 468, 309, 468, 317, 468, 319, 468, 323, 468, 333, 468, 335, 468,
+	339, 468, 364, 468, 367, 468, -1, -2, 489, -1, 5, 569, 32, 569, 34, 569, 39,
+	569, 51, 569, 60, 569, 63, 569, 69, 569, 72, 569, 77, 569, 78, 569, 81, 569,
+	82, 569, 83, 569, 88, 569, 89, 569, 90, 569, 120, 569, 121, 569, 122, 569,
+	126, 569, 127, 569, 128, 569, 131, 569, 132, 569, 134, 569, 135, 569, 136,
+	569, 137, 569, 138, 569, 139, 569, 141, 569, 143, 569, 152, 569, 159, 569,
+	164, 569, 165, 569, 169, 569, 170, 569, 173, 569, 177, 569, 181, 569, 190,
+	569, 193, 569, 221, 569, 238, 569, 244, 569, 255, 569, 262, 569, 263, 569,
+	273, 569, 275, 569, 277, 569, 281, 569, 282, 569, 286, 569, 294, 569, 299,
+	569, 301, 569, 307, 569, 309, 569, 317, 569, 319, 569, 323, 569, 333, 569,
+	335, 569, 339, 569, 364, 569, 367, 569, -1, -2, 489, -1, 5, 463, 32, 463,
+	34, 463, 39, 463, 51, 463, 60, 463, 63, 463, 69, 463, 72, 463, 77, 463, 78,
+	463, 81, 463, 82, 463, 83, 463, 88, 463, 89, 463, 90, 463, 120, 463, 121,
+	463, 122, 463, 126, 463, 127, 463, 128, 463, 131, 463, 132, 463, 134, 463,
+	135, 463, 136, 463, 137, 463, 138, 463, 139, 463, 141, 463, 143, 463, 152,
+	463, 159, 463, 164, 463, 165, 463, 169, 463, 170, 463, 173, 463, 177, 463,
+	181, 463, 190, 463, 193, 463, 221, 463, 238, 463, 244, 463, 255, 463, 262,
+	463, 263, 463, 273, 463, 275, 463, 277, 463, 281, 463, 282, 463, 286, 463,
+	294, 463, 299, 463, 301, 463, 307, 463, 309, 463, 317, 463, 319, 463, 323,
+	463, 333, 463, 335, 463, 339, 463, 364, 463, 367, 463, -1, -2, 489, -1, 5,
+	520, 32, 520, 34, 520, 39, 520, 51, 520, 60, 520, 63, 520, 69, 520, 72, 520,
+	77, 520, 78, 520, 81, 520, 82, 520, 83, 520, 88, 520, 89, 520, 90, 520, 120,
+	520, 121, 520, 122, 520, 126, 520, 127, 520, 128, 520, 131, 520, 132, 520,
+	134, 520, 135, 520, 136, 520, 137, 520, 138, 520, 139, 520, 141, 520, 143,
+	520, 152, 520, 159, 520, 164, 520, 165, 520, 169, 520, 170, 520, 173, 520,
+	177, 520, 181, 520, 190, 520, 193, 520, 221, 520, 238, 520, 244, 520, 255,
+	520, 262, 520, 263, 520, 273, 520, 275, 520, 277, 520, 281, 520, 282, 520,
+	286, 520, 294, 520, 299, 520, 301, 520, 307, 520, 309, 520, 317, 520, 319,
+	520, 323, 520, 333, 520, 335, 520, 339, 520, 364, 520, 367, 520, -1, -2,
+	489, -1, 5, 448, 32, 448, 34, 448, 39, 448, 51, 448, 60, 448, 63, 448, 69,
+	448, 72, 448, 77, 448, 78, 448, 81, 448, 82, 448, 83, 448, 88, 448, 89, 448,
+	90, 448, 120, 448, 121, 448, 122, 448, 126, 448, 127, 448, 128, 448, 131,
+	448, 132, 448, 134, 448, 135, 448, 136, 448, 137, 448, 138, 448, 139, 448,
+	141, 448, 143, 448, 152, 448, 159, 448, 164, 448, 165, 448, 169, 448, 170,
+	448, 173, 448, 177, 448, 181, 448, 190, 448, 193, 448, 221, 448, 238, 448,
+	244, 448, 255, 448, 262, 448, 263, 448, 273, 448, 275, 448, 277, 448, 281,
+	448, 282, 448, 286, 448, 294, 448, 299, 448, 301, 448, 307, 448, 309, 448,
+	317, 448, 319, 448, 323, 448, 333, 448, 335, 448, 339, 448, 364, 448, 367,
+	448, -1, -2, 489, -1, 5, 553, 32, 553, 34, 553, 39, 553, 51, 553, 60, 553,
+	63, 553, 69, 553, 72, 553, 77, 553, 78, 553, 81, 553, 82, 553, 83, 553, 88,
+	553, 89, 553, 90, 553, 120, 553, 121, 553, 122, 553, 126, 553, 127, 553,
+	128, 553, 131, 553, 132, 553, 134, 553, 135, 553, 136, 553, 137, 553, 138,
+	553, 139, 553, 141, 553, 143, 553, 152, 553, 159, 553, 164, 553, 165, 553,
+	169, 553, 170, 553, 173, 553, 177, 553, 181, 553, 190, 553, 193, 553, 221,
+	553, 238, 553, 244, 553, 255, 553, 262, 553, 263, 553, 273, 553, 275, 553,
+	277, 553, 281, 553, 282, 553, 286, 553, 294, 553, 299, 553, 301, 553, 307,
+	553, 309, 553, 317, 553, 319, 553, 323, 553, 333, 553, 335, 553, 339, 553,
+	364, 553, 367, 553, -1, -2, 489, -1, 5, 456, 32, 456, 34, 456, 39, 456, 51,
+	456, 60, 456, 63, 456, 69, 456, 72, 456, 77, 456, 78, 456, 81, 456, 82, 456,
+	83, 456, 88, 456, 89, 456, 90, 456, 120, 456, 121, 456, 122, 456, 126, 456,
+	127, 456, 128, 456, 131, 456, 132, 456, 134, 456, 135, 456, 136, 456, 137,
+	456, 138, 456, 139, 456, 141, 456, 143, 456, 152, 456, 159, 456, 164, 456,
+	165, 456, 169, 456, 170, 456, 173, 456, 177, 456, 181, 456, 190, 456, 193,
+	456, 221, 456, 238, 456, 244, 456, 255, 456, 262, 456, 263, 456, 273, 456,
+	275, 456, 277, 456, 281, 456, 282, 456, 286, 456, 294, 456, 299, 456, 301,
+	456, 307, 456, 309, 456, 317, 456, 319, 456, 323, 456, 333, 456, 335, 456,
+	339, 456, 364, 456, 367, 456, -1, -2, 489, -1, 5, 567, 32, 567, 34, 567, 39,
+	567, 51, 567, 60, 567, 63, 567, 69, 567, 72, 567, 77, 567, 78, 567, 81, 567,
+	82, 567, 83, 567, 88, 567, 89, 567, 90, 567, 120, 567, 121, 567, 122, 567,
+	126, 567, 127, 567, 128, 567, 131, 567, 132, 567, 134, 567, 135, 567, 136,
+	567, 137, 567, 138, 567, 139, 567, 141, 567, 143, 567, 152, 567, 159, 567,
+	164, 567, 165, 567, 169, 567, 170, 567, 173, 567, 177, 567, 181, 567, 190,
+	567, 193, 567, 221, 567, 238, 567, 244, 567, 255, 567, 262, 567, 263, 567,
+	273, 567, 275, 567, 277, 567, 281, 567, 282, 567, 286, 567, 294, 567, 299,
+	567, 301, 567, 307, 567, 309, 567, 317, 567, 319, 567, 323, 567, 333, 567,
+	335, 567, 339, 567, 364, 567, 367, 567, -1, -2, 489, -1, 5, 461, 32, 461,
+	34, 461, 39, 461, 51, 461, 60, 461, 63, 461, 69, 461, 72, 461, 77, 461, 78,
+	461, 81, 461, 82, 461, 83, 461, 88, 461, 89, 461, 90, 461, 120, 461, 121,
+	461, 122, 461, 126, 461, 127, 461, 128, 461, 131, 461, 132, 461, 134, 461,
+	135, 461, 136, 461, 137, 461, 138, 461, 139, 461, 141, 461, 143, 461, 152,
+	461, 159, 461, 164, 461, 165, 461, 169, 461, 170, 461, 173, 461, 177, 461,
+	181, 461, 190, 461, 193, 461, 221, 461, 238, 461, 244, 461, 255, 461, 262,
+	461, 263, 461, 273, 461, 275, 461, 277, 461, 281, 461, 282, 461, 286, 461,
+	294, 461, 299, 461, 301, 461, 307, 461, 309, 461, 317, 461, 319, 461, 323,
+	461, 333, 461, 335, 461, 339, 461, 364, 461, 367, 461, -1, -2, 489, -1, 5,
+	601, 32, 601, 34, 601, 39, 601, 51, 601, 60, 601, 63, 601, 69, 601, 72, 601,
+	77, 601, 78, 601, 81, 601, 82, 601, 83, 601, 88, 601, 89, 601, 90, 601, 120,
+	601, 121, 601, 122, 601, 126, 601, 127, 601, 128, 601, 131, 601, 132, 601,
+	134, 601, 135, 601, 136, 601, 137, 601, 138, 601, 139, 601, 141, 601, 143,
+	601, 152, 601, 159, 601, 164, 601, 165, 601, 169, 601, 170, 601, 173, 601,
+	177, 601, 181, 601, 190, 601, 193, 601, 221, 601, 238, 601, 244, 601, 255,
+	601, 262, 601, 263, 601, 273, 601, 275, 601, 277, 601, 281, 601, 282, 601,
+	286, 601, 294, 601, 299, 601, 301, 601, 307, 601, 309, 601, 317, 601, 319,
+	601, 323, 601, 333, 601, 335, 601, 339, 601, 364, 601, 367, 601, -1, -2,
+	489, -1, 5, 477, 32, 477, 34, 477, 39, 477, 51, 477, 60, 477, 63, 477, 69,
+	477, 72, 477, 77, 477, 78, 477, 81, 477, 82, 477, 83, 477, 88, 477, 89, 477,
+	90, 477, 120, 477, 121, 477, 122, 477, 126, 477, 127, 477, 128, 477, 131,
+	477, 132, 477, 134, 477, 135, 477, 136, 477, 137, 477, 138, 477, 139, 477,
+	141, 477, 143, 477, 152, 477, 159, 477, 164, 477, 165, 477, 169, 477, 170,
+	477, 173, 477, 177, 477, 181, 477, 190, 477, 193, 477, 221, 477, 238, 477,
+	244, 477, 255, 477, 262, 477, 263, 477, 273, 477, 275, 477, 277, 477, 281,
+	477, 282, 477, 286, 477, 294, 477, 299, 477, 301, 477, 307, 477, 309, 477,
+	317, 477, 319, 477, 323, 477, 333, 477, 335, 477, 339, 477, 364, 477, 367,
+	477, -1, -2, 489, -1, 5, 555, 32, 555, 34, 555, 39, 555, 51, 555, 60, 555,
+	63, 555, 69, 555, 72, 555, 77, 555, 78, 555, 81, 555, 82, 555, 83, 555, 88,
+	555, 89, 555, 90, 555, 120, 555, 121, 555, 122, 555, 126, 555, 127, 555,
+	128, 555, 131, 555, 132, 555, 134, 555, 135, 555, 136, 555, 137, 555, 138,
+	555, 139, 555, 141, 555, 143, 555, 152, 555, 159, 555, 164, 555, 165, 555,
+	169, 555, 170, 555, 173, 555, 177, 555, 181, 555, 190, 555, 193, 555, 221,
+	555, 238, 555, 244, 555, 255, 555, 262, 555, 263, 555, 273, 555, 275, 555,
+	277, 555, 281, 555, 282, 555, 286, 555, 294, 555, 299, 555, 301, 555, 307,
+	555, 309, 555, 317, 555, 319, 555, 323, 555, 333, 555, 335, 555, 339, 555,
+	364, 555, 367, 555, -1, -2, 178, -1, 330, -1, 0, 1472, 4, 1472, 5, 1472, 7,
+	1472, 8, 1472, 9, 1472, 10, 1472, 25, 1472, 27, 1472, 33, 1472, 37, 1472,
+	38, 1472, 40, 1472, 41, 1472, 56, 1472, 64, 1472, 73, 1472, 91, 1472, 93,
+	1472, 97, 1472, 100, 1472, 102, 1472, 105, 1472, 142, 1472, 151, 1472, 155,
+	1472, 156, 1472, 161, 1472, 171, 1472, 186, 1472, 187, 1472, 192, 1472, 194,
+	1472, 202, 1472, 203, 1472, 205, 1472, 207, 1472, 208, 1472, 209, 1472, 210,
+	1472, 211, 1472, 213, 1472, 215, 1472, 216, 1472, 217, 1472, 218, 1472, 219,
+	1472, 220, 1472, 226, 1472, 235, 1472, 236, 1472, 237, 1472, 242, 1472, 243,
+	1472, 247, 1472, 248, 1472, 252, 1472, 258, 1472, 259, 1472, 265, 1472, 266,
+	1472, 268, 1472, 269, 1472, 270, 1472, 271, 1472, 272, 1472, 274, 1472, 280,
+	1472, 290, 1472, 291, 1472, 296, 1472, 297, 1472, 298, 1472, 300, 1472, 336,
+	1472, 337, 1472, 338, 1472, 345, 1472, 349, 1472, 352, 1472, 496, 1472, -1,
+	-2, 489, -1, 5, 443, 32, 443, 34, 443, 39, 443, 51, 443, 60, 443, 63, 443,
+	69, 443, 72, 443, 77, 443, 78, 443, 81, 443, 82, 443, 83, 443, 88, 443, 89,
+	443, 90, 443, 120, 443, 121, 443, 122, 443, 126, 443, 127, 443, 128, 443,
+	131, 443, 132, 443, 134, 443, 135, 443, 136, 443, 137, 443, 138, 443, 139,
+	443, 141, 443, 143, 443, 152, 443, 159, 443, 164, 443, 165, 443, 169, 443,
+	170, 443, 173, 443, 177, 443, 181, 443, 190, 443, 193, 443, 221, 443, 238,
+	443, 244, 443, 255, 443, 262, 443, 263, 443, 273, 443, 275, 443, 277, 443,
+	281, 443, 282, 443, 286, 443, 294, 443, 299, 443, 301, 443, 307, 443, 309,
+	443, 317, 443, 319, 443, 323, 443, 333, 443, 335, 443, 339, 443, 364, 443,
+	367, 443, -1, -2, 489, -1, 5, 504, 32, 504, 34, 504, 39, 504, 51, 504, 60,
+	504, 63, 504, 69, 504, 72, 504, 77, 504, 78, 504, 81, 504, 82, 504, 83, 504,
+	88, 504, 89, 504, 90, 504, 120, 504, 121, 504, 122, 504, 126, 504, 127, 504,
+	128, 504, 131, 504, 132, 504, 134, 504, 135, 504, 136, 504, 137, 504, 138,
+	504, 139, 504, 141, 504, 143, 504, 152, 504, 159, 504, 164, 504, 165, 504,
+	169, 504, 170, 504, 173, 504, 177, 504, 181, 504, 190, 504, 193, 504, 221,
+	504, 238, 504, 244, 504, 255, 504, 262, 504, 263, 504, 273, 504, 275, 504,
+	277, 504, 281, 504, 282, 504, 286, 504, 294, 504, 299, 504, 301, 504, 307,
+	504, 309, 504, 317, 504, 319, 504, 323, 504, 333, 504, 335, 504, 339, 504,
+	364, 504, 367, 504, -1, -2, 489, -1, 5, 502, 32, 502, 34, 502, 39, 502, 51,
+	502, 60, 502, 63, 502, 69, 502, 72, 502, 77, 502, 78, 502, 81, 502, 82, 502,
+	83, 502, 88, 502, 89, 502, 90, 502, 120, 502, 121, 502, 122, 502, 126, 502,
+	127, 502, 128, 502, 131, 502, 132, 502, 134, 502, 135, 502, 136, 502, 137,
+	502, 138, 502, 139, 502, 141, 502, 143, 502, 152, 502, 159, 502, 164, 502,
+	165, 502, 169, 502, 170, 502, 173, 502, 177, 502, 181, 502, 190, 502, 193,
+	502, 221, 502, 238, 502, 244, 502, 255, 502, 262, 502, 263, 502, 273, 502,
+	275, 502, 277, 502, 281, 502, 282, 502, 286, 502, 294, 502, 299, 502, 301,
+	502, 307, 502, 309, 502, 317, 502, 319, 502, 323, 502, 333, 502, 335, 502,
+	339, 502, 364, 502, 367, 502, -1, -2, 489, -1, 5, 498, 32, 498, 34, 498, 39,
+	498, 51, 498, 60, 498, 63, 498, 69, 498, 72, 498, 77, 498, 78, 498, 81, 498,
+	82, 498, 83, 498, 88, 498, 89, 498, 90, 498, 120, 498, 121, 498, 122, 498,
+	126, 498, 127, 498, 128, 498, 131, 498, 132, 498, 134, 498, 135, 498, 136,
+	498, 137, 498, 138, 498, 139, 498, 141, 498, 143, 498, 152, 498, 159, 498,
+	164, 498, 165, 498, 169, 498, 170, 498, 173, 498, 177, 498, 181, 498, 190,
+	498, 193, 498, 221, 498, 238, 498, 244, 498, 255, 498, 262, 498, 263, 498,
+	273, 498, 275, 498, 277, 498, 281, 498, 282, 498, 286, 498, 294, 498, 299,
+	498, 301, 498, 307, 498, 309, 498, 317, 498, 319, 498, 323, 498, 333, 498,
+	335, 498, 339, 498, 364, 498, 367, 498, -1, -2, 489, -1, 5, 471, 32, 471,
+	34, 471, 39, 471, 51, 471, 60, 471, 63, 471, 69, 471, 72, 471, 77, 471, 78,
+	471, 81, 471, 82, 471, 83, 471, 88, 471, 89, 471, 90, 471, 120, 471, 121,
+	471, 122, 471, 126, 471, 127, 471, 128, 471, 131, 471, 132, 471, 134, 471,
+	135, 471, 136, 471, 137, 471, 138, 471, 139, 471, 141, 471, 143, 471, 152,
+	471, 159, 471, 164, 471, 165, 471, 169, 471, 170, 471, 173, 471, 177, 471,
+	181, 471, 190, 471, 193, 471, 221, 471, 238, 471, 244, 471, 255, 471, 262,
+	471, 263, 471, 273, 471, 275, 471, 277, 471, 281, 471, 282, 471, 286, 471,
+	294, 471, 299, 471, 301, 471, 307, 471, 309, 471, 317, 471, 319, 471, 323,
+	471, 333, 471, 335, 471, 339, 471, 364, 471, 367, 471, -1, -2, 489, -1, 5,
+	612, 32, 612, 34, 612, 39, 612, 51, 612, 60, 612, 63, 612, 69, 612, 72, 612,
+	77, 612, 78, 612, 81, 612, 82, 612, 83, 612, 88, 612, 89, 612, 90, 612, 120,
+	612, 121, 612, 122, 612, 126, 612, 127, 612, 128, 612, 131, 612, 132, 612,
+	134, 612, 135, 612, 136, 612, 137, 612, 138, 612, 139, 612, 141, 612, 143,
+	612, 152, 612, 159, 612, 164, 612, 165, 612, 169, 612, 170, 612, 173, 612,
+	177, 612, 181, 612, 190, 612, 193, 612, 221, 612, 238, 612, 244, 612, 255,
+	612, 262, 612, 263, 612, 273, 612, 275, 612, 277, 612, 281, 612, 282, 612,
+	286, 612, 294, 612, 299, 612, 301, 612, 307, 612, 309, 612, 317, 612, 319,
+	612, 323, 612, 333, 612, 335, 612, 339, 612, 364, 612, 367, 612, -1, -2,
+	489, -1, 5, 666, 6, 666, 32, 666, 34, 666, 39, 666, 51, 666, 60, 666, 63,
+	666, 69, 666, 72, 666, 77, 666, 78, 666, 81, 666, 82, 666, 83, 666, 88, 666,
+	89, 666, 90, 666, 120, 666, 121, 666, 122, 666, 126, 666, 127, 666, 128,
+	666, 131, 666, 132, 666, 134, 666, 135, 666, 136, 666, 137, 666, 138, 666,
+	139, 666, 141, 666, 143, 666, 152, 666, 159, 666, 164, 666, 165, 666, 169,
+	666, 170, 666, 173, 666, 177, 666, 181, 666, 190, 666, 193, 666, 221, 666,
+	238, 666, 244, 666, 255, 666, 262, 666, 263, 666, 273, 666, 275, 666, 277,
+	666, 281, 666, 282, 666, 286, 666, 294, 666, 299, 666, 301, 666, 307, 666,
+	309, 666, 317, 666, 319, 666, 323, 666, 333, 666, 335, 666, 336, 666, 339,
+	666, 364, 666, 367, 666, 397, 666, 400, 666, 401, 666, 403, 666, 404, 666,
+	405, 666, 406, 666, 407, 666, 408, 666, 409, 666, 410, 666, 411, 666, 414,
+	666, 415, 666, 417, 666, 418, 666, 419, 666, 420, 666, 421, 666, 422, 666,
+	423, 666, 424, 666, 425, 666, 426, 666, 427, 666, 428, 666, 429, 666, 430,
+	666, 431, 666, 432, 666, 433, 666, 434, 666, 435, 666, 436, 666, 437, 666,
+	438, 666, 440, 666, 441, 666, 442, 666, 443, 666, 444, 666, 445, 666, 446,
+	666, 447, 666, 448, 666, 449, 666, 450, 666, 451, 666, 452, 666, 453, 666,
+	454, 666, 455, 666, 458, 666, 459, 666, 460, 666, 461, 666, 462, 666, 463,
+	666, 465, 666, 466, 666, 467, 666, 469, 666, 470, 666, 476, 666, 477, 666,
+	478, 666, 479, 666, 480, 666, 481, 666, 482, 666, 484, 666, 485, 666, 486,
+	666, 487, 666, 488, 666, 497, 666, -1, -2, 489, -1, 5, 618, 32, 618, 34,
+	618, 39, 618, 51, 618, 60, 618, 63, 618, 69, 618, 72, 618, 77, 618, 78, 618,
+	81, 618, 82, 618, 83, 618, 88, 618, 89, 618, 90, 618, 120, 618, 121, 618,
+	122, 618, 126, 618, 127, 618, 128, 618, 131, 618, 132, 618, 134, 618, 135,
+	618, 136, 618, 137, 618, 138, 618, 139, 618, 141, 618, 143, 618, 152, 618,
+	159, 618, 164, 618, 165, 618, 169, 618, 170, 618, 173, 618, 177, 618, 181,
+	618, 190, 618, 193, 618, 221, 618, 238, 618, 244, 618, 255, 618, 262, 618,
+	263, 618, 273, 618, 275, 618, 277, 618, 281, 618, 282, 618, 286, 618, 294,
+	618, 299, 618, 301, 618, 307, 618, 309, 618, 317, 618, 319, 618, 323, 618,
+	333, 618, 335, 618, 339, 618, 364, 618, 367, 618, -1, -2, 489, -1, 5, 668,
+	6, 668, 32, 668, 34, 668, 39, 668, 51, 668, 60, 668, 63, 668, 69, 668, 72,
+	668, 77, 668, 78, 668, 81, 668, 82, 668, 83, 668, 88, 668, 89, 668, 90, 668,
+	120, 668, 121, 668, 122, 668, 126, 668, 127, 668, 128, 668, 131, 668, 132,
+	668, 134, 668, 135, 668, 136, 668, 137, 668, 138, 668, 139, 668, 141, 668,
+	143, 668, 152, 668, 159, 668, 164, 668, 165, 668, 169, 668, 170, 668, 173,
+	668, 177, 668, 181, 668, 190, 668, 193, 668, 221, 668, 238, 668, 244, 668,
+	255, 668, 262, 668, 263, 668, 273, 668, 275, 668, 277, 668, 281, 668, 282,
+	668, 286, 668, 294, 668, 299, 668, 301, 668, 307, 668, 309, 668, 317, 668,
+	319, 668, 323, 668, 333, 668, 335, 668, 336, 668, 339, 668, 364, 668, 367,
+	668, 397, 668, 400, 668, 401, 668, 403, 668, 404, 668, 405, 668, 406, 668,
+	407, 668, 408, 668, 409, 668, 410, 668, 411, 668, 414, 668, 415, 668, 417,
+	668, 418, 668, 419, 668, 420, 668, 421, 668, 422, 668, 423, 668, 424, 668,
+	425, 668, 426, 668, 427, 668, 428, 668, 429, 668, 430, 668, 431, 668, 432,
+	668, 433, 668, 434, 668, 435, 668, 436, 668, 437, 668, 438, 668, 440, 668,
+	441, 668, 442, 668, 443, 668, 444, 668, 445, 668, 446, 668, 447, 668, 448,
+	668, 449, 668, 450, 668, 451, 668, 452, 668, 453, 668, 454, 668, 455, 668,
+	458, 668, 459, 668, 460, 668, 461, 668, 462, 668, 463, 668, 465, 668, 466,
+	668, 467, 668, 469, 668, 470, 668, 476, 668, 477, 668, 478, 668, 479, 668,
+	480, 668, 481, 668, 482, 668, 484, 668, 485, 668, 486, 668, 487, 668, 488,
+	668, 497, 668, -1, -2, 489, -1, 5, 445, 32, 445, 34, 445, 39, 445, 51, 445,
+	60, 445, 63, 445, 69, 445, 72, 445, 77, 445, 78, 445, 81, 445, 82, 445, 83,
+	445, 88, 445, 89, 445, 90, 445, 120, 445, 121, 445, 122, 445, 126, 445, 127,
+	445, 128, 445, 131, 445, 132, 445, 134, 445, 135, 445, 136, 445, 137, 445,
+	138, 445, 139, 445, 141, 445, 143, 445, 152, 445, 159, 445, 164, 445, 165,
+	445, 169, 445, 170, 445, 173, 445, 177, 445, 181, 445, 190, 445, 193, 445,
+	221, 445, 238, 445, 244, 445, 255, 445, 262, 445, 263, 445, 273, 445, 275,
+	445, 277, 445, 281, 445, 282, 445, 286, 445, 294, 445, 299, 445, 301, 445,
+	307, 445, 309, 445, 317, 445, 319, 445, 323, 445, 333, 445, 335, 445, 339,
+	445, 364, 445, 367, 445, -1, -2, 489, -1, 5, 582, 32, 582, 34, 582, 39, 582,
+	51, 582, 60, 582, 63, 582, 69, 582, 72, 582, 77, 582, 78, 582, 81, 582, 82,
+	582, 83, 582, 88, 582, 89, 582, 90, 582, 120, 582, 121, 582, 122, 582, 126,
+	582, 127, 582, 128, 582, 131, 582, 132, 582, 134, 582, 135, 582, 136, 582,
+	137, 582, 138, 582, 139, 582, 141, 582, 143, 582, 152, 582, 159, 582, 164,
+	582, 165, 582, 169, 582, 170, 582, 173, 582, 177, 582, 181, 582, 190, 582,
+	193, 582, 221, 582, 238, 582, 244, 582, 255, 582, 262, 582, 263, 582, 273,
+	582, 275, 582, 277, 582, 281, 582, 282, 582, 286, 582, 294, 582, 299, 582,
+	301, 582, 307, 582, 309, 582, 317, 582, 319, 582, 323, 582, 333, 582, 335,
+	582, 339, 582, 364, 582, 367, 582, -1, -2, 489, -1, 5, 459, 32, 459, 34,
+	459, 39, 459, 51, 459, 60, 459, 63, 459, 69, 459, 72, 459, 77, 459, 78, 459,
+	81, 459, 82, 459, 83, 459, 88, 459, 89, 459, 90, 459, 120, 459, 121, 459,
+	122, 459, 126, 459, 127, 459, 128, 459, 131, 459, 132, 459, 134, 459, 135,
+	459, 136, 459, 137, 459, 138, 459, 139, 459, 141, 459, 143, 459, 152, 459,
+	159, 459, 164, 459, 165, 459, 169, 459, 170, 459, 173, 459, 177, 459, 181,
+	459, 190, 459, 193, 459, 221, 459, 238, 459, 244, 459, 255, 459, 262, 459,
+	263, 459, 273, 459, 275, 459, 277, 459, 281, 459, 282, 459, 286, 459, 294,
+	459, 299, 459, 301, 459, 307, 459, 309, 459, 317, 459, 319, 459, 323, 459,
+	333, 459, 335, 459, 339, 459, 364, 459, 367, 459, -1, -2, 489, -1, 5, 453,
+	32, 453, 34, 453, 39, 453, 51, 453, 60, 453, 63, 453, 69, 453, 72, 453, 77,
+	453, 78, 453, 81, 453, 82, 453, 83, 453, 88, 453, 89, 453, 90, 453, 120,
+	453, 121, 453, 122, 453, 126, 453, 127, 453, 128, 453, 131, 453, 132, 453,
+	134, 453, 135, 453, 136, 453, 137, 453, 138, 453, 139, 453, 141, 453, 143,
+	453, 152, 453, 159, 453, 164, 453, 165, 453, 169, 453, 170, 453, 173, 453,
+	177, 453, 181, 453, 190, 453, 193, 453, 221, 453, 238, 453, 244, 453, 255,
+	453, 262, 453, 263, 453, 273, 453, 275, 453, 277, 453, 281, 453, 282, 453,
+	286, 453, 294, 453, 299, 453, 301, 453, 307, 453, 309, 453, 317, 453, 319,
+	453, 323, 453, 333, 453, 335, 453, 339, 453, 364, 453, 367, 453, -1, -2,
+	489, -1, 5, 465, 32, 465, 34, 465, 39, 465, 51, 465, 60, 465, 63, 465, 69,
+	465, 72, 465, 77, 465, 78, 465, 81, 465, 82, 465, 83, 465, 88, 465, 89, 465,
+	90, 465, 120, 465, 121, 465, 122, 465, 126, 465, 127, 465, 128, 465, 131,
+	465, 132, 465, 134, 465, 135, 465, 136, 465, 137, 465, 138, 465, 139, 465,
+	141, 465, 143, 465, 152, 465, 159, 465, 164, 465, 165, 465, 169, 465, 170,
+	465, 173, 465, 177, 465, 181, 465, 190, 465, 193, 465, 221, 465, 238, 465,
+	244, 465, 255, 465, 262, 465, 263, 465, 273, 465, 275, 465, 277, 465, 281,
+	465, 282, 465, 286, 465, 294, 465, 299, 465, 301, 465, 307, 465, 309, 465,
+	317, 465, 319, 465, 323, 465, 333, 465, 335, 465, 339, 465, 364, 465, 367,
+	465, -1, -2, 489, -1, 5, 449, 32, 449, 34, 449, 39, 449, 51, 449, 60, 449,
+	63, 449, 69, 449, 72, 449, 77, 449, 78, 449, 81, 449, 82, 449, 83, 449, 88,
+	449, 89, 449, 90, 449, 120, 449, 121, 449, 122, 449, 126, 449, 127, 449,
+	128, 449, 131, 449, 132, 449, 134, 449, 135, 449, 136, 449, 137, 449, 138,
+	449, 139, 449, 141, 449, 143, 449, 152, 449, 159, 449, 164, 449, 165, 449,
+	169, 449, 170, 449, 173, 449, 177, 449, 181, 449, 190, 449, 193, 449, 221,
+	449, 238, 449, 244, 449, 255, 449, 262, 449, 263, 449, 273, 449, 275, 449,
+	277, 449, 281, 449, 282, 449, 286, 449, 294, 449, 299, 449, 301, 449, 307,
+	449, 309, 449, 317, 449, 319, 449, 323, 449, 333, 449, 335, 449, 339, 449,
+	364, 449, 367, 449, -1, -2, 489, -1, 5, 550, 32, 550, 34, 550, 39, 550, 51,
+	550, 60, 550, 63, 550, 69, 550, 72, 550, 77, 550, 78, 550, 81, 550, 82, 550,
+	83, 550, 88, 550, 89, 550, 90, 550, 120, 550, 121, 550, 122, 550, 126, 550,
+	127, 550, 128, 550, 131, 550, 132, 550, 134, 550, 135, 550, 136, 550, 137,
+	550, 138, 550, 139, 550, 141, 550, 143, 550, 152, 550, 159, 550, 164, 550,
+	165, 550, 169, 550, 170, 550, 173, 550, 177, 550, 181, 550, 190, 550, 193,
+	550, 221, 550, 238, 550, 244, 550, 255, 550, 262, 550, 263, 550, 273, 550,
+	275, 550, 277, 550, 281, 550, 282, 550, 286, 550, 294, 550, 299, 550, 301,
+	550, 307, 550, 309, 550, 317, 550, 319, 550, 323, 550, 333, 550, 335, 550,
+	339, 550, 364, 550, 367, 550, -1, -2, 489, -1, 5, 579, 32, 579, 34, 579, 39,
+	579, 51, 579, 60, 579, 63, 579, 69, 579, 72, 579, 77, 579, 78, 579, 81, 579,
+	82, 579, 83, 579, 88, 579, 89, 579, 90, 579, 120, 579, 121, 579, 122, 579,
+	126, 579, 127, 579, 128, 579, 131, 579, 132, 579, 134, 579, 135, 579, 136,
+	579, 137, 579, 138, 579, 139, 579, 141, 579, 143, 579, 152, 579, 159, 579,
+	164, 579, 165, 579, 169, 579, 170, 579, 173, 579, 177, 579, 181, 579, 190,
+	579, 193, 579, 221, 579, 238, 579, 244, 579, 255, 579, 262, 579, 263, 579,
+	273, 579, 275, 579, 277, 579, 281, 579, 282, 579, 286, 579, 294, 579, 299,
+	579, 301, 579, 307, 579, 309, 579, 317, 579, 319, 579, 323, 579, 333, 579,
+	335, 579, 339, 579, 364, 579, 367, 579, -1, -2, 489, -1, 5, 648, 6, 648, 32,
+	648, 34, 648, 39, 648, 51, 648, 60, 648, 63, 648, 69, 648, 72, 648, 77, 648,
+	78, 648, 81, 648, 82, 648, 83, 648, 88, 648, 89, 648, 90, 648, 120, 648,
+	121, 648, 122, 648, 126, 648, 127, 648, 128, 648, 131, 648, 132, 648, 134,
+	648, 135, 648, 136, 648, 137, 648, 138, 648, 139, 648, 141, 648, 143, 648,
+	152, 648, 159, 648, 164, 648, 165, 648, 169, 648, 170, 648, 173, 648, 177,
+	648, 181, 648, 190, 648, 193, 648, 221, 648, 238, 648, 244, 648, 255, 648,
+	262, 648, 263, 648, 273, 648, 275, 648, 277, 648, 281, 648, 282, 648, 286,
+	648, 294, 648, 299, 648, 301, 648, 307, 648, 309, 648, 317, 648, 319, 648,
+	323, 648, 333, 648, 335, 648, 336, 648, 339, 648, 364, 648, 367, 648, 397,
+	648, 400, 648, 401, 648, 403, 648, 404, 648, 405, 648, 406, 648, 407, 648,
+	408, 648, 409, 648, 410, 648, 411, 648, 414, 648, 415, 648, 417, 648, 418,
+	648, 419, 648, 420, 648, 421, 648, 422, 648, 423, 648, 424, 648, 425, 648,
+	426, 648, 427, 648, 428, 648, 429, 648, 430, 648, 431, 648, 432, 648, 433,
+	648, 434, 648, 435, 648, 436, 648, 437, 648, 438, 648, 440, 648, 441, 648,
+	442, 648, 443, 648, 444, 648, 445, 648, 446, 648, 447, 648, 448, 648, 449,
+	648, 450, 648, 451, 648, 452, 648, 453, 648, 454, 648, 455, 648, 458, 648,
+	459, 648, 460, 648, 461, 648, 462, 648, 463, 648, 465, 648, 466, 648, 467,
+	648, 469, 648, 470, 648, 476, 648, 477, 648, 478, 648, 479, 648, 480, 648,
+	481, 648, 482, 648, 484, 648, 485, 648, 486, 648, 487, 648, 488, 648, 497,
+	648, -1, -2, 489, -1, 5, 482, 32, 482, 34, 482, 39, 482, 51, 482, 60, 482,
+	63, 482, 69, 482, 72, 482, 77, 482, 78, 482, 81, 482, 82, 482, 83, 482, 88,
+	482, 89, 482, 90, 482, 120, 482, 121, 482, 122, 482, 126, 482, 127, 482,
+	128, 482, 131, 482, 132, 482, 134, 482, 135, 482, 136, 482, 137, 482, 138,
+	482, 139, 482, 141, 482, 143, 482, 152, 482, 159, 482, 164, 482, 165, 482,
+	169, 482, 170, 482, 173, 482, 177, 482, 181, 482, 190, 482, 193, 482, 221,
+	482, 238, 482, 244, 482, 255, 482, 262, 482, 263, 482, 273, 482, 275, 482,
+	277, 482, 281, 482, 282, 482, 286, 482, 294, 482, 299, 482, 301, 482, 307,
+	482, 309, 482, 317, 482, 319, 482, 323, 482, 333, 482, 335, 482, 339, 482,
+	364, 482, 367, 482, -1, -2, 489, -1, 5, 489, 32, 489, 34, 489, 39, 489, 51,
+	489, 60, 489, 63, 489, 69, 489, 72, 489, 77, 489, 78, 489, 81, 489, 82, 489,
+	83, 489, 88, 489, 89, 489, 90, 489, 120, 489, 121, 489, 122, 489, 126, 489,
+	127, 489, 128, 489, 131, 489, 132, 489, 134, 489, 135, 489, 136, 489, 137,
+	489, 138, 489, 139, 489, 141, 489, 143, 489, 152, 489, 159, 489, 164, 489,
+	165, 489, 169, 489, 170, 489, 173, 489, 177, 489, 181, 489, 190, 489, 193,
+	489, 221, 489, 238, 489, 244, 489, 255, 489, 262, 489, 263, 489, 273, 489,
+	275, 489, 277, 489, 281, 489, 282, 489, 286, 489, 294, 489, 299, 489, 301,
+	489, 307, 489, 309, 489, 317, 489, 319, 489, 323, 489, 333, 489, 335, 489,
+	339, 489, 364, 489, 367, 489, -1, -2, 489, -1, 5, 511, 32, 511, 34, 511, 39,
+	511, 51, 511, 60, 511, 63, 511, 69, 511, 72, 511, 77, 511, 78, 511, 81, 511,
+	82, 511, 83, 511, 88, 511, 89, 511, 90, 511, 120, 511, 121, 511, 122, 511,
+	126, 511, 127, 511, 128, 511, 131, 511, 132, 511, 134, 511, 135, 511, 136,
+	511, 137, 511, 138, 511, 139, 511, 141, 511, 143, 511, 152, 511, 159, 511,
+	164, 511, 165, 511, 169, 511, 170, 511, 173, 511, 177, 511, 181, 511, 190,
+	511, 193, 511, 221, 511, 238, 511, 244, 511, 255, 511, 262, 511, 263, 511,
+	273, 511, 275, 511, 277, 511, 281, 511, 282, 511, 286, 511, 294, 511, 299,
+	511, 301, 511, 307, 511, 309, 511, 317, 511, 319, 511, 323, 511, 333, 511,
+	335, 511, 339, 511, 364, 511, 367, 511, -1, -2, 489, -1, 5, 469, 32, 469,
+	34, 469, 39, 469, 51, 469, 60, 469, 63, 469, 69, 469, 72, 469, 77, 469, 78,
+	469, 81, 469, 82, 469, 83, 469, 88, 469, 89, 469, 90, 469, 120, 469, 121,
+	469, 122, 469, 126, 469, 127, 469, 128, 469, 131, 469, 132, 469, 134, 469,
+	135, 469, 136, 469, 137, 469, 138, 469, 139, 469, 141, 469, 143, 469, 152,
+	469, 159, 469, 164, 469, 165, 469, 169, 469, 170, 469, 173, 469, 177, 469,
+	181, 469, 190, 469, 193, 469, 221, 469, 238, 469, 244, 469, 255, 469, 262,
+	469, 263, 469, 273, 469, 275, 469, 277, 469, 281, 469, 282, 469, 286, 469,
+	294, 469, 299, 469, 301, 469, 307, 469, 309, 469, 317, 469, 319, 469, 323,
+	469, 333, 469, 335, 469, 339, 469, 364, 469, 367, 469, -1, -2, 489, -1, 5,
+	451, 32, 451, 34, 451, 39, 451, 51, 451, 60, 451, 63, 451, 69, 451, 72, 451,
+	77, 451, 78, 451, 81, 451, 82, 451, 83, 451, 88, 451, 89, 451, 90, 451, 120,
+	451, 121, 451, 122, 451, 126, 451, 127, 451, 128, 451, 131, 451, 132, 451,
+	134, 451, 135, 451, 136, 451, 137, 451, 138, 451, 139, 451, 141, 451, 143,
+	451, 152, 451, 159, 451, 164, 451, 165, 451, 169, 451, 170, 451, 173, 451,
+	177, 451, 181, 451, 190, 451, 193, 451, 221, 451, 238, 451, 244, 451, 255,
+	451, 262, 451, 263, 451, 273, 451, 275, 451, 277, 451, 281, 451, 282, 451,
+	286, 451, 294, 451, 299, 451, 301, 451, 307, 451, 309, 451, 317, 451, 319,
+	451, 323, 451, 333, 451, 335, 451, 339, 451, 364, 451, 367, 451, -1, -2,
+	489, -1, 5, 457, 32, 457, 34, 457, 39, 457, 51, 457, 60, 457, 63, 457, 69,
+	457, 72, 457, 77, 457, 78, 457, 81, 457, 82, 457, 83, 457, 88, 457, 89, 457,
+	90, 457, 120, 457, 121, 457, 122, 457, 126, 457, 127, 457, 128, 457, 131,
+	457, 132, 457, 134, 457, 135, 457, 136, 457, 137, 457, 138, 457, 139, 457,
+	141, 457, 143, 457, 152, 457, 159, 457, 164, 457, 165, 457, 169, 457, 170,
+	457, 173, 457, 177, 457, 181, 457, 190, 457, 193, 457, 221, 457, 238, 457,
+	244, 457, 255, 457, 262, 457, 263, 457, 273, 457, 275, 457, 277, 457, 281,
+	457, 282, 457, 286, 457, 294, 457, 299, 457, 301, 457, 307, 457, 309, 457,
+	317, 457, 319, 457, 323, 457, 333, 457, 335, 457, 339, 457, 364, 457, 367,
+	457, -1, -2, 489, -1, 5, 467, 32, 467, 34, 467, 39, 467, 51, 467, 60, 467,
+	63, 467, 69, 467, 72, 467, 77, 467, 78, 467, 81, 467, 82, 467, 83, 467, 88,
+	467, 89, 467, 90, 467, 120, 467, 121, 467, 122, 467, 126, 467, 127, 467,
+	128, 467, 131, 467, 132, 467, 134, 467, 135, 467, 136, 467, 137, 467, 138,
+	467, 139, 467, 141, 467, 143, 467, 152, 467, 159, 467, 164, 467, 165, 467,
+	169, 467, 170, 467, 173, 467, 177, 467, 181, 467, 190, 467, 193, 467, 221,
+	467, 238, 467, 244, 467, 255, 467, 262, 467, 263, 467, 273, 467, 275, 467,
+	277, 467, 281, 467, 282, 467, 286, 467, 294, 467, 299, 467, 301, 467, 307,
+	467, 309, 467, 317, 467, 319, 467, 323, 467, 333, 467, 335, 467, 339, 467,
+	364, 467, 367, 467, -1, -2, 489, -1, 5, 484, 32, 484, 34, 484, 39, 484, 51,
+	484, 60, 484, 63, 484, 69, 484, 72, 484, 77, 484, 78, 484, 81, 484, 82, 484,
+	83, 484, 88, 484, 89, 484, 90, 484, 120, 484, 121, 484, 122, 484, 126, 484,
+	127, 484, 128, 484, 131, 484, 132, 484, 134, 484, 135, 484, 136, 484, 137,
+	484, 138, 484, 139, 484, 141, 484, 143, 484, 152, 484, 159, 484, 164, 484,
+	165, 484, 169, 484, 170, 484, 173, 484, 177, 484, 181, 484, 190, 484, 193,
+	484, 221, 484, 238, 484, 244, 484, 255, 484, 262, 484, 263, 484, 273, 484,
+	275, 484, 277, 484, 281, 484, 282, 484, 286, 484, 294, 484, 299, 484, 301,
+	484, 307, 484, 309, 484, 317, 484, 319, 484, 323, 484, 333, 484, 335, 484,
+	339, 484, 364, 484, 367, 484, -1, -2, 489, -1, 5, 519, 32, 519, 34, 519, 39,
+	519, 51, 519, 60, 519, 63, 519, 69, 519, 72, 519, 77, 519, 78, 519, 81, 519,
+	82, 519, 83, 519, 88, 519, 89, 519, 90, 519, 120, 519, 121, 519, 122, 519,
+	126, 519, 127, 519, 128, 519, 131, 519, 132, 519, 134, 519, 135, 519, 136,
+	519, 137, 519, 138, 519, 139, 519, 141, 519, 143, 519, 152, 519, 159, 519,
+	164, 519, 165, 519, 169, 519, 170, 519, 173, 519, 177, 519, 181, 519, 190,
+	519, 193, 519, 221, 519, 238, 519, 244, 519, 255, 519, 262, 519, 263, 519,
+	273, 519, 275, 519, 277, 519, 281, 519, 282, 519, 286, 519, 294, 519, 299,
+	519, 301, 519, 307, 519, 309, 519, 317, 519, 319, 519, 323, 519, 333, 519,
+	335, 519, 339, 519, 364, 519, 367, 519, -1, -2, 489, -1, 5, 447, 32, 447,
+	34, 447, 39, 447, 51, 447, 60, 447, 63, 447, 69, 447, 72, 447, 77, 447, 78,
+	447, 81, 447, 82, 447, 83, 447, 88, 447, 89, 447, 90, 447, 120, 447, 121,
+	447, 122, 447, 126, 447, 127, 447, 128, 447, 131, 447, 132, 447, 134, 447,
+	135, 447, 136, 447, 137, 447, 138, 447, 139, 447, 141, 447, 143, 447, 152,
+	447, 159, 447, 164, 447, 165, 447, 169, 447, 170, 447, 173, 447, 177, 447,
+	181, 447, 190, 447, 193, 447, 221, 447, 238, 447, 244, 447, 255, 447, 262,
+	447, 263, 447, 273, 447, 275, 447, 277, 447, 281, 447, 282, 447, 286, 447,
+	294, 447, 299, 447, 301, 447, 307, 447, 309, 447, 317, 447, 319, 447, 323,
+	447, 333, 447, 335, 447, 339, 447, 364, 447, 367, 447, -1, -2, 489, -1, 5,
+	455, 32, 455, 34, 455, 39, 455, 51, 455, 60, 455, 63, 455, 69, 455, 72, 455,
+	77, 455, 78, 455, 81, 455, 82, 455, 83, 455, 88, 455, 89, 455, 90, 455, 120,
+	455, 121, 455, 122, 455, 126, 455, 127, 455, 128, 455, 131, 455, 132, 455,
+	134, 455, 135, 455, 136, 455, 137, 455, 138, 455, 139, 455, 141, 455, 143,
+	455, 152, 455, 159, 455, 164, 455, 165, 455, 169, 455, 170, 455, 173, 455,
+	177, 455, 181, 455, 190, 455, 193, 455, 221, 455, 238, 455, 244, 455, 255,
+	455, 262, 455, 263, 455, 273, 455, 275, 455, 277, 455, 281, 455, 282, 455,
+	286, 455, 294, 455, 299, 455, 301, 455, 307, 455, 309, 455, 317, 455, 319,
+	455, 323, 455, 333, 455, 335, 455, 339, 455, 364, 455, 367, 455, -1, -2, 33,
+	-1, 0, 1474, 4, 1474, 5, 1474, 7, 1474, 8, 1474, 9, 1474, 10, 1474, 25,
+	1474, 27, 1474, 37, 1474, 38, 1474, 40, 1474, 41, 1474, 56, 1474, 64, 1474,
+	73, 1474, 91, 1474, 93, 1474, 97, 1474, 100, 1474, 102, 1474, 105, 1474,
+	142, 1474, 151, 1474, 155, 1474, 156, 1474, 161, 1474, 171, 1474, 186, 1474,
+	187, 1474, 192, 1474, 194, 1474, 202, 1474, 203, 1474, 205, 1474, 207, 1474,
+	208, 1474, 209, 1474, 210, 1474, 211, 1474, 213, 1474, 215, 1474, 216, 1474,
+	217, 1474, 218, 1474, 219, 1474, 220, 1474, 226, 1474, 235, 1474, 236, 1474,
+	237, 1474, 242, 1474, 243, 1474, 247, 1474, 248, 1474, 252, 1474, 258, 1474,
+	259, 1474, 265, 1474, 266, 1474, 268, 1474, 269, 1474, 270, 1474, 271, 1474,
+	272, 1474, 274, 1474, 280, 1474, 290, 1474, 291, 1474, 296, 1474, 297, 1474,
+	298, 1474, 300, 1474, 336, 1474, 337, 1474, 338, 1474, 345, 1474, 349, 1474,
+	352, 1474, 496, 1474, -1, -2, 33, -1, 492, -1, 0, 132, 4, 132, 5, 132, 7,
+	132, 8, 132, 9, 132, 10, 132, 25, 132, 27, 132, 37, 132, 38, 132, 40, 132,
+	41, 132, 56, 132, 64, 132, 73, 132, 91, 132, 97, 132, 100, 132, 102, 132,
+	105, 132, 151, 132, 155, 132, 156, 132, 161, 132, 171, 132, 186, 132, 187,
+	132, 192, 132, 194, 132, 202, 132, 203, 132, 205, 132, 207, 132, 208, 132,
+	209, 132, 210, 132, 211, 132, 213, 132, 215, 132, 216, 132, 217, 132, 218,
+	132, 219, 132, 220, 132, 226, 132, 235, 132, 236, 132, 237, 132, 247, 132,
+	258, 132, 259, 132, 265, 132, 266, 132, 268, 132, 269, 132, 270, 132, 271,
+	132, 272, 132, 280, 132, 290, 132, 291, 132, 296, 132, 297, 132, 298, 132,
+	300, 132, 336, 132, 337, 132, 338, 132, 345, 132, 349, 132, 352, 132, 489,
+	132, 498, 1474, -1, -2, 4, -1, 23, -1, 24, -1, 32, -1, 33, -1, 34, -1, 51,
+	-1, 60, -1, 69, -1, 70, -1, 76, -1, 111, -1, 120, -1, 121, -1, 122, -1, 123,
+	-1, 126, -1, 127, -1, 131, -1, 132, -1, 134, -1, 135, -1, 136, -1, 137, -1,
+	139, -1, 141, -1, 143, -1, 152, -1, 164, -1, 165, -1, 169, -1, 181, -1, 190,
+	-1, 200, -1, 212, -1, 225, -1, 238, -1, 245, -1, 255, -1, 273, -1, 275, -1,
+	277, -1, 281, -1, 282, -1, 286, -1, 294, -1, 301, -1, 316, -1, 317, -1, 319,
+	-1, 323, -1, 328, -1, 335, -1, 364, -1, 366, -1, 367, -1, 492, -1, 494, -1,
+	496, -1, 499, -1, 498, 1474, -1, -2, 489, -1, 5, 503, 32, 503, 34, 503, 39,
+	503, 51, 503, 60, 503, 63, 503, 69, 503, 72, 503, 77, 503, 78, 503, 81, 503,
+	82, 503, 83, 503, 88, 503, 89, 503, 90, 503, 120, 503, 121, 503, 122, 503,
+	126, 503, 127, 503, 128, 503, 131, 503, 132, 503, 134, 503, 135, 503, 136,
+	503, 137, 503, 138, 503, 139, 503, 141, 503, 143, 503, 152, 503, 159, 503,
+	164, 503, 165, 503, 169, 503, 170, 503, 173, 503, 177, 503, 181, 503, 190,
+	503, 193, 503, 221, 503, 238, 503, 244, 503, 255, 503, 262, 503, 263, 503,
+	273, 503, 275, 503, 277, 503, 281, 503, 282, 503, 286, 503, 294, 503, 299,
+	503, 301, 503, 307, 503, 309, 503, 317, 503, 319, 503, 323, 503, 333, 503,
+	335, 503, 339, 503, 364, 503, 367, 503, -1, -2, 489, -1, 5, 501, 32, 501,
+	34, 501, 39, 501, 51, 501, 60, 501, 63, 501, 69, 501, 72, 501, 77, 501, 78,
+	501, 81, 501, 82, 501, 83, 501, 88, 501, 89, 501, 90, 501, 120, 501, 121,
+	501, 122, 501, 126, 501, 127, 501, 128, 501, 131, 501, 132, 501, 134, 501,
+	135, 501, 136, 501, 137, 501, 138, 501, 139, 501, 141, 501, 143, 501, 152,
+	501, 159, 501, 164, 501, 165, 501, 169, 501, 170, 501, 173, 501, 177, 501,
+	181, 501, 190, 501, 193, 501, 221, 501, 238, 501, 244, 501, 255, 501, 262,
+	501, 263, 501, 273, 501, 275, 501, 277, 501, 281, 501, 282, 501, 286, 501,
+	294, 501, 299, 501, 301, 501, 307, 501, 309, 501, 317, 501, 319, 501, 323,
+	501, 333, 501, 335, 501, 339, 501, 364, 501, 367, 501, -1, -2, 489, -1, 5,
+	497, 32, 497, 34, 497, 39, 497, 51, 497, 60, 497, 63, 497, 69, 497, 72, 497,
+	77, 497, 78, 497, 81, 497, 82, 497, 83, 497, 88, 497, 89, 497, 90, 497, 120,
+	497, 121, 497, 122, 497, 126, 497, 127, 497, 128, 497, 131, 497, 132, 497,
+	134, 497, 135, 497, 136, 497, 137, 497, 138, 497, 139, 497, 141, 497, 143,
+	497, 152, 497, 159, 497, 164, 497, 165, 497, 169, 497, 170, 497, 173, 497,
+	177, 497, 181, 497, 190, 497, 193, 497, 221, 497, 238, 497, 244, 497, 255,
+	497, 262, 497, 263, 497, 273, 497, 275, 497, 277, 497, 281, 497, 282, 497,
+	286, 497, 294, 497, 299, 497, 301, 497, 307, 497, 309, 497, 317, 497, 319,
+	497, 323, 497, 333, 497, 335, 497, 339, 497, 364, 497, 367, 497, -1, -2,
+	489, -1, 5, 641, 6, 641, 32, 641, 34, 641, 39, 641, 51, 641, 60, 641, 63,
+	641, 69, 641, 72, 641, 77, 641, 78, 641, 81, 641, 82, 641, 83, 641, 88, 641,
+	89, 641, 90, 641, 120, 641, 121, 641, 122, 641, 126, 641, 127, 641, 128,
+	641, 131, 641, 132, 641, 134, 641, 135, 641, 136, 641, 137, 641, 138, 641,
+	139, 641, 141, 641, 143, 641, 152, 641, 159, 641, 164, 641, 165, 641, 169,
+	641, 170, 641, 173, 641, 177, 641, 181, 641, 190, 641, 193, 641, 221, 641,
+	238, 641, 244, 641, 255, 641, 262, 641, 263, 641, 273, 641, 275, 641, 277,
+	641, 281, 641, 282, 641, 286, 641, 294, 641, 299, 641, 301, 641, 307, 641,
+	309, 641, 317, 641, 319, 641, 323, 641, 333, 641, 335, 641, 336, 641, 339,
+	641, 364, 641, 367, 641, 397, 641, 400, 641, 401, 641, 403, 641, 404, 641,
+	405, 641, 406, 641, 407, 641, 408, 641, 409, 641, 410, 641, 411, 641, 414,
+	641, 415, 641, 417, 641, 418, 641, 419, 641, 420, 641, 421, 641, 422, 641,
+	423, 641, 424, 641, 425, 641, 426, 641, 427, 641, 428, 641, 429, 641, 430,
+	641, 431, 641, 432, 641, 433, 641, 434, 641, 435, 641, 436, 641, 437, 641,
+	438, 641, 440, 641, 441, 641, 442, 641, 443, 641, 444, 641, 445, 641, 446,
+	641, 447, 641, 448, 641, 449, 641, 450, 641, 451, 641, 452, 641, 453, 641,
+	454, 641, 455, 641, 458, 641, 459, 641, 460, 641, 461, 641, 462, 641, 463,
+	641, 465, 641, 466, 641, 467, 641, 469, 641, 470, 641, 476, 641, 477, 641,
+	478, 641, 479, 641, 480, 641, 481, 641, 482, 641, 484, 641, 485, 641, 486,
+	641, 487, 641, 488, 641, 497, 641, -1, -2, 5, -1, 26, -1, 68, -1, 109, -1,
+	130, -1, 133, -1, 146, -1, 172, -1, 184, -1, 246, -1, 254, -1, 314, -1, 342,
+	-1, 355, -1, 357, -1, 491, -1, 494, -1, 496, -1, 499, -1, 493, 1197, -1, -2,
+	489, -1, 5, 611, 32, 611, 34, 611, 39, 611, 51, 611, 60, 611, 63, 611, 69,
+	611, 72, 611, 77, 611, 78, 611, 81, 611, 82, 611, 83, 611, 88, 611, 89, 611,
+	90, 611, 120, 611, 121, 611, 122, 611, 126, 611, 127, 611, 128, 611, 131,
+	611, 132, 611, 134, 611, 135, 611, 136, 611, 137, 611, 138, 611, 139, 611,
+	141, 611, 143, 611, 152, 611, 159, 611, 164, 611, 165, 611, 169, 611, 170,
+	611, 173, 611, 177, 611, 181, 611, 190, 611, 193, 611, 221, 611, 238, 611,
+	244, 611, 255, 611, 262, 611, 263, 611, 273, 611, 275, 611, 277, 611, 281,
+	611, 282, 611, 286, 611, 294, 611, 299, 611, 301, 611, 307, 611, 309, 611,
+	317, 611, 319, 611, 323, 611, 333, 611, 335, 611, 339, 611, 364, 611, 367,
+	611, -1, -2, 489, -1, 5, 617, 32, 617, 34, 617, 39, 617, 51, 617, 60, 617,
+	63, 617, 69, 617, 72, 617, 77, 617, 78, 617, 81, 617, 82, 617, 83, 617, 88,
+	617, 89, 617, 90, 617, 120, 617, 121, 617, 122, 617, 126, 617, 127, 617,
+	128, 617, 131, 617, 132, 617, 134, 617, 135, 617, 136, 617, 137, 617, 138,
+	617, 139, 617, 141, 617, 143, 617, 152, 617, 159, 617, 164, 617, 165, 617,
+	169, 617, 170, 617, 173, 617, 177, 617, 181, 617, 190, 617, 193, 617, 221,
+	617, 238, 617, 244, 617, 255, 617, 262, 617, 263, 617, 273, 617, 275, 617,
+	277, 617, 281, 617, 282, 617, 286, 617, 294, 617, 299, 617, 301, 617, 307,
+	617, 309, 617, 317, 617, 319, 617, 323, 617, 333, 617, 335, 617, 339, 617,
+	364, 617, 367, 617, -1, -2, 489, -1, 5, 581, 32, 581, 34, 581, 39, 581, 51,
+	581, 60, 581, 63, 581, 69, 581, 72, 581, 77, 581, 78, 581, 81, 581, 82, 581,
+	83, 581, 88, 581, 89, 581, 90, 581, 120, 581, 121, 581, 122, 581, 126, 581,
+	127, 581, 128, 581, 131, 581, 132, 581, 134, 581, 135, 581, 136, 581, 137,
+	581, 138, 581, 139, 581, 141, 581, 143, 581, 152, 581, 159, 581, 164, 581,
+	165, 581, 169, 581, 170, 581, 173, 581, 177, 581, 181, 581, 190, 581, 193,
+	581, 221, 581, 238, 581, 244, 581, 255, 581, 262, 581, 263, 581, 273, 581,
+	275, 581, 277, 581, 281, 581, 282, 581, 286, 581, 294, 581, 299, 581, 301,
+	581, 307, 581, 309, 581, 317, 581, 319, 581, 323, 581, 333, 581, 335, 581,
+	339, 581, 364, 581, 367, 581, -1, -2, 489, -1, 5, 549, 32, 549, 34, 549, 39,
+	549, 51, 549, 60, 549, 63, 549, 69, 549, 72, 549, 77, 549, 78, 549, 81, 549,
+	82, 549, 83, 549, 88, 549, 89, 549, 90, 549, 120, 549, 121, 549, 122, 549,
+	126, 549, 127, 549, 128, 549, 131, 549, 132, 549, 134, 549, 135, 549, 136,
+	549, 137, 549, 138, 549, 139, 549, 141, 549, 143, 549, 152, 549, 159, 549,
+	164, 549, 165, 549, 169, 549, 170, 549, 173, 549, 177, 549, 181, 549, 190,
+	549, 193, 549, 221, 549, 238, 549, 244, 549, 255, 549, 262, 549, 263, 549,
+	273, 549, 275, 549, 277, 549, 281, 549, 282, 549, 286, 549, 294, 549, 299,
+	549, 301, 549, 307, 549, 309, 549, 317, 549, 319, 549, 323, 549, 333, 549,
+	335, 549, 339, 549, 364, 549, 367, 549, -1, -2, 489, -1, 5, 647, 6, 647, 32,
+	647, 34, 647, 39, 647, 51, 647, 60, 647, 63, 647, 69, 647, 72, 647, 77, 647,
+	78, 647, 81, 647, 82, 647, 83, 647, 88, 647, 89, 647, 90, 647, 120, 647,
+	121, 647, 122, 647, 126, 647, 127, 647, 128, 647, 131, 647, 132, 647, 134,
+	647, 135, 647, 136, 647, 137, 647, 138, 647, 139, 647, 141, 647, 143, 647,
+	152, 647, 159, 647, 164, 647, 165, 647, 169, 647, 170, 647, 173, 647, 177,
+	647, 181, 647, 190, 647, 193, 647, 221, 647, 238, 647, 244, 647, 255, 647,
+	262, 647, 263, 647, 273, 647, 275, 647, 277, 647, 281, 647, 282, 647, 286,
+	647, 294, 647, 299, 647, 301, 647, 307, 647, 309, 647, 317, 647, 319, 647,
+	323, 647, 333, 647, 335, 647, 336, 647, 339, 647, 364, 647, 367, 647, 397,
+	647, 400, 647, 401, 647, 403, 647, 404, 647, 405, 647, 406, 647, 407, 647,
+	408, 647, 409, 647, 410, 647, 411, 647, 414, 647, 415, 647, 417, 647, 418,
+	647, 419, 647, 420, 647, 421, 647, 422, 647, 423, 647, 424, 647, 425, 647,
+	426, 647, 427, 647, 428, 647, 429, 647, 430, 647, 431, 647, 432, 647, 433,
+	647, 434, 647, 435, 647, 436, 647, 437, 647, 438, 647, 440, 647, 441, 647,
+	442, 647, 443, 647, 444, 647, 445, 647, 446, 647, 447, 647, 448, 647, 449,
+	647, 450, 647, 451, 647, 452, 647, 453, 647, 454, 647, 455, 647, 458, 647,
+	459, 647, 460, 647, 461, 647, 462, 647, 463, 647, 465, 647, 466, 647, 467,
+	647, 469, 647, 470, 647, 476, 647, 477, 647, 478, 647, 479, 647, 480, 647,
+	481, 647, 482, 647, 484, 647, 485, 647, 486, 647, 487, 647, 488, 647, 497,
+	647, -1, -2, 489, -1, 5, 481, 32, 481, 34, 481, 39, 481, 51, 481, 60, 481,
+	63, 481, 69, 481, 72, 481, 77, 481, 78, 481, 81, 481, 82, 481, 83, 481, 88,
+	481, 89, 481, 90, 481, 120, 481, 121, 481, 122, 481, 126, 481, 127, 481,
+	128, 481, 131, 481, 132, 481, 134, 481, 135, 481, 136, 481, 137, 481, 138,
+	481, 139, 481, 141, 481, 143, 481, 152, 481, 159, 481, 164, 481, 165, 481,
+	169, 481, 170, 481, 173, 481, 177, 481, 181, 481, 190, 481, 193, 481, 221,
+	481, 238, 481, 244, 481, 255, 481, 262, 481, 263, 481, 273, 481, 275, 481,
+	277, 481, 281, 481, 282, 481, 286, 481, 294, 481, 299, 481, 301, 481, 307,
+	481, 309, 481, 317, 481, 319, 481, 323, 481, 333, 481, 335, 481, 339, 481,
+	364, 481, 367, 481, -1, -2, 489, -1, 5, 510, 32, 510, 34, 510, 39, 510, 51,
+	510, 60, 510, 63, 510, 69, 510, 72, 510, 77, 510, 78, 510, 81, 510, 82, 510,
+	83, 510, 88, 510, 89, 510, 90, 510, 120, 510, 121, 510, 122, 510, 126, 510,
+	127, 510, 128, 510, 131, 510, 132, 510, 134, 510, 135, 510, 136, 510, 137,
+	510, 138, 510, 139, 510, 141, 510, 143, 510, 152, 510, 159, 510, 164, 510,
+	165, 510, 169, 510, 170, 510, 173, 510, 177, 510, 181, 510, 190, 510, 193,
+	510, 221, 510, 238, 510, 244, 510, 255, 510, 262, 510, 263, 510, 273, 510,
+	275, 510, 277, 510, 281, 510, 282, 510, 286, 510, 294, 510, 299, 510, 301,
+	510, 307, 510, 309, 510, 317, 510, 319, 510, 323, 510, 333, 510, 335, 510,
+	339, 510, 364, 510, 367, 510, -1, -2, 489, -1, 5, 589, 32, 589, 34, 589, 39,
+	589, 51, 589, 60, 589, 63, 589, 69, 589, 72, 589, 77, 589, 78, 589, 81, 589,
+	82, 589, 83, 589, 88, 589, 89, 589, 90, 589, 120, 589, 121, 589, 122, 589,
+	126, 589, 127, 589, 128, 589, 131, 589, 132, 589, 134, 589, 135, 589, 136,
+	589, 137, 589, 138, 589, 139, 589, 141, 589, 143, 589, 152, 589, 159, 589,
+	164, 589, 165, 589, 169, 589, 170, 589, 173, 589, 177, 589, 181, 589, 190,
+	589, 193, 589, 221, 589, 238, 589, 244, 589, 255, 589, 262, 589, 263, 589,
+	273, 589, 275, 589, 277, 589, 281, 589, 282, 589, 286, 589, 294, 589, 299,
+	589, 301, 589, 307, 589, 309, 589, 317, 589, 319, 589, 323, 589, 333, 589,
+	335, 589, 339, 589, 364, 589, 367, 589, -1, -2, 489, -1, 5, 483, 32, 483,
+	34, 483, 39, 483, 51, 483, 60, 483, 63, 483, 69, 483, 72, 483, 77, 483, 78,
+	483, 81, 483, 82, 483, 83, 483, 88, 483, 89, 483, 90, 483, 120, 483, 121,
+	483, 122, 483, 126, 483, 127, 483, 128, 483, 131, 483, 132, 483, 134, 483,
+	135, 483, 136, 483, 137, 483, 138, 483, 139, 483, 141, 483, 143, 483, 152,
+	483, 159, 483, 164, 483, 165, 483, 169, 483, 170, 483, 173, 483, 177, 483,
+	181, 483, 190, 483, 193, 483, 221, 483, 238, 483, 244, 483, 255, 483, 262,
+	483, 263, 483, 273, 483, 275, 483, 277, 483, 281, 483, 282, 483, 286, 483,
+	294, 483, 299, 483, 301, 483, 307, 483, 309, 483, 317, 483, 319, 483, 323,
+	483, 333, 483, 335, 483, 339, 483, 364, 483, 367, 483, -1, -2, 489, -1, 5,
+	518, 32, 518, 34, 518, 39, 518, 51, 518, 60, 518, 63, 518, 69, 518, 72, 518,
+	77, 518, 78, 518, 81, 518, 82, 518, 83, 518, 88, 518, 89, 518, 90, 518, 120,
+	518, 121, 518, 122, 518, 126, 518, 127, 518, 128, 518, 131, 518, 132, 518,
+	134, 518, 135, 518, 136, 518, 137, 518, 138, 518, 139, 518, 141, 518, 143,
+	518, 152, 518, 159, 518, 164, 518, 165, 518, 169, 518, 170, 518, 173, 518,
+	177, 518, 181, 518, 190, 518, 193, 518, 221, 518, 238, 518, 244, 518, 255,
+	518, 262, 518, 263, 518, 273, 518, 275, 518, 277, 518, 281, 518, 282, 518,
+	286, 518, 294, 518, 299, 518, 301, 518, 307, 518, 309, 518, 317, 518, 319,
+	518, 323, 518, 333, 518, 335, 518, 339, 518, 364, 518, 367, 518, -1, -2,
+	489, -1, 5, 645, 6, 645, 32, 645, 34, 645, 39, 645, 51, 645, 60, 645, 63,
+	645, 69, 645, 72, 645, 77, 645, 78, 645, 81, 645, 82, 645, 83, 645, 88, 645,
+	89, 645, 90, 645, 120, 645, 121, 645, 122, 645, 126, 645, 127, 645, 128,
+	645, 131, 645, 132, 645, 134, 645, 135, 645, 136, 645, 137, 645, 138, 645,
+	139, 645, 141, 645, 143, 645, 152, 645, 159, 645, 164, 645, 165, 645, 169,
+	645, 170, 645, 173, 645, 177, 645, 181, 645, 190, 645, 193, 645, 221, 645,
+	238, 645, 244, 645, 255, 645, 262, 645, 263, 645, 273, 645, 275, 645, 277,
+	645, 281, 645, 282, 645, 286, 645, 294, 645, 299, 645, 301, 645, 307, 645,
+	309, 645, 317, 645, 319, 645, 323, 645, 333, 645, 335, 645, 336, 645, 339,
+	645, 364, 645, 367, 645, 397, 645, 400, 645, 401, 645, 403, 645, 404, 645,
+	405, 645, 406, 645, 407, 645, 408, 645, 409, 645, 410, 645, 411, 645, 414,
+	645, 415, 645, 417, 645, 418, 645, 419, 645, 420, 645, 421, 645, 422, 645,
+	423, 645, 424, 645, 425, 645, 426, 645, 427, 645, 428, 645, 429, 645, 430,
+	645, 431, 645, 432, 645, 433, 645, 434, 645, 435, 645, 436, 645, 437, 645,
+	438, 645, 440, 645, 441, 645, 442, 645, 443, 645, 444, 645, 445, 645, 446,
+	645, 447, 645, 448, 645, 449, 645, 450, 645, 451, 645, 452, 645, 453, 645,
+	454, 645, 455, 645, 458, 645, 459, 645, 460, 645, 461, 645, 462, 645, 463,
+	645, 465, 645, 466, 645, 467, 645, 469, 645, 470, 645, 476, 645, 477, 645,
+	478, 645, 479, 645, 480, 645, 481, 645, 482, 645, 484, 645, 485, 645, 486,
+	645, 487, 645, 488, 645, 497, 645, -1, -2, 489, -1, 0, 142, 4, 142, 5, 142,
+	8, 142, 9, 142, 10, 142, 64, 142, 100, 142, 102, 142, 187, 142, 336, 142,
+	337, 142, -1, -2, 7, -1, 25, -1, 27, -1, 37, -1, 38, -1, 40, -1, 41, -1, 56,
+	-1, 73, -1, 91, -1, 97, -1, 105, -1, 151, -1, 155, -1, 156, -1, 161, -1,
+	171, -1, 186, -1, 192, -1, 194, -1, 202, -1, 203, -1, 205, -1, 207, -1, 208,
+	-1, 209, -1, 210, -1, 211, -1, 213, -1, 215, -1, 216, -1, 217, -1, 218, -1,
+	219, -1, 220, -1, 226, -1, 235, -1, 236, -1, 237, -1, 247, -1, 258, -1, 259,
+	-1, 265, -1, 266, -1, 268, -1, 269, -1, 270, -1, 271, -1, 272, -1, 280, -1,
+	290, -1, 291, -1, 296, -1, 297, -1, 298, -1, 300, -1, 338, -1, 345, -1, 349,
+	-1, 352, -1, 489, -1, 0, 126, 4, 126, 5, 126, 8, 126, 9, 126, 10, 126, 64,
+	126, 100, 126, 102, 126, 187, 126, 336, 126, 337, 126, -1, -2, 489, -1, 0,
+	143, 4, 143, 5, 143, 8, 143, 9, 143, 10, 143, 64, 143, 100, 143, 102, 143,
+	187, 143, 336, 143, 337, 143, -1, -2, 489, -1, 5, 500, 32, 500, 34, 500, 39,
+	500, 51, 500, 60, 500, 63, 500, 69, 500, 72, 500, 77, 500, 78, 500, 81, 500,
+	82, 500, 83, 500, 88, 500, 89, 500, 90, 500, 120, 500, 121, 500, 122, 500,
+	126, 500, 127, 500, 128, 500, 131, 500, 132, 500, 134, 500, 135, 500, 136,
+	500, 137, 500, 138, 500, 139, 500, 141, 500, 143, 500, 152, 500, 159, 500,
+	164, 500, 165, 500, 169, 500, 170, 500, 173, 500, 177, 500, 181, 500, 190,
+	500, 193, 500, 221, 500, 238, 500, 244, 500, 255, 500, 262, 500, 263, 500,
+	273, 500, 275, 500, 277, 500, 281, 500, 282, 500, 286, 500, 294, 500, 299,
+	500, 301, 500, 307, 500, 309, 500, 317, 500, 319, 500, 323, 500, 333, 500,
+	335, 500, 339, 500, 364, 500, 367, 500, -1, -2, 489, -1, 5, 496, 32, 496,
+	34, 496, 39, 496, 51, 496, 60, 496, 63, 496, 69, 496, 72, 496, 77, 496, 78,
+	496, 81, 496, 82, 496, 83, 496, 88, 496, 89, 496, 90, 496, 120, 496, 121,
+	496, 122, 496, 126, 496, 127, 496, 128, 496, 131, 496, 132, 496, 134, 496,
+	135, 496, 136, 496, 137, 496, 138, 496, 139, 496, 141, 496, 143, 496, 152,
+	496, 159, 496, 164, 496, 165, 496, 169, 496, 170, 496, 173, 496, 177, 496,
+	181, 496, 190, 496, 193, 496, 221, 496, 238, 496, 244, 496, 255, 496, 262,
+	496, 263, 496, 273, 496, 275, 496, 277, 496, 281, 496, 282, 496, 286, 496,
+	294, 496, 299, 496, 301, 496, 307, 496, 309, 496, 317, 496, 319, 496, 323,
+	496, 333, 496, 335, 496, 339, 496, 364, 496, 367, 496, -1, -2, 489, -1, 5,
+	494, 32, 494, 34, 494, 39, 494, 51, 494, 60, 494, 63, 494, 69, 494, 72, 494,
+	77, 494, 78, 494, 81, 494, 82, 494, 83, 494, 88, 494, 89, 494, 90, 494, 120,
+	494, 121, 494, 122, 494, 126, 494, 127, 494, 128, 494, 131, 494, 132, 494,
+	134, 494, 135, 494, 136, 494, 137, 494, 138, 494, 139, 494, 141, 494, 143,
+	494, 152, 494, 159, 494, 164, 494, 165, 494, 169, 494, 170, 494, 173, 494,
+	177, 494, 181, 494, 190, 494, 193, 494, 221, 494, 238, 494, 244, 494, 255,
+	494, 262, 494, 263, 494, 273, 494, 275, 494, 277, 494, 281, 494, 282, 494,
+	286, 494, 294, 494, 299, 494, 301, 494, 307, 494, 309, 494, 317, 494, 319,
+	494, 323, 494, 333, 494, 335, 494, 339, 494, 364, 494, 367, 494, -1, -2,
+	489, -1, 5, 535, 32, 535, 34, 535, 39, 535, 51, 535, 60, 535, 63, 535, 69,
+	535, 72, 535, 77, 535, 78, 535, 81, 535, 82, 535, 83, 535, 88, 535, 89, 535,
+	90, 535, 120, 535, 121, 535, 122, 535, 126, 535, 127, 535, 128, 535, 131,
+	535, 132, 535, 134, 535, 135, 535, 136, 535, 137, 535, 138, 535, 139, 535,
+	141, 535, 143, 535, 152, 535, 159, 535, 164, 535, 165, 535, 169, 535, 170,
+	535, 173, 535, 177, 535, 181, 535, 190, 535, 193, 535, 221, 535, 238, 535,
+	244, 535, 255, 535, 262, 535, 263, 535, 273, 535, 275, 535, 277, 535, 281,
+	535, 282, 535, 286, 535, 294, 535, 299, 535, 301, 535, 307, 535, 309, 535,
+	317, 535, 319, 535, 323, 535, 333, 535, 335, 535, 339, 535, 364, 535, 367,
+	535, -1, -2, 489, -1, 5, 640, 6, 640, 32, 640, 34, 640, 39, 640, 51, 640,
+	60, 640, 63, 640, 69, 640, 72, 640, 77, 640, 78, 640, 81, 640, 82, 640, 83,
+	640, 88, 640, 89, 640, 90, 640, 120, 640, 121, 640, 122, 640, 126, 640, 127,
+	640, 128, 640, 131, 640, 132, 640, 134, 640, 135, 640, 136, 640, 137, 640,
+	138, 640, 139, 640, 141, 640, 143, 640, 152, 640, 159, 640, 164, 640, 165,
+	640, 169, 640, 170, 640, 173, 640, 177, 640, 181, 640, 190, 640, 193, 640,
+	221, 640, 238, 640, 244, 640, 255, 640, 262, 640, 263, 640, 273, 640, 275,
+	640, 277, 640, 281, 640, 282, 640, 286, 640, 294, 640, 299, 640, 301, 640,
+	307, 640, 309, 640, 317, 640, 319, 640, 323, 640, 333, 640, 335, 640, 336,
+	640, 339, 640, 364, 640, 367, 640, 397, 640, 400, 640, 401, 640, 403, 640,
+	404, 640, 405, 640, 406, 640, 407, 640, 408, 640, 409, 640, 410, 640, 411,
+	640, 414, 640, 415, 640, 417, 640, 418, 640, 419, 640, 420, 640, 421, 640,
+	422, 640, 423, 640, 424, 640, 425, 640, 426, 640, 427, 640, 428, 640, 429,
+	640, 430, 640, 431, 640, 432, 640, 433, 640, 434, 640, 435, 640, 436, 640,
+	437, 640, 438, 640, 440, 640, 441, 640, 442, 640, 443, 640, 444, 640, 445,
+	640, 446, 640, 447, 640, 448, 640, 449, 640, 450, 640, 451, 640, 452, 640,
+	453, 640, 454, 640, 455, 640, 458, 640, 459, 640, 460, 640, 461, 640, 462,
+	640, 463, 640, 465, 640, 466, 640, 467, 640, 469, 640, 470, 640, 476, 640,
+	477, 640, 478, 640, 479, 640, 480, 640, 481, 640, 482, 640, 484, 640, 485,
+	640, 486, 640, 487, 640, 488, 640, 497, 640, -1, -2, 33, 202, 492, 202, 498,
+	202, 4, 1203, 5, 1203, 23, 1203, 24, 1203, 25, 1203, 32, 1203, 34, 1203, 37,
+	1203, 38, 1203, 51, 1203, 60, 1203, 61, 1203, 69, 1203, 70, 1203, 74, 1203,
+	75, 1203, 76, 1203, 103, 1203, 104, 1203, 111, 1203, 113, 1203, 120, 1203,
+	121, 1203, 122, 1203, 123, 1203, 126, 1203, 127, 1203, 131, 1203, 132, 1203,
+	134, 1203, 135, 1203, 136, 1203, 137, 1203, 139, 1203, 141, 1203, 143, 1203,
+	152, 1203, 154, 1203, 157, 1203, 163, 1203, 164, 1203, 165, 1203, 169, 1203,
+	181, 1203, 190, 1203, 197, 1203, 200, 1203, 201, 1203, 204, 1203, 208, 1203,
+	212, 1203, 214, 1203, 222, 1203, 225, 1203, 238, 1203, 245, 1203, 247, 1203,
+	255, 1203, 258, 1203, 259, 1203, 264, 1203, 273, 1203, 275, 1203, 277, 1203,
+	281, 1203, 282, 1203, 284, 1203, 286, 1203, 294, 1203, 295, 1203, 301, 1203,
+	302, 1203, 304, 1203, 305, 1203, 316, 1203, 317, 1203, 319, 1203, 323, 1203,
+	328, 1203, 335, 1203, 352, 1203, 364, 1203, 365, 1203, 366, 1203, 367, 1203,
+	494, 1203, 496, 1203, 499, 1203, -1, -2, 489, -1, 493, 1199, -1, -2, 489,
+	-1, 5, 664, 6, 664, 32, 664, 34, 664, 39, 664, 51, 664, 60, 664, 63, 664,
+	69, 664, 72, 664, 77, 664, 78, 664, 81, 664, 82, 664, 83, 664, 88, 664, 89,
+	664, 90, 664, 120, 664, 121, 664, 122, 664, 126, 664, 127, 664, 128, 664,
+	131, 664, 132, 664, 134, 664, 135, 664, 136, 664, 137, 664, 138, 664, 139,
+	664, 141, 664, 143, 664, 152, 664, 159, 664, 164, 664, 165, 664, 169, 664,
+	170, 664, 173, 664, 177, 664, 181, 664, 190, 664, 193, 664, 221, 664, 238,
+	664, 244, 664, 255, 664, 262, 664, 263, 664, 273, 664, 275, 664, 277, 664,
+	281, 664, 282, 664, 286, 664, 294, 664, 299, 664, 301, 664, 307, 664, 309,
+	664, 317, 664, 319, 664, 323, 664, 333, 664, 335, 664, 336, 664, 339, 664,
+	364, 664, 367, 664, 397, 664, 400, 664, 401, 664, 403, 664, 404, 664, 405,
+	664, 406, 664, 407, 664, 408, 664, 409, 664, 410, 664, 411, 664, 414, 664,
+	415, 664, 417, 664, 418, 664, 419, 664, 420, 664, 421, 664, 422, 664, 423,
+	664, 424, 664, 425, 664, 426, 664, 427, 664, 428, 664, 429, 664, 430, 664,
+	431, 664, 432, 664, 433, 664, 434, 664, 435, 664, 436, 664, 437, 664, 438,
+	664, 440, 664, 441, 664, 442, 664, 443, 664, 444, 664, 445, 664, 446, 664,
+	447, 664, 448, 664, 449, 664, 450, 664, 451, 664, 452, 664, 453, 664, 454,
+	664, 455, 664, 458, 664, 459, 664, 460, 664, 461, 664, 462, 664, 463, 664,
+	465, 664, 466, 664, 467, 664, 469, 664, 470, 664, 476, 664, 477, 664, 478,
+	664, 479, 664, 480, 664, 481, 664, 482, 664, 484, 664, 485, 664, 486, 664,
+	487, 664, 488, 664, 497, 664, -1, -2, 489, -1, 5, 516, 32, 516, 34, 516, 39,
+	516, 51, 516, 60, 516, 63, 516, 69, 516, 72, 516, 77, 516, 78, 516, 81, 516,
+	82, 516, 83, 516, 88, 516, 89, 516, 90, 516, 120, 516, 121, 516, 122, 516,
+	126, 516, 127, 516, 128, 516, 131, 516, 132, 516, 134, 516, 135, 516, 136,
+	516, 137, 516, 138, 516, 139, 516, 141, 516, 143, 516, 152, 516, 159, 516,
+	164, 516, 165, 516, 169, 516, 170, 516, 173, 516, 177, 516, 181, 516, 190,
+	516, 193, 516, 221, 516, 238, 516, 244, 516, 255, 516, 262, 516, 263, 516,
+	273, 516, 275, 516, 277, 516, 281, 516, 282, 516, 286, 516, 294, 516, 299,
+	516, 301, 516, 307, 516, 309, 516, 317, 516, 319, 516, 323, 516, 333, 516,
+	335, 516, 339, 516, 364, 516, 367, 516, -1, -2, 489, -1, 5, 509, 32, 509,
+	34, 509, 39, 509, 51, 509, 60, 509, 63, 509, 69, 509, 72, 509, 77, 509, 78,
+	509, 81, 509, 82, 509, 83, 509, 88, 509, 89, 509, 90, 509, 120, 509, 121,
+	509, 122, 509, 126, 509, 127, 509, 128, 509, 131, 509, 132, 509, 134, 509,
+	135, 509, 136, 509, 137, 509, 138, 509, 139, 509, 141, 509, 143, 509, 152,
+	509, 159, 509, 164, 509, 165, 509, 169, 509, 170, 509, 173, 509, 177, 509,
+	181, 509, 190, 509, 193, 509, 221, 509, 238, 509, 244, 509, 255, 509, 262,
+	509, 263, 509, 273, 509, 275, 509, 277, 509, 281, 509, 282, 509, 286, 509,
+	294, 509, 299, 509, 301, 509, 307, 509, 309, 509, 317, 509, 319, 509, 323,
+	509, 333, 509, 335, 509, 339, 509, 364, 509, 367, 509, -1, -2, 489, -1, 5,
+	588, 32, 588, 34, 588, 39, 588, 51, 588, 60, 588, 63, 588, 69, 588, 72, 588,
+	77, 588, 78, 588, 81, 588, 82, 588, 83, 588, 88, 588, 89, 588, 90, 588, 120,
+	588, 121, 588, 122, 588, 126, 588, 127, 588, 128, 588, 131, 588, 132, 588,
+	134, 588, 135, 588, 136, 588, 137, 588, 138, 588, 139, 588, 141, 588, 143,
+	588, 152, 588, 159, 588, 164, 588, 165, 588, 169, 588, 170, 588, 173, 588,
+	177, 588, 181, 588, 190, 588, 193, 588, 221, 588, 238, 588, 244, 588, 255,
+	588, 262, 588, 263, 588, 273, 588, 275, 588, 277, 588, 281, 588, 282, 588,
+	286, 588, 294, 588, 299, 588, 301, 588, 307, 588, 309, 588, 317, 588, 319,
+	588, 323, 588, 333, 588, 335, 588, 339, 588, 364, 588, 367, 588, -1, -2,
+	489, -1, 5, 524, 32, 524, 34, 524, 39, 524, 51, 524, 60, 524, 63, 524, 69,
+	524, 72, 524, 77, 524, 78, 524, 81, 524, 82, 524, 83, 524, 88, 524, 89, 524,
+	90, 524, 120, 524, 121, 524, 122, 524, 126, 524, 127, 524, 128, 524, 131,
+	524, 132, 524, 134, 524, 135, 524, 136, 524, 137, 524, 138, 524, 139, 524,
+	141, 524, 143, 524, 152, 524, 159, 524, 164, 524, 165, 524, 169, 524, 170,
+	524, 173, 524, 177, 524, 181, 524, 190, 524, 193, 524, 221, 524, 238, 524,
+	244, 524, 255, 524, 262, 524, 263, 524, 273, 524, 275, 524, 277, 524, 281,
+	524, 282, 524, 286, 524, 294, 524, 299, 524, 301, 524, 307, 524, 309, 524,
+	317, 524, 319, 524, 323, 524, 333, 524, 335, 524, 339, 524, 364, 524, 367,
+	524, -1, -2, 489, -1, 5, 517, 32, 517, 34, 517, 39, 517, 51, 517, 60, 517,
+	63, 517, 69, 517, 72, 517, 77, 517, 78, 517, 81, 517, 82, 517, 83, 517, 88,
+	517, 89, 517, 90, 517, 120, 517, 121, 517, 122, 517, 126, 517, 127, 517,
+	128, 517, 131, 517, 132, 517, 134, 517, 135, 517, 136, 517, 137, 517, 138,
+	517, 139, 517, 141, 517, 143, 517, 152, 517, 159, 517, 164, 517, 165, 517,
+	169, 517, 170, 517, 173, 517, 177, 517, 181, 517, 190, 517, 193, 517, 221,
+	517, 238, 517, 244, 517, 255, 517, 262, 517, 263, 517, 273, 517, 275, 517,
+	277, 517, 281, 517, 282, 517, 286, 517, 294, 517, 299, 517, 301, 517, 307,
+	517, 309, 517, 317, 517, 319, 517, 323, 517, 333, 517, 335, 517, 339, 517,
+	364, 517, 367, 517, -1, -2, 489, -1, 5, 644, 6, 644, 32, 644, 34, 644, 39,
+	644, 51, 644, 60, 644, 63, 644, 69, 644, 72, 644, 77, 644, 78, 644, 81, 644,
+	82, 644, 83, 644, 88, 644, 89, 644, 90, 644, 120, 644, 121, 644, 122, 644,
+	126, 644, 127, 644, 128, 644, 131, 644, 132, 644, 134, 644, 135, 644, 136,
+	644, 137, 644, 138, 644, 139, 644, 141, 644, 143, 644, 152, 644, 159, 644,
+	164, 644, 165, 644, 169, 644, 170, 644, 173, 644, 177, 644, 181, 644, 190,
+	644, 193, 644, 221, 644, 238, 644, 244, 644, 255, 644, 262, 644, 263, 644,
+	273, 644, 275, 644, 277, 644, 281, 644, 282, 644, 286, 644, 294, 644, 299,
+	644, 301, 644, 307, 644, 309, 644, 317, 644, 319, 644, 323, 644, 333, 644,
+	335, 644, 336, 644, 339, 644, 364, 644, 367, 644, 397, 644, 400, 644, 401,
+	644, 403, 644, 404, 644, 405, 644, 406, 644, 407, 644, 408, 644, 409, 644,
+	410, 644, 411, 644, 414, 644, 415, 644, 417, 644, 418, 644, 419, 644, 420,
+	644, 421, 644, 422, 644, 423, 644, 424, 644, 425, 644, 426, 644, 427, 644,
+	428, 644, 429, 644, 430, 644, 431, 644, 432, 644, 433, 644, 434, 644, 435,
+	644, 436, 644, 437, 644, 438, 644, 440, 644, 441, 644, 442, 644, 443, 644,
+	444, 644, 445, 644, 446, 644, 447, 644, 448, 644, 449, 644, 450, 644, 451,
+	644, 452, 644, 453, 644, 454, 644, 455, 644, 458, 644, 459, 644, 460, 644,
+	461, 644, 462, 644, 463, 644, 465, 644, 466, 644, 467, 644, 469, 644, 470,
+	644, 476, 644, 477, 644, 478, 644, 479, 644, 480, 644, 481, 644, 482, 644,
+	484, 644, 485, 644, 486, 644, 487, 644, 488, 644, 497, 644, -1, -2, 7, -1,
+	25, -1, 27, -1, 37, -1, 38, -1, 40, -1, 41, -1, 56, -1, 73, -1, 91, -1, 93,
+	-1, 97, -1, 105, -1, 142, -1, 151, -1, 155, -1, 156, -1, 161, -1, 171, -1,
+	186, -1, 192, -1, 194, -1, 202, -1, 203, -1, 205, -1, 207, -1, 208, -1, 209,
+	-1, 210, -1, 211, -1, 213, -1, 215, -1, 216, -1, 217, -1, 218, -1, 219, -1,
+	220, -1, 226, -1, 235, -1, 236, -1, 237, -1, 242, -1, 243, -1, 247, -1, 248,
+	-1, 252, -1, 258, -1, 259, -1, 265, -1, 266, -1, 268, -1, 269, -1, 270, -1,
+	271, -1, 272, -1, 274, -1, 280, -1, 290, -1, 291, -1, 296, -1, 297, -1, 298,
+	-1, 300, -1, 338, -1, 345, -1, 349, -1, 352, -1, 0, 161, 4, 161, 5, 161, 8,
+	161, 9, 161, 10, 161, 64, 161, 100, 161, 102, 161, 187, 161, 336, 161, 337,
+	161, 496, 161, -1, -2, 7, -1, 25, -1, 27, -1, 37, -1, 38, -1, 40, -1, 41,
+	-1, 56, -1, 73, -1, 91, -1, 97, -1, 105, -1, 151, -1, 155, -1, 156, -1, 161,
+	-1, 171, -1, 186, -1, 192, -1, 194, -1, 202, -1, 203, -1, 205, -1, 207, -1,
+	208, -1, 209, -1, 210, -1, 211, -1, 213, -1, 215, -1, 216, -1, 217, -1, 218,
+	-1, 219, -1, 220, -1, 226, -1, 235, -1, 236, -1, 237, -1, 247, -1, 258, -1,
+	259, -1, 265, -1, 266, -1, 268, -1, 269, -1, 270, -1, 271, -1, 272, -1, 280,
+	-1, 290, -1, 291, -1, 296, -1, 297, -1, 298, -1, 300, -1, 338, -1, 345, -1,
+	349, -1, 352, -1, 0, 125, 4, 125, 5, 125, 8, 125, 9, 125, 10, 125, 64, 125,
+	100, 125, 102, 125, 187, 125, 336, 125, 337, 125, -1, -2, 7, -1, 25, -1, 27,
+	-1, 37, -1, 38, -1, 40, -1, 41, -1, 56, -1, 73, -1, 91, -1, 97, -1, 105, -1,
+	151, -1, 155, -1, 156, -1, 161, -1, 171, -1, 186, -1, 192, -1, 194, -1, 202,
+	-1, 203, -1, 205, -1, 207, -1, 208, -1, 209, -1, 210, -1, 211, -1, 213, -1,
+	215, -1, 216, -1, 217, -1, 218, -1, 219, -1, 220, -1, 226, -1, 235, -1, 236,
+	-1, 237, -1, 247, -1, 258, -1, 259, -1, 265, -1, 266, -1, 268, -1, 269, -1,
+	270, -1, 271, -1, 272, -1, 280, -1, 290, -1, 291, -1, 296, -1, 297, -1, 298,
+	-1, 300, -1, 338, -1, 345, -1, 349, -1, 352, -1, 489, -1, 0, 124, 4, 124, 5,
+	124, 8, 124, 9, 124, 10, 124, 64, 124, 100, 124, 102, 124, 187, 124, 336,
+	124, 337, 124, -1, -2, 7, -1, 25, -1, 27, -1, 37, -1, 38, -1, 40, -1, 41,
+	-1, 56, -1, 73, -1, 91, -1, 97, -1, 105, -1, 151, -1, 155, -1, 156, -1, 161,
+	-1, 171, -1, 186, -1, 192, -1, 194, -1, 202, -1, 203, -1, 205, -1, 207, -1,
+	208, -1, 209, -1, 210, -1, 211, -1, 213, -1, 215, -1, 216, -1, 217, -1, 218,
+	-1, 219, -1, 220, -1, 226, -1, 235, -1, 236, -1, 237, -1, 247, -1, 258, -1,
+	259, -1, 265, -1, 266, -1, 268, -1, 269, -1, 270, -1, 271, -1, 272, -1, 280,
+	-1, 290, -1, 291, -1, 296, -1, 297, -1, 298, -1, 300, -1, 338, -1, 345, -1,
+	349, -1, 352, -1, 489, -1, 0, 130, 4, 130, 5, 130, 8, 130, 9, 130, 10, 130,
+	64, 130, 100, 130, 102, 130, 187, 130, 336, 130, 337, 130, -1, -2, 489, -1,
+	5, 499, 32, 499, 34, 499, 39, 499, 51, 499, 60, 499, 63, 499, 69, 499, 72,
+	499, 77, 499, 78, 499, 81, 499, 82, 499, 83, 499, 88, 499, 89, 499, 90, 499,
+	120, 499, 121, 499, 122, 499, 126, 499, 127, 499, 128, 499, 131, 499, 132,
+	499, 134, 499, 135, 499, 136, 499, 137, 499, 138, 499, 139, 499, 141, 499,
+	143, 499, 152, 499, 159, 499, 164, 499, 165, 499, 169, 499, 170, 499, 173,
+	499, 177, 499, 181, 499, 190, 499, 193, 499, 221, 499, 238, 499, 244, 499,
+	255, 499, 262, 499, 263, 499, 273, 499, 275, 499, 277, 499, 281, 499, 282,
+	499, 286, 499, 294, 499, 299, 499, 301, 499, 307, 499, 309, 499, 317, 499,
+	319, 499, 323, 499, 333, 499, 335, 499, 339, 499, 364, 499, 367, 499, -1,
+	-2, 489, -1, 5, 495, 32, 495, 34, 495, 39, 495, 51, 495, 60, 495, 63, 495,
+	69, 495, 72, 495, 77, 495, 78, 495, 81, 495, 82, 495, 83, 495, 88, 495, 89,
+	495, 90, 495, 120, 495, 121, 495, 122, 495, 126, 495, 127, 495, 128, 495,
+	131, 495, 132, 495, 134, 495, 135, 495, 136, 495, 137, 495, 138, 495, 139,
+	495, 141, 495, 143, 495, 152, 495, 159, 495, 164, 495, 165, 495, 169, 495,
+	170, 495, 173, 495, 177, 495, 181, 495, 190, 495, 193, 495, 221, 495, 238,
+	495, 244, 495, 255, 495, 262, 495, 263, 495, 273, 495, 275, 495, 277, 495,
+	281, 495, 282, 495, 286, 495, 294, 495, 299, 495, 301, 495, 307, 495, 309,
+	495, 317, 495, 319, 495, 323, 495, 333, 495, 335, 495, 339, 495, 364, 495,
+	367, 495, -1, -2, 489, -1, 5, 493, 32, 493, 34, 493, 39, 493, 51, 493, 60,
+	493, 63, 493, 69, 493, 72, 493, 77, 493, 78, 493, 81, 493, 82, 493, 83, 493,
+	88, 493, 89, 493, 90, 493, 120, 493, 121, 493, 122, 493, 126, 493, 127, 493,
+	128, 493, 131, 493, 132, 493, 134, 493, 135, 493, 136, 493, 137, 493, 138,
+	493, 139, 493, 141, 493, 143, 493, 152, 493, 159, 493, 164, 493, 165, 493,
+	169, 493, 170, 493, 173, 493, 177, 493, 181, 493, 190, 493, 193, 493, 221,
+	493, 238, 493, 244, 493, 255, 493, 262, 493, 263, 493, 273, 493, 275, 493,
+	277, 493, 281, 493, 282, 493, 286, 493, 294, 493, 299, 493, 301, 493, 307,
+	493, 309, 493, 317, 493, 319, 493, 323, 493, 333, 493, 335, 493, 339, 493,
+	364, 493, 367, 493, -1, -2, 489, -1, 5, 534, 32, 534, 34, 534, 39, 534, 51,
+	534, 60, 534, 63, 534, 69, 534, 72, 534, 77, 534, 78, 534, 81, 534, 82, 534,
+	83, 534, 88, 534, 89, 534, 90, 534, 120, 534, 121, 534, 122, 534, 126, 534,
+	127, 534, 128, 534, 131, 534, 132, 534, 134, 534, 135, 534, 136, 534, 137,
+	534, 138, 534, 139, 534, 141, 534, 143, 534, 152, 534, 159, 534, 164, 534,
+	165, 534, 169, 534, 170, 534, 173, 534, 177, 534, 181, 534, 190, 534, 193,
+	534, 221, 534, 238, 534, 244, 534, 255, 534, 262, 534, 263, 534, 273, 534,
+	275, 534, 277, 534, 281, 534, 282, 534, 286, 534, 294, 534, 299, 534, 301,
+	534, 307, 534, 309, 534, 317, 534, 319, 534, 323, 534, 333, 534, 335, 534,
+	339, 534, 364, 534, 367, 534, -1, -2, 489, -1, 5, 663, 6, 663, 32, 663, 34,
+	663, 39, 663, 51, 663, 60, 663, 63, 663, 69, 663, 72, 663, 77, 663, 78, 663,
+	81, 663, 82, 663, 83, 663, 88, 663, 89, 663, 90, 663, 120, 663, 121, 663,
+	122, 663, 126, 663, 127, 663, 128, 663, 131, 663, 132, 663, 134, 663, 135,
+	663, 136, 663, 137, 663, 138, 663, 139, 663, 141, 663, 143, 663, 152, 663,
+	159, 663, 164, 663, 165, 663, 169, 663, 170, 663, 173, 663, 177, 663, 181,
+	663, 190, 663, 193, 663, 221, 663, 238, 663, 244, 663, 255, 663, 262, 663,
+	263, 663, 273, 663, 275, 663, 277, 663, 281, 663, 282, 663, 286, 663, 294,
+	663, 299, 663, 301, 663, 307, 663, 309, 663, 317, 663, 319, 663, 323, 663,
+	333, 663, 335, 663, 336, 663, 339, 663, 364, 663, 367, 663, 397, 663, 400,
+	663, 401, 663, 403, 663, 404, 663, 405, 663, 406, 663, 407, 663, 408, 663,
+	409, 663, 410, 663, 411, 663, 414, 663, 415, 663, 417, 663, 418, 663, 419,
+	663, 420, 663, 421, 663, 422, 663, 423, 663, 424, 663, 425, 663, 426, 663,
+	427, 663, 428, 663, 429, 663, 430, 663, 431, 663, 432, 663, 433, 663, 434,
+	663, 435, 663, 436, 663, 437, 663, 438, 663, 440, 663, 441, 663, 442, 663,
+	443, 663, 444, 663, 445, 663, 446, 663, 447, 663, 448, 663, 449, 663, 450,
+	663, 451, 663, 452, 663, 453, 663, 454, 663, 455, 663, 458, 663, 459, 663,
+	460, 663, 461, 663, 462, 663, 463, 663, 465, 663, 466, 663, 467, 663, 469,
+	663, 470, 663, 476, 663, 477, 663, 478, 663, 479, 663, 480, 663, 481, 663,
+	482, 663, 484, 663, 485, 663, 486, 663, 487, 663, 488, 663, 497, 663, -1,
+	-2, 489, -1, 5, 515, 32, 515, 34, 515, 39, 515, 51, 515, 60, 515, 63, 515,
+	69, 515, 72, 515, 77, 515, 78, 515, 81, 515, 82, 515, 83, 515, 88, 515, 89,
+	515, 90, 515, 120, 515, 121, 515, 122, 515, 126, 515, 127, 515, 128, 515,
+	131, 515, 132, 515, 134, 515, 135, 515, 136, 515, 137, 515, 138, 515, 139,
+	515, 141, 515, 143, 515, 152, 515, 159, 515, 164, 515, 165, 515, 169, 515,
+	170, 515, 173, 515, 177, 515, 181, 515, 190, 515, 193, 515, 221, 515, 238,
+	515, 244, 515, 255, 515, 262, 515, 263, 515, 273, 515, 275, 515, 277, 515,
+	281, 515, 282, 515, 286, 515, 294, 515, 299, 515, 301, 515, 307, 515, 309,
+	515, 317, 515, 319, 515, 323, 515, 333, 515, 335, 515, 339, 515, 364, 515,
+	367, 515, -1, -2, 489, -1, 5, 523, 32, 523, 34, 523, 39, 523, 51, 523, 60,
+	523, 63, 523, 69, 523, 72, 523, 77, 523, 78, 523, 81, 523, 82, 523, 83, 523,
+	88, 523, 89, 523, 90, 523, 120, 523, 121, 523, 122, 523, 126, 523, 127, 523,
+	128, 523, 131, 523, 132, 523, 134, 523, 135, 523, 136, 523, 137, 523, 138,
+	523, 139, 523, 141, 523, 143, 523, 152, 523, 159, 523, 164, 523, 165, 523,
+	169, 523, 170, 523, 173, 523, 177, 523, 181, 523, 190, 523, 193, 523, 221,
+	523, 238, 523, 244, 523, 255, 523, 262, 523, 263, 523, 273, 523, 275, 523,
+	277, 523, 281, 523, 282, 523, 286, 523, 294, 523, 299, 523, 301, 523, 307,
+	523, 309, 523, 317, 523, 319, 523, 323, 523, 333, 523, 335, 523, 339, 523,
+	364, 523, 367, 523, -1, -2, 7, -1, 25, -1, 27, -1, 37, -1, 38, -1, 40, -1,
+	41, -1, 56, -1, 73, -1, 91, -1, 93, -1, 97, -1, 105, -1, 142, -1, 151, -1,
+	155, -1, 156, -1, 161, -1, 171, -1, 186, -1, 192, -1, 194, -1, 202, -1, 203,
+	-1, 205, -1, 207, -1, 208, -1, 209, -1, 210, -1, 211, -1, 213, -1, 215, -1,
+	216, -1, 217, -1, 218, -1, 219, -1, 220, -1, 226, -1, 235, -1, 236, -1, 237,
+	-1, 242, -1, 243, -1, 247, -1, 248, -1, 252, -1, 258, -1, 259, -1, 265, -1,
+	266, -1, 268, -1, 269, -1, 270, -1, 271, -1, 272, -1, 274, -1, 280, -1, 290,
+	-1, 291, -1, 296, -1, 297, -1, 298, -1, 300, -1, 338, -1, 345, -1, 349, -1,
+	352, -1, 0, 159, 4, 159, 5, 159, 8, 159, 9, 159, 10, 159, 64, 159, 100, 159,
+	102, 159, 187, 159, 336, 159, 337, 159, 496, 159, -1, -2, 7, -1, 25, -1, 27,
+	-1, 37, -1, 38, -1, 40, -1, 41, -1, 56, -1, 73, -1, 91, -1, 93, -1, 97, -1,
+	105, -1, 142, -1, 151, -1, 155, -1, 156, -1, 161, -1, 171, -1, 186, -1, 192,
+	-1, 194, -1, 202, -1, 203, -1, 205, -1, 207, -1, 208, -1, 209, -1, 210, -1,
+	211, -1, 213, -1, 215, -1, 216, -1, 217, -1, 218, -1, 219, -1, 220, -1, 226,
+	-1, 235, -1, 236, -1, 237, -1, 242, -1, 243, -1, 247, -1, 248, -1, 252, -1,
+	258, -1, 259, -1, 265, -1, 266, -1, 268, -1, 269, -1, 270, -1, 271, -1, 272,
+	-1, 274, -1, 280, -1, 290, -1, 291, -1, 296, -1, 297, -1, 298, -1, 300, -1,
+	338, -1, 345, -1, 349, -1, 352, -1, 0, 160, 4, 160, 5, 160, 8, 160, 9, 160,
+	10, 160, 64, 160, 100, 160, 102, 160, 187, 160, 336, 160, 337, 160, 496,
+	160, -1, -2, 492, -1, 0, 1263, 4, 1263, 5, 1263, 7, 1263, 8, 1263, 9, 1263,
+	10, 1263, 25, 1263, 27, 1263, 37, 1263, 38, 1263, 40, 1263, 41, 1263, 56,
+	1263, 64, 1263, 73, 1263, 91, 1263, 93, 1263, 97, 1263, 100, 1263, 102,
+	1263, 105, 1263, 142, 1263, 151, 1263, 155, 1263, 156, 1263, 161, 1263, 171,
+	1263, 186, 1263, 187, 1263, 192, 1263, 194, 1263, 202, 1263, 203, 1263, 205,
+	1263, 207, 1263, 208, 1263, 209, 1263, 210, 1263, 211, 1263, 213, 1263, 215,
+	1263, 216, 1263, 217, 1263, 218, 1263, 219, 1263, 220, 1263, 226, 1263, 235,
+	1263, 236, 1263, 237, 1263, 242, 1263, 243, 1263, 247, 1263, 248, 1263, 252,
+	1263, 258, 1263, 259, 1263, 265, 1263, 266, 1263, 268, 1263, 269, 1263, 270,
+	1263, 271, 1263, 272, 1263, 274, 1263, 280, 1263, 290, 1263, 291, 1263, 296,
+	1263, 297, 1263, 298, 1263, 300, 1263, 336, 1263, 337, 1263, 338, 1263, 345,
+	1263, 349, 1263, 352, 1263, 489, 1263, 496, 1263, -1, -2, 7, -1, 25, -1, 27,
+	-1, 37, -1, 38, -1, 40, -1, 41, -1, 56, -1, 73, -1, 91, -1, 97, -1, 105, -1,
+	151, -1, 155, -1, 156, -1, 161, -1, 171, -1, 186, -1, 192, -1, 194, -1, 202,
+	-1, 203, -1, 205, -1, 207, -1, 208, -1, 209, -1, 210, -1, 211, -1, 213, -1,
+	215, -1, 216, -1, 217, -1, 218, -1, 219, -1, 220, -1, 226, -1, 235, -1, 236,
+	-1, 237, -1, 247, -1, 258, -1, 259, -1, 265, -1, 266, -1, 268, -1, 269, -1,
+	270, -1, 271, -1, 272, -1, 280, -1, 290, -1, 291, -1, 296, -1, 297, -1, 298,
+	-1, 300, -1, 338, -1, 345, -1, 349, -1, 352, -1, 0, 123, 4, 123, 5, 123, 8,
+	123, 9, 123, 10, 123, 64, 123, 100, 123, 102, 123, 187, 123, 336, 123, 337,
+	123, -1, -2, 7, -1, 25, -1, 27, -1, 37, -1, 38, -1, 40, -1, 41, -1, 56, -1,
+	73, -1, 91, -1, 97, -1, 105, -1, 151, -1, 155, -1, 156, -1, 161, -1, 171,
+	-1, 186, -1, 192, -1, 194, -1, 202, -1, 203, -1, 205, -1, 207, -1, 208, -1,
+	209, -1, 210, -1, 211, -1, 213, -1, 215, -1, 216, -1, 217, -1, 218, -1, 219,
+	-1, 220, -1, 226, -1, 235, -1, 236, -1, 237, -1, 247, -1, 258, -1, 259, -1,
+	265, -1, 266, -1, 268, -1, 269, -1, 270, -1, 271, -1, 272, -1, 280, -1, 290,
+	-1, 291, -1, 296, -1, 297, -1, 298, -1, 300, -1, 338, -1, 345, -1, 349, -1,
+	352, -1, 0, 129, 4, 129, 5, 129, 8, 129, 9, 129, 10, 129, 64, 129, 100, 129,
+	102, 129, 187, 129, 336, 129, 337, 129, -1, -2, 7, -1, 25, -1, 27, -1, 37,
+	-1, 38, -1, 40, -1, 41, -1, 56, -1, 73, -1, 91, -1, 97, -1, 105, -1, 151,
+	-1, 155, -1, 156, -1, 161, -1, 171, -1, 186, -1, 192, -1, 194, -1, 202, -1,
+	203, -1, 205, -1, 207, -1, 208, -1, 209, -1, 210, -1, 211, -1, 213, -1, 215,
+	-1, 216, -1, 217, -1, 218, -1, 219, -1, 220, -1, 226, -1, 235, -1, 236, -1,
+	237, -1, 247, -1, 258, -1, 259, -1, 265, -1, 266, -1, 268, -1, 269, -1, 270,
+	-1, 271, -1, 272, -1, 280, -1, 290, -1, 291, -1, 296, -1, 297, -1, 298, -1,
+	300, -1, 338, -1, 345, -1, 349, -1, 352, -1, 489, -1, 0, 128, 4, 128, 5,
+	128, 8, 128, 9, 128, 10, 128, 64, 128, 100, 128, 102, 128, 187, 128, 336,
+	128, 337, 128, -1, -2, 489, -1, 5, 492, 32, 492, 34, 492, 39, 492, 51, 492,
+	60, 492, 63, 492, 69, 492, 72, 492, 77, 492, 78, 492, 81, 492, 82, 492, 83,
+	492, 88, 492, 89, 492, 90, 492, 120, 492, 121, 492, 122, 492, 126, 492, 127,
+	492, 128, 492, 131, 492, 132, 492, 134, 492, 135, 492, 136, 492, 137, 492,
+	138, 492, 139, 492, 141, 492, 143, 492, 152, 492, 159, 492, 164, 492, 165,
+	492, 169, 492, 170, 492, 173, 492, 177, 492, 181, 492, 190, 492, 193, 492,
+	221, 492, 238, 492, 244, 492, 255, 492, 262, 492, 263, 492, 273, 492, 275,
+	492, 277, 492, 281, 492, 282, 492, 286, 492, 294, 492, 299, 492, 301, 492,
+	307, 492, 309, 492, 317, 492, 319, 492, 323, 492, 333, 492, 335, 492, 339,
+	492, 364, 492, 367, 492, -1, -2, 489, -1, 5, 533, 32, 533, 34, 533, 39, 533,
+	51, 533, 60, 533, 63, 533, 69, 533, 72, 533, 77, 533, 78, 533, 81, 533, 82,
+	533, 83, 533, 88, 533, 89, 533, 90, 533, 120, 533, 121, 533, 122, 533, 126,
+	533, 127, 533, 128, 533, 131, 533, 132, 533, 134, 533, 135, 533, 136, 533,
+	137, 533, 138, 533, 139, 533, 141, 533, 143, 533, 152, 533, 159, 533, 164,
+	533, 165, 533, 169, 533, 170, 533, 173, 533, 177, 533, 181, 533, 190, 533,
+	193, 533, 221, 533, 238, 533, 244, 533, 255, 533, 262, 533, 263, 533, 273,
+	533, 275, 533, 277, 533, 281, 533, 282, 533, 286, 533, 294, 533, 299, 533,
+	301, 533, 307, 533, 309, 533, 317, 533, 319, 533, 323, 533, 333, 533, 335,
+	533, 339, 533, 364, 533, 367, 533, -1, -2, 489, -1, 5, 514, 32, 514, 34,
+	514, 39, 514, 51, 514, 60, 514, 63, 514, 69, 514, 72, 514, 77, 514, 78, 514,
+	81, 514, 82, 514, 83, 514, 88, 514, 89, 514, 90, 514, 120, 514, 121, 514,
+	122, 514, 126, 514, 127, 514, 128, 514, 131, 514, 132, 514, 134, 514, 135,
+	514, 136, 514, 137, 514, 138, 514, 139, 514, 141, 514, 143, 514, 152, 514,
+	159, 514, 164, 514, 165, 514, 169, 514, 170, 514, 173, 514, 177, 514, 181,
+	514, 190, 514, 193, 514, 221, 514, 238, 514, 244, 514, 255, 514, 262, 514,
+	263, 514, 273, 514, 275, 514, 277, 514, 281, 514, 282, 514, 286, 514, 294,
+	514, 299, 514, 301, 514, 307, 514, 309, 514, 317, 514, 319, 514, 323, 514,
+	333, 514, 335, 514, 339, 514, 364, 514, 367, 514, -1, -2, 489, -1, 5, 522,
+	32, 522, 34, 522, 39, 522, 51, 522, 60, 522, 63, 522, 69, 522, 72, 522, 77,
+	522, 78, 522, 81, 522, 82, 522, 83, 522, 88, 522, 89, 522, 90, 522, 120,
+	522, 121, 522, 122, 522, 126, 522, 127, 522, 128, 522, 131, 522, 132, 522,
+	134, 522, 135, 522, 136, 522, 137, 522, 138, 522, 139, 522, 141, 522, 143,
+	522, 152, 522, 159, 522, 164, 522, 165, 522, 169, 522, 170, 522, 173, 522,
+	177, 522, 181, 522, 190, 522, 193, 522, 221, 522, 238, 522, 244, 522, 255,
+	522, 262, 522, 263, 522, 273, 522, 275, 522, 277, 522, 281, 522, 282, 522,
+	286, 522, 294, 522, 299, 522, 301, 522, 307, 522, 309, 522, 317, 522, 319,
+	522, 323, 522, 333, 522, 335, 522, 339, 522, 364, 522, 367, 522, -1, -2, 7,
+	-1, 25, -1, 27, -1, 37, -1, 38, -1, 40, -1, 41, -1, 56, -1, 73, -1, 91, -1,
+	97, -1, 105, -1, 151, -1, 155, -1, 156, -1, 161, -1, 171, -1, 186, -1, 192,
+	-1, 194, -1, 202, -1, 203, -1, 205, -1, 207, -1, 208, -1, 209, -1, 210, -1,
+	211, -1, 213, -1, 215, -1, 216, -1, 217, -1, 218, -1, 219, -1, 220, -1, 226,
+	-1, 235, -1, 236, -1, 237, -1, 247, -1, 258, -1, 259, -1, 265, -1, 266, -1,
+	268, -1, 269, -1, 270, -1, 271, -1, 272, -1, 280, -1, 290, -1, 291, -1, 296,
+	-1, 297, -1, 298, -1, 300, -1, 338, -1, 345, -1, 349, -1, 352, -1, 0, 127,
+	4, 127, 5, 127, 8, 127, 9, 127, 10, 127, 64, 127, 100, 127, 102, 127, 187,
+	127, 336, 127, 337, 127, -1, -2, 489, -1, 5, 491, 32, 491, 34, 491, 39, 491,
+	51, 491, 60, 491, 63, 491, 69, 491, 72, 491, 77, 491, 78, 491, 81, 491, 82,
+	491, 83, 491, 88, 491, 89, 491, 90, 491, 120, 491, 121, 491, 122, 491, 126,
+	491, 127, 491, 128, 491, 131, 491, 132, 491, 134, 491, 135, 491, 136, 491,
+	137, 491, 138, 491, 139, 491, 141, 491, 143, 491, 152, 491, 159, 491, 164,
+	491, 165, 491, 169, 491, 170, 491, 173, 491, 177, 491, 181, 491, 190, 491,
+	193, 491, 221, 491, 238, 491, 244, 491, 255, 491, 262, 491, 263, 491, 273,
+	491, 275, 491, 277, 491, 281, 491, 282, 491, 286, 491, 294, 491, 299, 491,
+	301, 491, 307, 491, 309, 491, 317, 491, 319, 491, 323, 491, 333, 491, 335,
+	491, 339, 491, 364, 491, 367, 491, -1, -2, 489, -1, 5, 532, 32, 532, 34,
+	532, 39, 532, 51, 532, 60, 532, 63, 532, 69, 532, 72, 532, 77, 532, 78, 532,
+	81, 532, 82, 532, 83, 532, 88, 532, 89, 532, 90, 532, 120, 532, 121, 532,
+	122, 532, 126, 532, 127, 532, 128, 532, 131, 532, 132, 532, 134, 532, 135,
+	532, 136, 532, 137, 532, 138, 532, 139, 532, 141, 532, 143, 532, 152, 532,
+	159, 532, 164, 532, 165, 532, 169, 532, 170, 532, 173, 532, 177, 532, 181,
+	532, 190, 532, 193, 532, 221, 532, 238, 532, 244, 532, 255, 532, 262, 532,
+	263, 532, 273, 532, 275, 532, 277, 532, 281, 532, 282, 532, 286, 532, 294,
+	532, 299, 532, 301, 532, 307, 532, 309, 532, 317, 532, 319, 532, 323, 532,
+	333, 532, 335, 532, 339, 532, 364, 532, 367, 532, -1, -2, 489, -1, 5, 530,
+	32, 530, 34, 530, 39, 530, 51, 530, 60, 530, 63, 530, 69, 530, 72, 530, 77,
+	530, 78, 530, 81, 530, 82, 530, 83, 530, 88, 530, 89, 530, 90, 530, 120,
+	530, 121, 530, 122, 530, 126, 530, 127, 530, 128, 530, 131, 530, 132, 530,
+	134, 530, 135, 530, 136, 530, 137, 530, 138, 530, 139, 530, 141, 530, 143,
+	530, 152, 530, 159, 530, 164, 530, 165, 530, 169, 530, 170, 530, 173, 530,
+	177, 530, 181, 530, 190, 530, 193, 530, 221, 530, 238, 530, 244, 530, 255,
+	530, 262, 530, 263, 530, 273, 530, 275, 530, 277, 530, 281, 530, 282, 530,
+	286, 530, 294, 530, 299, 530, 301, 530, 307, 530, 309, 530, 317, 530, 319,
+	530, 323, 530, 333, 530, 335, 530, 339, 530, 364, 530, 367, 530, -1, -2,
+	489, -1, 5, 513, 32, 513, 34, 513, 39, 513, 51, 513, 60, 513, 63, 513, 69,
+	513, 72, 513, 77, 513, 78, 513, 81, 513, 82, 513, 83, 513, 88, 513, 89, 513,
+	90, 513, 120, 513, 121, 513, 122, 513, 126, 513, 127, 513, 128, 513, 131,
+	513, 132, 513, 134, 513, 135, 513, 136, 513, 137, 513, 138, 513, 139, 513,
+	141, 513, 143, 513, 152, 513, 159, 513, 164, 513, 165, 513, 169, 513, 170,
+	513, 173, 513, 177, 513, 181, 513, 190, 513, 193, 513, 221, 513, 238, 513,
+	244, 513, 255, 513, 262, 513, 263, 513, 273, 513, 275, 513, 277, 513, 281,
+	513, 282, 513, 286, 513, 294, 513, 299, 513, 301, 513, 307, 513, 309, 513,
+	317, 513, 319, 513, 323, 513, 333, 513, 335, 513, 339, 513, 364, 513, 367,
+	513, -1, -2, 489, -1, 5, 521, 32, 521, 34, 521, 39, 521, 51, 521, 60, 521,
+	63, 521, 69, 521, 72, 521, 77, 521, 78, 521, 81, 521, 82, 521, 83, 521, 88,
+	521, 89, 521, 90, 521, 120, 521, 121, 521, 122, 521, 126, 521, 127, 521,
+	128, 521, 131, 521, 132, 521, 134, 521, 135, 521, 136, 521, 137, 521, 138,
+	521, 139, 521, 141, 521, 143, 521, 152, 521, 159, 521, 164, 521, 165, 521,
+	169, 521, 170, 521, 173, 521, 177, 521, 181, 521, 190, 521, 193, 521, 221,
+	521, 238, 521, 244, 521, 255, 521, 262, 521, 263, 521, 273, 521, 275, 521,
+	277, 521, 281, 521, 282, 521, 286, 521, 294, 521, 299, 521, 301, 521, 307,
+	521, 309, 521, 317, 521, 319, 521, 323, 521, 333, 521, 335, 521, 339, 521,
+	364, 521, 367, 521, -1, -2, 489, -1, 5, 529, 32, 529, 34, 529, 39, 529, 51,
+	529, 60, 529, 63, 529, 69, 529, 72, 529, 77, 529, 78, 529, 81, 529, 82, 529,
+	83, 529, 88, 529, 89, 529, 90, 529, 120, 529, 121, 529, 122, 529, 126, 529,
+	127, 529, 128, 529, 131, 529, 132, 529, 134, 529, 135, 529, 136, 529, 137,
+	529, 138, 529, 139, 529, 141, 529, 143, 529, 152, 529, 159, 529, 164, 529,
+	165, 529, 169, 529, 170, 529, 173, 529, 177, 529, 181, 529, 190, 529, 193,
+	529, 221, 529, 238, 529, 244, 529, 255, 529, 262, 529, 263, 529, 273, 529,
+	275, 529, 277, 529, 281, 529, 282, 529, 286, 529, 294, 529, 299, 529, 301,
+	529, 307, 529, 309, 529, 317, 529, 319, 529, 323, 529, 333, 529, 335, 529,
+	339, 529, 364, 529, 367, 529, -1, -2, 7, -1, 25, -1, 27, -1, 37, -1, 38, -1,
+	40, -1, 41, -1, 56, -1, 73, -1, 91, -1, 97, -1, 105, -1, 151, -1, 155, -1,
+	156, -1, 161, -1, 171, -1, 186, -1, 192, -1, 194, -1, 202, -1, 203, -1, 205,
+	-1, 207, -1, 208, -1, 209, -1, 210, -1, 211, -1, 213, -1, 215, -1, 216, -1,
+	217, -1, 218, -1, 219, -1, 220, -1, 226, -1, 235, -1, 236, -1, 237, -1, 247,
+	-1, 258, -1, 259, -1, 265, -1, 266, -1, 268, -1, 269, -1, 270, -1, 271, -1,
+	272, -1, 280, -1, 290, -1, 291, -1, 296, -1, 297, -1, 298, -1, 300, -1, 338,
+	-1, 345, -1, 349, -1, 352, -1, 489, -1, 494, -1, 5, 595, 32, 595, 34, 595,
+	39, 595, 51, 595, 60, 595, 63, 595, 69, 595, 72, 595, 77, 595, 78, 595, 81,
+	595, 82, 595, 83, 595, 88, 595, 89, 595, 90, 595, 120, 595, 121, 595, 122,
+	595, 126, 595, 127, 595, 128, 595, 131, 595, 132, 595, 134, 595, 135, 595,
+	136, 595, 137, 595, 138, 595, 139, 595, 141, 595, 143, 595, 152, 595, 159,
+	595, 164, 595, 165, 595, 169, 595, 170, 595, 173, 595, 177, 595, 181, 595,
+	190, 595, 193, 595, 221, 595, 238, 595, 244, 595, 255, 595, 262, 595, 263,
+	595, 273, 595, 275, 595, 277, 595, 281, 595, 282, 595, 286, 595, 294, 595,
+	299, 595, 301, 595, 307, 595, 309, 595, 317, 595, 319, 595, 323, 595, 333,
+	595, 335, 595, 339, 595, 364, 595, 367, 595, -1, -2, 489, -1, 5, 528, 32,
+	528, 34, 528, 39, 528, 51, 528, 60, 528, 63, 528, 69, 528, 72, 528, 77, 528,
+	78, 528, 81, 528, 82, 528, 83, 528, 88, 528, 89, 528, 90, 528, 120, 528,
+	121, 528, 122, 528, 126, 528, 127, 528, 128, 528, 131, 528, 132, 528, 134,
+	528, 135, 528, 136, 528, 137, 528, 138, 528, 139, 528, 141, 528, 143, 528,
+	152, 528, 159, 528, 164, 528, 165, 528, 169, 528, 170, 528, 173, 528, 177,
+	528, 181, 528, 190, 528, 193, 528, 221, 528, 238, 528, 244, 528, 255, 528,
+	262, 528, 263, 528, 273, 528, 275, 528, 277, 528, 281, 528, 282, 528, 286,
+	528, 294, 528, 299, 528, 301, 528, 307, 528, 309, 528, 317, 528, 319, 528,
+	323, 528, 333, 528, 335, 528, 339, 528, 364, 528, 367, 528, -1, -2, 489, -1,
+	5, 594, 32, 594, 34, 594, 39, 594, 51, 594, 60, 594, 63, 594, 69, 594, 72,
+	594, 77, 594, 78, 594, 81, 594, 82, 594, 83, 594, 88, 594, 89, 594, 90, 594,
+	120, 594, 121, 594, 122, 594, 126, 594, 127, 594, 128, 594, 131, 594, 132,
+	594, 134, 594, 135, 594, 136, 594, 137, 594, 138, 594, 139, 594, 141, 594,
+	143, 594, 152, 594, 159, 594, 164, 594, 165, 594, 169, 594, 170, 594, 173,
+	594, 177, 594, 181, 594, 190, 594, 193, 594, 221, 594, 238, 594, 244, 594,
+	255, 594, 262, 594, 263, 594, 273, 594, 275, 594, 277, 594, 281, 594, 282,
+	594, 286, 594, 294, 594, 299, 594, 301, 594, 307, 594, 309, 594, 317, 594,
+	319, 594, 323, 594, 333, 594, 335, 594, 339, 594, 364, 594, 367, 594, -1,
+	-2, 489, -1, 5, 527, 32, 527, 34, 527, 39, 527, 51, 527, 60, 527, 63, 527,
+	69, 527, 72, 527, 77, 527, 78, 527, 81, 527, 82, 527, 83, 527, 88, 527, 89,
+	527, 90, 527, 120, 527, 121, 527, 122, 527, 126, 527, 127, 527, 128, 527,
+	131, 527, 132, 527, 134, 527, 135, 527, 136, 527, 137, 527, 138, 527, 139,
+	527, 141, 527, 143, 527, 152, 527, 159, 527, 164, 527, 165, 527, 169, 527,
+	170, 527, 173, 527, 177, 527, 181, 527, 190, 527, 193, 527, 221, 527, 238,
+	527, 244, 527, 255, 527, 262, 527, 263, 527, 273, 527, 275, 527, 277, 527,
+	281, 527, 282, 527, 286, 527, 294, 527, 299, 527, 301, 527, 307, 527, 309,
+	527, 317, 527, 319, 527, 323, 527, 333, 527, 335, 527, 339, 527, 364, 527,
+	367, 527, -1, -2, 489, -1, 5, 656, 6, 656, 32, 656, 34, 656, 39, 656, 51,
+	656, 60, 656, 63, 656, 69, 656, 72, 656, 77, 656, 78, 656, 81, 656, 82, 656,
+	83, 656, 88, 656, 89, 656, 90, 656, 120, 656, 121, 656, 122, 656, 126, 656,
+	127, 656, 128, 656, 131, 656, 132, 656, 134, 656, 135, 656, 136, 656, 137,
+	656, 138, 656, 139, 656, 141, 656, 143, 656, 152, 656, 159, 656, 164, 656,
+	165, 656, 169, 656, 170, 656, 173, 656, 177, 656, 181, 656, 190, 656, 193,
+	656, 221, 656, 238, 656, 244, 656, 255, 656, 262, 656, 263, 656, 273, 656,
+	275, 656, 277, 656, 281, 656, 282, 656, 286, 656, 294, 656, 299, 656, 301,
+	656, 307, 656, 309, 656, 317, 656, 319, 656, 323, 656, 333, 656, 335, 656,
+	336, 656, 339, 656, 364, 656, 367, 656, 397, 656, 400, 656, 401, 656, 403,
+	656, 404, 656, 405, 656, 406, 656, 407, 656, 408, 656, 409, 656, 410, 656,
+	411, 656, 414, 656, 415, 656, 417, 656, 418, 656, 419, 656, 420, 656, 421,
+	656, 422, 656, 423, 656, 424, 656, 425, 656, 426, 656, 427, 656, 428, 656,
+	429, 656, 430, 656, 431, 656, 432, 656, 433, 656, 434, 656, 435, 656, 436,
+	656, 437, 656, 438, 656, 440, 656, 441, 656, 442, 656, 443, 656, 444, 656,
+	445, 656, 446, 656, 447, 656, 448, 656, 449, 656, 450, 656, 451, 656, 452,
+	656, 453, 656, 454, 656, 455, 656, 458, 656, 459, 656, 460, 656, 461, 656,
+	462, 656, 463, 656, 465, 656, 466, 656, 467, 656, 469, 656, 470, 656, 476,
+	656, 477, 656, 478, 656, 479, 656, 480, 656, 481, 656, 482, 656, 484, 656,
+	485, 656, 486, 656, 487, 656, 488, 656, 497, 656, -1, -2, 489, -1, 5, 660,
+	6, 660, 32, 660, 34, 660, 39, 660, 51, 660, 60, 660, 63, 660, 69, 660, 72,
+	660, 77, 660, 78, 660, 81, 660, 82, 660, 83, 660, 88, 660, 89, 660, 90, 660,
+	120, 660, 121, 660, 122, 660, 126, 660, 127, 660, 128, 660, 131, 660, 132,
+	660, 134, 660, 135, 660, 136, 660, 137, 660, 138, 660, 139, 660, 141, 660,
+	143, 660, 152, 660, 159, 660, 164, 660, 165, 660, 169, 660, 170, 660, 173,
+	660, 177, 660, 181, 660, 190, 660, 193, 660, 221, 660, 238, 660, 244, 660,
+	255, 660, 262, 660, 263, 660, 273, 660, 275, 660, 277, 660, 281, 660, 282,
+	660, 286, 660, 294, 660, 299, 660, 301, 660, 307, 660, 309, 660, 317, 660,
+	319, 660, 323, 660, 333, 660, 335, 660, 336, 660, 339, 660, 364, 660, 367,
+	660, 397, 660, 400, 660, 401, 660, 403, 660, 404, 660, 405, 660, 406, 660,
+	407, 660, 408, 660, 409, 660, 410, 660, 411, 660, 414, 660, 415, 660, 417,
+	660, 418, 660, 419, 660, 420, 660, 421, 660, 422, 660, 423, 660, 424, 660,
+	425, 660, 426, 660, 427, 660, 428, 660, 429, 660, 430, 660, 431, 660, 432,
+	660, 433, 660, 434, 660, 435, 660, 436, 660, 437, 660, 438, 660, 440, 660,
+	441, 660, 442, 660, 443, 660, 444, 660, 445, 660, 446, 660, 447, 660, 448,
+	660, 449, 660, 450, 660, 451, 660, 452, 660, 453, 660, 454, 660, 455, 660,
+	458, 660, 459, 660, 460, 660, 461, 660, 462, 660, 463, 660, 465, 660, 466,
+	660, 467, 660, 469, 660, 470, 660, 476, 660, 477, 660, 478, 660, 479, 660,
+	480, 660, 481, 660, 482, 660, 484, 660, 485, 660, 486, 660, 487, 660, 488,
+	660, 497, 660, -1, -2, 489, -1, 5, 655, 6, 655, 32, 655, 34, 655, 39, 655,
+	51, 655, 60, 655, 63, 655, 69, 655, 72, 655, 77, 655, 78, 655, 81, 655, 82,
+	655, 83, 655, 88, 655, 89, 655, 90, 655, 120, 655, 121, 655, 122, 655, 126,
+	655, 127, 655, 128, 655, 131, 655, 132, 655, 134, 655, 135, 655, 136, 655,
+	137, 655, 138, 655, 139, 655, 141, 655, 143, 655, 152, 655, 159, 655, 164,
+	655, 165, 655, 169, 655, 170, 655, 173, 655, 177, 655, 181, 655, 190, 655,
+	193, 655, 221, 655, 238, 655, 244, 655, 255, 655, 262, 655, 263, 655, 273,
+	655, 275, 655, 277, 655, 281, 655, 282, 655, 286, 655, 294, 655, 299, 655,
+	301, 655, 307, 655, 309, 655, 317, 655, 319, 655, 323, 655, 333, 655, 335,
+	655, 336, 655, 339, 655, 364, 655, 367, 655, 397, 655, 400, 655, 401, 655,
+	403, 655, 404, 655, 405, 655, 406, 655, 407, 655, 408, 655, 409, 655, 410,
+	655, 411, 655, 414, 655, 415, 655, 417, 655, 418, 655, 419, 655, 420, 655,
+	421, 655, 422, 655, 423, 655, 424, 655, 425, 655, 426, 655, 427, 655, 428,
+	655, 429, 655, 430, 655, 431, 655, 432, 655, 433, 655, 434, 655, 435, 655,
+	436, 655, 437, 655, 438, 655, 440, 655, 441, 655, 442, 655, 443, 655, 444,
+	655, 445, 655, 446, 655, 447, 655, 448, 655, 449, 655, 450, 655, 451, 655,
+	452, 655, 453, 655, 454, 655, 455, 655, 458, 655, 459, 655, 460, 655, 461,
+	655, 462, 655, 463, 655, 465, 655, 466, 655, 467, 655, 469, 655, 470, 655,
+	476, 655, 477, 655, 478, 655, 479, 655, 480, 655, 481, 655, 482, 655, 484,
+	655, 485, 655, 486, 655, 487, 655, 488, 655, 497, 655, -1, -2, 489, -1, 5,
+	593, 32, 593, 34, 593, 39, 593, 51, 593, 60, 593, 63, 593, 69, 593, 72, 593,
+	77, 593, 78, 593, 81, 593, 82, 593, 83, 593, 88, 593, 89, 593, 90, 593, 120,
+	593, 121, 593, 122, 593, 126, 593, 127, 593, 128, 593, 131, 593, 132, 593,
+	134, 593, 135, 593, 136, 593, 137, 593, 138, 593, 139, 593, 141, 593, 143,
+	593, 152, 593, 159, 593, 164, 593, 165, 593, 169, 593, 170, 593, 173, 593,
+	177, 593, 181, 593, 190, 593, 193, 593, 221, 593, 238, 593, 244, 593, 255,
+	593, 262, 593, 263, 593, 273, 593, 275, 593, 277, 593, 281, 593, 282, 593,
+	286, 593, 294, 593, 299, 593, 301, 593, 307, 593, 309, 593, 317, 593, 319,
+	593, 323, 593, 333, 593, 335, 593, 339, 593, 364, 593, 367, 593, -1, -2,
+	489, -1, 5, 659, 6, 659, 32, 659, 34, 659, 39, 659, 51, 659, 60, 659, 63,
+	659, 69, 659, 72, 659, 77, 659, 78, 659, 81, 659, 82, 659, 83, 659, 88, 659,
+	89, 659, 90, 659, 120, 659, 121, 659, 122, 659, 126, 659, 127, 659, 128,
+	659, 131, 659, 132, 659, 134, 659, 135, 659, 136, 659, 137, 659, 138, 659,
+	139, 659, 141, 659, 143, 659, 152, 659, 159, 659, 164, 659, 165, 659, 169,
+	659, 170, 659, 173, 659, 177, 659, 181, 659, 190, 659, 193, 659, 221, 659,
+	238, 659, 244, 659, 255, 659, 262, 659, 263, 659, 273, 659, 275, 659, 277,
+	659, 281, 659, 282, 659, 286, 659, 294, 659, 299, 659, 301, 659, 307, 659,
+	309, 659, 317, 659, 319, 659, 323, 659, 333, 659, 335, 659, 336, 659, 339,
+	659, 364, 659, 367, 659, 397, 659, 400, 659, 401, 659, 403, 659, 404, 659,
+	405, 659, 406, 659, 407, 659, 408, 659, 409, 659, 410, 659, 411, 659, 414,
+	659, 415, 659, 417, 659, 418, 659, 419, 659, 420, 659, 421, 659, 422, 659,
+	423, 659, 424, 659, 425, 659, 426, 659, 427, 659, 428, 659, 429, 659, 430,
+	659, 431, 659, 432, 659, 433, 659, 434, 659, 435, 659, 436, 659, 437, 659,
+	438, 659, 440, 659, 441, 659, 442, 659, 443, 659, 444, 659, 445, 659, 446,
+	659, 447, 659, 448, 659, 449, 659, 450, 659, 451, 659, 452, 659, 453, 659,
+	454, 659, 455, 659, 458, 659, 459, 659, 460, 659, 461, 659, 462, 659, 463,
+	659, 465, 659, 466, 659, 467, 659, 469, 659, 470, 659, 476, 659, 477, 659,
+	478, 659, 479, 659, 480, 659, 481, 659, 482, 659, 484, 659, 485, 659, 486,
+	659, 487, 659, 488, 659, 497, 659, -1, -2, 489, -1, 5, 592, 32, 592, 34,
+	592, 39, 592, 51, 592, 60, 592, 63, 592, 69, 592, 72, 592, 77, 592, 78, 592,
+	81, 592, 82, 592, 83, 592, 88, 592, 89, 592, 90, 592, 120, 592, 121, 592,
+	122, 592, 126, 592, 127, 592, 128, 592, 131, 592, 132, 592, 134, 592, 135,
+	592, 136, 592, 137, 592, 138, 592, 139, 592, 141, 592, 143, 592, 152, 592,
+	159, 592, 164, 592, 165, 592, 169, 592, 170, 592, 173, 592, 177, 592, 181,
+	592, 190, 592, 193, 592, 221, 592, 238, 592, 244, 592, 255, 592, 262, 592,
+	263, 592, 273, 592, 275, 592, 277, 592, 281, 592, 282, 592, 286, 592, 294,
+	592, 299, 592, 301, 592, 307, 592, 309, 592, 317, 592, 319, 592, 323, 592,
+	333, 592, 335, 592, 339, 592, 364, 592, 367, 592, -1, -2, 489, -1, 5, 654,
+	6, 654, 32, 654, 34, 654, 39, 654, 51, 654, 60, 654, 63, 654, 69, 654, 72,
+	654, 77, 654, 78, 654, 81, 654, 82, 654, 83, 654, 88, 654, 89, 654, 90, 654,
+	120, 654, 121, 654, 122, 654, 126, 654, 127, 654, 128, 654, 131, 654, 132,
+	654, 134, 654, 135, 654, 136, 654, 137, 654, 138, 654, 139, 654, 141, 654,
+	143, 654, 152, 654, 159, 654, 164, 654, 165, 654, 169, 654, 170, 654, 173,
+	654, 177, 654, 181, 654, 190, 654, 193, 654, 221, 654, 238, 654, 244, 654,
+	255, 654, 262, 654, 263, 654, 273, 654, 275, 654, 277, 654, 281, 654, 282,
+	654, 286, 654, 294, 654, 299, 654, 301, 654, 307, 654, 309, 654, 317, 654,
+	319, 654, 323, 654, 333, 654, 335, 654, 336, 654, 339, 654, 364, 654, 367,
+	654, 397, 654, 400, 654, 401, 654, 403, 654, 404, 654, 405, 654, 406, 654,
+	407, 654, 408, 654, 409, 654, 410, 654, 411, 654, 414, 654, 415, 654, 417,
+	654, 418, 654, 419, 654, 420, 654, 421, 654, 422, 654, 423, 654, 424, 654,
+	425, 654, 426, 654, 427, 654, 428, 654, 429, 654, 430, 654, 431, 654, 432,
+	654, 433, 654, 434, 654, 435, 654, 436, 654, 437, 654, 438, 654, 440, 654,
+	441, 654, 442, 654, 443, 654, 444, 654, 445, 654, 446, 654, 447, 654, 448,
+	654, 449, 654, 450, 654, 451, 654, 452, 654, 453, 654, 454, 654, 455, 654,
+	458, 654, 459, 654, 460, 654, 461, 654, 462, 654, 463, 654, 465, 654, 466,
+	654, 467, 654, 469, 654, 470, 654, 476, 654, 477, 654, 478, 654, 479, 654,
+	480, 654, 481, 654, 482, 654, 484, 654, 485, 654, 486, 654, 487, 654, 488,
+	654, 497, 654, -1, -2, 489, -1, 5, 658, 6, 658, 32, 658, 34, 658, 39, 658,
+	51, 658, 60, 658, 63, 658, 69, 658, 72, 658, 77, 658, 78, 658, 81, 658, 82,
+	658, 83, 658, 88, 658, 89, 658, 90, 658, 120, 658, 121, 658, 122, 658, 126,
+	658, 127, 658, 128, 658, 131, 658, 132, 658, 134, 658, 135, 658, 136, 658,
+	137, 658, 138, 658, 139, 658, 141, 658, 143, 658, 152, 658, 159, 658, 164,
+	658, 165, 658, 169, 658, 170, 658, 173, 658, 177, 658, 181, 658, 190, 658,
+	193, 658, 221, 658, 238, 658, 244, 658, 255, 658, 262, 658, 263, 658, 273,
+	658, 275, 658, 277, 658, 281, 658, 282, 658, 286, 658, 294, 658, 299, 658,
+	301, 658, 307, 658, 309, 658, 317, 658, 319, 658, 323, 658, 333, 658, 335,
+	658, 336, 658, 339, 658, 364, 658, 367, 658, 397, 658, 400, 658, 401, 658,
+	403, 658, 404, 658, 405, 658, 406, 658, 407, 658, 408, 658, 409, 658, 410,
+	658, 411, 658, 414, 658, 415, 658, 417, 658, 418, 658, 419, 658, 420, 658,
+	421, 658, 422, 658, 423, 658, 424, 658, 425, 658, 426, 658, 427, 658, 428,
+	658, 429, 658, 430, 658, 431, 658, 432, 658, 433, 658, 434, 658, 435, 658,
+	436, 658, 437, 658, 438, 658, 440, 658, 441, 658, 442, 658, 443, 658, 444,
+	658, 445, 658, 446, 658, 447, 658, 448, 658, 449, 658, 450, 658, 451, 658,
+	452, 658, 453, 658, 454, 658, 455, 658, 458, 658, 459, 658, 460, 658, 461,
+	658, 462, 658, 463, 658, 465, 658, 466, 658, 467, 658, 469, 658, 470, 658,
+	476, 658, 477, 658, 478, 658, 479, 658, 480, 658, 481, 658, 482, 658, 484,
+	658, 485, 658, 486, 658, 487, 658, 488, 658, 497, 658, -1, -2, 489, -1, 5,
+	653, 6, 653, 32, 653, 34, 653, 39, 653, 51, 653, 60, 653, 63, 653, 69, 653,
+	72, 653, 77, 653, 78, 653, 81, 653, 82, 653, 83, 653, 88, 653, 89, 653, 90,
+	653, 120, 653, 121, 653, 122, 653, 126, 653, 127, 653, 128, 653, 131, 653,
+	132, 653, 134, 653, 135, 653, 136, 653, 137, 653, 138, 653, 139, 653, 141,
+	653, 143, 653, 152, 653, 159, 653, 164, 653, 165, 653, 169, 653, 170, 653,
+	173, 653, 177, 653, 181, 653, 190, 653, 193, 653, 221, 653, 238, 653, 244,
+	653, 255, 653, 262, 653, 263, 653, 273, 653, 275, 653, 277, 653, 281, 653,
+	282, 653, 286, 653, 294, 653, 299, 653, 301, 653, 307, 653, 309, 653, 317,
+	653, 319, 653, 323, 653, 333, 653, 335, 653, 336, 653, 339, 653, 364, 653,
+	367, 653, 397, 653, 400, 653, 401, 653, 403, 653, 404, 653, 405, 653, 406,
+	653, 407, 653, 408, 653, 409, 653, 410, 653, 411, 653, 414, 653, 415, 653,
+	417, 653, 418, 653, 419, 653, 420, 653, 421, 653, 422, 653, 423, 653, 424,
+	653, 425, 653, 426, 653, 427, 653, 428, 653, 429, 653, 430, 653, 431, 653,
+	432, 653, 433, 653, 434, 653, 435, 653, 436, 653, 437, 653, 438, 653, 440,
+	653, 441, 653, 442, 653, 443, 653, 444, 653, 445, 653, 446, 653, 447, 653,
+	448, 653, 449, 653, 450, 653, 451, 653, 452, 653, 453, 653, 454, 653, 455,
+	653, 458, 653, 459, 653, 460, 653, 461, 653, 462, 653, 463, 653, 465, 653,
+	466, 653, 467, 653, 469, 653, 470, 653, 476, 653, 477, 653, 478, 653, 479,
+	653, 480, 653, 481, 653, 482, 653, 484, 653, 485, 653, 486, 653, 487, 653,
+	488, 653, 497, 653, -1, -2, 489, -1, 5, 657, 6, 657, 32, 657, 34, 657, 39,
+	657, 51, 657, 60, 657, 63, 657, 69, 657, 72, 657, 77, 657, 78, 657, 81, 657,
+	82, 657, 83, 657, 88, 657, 89, 657, 90, 657, 120, 657, 121, 657, 122, 657,
+	126, 657, 127, 657, 128, 657, 131, 657, 132, 657, 134, 657, 135, 657, 136,
+	657, 137, 657, 138, 657, 139, 657, 141, 657, 143, 657, 152, 657, 159, 657,
+	164, 657, 165, 657, 169, 657, 170, 657, 173, 657, 177, 657, 181, 657, 190,
+	657, 193, 657, 221, 657, 238, 657, 244, 657, 255, 657, 262, 657, 263, 657,
+	273, 657, 275, 657, 277, 657, 281, 657, 282, 657, 286, 657, 294, 657, 299,
+	657, 301, 657, 307, 657, 309, 657, 317, 657, 319, 657, 323, 657, 333, 657,
+	335, 657, 336, 657, 339, 657, 364, 657, 367, 657, 397, 657, 400, 657, 401,
+	657, 403, 657, 404, 657, 405, 657, 406, 657, 407, 657, 408, 657, 409, 657,
+	410, 657, 411, 657, 414, 657, 415, 657, 417, 657, 418, 657, 419, 657, 420,
+	657, 421, 657, 422, 657, 423, 657, 424, 657, 425, 657, 426, 657, 427, 657,
+	428, 657, 429, 657, 430, 657, 431, 657, 432, 657, 433, 657, 434, 657, 435,
+	657, 436, 657, 437, 657, 438, 657, 440, 657, 441, 657, 442, 657, 443, 657,
+	444, 657, 445, 657, 446, 657, 447, 657, 448, 657, 449, 657, 450, 657, 451,
+	657, 452, 657, 453, 657, 454, 657, 455, 657, 458, 657, 459, 657, 460, 657,
+	461, 657, 462, 657, 463, 657, 465, 657, 466, 657, 467, 657, 469, 657, 470,
+	657, 476, 657, 477, 657, 478, 657, 479, 657, 480, 657, 481, 657, 482, 657,
+	484, 657, 485, 657, 486, 657, 487, 657, 488, 657, 497, 657, -1, -2,
+}
+
+var tmGoto = []int32{
+	0, 2, 2, 2, 2, 84, 698, 702, 734, 738, 776, 870, 872, 878, 882, 886, 890,
+	892, 894, 896, 898, 902, 904, 906, 1088, 1154, 1260, 1794, 1824, 1836, 1848,
+	1860, 1872, 1944, 2086, 2160, 2180, 2184, 2248, 2284, 2288, 2318, 2348,
+	2360, 2372, 2384, 2396, 2408, 2420, 2432, 2444, 2456, 2528, 2530, 2542,
+	2548, 2568, 2598, 2610, 2622, 2634, 2704, 2768, 2772, 2776, 2778, 2784,
+	2796, 2808, 3340, 3414, 3480, 3480, 3482, 3512, 3516, 3520, 3586, 3588,
+	3592, 3594, 3596, 3600, 3602, 3606, 3618, 3630, 3642, 3644, 3648, 3650,
+	3654, 3684, 3696, 3706, 3712, 3716, 3736, 3766, 3778, 3780, 3782, 3792,
+	3794, 3810, 3826, 3856, 3858, 3868, 3878, 4410, 4422, 4488, 4500, 4504,
+	4508, 4524, 4526, 4532, 4538, 4542, 4612, 4682, 4754, 4846, 4866, 4878,
+	4948, 5018, 5020, 5022, 5554, 5624, 5694, 6226, 6296, 6366, 6436, 6506,
+	6510, 6580, 6584, 6656, 6662, 6736, 6748, 6752, 7284, 7284, 7296, 7308,
+	7318, 7348, 7418, 7422, 7426, 7456, 7486, 7492, 7496, 7498, 7500, 7530,
+	7532, 7548, 7618, 7688, 7700, 7702, 7708, 7782, 7786, 7816, 8386, 8390,
+	8392, 8398, 8404, 8408, 8418, 8420, 8422, 8492, 8494, 8494, 9026, 9028,
+	9058, 9060, 9072, 9084, 9154, 9154, 9184, 9188, 9218, 9220, 9224, 9228,
+	9248, 9268, 9334, 9350, 9380, 9410, 9414, 9444, 9446, 9476, 9510, 9540,
+	9570, 9600, 9670, 9700, 9716, 9746, 9776, 9806, 9836, 9866, 9896, 9900,
+	9916, 9932, 9952, 10100, 10130, 10146, 10150, 10154, 10158, 10162, 10166,
+	10170, 10172, 10202, 10232, 10262, 10334, 10338, 10338, 10338, 10348, 10354,
+	10358, 10424, 10956, 10990, 10996, 11008, 11020, 11026, 11032, 11042, 11574,
+	11644, 11656, 11668, 11702, 11736, 11756, 11768, 11770, 11772, 11776, 11806,
+	11836, 11838, 11868, 11898, 11928, 11958, 11988, 12058, 12066, 12136, 12148,
+	12218, 12222, 12226, 12256, 12326, 12396, 12398, 12414, 12414, 12484, 12488,
+	12492, 12494, 12524, 12554, 12566, 12578, 12648, 12652, 12682, 12712, 12742,
+	12744, 12774, 12846, 12850, 12862, 12868, 12872, 12884, 12886, 12896, 12900,
+	12912, 12914, 12918, 12984, 13516, 13518, 13610, 13680, 13682, 13752, 13756,
+	13764, 13772, 13842, 13850, 13858, 13860, 13862, 13928, 13932, 13942, 13946,
+	13958, 13960, 13970, 14040, 14044, 14046, 14076, 14080, 14080, 14080, 14612,
+	14624, 14626, 14656, 14664, 14670, 14682, 14712, 14724, 14730, 14764, 14776,
+	14788, 15320, 15332, 15864, 15876, 15888, 15900, 15912, 15920, 15922, 15994,
+	16010, 16076, 16146, 16232, 16322, 16412, 16502, 16592, 16682, 16772, 16866,
+	16956, 17046, 17136, 17226, 17316, 17406, 17496, 17586, 17676, 17766, 17856,
+	17946, 18036, 18126, 18216, 18306, 18396, 18486, 18576, 18666, 18756, 18784,
+	18788, 18808, 18816, 18824, 18828, 18836, 18848, 18856, 18864, 18872, 18884,
+	18892, 18900, 18908, 18912, 18912, 18920, 18936, 18944, 18952, 18964, 18972,
+	18980, 18992, 19000, 19012, 19020, 19028, 19036, 19044, 19052, 19112, 19120,
+	19152, 19160, 19168, 19176, 19184, 19192, 19200, 19208, 19212, 19224, 19232,
+	19244, 19256, 19264, 19272, 19332, 19344, 19352, 19360, 19432, 19440, 19448,
+	19460, 19468, 19476, 19480, 19484, 19492, 19500, 19508, 19516, 19572, 19580,
+	19584, 19592, 19612, 19620, 19624, 19632, 19640, 19644, 19648, 19652, 19656,
+	19660, 19692, 19708, 19716, 19724, 19760, 19768, 19776, 19780, 19796, 19804,
+	19812, 19820, 19828, 20444, 20536, 20554, 20862, 21056, 21678, 21704, 22320,
+	22350, 22352, 22950, 22978, 22992, 22996, 23078, 23692, 23696, 23728, 23732,
+	23770, 23864, 23952, 24038, 24134, 24200, 24306, 24454, 24456, 24458, 24460,
+	24462, 24464, 24466, 24468, 24470, 24472, 24474, 24476, 24478, 24486, 24488,
+	24492, 24666, 24668, 24672, 24676, 24678, 24682, 24686, 24690, 24692, 24696,
+	24698, 24704, 24708, 24720, 24726, 24732, 24738, 24744, 24750, 24752, 24754,
+	24756, 24758, 24766, 24768, 24770, 24772, 24776, 24778, 24780, 24784, 24788,
+	24790, 25322, 25854, 26386, 26918, 27450, 27984, 28516, 29048, 29580, 30112,
+	30644, 31214, 31746, 32278, 32810, 33342, 33348, 33350, 33882, 33944, 34006,
+	34008, 34010, 34012, 34014, 34080, 34146, 34212, 34278, 34344, 34414, 34480,
+	34488, 34554, 34558, 34624, 34690, 34756, 34822, 34888, 34954, 35020, 35086,
+	35152, 35218, 35234, 35300, 35366, 35432, 35498, 35564, 35630, 35696, 35762,
+	35828, 35894, 35960, 36026, 36092, 36158, 36224, 36290, 36356, 36422, 36488,
+	36554, 36620, 36624, 36690, 36760, 36762, 36764, 36766, 36832, 36898, 36964,
+	37030, 37096, 37162, 37228, 37294, 37360, 37426, 37496, 37566, 37636, 37702,
+	37768, 37834, 37838, 37840, 37842, 37844, 37848, 37852, 37872, 37876, 37880,
+	37884, 37888, 37892, 37896, 37900, 37904, 37908, 37912, 37916, 37920, 37924,
+	37928, 37932, 37936, 37940, 37944, 37948, 37952, 37956, 37960, 37964, 37968,
+	37972, 37974, 37976, 37980, 37982, 37984, 37988, 37990, 37994, 37996, 38000,
+	38002, 38006, 38010, 38014, 38018, 38022, 38026, 38030, 38034, 38038, 38042,
+	38046, 38050, 38054, 38058, 38062, 38064, 38068, 38072, 38076, 38080, 38084,
+	38090, 38094, 38098, 38100, 38104, 38106, 38108, 38110, 38114, 38118, 38122,
+	38126, 38128, 38130, 38134, 38136, 38138, 38140, 38142, 38144, 38146, 38148,
+	38156, 38162, 38166, 38170, 38172, 38176, 38178, 38180, 38182, 38184, 38188,
+	38192, 38282, 38364, 38450, 38536, 38572, 38574, 38660, 38664, 38668, 38758,
+	38848, 38850, 38852, 38856, 38946, 38948, 38950, 38954, 39044, 39046, 39048,
+	39052, 39142, 39144, 39146, 39150, 39240, 39242, 39244, 39248, 39338, 39340,
+	39342, 39346, 39436, 39438, 39440, 39444, 39538, 39540, 39542, 39546, 39636,
+	39638, 39640, 39644, 39734, 39736, 39738, 39742, 39832, 39834, 39836, 39840,
+	39930, 39932, 39934, 39938, 40028, 40030, 40032, 40036, 40126, 40128, 40130,
+	40134, 40224, 40226, 40228, 40232, 40322, 40324, 40326, 40330, 40420, 40422,
+	40424, 40428, 40518, 40520, 40522, 40526, 40616, 40618, 40620, 40624, 40714,
+	40716, 40718, 40722, 40812, 40814, 40816, 40820, 40910, 40912, 40914, 40918,
+	41008, 41010, 41012, 41016, 41106, 41108, 41110, 41114, 41204, 41206, 41208,
+	41212, 41302, 41304, 41306, 41310, 41400, 41402, 41404, 41408, 41498, 41500,
+	41502, 41506, 41530, 41534, 41554, 41558, 41562, 41566, 41574, 41578, 41582,
+	41586, 41594, 41598, 41602, 41606, 41610, 41622, 41626, 41630, 41634, 41642,
+	41646, 41650, 41654, 41662, 41666, 41674, 41678, 41682, 41686, 41690, 41694,
+	41750, 41754, 41778, 41782, 41786, 41790, 41794, 41798, 41802, 41806, 41810,
+	41814, 41822, 41826, 41834, 41842, 41846, 41850, 41854, 41910, 41918, 41922,
+	41926, 41994, 41998, 42002, 42010, 42014, 42018, 42022, 42026, 42030, 42034,
+	42038, 42042, 42094, 42098, 42102, 42106, 42122, 42126, 42130, 42134, 42138,
+	42142, 42146, 42150, 42154, 42158, 42186, 42198, 42202, 42206, 42230, 42238,
+	42242, 42246, 42250, 42254, 42258, 42262, 42266, 42270, 42274, 42278, 42288,
+	42290, 42292, 42294, 42298, 42300, 42302, 42306, 42312, 42314, 42316, 42320,
+	42322, 42326, 42328, 42330, 42332, 42334, 42476, 42516, 42546, 42580, 42610,
+	42640, 42646, 42652, 42660, 42664, 42668, 42680, 42714, 42748, 42752, 42764,
+	42776, 42788, 42796, 42812, 42816, 42826, 42836, 42852, 42858, 42862, 42882,
+	42886, 42916, 42946, 42950, 42954, 42958, 42996, 43002, 43008, 43016, 43032,
+	43040, 43048, 43058, 43062, 43066, 43070, 43080, 43114, 43126, 43130, 43142,
+	43154, 43162, 43172, 43176, 43178, 43340, 43570, 43580, 43584, 43588, 43598,
+	43610, 43640, 43670, 43682, 43692, 43702, 43706, 43716, 43848, 43852, 43854,
+	43866, 43868, 43870, 43872, 43874, 43876, 43892, 43896, 43898, 43902, 43904,
+	43906, 43918, 43928, 43930, 43934, 43936, 43944,
+}
+
+var tmFromTo = []int16{
+	2458, 2459, 4, 12, 22, 12, 86, 12, 148, 12, 170, 12, 368, 12, 436, 12, 1611,
+	12, 1732, 12, 1737, 12, 1740, 12, 1778, 12, 1802, 12, 1803, 12, 1879, 12,
+	1954, 12, 1966, 12, 1976, 12, 1978, 12, 2023, 12, 2027, 12, 2042, 12, 2044,
+	12, 2047, 12, 2053, 12, 2054, 12, 2056, 12, 2065, 12, 2066, 12, 2068, 12,
+	2073, 12, 2076, 12, 2082, 12, 2085, 12, 2120, 12, 2121, 12, 2139, 12, 2156,
+	12, 2265, 12, 2296, 12, 2343, 12, 4, 13, 21, 13, 82, 13, 86, 13, 133, 13,
+	135, 13, 193, 13, 194, 13, 195, 13, 262, 13, 266, 13, 343, 13, 344, 13, 349,
+	13, 359, 13, 360, 13, 367, 13, 371, 13, 372, 13, 373, 13, 391, 13, 392, 13,
+	393, 13, 394, 13, 395, 13, 396, 13, 397, 13, 398, 13, 399, 13, 414, 13, 415,
+	13, 416, 13, 420, 13, 421, 13, 423, 13, 424, 13, 426, 13, 427, 13, 428, 13,
+	430, 13, 432, 13, 433, 13, 434, 13, 435, 13, 442, 13, 489, 13, 629, 13, 630,
+	13, 634, 13, 638, 13, 647, 13, 657, 13, 658, 13, 662, 13, 663, 13, 666, 13,
+	669, 13, 673, 13, 675, 13, 681, 13, 784, 13, 785, 13, 787, 13, 799, 13, 801,
+	13, 802, 13, 805, 13, 809, 13, 838, 13, 839, 13, 840, 13, 841, 13, 842, 13,
+	843, 13, 844, 13, 847, 13, 849, 13, 850, 13, 876, 13, 925, 13, 941, 13, 942,
+	13, 948, 13, 994, 13, 1013, 13, 1056, 13, 1057, 13, 1058, 13, 1070, 13,
+	1071, 13, 1075, 13, 1076, 13, 1104, 13, 1105, 13, 1106, 13, 1107, 13, 1116,
+	13, 1130, 13, 1162, 13, 1166, 13, 1169, 13, 1215, 13, 1216, 13, 1221, 13,
+	1222, 13, 1223, 13, 1225, 13, 1227, 13, 1228, 13, 1230, 13, 1231, 13, 1232,
+	13, 1233, 13, 1234, 13, 1235, 13, 1238, 13, 1239, 13, 1240, 13, 1243, 13,
+	1244, 13, 1246, 13, 1247, 13, 1249, 13, 1250, 13, 1251, 13, 1253, 13, 1255,
+	13, 1256, 13, 1257, 13, 1258, 13, 1273, 13, 1452, 13, 1455, 13, 1457, 13,
+	1462, 13, 1464, 13, 1467, 13, 1476, 13, 1477, 13, 1484, 13, 1485, 13, 1486,
+	13, 1487, 13, 1488, 13, 1493, 13, 1494, 13, 1495, 13, 1496, 13, 1498, 13,
+	1504, 13, 1506, 13, 1507, 13, 1508, 13, 1511, 13, 1513, 13, 1514, 13, 1515,
+	13, 1518, 13, 1520, 13, 1522, 13, 1524, 13, 1525, 13, 1526, 13, 1527, 13,
+	1603, 13, 1604, 13, 1619, 13, 1620, 13, 1623, 13, 1624, 13, 1625, 13, 1626,
+	13, 1629, 13, 1639, 13, 1648, 13, 1649, 13, 1653, 13, 1654, 13, 1657, 13,
+	1660, 13, 1664, 13, 1666, 13, 1721, 13, 1727, 13, 1732, 13, 1733, 13, 1736,
+	13, 1737, 13, 1738, 13, 1739, 13, 1740, 13, 1746, 13, 1748, 13, 1752, 13,
+	1753, 13, 1759, 13, 1760, 13, 1761, 13, 1762, 13, 1771, 13, 1772, 13, 1773,
+	13, 1775, 13, 1778, 13, 1780, 13, 1781, 13, 1783, 13, 1788, 13, 1789, 13,
+	1792, 13, 1835, 13, 1843, 13, 1846, 13, 1872, 13, 1875, 13, 1878, 13, 1879,
+	13, 1894, 13, 1895, 13, 1907, 13, 1908, 13, 1921, 13, 1933, 13, 1934, 13,
+	1935, 13, 1936, 13, 1943, 13, 1944, 13, 1945, 13, 1950, 13, 1954, 13, 1956,
+	13, 1962, 13, 1964, 13, 1965, 13, 1966, 13, 1967, 13, 1971, 13, 1973, 13,
+	1976, 13, 1977, 13, 1978, 13, 1979, 13, 1985, 13, 2016, 13, 2018, 13, 2023,
+	13, 2027, 13, 2031, 13, 2032, 13, 2035, 13, 2042, 13, 2044, 13, 2047, 13,
+	2053, 13, 2054, 13, 2055, 13, 2056, 13, 2061, 13, 2064, 13, 2065, 13, 2066,
+	13, 2068, 13, 2073, 13, 2074, 13, 2076, 13, 2081, 13, 2082, 13, 2085, 13,
+	2093, 13, 2094, 13, 2113, 13, 2117, 13, 2120, 13, 2121, 13, 2122, 13, 2135,
+	13, 2139, 13, 2153, 13, 2156, 13, 2161, 13, 2162, 13, 2175, 13, 2178, 13,
+	2196, 13, 2197, 13, 2200, 13, 2201, 13, 2202, 13, 2213, 13, 2240, 13, 2243,
+	13, 2250, 13, 2252, 13, 2273, 13, 2279, 13, 2281, 13, 2285, 13, 2296, 13,
+	2311, 13, 2343, 13, 2345, 13, 2355, 13, 2362, 13, 2363, 13, 2364, 13, 2427,
+	13, 334, 490, 567, 490, 17, 45, 460, 45, 2301, 45, 2330, 45, 2333, 45, 2334,
+	45, 2336, 45, 2356, 45, 2357, 45, 2383, 45, 2384, 45, 2385, 45, 2391, 45,
+	2393, 45, 2404, 45, 2424, 45, 4, 14, 2397, 14, 4, 15, 47, 15, 115, 15, 1794,
+	15, 1975, 15, 2040, 15, 2113, 15, 2168, 15, 2236, 15, 2247, 15, 2267, 15,
+	2269, 15, 2281, 15, 2332, 15, 2339, 15, 2341, 15, 2350, 15, 2352, 15, 2420,
+	15, 4, 16, 112, 16, 457, 16, 489, 16, 784, 16, 785, 16, 787, 16, 799, 16,
+	801, 16, 802, 16, 805, 16, 809, 16, 838, 16, 839, 16, 840, 16, 841, 16, 842,
+	16, 843, 16, 844, 16, 847, 16, 849, 16, 850, 16, 876, 16, 925, 16, 941, 16,
+	942, 16, 948, 16, 994, 16, 1013, 16, 1056, 16, 1057, 16, 1058, 16, 1070, 16,
+	1071, 16, 1075, 16, 1076, 16, 1104, 16, 1105, 16, 1106, 16, 1107, 16, 1116,
+	16, 1130, 16, 1267, 16, 1452, 16, 1457, 16, 2122, 16, 2311, 16, 774, 1287,
+	467, 901, 770, 901, 1350, 901, 771, 1280, 1686, 1280, 1073, 1419, 1709,
+	1419, 804, 1308, 848, 1308, 1115, 1439, 910, 1353, 1078, 1428, 1004, 1388,
+	467, 902, 1350, 902, 798, 1300, 806, 1312, 81, 126, 83, 126, 86, 138, 342,
+	126, 346, 126, 436, 138, 444, 126, 467, 126, 589, 126, 769, 126, 770, 126,
+	771, 126, 773, 126, 774, 126, 786, 138, 797, 126, 798, 126, 804, 126, 806,
+	126, 810, 126, 846, 126, 847, 138, 848, 126, 875, 126, 894, 138, 966, 138,
+	974, 126, 981, 126, 1004, 126, 1043, 126, 1072, 138, 1073, 126, 1074, 138,
+	1077, 126, 1078, 126, 1104, 138, 1105, 138, 1106, 138, 1107, 138, 1115, 126,
+	1172, 126, 1262, 126, 1264, 126, 1269, 126, 1270, 126, 1271, 126, 1272, 126,
+	1274, 126, 1350, 126, 1617, 126, 1621, 126, 1622, 126, 1636, 126, 1686, 126,
+	1709, 126, 1732, 138, 1737, 138, 1740, 138, 1778, 138, 1864, 126, 1867, 126,
+	1879, 138, 1909, 126, 1954, 138, 1966, 138, 1976, 138, 1978, 138, 2023, 138,
+	2027, 138, 2040, 126, 2042, 138, 2044, 138, 2047, 138, 2053, 138, 2054, 138,
+	2056, 138, 2065, 138, 2066, 138, 2068, 138, 2073, 138, 2076, 138, 2082, 138,
+	2085, 138, 2120, 138, 2121, 138, 2139, 138, 2156, 138, 2265, 138, 2296, 138,
+	2343, 138, 2358, 126, 86, 139, 436, 139, 1732, 139, 1737, 139, 1740, 139,
+	1778, 139, 1879, 139, 1954, 139, 1966, 139, 1976, 139, 1978, 139, 2023, 139,
+	2027, 139, 2042, 139, 2044, 139, 2047, 139, 2053, 139, 2054, 139, 2056, 139,
+	2065, 139, 2066, 139, 2068, 139, 2073, 139, 2076, 139, 2082, 139, 2085, 139,
+	2120, 139, 2121, 139, 2139, 139, 2156, 139, 2265, 139, 2296, 139, 2343, 139,
+	9, 41, 43, 41, 44, 41, 66, 41, 147, 41, 460, 41, 629, 41, 772, 41, 800, 41,
+	807, 41, 811, 41, 812, 41, 814, 41, 845, 41, 909, 41, 911, 41, 912, 41, 913,
+	41, 924, 41, 1005, 41, 1021, 41, 1022, 41, 1023, 41, 1044, 41, 1045, 41,
+	1139, 41, 1148, 41, 1275, 41, 1632, 41, 1923, 41, 1996, 41, 2301, 41, 2330,
+	41, 2333, 41, 2334, 41, 2336, 41, 2343, 41, 2356, 41, 2357, 41, 2360, 41,
+	2361, 41, 2365, 41, 2383, 41, 2384, 41, 2385, 41, 2391, 41, 2393, 41, 2404,
+	41, 2408, 41, 2411, 41, 2424, 41, 2428, 41, 2433, 41, 21, 67, 82, 67, 133,
+	67, 135, 67, 193, 67, 194, 67, 195, 67, 266, 67, 343, 67, 344, 67, 349, 67,
+	359, 67, 360, 67, 367, 67, 371, 67, 372, 67, 373, 67, 391, 67, 392, 67, 393,
+	67, 394, 67, 395, 67, 396, 67, 397, 67, 398, 67, 399, 67, 414, 67, 415, 67,
+	416, 67, 420, 67, 421, 67, 423, 67, 424, 67, 426, 67, 427, 67, 428, 67, 430,
+	67, 432, 67, 433, 67, 434, 67, 435, 67, 442, 67, 489, 67, 630, 67, 634, 67,
+	638, 67, 647, 67, 657, 67, 658, 67, 662, 67, 663, 67, 666, 67, 669, 67, 673,
+	67, 675, 67, 681, 67, 784, 67, 785, 67, 787, 67, 799, 67, 801, 67, 802, 67,
+	805, 67, 809, 67, 838, 67, 839, 67, 840, 67, 841, 67, 842, 67, 843, 67, 844,
+	67, 847, 67, 849, 67, 850, 67, 876, 67, 925, 67, 941, 67, 942, 67, 948, 67,
+	994, 67, 1013, 67, 1056, 67, 1057, 67, 1058, 67, 1070, 67, 1071, 67, 1075,
+	67, 1076, 67, 1104, 67, 1105, 67, 1106, 67, 1107, 67, 1116, 67, 1130, 67,
+	1166, 67, 1169, 67, 1215, 67, 1216, 67, 1221, 67, 1223, 67, 1225, 67, 1227,
+	67, 1228, 67, 1230, 67, 1231, 67, 1232, 67, 1233, 67, 1234, 67, 1235, 67,
+	1238, 67, 1239, 67, 1240, 67, 1243, 67, 1244, 67, 1246, 67, 1247, 67, 1249,
+	67, 1250, 67, 1251, 67, 1253, 67, 1255, 67, 1256, 67, 1257, 67, 1258, 67,
+	1273, 67, 1452, 67, 1455, 67, 1457, 67, 1462, 67, 1464, 67, 1467, 67, 1468,
+	67, 1476, 67, 1477, 67, 1484, 67, 1485, 67, 1486, 67, 1487, 67, 1488, 67,
+	1493, 67, 1494, 67, 1495, 67, 1496, 67, 1498, 67, 1504, 67, 1506, 67, 1507,
+	67, 1508, 67, 1511, 67, 1513, 67, 1514, 67, 1515, 67, 1518, 67, 1520, 67,
+	1522, 67, 1524, 67, 1525, 67, 1526, 67, 1527, 67, 1603, 67, 1604, 67, 1619,
+	67, 1620, 67, 1623, 67, 1624, 67, 1625, 67, 1626, 67, 1629, 67, 1639, 67,
+	1648, 67, 1649, 67, 1653, 67, 1654, 67, 1657, 67, 1660, 67, 1664, 67, 1666,
+	67, 1721, 67, 1727, 67, 1746, 67, 1748, 67, 1752, 67, 1753, 67, 1759, 67,
+	1760, 67, 1761, 67, 1762, 67, 1771, 67, 1772, 67, 1773, 67, 1775, 67, 1780,
+	67, 1781, 67, 1783, 67, 1788, 67, 1789, 67, 1792, 67, 1835, 67, 1843, 67,
+	1846, 67, 1872, 67, 1875, 67, 1878, 67, 1894, 67, 1895, 67, 1907, 67, 1908,
+	67, 1921, 67, 1933, 67, 1934, 67, 1935, 67, 1936, 67, 1943, 67, 1944, 67,
+	1945, 67, 1950, 67, 1956, 67, 1962, 67, 1964, 67, 1965, 67, 1967, 67, 1971,
+	67, 1973, 67, 1977, 67, 1979, 67, 1985, 67, 2016, 67, 2018, 67, 2031, 67,
+	2032, 67, 2035, 67, 2055, 67, 2061, 67, 2064, 67, 2074, 67, 2081, 67, 2093,
+	67, 2094, 67, 2113, 67, 2117, 67, 2122, 67, 2135, 67, 2153, 67, 2161, 67,
+	2162, 67, 2175, 67, 2178, 67, 2196, 67, 2197, 67, 2200, 67, 2201, 67, 2202,
+	67, 2213, 67, 2243, 67, 2250, 67, 2252, 67, 2273, 67, 2279, 67, 2281, 67,
+	2285, 67, 2311, 67, 2345, 67, 2355, 67, 2362, 67, 2363, 67, 2364, 67, 2427,
+	67, 460, 697, 2301, 697, 2330, 697, 2333, 697, 2334, 697, 2336, 697, 2356,
+	697, 2357, 697, 2383, 697, 2384, 697, 2385, 697, 2391, 697, 2393, 697, 2404,
+	697, 2424, 697, 341, 572, 570, 572, 571, 572, 1469, 572, 1497, 572, 1982,
+	572, 341, 573, 570, 573, 571, 573, 1469, 573, 1497, 573, 1982, 573, 1751,
+	1924, 2271, 1924, 2286, 1924, 2291, 1924, 2348, 1924, 2392, 1924, 1751,
+	1925, 2271, 1925, 2286, 1925, 2291, 1925, 2348, 1925, 2392, 1925, 86, 140,
+	436, 140, 1162, 1461, 1730, 1881, 1732, 140, 1737, 140, 1740, 140, 1778,
+	140, 1800, 1461, 1879, 140, 1954, 140, 1966, 140, 1976, 140, 1978, 140,
+	2023, 140, 2027, 140, 2042, 140, 2044, 140, 2047, 140, 2053, 140, 2054, 140,
+	2056, 140, 2065, 140, 2066, 140, 2068, 140, 2073, 140, 2076, 140, 2082, 140,
+	2085, 140, 2120, 140, 2121, 140, 2139, 140, 2156, 140, 2265, 140, 2296, 140,
+	2343, 140, 76, 124, 85, 124, 129, 124, 351, 124, 449, 124, 623, 124, 624,
+	124, 628, 124, 1236, 124, 1611, 124, 1616, 124, 1627, 124, 1633, 124, 1642,
+	124, 1643, 124, 1644, 124, 1645, 124, 1652, 124, 1656, 124, 1659, 124, 1662,
+	124, 1665, 124, 1667, 124, 1670, 124, 1683, 124, 1768, 124, 1802, 124, 1803,
+	124, 1807, 124, 1808, 124, 1811, 124, 1812, 124, 1813, 124, 1861, 124, 1862,
+	124, 1899, 124, 1940, 124, 1946, 124, 1951, 124, 1955, 124, 2024, 124, 2025,
+	124, 2029, 124, 2049, 124, 2050, 124, 2051, 124, 2052, 124, 2060, 124, 2063,
+	124, 2071, 124, 2075, 124, 2078, 124, 2084, 124, 2086, 124, 2089, 124, 2091,
+	124, 2103, 124, 2107, 124, 2113, 124, 2120, 124, 2156, 124, 2189, 124, 2255,
+	124, 2263, 124, 2265, 124, 2267, 124, 2269, 124, 2296, 124, 2297, 124, 2298,
+	124, 2339, 124, 86, 141, 436, 141, 1162, 1462, 1732, 141, 1737, 141, 1740,
+	141, 1778, 141, 1800, 1462, 1879, 141, 1954, 141, 1966, 141, 1976, 141,
+	1978, 141, 2023, 141, 2027, 141, 2042, 141, 2044, 141, 2047, 141, 2053, 141,
+	2054, 141, 2056, 141, 2065, 141, 2066, 141, 2068, 141, 2073, 141, 2076, 141,
+	2082, 141, 2085, 141, 2120, 141, 2121, 141, 2139, 141, 2156, 141, 2197, 141,
+	2201, 141, 2265, 141, 2296, 141, 2343, 141, 1746, 1911, 1747, 1911, 1748,
+	1911, 1752, 1911, 1753, 1911, 1759, 1911, 1760, 1911, 1775, 1911, 1781,
+	1911, 1982, 1911, 1861, 2014, 1862, 2014, 460, 698, 629, 1172, 1166, 1172,
+	1603, 1172, 1604, 1172, 1899, 1172, 1946, 1172, 2113, 1172, 2189, 1172,
+	2236, 1172, 2247, 1172, 2269, 1172, 2301, 698, 2330, 2358, 2332, 1172, 2333,
+	698, 2334, 698, 2336, 698, 2341, 1172, 2343, 1172, 2350, 1172, 2352, 1172,
+	2356, 2358, 2357, 2358, 2383, 698, 2384, 698, 2385, 698, 2391, 698, 2393,
+	698, 2404, 698, 2420, 1172, 2424, 698, 366, 639, 460, 699, 629, 1173, 2301,
+	699, 2330, 699, 2333, 699, 2334, 699, 2336, 699, 2343, 1173, 2356, 699,
+	2357, 699, 2383, 699, 2384, 699, 2385, 699, 2391, 699, 2393, 699, 2404, 699,
+	2424, 699, 1162, 1463, 1800, 1463, 460, 700, 2301, 700, 2330, 700, 2333,
+	700, 2334, 700, 2336, 700, 2356, 700, 2357, 700, 2383, 700, 2384, 700, 2385,
+	700, 2391, 700, 2393, 700, 2404, 700, 2424, 700, 460, 701, 2301, 701, 2330,
+	701, 2333, 701, 2334, 701, 2336, 701, 2356, 701, 2357, 701, 2383, 701, 2384,
+	701, 2385, 701, 2391, 701, 2393, 701, 2404, 701, 2424, 701, 341, 574, 570,
+	574, 571, 574, 1469, 574, 1497, 574, 1982, 574, 341, 575, 570, 575, 571,
+	575, 1469, 575, 1497, 575, 1982, 575, 341, 576, 570, 576, 571, 576, 1469,
+	576, 1497, 576, 1982, 576, 341, 577, 570, 577, 571, 577, 1469, 577, 1497,
+	577, 1982, 577, 341, 578, 570, 578, 571, 578, 1469, 578, 1497, 578, 1982,
+	578, 341, 579, 570, 579, 571, 579, 1469, 579, 1497, 579, 1982, 579, 341,
+	580, 570, 580, 571, 580, 1469, 580, 1497, 580, 1982, 580, 341, 581, 570,
+	581, 571, 581, 1469, 581, 1497, 581, 1982, 581, 341, 582, 570, 582, 571,
+	582, 1469, 582, 1497, 582, 1982, 582, 86, 142, 436, 142, 1162, 1464, 1730,
+	1882, 1732, 142, 1737, 142, 1740, 142, 1778, 142, 1800, 1464, 1879, 142,
+	1954, 142, 1966, 142, 1976, 142, 1978, 142, 2023, 142, 2027, 142, 2042, 142,
+	2044, 142, 2047, 142, 2053, 142, 2054, 142, 2056, 142, 2065, 142, 2066, 142,
+	2068, 142, 2073, 142, 2076, 142, 2082, 142, 2085, 142, 2120, 142, 2121, 142,
+	2139, 142, 2156, 142, 2265, 142, 2296, 142, 2343, 142, 267, 451, 341, 583,
+	570, 583, 571, 583, 1469, 583, 1497, 583, 1982, 583, 19, 48, 47, 48, 104,
+	48, 1746, 1912, 1747, 1912, 1748, 1912, 1752, 1912, 1753, 1912, 1759, 1912,
+	1760, 1912, 1775, 1912, 1781, 1912, 1982, 1912, 460, 702, 2301, 702, 2330,
+	702, 2333, 702, 2334, 702, 2336, 702, 2356, 702, 2357, 702, 2383, 702, 2384,
+	702, 2385, 702, 2391, 702, 2393, 702, 2404, 702, 2424, 702, 341, 584, 570,
+	584, 571, 584, 1469, 584, 1497, 584, 1982, 584, 341, 585, 570, 585, 571,
+	585, 1469, 585, 1497, 585, 1982, 585, 341, 586, 570, 586, 571, 586, 1469,
+	586, 1497, 586, 1982, 586, 86, 143, 436, 143, 1162, 1465, 1732, 143, 1737,
+	143, 1740, 143, 1778, 143, 1800, 1465, 1879, 143, 1954, 143, 1966, 143,
+	1976, 143, 1978, 143, 2023, 143, 2027, 143, 2042, 143, 2044, 143, 2047, 143,
+	2053, 143, 2054, 143, 2056, 143, 2065, 143, 2066, 143, 2068, 143, 2073, 143,
+	2076, 143, 2082, 143, 2085, 143, 2120, 143, 2121, 143, 2139, 143, 2156, 143,
+	2265, 143, 2296, 143, 2343, 143, 20, 66, 86, 144, 1732, 144, 1737, 144,
+	1740, 144, 1778, 144, 1879, 144, 1954, 144, 1966, 144, 1976, 144, 1978, 144,
+	2023, 144, 2027, 144, 2042, 144, 2044, 144, 2047, 144, 2053, 144, 2054, 144,
+	2056, 144, 2065, 144, 2066, 144, 2068, 144, 2073, 144, 2076, 144, 2082, 144,
+	2085, 144, 2120, 144, 2121, 144, 2139, 144, 2156, 144, 2296, 144, 2343, 144,
+	1499, 1769, 1516, 1769, 1162, 1466, 1800, 1466, 4, 17, 19, 49, 47, 49, 104,
+	49, 341, 587, 570, 587, 571, 587, 1469, 587, 1497, 587, 1982, 587, 341, 588,
+	570, 588, 571, 588, 1469, 588, 1497, 588, 1982, 588, 21, 68, 82, 68, 133,
+	68, 135, 68, 193, 68, 194, 68, 195, 68, 266, 68, 343, 68, 344, 68, 349, 68,
+	359, 68, 360, 68, 367, 68, 371, 68, 372, 68, 373, 68, 391, 68, 392, 68, 393,
+	68, 394, 68, 395, 68, 396, 68, 397, 68, 398, 68, 399, 68, 414, 68, 415, 68,
+	416, 68, 420, 68, 421, 68, 423, 68, 424, 68, 426, 68, 427, 68, 428, 68, 430,
+	68, 432, 68, 433, 68, 434, 68, 435, 68, 442, 68, 489, 68, 630, 68, 634, 68,
+	638, 68, 647, 68, 657, 68, 658, 68, 662, 68, 663, 68, 666, 68, 669, 68, 673,
+	68, 675, 68, 681, 68, 784, 68, 785, 68, 787, 68, 799, 68, 801, 68, 802, 68,
+	805, 68, 809, 68, 838, 68, 839, 68, 840, 68, 841, 68, 842, 68, 843, 68, 844,
+	68, 847, 68, 849, 68, 850, 68, 876, 68, 925, 68, 941, 68, 942, 68, 948, 68,
+	994, 68, 1013, 68, 1056, 68, 1057, 68, 1058, 68, 1070, 68, 1071, 68, 1075,
+	68, 1076, 68, 1104, 68, 1105, 68, 1106, 68, 1107, 68, 1116, 68, 1130, 68,
+	1166, 68, 1169, 68, 1215, 68, 1216, 68, 1221, 68, 1223, 68, 1225, 68, 1227,
+	68, 1228, 68, 1230, 68, 1231, 68, 1232, 68, 1233, 68, 1234, 68, 1235, 68,
+	1238, 68, 1239, 68, 1240, 68, 1243, 68, 1244, 68, 1246, 68, 1247, 68, 1249,
+	68, 1250, 68, 1251, 68, 1253, 68, 1255, 68, 1256, 68, 1257, 68, 1258, 68,
+	1273, 68, 1452, 68, 1455, 68, 1457, 68, 1462, 68, 1464, 68, 1467, 68, 1476,
+	68, 1477, 68, 1484, 68, 1485, 68, 1486, 68, 1487, 68, 1488, 68, 1493, 68,
+	1494, 68, 1495, 68, 1496, 68, 1498, 68, 1504, 68, 1506, 68, 1507, 68, 1508,
+	68, 1511, 68, 1513, 68, 1514, 68, 1515, 68, 1518, 68, 1520, 68, 1522, 68,
+	1524, 68, 1525, 68, 1526, 68, 1527, 68, 1603, 68, 1604, 68, 1619, 68, 1620,
+	68, 1623, 68, 1624, 68, 1625, 68, 1626, 68, 1629, 68, 1639, 68, 1648, 68,
+	1649, 68, 1653, 68, 1654, 68, 1657, 68, 1660, 68, 1664, 68, 1666, 68, 1721,
+	68, 1727, 68, 1746, 68, 1748, 68, 1752, 68, 1753, 68, 1759, 68, 1760, 68,
+	1761, 68, 1762, 68, 1771, 68, 1772, 68, 1773, 68, 1775, 68, 1780, 68, 1781,
+	68, 1783, 68, 1788, 68, 1789, 68, 1792, 68, 1835, 68, 1843, 68, 1846, 68,
+	1872, 68, 1875, 68, 1878, 68, 1894, 68, 1895, 68, 1907, 68, 1908, 68, 1921,
+	68, 1933, 68, 1934, 68, 1935, 68, 1936, 68, 1943, 68, 1944, 68, 1945, 68,
+	1950, 68, 1956, 68, 1962, 68, 1964, 68, 1965, 68, 1967, 68, 1971, 68, 1973,
+	68, 1977, 68, 1979, 68, 1985, 68, 2016, 68, 2018, 68, 2031, 68, 2032, 68,
+	2035, 68, 2055, 68, 2061, 68, 2064, 68, 2074, 68, 2081, 68, 2093, 68, 2094,
+	68, 2113, 68, 2117, 68, 2122, 68, 2135, 68, 2153, 68, 2161, 68, 2162, 68,
+	2175, 68, 2178, 68, 2196, 68, 2197, 68, 2200, 68, 2201, 68, 2202, 68, 2213,
+	68, 2243, 68, 2250, 68, 2252, 68, 2273, 68, 2279, 68, 2281, 68, 2285, 68,
+	2311, 68, 2345, 68, 2355, 68, 2362, 68, 2363, 68, 2364, 68, 2427, 68, 86,
+	145, 436, 145, 1162, 1467, 1732, 145, 1737, 145, 1740, 145, 1778, 145, 1800,
+	1467, 1879, 145, 1954, 145, 1966, 145, 1976, 145, 1978, 145, 2023, 145,
+	2027, 145, 2042, 145, 2044, 145, 2047, 145, 2053, 145, 2054, 145, 2056, 145,
+	2065, 145, 2066, 145, 2068, 145, 2073, 145, 2076, 145, 2082, 145, 2085, 145,
+	2120, 145, 2121, 145, 2139, 145, 2156, 145, 2197, 145, 2201, 145, 2265, 145,
+	2296, 145, 2343, 145, 86, 146, 436, 146, 1732, 146, 1737, 146, 1740, 146,
+	1778, 146, 1879, 146, 1954, 146, 1966, 146, 1976, 146, 1978, 146, 2023, 146,
+	2027, 146, 2042, 146, 2044, 146, 2047, 146, 2053, 146, 2054, 146, 2056, 146,
+	2065, 146, 2066, 146, 2068, 146, 2073, 146, 2076, 146, 2082, 146, 2085, 146,
+	2120, 146, 2121, 146, 2139, 146, 2156, 146, 2265, 146, 2296, 146, 2343, 146,
+	1162, 1468, 460, 703, 2301, 703, 2330, 703, 2333, 703, 2334, 703, 2336, 703,
+	2356, 703, 2357, 703, 2383, 703, 2384, 703, 2385, 703, 2391, 703, 2393, 703,
+	2404, 703, 2424, 703, 629, 1174, 2343, 1174, 629, 1175, 2343, 1175, 86, 147,
+	436, 147, 1732, 147, 1737, 147, 1740, 147, 1778, 147, 1879, 147, 1954, 147,
+	1966, 147, 1976, 147, 1978, 147, 2023, 147, 2027, 147, 2042, 147, 2044, 147,
+	2047, 147, 2053, 147, 2054, 147, 2056, 147, 2065, 147, 2066, 147, 2068, 147,
+	2073, 147, 2076, 147, 2082, 147, 2085, 147, 2120, 147, 2121, 147, 2139, 147,
+	2156, 147, 2265, 147, 2296, 147, 2343, 147, 1602, 1801, 1162, 1469, 1800,
+	1469, 2131, 2219, 2062, 2157, 1162, 1470, 1800, 1470, 1162, 1471, 1162,
+	1472, 1800, 1472, 341, 589, 570, 589, 571, 589, 1469, 589, 1497, 589, 1982,
+	589, 341, 590, 570, 590, 571, 590, 1469, 590, 1497, 590, 1982, 590, 341,
+	591, 570, 591, 571, 591, 1469, 591, 1497, 591, 1982, 591, 1768, 1947, 1162,
+	1473, 1800, 1473, 1162, 1474, 1162, 1475, 1800, 1475, 460, 704, 2301, 704,
+	2330, 704, 2333, 704, 2334, 704, 2336, 704, 2356, 704, 2357, 704, 2383, 704,
+	2384, 704, 2385, 704, 2391, 704, 2393, 704, 2404, 704, 2424, 704, 341, 592,
+	570, 592, 571, 592, 1469, 592, 1497, 592, 1982, 592, 106, 267, 2330, 2359,
+	2332, 2359, 2356, 2359, 2357, 2359, 19, 50, 47, 50, 104, 50, 2106, 2198,
+	2108, 2198, 1746, 1913, 1747, 1913, 1748, 1913, 1752, 1913, 1753, 1913,
+	1759, 1913, 1760, 1913, 1775, 1913, 1781, 1913, 1982, 1913, 460, 705, 2301,
+	705, 2330, 705, 2333, 705, 2334, 705, 2336, 705, 2356, 705, 2357, 705, 2383,
+	705, 2384, 705, 2385, 705, 2391, 705, 2393, 705, 2404, 705, 2424, 705, 341,
+	593, 570, 593, 571, 593, 1469, 593, 1497, 593, 1982, 593, 3, 10, 4, 18, 65,
+	118, 116, 118, 117, 118, 446, 118, 447, 118, 4, 19, 629, 1176, 1166, 1176,
+	1603, 1176, 1604, 1176, 1899, 1176, 1946, 1176, 2189, 1176, 2343, 1176, 629,
+	1177, 1166, 1177, 1603, 1177, 1604, 1177, 1899, 1177, 1946, 1177, 2189,
+	1177, 2343, 1177, 460, 706, 2301, 706, 2330, 706, 2333, 706, 2334, 706,
+	2336, 706, 2356, 706, 2357, 706, 2383, 706, 2384, 706, 2385, 706, 2391, 706,
+	2393, 706, 2404, 706, 2424, 706, 108, 269, 122, 338, 336, 338, 337, 338,
+	690, 338, 691, 338, 122, 339, 336, 339, 337, 339, 690, 339, 691, 339, 21,
+	69, 82, 69, 133, 69, 135, 69, 193, 69, 194, 69, 195, 69, 266, 69, 343, 69,
+	344, 69, 349, 69, 359, 69, 360, 69, 367, 69, 371, 69, 372, 69, 373, 69, 391,
+	69, 392, 69, 393, 69, 394, 69, 395, 69, 396, 69, 397, 69, 398, 69, 399, 69,
+	414, 69, 415, 69, 416, 69, 420, 69, 421, 69, 423, 69, 424, 69, 426, 69, 427,
+	69, 428, 69, 430, 69, 432, 69, 433, 69, 434, 69, 435, 69, 442, 69, 489, 69,
+	630, 69, 634, 69, 638, 69, 647, 69, 657, 69, 658, 69, 662, 69, 663, 69, 666,
+	69, 669, 69, 673, 69, 675, 69, 681, 69, 784, 69, 785, 69, 787, 69, 799, 69,
+	801, 69, 802, 69, 805, 69, 809, 69, 838, 69, 839, 69, 840, 69, 841, 69, 842,
+	69, 843, 69, 844, 69, 847, 69, 849, 69, 850, 69, 876, 69, 925, 69, 941, 69,
+	942, 69, 948, 69, 994, 69, 1013, 69, 1056, 69, 1057, 69, 1058, 69, 1070, 69,
+	1071, 69, 1075, 69, 1076, 69, 1104, 69, 1105, 69, 1106, 69, 1107, 69, 1116,
+	69, 1130, 69, 1166, 69, 1169, 69, 1215, 69, 1216, 69, 1221, 69, 1223, 69,
+	1225, 69, 1227, 69, 1228, 69, 1230, 69, 1231, 69, 1232, 69, 1233, 69, 1234,
+	69, 1235, 69, 1238, 69, 1239, 69, 1240, 69, 1243, 69, 1244, 69, 1246, 69,
+	1247, 69, 1249, 69, 1250, 69, 1251, 69, 1253, 69, 1255, 69, 1256, 69, 1257,
+	69, 1258, 69, 1273, 69, 1452, 69, 1455, 69, 1457, 69, 1462, 69, 1464, 69,
+	1467, 69, 1476, 69, 1477, 69, 1484, 69, 1485, 69, 1486, 69, 1487, 69, 1488,
+	69, 1493, 69, 1494, 69, 1495, 69, 1496, 69, 1498, 69, 1504, 69, 1506, 69,
+	1507, 69, 1508, 69, 1511, 69, 1513, 69, 1514, 69, 1515, 69, 1518, 69, 1520,
+	69, 1522, 69, 1524, 69, 1525, 69, 1526, 69, 1527, 69, 1603, 69, 1604, 69,
+	1619, 69, 1620, 69, 1623, 69, 1624, 69, 1625, 69, 1626, 69, 1629, 69, 1639,
+	69, 1648, 69, 1649, 69, 1653, 69, 1654, 69, 1657, 69, 1660, 69, 1664, 69,
+	1666, 69, 1721, 69, 1727, 69, 1746, 69, 1748, 69, 1752, 69, 1753, 69, 1759,
+	69, 1760, 69, 1761, 69, 1762, 69, 1771, 69, 1772, 69, 1773, 69, 1775, 69,
+	1780, 69, 1781, 69, 1783, 69, 1788, 69, 1789, 69, 1792, 69, 1835, 69, 1843,
+	69, 1846, 69, 1872, 69, 1875, 69, 1878, 69, 1894, 69, 1895, 69, 1907, 69,
+	1908, 69, 1921, 69, 1933, 69, 1934, 69, 1935, 69, 1936, 69, 1943, 69, 1944,
+	69, 1945, 69, 1950, 69, 1956, 69, 1962, 69, 1964, 69, 1965, 69, 1967, 69,
+	1971, 69, 1973, 69, 1977, 69, 1979, 69, 1985, 69, 2016, 69, 2018, 69, 2031,
+	69, 2032, 69, 2035, 69, 2055, 69, 2061, 69, 2064, 69, 2074, 69, 2081, 69,
+	2093, 69, 2094, 69, 2113, 69, 2117, 69, 2122, 69, 2135, 69, 2153, 69, 2161,
+	69, 2162, 69, 2175, 69, 2178, 69, 2196, 69, 2197, 69, 2200, 69, 2201, 69,
+	2202, 69, 2213, 69, 2243, 69, 2250, 69, 2252, 69, 2273, 69, 2279, 69, 2281,
+	69, 2285, 69, 2311, 69, 2345, 69, 2355, 69, 2362, 69, 2363, 69, 2364, 69,
+	2427, 69, 19, 51, 47, 51, 62, 51, 63, 51, 264, 51, 265, 51, 86, 148, 436,
+	148, 1732, 148, 1737, 148, 1740, 148, 1778, 148, 1879, 148, 1954, 148, 1966,
+	148, 1976, 148, 1978, 148, 2023, 148, 2027, 148, 2042, 148, 2044, 148, 2047,
+	148, 2053, 148, 2054, 148, 2056, 148, 2065, 148, 2066, 148, 2068, 148, 2073,
+	148, 2076, 148, 2082, 148, 2085, 148, 2120, 148, 2121, 148, 2139, 148, 2156,
+	148, 2265, 148, 2296, 148, 2343, 148, 19, 52, 47, 52, 62, 52, 63, 52, 264,
+	52, 265, 52, 629, 1178, 2343, 1178, 164, 403, 1492, 403, 143, 361, 168, 361,
+	176, 361, 186, 361, 1465, 361, 1500, 361, 1509, 361, 1521, 361, 267, 452,
+	19, 53, 47, 53, 104, 53, 19, 54, 47, 54, 104, 54, 2017, 2104, 2019, 2104,
+	86, 149, 436, 149, 1162, 1476, 1732, 149, 1737, 149, 1740, 149, 1778, 149,
+	1800, 1476, 1879, 149, 1954, 149, 1966, 149, 1976, 149, 1978, 149, 2023,
+	149, 2027, 149, 2042, 149, 2044, 149, 2047, 149, 2053, 149, 2054, 149, 2056,
+	149, 2065, 149, 2066, 149, 2068, 149, 2073, 149, 2076, 149, 2082, 149, 2085,
+	149, 2120, 149, 2121, 149, 2139, 149, 2156, 149, 2265, 149, 2296, 149, 2343,
+	149, 86, 150, 436, 150, 1162, 1477, 1732, 150, 1737, 150, 1740, 150, 1778,
+	150, 1800, 1477, 1879, 150, 1954, 150, 1966, 150, 1976, 150, 1978, 150,
+	2023, 150, 2027, 150, 2042, 150, 2044, 150, 2047, 150, 2053, 150, 2054, 150,
+	2056, 150, 2065, 150, 2066, 150, 2068, 150, 2073, 150, 2076, 150, 2082, 150,
+	2085, 150, 2120, 150, 2121, 150, 2139, 150, 2156, 150, 2265, 150, 2296, 150,
+	2343, 150, 86, 151, 436, 151, 1162, 1478, 1730, 1883, 1732, 151, 1737, 151,
+	1740, 151, 1778, 151, 1800, 1478, 1879, 151, 1954, 151, 1966, 151, 1976,
+	151, 1978, 151, 2023, 151, 2027, 151, 2042, 151, 2044, 151, 2047, 151, 2053,
+	151, 2054, 151, 2056, 151, 2065, 151, 2066, 151, 2068, 151, 2073, 151, 2076,
+	151, 2082, 151, 2085, 151, 2120, 151, 2121, 151, 2139, 151, 2156, 151, 2265,
+	151, 2296, 151, 2343, 151, 86, 152, 153, 374, 436, 152, 796, 152, 803, 152,
+	808, 152, 813, 152, 893, 152, 922, 152, 923, 152, 995, 152, 1024, 152, 1036,
+	152, 1123, 152, 1732, 152, 1737, 152, 1740, 152, 1747, 374, 1778, 152, 1879,
+	152, 1954, 152, 1966, 152, 1976, 152, 1978, 152, 2023, 152, 2027, 152, 2042,
+	152, 2044, 152, 2047, 152, 2053, 152, 2054, 152, 2056, 152, 2065, 152, 2066,
+	152, 2068, 152, 2073, 152, 2076, 152, 2082, 152, 2085, 152, 2120, 152, 2121,
+	152, 2139, 152, 2156, 152, 2265, 152, 2296, 152, 2343, 152, 1746, 1914,
+	1747, 1914, 1748, 1914, 1752, 1914, 1753, 1914, 1759, 1914, 1760, 1914,
+	1775, 1914, 1781, 1914, 1982, 1914, 341, 594, 570, 594, 571, 594, 1469, 594,
+	1497, 594, 1982, 594, 86, 153, 436, 153, 1162, 1479, 1732, 153, 1737, 153,
+	1740, 153, 1778, 153, 1800, 1479, 1879, 153, 1954, 153, 1966, 153, 1976,
+	153, 1978, 153, 2023, 153, 2027, 153, 2042, 153, 2044, 153, 2047, 153, 2053,
+	153, 2054, 153, 2056, 153, 2065, 153, 2066, 153, 2068, 153, 2073, 153, 2076,
+	153, 2082, 153, 2085, 153, 2120, 153, 2121, 153, 2139, 153, 2156, 153, 2265,
+	153, 2296, 153, 2343, 153, 86, 154, 436, 154, 1162, 1480, 1732, 154, 1737,
+	154, 1740, 154, 1778, 154, 1800, 1480, 1879, 154, 1954, 154, 1966, 154,
+	1976, 154, 1978, 154, 2023, 154, 2027, 154, 2042, 154, 2044, 154, 2047, 154,
+	2053, 154, 2054, 154, 2056, 154, 2065, 154, 2066, 154, 2068, 154, 2073, 154,
+	2076, 154, 2082, 154, 2085, 154, 2120, 154, 2121, 154, 2139, 154, 2156, 154,
+	2265, 154, 2296, 154, 2343, 154, 1162, 1481, 2062, 2158, 21, 70, 82, 70,
+	133, 70, 135, 70, 193, 70, 194, 70, 195, 70, 266, 70, 343, 70, 344, 70, 349,
+	70, 359, 70, 360, 70, 367, 70, 371, 70, 372, 70, 373, 70, 391, 70, 392, 70,
+	393, 70, 394, 70, 395, 70, 396, 70, 397, 70, 398, 70, 399, 70, 414, 70, 415,
+	70, 416, 70, 420, 70, 421, 70, 423, 70, 424, 70, 426, 70, 427, 70, 428, 70,
+	430, 70, 432, 70, 433, 70, 434, 70, 435, 70, 442, 70, 489, 70, 630, 70, 634,
+	70, 638, 70, 647, 70, 657, 70, 658, 70, 662, 70, 663, 70, 666, 70, 669, 70,
+	673, 70, 675, 70, 681, 70, 784, 70, 785, 70, 787, 70, 799, 70, 801, 70, 802,
+	70, 805, 70, 809, 70, 838, 70, 839, 70, 840, 70, 841, 70, 842, 70, 843, 70,
+	844, 70, 847, 70, 849, 70, 850, 70, 876, 70, 925, 70, 941, 70, 942, 70, 948,
+	70, 994, 70, 1013, 70, 1056, 70, 1057, 70, 1058, 70, 1070, 70, 1071, 70,
+	1075, 70, 1076, 70, 1104, 70, 1105, 70, 1106, 70, 1107, 70, 1116, 70, 1130,
+	70, 1166, 70, 1169, 70, 1215, 70, 1216, 70, 1221, 70, 1223, 70, 1225, 70,
+	1227, 70, 1228, 70, 1230, 70, 1231, 70, 1232, 70, 1233, 70, 1234, 70, 1235,
+	70, 1238, 70, 1239, 70, 1240, 70, 1243, 70, 1244, 70, 1246, 70, 1247, 70,
+	1249, 70, 1250, 70, 1251, 70, 1253, 70, 1255, 70, 1256, 70, 1257, 70, 1258,
+	70, 1273, 70, 1452, 70, 1455, 70, 1457, 70, 1462, 70, 1464, 70, 1467, 70,
+	1476, 70, 1477, 70, 1484, 70, 1485, 70, 1486, 70, 1487, 70, 1488, 70, 1493,
+	70, 1494, 70, 1495, 70, 1496, 70, 1498, 70, 1504, 70, 1506, 70, 1507, 70,
+	1508, 70, 1511, 70, 1513, 70, 1514, 70, 1515, 70, 1518, 70, 1520, 70, 1522,
+	70, 1524, 70, 1525, 70, 1526, 70, 1527, 70, 1603, 70, 1604, 70, 1619, 70,
+	1620, 70, 1623, 70, 1624, 70, 1625, 70, 1626, 70, 1629, 70, 1639, 70, 1648,
+	70, 1649, 70, 1653, 70, 1654, 70, 1657, 70, 1660, 70, 1664, 70, 1666, 70,
+	1721, 70, 1727, 70, 1746, 70, 1748, 70, 1752, 70, 1753, 70, 1759, 70, 1760,
+	70, 1761, 70, 1762, 70, 1771, 70, 1772, 70, 1773, 70, 1775, 70, 1780, 70,
+	1781, 70, 1783, 70, 1788, 70, 1789, 70, 1792, 70, 1835, 70, 1843, 70, 1846,
+	70, 1872, 70, 1875, 70, 1878, 70, 1894, 70, 1895, 70, 1907, 70, 1908, 70,
+	1921, 70, 1933, 70, 1934, 70, 1935, 70, 1936, 70, 1943, 70, 1944, 70, 1945,
+	70, 1950, 70, 1956, 70, 1962, 70, 1964, 70, 1965, 70, 1967, 70, 1971, 70,
+	1973, 70, 1977, 70, 1979, 70, 1985, 70, 2016, 70, 2018, 70, 2031, 70, 2032,
+	70, 2035, 70, 2055, 70, 2061, 70, 2064, 70, 2074, 70, 2081, 70, 2093, 70,
+	2094, 70, 2113, 70, 2117, 70, 2122, 70, 2135, 70, 2153, 70, 2161, 70, 2162,
+	70, 2175, 70, 2178, 70, 2196, 70, 2197, 70, 2200, 70, 2201, 70, 2202, 70,
+	2213, 70, 2243, 70, 2250, 70, 2252, 70, 2273, 70, 2279, 70, 2281, 70, 2285,
+	70, 2311, 70, 2345, 70, 2355, 70, 2362, 70, 2363, 70, 2364, 70, 2427, 70,
+	86, 155, 436, 155, 1162, 1482, 1732, 155, 1737, 155, 1740, 155, 1778, 155,
+	1800, 1482, 1879, 155, 1954, 155, 1966, 155, 1976, 155, 1978, 155, 2023,
+	155, 2027, 155, 2042, 155, 2044, 155, 2047, 155, 2053, 155, 2054, 155, 2056,
+	155, 2065, 155, 2066, 155, 2068, 155, 2073, 155, 2076, 155, 2082, 155, 2085,
+	155, 2120, 155, 2121, 155, 2139, 155, 2156, 155, 2265, 155, 2296, 155, 2343,
+	155, 86, 156, 436, 156, 1162, 1483, 1732, 156, 1737, 156, 1740, 156, 1778,
+	156, 1800, 1483, 1879, 156, 1954, 156, 1966, 156, 1976, 156, 1978, 156,
+	2023, 156, 2027, 156, 2042, 156, 2044, 156, 2047, 156, 2053, 156, 2054, 156,
+	2056, 156, 2065, 156, 2066, 156, 2068, 156, 2073, 156, 2076, 156, 2082, 156,
+	2085, 156, 2120, 156, 2121, 156, 2139, 156, 2156, 156, 2265, 156, 2296, 156,
+	2343, 156, 21, 71, 82, 71, 133, 71, 135, 71, 193, 71, 194, 71, 195, 71, 266,
+	71, 343, 71, 344, 71, 349, 71, 359, 71, 360, 71, 367, 71, 371, 71, 372, 71,
+	373, 71, 391, 71, 392, 71, 393, 71, 394, 71, 395, 71, 396, 71, 397, 71, 398,
+	71, 399, 71, 414, 71, 415, 71, 416, 71, 420, 71, 421, 71, 423, 71, 424, 71,
+	426, 71, 427, 71, 428, 71, 430, 71, 432, 71, 433, 71, 434, 71, 435, 71, 442,
+	71, 489, 71, 630, 71, 634, 71, 638, 71, 647, 71, 657, 71, 658, 71, 662, 71,
+	663, 71, 666, 71, 669, 71, 673, 71, 675, 71, 681, 71, 784, 71, 785, 71, 787,
+	71, 799, 71, 801, 71, 802, 71, 805, 71, 809, 71, 838, 71, 839, 71, 840, 71,
+	841, 71, 842, 71, 843, 71, 844, 71, 847, 71, 849, 71, 850, 71, 876, 71, 925,
+	71, 941, 71, 942, 71, 948, 71, 994, 71, 1013, 71, 1056, 71, 1057, 71, 1058,
+	71, 1070, 71, 1071, 71, 1075, 71, 1076, 71, 1104, 71, 1105, 71, 1106, 71,
+	1107, 71, 1116, 71, 1130, 71, 1166, 71, 1169, 71, 1215, 71, 1216, 71, 1221,
+	71, 1223, 71, 1225, 71, 1227, 71, 1228, 71, 1230, 71, 1231, 71, 1232, 71,
+	1233, 71, 1234, 71, 1235, 71, 1238, 71, 1239, 71, 1240, 71, 1243, 71, 1244,
+	71, 1246, 71, 1247, 71, 1249, 71, 1250, 71, 1251, 71, 1253, 71, 1255, 71,
+	1256, 71, 1257, 71, 1258, 71, 1273, 71, 1452, 71, 1455, 71, 1457, 71, 1462,
+	71, 1464, 71, 1467, 71, 1476, 71, 1477, 71, 1484, 71, 1485, 71, 1486, 71,
+	1487, 71, 1488, 71, 1493, 71, 1494, 71, 1495, 71, 1496, 71, 1498, 71, 1504,
+	71, 1506, 71, 1507, 71, 1508, 71, 1511, 71, 1513, 71, 1514, 71, 1515, 71,
+	1518, 71, 1520, 71, 1522, 71, 1524, 71, 1525, 71, 1526, 71, 1527, 71, 1603,
+	71, 1604, 71, 1619, 71, 1620, 71, 1623, 71, 1624, 71, 1625, 71, 1626, 71,
+	1629, 71, 1639, 71, 1648, 71, 1649, 71, 1653, 71, 1654, 71, 1657, 71, 1660,
+	71, 1664, 71, 1666, 71, 1721, 71, 1727, 71, 1746, 71, 1748, 71, 1752, 71,
+	1753, 71, 1759, 71, 1760, 71, 1761, 71, 1762, 71, 1771, 71, 1772, 71, 1773,
+	71, 1775, 71, 1780, 71, 1781, 71, 1783, 71, 1788, 71, 1789, 71, 1792, 71,
+	1835, 71, 1843, 71, 1846, 71, 1872, 71, 1875, 71, 1878, 71, 1894, 71, 1895,
+	71, 1907, 71, 1908, 71, 1921, 71, 1933, 71, 1934, 71, 1935, 71, 1936, 71,
+	1943, 71, 1944, 71, 1945, 71, 1950, 71, 1956, 71, 1962, 71, 1964, 71, 1965,
+	71, 1967, 71, 1971, 71, 1973, 71, 1977, 71, 1979, 71, 1985, 71, 2016, 71,
+	2018, 71, 2031, 71, 2032, 71, 2035, 71, 2055, 71, 2061, 71, 2064, 71, 2074,
+	71, 2081, 71, 2093, 71, 2094, 71, 2113, 71, 2117, 71, 2122, 71, 2135, 71,
+	2153, 71, 2161, 71, 2162, 71, 2175, 71, 2178, 71, 2196, 71, 2197, 71, 2200,
+	71, 2201, 71, 2202, 71, 2213, 71, 2243, 71, 2250, 71, 2252, 71, 2273, 71,
+	2279, 71, 2281, 71, 2285, 71, 2311, 71, 2345, 71, 2355, 71, 2362, 71, 2363,
+	71, 2364, 71, 2427, 71, 86, 157, 436, 157, 1162, 1484, 1732, 157, 1737, 157,
+	1740, 157, 1778, 157, 1800, 1484, 1879, 157, 1954, 157, 1966, 157, 1976,
+	157, 1978, 157, 2023, 157, 2027, 157, 2042, 157, 2044, 157, 2047, 157, 2053,
+	157, 2054, 157, 2056, 157, 2065, 157, 2066, 157, 2068, 157, 2073, 157, 2076,
+	157, 2082, 157, 2085, 157, 2120, 157, 2121, 157, 2139, 157, 2156, 157, 2265,
+	157, 2296, 157, 2343, 157, 86, 158, 436, 158, 1162, 1485, 1732, 158, 1737,
+	158, 1740, 158, 1778, 158, 1800, 1485, 1879, 158, 1954, 158, 1966, 158,
+	1976, 158, 1978, 158, 2023, 158, 2027, 158, 2042, 158, 2044, 158, 2047, 158,
+	2053, 158, 2054, 158, 2056, 158, 2065, 158, 2066, 158, 2068, 158, 2073, 158,
+	2076, 158, 2082, 158, 2085, 158, 2120, 158, 2121, 158, 2139, 158, 2156, 158,
+	2265, 158, 2296, 158, 2343, 158, 86, 159, 436, 159, 1162, 1486, 1732, 159,
+	1737, 159, 1740, 159, 1778, 159, 1800, 1486, 1879, 159, 1954, 159, 1966,
+	159, 1976, 159, 1978, 159, 2023, 159, 2027, 159, 2042, 159, 2044, 159, 2047,
+	159, 2053, 159, 2054, 159, 2056, 159, 2065, 159, 2066, 159, 2068, 159, 2073,
+	159, 2076, 159, 2082, 159, 2085, 159, 2120, 159, 2121, 159, 2139, 159, 2156,
+	159, 2265, 159, 2296, 159, 2343, 159, 86, 160, 436, 160, 1162, 1487, 1732,
+	160, 1737, 160, 1740, 160, 1778, 160, 1800, 1487, 1879, 160, 1954, 160,
+	1966, 160, 1976, 160, 1978, 160, 2023, 160, 2027, 160, 2042, 160, 2044, 160,
+	2047, 160, 2053, 160, 2054, 160, 2056, 160, 2065, 160, 2066, 160, 2068, 160,
+	2073, 160, 2076, 160, 2082, 160, 2085, 160, 2120, 160, 2121, 160, 2139, 160,
+	2156, 160, 2265, 160, 2296, 160, 2343, 160, 1162, 1488, 1800, 1488, 86, 161,
+	436, 161, 1162, 1489, 1732, 161, 1737, 161, 1740, 161, 1778, 161, 1800,
+	1489, 1879, 161, 1954, 161, 1966, 161, 1976, 161, 1978, 161, 2023, 161,
+	2027, 161, 2042, 161, 2044, 161, 2047, 161, 2053, 161, 2054, 161, 2056, 161,
+	2065, 161, 2066, 161, 2068, 161, 2073, 161, 2076, 161, 2082, 161, 2085, 161,
+	2120, 161, 2121, 161, 2139, 161, 2156, 161, 2265, 161, 2296, 161, 2343, 161,
+	1471, 1737, 1474, 1740, 86, 162, 436, 162, 1162, 1490, 1730, 1884, 1732,
+	162, 1737, 162, 1740, 162, 1778, 162, 1800, 1490, 1879, 162, 1954, 162,
+	1966, 162, 1976, 162, 1978, 162, 2023, 162, 2027, 162, 2042, 162, 2044, 162,
+	2047, 162, 2053, 162, 2054, 162, 2056, 162, 2065, 162, 2066, 162, 2068, 162,
+	2073, 162, 2076, 162, 2082, 162, 2085, 162, 2120, 162, 2121, 162, 2139, 162,
+	2156, 162, 2265, 162, 2296, 162, 2343, 162, 2330, 2360, 2356, 2360, 2357,
+	2360, 86, 163, 436, 163, 1162, 1491, 1732, 163, 1737, 163, 1740, 163, 1778,
+	163, 1800, 1491, 1879, 163, 1954, 163, 1966, 163, 1976, 163, 1978, 163,
+	2023, 163, 2027, 163, 2042, 163, 2044, 163, 2047, 163, 2053, 163, 2054, 163,
+	2056, 163, 2065, 163, 2066, 163, 2068, 163, 2073, 163, 2076, 163, 2082, 163,
+	2085, 163, 2120, 163, 2121, 163, 2139, 163, 2156, 163, 2197, 163, 2201, 163,
+	2265, 163, 2296, 163, 2343, 163, 341, 595, 570, 595, 571, 595, 1469, 595,
+	1497, 595, 1982, 595, 2106, 2199, 2108, 2199, 21, 72, 82, 72, 133, 72, 135,
+	72, 193, 72, 194, 72, 195, 72, 266, 72, 343, 72, 344, 72, 349, 72, 359, 72,
+	360, 72, 367, 72, 371, 72, 372, 72, 373, 72, 391, 72, 392, 72, 393, 72, 394,
+	72, 395, 72, 396, 72, 397, 72, 398, 72, 399, 72, 414, 72, 415, 72, 416, 72,
+	420, 72, 421, 72, 423, 72, 424, 72, 426, 72, 427, 72, 428, 72, 430, 72, 432,
+	72, 433, 72, 434, 72, 435, 72, 442, 72, 489, 72, 630, 72, 634, 72, 638, 72,
+	647, 72, 657, 72, 658, 72, 662, 72, 663, 72, 666, 72, 669, 72, 673, 72, 675,
+	72, 681, 72, 784, 72, 785, 72, 787, 72, 799, 72, 801, 72, 802, 72, 805, 72,
+	809, 72, 838, 72, 839, 72, 840, 72, 841, 72, 842, 72, 843, 72, 844, 72, 847,
+	72, 849, 72, 850, 72, 876, 72, 925, 72, 941, 72, 942, 72, 948, 72, 994, 72,
+	1013, 72, 1056, 72, 1057, 72, 1058, 72, 1070, 72, 1071, 72, 1075, 72, 1076,
+	72, 1104, 72, 1105, 72, 1106, 72, 1107, 72, 1116, 72, 1130, 72, 1166, 72,
+	1169, 72, 1215, 72, 1216, 72, 1221, 72, 1223, 72, 1225, 72, 1227, 72, 1228,
+	72, 1230, 72, 1231, 72, 1232, 72, 1233, 72, 1234, 72, 1235, 72, 1238, 72,
+	1239, 72, 1240, 72, 1243, 72, 1244, 72, 1246, 72, 1247, 72, 1249, 72, 1250,
+	72, 1251, 72, 1253, 72, 1255, 72, 1256, 72, 1257, 72, 1258, 72, 1273, 72,
+	1452, 72, 1455, 72, 1457, 72, 1462, 72, 1464, 72, 1467, 72, 1476, 72, 1477,
+	72, 1484, 72, 1485, 72, 1486, 72, 1487, 72, 1488, 72, 1493, 72, 1494, 72,
+	1495, 72, 1496, 72, 1498, 72, 1504, 72, 1506, 72, 1507, 72, 1508, 72, 1511,
+	72, 1513, 72, 1514, 72, 1515, 72, 1518, 72, 1520, 72, 1522, 72, 1524, 72,
+	1525, 72, 1526, 72, 1527, 72, 1603, 72, 1604, 72, 1619, 72, 1620, 72, 1623,
+	72, 1624, 72, 1625, 72, 1626, 72, 1629, 72, 1639, 72, 1648, 72, 1649, 72,
+	1653, 72, 1654, 72, 1657, 72, 1660, 72, 1664, 72, 1666, 72, 1721, 72, 1727,
+	72, 1746, 72, 1748, 72, 1752, 72, 1753, 72, 1759, 72, 1760, 72, 1761, 72,
+	1762, 72, 1771, 72, 1772, 72, 1773, 72, 1775, 72, 1780, 72, 1781, 72, 1783,
+	72, 1788, 72, 1789, 72, 1792, 72, 1835, 72, 1843, 72, 1846, 72, 1872, 72,
+	1875, 72, 1878, 72, 1894, 72, 1895, 72, 1907, 72, 1908, 72, 1921, 72, 1933,
+	72, 1934, 72, 1935, 72, 1936, 72, 1943, 72, 1944, 72, 1945, 72, 1950, 72,
+	1956, 72, 1962, 72, 1964, 72, 1965, 72, 1967, 72, 1971, 72, 1973, 72, 1977,
+	72, 1979, 72, 1985, 72, 2016, 72, 2018, 72, 2031, 72, 2032, 72, 2035, 72,
+	2055, 72, 2061, 72, 2064, 72, 2074, 72, 2081, 72, 2093, 72, 2094, 72, 2113,
+	72, 2117, 72, 2122, 72, 2135, 72, 2153, 72, 2161, 72, 2162, 72, 2175, 72,
+	2178, 72, 2196, 72, 2197, 72, 2200, 72, 2201, 72, 2202, 72, 2213, 72, 2243,
+	72, 2250, 72, 2252, 72, 2273, 72, 2279, 72, 2281, 72, 2285, 72, 2311, 72,
+	2345, 72, 2355, 72, 2362, 72, 2363, 72, 2364, 72, 2427, 72, 341, 596, 570,
+	596, 571, 596, 1469, 596, 1497, 596, 1982, 596, 341, 597, 570, 597, 571,
+	597, 1469, 597, 1497, 597, 1982, 597, 65, 119, 116, 119, 117, 119, 446, 119,
+	447, 119, 460, 707, 2301, 707, 2330, 707, 2333, 707, 2334, 707, 2336, 707,
+	2356, 707, 2357, 707, 2383, 707, 2384, 707, 2385, 707, 2391, 707, 2393, 707,
+	2404, 707, 2424, 707, 86, 164, 436, 164, 1162, 1492, 1732, 164, 1737, 164,
+	1740, 164, 1778, 164, 1800, 1492, 1879, 164, 1954, 164, 1966, 164, 1976,
+	164, 1978, 164, 2023, 164, 2027, 164, 2042, 164, 2044, 164, 2047, 164, 2053,
+	164, 2054, 164, 2056, 164, 2065, 164, 2066, 164, 2068, 164, 2073, 164, 2076,
+	164, 2082, 164, 2085, 164, 2120, 164, 2121, 164, 2139, 164, 2156, 164, 2265,
+	164, 2296, 164, 2343, 164, 1861, 2015, 1862, 2015, 629, 1179, 2343, 1179,
+	460, 708, 2301, 708, 2330, 708, 2333, 708, 2334, 708, 2336, 708, 2356, 708,
+	2357, 708, 2383, 708, 2384, 708, 2385, 708, 2391, 708, 2393, 708, 2404, 708,
+	2424, 708, 460, 709, 2301, 709, 2330, 709, 2333, 709, 2334, 709, 2336, 709,
+	2356, 709, 2357, 709, 2383, 709, 2384, 709, 2385, 709, 2391, 709, 2393, 709,
+	2404, 709, 2424, 709, 629, 1180, 1463, 1723, 2343, 1180, 163, 400, 1491,
+	400, 1162, 1493, 1857, 2010, 460, 710, 2301, 710, 2330, 710, 2333, 710,
+	2334, 710, 2336, 710, 2356, 710, 2357, 710, 2383, 710, 2384, 710, 2385, 710,
+	2391, 710, 2393, 710, 2404, 710, 2424, 710, 2097, 2193, 629, 1181, 1166,
+	1605, 1603, 1605, 1604, 1605, 1899, 1605, 1946, 1605, 2189, 1605, 2343,
+	1181, 86, 165, 436, 165, 1162, 1494, 1732, 165, 1737, 165, 1740, 165, 1778,
+	165, 1800, 1494, 1879, 165, 1954, 165, 1966, 165, 1976, 165, 1978, 165,
+	2023, 165, 2027, 165, 2042, 165, 2044, 165, 2047, 165, 2053, 165, 2054, 165,
+	2056, 165, 2065, 165, 2066, 165, 2068, 165, 2073, 165, 2076, 165, 2082, 165,
+	2085, 165, 2120, 165, 2121, 165, 2139, 165, 2156, 165, 2265, 165, 2296, 165,
+	2343, 165, 86, 166, 436, 166, 1162, 1495, 1732, 166, 1737, 166, 1740, 166,
+	1778, 166, 1800, 1495, 1879, 166, 1954, 166, 1966, 166, 1976, 166, 1978,
+	166, 2023, 166, 2027, 166, 2042, 166, 2044, 166, 2047, 166, 2053, 166, 2054,
+	166, 2056, 166, 2065, 166, 2066, 166, 2068, 166, 2073, 166, 2076, 166, 2082,
+	166, 2085, 166, 2120, 166, 2121, 166, 2139, 166, 2156, 166, 2265, 166, 2296,
+	166, 2343, 166, 341, 598, 570, 598, 571, 598, 1469, 598, 1497, 598, 1982,
+	598, 641, 1218, 19, 55, 47, 55, 104, 55, 86, 167, 436, 167, 1162, 1496,
+	1732, 167, 1737, 167, 1740, 167, 1778, 167, 1800, 1496, 1879, 167, 1954,
+	167, 1966, 167, 1976, 167, 1978, 167, 2023, 167, 2027, 167, 2042, 167, 2044,
+	167, 2047, 167, 2053, 167, 2054, 167, 2056, 167, 2065, 167, 2066, 167, 2068,
+	167, 2073, 167, 2076, 167, 2082, 167, 2085, 167, 2120, 167, 2121, 167, 2139,
+	167, 2156, 167, 2197, 167, 2201, 167, 2265, 167, 2296, 167, 2343, 167, 1162,
+	1497, 1800, 1497, 460, 711, 2301, 711, 2330, 711, 2333, 711, 2334, 711,
+	2336, 711, 2356, 711, 2357, 711, 2383, 711, 2384, 711, 2385, 711, 2391, 711,
+	2393, 711, 2404, 711, 2424, 711, 21, 73, 82, 73, 133, 73, 135, 73, 193, 73,
+	194, 73, 195, 73, 266, 73, 343, 73, 344, 73, 349, 73, 359, 73, 360, 73, 367,
+	73, 371, 73, 372, 73, 373, 73, 391, 73, 392, 73, 393, 73, 394, 73, 395, 73,
+	396, 73, 397, 73, 398, 73, 399, 73, 414, 73, 415, 73, 416, 73, 420, 73, 421,
+	73, 423, 73, 424, 73, 426, 73, 427, 73, 428, 73, 430, 73, 432, 73, 433, 73,
+	434, 73, 435, 73, 442, 73, 489, 73, 630, 73, 634, 73, 638, 73, 647, 73, 657,
+	73, 658, 73, 662, 73, 663, 73, 666, 73, 669, 73, 673, 73, 675, 73, 681, 73,
+	784, 73, 785, 73, 787, 73, 799, 73, 801, 73, 802, 73, 805, 73, 809, 73, 838,
+	73, 839, 73, 840, 73, 841, 73, 842, 73, 843, 73, 844, 73, 847, 73, 849, 73,
+	850, 73, 876, 73, 925, 73, 941, 73, 942, 73, 948, 73, 994, 73, 1013, 73,
+	1056, 73, 1057, 73, 1058, 73, 1070, 73, 1071, 73, 1075, 73, 1076, 73, 1104,
+	73, 1105, 73, 1106, 73, 1107, 73, 1116, 73, 1130, 73, 1166, 73, 1169, 73,
+	1215, 73, 1216, 73, 1221, 73, 1223, 73, 1225, 73, 1227, 73, 1228, 73, 1230,
+	73, 1231, 73, 1232, 73, 1233, 73, 1234, 73, 1235, 73, 1238, 73, 1239, 73,
+	1240, 73, 1243, 73, 1244, 73, 1246, 73, 1247, 73, 1249, 73, 1250, 73, 1251,
+	73, 1253, 73, 1255, 73, 1256, 73, 1257, 73, 1258, 73, 1273, 73, 1452, 73,
+	1455, 73, 1457, 73, 1462, 73, 1464, 73, 1467, 73, 1468, 73, 1476, 73, 1477,
+	73, 1484, 73, 1485, 73, 1486, 73, 1487, 73, 1488, 73, 1493, 73, 1494, 73,
+	1495, 73, 1496, 73, 1498, 73, 1504, 73, 1506, 73, 1507, 73, 1508, 73, 1511,
+	73, 1513, 73, 1514, 73, 1515, 73, 1518, 73, 1520, 73, 1522, 73, 1524, 73,
+	1525, 73, 1526, 73, 1527, 73, 1603, 73, 1604, 73, 1619, 73, 1620, 73, 1623,
+	73, 1624, 73, 1625, 73, 1626, 73, 1629, 73, 1639, 73, 1648, 73, 1649, 73,
+	1653, 73, 1654, 73, 1657, 73, 1660, 73, 1664, 73, 1666, 73, 1721, 73, 1727,
+	73, 1746, 73, 1748, 73, 1752, 73, 1753, 73, 1759, 73, 1760, 73, 1761, 73,
+	1762, 73, 1771, 73, 1772, 73, 1773, 73, 1775, 73, 1780, 73, 1781, 73, 1783,
+	73, 1788, 73, 1789, 73, 1792, 73, 1835, 73, 1843, 73, 1846, 73, 1872, 73,
+	1875, 73, 1878, 73, 1894, 73, 1895, 73, 1907, 73, 1908, 73, 1921, 73, 1933,
+	73, 1934, 73, 1935, 73, 1936, 73, 1943, 73, 1944, 73, 1945, 73, 1950, 73,
+	1956, 73, 1962, 73, 1964, 73, 1965, 73, 1967, 73, 1970, 73, 1971, 73, 1973,
+	73, 1977, 73, 1979, 73, 1985, 73, 2016, 73, 2018, 73, 2030, 73, 2031, 73,
+	2032, 73, 2033, 73, 2034, 73, 2035, 73, 2036, 73, 2055, 73, 2057, 73, 2061,
+	73, 2064, 73, 2074, 73, 2081, 73, 2093, 73, 2094, 73, 2113, 73, 2117, 73,
+	2122, 73, 2135, 73, 2153, 73, 2161, 73, 2162, 73, 2175, 73, 2178, 73, 2196,
+	73, 2197, 73, 2200, 73, 2201, 73, 2202, 73, 2209, 73, 2213, 73, 2216, 73,
+	2243, 73, 2250, 73, 2252, 73, 2273, 73, 2277, 73, 2279, 73, 2281, 73, 2285,
+	73, 2311, 73, 2326, 73, 2345, 73, 2355, 73, 2362, 73, 2363, 73, 2364, 73,
+	2407, 73, 2410, 73, 2426, 73, 2427, 73, 2431, 73, 2438, 73, 2441, 73, 2450,
+	73, 2451, 73, 1162, 1498, 1800, 1498, 267, 453, 19, 56, 47, 56, 104, 56, 19,
+	57, 47, 57, 104, 57, 1162, 1499, 1800, 1499, 1676, 1858, 1677, 1858, 2192,
+	1858, 2253, 1858, 2254, 1858, 1857, 2011, 1857, 2012, 86, 168, 436, 168,
+	1162, 1500, 1732, 168, 1737, 168, 1740, 168, 1778, 168, 1800, 1500, 1879,
+	168, 1954, 168, 1966, 168, 1976, 168, 1978, 168, 2023, 168, 2027, 168, 2042,
+	168, 2044, 168, 2047, 168, 2053, 168, 2054, 168, 2056, 168, 2065, 168, 2066,
+	168, 2068, 168, 2073, 168, 2076, 168, 2082, 168, 2085, 168, 2120, 168, 2121,
+	168, 2139, 168, 2156, 168, 2265, 168, 2296, 168, 2343, 168, 1730, 1885, 21,
+	74, 82, 74, 133, 74, 135, 74, 193, 74, 194, 74, 195, 74, 266, 74, 343, 74,
+	344, 74, 349, 74, 359, 74, 360, 74, 367, 74, 371, 74, 372, 74, 373, 74, 391,
+	74, 392, 74, 393, 74, 394, 74, 395, 74, 396, 74, 397, 74, 398, 74, 399, 74,
+	414, 74, 415, 74, 416, 74, 420, 74, 421, 74, 423, 74, 424, 74, 426, 74, 427,
+	74, 428, 74, 430, 74, 432, 74, 433, 74, 434, 74, 435, 74, 442, 74, 489, 74,
+	630, 74, 634, 74, 638, 74, 647, 74, 657, 74, 658, 74, 662, 74, 663, 74, 666,
+	74, 669, 74, 673, 74, 675, 74, 681, 74, 784, 74, 785, 74, 787, 74, 799, 74,
+	801, 74, 802, 74, 805, 74, 809, 74, 838, 74, 839, 74, 840, 74, 841, 74, 842,
+	74, 843, 74, 844, 74, 847, 74, 849, 74, 850, 74, 876, 74, 925, 74, 941, 74,
+	942, 74, 948, 74, 994, 74, 1013, 74, 1056, 74, 1057, 74, 1058, 74, 1070, 74,
+	1071, 74, 1075, 74, 1076, 74, 1104, 74, 1105, 74, 1106, 74, 1107, 74, 1116,
+	74, 1130, 74, 1166, 74, 1169, 74, 1215, 74, 1216, 74, 1221, 74, 1223, 74,
+	1225, 74, 1227, 74, 1228, 74, 1230, 74, 1231, 74, 1232, 74, 1233, 74, 1234,
+	74, 1235, 74, 1238, 74, 1239, 74, 1240, 74, 1243, 74, 1244, 74, 1246, 74,
+	1247, 74, 1249, 74, 1250, 74, 1251, 74, 1253, 74, 1255, 74, 1256, 74, 1257,
+	74, 1258, 74, 1273, 74, 1452, 74, 1455, 74, 1457, 74, 1462, 74, 1464, 74,
+	1467, 74, 1476, 74, 1477, 74, 1484, 74, 1485, 74, 1486, 74, 1487, 74, 1488,
+	74, 1493, 74, 1494, 74, 1495, 74, 1496, 74, 1498, 74, 1504, 74, 1506, 74,
+	1507, 74, 1508, 74, 1511, 74, 1513, 74, 1514, 74, 1515, 74, 1518, 74, 1520,
+	74, 1522, 74, 1524, 74, 1525, 74, 1526, 74, 1527, 74, 1603, 74, 1604, 74,
+	1619, 74, 1620, 74, 1623, 74, 1624, 74, 1625, 74, 1626, 74, 1629, 74, 1639,
+	74, 1648, 74, 1649, 74, 1653, 74, 1654, 74, 1657, 74, 1660, 74, 1664, 74,
+	1666, 74, 1721, 74, 1727, 74, 1746, 74, 1748, 74, 1752, 74, 1753, 74, 1759,
+	74, 1760, 74, 1761, 74, 1762, 74, 1771, 74, 1772, 74, 1773, 74, 1775, 74,
+	1780, 74, 1781, 74, 1783, 74, 1788, 74, 1789, 74, 1792, 74, 1835, 74, 1843,
+	74, 1846, 74, 1872, 74, 1875, 74, 1878, 74, 1894, 74, 1895, 74, 1907, 74,
+	1908, 74, 1921, 74, 1933, 74, 1934, 74, 1935, 74, 1936, 74, 1943, 74, 1944,
+	74, 1945, 74, 1950, 74, 1956, 74, 1962, 74, 1964, 74, 1965, 74, 1967, 74,
+	1971, 74, 1973, 74, 1977, 74, 1979, 74, 1985, 74, 2016, 74, 2018, 74, 2031,
+	74, 2032, 74, 2035, 74, 2055, 74, 2061, 74, 2064, 74, 2074, 74, 2081, 74,
+	2093, 74, 2094, 74, 2113, 74, 2117, 74, 2122, 74, 2135, 74, 2153, 74, 2161,
+	74, 2162, 74, 2175, 74, 2178, 74, 2196, 74, 2197, 74, 2200, 74, 2201, 74,
+	2202, 74, 2213, 74, 2243, 74, 2250, 74, 2252, 74, 2273, 74, 2279, 74, 2281,
+	74, 2285, 74, 2311, 74, 2345, 74, 2355, 74, 2362, 74, 2363, 74, 2364, 74,
+	2427, 74, 1730, 1886, 460, 712, 2301, 712, 2330, 712, 2333, 712, 2334, 712,
+	2336, 712, 2356, 712, 2357, 712, 2383, 712, 2384, 712, 2385, 712, 2391, 712,
+	2393, 712, 2404, 712, 2424, 712, 4, 20, 1751, 1926, 2271, 1926, 2286, 1926,
+	2291, 1926, 2348, 1926, 2392, 1926, 341, 599, 570, 599, 571, 599, 1469, 599,
+	1497, 599, 1982, 599, 86, 169, 436, 169, 1162, 1501, 1732, 169, 1737, 169,
+	1740, 169, 1778, 169, 1800, 1501, 1879, 169, 1954, 169, 1966, 169, 1976,
+	169, 1978, 169, 2023, 169, 2027, 169, 2042, 169, 2044, 169, 2047, 169, 2053,
+	169, 2054, 169, 2056, 169, 2065, 169, 2066, 169, 2068, 169, 2073, 169, 2076,
+	169, 2082, 169, 2085, 169, 2120, 169, 2121, 169, 2139, 169, 2156, 169, 2265,
+	169, 2296, 169, 2343, 169, 460, 713, 2301, 713, 2330, 713, 2333, 713, 2334,
+	713, 2336, 713, 2356, 713, 2357, 713, 2383, 713, 2384, 713, 2385, 713, 2391,
+	713, 2393, 713, 2404, 713, 2424, 713, 1162, 1502, 1800, 1502, 460, 714,
+	2301, 714, 2330, 714, 2333, 714, 2334, 714, 2336, 714, 2356, 714, 2357, 714,
+	2383, 714, 2384, 714, 2385, 714, 2391, 714, 2393, 714, 2404, 714, 2424, 714,
+	1730, 1887, 164, 404, 1492, 404, 629, 1182, 2343, 1182, 1746, 1915, 1747,
+	1915, 1748, 1915, 1752, 1915, 1753, 1915, 1759, 1915, 1760, 1915, 1775,
+	1915, 1781, 1915, 1982, 1915, 1746, 1916, 1747, 1916, 1748, 1916, 1752,
+	1916, 1753, 1916, 1759, 1916, 1760, 1916, 1775, 1916, 1781, 1916, 1982,
+	1916, 86, 170, 436, 170, 1732, 170, 1737, 170, 1740, 170, 1778, 170, 1879,
+	170, 1954, 170, 1966, 170, 1976, 170, 1978, 170, 2023, 170, 2027, 170, 2042,
+	170, 2044, 170, 2047, 170, 2053, 170, 2054, 170, 2056, 170, 2065, 170, 2066,
+	170, 2068, 170, 2073, 170, 2076, 170, 2082, 170, 2085, 170, 2120, 170, 2121,
+	170, 2139, 170, 2156, 170, 2265, 170, 2296, 170, 2343, 170, 629, 1183, 1166,
+	1606, 1603, 1606, 1604, 1606, 1899, 1606, 1946, 1606, 2189, 1606, 2343,
+	1183, 460, 715, 2301, 715, 2330, 715, 2333, 715, 2334, 715, 2336, 715, 2356,
+	715, 2357, 715, 2383, 715, 2384, 715, 2385, 715, 2391, 715, 2393, 715, 2404,
+	715, 2424, 715, 460, 716, 2301, 716, 2330, 716, 2333, 716, 2334, 716, 2336,
+	716, 2356, 716, 2357, 716, 2383, 716, 2384, 716, 2385, 716, 2391, 716, 2393,
+	716, 2404, 716, 2424, 716, 629, 1184, 2343, 1184, 460, 717, 2301, 717, 2330,
+	717, 2333, 717, 2334, 717, 2336, 717, 2356, 717, 2357, 717, 2383, 717, 2384,
+	717, 2385, 717, 2391, 717, 2393, 717, 2404, 717, 2424, 717, 267, 454, 460,
+	718, 2301, 718, 2330, 718, 2333, 718, 2334, 718, 2336, 718, 2356, 718, 2357,
+	718, 2383, 718, 2384, 718, 2385, 718, 2391, 718, 2393, 718, 2404, 718, 2424,
+	718, 460, 719, 629, 1185, 2301, 719, 2330, 719, 2333, 719, 2334, 719, 2336,
+	719, 2343, 1185, 2356, 719, 2357, 719, 2383, 719, 2384, 719, 2385, 719,
+	2391, 719, 2393, 719, 2404, 719, 2424, 719, 460, 720, 2301, 720, 2330, 720,
+	2333, 720, 2334, 720, 2336, 720, 2356, 720, 2357, 720, 2383, 720, 2384, 720,
+	2385, 720, 2391, 720, 2393, 720, 2404, 720, 2424, 720, 460, 721, 2301, 721,
+	2330, 721, 2333, 721, 2334, 721, 2336, 721, 2356, 721, 2357, 721, 2383, 721,
+	2384, 721, 2385, 721, 2391, 721, 2393, 721, 2404, 721, 2424, 721, 460, 722,
+	2301, 722, 2330, 722, 2333, 722, 2334, 722, 2336, 722, 2356, 722, 2357, 722,
+	2383, 722, 2384, 722, 2385, 722, 2391, 722, 2393, 722, 2404, 722, 2424, 722,
+	86, 171, 436, 171, 1732, 171, 1737, 171, 1738, 171, 1739, 171, 1740, 171,
+	1778, 171, 1879, 171, 1954, 171, 1966, 171, 1976, 171, 1978, 171, 2023, 171,
+	2027, 171, 2042, 171, 2044, 171, 2047, 171, 2053, 171, 2054, 171, 2056, 171,
+	2065, 171, 2066, 171, 2068, 171, 2073, 171, 2076, 171, 2082, 171, 2085, 171,
+	2120, 171, 2121, 171, 2139, 171, 2156, 171, 2265, 171, 2296, 171, 2343, 171,
+	460, 723, 2301, 723, 2330, 723, 2333, 723, 2334, 723, 2336, 723, 2356, 723,
+	2357, 723, 2383, 723, 2384, 723, 2385, 723, 2391, 723, 2393, 723, 2404, 723,
+	2424, 723, 629, 1186, 1166, 1607, 1603, 1607, 1604, 1607, 1899, 1607, 1946,
+	1607, 2189, 1607, 2343, 1186, 460, 724, 2301, 724, 2330, 724, 2333, 724,
+	2334, 724, 2336, 724, 2356, 724, 2357, 724, 2383, 724, 2384, 724, 2385, 724,
+	2391, 724, 2393, 724, 2404, 724, 2424, 724, 460, 725, 2301, 725, 2330, 725,
+	2333, 725, 2334, 725, 2336, 725, 2356, 725, 2357, 725, 2383, 725, 2384, 725,
+	2385, 725, 2391, 725, 2393, 725, 2404, 725, 2424, 725, 460, 726, 2301, 726,
+	2330, 726, 2333, 726, 2334, 726, 2336, 726, 2356, 726, 2357, 726, 2383, 726,
+	2384, 726, 2385, 726, 2391, 726, 2393, 726, 2404, 726, 2424, 726, 460, 727,
+	2301, 727, 2330, 727, 2333, 727, 2334, 727, 2336, 727, 2356, 727, 2357, 727,
+	2383, 727, 2384, 727, 2385, 727, 2391, 727, 2393, 727, 2404, 727, 2424, 727,
+	460, 728, 2301, 728, 2330, 728, 2333, 728, 2334, 728, 2336, 728, 2356, 728,
+	2357, 728, 2383, 728, 2384, 728, 2385, 728, 2391, 728, 2393, 728, 2404, 728,
+	2424, 728, 460, 729, 2301, 729, 2330, 729, 2333, 729, 2334, 729, 2336, 729,
+	2356, 729, 2357, 729, 2383, 729, 2384, 729, 2385, 729, 2391, 729, 2393, 729,
+	2404, 729, 2424, 729, 1162, 1503, 1800, 1503, 629, 1187, 1166, 1608, 1603,
+	1608, 1604, 1608, 1899, 1608, 1946, 1608, 2189, 1608, 2343, 1187, 358, 632,
+	418, 632, 425, 632, 429, 632, 1721, 632, 1773, 632, 1783, 632, 1789, 632,
+	1746, 1917, 1747, 1917, 1748, 1917, 1752, 1917, 1753, 1917, 1759, 1917,
+	1760, 1917, 1775, 1917, 1781, 1917, 1982, 1917, 86, 172, 436, 172, 489, 172,
+	784, 172, 785, 172, 787, 172, 799, 172, 801, 172, 802, 172, 805, 172, 809,
+	172, 838, 172, 839, 172, 840, 172, 841, 172, 842, 172, 843, 172, 844, 172,
+	847, 172, 849, 172, 850, 172, 876, 172, 925, 172, 941, 172, 942, 172, 948,
+	172, 994, 172, 1013, 172, 1056, 172, 1057, 172, 1058, 172, 1070, 172, 1071,
+	172, 1075, 172, 1076, 172, 1104, 172, 1105, 172, 1106, 172, 1107, 172, 1116,
+	172, 1130, 172, 1452, 172, 1457, 172, 1732, 172, 1737, 172, 1740, 172, 1778,
+	172, 1879, 172, 1954, 172, 1966, 172, 1976, 172, 1978, 172, 2023, 172, 2027,
+	172, 2042, 172, 2044, 172, 2047, 172, 2053, 172, 2054, 172, 2056, 172, 2065,
+	172, 2066, 172, 2068, 172, 2073, 172, 2076, 172, 2082, 172, 2085, 172, 2120,
+	172, 2121, 172, 2139, 172, 2156, 172, 2265, 172, 2296, 172, 2343, 172, 460,
+	730, 2301, 730, 2330, 730, 2333, 730, 2334, 730, 2336, 730, 2356, 730, 2357,
+	730, 2383, 730, 2384, 730, 2385, 730, 2391, 730, 2393, 730, 2404, 730, 2424,
+	730, 358, 633, 418, 633, 425, 633, 429, 633, 1721, 633, 1773, 633, 1783,
+	633, 1789, 633, 153, 375, 1747, 375, 153, 376, 1747, 376, 153, 377, 1747,
+	377, 153, 378, 1747, 378, 153, 379, 1747, 379, 153, 380, 1747, 380, 266,
+	448, 460, 731, 2301, 731, 2330, 731, 2333, 731, 2334, 731, 2336, 731, 2356,
+	731, 2357, 731, 2383, 731, 2384, 731, 2385, 731, 2391, 731, 2393, 731, 2404,
+	731, 2424, 731, 460, 732, 2301, 732, 2330, 732, 2333, 732, 2334, 732, 2336,
+	732, 2356, 732, 2357, 732, 2383, 732, 2384, 732, 2385, 732, 2391, 732, 2393,
+	732, 2404, 732, 2424, 732, 460, 733, 2301, 733, 2330, 733, 2333, 733, 2334,
+	733, 2336, 733, 2356, 733, 2357, 733, 2383, 733, 2384, 733, 2385, 733, 2391,
+	733, 2393, 733, 2404, 733, 2424, 733, 86, 173, 436, 173, 1162, 1504, 1730,
+	1888, 1732, 173, 1737, 173, 1740, 173, 1778, 173, 1800, 1504, 1879, 173,
+	1954, 173, 1966, 173, 1976, 173, 1978, 173, 2023, 173, 2027, 173, 2042, 173,
+	2044, 173, 2047, 173, 2053, 173, 2054, 173, 2056, 173, 2065, 173, 2066, 173,
+	2068, 173, 2073, 173, 2076, 173, 2082, 173, 2085, 173, 2120, 173, 2121, 173,
+	2139, 173, 2156, 173, 2265, 173, 2296, 173, 2343, 173, 153, 381, 1747, 381,
+	2330, 2361, 2331, 2361, 2332, 2361, 2356, 2361, 2357, 2361, 2330, 2362,
+	2356, 2362, 2357, 2362, 1162, 1505, 1800, 1505, 86, 174, 436, 174, 1732,
+	174, 1737, 174, 1740, 174, 1778, 174, 1879, 174, 1954, 174, 1966, 174, 1976,
+	174, 1978, 174, 2023, 174, 2027, 174, 2042, 174, 2044, 174, 2047, 174, 2053,
+	174, 2054, 174, 2056, 174, 2065, 174, 2066, 174, 2068, 174, 2073, 174, 2076,
+	174, 2082, 174, 2085, 174, 2120, 174, 2121, 174, 2139, 174, 2156, 174, 2265,
+	174, 2296, 174, 2343, 174, 21, 75, 82, 75, 133, 75, 135, 75, 193, 75, 194,
+	75, 195, 75, 266, 75, 343, 75, 344, 75, 349, 75, 359, 75, 360, 75, 367, 75,
+	371, 75, 372, 75, 373, 75, 391, 75, 392, 75, 393, 75, 394, 75, 395, 75, 396,
+	75, 397, 75, 398, 75, 399, 75, 414, 75, 415, 75, 416, 75, 420, 75, 421, 75,
+	423, 75, 424, 75, 426, 75, 427, 75, 428, 75, 430, 75, 432, 75, 433, 75, 434,
+	75, 435, 75, 442, 75, 489, 75, 630, 75, 634, 75, 638, 75, 647, 75, 657, 75,
+	658, 75, 662, 75, 663, 75, 666, 75, 669, 75, 673, 75, 675, 75, 681, 75, 784,
+	75, 785, 75, 787, 75, 799, 75, 801, 75, 802, 75, 805, 75, 809, 75, 838, 75,
+	839, 75, 840, 75, 841, 75, 842, 75, 843, 75, 844, 75, 847, 75, 849, 75, 850,
+	75, 876, 75, 925, 75, 941, 75, 942, 75, 948, 75, 994, 75, 1013, 75, 1056,
+	75, 1057, 75, 1058, 75, 1070, 75, 1071, 75, 1075, 75, 1076, 75, 1104, 75,
+	1105, 75, 1106, 75, 1107, 75, 1116, 75, 1130, 75, 1166, 75, 1169, 75, 1215,
+	75, 1216, 75, 1221, 75, 1223, 75, 1225, 75, 1227, 75, 1228, 75, 1230, 75,
+	1231, 75, 1232, 75, 1233, 75, 1234, 75, 1235, 75, 1238, 75, 1239, 75, 1240,
+	75, 1243, 75, 1244, 75, 1246, 75, 1247, 75, 1249, 75, 1250, 75, 1251, 75,
+	1253, 75, 1255, 75, 1256, 75, 1257, 75, 1258, 75, 1273, 75, 1452, 75, 1455,
+	75, 1457, 75, 1462, 75, 1464, 75, 1467, 75, 1476, 75, 1477, 75, 1484, 75,
+	1485, 75, 1486, 75, 1487, 75, 1488, 75, 1493, 75, 1494, 75, 1495, 75, 1496,
+	75, 1498, 75, 1504, 75, 1506, 75, 1507, 75, 1508, 75, 1511, 75, 1513, 75,
+	1514, 75, 1515, 75, 1518, 75, 1520, 75, 1522, 75, 1524, 75, 1525, 75, 1526,
+	75, 1527, 75, 1603, 75, 1604, 75, 1619, 75, 1620, 75, 1623, 75, 1624, 75,
+	1625, 75, 1626, 75, 1629, 75, 1639, 75, 1648, 75, 1649, 75, 1653, 75, 1654,
+	75, 1657, 75, 1660, 75, 1664, 75, 1666, 75, 1721, 75, 1727, 75, 1746, 75,
+	1748, 75, 1752, 75, 1753, 75, 1759, 75, 1760, 75, 1761, 75, 1762, 75, 1771,
+	75, 1772, 75, 1773, 75, 1775, 75, 1780, 75, 1781, 75, 1783, 75, 1788, 75,
+	1789, 75, 1792, 75, 1835, 75, 1843, 75, 1846, 75, 1872, 75, 1875, 75, 1878,
+	75, 1894, 75, 1895, 75, 1907, 75, 1908, 75, 1921, 75, 1933, 75, 1934, 75,
+	1935, 75, 1936, 75, 1943, 75, 1944, 75, 1945, 75, 1950, 75, 1956, 75, 1962,
+	75, 1964, 75, 1965, 75, 1967, 75, 1971, 75, 1973, 75, 1977, 75, 1979, 75,
+	1985, 75, 2016, 75, 2018, 75, 2031, 75, 2032, 75, 2035, 75, 2055, 75, 2061,
+	75, 2064, 75, 2074, 75, 2081, 75, 2093, 75, 2094, 75, 2113, 75, 2117, 75,
+	2122, 75, 2135, 75, 2153, 75, 2161, 75, 2162, 75, 2175, 75, 2178, 75, 2196,
+	75, 2197, 75, 2200, 75, 2201, 75, 2202, 75, 2213, 75, 2243, 75, 2250, 75,
+	2252, 75, 2273, 75, 2279, 75, 2281, 75, 2285, 75, 2311, 75, 2345, 75, 2355,
+	75, 2362, 75, 2363, 75, 2364, 75, 2427, 75, 460, 734, 629, 734, 2301, 734,
+	2330, 734, 2333, 734, 2334, 734, 2336, 734, 2343, 734, 2356, 734, 2357, 734,
+	2383, 734, 2384, 734, 2385, 734, 2391, 734, 2393, 734, 2404, 734, 2424, 734,
+	2330, 2363, 2356, 2363, 2357, 2363, 341, 600, 570, 600, 571, 600, 1469, 600,
+	1497, 600, 1982, 600, 341, 601, 570, 601, 571, 601, 1469, 601, 1497, 601,
+	1982, 601, 19, 58, 47, 58, 104, 58, 2330, 2364, 2356, 2364, 2357, 2364, 65,
+	120, 116, 120, 117, 120, 446, 120, 447, 120, 21, 76, 82, 76, 133, 76, 135,
+	76, 193, 76, 194, 76, 195, 76, 266, 76, 343, 76, 344, 76, 349, 76, 359, 76,
+	360, 76, 367, 76, 371, 76, 372, 76, 373, 76, 391, 76, 392, 76, 393, 76, 394,
+	76, 395, 76, 396, 76, 397, 76, 398, 76, 399, 76, 414, 76, 415, 76, 416, 76,
+	420, 76, 421, 76, 423, 76, 424, 76, 426, 76, 427, 76, 428, 76, 430, 76, 432,
+	76, 433, 76, 434, 76, 435, 76, 442, 76, 489, 76, 630, 76, 634, 76, 638, 76,
+	647, 76, 657, 76, 658, 76, 662, 76, 663, 76, 666, 76, 669, 76, 673, 76, 675,
+	76, 681, 76, 784, 76, 785, 76, 787, 76, 799, 76, 801, 76, 802, 76, 805, 76,
+	809, 76, 838, 76, 839, 76, 840, 76, 841, 76, 842, 76, 843, 76, 844, 76, 847,
+	76, 849, 76, 850, 76, 876, 76, 925, 76, 941, 76, 942, 76, 948, 76, 994, 76,
+	1013, 76, 1056, 76, 1057, 76, 1058, 76, 1070, 76, 1071, 76, 1075, 76, 1076,
+	76, 1104, 76, 1105, 76, 1106, 76, 1107, 76, 1116, 76, 1130, 76, 1166, 76,
+	1169, 76, 1215, 76, 1216, 76, 1221, 76, 1223, 76, 1225, 76, 1227, 76, 1228,
+	76, 1230, 76, 1231, 76, 1232, 76, 1233, 76, 1234, 76, 1235, 76, 1238, 76,
+	1239, 76, 1240, 76, 1243, 76, 1244, 76, 1246, 76, 1247, 76, 1249, 76, 1250,
+	76, 1251, 76, 1253, 76, 1255, 76, 1256, 76, 1257, 76, 1258, 76, 1273, 76,
+	1452, 76, 1455, 76, 1457, 76, 1462, 76, 1464, 76, 1467, 76, 1476, 76, 1477,
+	76, 1484, 76, 1485, 76, 1486, 76, 1487, 76, 1488, 76, 1493, 76, 1494, 76,
+	1495, 76, 1496, 76, 1498, 76, 1504, 76, 1506, 76, 1507, 76, 1508, 76, 1511,
+	76, 1513, 76, 1514, 76, 1515, 76, 1518, 76, 1520, 76, 1522, 76, 1524, 76,
+	1525, 76, 1526, 76, 1527, 76, 1603, 76, 1604, 76, 1619, 76, 1620, 76, 1623,
+	76, 1624, 76, 1625, 76, 1626, 76, 1629, 76, 1639, 76, 1648, 76, 1649, 76,
+	1653, 76, 1654, 76, 1657, 76, 1660, 76, 1664, 76, 1666, 76, 1721, 76, 1727,
+	76, 1746, 76, 1748, 76, 1752, 76, 1753, 76, 1759, 76, 1760, 76, 1761, 76,
+	1762, 76, 1771, 76, 1772, 76, 1773, 76, 1775, 76, 1780, 76, 1781, 76, 1783,
+	76, 1788, 76, 1789, 76, 1792, 76, 1835, 76, 1843, 76, 1846, 76, 1872, 76,
+	1875, 76, 1878, 76, 1894, 76, 1895, 76, 1907, 76, 1908, 76, 1921, 76, 1933,
+	76, 1934, 76, 1935, 76, 1936, 76, 1943, 76, 1944, 76, 1945, 76, 1950, 76,
+	1956, 76, 1962, 76, 1964, 76, 1965, 76, 1967, 76, 1971, 76, 1973, 76, 1977,
+	76, 1979, 76, 1985, 76, 2016, 76, 2018, 76, 2031, 76, 2032, 76, 2035, 76,
+	2055, 76, 2061, 76, 2064, 76, 2074, 76, 2081, 76, 2093, 76, 2094, 76, 2113,
+	76, 2117, 76, 2122, 76, 2135, 76, 2153, 76, 2161, 76, 2162, 76, 2175, 76,
+	2178, 76, 2196, 76, 2197, 76, 2200, 76, 2201, 76, 2202, 76, 2213, 76, 2243,
+	76, 2250, 76, 2252, 76, 2273, 76, 2279, 76, 2281, 76, 2285, 76, 2311, 76,
+	2345, 76, 2355, 76, 2362, 76, 2363, 76, 2364, 76, 2427, 76, 86, 175, 436,
+	175, 1162, 1506, 1732, 175, 1737, 175, 1740, 175, 1778, 175, 1800, 1506,
+	1879, 175, 1954, 175, 1966, 175, 1976, 175, 1978, 175, 2023, 175, 2027, 175,
+	2042, 175, 2044, 175, 2047, 175, 2053, 175, 2054, 175, 2056, 175, 2065, 175,
+	2066, 175, 2068, 175, 2073, 175, 2076, 175, 2082, 175, 2085, 175, 2120, 175,
+	2121, 175, 2139, 175, 2156, 175, 2265, 175, 2296, 175, 2343, 175, 341, 602,
+	570, 602, 571, 602, 1469, 602, 1497, 602, 1982, 602, 341, 603, 570, 603,
+	571, 603, 1469, 603, 1497, 603, 1982, 603, 460, 735, 629, 1188, 2301, 735,
+	2330, 735, 2333, 735, 2334, 735, 2336, 735, 2343, 1188, 2356, 735, 2357,
+	735, 2383, 735, 2384, 735, 2385, 735, 2391, 735, 2393, 735, 2404, 735, 2424,
+	735, 460, 736, 629, 1189, 2301, 736, 2330, 736, 2333, 736, 2334, 736, 2336,
+	736, 2343, 1189, 2356, 736, 2357, 736, 2383, 736, 2384, 736, 2385, 736,
+	2391, 736, 2393, 736, 2404, 736, 2424, 736, 1746, 1918, 1747, 1918, 1748,
+	1918, 1752, 1918, 1753, 1918, 1759, 1918, 1760, 1918, 1775, 1918, 1781,
+	1918, 1982, 1918, 1751, 1927, 2271, 1927, 2286, 1927, 2291, 1927, 2348,
+	1927, 2392, 1927, 1162, 1507, 1162, 1508, 629, 1190, 2343, 1190, 460, 737,
+	2301, 737, 2330, 737, 2333, 737, 2334, 737, 2336, 737, 2356, 737, 2357, 737,
+	2383, 737, 2384, 737, 2385, 737, 2391, 737, 2393, 737, 2404, 737, 2424, 737,
+	460, 738, 2301, 738, 2330, 738, 2333, 738, 2334, 738, 2336, 738, 2356, 738,
+	2357, 738, 2383, 738, 2384, 738, 2385, 738, 2391, 738, 2393, 738, 2404, 738,
+	2424, 738, 267, 455, 460, 739, 2301, 739, 2330, 739, 2333, 739, 2334, 739,
+	2336, 739, 2356, 739, 2357, 739, 2383, 739, 2384, 739, 2385, 739, 2391, 739,
+	2393, 739, 2404, 739, 2424, 739, 460, 740, 2301, 740, 2330, 740, 2333, 740,
+	2334, 740, 2336, 740, 2356, 740, 2357, 740, 2383, 740, 2384, 740, 2385, 740,
+	2391, 740, 2393, 740, 2404, 740, 2424, 740, 460, 741, 2301, 741, 2330, 741,
+	2333, 741, 2334, 741, 2336, 741, 2356, 741, 2357, 741, 2383, 741, 2384, 741,
+	2385, 741, 2391, 741, 2393, 741, 2404, 741, 2424, 741, 460, 742, 2301, 742,
+	2330, 742, 2333, 742, 2334, 742, 2336, 742, 2356, 742, 2357, 742, 2383, 742,
+	2384, 742, 2385, 742, 2391, 742, 2393, 742, 2404, 742, 2424, 742, 460, 743,
+	2301, 743, 2330, 743, 2333, 743, 2334, 743, 2336, 743, 2356, 743, 2357, 743,
+	2383, 743, 2384, 743, 2385, 743, 2391, 743, 2393, 743, 2404, 743, 2424, 743,
+	86, 176, 436, 176, 1162, 1509, 1732, 176, 1737, 176, 1740, 176, 1778, 176,
+	1800, 1509, 1879, 176, 1954, 176, 1966, 176, 1976, 176, 1978, 176, 2023,
+	176, 2027, 176, 2042, 176, 2044, 176, 2047, 176, 2053, 176, 2054, 176, 2056,
+	176, 2065, 176, 2066, 176, 2068, 176, 2073, 176, 2076, 176, 2082, 176, 2085,
+	176, 2120, 176, 2121, 176, 2139, 176, 2156, 176, 2265, 176, 2296, 176, 2343,
+	176, 2330, 2365, 2332, 2365, 2356, 2365, 2357, 2365, 86, 177, 436, 177,
+	1162, 1510, 1732, 177, 1737, 177, 1740, 177, 1778, 177, 1800, 1510, 1879,
+	177, 1954, 177, 1966, 177, 1976, 177, 1978, 177, 2023, 177, 2027, 177, 2042,
+	177, 2044, 177, 2047, 177, 2053, 177, 2054, 177, 2056, 177, 2065, 177, 2066,
+	177, 2068, 177, 2073, 177, 2076, 177, 2082, 177, 2085, 177, 2120, 177, 2121,
+	177, 2139, 177, 2156, 177, 2265, 177, 2296, 177, 2343, 177, 1751, 1928,
+	2271, 1928, 2286, 1928, 2291, 1928, 2348, 1928, 2392, 1928, 86, 178, 436,
+	178, 1162, 1511, 1732, 178, 1737, 178, 1740, 178, 1778, 178, 1800, 1511,
+	1879, 178, 1954, 178, 1966, 178, 1976, 178, 1978, 178, 2023, 178, 2027, 178,
+	2042, 178, 2044, 178, 2047, 178, 2053, 178, 2054, 178, 2056, 178, 2065, 178,
+	2066, 178, 2068, 178, 2073, 178, 2076, 178, 2082, 178, 2085, 178, 2120, 178,
+	2121, 178, 2139, 178, 2156, 178, 2265, 178, 2296, 178, 2343, 178, 164, 405,
+	1492, 405, 164, 406, 1492, 406, 460, 744, 2301, 744, 2330, 744, 2333, 744,
+	2334, 744, 2336, 744, 2356, 744, 2357, 744, 2383, 744, 2384, 744, 2385, 744,
+	2391, 744, 2393, 744, 2404, 744, 2424, 744, 86, 179, 436, 179, 1162, 1512,
+	1732, 179, 1737, 179, 1740, 179, 1778, 179, 1800, 1512, 1879, 179, 1954,
+	179, 1966, 179, 1976, 179, 1978, 179, 2023, 179, 2027, 179, 2042, 179, 2044,
+	179, 2047, 179, 2053, 179, 2054, 179, 2056, 179, 2065, 179, 2066, 179, 2068,
+	179, 2073, 179, 2076, 179, 2082, 179, 2085, 179, 2120, 179, 2121, 179, 2139,
+	179, 2156, 179, 2265, 179, 2296, 179, 2343, 179, 86, 180, 436, 180, 1162,
+	1513, 1732, 180, 1737, 180, 1740, 180, 1778, 180, 1800, 1513, 1879, 180,
+	1954, 180, 1966, 180, 1976, 180, 1978, 180, 2023, 180, 2027, 180, 2042, 180,
+	2044, 180, 2047, 180, 2053, 180, 2054, 180, 2056, 180, 2065, 180, 2066, 180,
+	2068, 180, 2073, 180, 2076, 180, 2082, 180, 2085, 180, 2120, 180, 2121, 180,
+	2139, 180, 2156, 180, 2265, 180, 2296, 180, 2343, 180, 144, 364, 629, 1191,
+	1166, 1609, 1603, 1609, 1604, 1609, 1899, 1609, 1946, 1609, 2189, 1609,
+	2343, 1191, 86, 181, 436, 181, 1162, 1514, 1732, 181, 1737, 181, 1740, 181,
+	1778, 181, 1800, 1514, 1879, 181, 1954, 181, 1966, 181, 1976, 181, 1978,
+	181, 2023, 181, 2027, 181, 2042, 181, 2044, 181, 2047, 181, 2053, 181, 2054,
+	181, 2056, 181, 2065, 181, 2066, 181, 2068, 181, 2073, 181, 2076, 181, 2082,
+	181, 2085, 181, 2120, 181, 2121, 181, 2139, 181, 2156, 181, 2265, 181, 2296,
+	181, 2343, 181, 164, 407, 1492, 407, 164, 408, 1492, 408, 1, 2, 460, 745,
+	2301, 745, 2330, 745, 2333, 745, 2334, 745, 2336, 745, 2356, 745, 2357, 745,
+	2383, 745, 2384, 745, 2385, 745, 2391, 745, 2393, 745, 2404, 745, 2424, 745,
+	460, 746, 2301, 746, 2330, 746, 2333, 746, 2334, 746, 2336, 746, 2356, 746,
+	2357, 746, 2383, 746, 2384, 746, 2385, 746, 2391, 746, 2393, 746, 2404, 746,
+	2424, 746, 341, 604, 570, 604, 571, 604, 1469, 604, 1497, 604, 1982, 604,
+	341, 605, 570, 605, 571, 605, 1469, 605, 1497, 605, 1982, 605, 86, 182, 436,
+	182, 1162, 1515, 1732, 182, 1737, 182, 1740, 182, 1778, 182, 1800, 1515,
+	1879, 182, 1954, 182, 1966, 182, 1976, 182, 1978, 182, 2023, 182, 2027, 182,
+	2042, 182, 2044, 182, 2047, 182, 2053, 182, 2054, 182, 2056, 182, 2065, 182,
+	2066, 182, 2068, 182, 2073, 182, 2076, 182, 2082, 182, 2085, 182, 2120, 182,
+	2121, 182, 2139, 182, 2156, 182, 2265, 182, 2296, 182, 2343, 182, 629, 1192,
+	2343, 1192, 460, 747, 2301, 747, 2330, 747, 2333, 747, 2334, 747, 2336, 747,
+	2356, 747, 2357, 747, 2383, 747, 2384, 747, 2385, 747, 2391, 747, 2393, 747,
+	2404, 747, 2424, 747, 460, 748, 2301, 748, 2330, 748, 2333, 748, 2334, 748,
+	2336, 748, 2356, 748, 2357, 748, 2383, 748, 2384, 748, 2385, 748, 2391, 748,
+	2393, 748, 2404, 748, 2424, 748, 460, 749, 2301, 749, 2330, 749, 2333, 749,
+	2334, 749, 2336, 749, 2356, 749, 2357, 749, 2383, 749, 2384, 749, 2385, 749,
+	2391, 749, 2393, 749, 2404, 749, 2424, 749, 1162, 1516, 460, 750, 2301, 750,
+	2330, 750, 2333, 750, 2334, 750, 2336, 750, 2356, 750, 2357, 750, 2383, 750,
+	2384, 750, 2385, 750, 2391, 750, 2393, 750, 2404, 750, 2424, 750, 86, 183,
+	436, 183, 1162, 1517, 1730, 1889, 1732, 183, 1737, 183, 1740, 183, 1778,
+	183, 1800, 1517, 1879, 183, 1954, 183, 1966, 183, 1976, 183, 1978, 183,
+	2023, 183, 2027, 183, 2042, 183, 2044, 183, 2047, 183, 2053, 183, 2054, 183,
+	2056, 183, 2065, 183, 2066, 183, 2068, 183, 2073, 183, 2076, 183, 2082, 183,
+	2085, 183, 2120, 183, 2121, 183, 2139, 183, 2156, 183, 2265, 183, 2296, 183,
+	2343, 183, 629, 1193, 2343, 1193, 341, 606, 570, 606, 571, 606, 1469, 606,
+	1497, 606, 1982, 606, 629, 1194, 1725, 1876, 2343, 1194, 629, 1195, 2343,
+	1195, 341, 607, 570, 607, 571, 607, 1469, 607, 1497, 607, 1982, 607, 1162,
+	1518, 1481, 1749, 2208, 1749, 2235, 1749, 2246, 1749, 2316, 1749, 1162,
+	1519, 1800, 1519, 341, 608, 570, 608, 571, 608, 1469, 608, 1497, 608, 1982,
+	608, 1, 3, 1265, 1674, 1266, 1674, 636, 1215, 642, 1221, 651, 1230, 652,
+	1231, 653, 1232, 654, 1233, 661, 1240, 665, 1244, 668, 1247, 671, 1250, 674,
+	1253, 676, 1255, 679, 1258, 1722, 1875, 1731, 1895, 1754, 1933, 1755, 1934,
+	1756, 1935, 1757, 1936, 1766, 1945, 1776, 1956, 1782, 1962, 1785, 1965,
+	1791, 1971, 1793, 1973, 1799, 1979, 1901, 2033, 2036, 2131, 2277, 2131,
+	2391, 2407, 2393, 2410, 2429, 2438, 2432, 2441, 21, 77, 82, 77, 133, 77,
+	135, 77, 193, 77, 194, 77, 195, 77, 266, 77, 343, 77, 344, 77, 349, 77, 359,
+	77, 360, 77, 367, 77, 371, 77, 372, 77, 373, 77, 391, 77, 392, 77, 393, 77,
+	394, 77, 395, 77, 396, 77, 397, 77, 398, 77, 399, 77, 414, 77, 415, 77, 416,
+	77, 420, 77, 421, 77, 423, 77, 424, 77, 426, 77, 427, 77, 428, 77, 430, 77,
+	432, 77, 433, 77, 434, 77, 435, 77, 442, 77, 489, 77, 630, 77, 634, 77, 638,
+	77, 647, 77, 657, 77, 658, 77, 662, 77, 663, 77, 666, 77, 669, 77, 673, 77,
+	675, 77, 681, 77, 784, 77, 785, 77, 787, 77, 799, 77, 801, 77, 802, 77, 805,
+	77, 809, 77, 838, 77, 839, 77, 840, 77, 841, 77, 842, 77, 843, 77, 844, 77,
+	847, 77, 849, 77, 850, 77, 876, 77, 925, 77, 941, 77, 942, 77, 948, 77, 994,
+	77, 1013, 77, 1056, 77, 1057, 77, 1058, 77, 1070, 77, 1071, 77, 1075, 77,
+	1076, 77, 1104, 77, 1105, 77, 1106, 77, 1107, 77, 1116, 77, 1130, 77, 1166,
+	77, 1169, 77, 1215, 77, 1216, 77, 1221, 77, 1223, 77, 1225, 77, 1227, 77,
+	1228, 77, 1230, 77, 1231, 77, 1232, 77, 1233, 77, 1234, 77, 1235, 77, 1238,
+	77, 1239, 77, 1240, 77, 1243, 77, 1244, 77, 1246, 77, 1247, 77, 1249, 77,
+	1250, 77, 1251, 77, 1253, 77, 1255, 77, 1256, 77, 1257, 77, 1258, 77, 1273,
+	77, 1452, 77, 1455, 77, 1457, 77, 1462, 77, 1464, 77, 1467, 77, 1476, 77,
+	1477, 77, 1484, 77, 1485, 77, 1486, 77, 1487, 77, 1488, 77, 1493, 77, 1494,
+	77, 1495, 77, 1496, 77, 1498, 77, 1504, 77, 1506, 77, 1507, 77, 1508, 77,
+	1511, 77, 1513, 77, 1514, 77, 1515, 77, 1518, 77, 1520, 77, 1522, 77, 1524,
+	77, 1525, 77, 1526, 77, 1527, 77, 1603, 77, 1604, 77, 1619, 77, 1620, 77,
+	1623, 77, 1624, 77, 1625, 77, 1626, 77, 1629, 77, 1639, 77, 1648, 77, 1649,
+	77, 1653, 77, 1654, 77, 1657, 77, 1660, 77, 1664, 77, 1666, 77, 1721, 77,
+	1727, 77, 1746, 77, 1748, 77, 1752, 77, 1753, 77, 1759, 77, 1760, 77, 1761,
+	77, 1762, 77, 1771, 77, 1772, 77, 1773, 77, 1775, 77, 1780, 77, 1781, 77,
+	1783, 77, 1788, 77, 1789, 77, 1792, 77, 1835, 77, 1843, 77, 1846, 77, 1872,
+	77, 1875, 77, 1878, 77, 1894, 77, 1895, 77, 1907, 77, 1908, 77, 1921, 77,
+	1933, 77, 1934, 77, 1935, 77, 1936, 77, 1943, 77, 1944, 77, 1945, 77, 1950,
+	77, 1956, 77, 1962, 77, 1964, 77, 1965, 77, 1967, 77, 1971, 77, 1973, 77,
+	1977, 77, 1979, 77, 1985, 77, 2016, 77, 2018, 77, 2031, 77, 2032, 77, 2035,
+	77, 2055, 77, 2061, 77, 2064, 77, 2074, 77, 2081, 77, 2093, 77, 2094, 77,
+	2113, 77, 2117, 77, 2122, 77, 2135, 77, 2153, 77, 2161, 77, 2162, 77, 2175,
+	77, 2178, 77, 2196, 77, 2197, 77, 2200, 77, 2201, 77, 2202, 77, 2213, 77,
+	2243, 77, 2250, 77, 2252, 77, 2273, 77, 2279, 77, 2281, 77, 2285, 77, 2311,
+	77, 2345, 77, 2355, 77, 2362, 77, 2363, 77, 2364, 77, 2427, 77, 3, 11, 86,
+	184, 153, 382, 436, 184, 796, 184, 803, 184, 808, 184, 813, 184, 893, 184,
+	922, 184, 923, 184, 995, 184, 1024, 184, 1036, 184, 1123, 184, 1732, 184,
+	1737, 184, 1740, 184, 1747, 382, 1778, 184, 1879, 184, 1954, 184, 1966, 184,
+	1976, 184, 1978, 184, 2023, 184, 2027, 184, 2042, 184, 2044, 184, 2047, 184,
+	2053, 184, 2054, 184, 2056, 184, 2065, 184, 2066, 184, 2068, 184, 2073, 184,
+	2076, 184, 2082, 184, 2085, 184, 2120, 184, 2121, 184, 2139, 184, 2156, 184,
+	2265, 184, 2296, 184, 2343, 184, 86, 185, 436, 185, 1162, 1520, 1732, 185,
+	1737, 185, 1740, 185, 1778, 185, 1800, 1520, 1879, 185, 1954, 185, 1966,
+	185, 1976, 185, 1978, 185, 2023, 185, 2027, 185, 2042, 185, 2044, 185, 2047,
+	185, 2053, 185, 2054, 185, 2056, 185, 2065, 185, 2066, 185, 2068, 185, 2073,
+	185, 2076, 185, 2082, 185, 2085, 185, 2120, 185, 2121, 185, 2139, 185, 2156,
+	185, 2265, 185, 2296, 185, 2343, 185, 105, 266, 86, 186, 436, 186, 1162,
+	1521, 1732, 186, 1737, 186, 1740, 186, 1778, 186, 1800, 1521, 1879, 186,
+	1954, 186, 1966, 186, 1976, 186, 1978, 186, 2023, 186, 2027, 186, 2042, 186,
+	2044, 186, 2047, 186, 2053, 186, 2054, 186, 2056, 186, 2065, 186, 2066, 186,
+	2068, 186, 2073, 186, 2076, 186, 2082, 186, 2085, 186, 2120, 186, 2121, 186,
+	2139, 186, 2156, 186, 2265, 186, 2296, 186, 2343, 186, 153, 383, 1747, 383,
+	153, 384, 164, 409, 1492, 409, 1747, 384, 153, 385, 164, 410, 1492, 410,
+	1747, 385, 86, 187, 436, 187, 1162, 1522, 1732, 187, 1737, 187, 1740, 187,
+	1778, 187, 1800, 1522, 1879, 187, 1954, 187, 1966, 187, 1976, 187, 1978,
+	187, 2023, 187, 2027, 187, 2042, 187, 2044, 187, 2047, 187, 2053, 187, 2054,
+	187, 2056, 187, 2065, 187, 2066, 187, 2068, 187, 2073, 187, 2076, 187, 2082,
+	187, 2085, 187, 2120, 187, 2121, 187, 2139, 187, 2156, 187, 2265, 187, 2296,
+	187, 2343, 187, 153, 386, 164, 411, 1492, 411, 1747, 386, 153, 387, 164,
+	412, 1492, 412, 1747, 387, 1730, 1890, 1730, 1891, 86, 188, 436, 188, 1732,
+	188, 1737, 188, 1740, 188, 1778, 188, 1879, 188, 1954, 188, 1966, 188, 1976,
+	188, 1978, 188, 2023, 188, 2027, 188, 2042, 188, 2044, 188, 2047, 188, 2053,
+	188, 2054, 188, 2056, 188, 2065, 188, 2066, 188, 2068, 188, 2073, 188, 2076,
+	188, 2082, 188, 2085, 188, 2120, 188, 2121, 188, 2139, 188, 2156, 188, 2265,
+	188, 2296, 188, 2343, 188, 153, 388, 1747, 388, 1676, 1859, 1677, 1859,
+	2192, 1859, 2253, 1859, 2254, 1859, 153, 389, 1747, 389, 1751, 1929, 2271,
+	1929, 2286, 1929, 2291, 1929, 2348, 1929, 2392, 1929, 1162, 1523, 1220,
+	1630, 1906, 2036, 2217, 2277, 2422, 2431, 2447, 2451, 86, 189, 436, 189,
+	1162, 1524, 1732, 189, 1737, 189, 1740, 189, 1778, 189, 1800, 1524, 1879,
+	189, 1954, 189, 1966, 189, 1976, 189, 1978, 189, 2023, 189, 2027, 189, 2042,
+	189, 2044, 189, 2047, 189, 2053, 189, 2054, 189, 2056, 189, 2065, 189, 2066,
+	189, 2068, 189, 2073, 189, 2076, 189, 2082, 189, 2085, 189, 2120, 189, 2121,
+	189, 2139, 189, 2156, 189, 2265, 189, 2296, 189, 2343, 189, 4, 21, 1160, 21,
+	4, 22, 460, 751, 2301, 751, 2330, 751, 2333, 751, 2334, 751, 2336, 751,
+	2356, 751, 2357, 751, 2383, 751, 2384, 751, 2385, 751, 2391, 751, 2393, 751,
+	2404, 751, 2424, 751, 1162, 1525, 1800, 1525, 21, 78, 82, 78, 133, 78, 135,
+	78, 193, 78, 194, 78, 195, 78, 266, 78, 343, 78, 344, 78, 349, 78, 359, 78,
+	360, 78, 367, 78, 371, 78, 372, 78, 373, 78, 391, 78, 392, 78, 393, 78, 394,
+	78, 395, 78, 396, 78, 397, 78, 398, 78, 399, 78, 414, 78, 415, 78, 416, 78,
+	420, 78, 421, 78, 423, 78, 424, 78, 426, 78, 427, 78, 428, 78, 430, 78, 432,
+	78, 433, 78, 434, 78, 435, 78, 442, 78, 489, 78, 630, 78, 634, 78, 638, 78,
+	647, 78, 657, 78, 658, 78, 662, 78, 663, 78, 666, 78, 669, 78, 673, 78, 675,
+	78, 681, 78, 784, 78, 785, 78, 787, 78, 799, 78, 801, 78, 802, 78, 805, 78,
+	809, 78, 838, 78, 839, 78, 840, 78, 841, 78, 842, 78, 843, 78, 844, 78, 847,
+	78, 849, 78, 850, 78, 876, 78, 925, 78, 941, 78, 942, 78, 948, 78, 994, 78,
+	1013, 78, 1056, 78, 1057, 78, 1058, 78, 1070, 78, 1071, 78, 1075, 78, 1076,
+	78, 1104, 78, 1105, 78, 1106, 78, 1107, 78, 1116, 78, 1130, 78, 1166, 78,
+	1169, 78, 1215, 78, 1216, 78, 1221, 78, 1223, 78, 1225, 78, 1227, 78, 1228,
+	78, 1230, 78, 1231, 78, 1232, 78, 1233, 78, 1234, 78, 1235, 78, 1238, 78,
+	1239, 78, 1240, 78, 1243, 78, 1244, 78, 1246, 78, 1247, 78, 1249, 78, 1250,
+	78, 1251, 78, 1253, 78, 1255, 78, 1256, 78, 1257, 78, 1258, 78, 1273, 78,
+	1452, 78, 1455, 78, 1457, 78, 1462, 78, 1464, 78, 1467, 78, 1476, 78, 1477,
+	78, 1484, 78, 1485, 78, 1486, 78, 1487, 78, 1488, 78, 1493, 78, 1494, 78,
+	1495, 78, 1496, 78, 1498, 78, 1504, 78, 1506, 78, 1507, 78, 1508, 78, 1511,
+	78, 1513, 78, 1514, 78, 1515, 78, 1518, 78, 1520, 78, 1522, 78, 1524, 78,
+	1525, 78, 1526, 78, 1527, 78, 1603, 78, 1604, 78, 1619, 78, 1620, 78, 1623,
+	78, 1624, 78, 1625, 78, 1626, 78, 1629, 78, 1639, 78, 1648, 78, 1649, 78,
+	1653, 78, 1654, 78, 1657, 78, 1660, 78, 1664, 78, 1666, 78, 1721, 78, 1727,
+	78, 1746, 78, 1748, 78, 1752, 78, 1753, 78, 1759, 78, 1760, 78, 1761, 78,
+	1762, 78, 1771, 78, 1772, 78, 1773, 78, 1775, 78, 1780, 78, 1781, 78, 1783,
+	78, 1788, 78, 1789, 78, 1792, 78, 1835, 78, 1843, 78, 1846, 78, 1872, 78,
+	1875, 78, 1878, 78, 1894, 78, 1895, 78, 1907, 78, 1908, 78, 1921, 78, 1933,
+	78, 1934, 78, 1935, 78, 1936, 78, 1943, 78, 1944, 78, 1945, 78, 1950, 78,
+	1956, 78, 1962, 78, 1964, 78, 1965, 78, 1967, 78, 1971, 78, 1973, 78, 1977,
+	78, 1979, 78, 1985, 78, 2016, 78, 2018, 78, 2031, 78, 2032, 78, 2035, 78,
+	2055, 78, 2061, 78, 2064, 78, 2074, 78, 2081, 78, 2093, 78, 2094, 78, 2113,
+	78, 2117, 78, 2122, 78, 2135, 78, 2153, 78, 2161, 78, 2162, 78, 2175, 78,
+	2178, 78, 2196, 78, 2197, 78, 2200, 78, 2201, 78, 2202, 78, 2213, 78, 2243,
+	78, 2250, 78, 2252, 78, 2273, 78, 2279, 78, 2281, 78, 2285, 78, 2311, 78,
+	2345, 78, 2355, 78, 2362, 78, 2363, 78, 2364, 78, 2427, 78, 1466, 1728,
+	1499, 1728, 1516, 1728, 1743, 1728, 1770, 1728, 1787, 1728, 83, 132, 460,
+	752, 2301, 752, 2330, 752, 2333, 752, 2334, 752, 2336, 752, 2356, 752, 2357,
+	752, 2383, 752, 2384, 752, 2385, 752, 2391, 752, 2393, 752, 2404, 752, 2424,
+	752, 19, 59, 47, 59, 104, 59, 1475, 1741, 19, 60, 47, 60, 104, 60, 341, 609,
+	570, 609, 571, 609, 1469, 609, 1497, 609, 1982, 609, 460, 753, 2301, 753,
+	2330, 753, 2333, 753, 2334, 753, 2336, 753, 2356, 753, 2357, 753, 2383, 753,
+	2384, 753, 2385, 753, 2391, 753, 2393, 753, 2404, 753, 2424, 753, 341, 610,
+	570, 610, 571, 610, 1469, 610, 1497, 610, 1982, 610, 1470, 1736, 1472, 1738,
+	1473, 1739, 460, 754, 629, 1196, 2301, 754, 2330, 754, 2333, 754, 2334, 754,
+	2336, 754, 2343, 1196, 2356, 754, 2357, 754, 2383, 754, 2384, 754, 2385,
+	754, 2391, 754, 2393, 754, 2404, 754, 2424, 754, 341, 611, 570, 611, 571,
+	611, 1469, 611, 1497, 611, 1982, 611, 341, 612, 570, 612, 571, 612, 1469,
+	612, 1497, 612, 1982, 612, 21, 79, 82, 79, 133, 79, 135, 79, 193, 79, 194,
+	79, 195, 79, 266, 79, 343, 79, 344, 79, 349, 79, 359, 79, 360, 79, 367, 79,
+	371, 79, 372, 79, 373, 79, 391, 79, 392, 79, 393, 79, 394, 79, 395, 79, 396,
+	79, 397, 79, 398, 79, 399, 79, 414, 79, 415, 79, 416, 79, 420, 79, 421, 79,
+	423, 79, 424, 79, 426, 79, 427, 79, 428, 79, 430, 79, 432, 79, 433, 79, 434,
+	79, 435, 79, 442, 79, 489, 79, 630, 79, 634, 79, 638, 79, 647, 79, 657, 79,
+	658, 79, 662, 79, 663, 79, 666, 79, 669, 79, 673, 79, 675, 79, 681, 79, 784,
+	79, 785, 79, 787, 79, 799, 79, 801, 79, 802, 79, 805, 79, 809, 79, 838, 79,
+	839, 79, 840, 79, 841, 79, 842, 79, 843, 79, 844, 79, 847, 79, 849, 79, 850,
+	79, 876, 79, 925, 79, 941, 79, 942, 79, 948, 79, 994, 79, 1013, 79, 1056,
+	79, 1057, 79, 1058, 79, 1070, 79, 1071, 79, 1075, 79, 1076, 79, 1104, 79,
+	1105, 79, 1106, 79, 1107, 79, 1116, 79, 1130, 79, 1166, 79, 1169, 79, 1215,
+	79, 1216, 79, 1221, 79, 1223, 79, 1225, 79, 1227, 79, 1228, 79, 1230, 79,
+	1231, 79, 1232, 79, 1233, 79, 1234, 79, 1235, 79, 1238, 79, 1239, 79, 1240,
+	79, 1243, 79, 1244, 79, 1246, 79, 1247, 79, 1249, 79, 1250, 79, 1251, 79,
+	1253, 79, 1255, 79, 1256, 79, 1257, 79, 1258, 79, 1273, 79, 1452, 79, 1455,
+	79, 1457, 79, 1462, 79, 1464, 79, 1467, 79, 1476, 79, 1477, 79, 1484, 79,
+	1485, 79, 1486, 79, 1487, 79, 1488, 79, 1493, 79, 1494, 79, 1495, 79, 1496,
+	79, 1498, 79, 1504, 79, 1506, 79, 1507, 79, 1508, 79, 1511, 79, 1513, 79,
+	1514, 79, 1515, 79, 1518, 79, 1520, 79, 1522, 79, 1524, 79, 1525, 79, 1526,
+	79, 1527, 79, 1603, 79, 1604, 79, 1619, 79, 1620, 79, 1623, 79, 1624, 79,
+	1625, 79, 1626, 79, 1629, 79, 1639, 79, 1648, 79, 1649, 79, 1653, 79, 1654,
+	79, 1657, 79, 1660, 79, 1664, 79, 1666, 79, 1721, 79, 1727, 79, 1746, 79,
+	1748, 79, 1752, 79, 1753, 79, 1759, 79, 1760, 79, 1761, 79, 1762, 79, 1771,
+	79, 1772, 79, 1773, 79, 1775, 79, 1780, 79, 1781, 79, 1783, 79, 1788, 79,
+	1789, 79, 1792, 79, 1835, 79, 1843, 79, 1846, 79, 1872, 79, 1875, 79, 1878,
+	79, 1894, 79, 1895, 79, 1907, 79, 1908, 79, 1921, 79, 1933, 79, 1934, 79,
+	1935, 79, 1936, 79, 1943, 79, 1944, 79, 1945, 79, 1950, 79, 1956, 79, 1962,
+	79, 1964, 79, 1965, 79, 1967, 79, 1971, 79, 1973, 79, 1977, 79, 1979, 79,
+	1985, 79, 2016, 79, 2018, 79, 2031, 79, 2032, 79, 2035, 79, 2055, 79, 2061,
+	79, 2064, 79, 2074, 79, 2081, 79, 2093, 79, 2094, 79, 2113, 79, 2117, 79,
+	2122, 79, 2135, 79, 2153, 79, 2161, 79, 2162, 79, 2175, 79, 2178, 79, 2196,
+	79, 2197, 79, 2200, 79, 2201, 79, 2202, 79, 2213, 79, 2243, 79, 2250, 79,
+	2252, 79, 2273, 79, 2279, 79, 2281, 79, 2285, 79, 2311, 79, 2345, 79, 2355,
+	79, 2362, 79, 2363, 79, 2364, 79, 2427, 79, 341, 613, 570, 613, 571, 613,
+	1469, 613, 1497, 613, 1982, 613, 21, 80, 82, 80, 133, 80, 135, 80, 193, 80,
+	194, 80, 195, 80, 266, 80, 343, 80, 344, 80, 349, 80, 359, 80, 360, 80, 367,
+	80, 371, 80, 372, 80, 373, 80, 391, 80, 392, 80, 393, 80, 394, 80, 395, 80,
+	396, 80, 397, 80, 398, 80, 399, 80, 414, 80, 415, 80, 416, 80, 420, 80, 421,
+	80, 423, 80, 424, 80, 426, 80, 427, 80, 428, 80, 430, 80, 432, 80, 433, 80,
+	434, 80, 435, 80, 442, 80, 489, 80, 630, 80, 634, 80, 638, 80, 647, 80, 657,
+	80, 658, 80, 662, 80, 663, 80, 666, 80, 669, 80, 673, 80, 675, 80, 681, 80,
+	784, 80, 785, 80, 787, 80, 799, 80, 801, 80, 802, 80, 805, 80, 809, 80, 838,
+	80, 839, 80, 840, 80, 841, 80, 842, 80, 843, 80, 844, 80, 847, 80, 849, 80,
+	850, 80, 876, 80, 925, 80, 941, 80, 942, 80, 948, 80, 994, 80, 1013, 80,
+	1056, 80, 1057, 80, 1058, 80, 1070, 80, 1071, 80, 1075, 80, 1076, 80, 1104,
+	80, 1105, 80, 1106, 80, 1107, 80, 1116, 80, 1130, 80, 1166, 80, 1169, 80,
+	1215, 80, 1216, 80, 1221, 80, 1223, 80, 1225, 80, 1227, 80, 1228, 80, 1230,
+	80, 1231, 80, 1232, 80, 1233, 80, 1234, 80, 1235, 80, 1238, 80, 1239, 80,
+	1240, 80, 1243, 80, 1244, 80, 1246, 80, 1247, 80, 1249, 80, 1250, 80, 1251,
+	80, 1253, 80, 1255, 80, 1256, 80, 1257, 80, 1258, 80, 1273, 80, 1452, 80,
+	1455, 80, 1457, 80, 1462, 80, 1464, 80, 1467, 80, 1476, 80, 1477, 80, 1484,
+	80, 1485, 80, 1486, 80, 1487, 80, 1488, 80, 1493, 80, 1494, 80, 1495, 80,
+	1496, 80, 1498, 80, 1504, 80, 1506, 80, 1507, 80, 1508, 80, 1511, 80, 1513,
+	80, 1514, 80, 1515, 80, 1518, 80, 1520, 80, 1522, 80, 1524, 80, 1525, 80,
+	1526, 80, 1527, 80, 1603, 80, 1604, 80, 1619, 80, 1620, 80, 1623, 80, 1624,
+	80, 1625, 80, 1626, 80, 1629, 80, 1639, 80, 1648, 80, 1649, 80, 1653, 80,
+	1654, 80, 1657, 80, 1660, 80, 1664, 80, 1666, 80, 1721, 80, 1727, 80, 1746,
+	80, 1748, 80, 1752, 80, 1753, 80, 1759, 80, 1760, 80, 1761, 80, 1762, 80,
+	1771, 80, 1772, 80, 1773, 80, 1775, 80, 1780, 80, 1781, 80, 1783, 80, 1788,
+	80, 1789, 80, 1792, 80, 1835, 80, 1843, 80, 1846, 80, 1872, 80, 1875, 80,
+	1878, 80, 1894, 80, 1895, 80, 1907, 80, 1908, 80, 1921, 80, 1933, 80, 1934,
+	80, 1935, 80, 1936, 80, 1943, 80, 1944, 80, 1945, 80, 1950, 80, 1956, 80,
+	1962, 80, 1964, 80, 1965, 80, 1967, 80, 1971, 80, 1973, 80, 1977, 80, 1979,
+	80, 1985, 80, 2016, 80, 2018, 80, 2031, 80, 2032, 80, 2035, 80, 2055, 80,
+	2061, 80, 2064, 80, 2074, 80, 2081, 80, 2093, 80, 2094, 80, 2113, 80, 2117,
+	80, 2122, 80, 2135, 80, 2153, 80, 2161, 80, 2162, 80, 2175, 80, 2178, 80,
+	2196, 80, 2197, 80, 2200, 80, 2201, 80, 2202, 80, 2213, 80, 2243, 80, 2250,
+	80, 2252, 80, 2273, 80, 2279, 80, 2281, 80, 2285, 80, 2311, 80, 2345, 80,
+	2355, 80, 2362, 80, 2363, 80, 2364, 80, 2427, 80, 341, 614, 570, 614, 571,
+	614, 1469, 614, 1497, 614, 1982, 614, 341, 615, 570, 615, 571, 615, 1469,
+	615, 1497, 615, 1982, 615, 341, 616, 570, 616, 571, 616, 1469, 616, 1497,
+	616, 1982, 616, 341, 617, 570, 617, 571, 617, 1469, 617, 1497, 617, 1982,
+	617, 127, 343, 132, 346, 134, 349, 625, 1169, 1730, 1892, 86, 190, 436, 190,
+	1162, 1526, 1730, 1893, 1732, 190, 1737, 190, 1740, 190, 1778, 190, 1800,
+	1526, 1879, 190, 1954, 190, 1966, 190, 1976, 190, 1978, 190, 2023, 190,
+	2027, 190, 2042, 190, 2044, 190, 2047, 190, 2053, 190, 2054, 190, 2056, 190,
+	2065, 190, 2066, 190, 2068, 190, 2073, 190, 2076, 190, 2082, 190, 2085, 190,
+	2120, 190, 2121, 190, 2139, 190, 2156, 190, 2265, 190, 2296, 190, 2343, 190,
+	629, 1197, 1166, 1610, 1603, 1610, 1604, 1610, 1899, 1610, 1946, 1610, 2189,
+	1610, 2343, 1197, 86, 191, 436, 191, 1732, 191, 1737, 191, 1740, 191, 1778,
+	191, 1879, 191, 1954, 191, 1966, 191, 1976, 191, 1978, 191, 2023, 191, 2027,
+	191, 2042, 191, 2044, 191, 2047, 191, 2053, 191, 2054, 191, 2056, 191, 2065,
+	191, 2066, 191, 2068, 191, 2073, 191, 2076, 191, 2082, 191, 2085, 191, 2120,
+	191, 2121, 191, 2139, 191, 2156, 191, 2265, 191, 2296, 191, 2343, 191, 86,
+	192, 436, 192, 1162, 1527, 1732, 192, 1737, 192, 1740, 192, 1778, 192, 1800,
+	1527, 1879, 192, 1954, 192, 1966, 192, 1976, 192, 1978, 192, 2023, 192,
+	2027, 192, 2042, 192, 2044, 192, 2047, 192, 2053, 192, 2054, 192, 2056, 192,
+	2065, 192, 2066, 192, 2068, 192, 2073, 192, 2076, 192, 2082, 192, 2085, 192,
+	2120, 192, 2121, 192, 2139, 192, 2156, 192, 2265, 192, 2296, 192, 2343, 192,
+	489, 1147, 784, 1147, 785, 1147, 787, 1147, 799, 1147, 801, 1147, 802, 1147,
+	805, 1147, 809, 1147, 838, 1147, 839, 1147, 840, 1147, 841, 1147, 842, 1147,
+	843, 1147, 844, 1147, 847, 1147, 849, 1147, 850, 1147, 876, 1147, 925, 1147,
+	941, 1147, 942, 1147, 948, 1147, 994, 1147, 1013, 1147, 1056, 1147, 1057,
+	1147, 1058, 1147, 1070, 1147, 1071, 1147, 1075, 1147, 1076, 1147, 1104,
+	1147, 1105, 1147, 1106, 1147, 1107, 1147, 1116, 1147, 1130, 1147, 1452,
+	1147, 1457, 1147, 2122, 1147, 2311, 1147, 112, 273, 271, 273, 489, 273, 784,
+	273, 785, 273, 787, 273, 799, 273, 801, 273, 802, 273, 805, 273, 809, 273,
+	838, 273, 839, 273, 840, 273, 841, 273, 842, 273, 843, 273, 844, 273, 847,
+	273, 849, 273, 850, 273, 876, 273, 925, 273, 941, 273, 942, 273, 948, 273,
+	994, 273, 1013, 273, 1056, 273, 1057, 273, 1058, 273, 1070, 273, 1071, 273,
+	1075, 273, 1076, 273, 1104, 273, 1105, 273, 1106, 273, 1107, 273, 1116, 273,
+	1130, 273, 1452, 273, 1457, 273, 2122, 273, 2311, 273, 112, 274, 271, 274,
+	489, 274, 784, 274, 785, 274, 787, 274, 799, 274, 801, 274, 802, 274, 805,
+	274, 809, 274, 838, 274, 839, 274, 840, 274, 841, 274, 842, 274, 843, 274,
+	844, 274, 847, 274, 849, 274, 850, 274, 876, 274, 925, 274, 941, 274, 942,
+	274, 948, 274, 994, 274, 1013, 274, 1056, 274, 1057, 274, 1058, 274, 1070,
+	274, 1071, 274, 1075, 274, 1076, 274, 1104, 274, 1105, 274, 1106, 274, 1107,
+	274, 1116, 274, 1130, 274, 1452, 274, 1457, 274, 2122, 274, 2311, 274, 112,
+	275, 271, 275, 489, 275, 784, 275, 785, 275, 787, 275, 799, 275, 801, 275,
+	802, 275, 805, 275, 809, 275, 838, 275, 839, 275, 840, 275, 841, 275, 842,
+	275, 843, 275, 844, 275, 847, 275, 849, 275, 850, 275, 876, 275, 925, 275,
+	941, 275, 942, 275, 948, 275, 994, 275, 1013, 275, 1056, 275, 1057, 275,
+	1058, 275, 1070, 275, 1071, 275, 1075, 275, 1076, 275, 1104, 275, 1105, 275,
+	1106, 275, 1107, 275, 1116, 275, 1130, 275, 1452, 275, 1457, 275, 2122, 275,
+	2311, 275, 112, 276, 271, 276, 489, 276, 784, 276, 785, 276, 787, 276, 799,
+	276, 801, 276, 802, 276, 805, 276, 809, 276, 838, 276, 839, 276, 840, 276,
+	841, 276, 842, 276, 843, 276, 844, 276, 847, 276, 849, 276, 850, 276, 876,
+	276, 925, 276, 941, 276, 942, 276, 948, 276, 994, 276, 1013, 276, 1056, 276,
+	1057, 276, 1058, 276, 1070, 276, 1071, 276, 1075, 276, 1076, 276, 1104, 276,
+	1105, 276, 1106, 276, 1107, 276, 1116, 276, 1130, 276, 1452, 276, 1457, 276,
+	2122, 276, 2311, 276, 112, 277, 271, 277, 489, 277, 784, 277, 785, 277, 787,
+	277, 799, 277, 801, 277, 802, 277, 805, 277, 809, 277, 838, 277, 839, 277,
+	840, 277, 841, 277, 842, 277, 843, 277, 844, 277, 847, 277, 849, 277, 850,
+	277, 876, 277, 925, 277, 941, 277, 942, 277, 948, 277, 994, 277, 1013, 277,
+	1056, 277, 1057, 277, 1058, 277, 1070, 277, 1071, 277, 1075, 277, 1076, 277,
+	1104, 277, 1105, 277, 1106, 277, 1107, 277, 1116, 277, 1130, 277, 1452, 277,
+	1457, 277, 2122, 277, 2311, 277, 112, 278, 271, 278, 489, 278, 784, 278,
+	785, 278, 787, 278, 799, 278, 801, 278, 802, 278, 805, 278, 809, 278, 838,
+	278, 839, 278, 840, 278, 841, 278, 842, 278, 843, 278, 844, 278, 847, 278,
+	849, 278, 850, 278, 876, 278, 925, 278, 941, 278, 942, 278, 948, 278, 994,
+	278, 1013, 278, 1056, 278, 1057, 278, 1058, 278, 1070, 278, 1071, 278, 1075,
+	278, 1076, 278, 1104, 278, 1105, 278, 1106, 278, 1107, 278, 1116, 278, 1130,
+	278, 1452, 278, 1457, 278, 2122, 278, 2311, 278, 112, 279, 271, 279, 457,
+	279, 489, 279, 784, 279, 785, 279, 787, 279, 799, 279, 801, 279, 802, 279,
+	805, 279, 809, 279, 838, 279, 839, 279, 840, 279, 841, 279, 842, 279, 843,
+	279, 844, 279, 847, 279, 849, 279, 850, 279, 876, 279, 925, 279, 941, 279,
+	942, 279, 948, 279, 994, 279, 1013, 279, 1056, 279, 1057, 279, 1058, 279,
+	1070, 279, 1071, 279, 1075, 279, 1076, 279, 1104, 279, 1105, 279, 1106, 279,
+	1107, 279, 1116, 279, 1130, 279, 1267, 279, 1452, 279, 1457, 279, 2122, 279,
+	2311, 279, 112, 280, 271, 280, 489, 280, 784, 280, 785, 280, 787, 280, 799,
+	280, 801, 280, 802, 280, 805, 280, 809, 280, 838, 280, 839, 280, 840, 280,
+	841, 280, 842, 280, 843, 280, 844, 280, 847, 280, 849, 280, 850, 280, 876,
+	280, 925, 280, 941, 280, 942, 280, 948, 280, 994, 280, 1013, 280, 1056, 280,
+	1057, 280, 1058, 280, 1070, 280, 1071, 280, 1075, 280, 1076, 280, 1104, 280,
+	1105, 280, 1106, 280, 1107, 280, 1116, 280, 1130, 280, 1452, 280, 1457, 280,
+	2122, 280, 2311, 280, 112, 281, 271, 281, 489, 281, 784, 281, 785, 281, 787,
+	281, 799, 281, 801, 281, 802, 281, 805, 281, 809, 281, 838, 281, 839, 281,
+	840, 281, 841, 281, 842, 281, 843, 281, 844, 281, 847, 281, 849, 281, 850,
+	281, 876, 281, 925, 281, 941, 281, 942, 281, 948, 281, 994, 281, 1013, 281,
+	1056, 281, 1057, 281, 1058, 281, 1070, 281, 1071, 281, 1075, 281, 1076, 281,
+	1104, 281, 1105, 281, 1106, 281, 1107, 281, 1116, 281, 1130, 281, 1452, 281,
+	1457, 281, 2122, 281, 2311, 281, 112, 282, 271, 282, 489, 282, 784, 282,
+	785, 282, 787, 282, 799, 282, 801, 282, 802, 282, 805, 282, 809, 282, 838,
+	282, 839, 282, 840, 282, 841, 282, 842, 282, 843, 282, 844, 282, 847, 282,
+	849, 282, 850, 282, 876, 282, 925, 282, 941, 282, 942, 282, 948, 282, 994,
+	282, 1013, 282, 1056, 282, 1057, 282, 1058, 282, 1070, 282, 1071, 282, 1075,
+	282, 1076, 282, 1104, 282, 1105, 282, 1106, 282, 1107, 282, 1116, 282, 1130,
+	282, 1452, 282, 1457, 282, 2122, 282, 2311, 282, 112, 283, 271, 283, 489,
+	283, 784, 283, 785, 283, 787, 283, 799, 283, 801, 283, 802, 283, 805, 283,
+	809, 283, 838, 283, 839, 283, 840, 283, 841, 283, 842, 283, 843, 283, 844,
+	283, 847, 283, 849, 283, 850, 283, 876, 283, 925, 283, 941, 283, 942, 283,
+	948, 283, 994, 283, 1013, 283, 1056, 283, 1057, 283, 1058, 283, 1070, 283,
+	1071, 283, 1075, 283, 1076, 283, 1104, 283, 1105, 283, 1106, 283, 1107, 283,
+	1116, 283, 1130, 283, 1452, 283, 1457, 283, 2122, 283, 2311, 283, 112, 284,
+	271, 284, 489, 284, 784, 284, 785, 284, 787, 284, 799, 284, 801, 284, 802,
+	284, 805, 284, 809, 284, 838, 284, 839, 284, 840, 284, 841, 284, 842, 284,
+	843, 284, 844, 284, 847, 284, 849, 284, 850, 284, 876, 284, 925, 284, 941,
+	284, 942, 284, 948, 284, 994, 284, 1013, 284, 1056, 284, 1057, 284, 1058,
+	284, 1070, 284, 1071, 284, 1075, 284, 1076, 284, 1104, 284, 1105, 284, 1106,
+	284, 1107, 284, 1116, 284, 1130, 284, 1452, 284, 1457, 284, 2122, 284, 2311,
+	284, 112, 285, 271, 285, 489, 285, 784, 285, 785, 285, 787, 285, 799, 285,
+	801, 285, 802, 285, 805, 285, 809, 285, 838, 285, 839, 285, 840, 285, 841,
+	285, 842, 285, 843, 285, 844, 285, 847, 285, 849, 285, 850, 285, 876, 285,
+	925, 285, 941, 285, 942, 285, 948, 285, 994, 285, 1013, 285, 1056, 285,
+	1057, 285, 1058, 285, 1070, 285, 1071, 285, 1075, 285, 1076, 285, 1104, 285,
+	1105, 285, 1106, 285, 1107, 285, 1116, 285, 1130, 285, 1452, 285, 1457, 285,
+	2122, 285, 2311, 285, 112, 286, 271, 286, 489, 286, 784, 286, 785, 286, 787,
+	286, 799, 286, 801, 286, 802, 286, 805, 286, 809, 286, 838, 286, 839, 286,
+	840, 286, 841, 286, 842, 286, 843, 286, 844, 286, 847, 286, 849, 286, 850,
+	286, 876, 286, 925, 286, 941, 286, 942, 286, 948, 286, 994, 286, 1013, 286,
+	1056, 286, 1057, 286, 1058, 286, 1070, 286, 1071, 286, 1075, 286, 1076, 286,
+	1104, 286, 1105, 286, 1106, 286, 1107, 286, 1116, 286, 1130, 286, 1452, 286,
+	1457, 286, 2122, 286, 2311, 286, 112, 287, 271, 287, 489, 287, 784, 287,
+	785, 287, 787, 287, 799, 287, 801, 287, 802, 287, 805, 287, 809, 287, 838,
+	287, 839, 287, 840, 287, 841, 287, 842, 287, 843, 287, 844, 287, 847, 287,
+	849, 287, 850, 287, 876, 287, 925, 287, 941, 287, 942, 287, 948, 287, 994,
+	287, 1013, 287, 1056, 287, 1057, 287, 1058, 287, 1070, 287, 1071, 287, 1075,
+	287, 1076, 287, 1104, 287, 1105, 287, 1106, 287, 1107, 287, 1116, 287, 1130,
+	287, 1452, 287, 1457, 287, 2122, 287, 2311, 287, 112, 288, 271, 288, 489,
+	288, 784, 288, 785, 288, 787, 288, 799, 288, 801, 288, 802, 288, 805, 288,
+	809, 288, 838, 288, 839, 288, 840, 288, 841, 288, 842, 288, 843, 288, 844,
+	288, 847, 288, 849, 288, 850, 288, 876, 288, 925, 288, 941, 288, 942, 288,
+	948, 288, 994, 288, 1013, 288, 1056, 288, 1057, 288, 1058, 288, 1070, 288,
+	1071, 288, 1075, 288, 1076, 288, 1104, 288, 1105, 288, 1106, 288, 1107, 288,
+	1116, 288, 1130, 288, 1452, 288, 1457, 288, 2122, 288, 2311, 288, 112, 289,
+	271, 289, 489, 289, 784, 289, 785, 289, 787, 289, 799, 289, 801, 289, 802,
+	289, 805, 289, 809, 289, 838, 289, 839, 289, 840, 289, 841, 289, 842, 289,
+	843, 289, 844, 289, 847, 289, 849, 289, 850, 289, 876, 289, 925, 289, 941,
+	289, 942, 289, 948, 289, 994, 289, 1013, 289, 1056, 289, 1057, 289, 1058,
+	289, 1070, 289, 1071, 289, 1075, 289, 1076, 289, 1104, 289, 1105, 289, 1106,
+	289, 1107, 289, 1116, 289, 1130, 289, 1452, 289, 1457, 289, 2122, 289, 2311,
+	289, 112, 290, 271, 290, 489, 290, 784, 290, 785, 290, 787, 290, 799, 290,
+	801, 290, 802, 290, 805, 290, 809, 290, 838, 290, 839, 290, 840, 290, 841,
+	290, 842, 290, 843, 290, 844, 290, 847, 290, 849, 290, 850, 290, 876, 290,
+	925, 290, 941, 290, 942, 290, 948, 290, 994, 290, 1013, 290, 1056, 290,
+	1057, 290, 1058, 290, 1070, 290, 1071, 290, 1075, 290, 1076, 290, 1104, 290,
+	1105, 290, 1106, 290, 1107, 290, 1116, 290, 1130, 290, 1452, 290, 1457, 290,
+	2122, 290, 2311, 290, 112, 291, 271, 291, 489, 291, 784, 291, 785, 291, 787,
+	291, 799, 291, 801, 291, 802, 291, 805, 291, 809, 291, 838, 291, 839, 291,
+	840, 291, 841, 291, 842, 291, 843, 291, 844, 291, 847, 291, 849, 291, 850,
+	291, 876, 291, 925, 291, 941, 291, 942, 291, 948, 291, 994, 291, 1013, 291,
+	1056, 291, 1057, 291, 1058, 291, 1070, 291, 1071, 291, 1075, 291, 1076, 291,
+	1104, 291, 1105, 291, 1106, 291, 1107, 291, 1116, 291, 1130, 291, 1452, 291,
+	1457, 291, 2122, 291, 2311, 291, 112, 292, 271, 292, 489, 292, 784, 292,
+	785, 292, 787, 292, 799, 292, 801, 292, 802, 292, 805, 292, 809, 292, 838,
+	292, 839, 292, 840, 292, 841, 292, 842, 292, 843, 292, 844, 292, 847, 292,
+	849, 292, 850, 292, 876, 292, 925, 292, 941, 292, 942, 292, 948, 292, 994,
+	292, 1013, 292, 1056, 292, 1057, 292, 1058, 292, 1070, 292, 1071, 292, 1075,
+	292, 1076, 292, 1104, 292, 1105, 292, 1106, 292, 1107, 292, 1116, 292, 1130,
+	292, 1452, 292, 1457, 292, 2122, 292, 2311, 292, 112, 293, 271, 293, 489,
+	293, 784, 293, 785, 293, 787, 293, 799, 293, 801, 293, 802, 293, 805, 293,
+	809, 293, 838, 293, 839, 293, 840, 293, 841, 293, 842, 293, 843, 293, 844,
+	293, 847, 293, 849, 293, 850, 293, 876, 293, 925, 293, 941, 293, 942, 293,
+	948, 293, 994, 293, 1013, 293, 1056, 293, 1057, 293, 1058, 293, 1070, 293,
+	1071, 293, 1075, 293, 1076, 293, 1104, 293, 1105, 293, 1106, 293, 1107, 293,
+	1116, 293, 1130, 293, 1452, 293, 1457, 293, 2122, 293, 2311, 293, 112, 294,
+	271, 294, 489, 294, 784, 294, 785, 294, 787, 294, 799, 294, 801, 294, 802,
+	294, 805, 294, 809, 294, 838, 294, 839, 294, 840, 294, 841, 294, 842, 294,
+	843, 294, 844, 294, 847, 294, 849, 294, 850, 294, 876, 294, 925, 294, 941,
+	294, 942, 294, 948, 294, 994, 294, 1013, 294, 1056, 294, 1057, 294, 1058,
+	294, 1070, 294, 1071, 294, 1075, 294, 1076, 294, 1104, 294, 1105, 294, 1106,
+	294, 1107, 294, 1116, 294, 1130, 294, 1452, 294, 1457, 294, 2122, 294, 2311,
+	294, 112, 295, 271, 295, 489, 295, 784, 295, 785, 295, 787, 295, 799, 295,
+	801, 295, 802, 295, 805, 295, 809, 295, 838, 295, 839, 295, 840, 295, 841,
+	295, 842, 295, 843, 295, 844, 295, 847, 295, 849, 295, 850, 295, 876, 295,
+	925, 295, 941, 295, 942, 295, 948, 295, 994, 295, 1013, 295, 1056, 295,
+	1057, 295, 1058, 295, 1070, 295, 1071, 295, 1075, 295, 1076, 295, 1104, 295,
+	1105, 295, 1106, 295, 1107, 295, 1116, 295, 1130, 295, 1452, 295, 1457, 295,
+	2122, 295, 2311, 295, 112, 296, 271, 296, 489, 296, 784, 296, 785, 296, 787,
+	296, 799, 296, 801, 296, 802, 296, 805, 296, 809, 296, 838, 296, 839, 296,
+	840, 296, 841, 296, 842, 296, 843, 296, 844, 296, 847, 296, 849, 296, 850,
+	296, 876, 296, 925, 296, 941, 296, 942, 296, 948, 296, 994, 296, 1013, 296,
+	1056, 296, 1057, 296, 1058, 296, 1070, 296, 1071, 296, 1075, 296, 1076, 296,
+	1104, 296, 1105, 296, 1106, 296, 1107, 296, 1116, 296, 1130, 296, 1452, 296,
+	1457, 296, 2122, 296, 2311, 296, 112, 297, 271, 297, 489, 297, 784, 297,
+	785, 297, 787, 297, 799, 297, 801, 297, 802, 297, 805, 297, 809, 297, 838,
+	297, 839, 297, 840, 297, 841, 297, 842, 297, 843, 297, 844, 297, 847, 297,
+	849, 297, 850, 297, 876, 297, 925, 297, 941, 297, 942, 297, 948, 297, 994,
+	297, 1013, 297, 1056, 297, 1057, 297, 1058, 297, 1070, 297, 1071, 297, 1075,
+	297, 1076, 297, 1104, 297, 1105, 297, 1106, 297, 1107, 297, 1116, 297, 1130,
+	297, 1452, 297, 1457, 297, 2122, 297, 2311, 297, 112, 298, 271, 298, 489,
+	298, 784, 298, 785, 298, 787, 298, 799, 298, 801, 298, 802, 298, 805, 298,
+	809, 298, 838, 298, 839, 298, 840, 298, 841, 298, 842, 298, 843, 298, 844,
+	298, 847, 298, 849, 298, 850, 298, 876, 298, 925, 298, 941, 298, 942, 298,
+	948, 298, 994, 298, 1013, 298, 1056, 298, 1057, 298, 1058, 298, 1070, 298,
+	1071, 298, 1075, 298, 1076, 298, 1104, 298, 1105, 298, 1106, 298, 1107, 298,
+	1116, 298, 1130, 298, 1452, 298, 1457, 298, 2122, 298, 2311, 298, 112, 299,
+	271, 299, 489, 299, 784, 299, 785, 299, 787, 299, 799, 299, 801, 299, 802,
+	299, 805, 299, 809, 299, 838, 299, 839, 299, 840, 299, 841, 299, 842, 299,
+	843, 299, 844, 299, 847, 299, 849, 299, 850, 299, 876, 299, 925, 299, 941,
+	299, 942, 299, 948, 299, 994, 299, 1013, 299, 1056, 299, 1057, 299, 1058,
+	299, 1070, 299, 1071, 299, 1075, 299, 1076, 299, 1104, 299, 1105, 299, 1106,
+	299, 1107, 299, 1116, 299, 1130, 299, 1452, 299, 1457, 299, 2122, 299, 2311,
+	299, 112, 300, 271, 300, 489, 300, 784, 300, 785, 300, 787, 300, 799, 300,
+	801, 300, 802, 300, 805, 300, 809, 300, 838, 300, 839, 300, 840, 300, 841,
+	300, 842, 300, 843, 300, 844, 300, 847, 300, 849, 300, 850, 300, 876, 300,
+	925, 300, 941, 300, 942, 300, 948, 300, 994, 300, 1013, 300, 1056, 300,
+	1057, 300, 1058, 300, 1070, 300, 1071, 300, 1075, 300, 1076, 300, 1104, 300,
+	1105, 300, 1106, 300, 1107, 300, 1116, 300, 1130, 300, 1452, 300, 1457, 300,
+	2122, 300, 2311, 300, 334, 491, 461, 769, 464, 769, 465, 769, 469, 769, 475,
+	769, 482, 769, 567, 491, 1290, 769, 1340, 769, 1344, 769, 1364, 769, 1382,
+	769, 1414, 769, 464, 838, 1340, 838, 464, 839, 465, 839, 469, 839, 475, 839,
+	483, 839, 1340, 839, 1344, 839, 1364, 839, 1382, 839, 1431, 839, 334, 492,
+	479, 1021, 567, 492, 1401, 1021, 334, 493, 475, 981, 567, 493, 1382, 981,
+	464, 840, 1340, 840, 334, 494, 481, 1043, 567, 494, 1409, 1043, 334, 495,
+	464, 841, 465, 841, 567, 495, 1340, 841, 1344, 841, 334, 496, 485, 1115,
+	567, 496, 1443, 1115, 334, 497, 468, 909, 567, 497, 1358, 909, 334, 498,
+	468, 910, 567, 498, 1358, 910, 334, 499, 473, 966, 476, 966, 567, 499, 1376,
+	966, 1386, 966, 334, 500, 479, 1022, 567, 500, 1401, 1022, 334, 501, 483,
+	1070, 567, 501, 1431, 1070, 334, 502, 484, 1104, 567, 502, 1437, 1104, 464,
+	842, 1340, 842, 334, 503, 463, 796, 567, 503, 1323, 796, 334, 504, 462, 784,
+	469, 784, 483, 784, 567, 504, 1296, 784, 1364, 784, 1431, 784, 486, 1123,
+	487, 1123, 1446, 1123, 1449, 1123, 334, 505, 468, 911, 567, 505, 1358, 911,
+	334, 506, 464, 843, 474, 974, 567, 506, 1340, 843, 1379, 974, 334, 507, 465,
+	875, 567, 507, 1344, 875, 334, 508, 463, 797, 567, 508, 1323, 797, 334, 509,
+	464, 844, 471, 844, 567, 509, 1340, 844, 1371, 844, 334, 510, 463, 798, 567,
+	510, 1323, 798, 334, 511, 461, 770, 482, 770, 567, 511, 1290, 770, 1414,
+	770, 334, 512, 471, 948, 567, 512, 1371, 948, 334, 513, 463, 799, 567, 513,
+	1323, 799, 334, 514, 480, 1036, 567, 514, 1404, 1036, 334, 515, 470, 941,
+	567, 515, 1368, 941, 334, 516, 465, 876, 567, 516, 1344, 876, 334, 517, 462,
+	785, 463, 785, 464, 785, 465, 785, 469, 785, 471, 785, 472, 785, 473, 785,
+	474, 785, 475, 785, 478, 785, 479, 785, 481, 785, 483, 785, 567, 517, 1296,
+	785, 1323, 785, 1340, 785, 1344, 785, 1364, 785, 1371, 785, 1373, 785, 1376,
+	785, 1379, 785, 1382, 785, 1395, 785, 1401, 785, 1409, 785, 1431, 785, 334,
+	518, 468, 912, 567, 518, 1358, 912, 334, 519, 461, 771, 463, 800, 464, 771,
+	465, 771, 475, 771, 483, 771, 485, 771, 567, 519, 1290, 771, 1323, 800,
+	1340, 771, 1344, 771, 1382, 771, 1431, 771, 1443, 771, 334, 520, 481, 1044,
+	567, 520, 1409, 1044, 334, 521, 463, 801, 567, 521, 1323, 801, 334, 522,
+	488, 1139, 567, 522, 1453, 1139, 334, 523, 464, 845, 567, 523, 1340, 845,
+	334, 524, 463, 802, 567, 524, 1323, 802, 334, 525, 479, 1023, 567, 525,
+	1401, 1023, 334, 526, 476, 994, 567, 526, 1386, 994, 479, 1024, 1401, 1024,
+	334, 527, 469, 922, 483, 922, 567, 527, 1364, 922, 1431, 922, 334, 528, 476,
+	995, 567, 528, 1386, 995, 334, 529, 469, 923, 483, 923, 567, 529, 1364, 923,
+	1431, 923, 334, 530, 463, 803, 483, 803, 567, 530, 1323, 803, 1431, 803,
+	334, 531, 466, 893, 567, 531, 1348, 893, 334, 532, 463, 804, 567, 532, 1323,
+	804, 334, 533, 462, 786, 464, 786, 465, 786, 469, 786, 471, 786, 472, 786,
+	473, 786, 475, 786, 476, 786, 477, 786, 478, 786, 479, 786, 481, 786, 483,
+	786, 567, 533, 1296, 786, 1340, 786, 1344, 786, 1364, 786, 1371, 786, 1373,
+	786, 1376, 786, 1382, 786, 1386, 786, 1392, 786, 1395, 786, 1401, 786, 1409,
+	786, 1431, 786, 334, 534, 469, 924, 483, 924, 567, 534, 1364, 924, 1431,
+	924, 334, 535, 484, 1105, 567, 535, 1437, 1105, 334, 536, 463, 805, 567,
+	536, 1323, 805, 334, 537, 461, 772, 462, 772, 464, 772, 465, 772, 466, 772,
+	469, 772, 471, 772, 472, 772, 475, 772, 477, 772, 479, 772, 480, 772, 481,
+	772, 482, 772, 483, 772, 486, 772, 487, 772, 567, 537, 1290, 772, 1296, 772,
+	1340, 772, 1344, 772, 1348, 772, 1364, 772, 1371, 772, 1373, 772, 1382, 772,
+	1392, 772, 1401, 772, 1404, 772, 1409, 772, 1414, 772, 1431, 772, 1446, 772,
+	1449, 772, 334, 538, 463, 806, 567, 538, 1323, 806, 334, 539, 478, 1013,
+	567, 539, 1395, 1013, 334, 540, 464, 846, 465, 846, 567, 540, 1340, 846,
+	1344, 846, 334, 541, 488, 1140, 567, 541, 1453, 1140, 334, 542, 463, 807,
+	567, 542, 1323, 807, 463, 808, 1323, 808, 464, 847, 1340, 847, 334, 543,
+	483, 1071, 567, 543, 1431, 1071, 334, 544, 463, 809, 567, 544, 1323, 809,
+	334, 545, 464, 848, 567, 545, 1340, 848, 334, 546, 463, 810, 567, 546, 1323,
+	810, 334, 547, 462, 787, 464, 787, 465, 787, 469, 787, 471, 787, 472, 787,
+	473, 787, 474, 787, 475, 787, 476, 787, 479, 787, 480, 787, 483, 787, 567,
+	547, 1296, 787, 1340, 787, 1344, 787, 1364, 787, 1371, 787, 1373, 787, 1376,
+	787, 1379, 787, 1382, 787, 1386, 787, 1401, 787, 1404, 787, 1431, 787, 334,
+	548, 483, 1072, 567, 548, 1431, 1072, 463, 811, 1323, 811, 334, 549, 481,
+	1045, 567, 549, 1409, 1045, 334, 550, 461, 773, 464, 773, 465, 773, 482,
+	773, 567, 550, 1290, 773, 1340, 773, 1344, 773, 1414, 773, 334, 551, 468,
+	913, 567, 551, 1358, 913, 483, 1073, 1431, 1073, 334, 552, 463, 812, 567,
+	552, 1323, 812, 334, 553, 463, 813, 567, 553, 1323, 813, 484, 1106, 1437,
+	1106, 482, 1056, 1414, 1056, 482, 1057, 1414, 1057, 482, 1058, 1414, 1058,
+	463, 814, 1323, 814, 334, 554, 461, 774, 464, 774, 465, 774, 471, 774, 482,
+	774, 487, 774, 488, 774, 567, 554, 1290, 774, 1340, 774, 1344, 774, 1371,
+	774, 1414, 774, 1449, 774, 1453, 774, 334, 555, 464, 849, 469, 849, 483,
+	849, 567, 555, 1340, 849, 1364, 849, 1431, 849, 334, 556, 483, 1074, 567,
+	556, 1431, 1074, 334, 557, 483, 1075, 567, 557, 1431, 1075, 334, 558, 469,
+	925, 475, 925, 477, 1004, 478, 1004, 481, 925, 483, 925, 486, 925, 487, 925,
+	567, 558, 1364, 925, 1382, 925, 1392, 1004, 1395, 1004, 1409, 925, 1431,
+	925, 1446, 925, 1449, 925, 334, 559, 485, 1116, 567, 559, 1443, 1116, 334,
+	560, 483, 1076, 567, 560, 1431, 1076, 484, 1107, 1437, 1107, 334, 561, 466,
+	894, 477, 1005, 487, 1130, 567, 561, 1348, 894, 1392, 1005, 1449, 1130, 334,
+	562, 470, 942, 567, 562, 1368, 942, 334, 563, 483, 1077, 567, 563, 1431,
+	1077, 334, 564, 483, 1078, 567, 564, 1431, 1078, 334, 565, 464, 850, 567,
+	565, 1340, 850, 102, 261, 103, 262, 131, 344, 348, 344, 353, 630, 437, 681,
+	441, 681, 445, 689, 637, 1216, 643, 1222, 644, 1223, 646, 1225, 648, 1227,
+	649, 1228, 655, 1234, 656, 1235, 659, 1238, 660, 1239, 664, 1243, 667, 1246,
+	670, 1249, 672, 1251, 677, 1256, 678, 1257, 685, 681, 688, 1262, 693, 1267,
+	775, 1290, 788, 1296, 815, 1323, 851, 1340, 877, 1344, 895, 1348, 904, 1350,
+	914, 1358, 926, 1364, 943, 1368, 949, 1371, 959, 1373, 967, 1376, 975, 1379,
+	982, 1382, 996, 1386, 1006, 1392, 1014, 1395, 1025, 1401, 1037, 1404, 1046,
+	1409, 1059, 1414, 1079, 1431, 1108, 1437, 1117, 1443, 1124, 1446, 1131,
+	1449, 1141, 1453, 1151, 1457, 1214, 1626, 1217, 1629, 1224, 1636, 1226,
+	1639, 1236, 1648, 1237, 1649, 1241, 1653, 1242, 1654, 1245, 1657, 1248,
+	1660, 1252, 1664, 1254, 1666, 1523, 1794, 1650, 1835, 1658, 1843, 1661,
+	1846, 1673, 1262, 1682, 1864, 1684, 1867, 1717, 1872, 1726, 1879, 1734,
+	1794, 1744, 1908, 1745, 1909, 1758, 1794, 1763, 1942, 1764, 1943, 1765,
+	1944, 1774, 1954, 1777, 1794, 1779, 1794, 1784, 1964, 1786, 1966, 1790,
+	1970, 1795, 1975, 1796, 1976, 1797, 1977, 1798, 1978, 1818, 1996, 1836,
+	1636, 1874, 2023, 1880, 2027, 1896, 2030, 1898, 1975, 1910, 2040, 1920,
+	2042, 1922, 2044, 1930, 1794, 1931, 2047, 1932, 1794, 1937, 1975, 1938,
+	2053, 1939, 2054, 1940, 2055, 1941, 2056, 1951, 2064, 1952, 2065, 1953,
+	2066, 1957, 1975, 1958, 1794, 1959, 1975, 1960, 2073, 1961, 2074, 1963,
+	2076, 1968, 2081, 1969, 2082, 1972, 2085, 1998, 2097, 2024, 1794, 2025,
+	2113, 2026, 1794, 2028, 2117, 2029, 1794, 2037, 2135, 2038, 1794, 2041,
+	1975, 2043, 2139, 2046, 1975, 2048, 1975, 2049, 1794, 2050, 1794, 2051,
+	1794, 2052, 1794, 2058, 2153, 2059, 1909, 2060, 1794, 2062, 1794, 2063,
+	2162, 2067, 1794, 2069, 2168, 2071, 1794, 2072, 1975, 2075, 1794, 2077,
+	2175, 2078, 1794, 2079, 1794, 2080, 2178, 2084, 1794, 2086, 1794, 2088,
+	1794, 2089, 1794, 2090, 1794, 2091, 1794, 2103, 2197, 2107, 2201, 2111,
+	1794, 2112, 1975, 2114, 1975, 2115, 1975, 2116, 1794, 2118, 1975, 2119,
+	2209, 2123, 2213, 2126, 1794, 2127, 2216, 2133, 1794, 2136, 1975, 2138,
+	1794, 2140, 1794, 2142, 1794, 2143, 1975, 2144, 1975, 2145, 1975, 2146,
+	1975, 2147, 1794, 2148, 1794, 2150, 1794, 2151, 2216, 2154, 2040, 2155,
+	1975, 2159, 1975, 2163, 2236, 2164, 1794, 2165, 1794, 2166, 1975, 2167,
+	2240, 2169, 1975, 2170, 1975, 2171, 1794, 2172, 2243, 2173, 1975, 2174,
+	1794, 2176, 1975, 2177, 1975, 2179, 2247, 2180, 1794, 2182, 1975, 2183,
+	1794, 2184, 1975, 2185, 1975, 2186, 1975, 2187, 1975, 2188, 1975, 2203,
+	1975, 2204, 1794, 2205, 2267, 2206, 2269, 2207, 1975, 2214, 1794, 2215,
+	1975, 2218, 1794, 2220, 1975, 2221, 2279, 2222, 1975, 2223, 1794, 2224,
+	1975, 2225, 1975, 2226, 1975, 2227, 1975, 2228, 2281, 2229, 1975, 2230,
+	1794, 2231, 1794, 2232, 1975, 2237, 1975, 2238, 1975, 2239, 1975, 2242,
+	1975, 2244, 1975, 2245, 1794, 2248, 1975, 2249, 1975, 2251, 1975, 2266,
+	1975, 2268, 1975, 2270, 1975, 2272, 1794, 2275, 1975, 2278, 1975, 2280,
+	1975, 2282, 1975, 2283, 1975, 2284, 1975, 2287, 1794, 2289, 1794, 2290,
+	1975, 2292, 1794, 2293, 1794, 2295, 2326, 2300, 2331, 2301, 2332, 2302,
+	2331, 2304, 1794, 2305, 1794, 2306, 2339, 2307, 2341, 2308, 1975, 2312,
+	2345, 2315, 1794, 2319, 2350, 2320, 1975, 2322, 1975, 2323, 2352, 2324,
+	1975, 2325, 1975, 2334, 1975, 2336, 2332, 2337, 1975, 2338, 1975, 2340,
+	1975, 2342, 1975, 2347, 1975, 2351, 1975, 2353, 1975, 2385, 1975, 2386,
+	1794, 2387, 1794, 2394, 1794, 2395, 1794, 2405, 1975, 2406, 1975, 2409,
+	2420, 2412, 1975, 2413, 1975, 2418, 2428, 2421, 1975, 2423, 2428, 2424,
+	1794, 2430, 1794, 2434, 1975, 2439, 1975, 2440, 1794, 2442, 2428, 2443,
+	1794, 2446, 1975, 2448, 1794, 2449, 1975, 2452, 1975, 2454, 1794, 2455,
+	1794, 2456, 1975, 2457, 1975, 107, 268, 112, 301, 271, 301, 489, 1148, 784,
+	1148, 785, 1148, 787, 1148, 799, 1148, 801, 1148, 802, 1148, 805, 1148, 809,
+	1148, 838, 1148, 839, 1148, 840, 1148, 841, 1148, 842, 1148, 843, 1148, 844,
+	1148, 847, 1148, 849, 1148, 850, 1148, 876, 1148, 925, 1148, 941, 1148, 942,
+	1148, 948, 1148, 994, 1148, 1013, 1148, 1056, 1148, 1057, 1148, 1058, 1148,
+	1070, 1148, 1071, 1148, 1075, 1148, 1076, 1148, 1104, 1148, 1105, 1148,
+	1106, 1148, 1107, 1148, 1116, 1148, 1130, 1148, 1452, 1148, 1457, 1148,
+	2122, 1148, 2311, 1148, 135, 350, 630, 350, 1985, 350, 2093, 350, 2094, 350,
+	2273, 2309, 2285, 2309, 2345, 2389, 2355, 2309, 85, 135, 124, 342, 129, 135,
+	141, 359, 142, 360, 145, 367, 146, 368, 149, 371, 150, 372, 151, 373, 154,
+	391, 155, 392, 156, 393, 157, 394, 158, 395, 159, 396, 160, 397, 161, 398,
+	162, 399, 165, 414, 166, 415, 167, 416, 173, 420, 175, 421, 177, 423, 178,
+	424, 180, 426, 181, 427, 182, 428, 185, 430, 187, 432, 189, 433, 190, 434,
+	192, 435, 273, 461, 274, 462, 275, 463, 276, 464, 277, 465, 278, 466, 279,
+	467, 280, 468, 281, 469, 282, 470, 283, 471, 284, 472, 285, 473, 286, 474,
+	287, 475, 288, 476, 289, 477, 290, 478, 291, 479, 292, 480, 293, 481, 294,
+	482, 295, 483, 296, 484, 297, 485, 298, 486, 299, 487, 300, 488, 351, 135,
+	358, 634, 363, 638, 390, 647, 402, 657, 413, 658, 417, 662, 418, 663, 422,
+	666, 425, 669, 429, 673, 431, 675, 449, 135, 623, 135, 624, 135, 628, 135,
+	699, 1270, 700, 1272, 734, 1273, 752, 1274, 1147, 1455, 1172, 1617, 1173,
+	1270, 1174, 1619, 1175, 1620, 1176, 1621, 1177, 1622, 1178, 1623, 1180,
+	1624, 1192, 1625, 1236, 135, 1611, 135, 1616, 135, 1627, 135, 1633, 135,
+	1642, 135, 1643, 135, 1644, 135, 1645, 135, 1652, 135, 1656, 135, 1659, 135,
+	1662, 135, 1665, 135, 1667, 135, 1670, 135, 1674, 1857, 1683, 135, 1749,
+	1923, 1768, 135, 1802, 135, 1803, 135, 1804, 1985, 1807, 135, 1808, 135,
+	1811, 135, 1812, 135, 1813, 135, 1940, 135, 1951, 135, 1955, 135, 1983,
+	2093, 1984, 2094, 2024, 135, 2025, 135, 2029, 135, 2049, 135, 2050, 135,
+	2051, 135, 2052, 135, 2060, 135, 2063, 135, 2071, 135, 2075, 135, 2078, 135,
+	2084, 135, 2086, 135, 2089, 135, 2091, 135, 2103, 135, 2107, 135, 2120, 135,
+	2156, 135, 2210, 2273, 2233, 2285, 2263, 135, 2265, 135, 2296, 135, 2327,
+	2355, 2358, 1617, 2359, 2397, 2417, 2427, 354, 631, 622, 1167, 650, 1229,
+	776, 1291, 789, 1297, 816, 1324, 852, 1341, 878, 1345, 896, 1349, 905, 1351,
+	915, 1359, 927, 1365, 944, 1369, 950, 1372, 960, 1374, 968, 1377, 976, 1380,
+	983, 1383, 997, 1387, 1007, 1393, 1015, 1396, 1026, 1402, 1038, 1405, 1047,
+	1410, 1060, 1415, 1080, 1432, 1109, 1438, 1118, 1444, 1125, 1447, 1132,
+	1450, 1142, 1454, 1224, 1637, 1627, 1815, 1628, 1816, 1633, 1819, 1634,
+	1820, 1635, 1821, 1638, 1823, 1640, 1825, 1641, 1826, 1642, 1827, 1643,
+	1828, 1644, 1829, 1645, 1830, 1646, 1831, 1647, 1832, 1652, 1837, 1655,
+	1840, 1656, 1841, 1659, 1844, 1662, 1847, 1663, 1848, 1665, 1850, 1667,
+	1852, 1668, 1853, 1669, 1854, 1670, 1855, 1680, 1863, 1682, 1865, 1683,
+	1866, 1684, 1868, 1718, 1873, 1806, 1986, 1807, 1987, 1808, 1988, 1809,
+	1989, 1810, 1990, 1811, 1991, 1812, 1992, 1813, 1993, 1814, 1994, 1817,
+	1995, 1824, 1997, 1834, 1999, 1836, 2001, 1838, 2002, 1839, 2003, 1842,
+	2004, 1845, 2006, 1849, 2008, 1851, 2009, 1998, 2098, 2000, 2099, 2005,
+	2100, 2007, 2101, 2013, 2102, 2020, 2109, 2021, 2110, 2045, 2141, 2095,
+	2192, 2190, 2253, 2191, 2254, 2313, 2346, 2318, 2349, 2396, 2414, 2415,
+	2425, 2436, 2444, 21, 81, 82, 81, 86, 193, 133, 81, 135, 81, 193, 81, 194,
+	81, 195, 81, 266, 81, 343, 81, 344, 81, 349, 81, 359, 81, 360, 81, 367, 81,
+	371, 81, 372, 81, 373, 81, 391, 81, 392, 81, 393, 81, 394, 81, 395, 81, 396,
+	81, 397, 81, 398, 81, 399, 81, 414, 81, 415, 81, 416, 81, 420, 81, 421, 81,
+	423, 81, 424, 81, 426, 81, 427, 81, 428, 81, 430, 81, 432, 81, 433, 81, 434,
+	81, 435, 81, 436, 193, 442, 81, 489, 81, 630, 81, 634, 81, 638, 81, 647, 81,
+	657, 81, 658, 81, 662, 81, 663, 81, 666, 81, 669, 81, 673, 81, 675, 81, 681,
+	81, 784, 81, 785, 81, 787, 81, 799, 81, 801, 81, 802, 81, 805, 81, 809, 81,
+	838, 81, 839, 81, 840, 81, 841, 81, 842, 81, 843, 81, 844, 81, 847, 81, 849,
+	81, 850, 81, 876, 81, 925, 81, 941, 81, 942, 81, 948, 81, 994, 81, 1013, 81,
+	1056, 81, 1057, 81, 1058, 81, 1070, 81, 1071, 81, 1075, 81, 1076, 81, 1104,
+	81, 1105, 81, 1106, 81, 1107, 81, 1116, 81, 1130, 81, 1166, 81, 1169, 81,
+	1215, 81, 1216, 81, 1221, 81, 1223, 81, 1225, 81, 1227, 81, 1228, 81, 1230,
+	81, 1231, 81, 1232, 81, 1233, 81, 1234, 81, 1235, 81, 1238, 81, 1239, 81,
+	1240, 81, 1243, 81, 1244, 81, 1246, 81, 1247, 81, 1249, 81, 1250, 81, 1251,
+	81, 1253, 81, 1255, 81, 1256, 81, 1257, 81, 1258, 81, 1273, 81, 1452, 81,
+	1455, 81, 1457, 81, 1462, 81, 1464, 81, 1467, 81, 1476, 81, 1477, 81, 1484,
+	81, 1485, 81, 1486, 81, 1487, 81, 1488, 81, 1493, 81, 1494, 81, 1495, 81,
+	1496, 81, 1498, 81, 1504, 81, 1506, 81, 1507, 81, 1508, 81, 1511, 81, 1513,
+	81, 1514, 81, 1515, 81, 1518, 81, 1520, 81, 1522, 81, 1524, 81, 1525, 81,
+	1526, 81, 1527, 81, 1603, 81, 1604, 81, 1619, 81, 1620, 81, 1623, 81, 1624,
+	81, 1625, 81, 1626, 81, 1629, 81, 1639, 81, 1648, 81, 1649, 81, 1653, 81,
+	1654, 81, 1657, 81, 1660, 81, 1664, 81, 1666, 81, 1721, 81, 1727, 81, 1732,
+	193, 1737, 193, 1740, 193, 1746, 81, 1748, 81, 1752, 81, 1753, 81, 1759, 81,
+	1760, 81, 1761, 81, 1762, 81, 1771, 81, 1772, 81, 1773, 81, 1775, 81, 1778,
+	193, 1780, 81, 1781, 81, 1783, 81, 1788, 81, 1789, 81, 1792, 81, 1835, 81,
+	1843, 81, 1846, 81, 1872, 81, 1875, 81, 1878, 81, 1879, 193, 1894, 81, 1895,
+	81, 1900, 2032, 1904, 2034, 1905, 2035, 1907, 81, 1908, 81, 1921, 81, 1933,
+	81, 1934, 81, 1935, 81, 1936, 81, 1942, 2057, 1943, 81, 1944, 81, 1945, 81,
+	1950, 81, 1954, 193, 1955, 2068, 1956, 81, 1962, 81, 1964, 81, 1965, 81,
+	1966, 193, 1967, 81, 1971, 81, 1973, 81, 1976, 193, 1977, 81, 1978, 193,
+	1979, 81, 1985, 81, 2016, 81, 2018, 81, 2023, 193, 2027, 193, 2031, 81,
+	2032, 81, 2035, 81, 2042, 193, 2044, 193, 2047, 193, 2053, 193, 2054, 193,
+	2055, 81, 2056, 193, 2061, 81, 2064, 81, 2065, 193, 2066, 193, 2068, 193,
+	2073, 193, 2074, 81, 2076, 193, 2081, 81, 2082, 193, 2083, 2181, 2085, 193,
+	2093, 81, 2094, 81, 2113, 81, 2117, 81, 2120, 193, 2121, 193, 2122, 81,
+	2135, 81, 2139, 193, 2153, 81, 2156, 193, 2161, 81, 2162, 81, 2168, 2068,
+	2175, 81, 2178, 81, 2196, 81, 2197, 81, 2200, 81, 2201, 81, 2202, 81, 2213,
+	81, 2243, 81, 2250, 81, 2252, 81, 2265, 193, 2273, 81, 2279, 81, 2281, 81,
+	2285, 81, 2296, 193, 2311, 81, 2343, 193, 2345, 81, 2355, 81, 2362, 81,
+	2363, 81, 2364, 81, 2391, 2408, 2393, 2411, 2416, 2426, 2424, 2433, 2427,
+	81, 2445, 2450, 438, 682, 623, 1168, 2124, 2214, 2128, 2217, 2130, 2218,
+	2152, 2230, 2250, 2293, 2288, 2321, 2418, 2429, 2423, 2432, 2435, 2443,
+	2442, 2448, 2453, 2455, 21, 82, 82, 82, 83, 133, 86, 194, 111, 272, 115,
+	334, 133, 82, 135, 82, 193, 82, 194, 82, 195, 442, 261, 444, 266, 82, 268,
+	457, 301, 489, 343, 82, 344, 82, 349, 82, 359, 82, 360, 82, 367, 82, 371,
+	82, 372, 82, 373, 82, 391, 82, 392, 82, 393, 82, 394, 82, 395, 82, 396, 82,
+	397, 82, 398, 82, 399, 82, 414, 82, 415, 82, 416, 82, 420, 82, 421, 82, 423,
+	82, 424, 82, 426, 82, 427, 82, 428, 82, 430, 82, 432, 82, 433, 82, 434, 82,
+	435, 82, 436, 194, 442, 82, 489, 82, 630, 82, 634, 82, 638, 82, 647, 82,
+	657, 82, 658, 82, 662, 82, 663, 82, 666, 82, 669, 82, 673, 82, 675, 82, 681,
+	82, 689, 1264, 784, 82, 785, 82, 787, 82, 799, 82, 801, 82, 802, 82, 805,
+	82, 809, 82, 838, 82, 839, 82, 840, 82, 841, 82, 842, 82, 843, 82, 844, 82,
+	847, 82, 849, 82, 850, 82, 876, 82, 925, 82, 941, 82, 942, 82, 948, 82, 994,
+	82, 1013, 82, 1056, 82, 1057, 82, 1058, 82, 1070, 82, 1071, 82, 1075, 82,
+	1076, 82, 1104, 82, 1105, 82, 1106, 82, 1107, 82, 1116, 82, 1130, 82, 1140,
+	1452, 1148, 489, 1166, 82, 1169, 82, 1215, 82, 1216, 82, 1221, 82, 1223, 82,
+	1225, 82, 1227, 82, 1228, 82, 1230, 82, 1231, 82, 1232, 82, 1233, 82, 1234,
+	82, 1235, 82, 1238, 82, 1239, 82, 1240, 82, 1243, 82, 1244, 82, 1246, 82,
+	1247, 82, 1249, 82, 1250, 82, 1251, 82, 1253, 82, 1255, 82, 1256, 82, 1257,
+	82, 1258, 82, 1273, 82, 1452, 82, 1455, 82, 1457, 82, 1462, 82, 1464, 82,
+	1467, 82, 1476, 82, 1477, 82, 1484, 82, 1485, 82, 1486, 82, 1487, 82, 1488,
+	82, 1493, 82, 1494, 82, 1495, 82, 1496, 82, 1498, 82, 1504, 82, 1506, 82,
+	1507, 82, 1508, 82, 1511, 82, 1513, 82, 1514, 82, 1515, 82, 1518, 82, 1520,
+	82, 1522, 82, 1524, 82, 1525, 82, 1526, 82, 1527, 82, 1603, 82, 1604, 82,
+	1619, 82, 1620, 82, 1623, 82, 1624, 82, 1625, 82, 1626, 82, 1629, 82, 1639,
+	82, 1648, 82, 1649, 82, 1653, 82, 1654, 82, 1657, 82, 1660, 82, 1664, 82,
+	1666, 82, 1721, 82, 1727, 82, 1732, 194, 1737, 194, 1740, 194, 1746, 82,
+	1748, 82, 1752, 82, 1753, 82, 1759, 82, 1760, 82, 1761, 82, 1762, 82, 1771,
+	82, 1772, 82, 1773, 82, 1775, 82, 1778, 194, 1780, 82, 1781, 82, 1783, 82,
+	1788, 82, 1789, 82, 1792, 82, 1835, 82, 1843, 82, 1846, 82, 1872, 82, 1875,
+	82, 1878, 82, 1879, 194, 1894, 82, 1895, 82, 1907, 82, 1908, 82, 1921, 82,
+	1933, 82, 1934, 82, 1935, 82, 1936, 82, 1943, 82, 1944, 82, 1945, 82, 1950,
+	82, 1954, 194, 1956, 82, 1962, 82, 1964, 82, 1965, 82, 1966, 194, 1967, 82,
+	1971, 82, 1973, 82, 1976, 194, 1977, 82, 1978, 194, 1979, 82, 1985, 82,
+	2016, 82, 2018, 82, 2023, 194, 2027, 194, 2031, 82, 2032, 82, 2035, 82,
+	2042, 194, 2044, 194, 2047, 194, 2053, 194, 2054, 194, 2055, 82, 2056, 194,
+	2061, 82, 2064, 82, 2065, 194, 2066, 194, 2068, 194, 2073, 194, 2074, 82,
+	2076, 194, 2081, 82, 2082, 194, 2085, 194, 2093, 82, 2094, 82, 2113, 82,
+	2117, 82, 2120, 194, 2121, 194, 2122, 82, 2135, 82, 2139, 194, 2153, 82,
+	2156, 194, 2161, 82, 2162, 82, 2175, 82, 2178, 82, 2196, 82, 2197, 82, 2200,
+	82, 2201, 82, 2202, 82, 2213, 82, 2243, 82, 2250, 82, 2252, 82, 2265, 194,
+	2273, 82, 2279, 82, 2281, 82, 2285, 82, 2296, 194, 2311, 82, 2343, 194,
+	2345, 82, 2355, 82, 2362, 82, 2363, 82, 2364, 82, 2427, 82, 82, 128, 131,
+	345, 133, 347, 194, 440, 348, 627, 441, 683, 442, 684, 460, 755, 685, 1261,
+	688, 1263, 694, 1268, 1152, 1458, 1160, 1459, 1673, 1856, 1715, 1871, 137,
+	357, 21, 83, 82, 83, 86, 195, 133, 83, 135, 83, 193, 83, 194, 83, 195, 83,
+	266, 83, 343, 83, 344, 83, 349, 83, 359, 83, 360, 83, 367, 83, 371, 83, 372,
+	83, 373, 83, 391, 83, 392, 83, 393, 83, 394, 83, 395, 83, 396, 83, 397, 83,
+	398, 83, 399, 83, 414, 83, 415, 83, 416, 83, 420, 83, 421, 83, 423, 83, 424,
+	83, 426, 83, 427, 83, 428, 83, 430, 83, 432, 83, 433, 83, 434, 83, 435, 83,
+	436, 195, 442, 83, 489, 83, 630, 83, 634, 83, 638, 83, 647, 83, 657, 83,
+	658, 83, 662, 83, 663, 83, 666, 83, 669, 83, 673, 83, 675, 83, 681, 83, 784,
+	83, 785, 83, 787, 83, 799, 83, 801, 83, 802, 83, 805, 83, 809, 83, 838, 83,
+	839, 83, 840, 83, 841, 83, 842, 83, 843, 83, 844, 83, 847, 83, 849, 83, 850,
+	83, 876, 83, 925, 83, 941, 83, 942, 83, 948, 83, 994, 83, 1013, 83, 1056,
+	83, 1057, 83, 1058, 83, 1070, 83, 1071, 83, 1075, 83, 1076, 83, 1104, 83,
+	1105, 83, 1106, 83, 1107, 83, 1116, 83, 1130, 83, 1166, 83, 1169, 83, 1215,
+	83, 1216, 83, 1221, 83, 1223, 83, 1225, 83, 1227, 83, 1228, 83, 1230, 83,
+	1231, 83, 1232, 83, 1233, 83, 1234, 83, 1235, 83, 1238, 83, 1239, 83, 1240,
+	83, 1243, 83, 1244, 83, 1246, 83, 1247, 83, 1249, 83, 1250, 83, 1251, 83,
+	1253, 83, 1255, 83, 1256, 83, 1257, 83, 1258, 83, 1273, 83, 1452, 83, 1455,
+	83, 1457, 83, 1462, 83, 1464, 83, 1467, 83, 1476, 83, 1477, 83, 1484, 83,
+	1485, 83, 1486, 83, 1487, 83, 1488, 83, 1493, 83, 1494, 83, 1495, 83, 1496,
+	83, 1498, 83, 1504, 83, 1506, 83, 1507, 83, 1508, 83, 1511, 83, 1513, 83,
+	1514, 83, 1515, 83, 1518, 83, 1520, 83, 1522, 83, 1524, 83, 1525, 83, 1526,
+	83, 1527, 83, 1603, 83, 1604, 83, 1619, 83, 1620, 83, 1623, 83, 1624, 83,
+	1625, 83, 1626, 83, 1629, 83, 1639, 83, 1648, 83, 1649, 83, 1653, 83, 1654,
+	83, 1657, 83, 1660, 83, 1664, 83, 1666, 83, 1721, 83, 1727, 83, 1732, 195,
+	1737, 195, 1740, 195, 1746, 83, 1748, 83, 1752, 83, 1753, 83, 1759, 83,
+	1760, 83, 1761, 83, 1762, 83, 1771, 83, 1772, 83, 1773, 83, 1775, 83, 1778,
+	195, 1780, 83, 1781, 83, 1783, 83, 1788, 83, 1789, 83, 1792, 83, 1835, 83,
+	1843, 83, 1846, 83, 1872, 83, 1875, 83, 1878, 83, 1879, 195, 1894, 83, 1895,
+	83, 1907, 83, 1908, 83, 1921, 83, 1933, 83, 1934, 83, 1935, 83, 1936, 83,
+	1943, 83, 1944, 83, 1945, 83, 1950, 83, 1954, 195, 1956, 83, 1962, 83, 1964,
+	83, 1965, 83, 1966, 195, 1967, 83, 1971, 83, 1973, 83, 1976, 195, 1977, 83,
+	1978, 195, 1979, 83, 1985, 83, 2016, 83, 2018, 83, 2023, 195, 2027, 195,
+	2031, 83, 2032, 83, 2035, 83, 2042, 195, 2044, 195, 2047, 195, 2053, 195,
+	2054, 195, 2055, 83, 2056, 195, 2061, 83, 2064, 83, 2065, 195, 2066, 195,
+	2068, 195, 2073, 195, 2074, 83, 2076, 195, 2081, 83, 2082, 195, 2085, 195,
+	2093, 83, 2094, 83, 2113, 83, 2117, 83, 2120, 195, 2121, 195, 2122, 83,
+	2135, 83, 2139, 195, 2153, 83, 2156, 195, 2161, 83, 2162, 83, 2175, 83,
+	2178, 83, 2196, 83, 2197, 83, 2200, 83, 2201, 83, 2202, 83, 2213, 83, 2243,
+	83, 2250, 83, 2252, 83, 2265, 195, 2273, 83, 2279, 83, 2281, 83, 2285, 83,
+	2296, 195, 2311, 83, 2343, 195, 2345, 83, 2355, 83, 2362, 83, 2363, 83,
+	2364, 83, 2427, 83, 2, 9, 10, 43, 11, 44, 23, 104, 24, 105, 25, 106, 26,
+	107, 27, 108, 46, 111, 698, 1269, 699, 1271, 757, 1275, 1528, 1800, 2358,
+	1269, 347, 626, 443, 686, 627, 1170, 628, 1171, 684, 1260, 1261, 1671, 1616,
+	1805, 1282, 1686, 1421, 1709, 4, 23, 22, 103, 86, 196, 148, 370, 170, 419,
+	368, 643, 436, 196, 1611, 1804, 1732, 196, 1737, 196, 1740, 196, 1778, 196,
+	1802, 1983, 1803, 1984, 1879, 196, 1954, 196, 1966, 196, 1976, 196, 1978,
+	196, 2023, 196, 2027, 196, 2042, 196, 2044, 196, 2047, 196, 2053, 196, 2054,
+	196, 2056, 196, 2065, 196, 2066, 196, 2068, 196, 2073, 196, 2076, 196, 2082,
+	196, 2085, 196, 2120, 196, 2121, 196, 2139, 196, 2156, 196, 2265, 196, 2296,
+	196, 2343, 196, 4, 24, 21, 84, 82, 84, 86, 197, 133, 84, 135, 84, 193, 84,
+	194, 84, 195, 84, 262, 445, 266, 84, 343, 84, 344, 84, 349, 84, 359, 84,
+	360, 84, 367, 84, 371, 84, 372, 84, 373, 84, 391, 84, 392, 84, 393, 84, 394,
+	84, 395, 84, 396, 84, 397, 84, 398, 84, 399, 84, 414, 84, 415, 84, 416, 84,
+	420, 84, 421, 84, 423, 84, 424, 84, 426, 84, 427, 84, 428, 84, 430, 84, 432,
+	84, 433, 84, 434, 84, 435, 84, 442, 84, 489, 84, 629, 1198, 630, 84, 634,
+	84, 638, 84, 647, 84, 657, 84, 658, 84, 662, 84, 663, 84, 666, 84, 669, 84,
+	673, 84, 675, 84, 681, 84, 784, 84, 785, 84, 787, 84, 799, 84, 801, 84, 802,
+	84, 805, 84, 809, 84, 838, 84, 839, 84, 840, 84, 841, 84, 842, 84, 843, 84,
+	844, 84, 847, 84, 849, 84, 850, 84, 876, 84, 925, 84, 941, 84, 942, 84, 948,
+	84, 994, 84, 1013, 84, 1056, 84, 1057, 84, 1058, 84, 1070, 84, 1071, 84,
+	1075, 84, 1076, 84, 1104, 84, 1105, 84, 1106, 84, 1107, 84, 1116, 84, 1130,
+	84, 1162, 1528, 1166, 84, 1169, 84, 1215, 84, 1216, 84, 1221, 84, 1222,
+	1634, 1223, 84, 1225, 84, 1227, 84, 1228, 84, 1230, 84, 1231, 84, 1232, 84,
+	1233, 84, 1234, 84, 1235, 84, 1238, 84, 1239, 84, 1240, 84, 1243, 84, 1244,
+	84, 1246, 84, 1247, 84, 1249, 84, 1250, 84, 1251, 84, 1253, 84, 1255, 84,
+	1256, 84, 1257, 84, 1258, 84, 1273, 84, 1452, 84, 1455, 84, 1457, 84, 1462,
+	84, 1464, 84, 1467, 84, 1476, 84, 1477, 84, 1484, 84, 1485, 84, 1486, 84,
+	1487, 84, 1488, 84, 1493, 84, 1494, 84, 1495, 84, 1496, 84, 1498, 84, 1504,
+	84, 1506, 84, 1507, 84, 1508, 84, 1511, 84, 1513, 84, 1514, 84, 1515, 84,
+	1518, 84, 1520, 84, 1522, 84, 1524, 84, 1525, 84, 1526, 84, 1527, 84, 1603,
+	84, 1604, 84, 1619, 84, 1620, 84, 1623, 84, 1624, 84, 1625, 84, 1626, 84,
+	1629, 84, 1639, 84, 1648, 84, 1649, 84, 1653, 84, 1654, 84, 1657, 84, 1660,
+	84, 1664, 84, 1666, 84, 1721, 84, 1727, 84, 1732, 197, 1733, 1897, 1736,
+	1900, 1737, 197, 1738, 1902, 1739, 1902, 1740, 197, 1746, 84, 1748, 84,
+	1752, 84, 1753, 84, 1759, 84, 1760, 84, 1761, 84, 1762, 84, 1771, 84, 1772,
+	84, 1773, 84, 1775, 84, 1778, 197, 1780, 84, 1781, 84, 1783, 84, 1788, 84,
+	1789, 84, 1792, 84, 1835, 84, 1843, 84, 1846, 84, 1872, 84, 1875, 84, 1878,
+	84, 1879, 197, 1894, 84, 1895, 84, 1907, 84, 1908, 84, 1921, 84, 1933, 84,
+	1934, 84, 1935, 84, 1936, 84, 1943, 84, 1944, 84, 1945, 84, 1950, 84, 1954,
+	197, 1956, 84, 1962, 84, 1964, 84, 1965, 84, 1966, 197, 1967, 84, 1971, 84,
+	1973, 84, 1976, 197, 1977, 84, 1978, 197, 1979, 84, 1985, 84, 2016, 84,
+	2018, 84, 2023, 197, 2027, 197, 2031, 84, 2032, 84, 2035, 84, 2042, 197,
+	2044, 197, 2047, 197, 2053, 197, 2054, 197, 2055, 84, 2056, 197, 2061, 84,
+	2064, 84, 2065, 197, 2066, 197, 2068, 197, 2073, 197, 2074, 84, 2076, 197,
+	2081, 84, 2082, 197, 2085, 197, 2093, 84, 2094, 84, 2113, 84, 2117, 84,
+	2120, 197, 2121, 197, 2122, 84, 2135, 84, 2139, 197, 2153, 84, 2156, 197,
+	2161, 84, 2162, 84, 2175, 84, 2178, 84, 2196, 84, 2197, 84, 2200, 84, 2201,
+	84, 2202, 84, 2213, 84, 2240, 2288, 2243, 84, 2250, 84, 2252, 84, 2273, 84,
+	2279, 84, 2281, 84, 2285, 84, 2296, 197, 2311, 84, 2343, 197, 2345, 84,
+	2355, 84, 2362, 84, 2363, 84, 2364, 84, 2427, 84, 334, 566, 567, 566, 17,
+	46, 460, 756, 2301, 756, 2330, 756, 2333, 756, 2334, 756, 2336, 756, 2356,
+	756, 2357, 756, 2383, 756, 2384, 756, 2385, 756, 2391, 756, 2393, 756, 2404,
+	756, 2424, 756, 4, 25, 2397, 2415, 4, 26, 47, 112, 115, 112, 1794, 112,
+	1975, 112, 2040, 112, 2113, 112, 2168, 112, 2236, 112, 2247, 112, 2267, 112,
+	2269, 112, 2281, 112, 2332, 112, 2339, 112, 2341, 112, 2350, 112, 2352, 112,
+	2420, 112, 4, 27, 112, 302, 457, 692, 489, 1149, 784, 1149, 785, 1149, 787,
+	1149, 799, 1149, 801, 1149, 802, 1149, 805, 1149, 809, 1149, 838, 1149, 839,
+	1149, 840, 1149, 841, 1149, 842, 1149, 843, 1149, 844, 1149, 847, 1149, 849,
+	1149, 850, 1149, 876, 1149, 925, 1149, 941, 1149, 942, 1149, 948, 1149, 994,
+	1149, 1013, 1149, 1056, 1149, 1057, 1149, 1058, 1149, 1070, 1149, 1071,
+	1149, 1075, 1149, 1076, 1149, 1104, 1149, 1105, 1149, 1106, 1149, 1107,
+	1149, 1116, 1149, 1130, 1149, 1267, 692, 1452, 1149, 1457, 1149, 2122, 1149,
+	2311, 1149, 86, 198, 436, 198, 796, 1298, 803, 1307, 808, 1316, 813, 1321,
+	893, 1346, 922, 1360, 923, 1361, 995, 1385, 1024, 1400, 1036, 1403, 1123,
+	1445, 1732, 198, 1737, 198, 1740, 198, 1778, 198, 1879, 198, 1954, 198,
+	1966, 198, 1976, 198, 1978, 198, 2023, 198, 2027, 198, 2042, 198, 2044, 198,
+	2047, 198, 2053, 198, 2054, 198, 2056, 198, 2065, 198, 2066, 198, 2068, 198,
+	2073, 198, 2076, 198, 2082, 198, 2085, 198, 2120, 198, 2121, 198, 2139, 198,
+	2156, 198, 2265, 198, 2296, 198, 2343, 198, 86, 199, 436, 199, 786, 1294,
+	847, 1334, 894, 1347, 966, 1375, 1072, 1418, 1074, 1424, 1104, 1334, 1105,
+	1334, 1106, 1334, 1107, 1334, 1732, 199, 1737, 199, 1740, 199, 1778, 199,
+	1879, 199, 1954, 199, 1966, 199, 1976, 199, 1978, 199, 2023, 199, 2027, 199,
+	2042, 199, 2044, 199, 2047, 199, 2053, 199, 2054, 199, 2056, 199, 2065, 199,
+	2066, 199, 2068, 199, 2073, 199, 2076, 199, 2082, 199, 2085, 199, 2120, 199,
+	2121, 199, 2139, 199, 2156, 199, 2265, 199, 2296, 199, 2343, 199, 81, 127,
+	83, 134, 342, 622, 346, 625, 444, 687, 467, 903, 589, 1165, 769, 1276, 770,
+	1277, 771, 1281, 773, 1286, 774, 1288, 797, 1299, 798, 1301, 804, 1309, 806,
+	1313, 810, 1318, 846, 1333, 848, 1309, 875, 1342, 974, 1378, 981, 1381,
+	1004, 1389, 1043, 1406, 1073, 1420, 1077, 1427, 1078, 1429, 1115, 1440,
+	1172, 1618, 1262, 1672, 1264, 687, 1269, 1679, 1270, 1680, 1271, 1681, 1272,
+	1682, 1274, 1684, 1350, 903, 1617, 1806, 1621, 1809, 1622, 1810, 1636, 1822,
+	1686, 1281, 1709, 1420, 1864, 2020, 1867, 2021, 1909, 2039, 2040, 2137,
+	2358, 1618, 86, 200, 436, 200, 1732, 200, 1737, 200, 1740, 200, 1778, 200,
+	1879, 200, 1954, 200, 1966, 200, 1976, 200, 1978, 200, 2023, 200, 2027, 200,
+	2042, 200, 2044, 200, 2047, 200, 2053, 200, 2054, 200, 2056, 200, 2065, 200,
+	2066, 200, 2068, 200, 2073, 200, 2076, 200, 2082, 200, 2085, 200, 2120, 200,
+	2121, 200, 2139, 200, 2156, 200, 2265, 200, 2296, 200, 2343, 200, 9, 42, 43,
+	109, 44, 110, 66, 123, 147, 369, 460, 757, 629, 757, 772, 1285, 800, 1304,
+	807, 1315, 811, 1319, 812, 1320, 814, 1322, 845, 1332, 909, 1352, 911, 1355,
+	912, 1356, 913, 1357, 924, 1362, 1005, 1391, 1021, 1397, 1022, 1398, 1023,
+	1399, 1044, 1407, 1045, 1408, 1139, 1451, 1148, 1456, 1275, 1685, 1632,
+	1818, 1923, 2045, 1996, 2096, 2301, 757, 2330, 757, 2333, 757, 2334, 757,
+	2336, 757, 2343, 757, 2356, 757, 2357, 757, 2360, 2398, 2361, 2399, 2365,
+	2403, 2383, 757, 2384, 757, 2385, 757, 2391, 757, 2393, 757, 2404, 757,
+	2408, 2417, 2411, 2417, 2424, 757, 2428, 2417, 2433, 2417, 86, 201, 436,
+	201, 489, 1150, 784, 1150, 785, 1150, 787, 1150, 799, 1150, 801, 1150, 802,
+	1150, 805, 1150, 809, 1150, 838, 1150, 839, 1150, 840, 1150, 841, 1150, 842,
+	1150, 843, 1150, 844, 1150, 847, 1150, 849, 1150, 850, 1150, 876, 1150, 925,
+	1150, 941, 1150, 942, 1150, 948, 1150, 994, 1150, 1013, 1150, 1056, 1150,
+	1057, 1150, 1058, 1150, 1070, 1150, 1071, 1150, 1075, 1150, 1076, 1150,
+	1104, 1150, 1105, 1150, 1106, 1150, 1107, 1150, 1116, 1150, 1130, 1150,
+	1452, 1150, 1457, 1150, 1732, 201, 1737, 201, 1740, 201, 1778, 201, 1879,
+	201, 1954, 201, 1966, 201, 1976, 201, 1978, 201, 2023, 201, 2027, 201, 2042,
+	201, 2044, 201, 2047, 201, 2053, 201, 2054, 201, 2056, 201, 2065, 201, 2066,
+	201, 2068, 201, 2073, 201, 2076, 201, 2082, 201, 2085, 201, 2120, 201, 2121,
+	201, 2139, 201, 2156, 201, 2265, 201, 2296, 201, 2343, 201, 0, 2458, 0, 1,
+	1, 4, 4, 28, 1, 5, 1, 6, 1, 7, 1, 8, 4, 29, 4, 30, 4, 31, 267, 456, 2301,
+	2333, 2334, 2383, 2336, 2384, 2385, 2404, 4, 32, 2263, 2301, 2303, 2336,
+	1523, 1795, 1734, 1898, 1758, 1937, 1777, 1957, 1779, 1959, 1910, 2041,
+	1930, 2046, 1932, 2048, 1958, 2072, 2024, 2112, 2025, 2114, 2026, 2115,
+	2029, 2118, 2038, 2136, 2049, 2143, 2050, 2144, 2051, 2145, 2052, 2146,
+	2060, 2155, 2062, 2159, 2067, 2166, 2069, 2169, 2071, 2170, 2075, 2173,
+	2078, 2176, 2079, 2177, 2084, 2182, 2086, 2184, 2088, 2185, 2089, 2186,
+	2090, 2187, 2091, 2188, 2111, 2203, 2116, 2207, 2126, 2215, 2133, 2220,
+	2138, 2222, 2140, 2224, 2142, 2225, 2147, 2226, 2148, 2227, 2150, 2229,
+	2154, 2232, 2163, 2237, 2164, 2238, 2165, 2239, 2171, 2242, 2174, 2244,
+	2179, 2248, 2180, 2249, 2183, 2251, 2204, 2266, 2205, 2268, 2206, 2270,
+	2214, 2275, 2218, 2278, 2223, 2280, 2228, 2282, 2230, 2283, 2231, 2284,
+	2245, 2290, 2272, 2308, 2287, 2320, 2289, 2322, 2292, 2324, 2293, 2325,
+	2301, 2334, 2304, 2337, 2305, 2338, 2306, 2340, 2307, 2342, 2315, 2347,
+	2319, 2351, 2323, 2353, 2336, 2385, 2386, 2405, 2387, 2406, 2394, 2412,
+	2395, 2413, 2409, 2421, 2424, 2434, 2430, 2439, 2440, 2446, 2443, 2449,
+	2448, 2452, 2454, 2456, 2455, 2457, 2332, 2377, 2017, 2105, 2019, 2105,
+	2106, 2200, 2108, 2202, 4, 33, 2257, 2300, 2264, 2302, 1861, 2016, 1862,
+	2018, 2197, 2257, 2201, 2264, 4, 34, 18, 47, 61, 115, 4, 35, 2299, 2330,
+	2328, 2356, 2329, 2357, 19, 61, 47, 113, 621, 1166, 1163, 1603, 1164, 1604,
+	1735, 1899, 1767, 1946, 2092, 2189, 2330, 2366, 2356, 2366, 2357, 2366,
+	2330, 2367, 2356, 2367, 2357, 2367, 2330, 2368, 2356, 2368, 2357, 2368,
+	2330, 2369, 2356, 2369, 2357, 2369, 2330, 2370, 2356, 2370, 2357, 2370, 334,
+	567, 115, 335, 567, 1160, 4, 36, 272, 460, 2346, 2391, 2349, 2393, 2414,
+	2424, 457, 693, 457, 694, 4, 37, 457, 695, 1267, 1678, 4, 38, 108, 270, 444,
+	688, 1264, 1673, 4, 39, 1160, 1460, 4, 40, 21, 85, 82, 129, 133, 129, 135,
+	351, 193, 85, 194, 85, 195, 85, 266, 449, 343, 623, 344, 624, 349, 628, 359,
+	85, 360, 85, 367, 85, 371, 85, 372, 85, 373, 85, 391, 85, 392, 85, 393, 85,
+	394, 85, 395, 85, 396, 85, 397, 85, 398, 85, 399, 85, 414, 85, 415, 85, 416,
+	85, 420, 85, 421, 85, 423, 85, 424, 85, 426, 85, 427, 85, 428, 85, 430, 85,
+	432, 85, 433, 85, 434, 85, 435, 85, 442, 129, 489, 85, 630, 351, 634, 85,
+	638, 85, 647, 85, 657, 1236, 658, 85, 662, 85, 663, 85, 666, 85, 669, 85,
+	673, 85, 675, 85, 681, 85, 784, 85, 785, 85, 787, 85, 799, 85, 801, 85, 802,
+	85, 805, 85, 809, 85, 838, 85, 839, 85, 840, 85, 841, 85, 842, 85, 843, 85,
+	844, 85, 847, 85, 849, 85, 850, 85, 876, 85, 925, 85, 941, 85, 942, 85, 948,
+	85, 994, 85, 1013, 85, 1056, 85, 1057, 85, 1058, 85, 1070, 85, 1071, 85,
+	1075, 85, 1076, 85, 1104, 85, 1105, 85, 1106, 85, 1107, 85, 1116, 85, 1130,
+	85, 1166, 1611, 1169, 1616, 1215, 1627, 1216, 85, 1221, 1633, 1223, 85,
+	1225, 85, 1227, 85, 1228, 85, 1230, 1642, 1231, 1643, 1232, 1644, 1233,
+	1645, 1234, 85, 1235, 85, 1238, 85, 1239, 85, 1240, 1652, 1243, 85, 1244,
+	1656, 1246, 85, 1247, 1659, 1249, 85, 1250, 1662, 1251, 85, 1253, 1665,
+	1255, 1667, 1256, 85, 1257, 85, 1258, 1670, 1273, 1683, 1452, 85, 1455, 85,
+	1457, 85, 1462, 85, 1464, 85, 1467, 85, 1476, 85, 1477, 85, 1484, 85, 1485,
+	85, 1486, 85, 1487, 85, 1488, 85, 1493, 85, 1494, 85, 1495, 85, 1496, 85,
+	1498, 1768, 1504, 85, 1506, 85, 1507, 85, 1508, 85, 1511, 85, 1513, 85,
+	1514, 85, 1515, 85, 1518, 85, 1520, 85, 1522, 85, 1524, 85, 1525, 85, 1526,
+	85, 1527, 85, 1603, 1802, 1604, 1803, 1619, 1807, 1620, 1808, 1623, 1811,
+	1624, 1812, 1625, 1813, 1626, 85, 1629, 85, 1639, 85, 1648, 85, 1649, 85,
+	1653, 85, 1654, 85, 1657, 85, 1660, 85, 1664, 85, 1666, 85, 1721, 85, 1727,
+	85, 1746, 85, 1748, 85, 1752, 85, 1753, 85, 1759, 85, 1760, 85, 1761, 1940,
+	1762, 85, 1771, 1951, 1772, 85, 1773, 85, 1775, 1955, 1780, 85, 1781, 85,
+	1783, 85, 1788, 85, 1789, 85, 1792, 85, 1835, 85, 1843, 85, 1846, 85, 1872,
+	85, 1875, 2024, 1878, 2025, 1894, 85, 1895, 2029, 1907, 85, 1908, 85, 1921,
+	85, 1933, 2049, 1934, 2050, 1935, 2051, 1936, 2052, 1943, 85, 1944, 85,
+	1945, 2060, 1950, 2063, 1956, 2071, 1962, 2075, 1964, 85, 1965, 2078, 1967,
+	85, 1971, 2084, 1973, 2086, 1977, 2089, 1979, 2091, 1985, 351, 2016, 2103,
+	2018, 2107, 2031, 2120, 2032, 85, 2035, 85, 2055, 85, 2061, 2156, 2064, 85,
+	2074, 85, 2081, 85, 2093, 351, 2094, 351, 2113, 85, 2117, 85, 2122, 85,
+	2135, 85, 2153, 85, 2161, 85, 2162, 85, 2175, 85, 2178, 85, 2196, 85, 2197,
+	85, 2200, 2263, 2201, 85, 2202, 2265, 2213, 85, 2243, 85, 2250, 85, 2252,
+	2296, 2273, 85, 2279, 85, 2281, 85, 2285, 85, 2311, 85, 2345, 85, 2355, 85,
+	2362, 85, 2363, 85, 2364, 85, 2427, 85, 21, 86, 82, 130, 133, 130, 135, 130,
+	193, 436, 194, 436, 195, 436, 266, 130, 343, 130, 344, 130, 349, 130, 359,
+	436, 360, 436, 367, 436, 371, 436, 372, 436, 373, 436, 391, 436, 392, 436,
+	393, 436, 394, 436, 395, 436, 396, 436, 397, 436, 398, 436, 399, 436, 414,
+	436, 415, 436, 416, 436, 420, 436, 421, 436, 423, 436, 424, 436, 426, 436,
+	427, 436, 428, 436, 430, 436, 432, 436, 433, 436, 434, 436, 435, 436, 442,
+	436, 489, 86, 630, 130, 634, 436, 638, 436, 647, 436, 657, 130, 658, 436,
+	662, 436, 663, 436, 666, 436, 669, 436, 673, 436, 675, 436, 681, 436, 784,
+	86, 785, 86, 787, 86, 799, 86, 801, 86, 802, 86, 805, 86, 809, 86, 838, 86,
+	839, 86, 840, 86, 841, 86, 842, 86, 843, 86, 844, 86, 847, 86, 849, 86, 850,
+	86, 876, 86, 925, 86, 941, 86, 942, 86, 948, 86, 994, 86, 1013, 86, 1056,
+	86, 1057, 86, 1058, 86, 1070, 86, 1071, 86, 1075, 86, 1076, 86, 1104, 86,
+	1105, 86, 1106, 86, 1107, 86, 1116, 86, 1130, 86, 1166, 130, 1169, 130,
+	1215, 130, 1216, 436, 1221, 130, 1223, 436, 1225, 436, 1227, 436, 1228, 436,
+	1230, 130, 1231, 130, 1232, 130, 1233, 130, 1234, 436, 1235, 436, 1238, 436,
+	1239, 436, 1240, 130, 1243, 436, 1244, 130, 1246, 436, 1247, 130, 1249, 436,
+	1250, 130, 1251, 436, 1253, 130, 1255, 130, 1256, 436, 1257, 436, 1258, 130,
+	1273, 130, 1452, 86, 1455, 86, 1457, 86, 1462, 86, 1464, 86, 1467, 86, 1476,
+	86, 1477, 86, 1484, 86, 1485, 86, 1486, 86, 1487, 86, 1488, 86, 1493, 86,
+	1494, 86, 1495, 86, 1496, 86, 1498, 130, 1504, 86, 1506, 86, 1507, 86, 1508,
+	130, 1511, 86, 1513, 86, 1514, 86, 1515, 86, 1518, 86, 1520, 86, 1522, 86,
+	1524, 86, 1525, 86, 1526, 86, 1527, 86, 1603, 130, 1604, 130, 1619, 130,
+	1620, 130, 1623, 130, 1624, 130, 1625, 130, 1626, 436, 1629, 436, 1639, 436,
+	1648, 436, 1649, 436, 1653, 436, 1654, 436, 1657, 436, 1660, 436, 1664, 436,
+	1666, 436, 1721, 86, 1727, 86, 1746, 86, 1748, 86, 1752, 86, 1753, 86, 1759,
+	86, 1760, 86, 1761, 130, 1762, 86, 1771, 130, 1772, 86, 1773, 86, 1775, 130,
+	1780, 86, 1781, 86, 1783, 86, 1788, 86, 1789, 86, 1792, 86, 1835, 436, 1843,
+	436, 1846, 436, 1872, 86, 1875, 130, 1878, 130, 1894, 86, 1895, 130, 1907,
+	86, 1908, 86, 1921, 86, 1933, 130, 1934, 130, 1935, 130, 1936, 130, 1943,
+	86, 1944, 86, 1945, 130, 1950, 130, 1956, 130, 1962, 130, 1964, 86, 1965,
+	130, 1967, 86, 1971, 130, 1973, 130, 1977, 130, 1979, 130, 1985, 130, 2016,
+	130, 2018, 130, 2031, 130, 2032, 130, 2035, 130, 2055, 86, 2061, 130, 2064,
+	86, 2074, 86, 2081, 86, 2093, 130, 2094, 130, 2113, 86, 2117, 86, 2122, 86,
+	2135, 86, 2153, 86, 2161, 86, 2162, 86, 2175, 86, 2178, 86, 2196, 436, 2197,
+	436, 2200, 130, 2201, 436, 2202, 130, 2213, 130, 2243, 86, 2250, 436, 2252,
+	130, 2273, 130, 2279, 86, 2281, 86, 2285, 130, 2311, 86, 2345, 130, 2355,
+	130, 2362, 436, 2363, 436, 2364, 436, 2427, 86, 21, 87, 82, 87, 133, 87,
+	135, 87, 193, 87, 194, 87, 195, 87, 266, 87, 343, 87, 344, 87, 349, 87, 359,
+	87, 360, 87, 367, 87, 371, 87, 372, 87, 373, 87, 391, 87, 392, 87, 393, 87,
+	394, 87, 395, 87, 396, 87, 397, 87, 398, 87, 399, 87, 414, 87, 415, 87, 416,
+	87, 420, 87, 421, 87, 423, 87, 424, 87, 426, 87, 427, 87, 428, 87, 430, 87,
+	432, 87, 433, 87, 434, 87, 435, 87, 442, 87, 489, 87, 630, 87, 634, 87, 638,
+	87, 647, 87, 657, 87, 658, 87, 662, 87, 663, 87, 666, 87, 669, 87, 673, 87,
+	675, 87, 681, 87, 784, 87, 785, 87, 787, 87, 799, 87, 801, 87, 802, 87, 805,
+	87, 809, 87, 838, 87, 839, 87, 840, 87, 841, 87, 842, 87, 843, 87, 844, 87,
+	847, 87, 849, 87, 850, 87, 876, 87, 925, 87, 941, 87, 942, 87, 948, 87, 994,
+	87, 1013, 87, 1056, 87, 1057, 87, 1058, 87, 1070, 87, 1071, 87, 1075, 87,
+	1076, 87, 1104, 87, 1105, 87, 1106, 87, 1107, 87, 1116, 87, 1130, 87, 1166,
+	87, 1169, 87, 1215, 87, 1216, 87, 1221, 87, 1223, 87, 1225, 87, 1227, 87,
+	1228, 87, 1230, 87, 1231, 87, 1232, 87, 1233, 87, 1234, 87, 1235, 87, 1238,
+	87, 1239, 87, 1240, 87, 1243, 87, 1244, 87, 1246, 87, 1247, 87, 1249, 87,
+	1250, 87, 1251, 87, 1253, 87, 1255, 87, 1256, 87, 1257, 87, 1258, 87, 1273,
+	87, 1452, 87, 1455, 87, 1457, 87, 1462, 87, 1464, 87, 1467, 87, 1476, 87,
+	1477, 87, 1484, 87, 1485, 87, 1486, 87, 1487, 87, 1488, 87, 1493, 87, 1494,
+	87, 1495, 87, 1496, 87, 1498, 87, 1504, 87, 1506, 87, 1507, 87, 1508, 1778,
+	1511, 87, 1513, 87, 1514, 87, 1515, 87, 1518, 87, 1520, 87, 1522, 87, 1524,
+	87, 1525, 87, 1526, 87, 1527, 87, 1603, 87, 1604, 87, 1619, 87, 1620, 87,
+	1623, 87, 1624, 87, 1625, 87, 1626, 87, 1629, 87, 1639, 87, 1648, 87, 1649,
+	87, 1653, 87, 1654, 87, 1657, 87, 1660, 87, 1664, 87, 1666, 87, 1721, 87,
+	1727, 87, 1746, 87, 1748, 87, 1752, 87, 1753, 87, 1759, 87, 1760, 87, 1761,
+	87, 1762, 87, 1771, 87, 1772, 87, 1773, 87, 1775, 87, 1780, 87, 1781, 87,
+	1783, 87, 1788, 87, 1789, 87, 1792, 87, 1835, 87, 1843, 87, 1846, 87, 1872,
+	87, 1875, 87, 1878, 87, 1894, 87, 1895, 87, 1907, 87, 1908, 87, 1921, 87,
+	1933, 87, 1934, 87, 1935, 87, 1936, 87, 1943, 87, 1944, 87, 1945, 87, 1950,
+	87, 1956, 87, 1962, 87, 1964, 87, 1965, 87, 1967, 87, 1971, 87, 1973, 87,
+	1977, 87, 1979, 87, 1985, 87, 2016, 87, 2018, 87, 2031, 87, 2032, 2121,
+	2035, 2121, 2055, 87, 2061, 87, 2064, 87, 2074, 87, 2081, 87, 2093, 87,
+	2094, 87, 2113, 87, 2117, 87, 2122, 87, 2135, 87, 2153, 87, 2161, 87, 2162,
+	87, 2175, 87, 2178, 87, 2196, 87, 2197, 87, 2200, 87, 2201, 87, 2202, 87,
+	2213, 2121, 2243, 87, 2250, 87, 2252, 87, 2273, 2310, 2279, 87, 2281, 87,
+	2285, 2310, 2311, 87, 2345, 2310, 2355, 2310, 2362, 87, 2363, 87, 2364, 87,
+	2427, 87, 21, 88, 82, 88, 133, 88, 135, 88, 193, 88, 194, 88, 195, 88, 266,
+	88, 343, 88, 344, 88, 349, 88, 359, 88, 360, 88, 367, 88, 371, 88, 372, 88,
+	373, 88, 391, 88, 392, 88, 393, 88, 394, 88, 395, 88, 396, 88, 397, 88, 398,
+	88, 399, 88, 414, 88, 415, 88, 416, 88, 420, 88, 421, 88, 423, 88, 424, 88,
+	426, 88, 427, 88, 428, 88, 430, 88, 432, 88, 433, 88, 434, 88, 435, 88, 442,
+	88, 489, 88, 630, 88, 634, 88, 638, 88, 647, 88, 657, 88, 658, 88, 662, 88,
+	663, 88, 666, 88, 669, 88, 673, 88, 675, 88, 681, 88, 784, 88, 785, 88, 787,
+	88, 799, 88, 801, 88, 802, 88, 805, 88, 809, 88, 838, 88, 839, 88, 840, 88,
+	841, 88, 842, 88, 843, 88, 844, 88, 847, 88, 849, 88, 850, 88, 876, 88, 925,
+	88, 941, 88, 942, 88, 948, 88, 994, 88, 1013, 88, 1056, 88, 1057, 88, 1058,
+	88, 1070, 88, 1071, 88, 1075, 88, 1076, 88, 1104, 88, 1105, 88, 1106, 88,
+	1107, 88, 1116, 88, 1130, 88, 1166, 88, 1169, 88, 1215, 88, 1216, 88, 1221,
+	88, 1223, 88, 1225, 88, 1227, 88, 1228, 88, 1230, 88, 1231, 88, 1232, 88,
+	1233, 88, 1234, 88, 1235, 88, 1238, 88, 1239, 88, 1240, 88, 1243, 88, 1244,
+	88, 1246, 88, 1247, 88, 1249, 88, 1250, 88, 1251, 88, 1253, 88, 1255, 88,
+	1256, 88, 1257, 88, 1258, 88, 1273, 88, 1452, 88, 1455, 88, 1457, 88, 1462,
+	88, 1464, 88, 1467, 88, 1476, 88, 1477, 88, 1484, 88, 1485, 88, 1486, 88,
+	1487, 88, 1488, 88, 1493, 88, 1494, 88, 1495, 88, 1496, 88, 1498, 88, 1504,
+	88, 1506, 88, 1507, 88, 1508, 1779, 1511, 88, 1513, 88, 1514, 88, 1515, 88,
+	1518, 88, 1520, 88, 1522, 88, 1524, 88, 1525, 88, 1526, 88, 1527, 88, 1603,
+	88, 1604, 88, 1619, 88, 1620, 88, 1623, 88, 1624, 88, 1625, 88, 1626, 88,
+	1629, 88, 1639, 88, 1648, 88, 1649, 88, 1653, 88, 1654, 88, 1657, 88, 1660,
+	88, 1664, 88, 1666, 88, 1721, 88, 1727, 88, 1746, 88, 1748, 88, 1752, 88,
+	1753, 88, 1759, 88, 1760, 88, 1761, 88, 1762, 88, 1771, 88, 1772, 88, 1773,
+	88, 1775, 88, 1780, 88, 1781, 88, 1783, 88, 1788, 88, 1789, 88, 1792, 88,
+	1835, 88, 1843, 88, 1846, 88, 1872, 88, 1875, 88, 1878, 88, 1894, 88, 1895,
+	88, 1907, 88, 1908, 88, 1921, 88, 1933, 88, 1934, 88, 1935, 88, 1936, 88,
+	1943, 88, 1944, 88, 1945, 88, 1950, 88, 1956, 88, 1962, 88, 1964, 88, 1965,
+	88, 1967, 88, 1971, 88, 1973, 88, 1977, 88, 1979, 88, 1985, 88, 2016, 88,
+	2018, 88, 2031, 88, 2032, 88, 2035, 88, 2055, 88, 2061, 88, 2064, 88, 2074,
+	88, 2081, 88, 2093, 88, 2094, 88, 2113, 88, 2117, 88, 2122, 88, 2135, 88,
+	2153, 88, 2161, 88, 2162, 88, 2175, 88, 2178, 88, 2196, 88, 2197, 88, 2200,
+	88, 2201, 88, 2202, 88, 2213, 88, 2243, 88, 2250, 88, 2252, 88, 2273, 88,
+	2279, 88, 2281, 88, 2285, 88, 2311, 88, 2345, 88, 2355, 88, 2362, 88, 2363,
+	88, 2364, 88, 2427, 88, 21, 89, 82, 89, 133, 89, 135, 89, 193, 89, 194, 89,
+	195, 89, 266, 89, 343, 89, 344, 89, 349, 89, 359, 89, 360, 89, 367, 89, 371,
+	89, 372, 89, 373, 89, 391, 89, 392, 89, 393, 89, 394, 89, 395, 89, 396, 89,
+	397, 89, 398, 89, 399, 89, 414, 89, 415, 89, 416, 89, 420, 89, 421, 89, 423,
+	89, 424, 89, 426, 89, 427, 89, 428, 89, 430, 89, 432, 89, 433, 89, 434, 89,
+	435, 89, 442, 89, 489, 89, 630, 89, 634, 89, 638, 89, 647, 89, 657, 89, 658,
+	89, 662, 89, 663, 89, 666, 89, 669, 89, 673, 89, 675, 89, 681, 89, 784, 89,
+	785, 89, 787, 89, 799, 89, 801, 89, 802, 89, 805, 89, 809, 89, 838, 89, 839,
+	89, 840, 89, 841, 89, 842, 89, 843, 89, 844, 89, 847, 89, 849, 89, 850, 89,
+	876, 89, 925, 89, 941, 89, 942, 89, 948, 89, 994, 89, 1013, 89, 1056, 89,
+	1057, 89, 1058, 89, 1070, 89, 1071, 89, 1075, 89, 1076, 89, 1104, 89, 1105,
+	89, 1106, 89, 1107, 89, 1116, 89, 1130, 89, 1166, 89, 1169, 89, 1215, 89,
+	1216, 89, 1221, 89, 1223, 89, 1225, 89, 1227, 89, 1228, 89, 1230, 89, 1231,
+	89, 1232, 89, 1233, 89, 1234, 89, 1235, 89, 1238, 89, 1239, 89, 1240, 89,
+	1243, 89, 1244, 89, 1246, 89, 1247, 89, 1249, 89, 1250, 89, 1251, 89, 1253,
+	89, 1255, 89, 1256, 89, 1257, 89, 1258, 89, 1273, 89, 1452, 89, 1455, 89,
+	1457, 89, 1462, 89, 1464, 89, 1467, 89, 1476, 89, 1477, 89, 1484, 89, 1485,
+	89, 1486, 89, 1487, 89, 1488, 89, 1493, 89, 1494, 89, 1495, 89, 1496, 89,
+	1498, 89, 1504, 89, 1506, 89, 1507, 89, 1508, 89, 1511, 89, 1513, 89, 1514,
+	89, 1515, 89, 1518, 89, 1520, 89, 1522, 89, 1524, 89, 1525, 89, 1526, 89,
+	1527, 89, 1603, 89, 1604, 89, 1619, 89, 1620, 89, 1623, 89, 1624, 89, 1625,
+	89, 1626, 89, 1629, 89, 1639, 89, 1648, 89, 1649, 89, 1653, 89, 1654, 89,
+	1657, 89, 1660, 89, 1664, 89, 1666, 89, 1721, 89, 1727, 89, 1746, 89, 1748,
+	89, 1752, 89, 1753, 89, 1759, 89, 1760, 89, 1761, 89, 1762, 89, 1771, 89,
+	1772, 89, 1773, 89, 1775, 89, 1780, 89, 1781, 89, 1783, 89, 1788, 89, 1789,
+	89, 1792, 89, 1835, 89, 1843, 89, 1846, 89, 1872, 89, 1875, 89, 1878, 89,
+	1894, 89, 1895, 89, 1907, 89, 1908, 89, 1921, 89, 1933, 89, 1934, 89, 1935,
+	89, 1936, 89, 1943, 89, 1944, 89, 1945, 89, 1950, 89, 1956, 89, 1962, 89,
+	1964, 89, 1965, 89, 1967, 89, 1971, 89, 1973, 89, 1977, 89, 1979, 89, 1985,
+	89, 2016, 89, 2018, 89, 2031, 89, 2032, 89, 2035, 89, 2055, 89, 2061, 89,
+	2064, 89, 2074, 89, 2081, 89, 2093, 89, 2094, 89, 2113, 89, 2117, 89, 2122,
+	89, 2135, 89, 2153, 89, 2161, 89, 2162, 89, 2175, 89, 2178, 89, 2196, 89,
+	2197, 89, 2200, 89, 2201, 89, 2202, 89, 2213, 89, 2243, 89, 2250, 89, 2252,
+	89, 2273, 89, 2279, 89, 2281, 89, 2285, 89, 2311, 89, 2345, 89, 2355, 89,
+	2362, 89, 2363, 89, 2364, 89, 2427, 89, 21, 90, 82, 90, 133, 90, 135, 90,
+	193, 90, 194, 90, 195, 90, 266, 90, 343, 90, 344, 90, 349, 90, 359, 90, 360,
+	90, 367, 90, 371, 90, 372, 90, 373, 90, 391, 90, 392, 90, 393, 90, 394, 90,
+	395, 90, 396, 90, 397, 90, 398, 90, 399, 90, 414, 90, 415, 90, 416, 90, 420,
+	90, 421, 90, 423, 90, 424, 90, 426, 90, 427, 90, 428, 90, 430, 90, 432, 90,
+	433, 90, 434, 90, 435, 90, 442, 90, 489, 90, 630, 90, 634, 90, 638, 90, 647,
+	90, 657, 90, 658, 90, 662, 90, 663, 90, 666, 90, 669, 90, 673, 90, 675, 90,
+	681, 90, 784, 90, 785, 90, 787, 90, 799, 90, 801, 90, 802, 90, 805, 90, 809,
+	90, 838, 90, 839, 90, 840, 90, 841, 90, 842, 90, 843, 90, 844, 90, 847, 90,
+	849, 90, 850, 90, 876, 90, 925, 90, 941, 90, 942, 90, 948, 90, 994, 90,
+	1013, 90, 1056, 90, 1057, 90, 1058, 90, 1070, 90, 1071, 90, 1075, 90, 1076,
+	90, 1104, 90, 1105, 90, 1106, 90, 1107, 90, 1116, 90, 1130, 90, 1166, 90,
+	1169, 90, 1215, 90, 1216, 90, 1221, 90, 1223, 90, 1225, 90, 1227, 90, 1228,
+	90, 1230, 90, 1231, 90, 1232, 90, 1233, 90, 1234, 90, 1235, 90, 1238, 90,
+	1239, 90, 1240, 90, 1243, 90, 1244, 90, 1246, 90, 1247, 90, 1249, 90, 1250,
+	90, 1251, 90, 1253, 90, 1255, 90, 1256, 90, 1257, 90, 1258, 90, 1273, 90,
+	1452, 90, 1455, 90, 1457, 90, 1462, 90, 1464, 90, 1467, 90, 1468, 1732,
+	1476, 90, 1477, 90, 1484, 90, 1485, 90, 1486, 90, 1487, 90, 1488, 90, 1493,
+	90, 1494, 90, 1495, 90, 1496, 90, 1498, 90, 1504, 90, 1506, 90, 1507, 90,
+	1508, 90, 1511, 90, 1513, 90, 1514, 90, 1515, 90, 1518, 90, 1520, 90, 1522,
+	90, 1524, 90, 1525, 90, 1526, 90, 1527, 90, 1603, 90, 1604, 90, 1619, 90,
+	1620, 90, 1623, 90, 1624, 90, 1625, 90, 1626, 90, 1629, 90, 1639, 90, 1648,
+	90, 1649, 90, 1653, 90, 1654, 90, 1657, 90, 1660, 90, 1664, 90, 1666, 90,
+	1721, 90, 1727, 90, 1746, 90, 1748, 90, 1752, 90, 1753, 90, 1759, 90, 1760,
+	90, 1761, 90, 1762, 90, 1771, 90, 1772, 90, 1773, 90, 1775, 90, 1780, 90,
+	1781, 90, 1783, 90, 1788, 90, 1789, 90, 1792, 90, 1835, 90, 1843, 90, 1846,
+	90, 1872, 90, 1875, 90, 1878, 90, 1894, 90, 1895, 90, 1907, 90, 1908, 90,
+	1921, 90, 1933, 90, 1934, 90, 1935, 90, 1936, 90, 1943, 90, 1944, 90, 1945,
+	90, 1950, 90, 1956, 90, 1962, 90, 1964, 90, 1965, 90, 1967, 90, 1971, 90,
+	1973, 90, 1977, 90, 1979, 90, 1985, 90, 2016, 90, 2018, 90, 2031, 90, 2032,
+	90, 2035, 90, 2055, 90, 2061, 90, 2064, 90, 2074, 90, 2081, 90, 2093, 90,
+	2094, 90, 2113, 90, 2117, 90, 2122, 90, 2135, 90, 2153, 90, 2161, 90, 2162,
+	90, 2175, 90, 2178, 90, 2196, 90, 2197, 90, 2200, 90, 2201, 90, 2202, 90,
+	2213, 90, 2243, 90, 2250, 90, 2252, 90, 2273, 90, 2279, 90, 2281, 90, 2285,
+	90, 2311, 90, 2345, 90, 2355, 90, 2362, 90, 2363, 90, 2364, 90, 2427, 90,
+	21, 91, 82, 91, 133, 91, 135, 91, 193, 91, 194, 91, 195, 91, 266, 91, 343,
+	91, 344, 91, 349, 91, 359, 91, 360, 91, 367, 91, 371, 91, 372, 91, 373, 91,
+	391, 91, 392, 91, 393, 91, 394, 91, 395, 91, 396, 91, 397, 91, 398, 91, 399,
+	91, 414, 91, 415, 91, 416, 91, 420, 91, 421, 91, 423, 91, 424, 91, 426, 91,
+	427, 91, 428, 91, 430, 91, 432, 91, 433, 91, 434, 91, 435, 91, 442, 91, 489,
+	91, 630, 91, 634, 91, 638, 91, 647, 91, 657, 91, 658, 91, 662, 91, 663, 91,
+	666, 91, 669, 91, 673, 91, 675, 91, 681, 91, 784, 91, 785, 91, 787, 91, 799,
+	91, 801, 91, 802, 91, 805, 91, 809, 91, 838, 91, 839, 91, 840, 91, 841, 91,
+	842, 91, 843, 91, 844, 91, 847, 91, 849, 91, 850, 91, 876, 91, 925, 91, 941,
+	91, 942, 91, 948, 91, 994, 91, 1013, 91, 1056, 91, 1057, 91, 1058, 91, 1070,
+	91, 1071, 91, 1075, 91, 1076, 91, 1104, 91, 1105, 91, 1106, 91, 1107, 91,
+	1116, 91, 1130, 91, 1166, 91, 1169, 91, 1215, 91, 1216, 91, 1221, 91, 1223,
+	91, 1225, 91, 1227, 91, 1228, 91, 1230, 91, 1231, 91, 1232, 91, 1233, 91,
+	1234, 91, 1235, 91, 1238, 91, 1239, 91, 1240, 91, 1243, 91, 1244, 91, 1246,
+	91, 1247, 91, 1249, 91, 1250, 91, 1251, 91, 1253, 91, 1255, 91, 1256, 91,
+	1257, 91, 1258, 91, 1273, 91, 1452, 91, 1455, 91, 1457, 91, 1462, 91, 1464,
+	91, 1467, 91, 1476, 91, 1477, 91, 1484, 91, 1485, 91, 1486, 91, 1487, 91,
+	1488, 91, 1493, 91, 1494, 91, 1495, 91, 1496, 91, 1498, 91, 1504, 91, 1506,
+	91, 1507, 91, 1508, 91, 1511, 91, 1513, 91, 1514, 91, 1515, 91, 1518, 91,
+	1520, 91, 1522, 91, 1524, 91, 1525, 91, 1526, 91, 1527, 91, 1603, 91, 1604,
+	91, 1619, 91, 1620, 91, 1623, 91, 1624, 91, 1625, 91, 1626, 91, 1629, 91,
+	1639, 91, 1648, 91, 1649, 91, 1653, 91, 1654, 91, 1657, 91, 1660, 91, 1664,
+	91, 1666, 91, 1721, 91, 1727, 91, 1746, 91, 1748, 91, 1752, 91, 1753, 91,
+	1759, 91, 1760, 91, 1761, 91, 1762, 91, 1771, 91, 1772, 91, 1773, 91, 1775,
+	91, 1780, 91, 1781, 91, 1783, 91, 1788, 91, 1789, 91, 1792, 91, 1835, 91,
+	1843, 91, 1846, 91, 1872, 91, 1875, 91, 1878, 91, 1894, 91, 1895, 91, 1907,
+	91, 1908, 91, 1921, 91, 1933, 91, 1934, 91, 1935, 91, 1936, 91, 1943, 91,
+	1944, 91, 1945, 91, 1950, 91, 1956, 91, 1962, 91, 1964, 91, 1965, 91, 1967,
+	91, 1971, 91, 1973, 91, 1977, 91, 1979, 91, 1985, 91, 2016, 91, 2018, 91,
+	2031, 91, 2032, 91, 2035, 91, 2055, 91, 2061, 91, 2064, 91, 2074, 91, 2081,
+	91, 2093, 91, 2094, 91, 2113, 91, 2117, 91, 2122, 91, 2135, 91, 2153, 91,
+	2161, 91, 2162, 91, 2175, 91, 2178, 91, 2196, 91, 2197, 91, 2200, 91, 2201,
+	91, 2202, 91, 2213, 91, 2243, 91, 2250, 91, 2252, 91, 2273, 91, 2279, 91,
+	2281, 91, 2285, 91, 2311, 91, 2345, 91, 2355, 91, 2362, 91, 2363, 91, 2364,
+	91, 2427, 91, 21, 92, 82, 92, 133, 92, 135, 92, 193, 92, 194, 92, 195, 92,
+	266, 92, 343, 92, 344, 92, 349, 92, 359, 92, 360, 92, 367, 92, 371, 92, 372,
+	92, 373, 92, 391, 92, 392, 92, 393, 92, 394, 92, 395, 92, 396, 92, 397, 92,
+	398, 92, 399, 92, 414, 92, 415, 92, 416, 92, 420, 92, 421, 92, 423, 92, 424,
+	92, 426, 92, 427, 92, 428, 92, 430, 92, 432, 92, 433, 92, 434, 92, 435, 92,
+	442, 92, 489, 92, 630, 92, 634, 92, 638, 92, 647, 92, 657, 92, 658, 92, 662,
+	92, 663, 92, 666, 92, 669, 92, 673, 92, 675, 92, 681, 92, 784, 92, 785, 92,
+	787, 92, 799, 92, 801, 92, 802, 92, 805, 92, 809, 92, 838, 92, 839, 92, 840,
+	92, 841, 92, 842, 92, 843, 92, 844, 92, 847, 92, 849, 92, 850, 92, 876, 92,
+	925, 92, 941, 92, 942, 92, 948, 92, 994, 92, 1013, 92, 1056, 92, 1057, 92,
+	1058, 92, 1070, 92, 1071, 92, 1075, 92, 1076, 92, 1104, 92, 1105, 92, 1106,
+	92, 1107, 92, 1116, 92, 1130, 92, 1166, 92, 1169, 92, 1215, 92, 1216, 92,
+	1221, 92, 1223, 92, 1225, 92, 1227, 92, 1228, 92, 1230, 92, 1231, 92, 1232,
+	92, 1233, 92, 1234, 92, 1235, 92, 1238, 92, 1239, 92, 1240, 92, 1243, 92,
+	1244, 92, 1246, 92, 1247, 92, 1249, 92, 1250, 92, 1251, 92, 1253, 92, 1255,
+	92, 1256, 92, 1257, 92, 1258, 92, 1273, 92, 1452, 92, 1455, 92, 1457, 92,
+	1462, 92, 1464, 92, 1467, 92, 1476, 92, 1477, 92, 1484, 92, 1485, 92, 1486,
+	92, 1487, 92, 1488, 92, 1493, 92, 1494, 92, 1495, 92, 1496, 92, 1498, 92,
+	1504, 92, 1506, 92, 1507, 92, 1508, 92, 1511, 92, 1513, 92, 1514, 92, 1515,
+	92, 1518, 92, 1520, 92, 1522, 92, 1524, 92, 1525, 92, 1526, 92, 1527, 92,
+	1603, 92, 1604, 92, 1619, 92, 1620, 92, 1623, 92, 1624, 92, 1625, 92, 1626,
+	92, 1629, 92, 1639, 92, 1648, 92, 1649, 92, 1653, 92, 1654, 92, 1657, 92,
+	1660, 92, 1664, 92, 1666, 92, 1721, 92, 1727, 92, 1746, 92, 1748, 92, 1752,
+	92, 1753, 92, 1759, 92, 1760, 92, 1761, 92, 1762, 92, 1771, 92, 1772, 92,
+	1773, 92, 1775, 92, 1780, 92, 1781, 92, 1783, 92, 1788, 92, 1789, 92, 1792,
+	92, 1835, 92, 1843, 92, 1846, 92, 1872, 92, 1875, 92, 1878, 92, 1894, 92,
+	1895, 92, 1907, 92, 1908, 92, 1921, 92, 1933, 92, 1934, 92, 1935, 92, 1936,
+	92, 1943, 92, 1944, 92, 1945, 92, 1950, 92, 1956, 92, 1962, 92, 1964, 92,
+	1965, 92, 1967, 92, 1971, 92, 1973, 92, 1977, 92, 1979, 92, 1985, 92, 2016,
+	92, 2018, 92, 2031, 92, 2032, 92, 2035, 92, 2055, 92, 2061, 92, 2064, 92,
+	2074, 92, 2081, 92, 2093, 92, 2094, 92, 2113, 92, 2117, 92, 2122, 92, 2135,
+	92, 2153, 92, 2161, 92, 2162, 92, 2175, 92, 2178, 92, 2196, 92, 2197, 92,
+	2200, 92, 2201, 92, 2202, 92, 2213, 92, 2243, 92, 2250, 92, 2252, 92, 2273,
+	92, 2279, 92, 2281, 92, 2285, 92, 2311, 92, 2345, 92, 2355, 92, 2362, 92,
+	2363, 92, 2364, 92, 2427, 92, 21, 93, 82, 93, 133, 93, 135, 93, 193, 93,
+	194, 93, 195, 93, 266, 93, 343, 93, 344, 93, 349, 93, 359, 93, 360, 93, 367,
+	93, 371, 93, 372, 93, 373, 93, 391, 93, 392, 93, 393, 93, 394, 93, 395, 93,
+	396, 93, 397, 93, 398, 93, 399, 93, 414, 93, 415, 93, 416, 93, 420, 93, 421,
+	93, 423, 93, 424, 93, 426, 93, 427, 93, 428, 93, 430, 93, 432, 93, 433, 93,
+	434, 93, 435, 93, 442, 93, 489, 93, 630, 93, 634, 93, 638, 93, 647, 93, 657,
+	93, 658, 93, 662, 93, 663, 93, 666, 93, 669, 93, 673, 93, 675, 93, 681, 93,
+	784, 93, 785, 93, 787, 93, 799, 93, 801, 93, 802, 93, 805, 93, 809, 93, 838,
+	93, 839, 93, 840, 93, 841, 93, 842, 93, 843, 93, 844, 93, 847, 93, 849, 93,
+	850, 93, 876, 93, 925, 93, 941, 93, 942, 93, 948, 93, 994, 93, 1013, 93,
+	1056, 93, 1057, 93, 1058, 93, 1070, 93, 1071, 93, 1075, 93, 1076, 93, 1104,
+	93, 1105, 93, 1106, 93, 1107, 93, 1116, 93, 1130, 93, 1166, 93, 1169, 93,
+	1215, 93, 1216, 93, 1221, 93, 1223, 93, 1225, 93, 1227, 93, 1228, 93, 1230,
+	93, 1231, 93, 1232, 93, 1233, 93, 1234, 93, 1235, 93, 1238, 93, 1239, 93,
+	1240, 93, 1243, 93, 1244, 93, 1246, 93, 1247, 93, 1249, 93, 1250, 93, 1251,
+	93, 1253, 93, 1255, 93, 1256, 93, 1257, 93, 1258, 93, 1273, 93, 1452, 93,
+	1455, 93, 1457, 93, 1462, 93, 1464, 93, 1467, 93, 1476, 93, 1477, 93, 1484,
+	93, 1485, 93, 1486, 93, 1487, 93, 1488, 93, 1493, 93, 1494, 93, 1495, 93,
+	1496, 93, 1498, 93, 1504, 93, 1506, 93, 1507, 93, 1508, 93, 1511, 93, 1513,
+	93, 1514, 93, 1515, 93, 1518, 93, 1520, 93, 1522, 93, 1524, 93, 1525, 93,
+	1526, 93, 1527, 93, 1603, 93, 1604, 93, 1619, 93, 1620, 93, 1623, 93, 1624,
+	93, 1625, 93, 1626, 93, 1629, 93, 1639, 93, 1648, 93, 1649, 93, 1653, 93,
+	1654, 93, 1657, 93, 1660, 93, 1664, 93, 1666, 93, 1721, 93, 1727, 93, 1746,
+	93, 1748, 93, 1752, 93, 1753, 93, 1759, 93, 1760, 93, 1761, 93, 1762, 93,
+	1771, 93, 1772, 93, 1773, 93, 1775, 93, 1780, 93, 1781, 93, 1783, 93, 1788,
+	93, 1789, 93, 1792, 93, 1835, 93, 1843, 93, 1846, 93, 1872, 93, 1875, 93,
+	1878, 93, 1894, 93, 1895, 93, 1907, 93, 1908, 93, 1921, 93, 1933, 93, 1934,
+	93, 1935, 93, 1936, 93, 1943, 93, 1944, 93, 1945, 93, 1950, 93, 1956, 93,
+	1962, 93, 1964, 93, 1965, 93, 1967, 93, 1971, 93, 1973, 93, 1977, 93, 1979,
+	93, 1985, 93, 2016, 93, 2018, 93, 2031, 93, 2032, 93, 2035, 93, 2055, 93,
+	2061, 93, 2064, 93, 2074, 93, 2081, 93, 2093, 93, 2094, 93, 2113, 93, 2117,
+	93, 2122, 93, 2135, 93, 2153, 93, 2161, 93, 2162, 93, 2175, 93, 2178, 93,
+	2196, 93, 2197, 93, 2200, 93, 2201, 93, 2202, 93, 2213, 93, 2243, 93, 2250,
+	93, 2252, 93, 2273, 93, 2279, 93, 2281, 93, 2285, 93, 2311, 93, 2345, 93,
+	2355, 93, 2362, 93, 2363, 93, 2364, 93, 2427, 93, 21, 94, 82, 94, 133, 94,
+	135, 94, 193, 94, 194, 94, 195, 94, 266, 94, 343, 94, 344, 94, 349, 94, 359,
+	94, 360, 94, 367, 94, 371, 94, 372, 94, 373, 94, 391, 94, 392, 94, 393, 94,
+	394, 94, 395, 94, 396, 94, 397, 94, 398, 94, 399, 94, 414, 94, 415, 94, 416,
+	94, 420, 94, 421, 94, 423, 94, 424, 94, 426, 94, 427, 94, 428, 94, 430, 94,
+	432, 94, 433, 94, 434, 94, 435, 94, 442, 94, 489, 94, 630, 94, 634, 94, 638,
+	94, 647, 94, 657, 94, 658, 94, 662, 94, 663, 94, 666, 94, 669, 94, 673, 94,
+	675, 94, 681, 94, 784, 94, 785, 94, 787, 94, 799, 94, 801, 94, 802, 94, 805,
+	94, 809, 94, 838, 94, 839, 94, 840, 94, 841, 94, 842, 94, 843, 94, 844, 94,
+	847, 94, 849, 94, 850, 94, 876, 94, 925, 94, 941, 94, 942, 94, 948, 94, 994,
+	94, 1013, 94, 1056, 94, 1057, 94, 1058, 94, 1070, 94, 1071, 94, 1075, 94,
+	1076, 94, 1104, 94, 1105, 94, 1106, 94, 1107, 94, 1116, 94, 1130, 94, 1166,
+	94, 1169, 94, 1215, 94, 1216, 94, 1221, 94, 1223, 94, 1225, 94, 1227, 94,
+	1228, 94, 1230, 94, 1231, 94, 1232, 94, 1233, 94, 1234, 94, 1235, 94, 1238,
+	94, 1239, 94, 1240, 94, 1243, 94, 1244, 94, 1246, 94, 1247, 94, 1249, 94,
+	1250, 94, 1251, 94, 1253, 94, 1255, 94, 1256, 94, 1257, 94, 1258, 94, 1273,
+	94, 1452, 94, 1455, 94, 1457, 94, 1462, 94, 1464, 94, 1467, 94, 1476, 94,
+	1477, 94, 1484, 94, 1485, 94, 1486, 94, 1487, 94, 1488, 94, 1493, 94, 1494,
+	94, 1495, 94, 1496, 94, 1498, 94, 1504, 94, 1506, 94, 1507, 94, 1508, 94,
+	1511, 94, 1513, 94, 1514, 94, 1515, 94, 1518, 94, 1520, 94, 1522, 94, 1524,
+	94, 1525, 94, 1526, 94, 1527, 94, 1603, 94, 1604, 94, 1619, 94, 1620, 94,
+	1623, 94, 1624, 94, 1625, 94, 1626, 94, 1629, 94, 1639, 94, 1648, 94, 1649,
+	94, 1653, 94, 1654, 94, 1657, 94, 1660, 94, 1664, 94, 1666, 94, 1721, 94,
+	1727, 94, 1746, 94, 1748, 94, 1752, 94, 1753, 94, 1759, 94, 1760, 94, 1761,
+	94, 1762, 94, 1771, 94, 1772, 94, 1773, 94, 1775, 94, 1780, 94, 1781, 94,
+	1783, 94, 1788, 94, 1789, 94, 1792, 94, 1835, 94, 1843, 94, 1846, 94, 1872,
+	94, 1875, 94, 1878, 94, 1894, 94, 1895, 94, 1907, 94, 1908, 94, 1921, 94,
+	1933, 94, 1934, 94, 1935, 94, 1936, 94, 1943, 94, 1944, 94, 1945, 94, 1950,
+	94, 1956, 94, 1962, 94, 1964, 94, 1965, 94, 1967, 94, 1971, 94, 1973, 94,
+	1977, 94, 1979, 94, 1985, 94, 2016, 94, 2018, 94, 2031, 94, 2032, 94, 2035,
+	94, 2055, 94, 2061, 94, 2064, 94, 2074, 94, 2081, 94, 2093, 94, 2094, 94,
+	2113, 94, 2117, 94, 2122, 94, 2135, 94, 2153, 94, 2161, 94, 2162, 94, 2175,
+	94, 2178, 94, 2196, 94, 2197, 94, 2200, 94, 2201, 94, 2202, 94, 2213, 94,
+	2243, 94, 2250, 94, 2252, 94, 2273, 94, 2279, 94, 2281, 94, 2285, 94, 2311,
+	94, 2345, 94, 2355, 94, 2362, 94, 2363, 94, 2364, 94, 2427, 94, 21, 95, 82,
+	95, 133, 95, 135, 95, 193, 95, 194, 95, 195, 95, 266, 95, 343, 95, 344, 95,
+	349, 95, 359, 95, 360, 95, 367, 95, 371, 95, 372, 95, 373, 95, 391, 95, 392,
+	95, 393, 95, 394, 95, 395, 95, 396, 95, 397, 95, 398, 95, 399, 95, 414, 95,
+	415, 95, 416, 95, 420, 95, 421, 95, 423, 95, 424, 95, 426, 95, 427, 95, 428,
+	95, 430, 95, 432, 95, 433, 95, 434, 95, 435, 95, 442, 95, 489, 95, 630, 95,
+	634, 95, 638, 95, 647, 95, 657, 95, 658, 95, 662, 95, 663, 95, 666, 95, 669,
+	95, 673, 95, 675, 95, 681, 95, 784, 95, 785, 95, 787, 95, 799, 95, 801, 95,
+	802, 95, 805, 95, 809, 95, 838, 95, 839, 95, 840, 95, 841, 95, 842, 95, 843,
+	95, 844, 95, 847, 95, 849, 95, 850, 95, 876, 95, 925, 95, 941, 95, 942, 95,
+	948, 95, 994, 95, 1013, 95, 1056, 95, 1057, 95, 1058, 95, 1070, 95, 1071,
+	95, 1075, 95, 1076, 95, 1104, 95, 1105, 95, 1106, 95, 1107, 95, 1116, 95,
+	1130, 95, 1166, 95, 1169, 95, 1215, 95, 1216, 95, 1221, 95, 1223, 95, 1225,
+	95, 1227, 95, 1228, 95, 1230, 95, 1231, 95, 1232, 95, 1233, 95, 1234, 95,
+	1235, 95, 1238, 95, 1239, 95, 1240, 95, 1243, 95, 1244, 95, 1246, 95, 1247,
+	95, 1249, 95, 1250, 95, 1251, 95, 1253, 95, 1255, 95, 1256, 95, 1257, 95,
+	1258, 95, 1273, 95, 1452, 95, 1455, 95, 1457, 95, 1462, 95, 1464, 95, 1467,
+	95, 1476, 95, 1477, 95, 1484, 95, 1485, 95, 1486, 95, 1487, 95, 1488, 95,
+	1493, 95, 1494, 95, 1495, 95, 1496, 95, 1498, 95, 1504, 95, 1506, 95, 1507,
+	95, 1508, 95, 1511, 95, 1513, 95, 1514, 95, 1515, 95, 1518, 95, 1520, 95,
+	1522, 95, 1524, 95, 1525, 95, 1526, 95, 1527, 95, 1603, 95, 1604, 95, 1619,
+	95, 1620, 95, 1623, 95, 1624, 95, 1625, 95, 1626, 95, 1629, 95, 1639, 95,
+	1648, 95, 1649, 95, 1653, 95, 1654, 95, 1657, 95, 1660, 95, 1664, 95, 1666,
+	95, 1721, 95, 1727, 95, 1746, 95, 1748, 95, 1752, 95, 1753, 95, 1759, 95,
+	1760, 95, 1761, 95, 1762, 95, 1771, 95, 1772, 95, 1773, 95, 1775, 95, 1780,
+	95, 1781, 95, 1783, 95, 1788, 95, 1789, 95, 1792, 95, 1835, 95, 1843, 95,
+	1846, 95, 1872, 95, 1875, 95, 1878, 95, 1894, 95, 1895, 95, 1907, 95, 1908,
+	95, 1921, 95, 1933, 95, 1934, 95, 1935, 95, 1936, 95, 1943, 95, 1944, 95,
+	1945, 95, 1950, 95, 1956, 95, 1962, 95, 1964, 95, 1965, 95, 1967, 95, 1971,
+	95, 1973, 95, 1977, 95, 1979, 95, 1985, 95, 2016, 95, 2018, 95, 2031, 95,
+	2032, 95, 2035, 95, 2055, 95, 2061, 95, 2064, 95, 2074, 95, 2081, 95, 2093,
+	95, 2094, 95, 2113, 95, 2117, 95, 2122, 95, 2135, 95, 2153, 95, 2161, 95,
+	2162, 95, 2175, 95, 2178, 95, 2196, 95, 2197, 95, 2200, 95, 2201, 95, 2202,
+	95, 2213, 95, 2243, 95, 2250, 95, 2252, 95, 2273, 95, 2279, 95, 2281, 95,
+	2285, 95, 2311, 95, 2345, 95, 2355, 95, 2362, 95, 2363, 95, 2364, 95, 2427,
+	95, 21, 96, 82, 96, 133, 96, 135, 96, 193, 96, 194, 96, 195, 96, 266, 96,
+	343, 96, 344, 96, 349, 96, 359, 96, 360, 96, 367, 96, 371, 96, 372, 96, 373,
+	96, 391, 96, 392, 96, 393, 96, 394, 96, 395, 96, 396, 96, 397, 96, 398, 96,
+	399, 96, 414, 96, 415, 96, 416, 96, 420, 96, 421, 96, 423, 96, 424, 96, 426,
+	96, 427, 96, 428, 96, 430, 96, 432, 96, 433, 96, 434, 96, 435, 96, 442, 96,
+	489, 96, 630, 96, 634, 96, 638, 96, 647, 96, 657, 96, 658, 96, 662, 96, 663,
+	96, 666, 96, 669, 96, 673, 96, 675, 96, 681, 96, 784, 96, 785, 96, 787, 96,
+	799, 96, 801, 96, 802, 96, 805, 96, 809, 96, 838, 96, 839, 96, 840, 96, 841,
+	96, 842, 96, 843, 96, 844, 96, 847, 96, 849, 96, 850, 96, 876, 96, 925, 96,
+	941, 96, 942, 96, 948, 96, 994, 96, 1013, 96, 1056, 96, 1057, 96, 1058, 96,
+	1070, 96, 1071, 96, 1075, 96, 1076, 96, 1104, 96, 1105, 96, 1106, 96, 1107,
+	96, 1116, 96, 1130, 96, 1166, 96, 1169, 96, 1215, 96, 1216, 96, 1221, 96,
+	1223, 96, 1225, 96, 1227, 96, 1228, 96, 1230, 96, 1231, 96, 1232, 96, 1233,
+	96, 1234, 96, 1235, 96, 1238, 96, 1239, 96, 1240, 96, 1243, 96, 1244, 96,
+	1246, 96, 1247, 96, 1249, 96, 1250, 96, 1251, 96, 1253, 96, 1255, 96, 1256,
+	96, 1257, 96, 1258, 96, 1273, 96, 1452, 96, 1455, 96, 1457, 96, 1462, 96,
+	1464, 96, 1467, 96, 1468, 1733, 1476, 96, 1477, 96, 1484, 96, 1485, 96,
+	1486, 96, 1487, 96, 1488, 96, 1493, 96, 1494, 96, 1495, 96, 1496, 96, 1498,
+	96, 1504, 96, 1506, 96, 1507, 96, 1508, 96, 1511, 96, 1513, 96, 1514, 96,
+	1515, 96, 1518, 96, 1520, 96, 1522, 96, 1524, 96, 1525, 96, 1526, 96, 1527,
+	96, 1603, 96, 1604, 96, 1619, 96, 1620, 96, 1623, 96, 1624, 96, 1625, 96,
+	1626, 96, 1629, 96, 1639, 96, 1648, 96, 1649, 96, 1653, 96, 1654, 96, 1657,
+	96, 1660, 96, 1664, 96, 1666, 96, 1721, 96, 1727, 96, 1746, 96, 1748, 96,
+	1752, 96, 1753, 96, 1759, 96, 1760, 96, 1761, 96, 1762, 96, 1771, 96, 1772,
+	96, 1773, 96, 1775, 96, 1780, 96, 1781, 96, 1783, 96, 1788, 96, 1789, 96,
+	1792, 96, 1835, 96, 1843, 96, 1846, 96, 1872, 96, 1875, 96, 1878, 96, 1894,
+	96, 1895, 96, 1907, 96, 1908, 96, 1921, 96, 1933, 96, 1934, 96, 1935, 96,
+	1936, 96, 1943, 96, 1944, 96, 1945, 96, 1950, 96, 1956, 96, 1962, 96, 1964,
+	96, 1965, 96, 1967, 96, 1970, 1733, 1971, 96, 1973, 96, 1977, 96, 1979, 96,
+	1985, 96, 2016, 96, 2018, 96, 2030, 1733, 2031, 96, 2032, 96, 2033, 1733,
+	2034, 1733, 2035, 96, 2036, 1733, 2055, 96, 2057, 1733, 2061, 96, 2064, 96,
+	2074, 96, 2081, 96, 2093, 96, 2094, 96, 2113, 96, 2117, 96, 2122, 96, 2135,
+	96, 2153, 96, 2161, 96, 2162, 96, 2175, 96, 2178, 96, 2196, 96, 2197, 96,
+	2200, 96, 2201, 96, 2202, 96, 2209, 1733, 2213, 96, 2216, 1733, 2243, 96,
+	2250, 96, 2252, 96, 2273, 96, 2277, 1733, 2279, 96, 2281, 96, 2285, 96,
+	2311, 96, 2326, 1733, 2345, 96, 2355, 96, 2362, 96, 2363, 96, 2364, 96,
+	2407, 1733, 2410, 1733, 2426, 1733, 2427, 96, 2431, 1733, 2438, 1733, 2441,
+	1733, 2450, 1733, 2451, 1733, 21, 97, 82, 97, 133, 97, 135, 97, 193, 97,
+	194, 97, 195, 97, 266, 97, 343, 97, 344, 97, 349, 97, 359, 97, 360, 97, 367,
+	97, 371, 97, 372, 97, 373, 97, 391, 97, 392, 97, 393, 97, 394, 97, 395, 97,
+	396, 97, 397, 97, 398, 97, 399, 97, 414, 97, 415, 97, 416, 97, 420, 97, 421,
+	97, 423, 97, 424, 97, 426, 97, 427, 97, 428, 97, 430, 97, 432, 97, 433, 97,
+	434, 97, 435, 97, 442, 97, 489, 97, 630, 97, 634, 97, 638, 97, 647, 97, 657,
+	97, 658, 97, 662, 97, 663, 97, 666, 97, 669, 97, 673, 97, 675, 97, 681, 97,
+	784, 97, 785, 97, 787, 97, 799, 97, 801, 97, 802, 97, 805, 97, 809, 97, 838,
+	97, 839, 97, 840, 97, 841, 97, 842, 97, 843, 97, 844, 97, 847, 97, 849, 97,
+	850, 97, 876, 97, 925, 97, 941, 97, 942, 97, 948, 97, 994, 97, 1013, 97,
+	1056, 97, 1057, 97, 1058, 97, 1070, 97, 1071, 97, 1075, 97, 1076, 97, 1104,
+	97, 1105, 97, 1106, 97, 1107, 97, 1116, 97, 1130, 97, 1166, 97, 1169, 97,
+	1215, 97, 1216, 97, 1221, 97, 1223, 97, 1225, 97, 1227, 97, 1228, 97, 1230,
+	97, 1231, 97, 1232, 97, 1233, 97, 1234, 97, 1235, 97, 1238, 97, 1239, 97,
+	1240, 97, 1243, 97, 1244, 97, 1246, 97, 1247, 97, 1249, 97, 1250, 97, 1251,
+	97, 1253, 97, 1255, 97, 1256, 97, 1257, 97, 1258, 97, 1273, 97, 1452, 97,
+	1455, 97, 1457, 97, 1462, 97, 1464, 97, 1467, 97, 1476, 97, 1477, 97, 1484,
+	97, 1485, 97, 1486, 97, 1487, 97, 1488, 97, 1493, 97, 1494, 97, 1495, 97,
+	1496, 97, 1498, 97, 1504, 97, 1506, 97, 1507, 97, 1508, 97, 1511, 97, 1513,
+	97, 1514, 97, 1515, 97, 1518, 97, 1520, 97, 1522, 97, 1524, 97, 1525, 97,
+	1526, 97, 1527, 97, 1603, 97, 1604, 97, 1619, 97, 1620, 97, 1623, 97, 1624,
+	97, 1625, 97, 1626, 97, 1629, 97, 1639, 97, 1648, 97, 1649, 97, 1653, 97,
+	1654, 97, 1657, 97, 1660, 97, 1664, 97, 1666, 97, 1721, 97, 1727, 97, 1746,
+	97, 1748, 97, 1752, 97, 1753, 97, 1759, 97, 1760, 97, 1761, 97, 1762, 97,
+	1771, 97, 1772, 97, 1773, 97, 1775, 97, 1780, 97, 1781, 97, 1783, 97, 1788,
+	97, 1789, 97, 1792, 97, 1835, 97, 1843, 97, 1846, 97, 1872, 97, 1875, 97,
+	1878, 97, 1894, 97, 1895, 97, 1907, 97, 1908, 97, 1921, 97, 1933, 97, 1934,
+	97, 1935, 97, 1936, 97, 1943, 97, 1944, 97, 1945, 97, 1950, 97, 1956, 97,
+	1962, 97, 1964, 97, 1965, 97, 1967, 97, 1971, 97, 1973, 97, 1977, 97, 1979,
+	97, 1985, 97, 2016, 97, 2018, 97, 2031, 97, 2032, 97, 2035, 97, 2055, 97,
+	2061, 97, 2064, 97, 2074, 97, 2081, 97, 2093, 97, 2094, 97, 2113, 97, 2117,
+	97, 2122, 97, 2135, 97, 2153, 97, 2161, 97, 2162, 97, 2175, 97, 2178, 97,
+	2196, 97, 2197, 97, 2200, 97, 2201, 97, 2202, 97, 2213, 97, 2243, 97, 2250,
+	97, 2252, 97, 2273, 97, 2279, 97, 2281, 97, 2285, 97, 2311, 97, 2345, 97,
+	2355, 97, 2362, 97, 2363, 97, 2364, 97, 2427, 97, 21, 98, 82, 98, 133, 98,
+	135, 98, 193, 98, 194, 98, 195, 98, 266, 98, 343, 98, 344, 98, 349, 98, 359,
+	98, 360, 98, 367, 98, 371, 98, 372, 98, 373, 98, 391, 98, 392, 98, 393, 98,
+	394, 98, 395, 98, 396, 98, 397, 98, 398, 98, 399, 98, 414, 98, 415, 98, 416,
+	98, 420, 98, 421, 98, 423, 98, 424, 98, 426, 98, 427, 98, 428, 98, 430, 98,
+	432, 98, 433, 98, 434, 98, 435, 98, 442, 98, 489, 98, 630, 98, 634, 98, 638,
+	98, 647, 98, 657, 98, 658, 98, 662, 98, 663, 98, 666, 98, 669, 98, 673, 98,
+	675, 98, 681, 98, 784, 98, 785, 98, 787, 98, 799, 98, 801, 98, 802, 98, 805,
+	98, 809, 98, 838, 98, 839, 98, 840, 98, 841, 98, 842, 98, 843, 98, 844, 98,
+	847, 98, 849, 98, 850, 98, 876, 98, 925, 98, 941, 98, 942, 98, 948, 98, 994,
+	98, 1013, 98, 1056, 98, 1057, 98, 1058, 98, 1070, 98, 1071, 98, 1075, 98,
+	1076, 98, 1104, 98, 1105, 98, 1106, 98, 1107, 98, 1116, 98, 1130, 98, 1166,
+	98, 1169, 98, 1215, 98, 1216, 98, 1221, 98, 1223, 98, 1225, 98, 1227, 98,
+	1228, 98, 1230, 98, 1231, 98, 1232, 98, 1233, 98, 1234, 98, 1235, 98, 1238,
+	98, 1239, 98, 1240, 98, 1243, 98, 1244, 98, 1246, 98, 1247, 98, 1249, 98,
+	1250, 98, 1251, 98, 1253, 98, 1255, 98, 1256, 98, 1257, 98, 1258, 98, 1273,
+	98, 1452, 98, 1455, 98, 1457, 98, 1462, 98, 1464, 98, 1467, 98, 1476, 98,
+	1477, 98, 1484, 98, 1485, 98, 1486, 98, 1487, 98, 1488, 98, 1493, 98, 1494,
+	98, 1495, 98, 1496, 98, 1498, 98, 1504, 98, 1506, 98, 1507, 98, 1508, 98,
+	1511, 98, 1513, 98, 1514, 98, 1515, 98, 1518, 98, 1520, 98, 1522, 98, 1524,
+	98, 1525, 98, 1526, 98, 1527, 98, 1603, 98, 1604, 98, 1619, 98, 1620, 98,
+	1623, 98, 1624, 98, 1625, 98, 1626, 98, 1629, 98, 1639, 98, 1648, 98, 1649,
+	98, 1653, 98, 1654, 98, 1657, 98, 1660, 98, 1664, 98, 1666, 98, 1721, 98,
+	1727, 98, 1746, 98, 1748, 98, 1752, 98, 1753, 98, 1759, 98, 1760, 98, 1761,
+	98, 1762, 98, 1771, 98, 1772, 98, 1773, 98, 1775, 98, 1780, 98, 1781, 98,
+	1783, 98, 1788, 98, 1789, 98, 1792, 98, 1835, 98, 1843, 98, 1846, 98, 1872,
+	98, 1875, 98, 1878, 98, 1894, 98, 1895, 98, 1907, 98, 1908, 98, 1921, 98,
+	1933, 98, 1934, 98, 1935, 98, 1936, 98, 1943, 98, 1944, 98, 1945, 98, 1950,
+	98, 1956, 98, 1962, 98, 1964, 98, 1965, 98, 1967, 98, 1971, 98, 1973, 98,
+	1977, 98, 1979, 98, 1985, 98, 2016, 98, 2018, 98, 2031, 98, 2032, 2122,
+	2035, 2122, 2055, 98, 2061, 98, 2064, 98, 2074, 98, 2081, 98, 2093, 98,
+	2094, 98, 2113, 98, 2117, 98, 2122, 98, 2135, 98, 2153, 98, 2161, 98, 2162,
+	98, 2175, 98, 2178, 98, 2196, 98, 2197, 98, 2200, 98, 2201, 98, 2202, 98,
+	2213, 2122, 2243, 98, 2250, 98, 2252, 98, 2273, 2311, 2279, 98, 2281, 98,
+	2285, 2311, 2311, 98, 2345, 2311, 2355, 2311, 2362, 98, 2363, 98, 2364, 98,
+	2427, 98, 21, 99, 82, 99, 133, 99, 135, 99, 193, 99, 194, 99, 195, 99, 266,
+	99, 343, 99, 344, 99, 349, 99, 359, 99, 360, 99, 367, 99, 371, 99, 372, 99,
+	373, 99, 391, 99, 392, 99, 393, 99, 394, 99, 395, 99, 396, 99, 397, 99, 398,
+	99, 399, 99, 414, 99, 415, 99, 416, 99, 420, 99, 421, 99, 423, 99, 424, 99,
+	426, 99, 427, 99, 428, 99, 430, 99, 432, 99, 433, 99, 434, 99, 435, 99, 442,
+	99, 489, 99, 630, 99, 634, 99, 638, 99, 647, 99, 657, 99, 658, 99, 662, 99,
+	663, 99, 666, 99, 669, 99, 673, 99, 675, 99, 681, 99, 784, 99, 785, 99, 787,
+	99, 799, 99, 801, 99, 802, 99, 805, 99, 809, 99, 838, 99, 839, 99, 840, 99,
+	841, 99, 842, 99, 843, 99, 844, 99, 847, 99, 849, 99, 850, 99, 876, 99, 925,
+	99, 941, 99, 942, 99, 948, 99, 994, 99, 1013, 99, 1056, 99, 1057, 99, 1058,
+	99, 1070, 99, 1071, 99, 1075, 99, 1076, 99, 1104, 99, 1105, 99, 1106, 99,
+	1107, 99, 1116, 99, 1130, 99, 1166, 99, 1169, 99, 1215, 99, 1216, 99, 1221,
+	99, 1223, 99, 1225, 99, 1227, 99, 1228, 99, 1230, 99, 1231, 99, 1232, 99,
+	1233, 99, 1234, 99, 1235, 99, 1238, 99, 1239, 99, 1240, 99, 1243, 99, 1244,
+	99, 1246, 99, 1247, 99, 1249, 99, 1250, 99, 1251, 99, 1253, 99, 1255, 99,
+	1256, 99, 1257, 99, 1258, 99, 1273, 99, 1452, 99, 1455, 99, 1457, 99, 1462,
+	99, 1464, 99, 1467, 99, 1476, 99, 1477, 99, 1484, 99, 1485, 99, 1486, 99,
+	1487, 99, 1488, 99, 1493, 99, 1494, 99, 1495, 99, 1496, 99, 1498, 99, 1504,
+	99, 1506, 99, 1507, 99, 1508, 99, 1511, 99, 1513, 99, 1514, 99, 1515, 99,
+	1518, 99, 1520, 99, 1522, 99, 1524, 99, 1525, 99, 1526, 99, 1527, 99, 1603,
+	99, 1604, 99, 1619, 99, 1620, 99, 1623, 99, 1624, 99, 1625, 99, 1626, 99,
+	1629, 99, 1639, 99, 1648, 99, 1649, 99, 1653, 99, 1654, 99, 1657, 99, 1660,
+	99, 1664, 99, 1666, 99, 1721, 99, 1727, 99, 1746, 99, 1748, 99, 1752, 99,
+	1753, 99, 1759, 99, 1760, 99, 1761, 99, 1762, 99, 1771, 99, 1772, 99, 1773,
+	99, 1775, 99, 1780, 99, 1781, 99, 1783, 99, 1788, 99, 1789, 99, 1792, 99,
+	1835, 99, 1843, 99, 1846, 99, 1872, 99, 1875, 99, 1878, 99, 1894, 99, 1895,
+	99, 1907, 99, 1908, 99, 1921, 99, 1933, 99, 1934, 99, 1935, 99, 1936, 99,
+	1943, 99, 1944, 99, 1945, 99, 1950, 99, 1956, 99, 1962, 99, 1964, 99, 1965,
+	99, 1967, 99, 1971, 99, 1973, 99, 1977, 99, 1979, 99, 1985, 99, 2016, 99,
+	2018, 99, 2031, 99, 2032, 99, 2035, 99, 2055, 99, 2061, 99, 2064, 99, 2074,
+	99, 2081, 99, 2093, 99, 2094, 99, 2113, 99, 2117, 99, 2122, 99, 2135, 99,
+	2153, 99, 2161, 99, 2162, 99, 2175, 99, 2178, 99, 2196, 99, 2197, 99, 2200,
+	99, 2201, 99, 2202, 99, 2213, 99, 2243, 99, 2250, 99, 2252, 99, 2273, 99,
+	2279, 99, 2281, 99, 2285, 99, 2311, 99, 2345, 99, 2355, 99, 2362, 99, 2363,
+	99, 2364, 99, 2427, 99, 21, 100, 82, 100, 133, 100, 135, 100, 193, 100, 194,
+	100, 195, 100, 266, 100, 343, 100, 344, 100, 349, 100, 359, 100, 360, 100,
+	367, 100, 371, 100, 372, 100, 373, 100, 391, 100, 392, 100, 393, 100, 394,
+	100, 395, 100, 396, 100, 397, 100, 398, 100, 399, 100, 414, 100, 415, 100,
+	416, 100, 420, 100, 421, 100, 423, 100, 424, 100, 426, 100, 427, 100, 428,
+	100, 430, 100, 432, 100, 433, 100, 434, 100, 435, 100, 442, 100, 489, 100,
+	630, 100, 634, 100, 638, 100, 647, 100, 657, 100, 658, 100, 662, 100, 663,
+	100, 666, 100, 669, 100, 673, 100, 675, 100, 681, 100, 784, 100, 785, 100,
+	787, 100, 799, 100, 801, 100, 802, 100, 805, 100, 809, 100, 838, 100, 839,
+	100, 840, 100, 841, 100, 842, 100, 843, 100, 844, 100, 847, 100, 849, 100,
+	850, 100, 876, 100, 925, 100, 941, 100, 942, 100, 948, 100, 994, 100, 1013,
+	100, 1056, 100, 1057, 100, 1058, 100, 1070, 100, 1071, 100, 1075, 100, 1076,
+	100, 1104, 100, 1105, 100, 1106, 100, 1107, 100, 1116, 100, 1130, 100, 1166,
+	100, 1169, 100, 1215, 100, 1216, 100, 1221, 100, 1223, 100, 1225, 100, 1227,
+	100, 1228, 100, 1230, 100, 1231, 100, 1232, 100, 1233, 100, 1234, 100, 1235,
+	100, 1238, 100, 1239, 100, 1240, 100, 1243, 100, 1244, 100, 1246, 100, 1247,
+	100, 1249, 100, 1250, 100, 1251, 100, 1253, 100, 1255, 100, 1256, 100, 1257,
+	100, 1258, 100, 1273, 100, 1452, 100, 1455, 100, 1457, 100, 1462, 100, 1464,
+	100, 1467, 100, 1476, 100, 1477, 100, 1484, 100, 1485, 100, 1486, 100, 1487,
+	100, 1488, 100, 1493, 100, 1494, 100, 1495, 100, 1496, 100, 1498, 100, 1504,
+	100, 1506, 100, 1507, 100, 1508, 100, 1511, 100, 1513, 100, 1514, 100, 1515,
+	100, 1518, 100, 1520, 100, 1522, 100, 1524, 100, 1525, 100, 1526, 100, 1527,
+	100, 1603, 100, 1604, 100, 1619, 100, 1620, 100, 1623, 100, 1624, 100, 1625,
+	100, 1626, 100, 1629, 100, 1639, 100, 1648, 100, 1649, 100, 1653, 100, 1654,
+	100, 1657, 100, 1660, 100, 1664, 100, 1666, 100, 1721, 100, 1727, 100, 1746,
+	100, 1748, 100, 1752, 100, 1753, 100, 1759, 100, 1760, 100, 1761, 100, 1762,
+	100, 1771, 100, 1772, 100, 1773, 100, 1775, 100, 1780, 100, 1781, 100, 1783,
+	100, 1788, 100, 1789, 100, 1792, 100, 1835, 100, 1843, 100, 1846, 100, 1872,
+	100, 1875, 100, 1878, 100, 1894, 100, 1895, 100, 1907, 100, 1908, 100, 1921,
+	100, 1933, 100, 1934, 100, 1935, 100, 1936, 100, 1943, 100, 1944, 100, 1945,
+	100, 1950, 100, 1956, 100, 1962, 100, 1964, 100, 1965, 100, 1967, 100, 1971,
+	100, 1973, 100, 1977, 100, 1979, 100, 1985, 100, 2016, 100, 2018, 100, 2031,
+	100, 2032, 100, 2035, 100, 2055, 100, 2061, 100, 2064, 100, 2074, 100, 2081,
+	100, 2093, 100, 2094, 100, 2113, 100, 2117, 100, 2122, 100, 2135, 100, 2153,
+	100, 2161, 100, 2162, 100, 2175, 100, 2178, 100, 2196, 100, 2197, 100, 2200,
+	100, 2201, 100, 2202, 100, 2213, 100, 2243, 100, 2250, 100, 2252, 100, 2273,
+	100, 2279, 100, 2281, 100, 2285, 100, 2311, 100, 2345, 100, 2355, 100, 2362,
+	100, 2363, 100, 2364, 100, 2427, 100, 82, 131, 133, 348, 442, 131, 266, 450,
+	21, 101, 82, 101, 133, 101, 135, 101, 193, 101, 194, 101, 195, 101, 266,
+	101, 343, 101, 344, 101, 349, 101, 359, 101, 360, 101, 367, 101, 371, 101,
+	372, 101, 373, 101, 391, 101, 392, 101, 393, 101, 394, 101, 395, 101, 396,
+	101, 397, 101, 398, 101, 399, 101, 414, 101, 415, 101, 416, 101, 420, 101,
+	421, 101, 423, 101, 424, 101, 426, 101, 427, 101, 428, 101, 430, 101, 432,
+	101, 433, 101, 434, 101, 435, 101, 442, 101, 489, 101, 630, 101, 634, 101,
+	638, 101, 647, 101, 657, 101, 658, 101, 662, 101, 663, 101, 666, 101, 669,
+	101, 673, 101, 675, 101, 681, 101, 784, 101, 785, 101, 787, 101, 799, 101,
+	801, 101, 802, 101, 805, 101, 809, 101, 838, 101, 839, 101, 840, 101, 841,
+	101, 842, 101, 843, 101, 844, 101, 847, 101, 849, 101, 850, 101, 876, 101,
+	925, 101, 941, 101, 942, 101, 948, 101, 994, 101, 1013, 101, 1056, 101,
+	1057, 101, 1058, 101, 1070, 101, 1071, 101, 1075, 101, 1076, 101, 1104, 101,
+	1105, 101, 1106, 101, 1107, 101, 1116, 101, 1130, 101, 1166, 101, 1169, 101,
+	1215, 101, 1216, 101, 1221, 101, 1223, 101, 1225, 101, 1227, 101, 1228, 101,
+	1230, 101, 1231, 101, 1232, 101, 1233, 101, 1234, 101, 1235, 101, 1238, 101,
+	1239, 101, 1240, 101, 1243, 101, 1244, 101, 1246, 101, 1247, 101, 1249, 101,
+	1250, 101, 1251, 101, 1253, 101, 1255, 101, 1256, 101, 1257, 101, 1258, 101,
+	1273, 101, 1452, 101, 1455, 101, 1457, 101, 1462, 101, 1464, 101, 1467, 101,
+	1476, 101, 1477, 101, 1484, 101, 1485, 101, 1486, 101, 1487, 101, 1488, 101,
+	1493, 101, 1494, 101, 1495, 101, 1496, 101, 1498, 101, 1504, 101, 1506, 101,
+	1507, 101, 1508, 101, 1511, 101, 1513, 101, 1514, 101, 1515, 101, 1518, 101,
+	1520, 101, 1522, 101, 1524, 101, 1525, 101, 1526, 101, 1527, 101, 1603, 101,
+	1604, 101, 1619, 101, 1620, 101, 1623, 101, 1624, 101, 1625, 101, 1626, 101,
+	1629, 101, 1639, 101, 1648, 101, 1649, 101, 1653, 101, 1654, 101, 1657, 101,
+	1660, 101, 1664, 101, 1666, 101, 1721, 101, 1727, 101, 1746, 101, 1748, 101,
+	1752, 101, 1753, 101, 1759, 101, 1760, 101, 1761, 101, 1762, 101, 1771, 101,
+	1772, 101, 1773, 101, 1775, 101, 1780, 101, 1781, 101, 1783, 101, 1788, 101,
+	1789, 101, 1792, 101, 1835, 101, 1843, 101, 1846, 101, 1872, 101, 1875, 101,
+	1878, 101, 1894, 101, 1895, 101, 1907, 101, 1908, 101, 1921, 101, 1933, 101,
+	1934, 101, 1935, 101, 1936, 101, 1943, 101, 1944, 101, 1945, 101, 1950, 101,
+	1956, 101, 1962, 101, 1964, 101, 1965, 101, 1967, 101, 1971, 101, 1973, 101,
+	1977, 101, 1979, 101, 1985, 101, 2016, 101, 2018, 101, 2031, 101, 2032, 101,
+	2035, 101, 2055, 101, 2061, 101, 2064, 101, 2074, 101, 2081, 101, 2093, 101,
+	2094, 101, 2113, 101, 2117, 101, 2122, 101, 2135, 101, 2153, 101, 2161, 101,
+	2162, 101, 2175, 101, 2178, 101, 2196, 101, 2197, 101, 2200, 101, 2201, 101,
+	2202, 101, 2213, 101, 2243, 101, 2250, 101, 2252, 101, 2273, 101, 2279, 101,
+	2281, 101, 2285, 101, 2311, 101, 2345, 101, 2355, 101, 2362, 101, 2363, 101,
+	2364, 101, 2427, 101, 86, 202, 1732, 1896, 1737, 1901, 1740, 1906, 1778,
+	1958, 1879, 2026, 1954, 2067, 1966, 2079, 1976, 2088, 1978, 2090, 2023,
+	2111, 2027, 2116, 2042, 2138, 2044, 2140, 2047, 2142, 2053, 2147, 2054,
+	2148, 2056, 2150, 2065, 2164, 2066, 2165, 2068, 2167, 2073, 2171, 2076,
+	2174, 2082, 2180, 2085, 2183, 2120, 2210, 2121, 2211, 2139, 2223, 2156,
+	2233, 2296, 2327, 2343, 2388, 86, 203, 1732, 203, 1737, 203, 1740, 203,
+	1778, 203, 1879, 203, 1954, 203, 1966, 203, 1976, 203, 1978, 203, 2023, 203,
+	2027, 203, 2042, 203, 2044, 203, 2047, 203, 2053, 203, 2054, 203, 2056, 203,
+	2065, 203, 2066, 203, 2068, 203, 2073, 203, 2076, 203, 2082, 203, 2085, 203,
+	2120, 203, 2121, 203, 2139, 203, 2156, 203, 2296, 203, 2343, 203, 144, 365,
+	366, 640, 641, 1219, 1220, 1631, 86, 204, 436, 680, 1732, 204, 1737, 204,
+	1740, 204, 1778, 204, 1879, 204, 1954, 204, 1966, 204, 1976, 204, 1978, 204,
+	2023, 204, 2027, 204, 2042, 204, 2044, 204, 2047, 204, 2053, 204, 2054, 204,
+	2056, 204, 2065, 204, 2066, 204, 2068, 204, 2073, 204, 2076, 204, 2082, 204,
+	2085, 204, 2120, 204, 2121, 204, 2139, 204, 2156, 204, 2265, 2303, 2296,
+	204, 2343, 204, 86, 205, 436, 205, 1732, 205, 1737, 205, 1740, 205, 1778,
+	205, 1879, 205, 1954, 205, 1966, 205, 1976, 205, 1978, 205, 2023, 205, 2027,
+	205, 2042, 205, 2044, 205, 2047, 205, 2053, 205, 2054, 205, 2056, 205, 2065,
+	205, 2066, 205, 2068, 205, 2073, 205, 2076, 205, 2082, 205, 2085, 205, 2120,
+	205, 2121, 205, 2139, 205, 2156, 205, 2265, 205, 2296, 205, 2343, 205, 86,
+	206, 436, 206, 1732, 206, 1737, 206, 1740, 206, 1778, 206, 1879, 206, 1954,
+	206, 1966, 206, 1976, 206, 1978, 206, 2023, 206, 2027, 206, 2042, 206, 2044,
+	206, 2047, 206, 2053, 206, 2054, 206, 2056, 206, 2065, 206, 2066, 206, 2068,
+	206, 2073, 206, 2076, 206, 2082, 206, 2085, 206, 2120, 206, 2121, 206, 2139,
+	206, 2156, 206, 2265, 206, 2296, 206, 2343, 206, 86, 207, 436, 207, 1732,
+	207, 1737, 207, 1740, 207, 1778, 207, 1879, 207, 1954, 207, 1966, 207, 1976,
+	207, 1978, 207, 2023, 207, 2027, 207, 2042, 207, 2044, 207, 2047, 207, 2053,
+	207, 2054, 207, 2056, 207, 2065, 207, 2066, 207, 2068, 207, 2073, 207, 2076,
+	207, 2082, 207, 2085, 207, 2120, 207, 2121, 207, 2139, 207, 2156, 207, 2265,
+	207, 2296, 207, 2343, 207, 86, 208, 436, 208, 1732, 208, 1737, 208, 1740,
+	208, 1778, 208, 1879, 208, 1954, 208, 1966, 208, 1976, 208, 1978, 208, 2023,
+	208, 2027, 208, 2042, 208, 2044, 208, 2047, 208, 2053, 208, 2054, 208, 2056,
+	208, 2065, 208, 2066, 208, 2068, 208, 2073, 208, 2076, 208, 2082, 208, 2085,
+	208, 2120, 208, 2121, 208, 2139, 208, 2156, 208, 2265, 208, 2296, 208, 2343,
+	208, 86, 209, 436, 209, 1732, 209, 1737, 209, 1738, 1903, 1739, 1903, 1740,
+	209, 1778, 209, 1879, 209, 1954, 209, 1966, 209, 1976, 209, 1978, 209, 2023,
+	209, 2027, 209, 2042, 209, 2044, 209, 2047, 209, 2053, 209, 2054, 209, 2056,
+	209, 2065, 209, 2066, 209, 2068, 209, 2073, 209, 2076, 209, 2082, 209, 2085,
+	209, 2120, 209, 2121, 209, 2139, 209, 2156, 209, 2265, 209, 2296, 209, 2343,
+	209, 86, 210, 436, 210, 1732, 210, 1737, 210, 1740, 210, 1778, 210, 1879,
+	210, 1954, 210, 1966, 210, 1976, 210, 1978, 210, 2023, 210, 2027, 210, 2042,
+	210, 2044, 210, 2047, 210, 2053, 210, 2054, 210, 2056, 210, 2065, 210, 2066,
+	210, 2068, 210, 2073, 210, 2076, 210, 2082, 210, 2085, 210, 2120, 210, 2121,
+	210, 2139, 210, 2156, 210, 2265, 210, 2296, 210, 2343, 210, 193, 437, 194,
+	441, 195, 437, 442, 685, 86, 211, 436, 211, 1732, 211, 1737, 211, 1740, 211,
+	1778, 211, 1879, 211, 1954, 211, 1966, 211, 1976, 211, 1978, 211, 2023, 211,
+	2027, 211, 2042, 211, 2044, 211, 2047, 211, 2053, 211, 2054, 211, 2056, 211,
+	2065, 211, 2066, 211, 2068, 211, 2073, 211, 2076, 211, 2082, 211, 2085, 211,
+	2120, 211, 2121, 211, 2139, 211, 2156, 211, 2265, 211, 2296, 211, 2343, 211,
+	193, 438, 195, 443, 86, 212, 436, 212, 1732, 212, 1737, 212, 1740, 212,
+	1778, 212, 1879, 212, 1954, 212, 1966, 212, 1976, 212, 1978, 212, 2023, 212,
+	2027, 212, 2042, 212, 2044, 212, 2047, 212, 2053, 212, 2054, 212, 2056, 212,
+	2065, 212, 2066, 212, 2068, 212, 2073, 212, 2076, 212, 2082, 212, 2085, 212,
+	2120, 212, 2121, 212, 2139, 212, 2156, 212, 2265, 212, 2296, 212, 2343, 212,
+	86, 213, 436, 213, 1732, 213, 1737, 213, 1740, 213, 1778, 213, 1879, 213,
+	1954, 213, 1966, 213, 1976, 213, 1978, 213, 2023, 213, 2027, 213, 2042, 213,
+	2044, 213, 2047, 213, 2053, 213, 2054, 213, 2056, 213, 2065, 213, 2066, 213,
+	2068, 213, 2073, 213, 2076, 213, 2082, 213, 2085, 213, 2120, 213, 2121, 213,
+	2139, 213, 2156, 213, 2265, 213, 2296, 213, 2343, 213, 86, 214, 436, 214,
+	1732, 214, 1737, 214, 1740, 214, 1778, 214, 1879, 214, 1954, 214, 1966, 214,
+	1976, 214, 1978, 214, 2023, 214, 2027, 214, 2042, 214, 2044, 214, 2047, 214,
+	2053, 214, 2054, 214, 2056, 214, 2065, 214, 2066, 214, 2068, 214, 2073, 214,
+	2076, 214, 2082, 214, 2085, 214, 2120, 214, 2121, 214, 2139, 214, 2156, 214,
+	2265, 214, 2296, 214, 2343, 214, 86, 215, 436, 215, 1732, 215, 1737, 215,
+	1740, 215, 1778, 215, 1879, 215, 1954, 215, 1966, 215, 1976, 215, 1978, 215,
+	2023, 215, 2027, 215, 2042, 215, 2044, 215, 2047, 215, 2053, 215, 2054, 215,
+	2056, 215, 2065, 215, 2066, 215, 2068, 215, 2073, 215, 2076, 215, 2082, 215,
+	2085, 215, 2120, 215, 2121, 215, 2139, 215, 2156, 215, 2265, 215, 2296, 215,
+	2343, 215, 86, 216, 436, 216, 1732, 216, 1737, 216, 1740, 216, 1778, 216,
+	1879, 216, 1954, 216, 1966, 216, 1976, 216, 1978, 216, 2023, 216, 2027, 216,
+	2042, 216, 2044, 216, 2047, 216, 2053, 216, 2054, 216, 2056, 216, 2065, 216,
+	2066, 216, 2068, 216, 2073, 216, 2076, 216, 2082, 216, 2085, 216, 2120, 216,
+	2121, 216, 2139, 216, 2156, 216, 2265, 216, 2296, 216, 2343, 216, 86, 217,
+	436, 217, 1732, 217, 1737, 217, 1740, 217, 1778, 217, 1879, 217, 1954, 217,
+	1966, 217, 1976, 217, 1978, 217, 2023, 217, 2027, 217, 2042, 217, 2044, 217,
+	2047, 217, 2053, 217, 2054, 217, 2056, 217, 2065, 217, 2066, 217, 2068, 217,
+	2073, 217, 2076, 217, 2082, 217, 2085, 217, 2120, 217, 2121, 217, 2139, 217,
+	2156, 217, 2265, 217, 2296, 217, 2343, 217, 86, 218, 436, 218, 1732, 218,
+	1737, 218, 1740, 218, 1778, 218, 1879, 218, 1954, 218, 1966, 218, 1976, 218,
+	1978, 218, 2023, 218, 2027, 218, 2042, 218, 2044, 218, 2047, 218, 2053, 218,
+	2054, 218, 2056, 218, 2065, 218, 2066, 218, 2068, 218, 2073, 218, 2076, 218,
+	2082, 218, 2085, 218, 2120, 218, 2121, 218, 2139, 218, 2156, 218, 2265, 218,
+	2296, 218, 2343, 218, 86, 219, 436, 219, 1732, 219, 1737, 219, 1740, 219,
+	1778, 219, 1879, 219, 1954, 219, 1966, 219, 1976, 219, 1978, 219, 2023, 219,
+	2027, 219, 2042, 219, 2044, 219, 2047, 219, 2053, 219, 2054, 219, 2056, 219,
+	2065, 219, 2066, 219, 2068, 219, 2073, 219, 2076, 219, 2082, 219, 2085, 219,
+	2120, 219, 2121, 219, 2139, 219, 2156, 219, 2265, 219, 2296, 219, 2343, 219,
+	86, 220, 436, 220, 1732, 220, 1737, 220, 1740, 220, 1778, 220, 1879, 220,
+	1954, 220, 1966, 220, 1976, 220, 1978, 220, 2023, 220, 2027, 220, 2042, 220,
+	2044, 220, 2047, 220, 2053, 220, 2054, 220, 2056, 220, 2065, 220, 2066, 220,
+	2068, 220, 2073, 220, 2076, 220, 2082, 220, 2085, 220, 2120, 220, 2121, 220,
+	2139, 220, 2156, 220, 2265, 220, 2296, 220, 2343, 220, 86, 221, 436, 221,
+	1732, 221, 1737, 221, 1740, 221, 1778, 221, 1879, 221, 1954, 221, 1966, 221,
+	1976, 221, 1978, 221, 2023, 221, 2027, 221, 2042, 221, 2044, 221, 2047, 221,
+	2053, 221, 2054, 221, 2056, 221, 2065, 221, 2066, 221, 2068, 221, 2073, 221,
+	2076, 221, 2082, 221, 2085, 221, 2120, 221, 2121, 221, 2139, 221, 2156, 221,
+	2265, 221, 2296, 221, 2343, 221, 140, 358, 169, 418, 179, 425, 183, 429,
+	1461, 1721, 1501, 1773, 1512, 1783, 1517, 1789, 86, 222, 436, 222, 1732,
+	222, 1737, 222, 1740, 222, 1778, 222, 1879, 222, 1954, 222, 1966, 222, 1976,
+	222, 1978, 222, 2023, 222, 2027, 222, 2042, 222, 2044, 222, 2047, 222, 2053,
+	222, 2054, 222, 2056, 222, 2065, 222, 2066, 222, 2068, 222, 2073, 222, 2076,
+	222, 2082, 222, 2085, 222, 2120, 222, 2121, 222, 2139, 222, 2156, 222, 2265,
+	222, 2296, 222, 2343, 222, 86, 223, 436, 223, 1732, 223, 1737, 223, 1740,
+	223, 1778, 223, 1879, 223, 1954, 223, 1966, 223, 1976, 223, 1978, 223, 2023,
+	223, 2027, 223, 2042, 223, 2044, 223, 2047, 223, 2053, 223, 2054, 223, 2056,
+	223, 2065, 223, 2066, 223, 2068, 223, 2073, 223, 2076, 223, 2082, 223, 2085,
+	223, 2120, 223, 2121, 223, 2139, 223, 2156, 223, 2265, 223, 2296, 223, 2343,
+	223, 86, 224, 436, 224, 1732, 224, 1737, 224, 1740, 224, 1778, 224, 1879,
+	224, 1954, 224, 1966, 224, 1976, 224, 1978, 224, 2023, 224, 2027, 224, 2042,
+	224, 2044, 224, 2047, 224, 2053, 224, 2054, 224, 2056, 224, 2065, 224, 2066,
+	224, 2068, 224, 2073, 224, 2076, 224, 2082, 224, 2085, 224, 2120, 224, 2121,
+	224, 2139, 224, 2156, 224, 2265, 224, 2296, 224, 2343, 224, 86, 225, 436,
+	225, 1732, 225, 1737, 225, 1740, 225, 1778, 225, 1879, 225, 1954, 225, 1966,
+	225, 1976, 225, 1978, 225, 2023, 225, 2027, 225, 2042, 225, 2044, 225, 2047,
+	225, 2053, 225, 2054, 225, 2056, 225, 2065, 225, 2066, 225, 2068, 225, 2073,
+	225, 2076, 225, 2082, 225, 2085, 225, 2120, 225, 2121, 225, 2139, 225, 2156,
+	225, 2265, 225, 2296, 225, 2343, 225, 86, 226, 436, 226, 1732, 226, 1737,
+	226, 1740, 226, 1778, 226, 1879, 226, 1954, 226, 1966, 226, 1976, 226, 1978,
+	226, 2023, 226, 2027, 226, 2042, 226, 2044, 226, 2047, 226, 2053, 226, 2054,
+	226, 2056, 226, 2065, 226, 2066, 226, 2068, 226, 2073, 226, 2076, 226, 2082,
+	226, 2085, 226, 2120, 226, 2121, 226, 2139, 226, 2156, 226, 2265, 226, 2296,
+	226, 2343, 226, 86, 227, 436, 227, 1732, 227, 1737, 227, 1740, 227, 1778,
+	227, 1879, 227, 1954, 227, 1966, 227, 1976, 227, 1978, 227, 2023, 227, 2027,
+	227, 2042, 227, 2044, 227, 2047, 227, 2053, 227, 2054, 227, 2056, 227, 2065,
+	227, 2066, 227, 2068, 227, 2073, 227, 2076, 227, 2082, 227, 2085, 227, 2120,
+	227, 2121, 227, 2139, 227, 2156, 227, 2265, 227, 2296, 227, 2343, 227, 86,
+	228, 436, 228, 1732, 228, 1737, 228, 1740, 228, 1778, 228, 1879, 228, 1954,
+	228, 1966, 228, 1976, 228, 1978, 228, 2023, 228, 2027, 228, 2042, 228, 2044,
+	228, 2047, 228, 2053, 228, 2054, 228, 2056, 228, 2065, 228, 2066, 228, 2068,
+	228, 2073, 228, 2076, 228, 2082, 228, 2085, 228, 2120, 228, 2121, 228, 2139,
+	228, 2156, 228, 2265, 228, 2296, 228, 2343, 228, 86, 229, 436, 229, 1732,
+	229, 1737, 229, 1740, 229, 1778, 229, 1879, 229, 1954, 229, 1966, 229, 1976,
+	229, 1978, 229, 2023, 229, 2027, 229, 2042, 229, 2044, 229, 2047, 229, 2053,
+	229, 2054, 229, 2056, 229, 2065, 229, 2066, 229, 2068, 229, 2073, 229, 2076,
+	229, 2082, 229, 2085, 229, 2120, 229, 2121, 229, 2139, 229, 2156, 229, 2265,
+	229, 2296, 229, 2343, 229, 86, 230, 436, 230, 1732, 230, 1737, 230, 1740,
+	230, 1778, 230, 1879, 230, 1954, 230, 1966, 230, 1976, 230, 1978, 230, 2023,
+	230, 2027, 230, 2042, 230, 2044, 230, 2047, 230, 2053, 230, 2054, 230, 2056,
+	230, 2065, 230, 2066, 230, 2068, 230, 2073, 230, 2076, 230, 2082, 230, 2085,
+	230, 2120, 230, 2121, 230, 2139, 230, 2156, 230, 2265, 230, 2296, 230, 2343,
+	230, 86, 231, 436, 231, 1732, 231, 1737, 231, 1740, 231, 1778, 231, 1879,
+	231, 1954, 231, 1966, 231, 1976, 231, 1978, 231, 2023, 231, 2027, 231, 2042,
+	231, 2044, 231, 2047, 231, 2053, 231, 2054, 231, 2056, 231, 2065, 231, 2066,
+	231, 2068, 231, 2073, 231, 2076, 231, 2082, 231, 2085, 231, 2120, 231, 2121,
+	231, 2139, 231, 2156, 231, 2265, 231, 2296, 231, 2343, 231, 86, 232, 436,
+	232, 1732, 232, 1737, 232, 1740, 232, 1778, 232, 1879, 232, 1954, 232, 1966,
+	232, 1976, 232, 1978, 232, 2023, 232, 2027, 232, 2042, 232, 2044, 232, 2047,
+	232, 2053, 232, 2054, 232, 2056, 232, 2065, 232, 2066, 232, 2068, 232, 2073,
+	232, 2076, 232, 2082, 232, 2085, 232, 2120, 232, 2121, 232, 2139, 232, 2156,
+	232, 2265, 232, 2296, 232, 2343, 232, 86, 233, 436, 233, 1732, 233, 1737,
+	233, 1740, 233, 1778, 233, 1879, 233, 1954, 233, 1966, 233, 1976, 233, 1978,
+	233, 2023, 233, 2027, 233, 2042, 233, 2044, 233, 2047, 233, 2053, 233, 2054,
+	233, 2056, 233, 2065, 233, 2066, 233, 2068, 233, 2073, 233, 2076, 233, 2082,
+	233, 2085, 233, 2120, 233, 2121, 233, 2139, 233, 2156, 233, 2265, 233, 2296,
+	233, 2343, 233, 86, 234, 436, 234, 1732, 234, 1737, 234, 1740, 234, 1778,
+	234, 1879, 234, 1954, 234, 1966, 234, 1976, 234, 1978, 234, 2023, 234, 2027,
+	234, 2042, 234, 2044, 234, 2047, 234, 2053, 234, 2054, 234, 2056, 234, 2065,
+	234, 2066, 234, 2068, 234, 2073, 234, 2076, 234, 2082, 234, 2085, 234, 2120,
+	234, 2121, 234, 2139, 234, 2156, 234, 2265, 234, 2296, 234, 2343, 234, 86,
+	235, 436, 235, 1732, 235, 1737, 235, 1740, 235, 1778, 235, 1879, 235, 1954,
+	235, 1966, 235, 1976, 235, 1978, 235, 2023, 235, 2027, 235, 2042, 235, 2044,
+	235, 2047, 235, 2053, 235, 2054, 235, 2056, 235, 2065, 235, 2066, 235, 2068,
+	235, 2073, 235, 2076, 235, 2082, 235, 2085, 235, 2120, 235, 2121, 235, 2139,
+	235, 2156, 235, 2265, 235, 2296, 235, 2343, 235, 86, 236, 436, 236, 1732,
+	236, 1737, 236, 1740, 236, 1778, 236, 1879, 236, 1954, 236, 1966, 236, 1976,
+	236, 1978, 236, 2023, 236, 2027, 236, 2042, 236, 2044, 236, 2047, 236, 2053,
+	236, 2054, 236, 2056, 236, 2065, 236, 2066, 236, 2068, 236, 2073, 236, 2076,
+	236, 2082, 236, 2085, 236, 2120, 236, 2121, 236, 2139, 236, 2156, 236, 2265,
+	236, 2296, 236, 2343, 236, 86, 237, 436, 237, 1732, 237, 1737, 237, 1740,
+	237, 1778, 237, 1879, 237, 1954, 237, 1966, 237, 1976, 237, 1978, 237, 2023,
+	237, 2027, 237, 2042, 237, 2044, 237, 2047, 237, 2053, 237, 2054, 237, 2056,
+	237, 2065, 237, 2066, 237, 2068, 237, 2073, 237, 2076, 237, 2082, 237, 2085,
+	237, 2120, 237, 2121, 237, 2139, 237, 2156, 237, 2265, 237, 2296, 237, 2343,
+	237, 86, 238, 436, 238, 1732, 238, 1737, 238, 1740, 238, 1778, 238, 1879,
+	238, 1954, 238, 1966, 238, 1976, 238, 1978, 238, 2023, 238, 2027, 238, 2042,
+	238, 2044, 238, 2047, 238, 2053, 238, 2054, 238, 2056, 238, 2065, 238, 2066,
+	238, 2068, 238, 2073, 238, 2076, 238, 2082, 238, 2085, 238, 2120, 238, 2121,
+	238, 2139, 238, 2156, 238, 2265, 238, 2296, 238, 2343, 238, 86, 239, 436,
+	239, 1732, 239, 1737, 239, 1740, 239, 1778, 239, 1879, 239, 1954, 239, 1966,
+	239, 1976, 239, 1978, 239, 2023, 239, 2027, 239, 2042, 239, 2044, 239, 2047,
+	239, 2053, 239, 2054, 239, 2056, 239, 2065, 239, 2066, 239, 2068, 239, 2073,
+	239, 2076, 239, 2082, 239, 2085, 239, 2120, 239, 2121, 239, 2139, 239, 2156,
+	239, 2265, 239, 2296, 239, 2343, 239, 86, 240, 436, 240, 1732, 240, 1737,
+	240, 1740, 240, 1778, 240, 1879, 240, 1954, 240, 1966, 240, 1976, 240, 1978,
+	240, 2023, 240, 2027, 240, 2042, 240, 2044, 240, 2047, 240, 2053, 240, 2054,
+	240, 2056, 240, 2065, 240, 2066, 240, 2068, 240, 2073, 240, 2076, 240, 2082,
+	240, 2085, 240, 2120, 240, 2121, 240, 2139, 240, 2156, 240, 2265, 240, 2296,
+	240, 2343, 240, 86, 241, 436, 241, 1732, 241, 1737, 241, 1740, 241, 1778,
+	241, 1879, 241, 1954, 241, 1966, 241, 1976, 241, 1978, 241, 2023, 241, 2027,
+	241, 2042, 241, 2044, 241, 2047, 241, 2053, 241, 2054, 241, 2056, 241, 2065,
+	241, 2066, 241, 2068, 241, 2073, 241, 2076, 241, 2082, 241, 2085, 241, 2120,
+	241, 2121, 241, 2139, 241, 2156, 241, 2265, 241, 2296, 241, 2343, 241, 86,
+	242, 436, 242, 1732, 242, 1737, 242, 1740, 242, 1778, 242, 1879, 242, 1954,
+	242, 1966, 242, 1976, 242, 1978, 242, 2023, 242, 2027, 242, 2042, 242, 2044,
+	242, 2047, 242, 2053, 242, 2054, 242, 2056, 242, 2065, 242, 2066, 242, 2068,
+	242, 2073, 242, 2076, 242, 2082, 242, 2085, 242, 2120, 242, 2121, 242, 2139,
+	242, 2156, 242, 2265, 242, 2296, 242, 2343, 242, 645, 1224, 1651, 1836, 86,
+	243, 436, 243, 1732, 243, 1737, 243, 1740, 243, 1778, 243, 1879, 243, 1954,
+	243, 1966, 243, 1976, 243, 1978, 243, 2023, 243, 2027, 243, 2042, 243, 2044,
+	243, 2047, 243, 2053, 243, 2054, 243, 2056, 243, 2065, 243, 2066, 243, 2068,
+	243, 2073, 243, 2076, 243, 2082, 243, 2085, 243, 2120, 243, 2121, 243, 2139,
+	243, 2156, 243, 2265, 243, 2296, 243, 2343, 243, 86, 244, 436, 244, 1732,
+	244, 1737, 244, 1740, 244, 1778, 244, 1879, 244, 1954, 244, 1966, 244, 1976,
+	244, 1978, 244, 2023, 244, 2027, 244, 2042, 244, 2044, 244, 2047, 244, 2053,
+	244, 2054, 244, 2056, 244, 2065, 244, 2066, 244, 2068, 244, 2073, 244, 2076,
+	244, 2082, 244, 2085, 244, 2120, 244, 2121, 244, 2139, 244, 2156, 244, 2197,
+	2258, 2201, 2258, 2265, 244, 2296, 244, 2343, 244, 1833, 1998, 2097, 2194,
+	2097, 2195, 86, 245, 436, 245, 1732, 245, 1737, 245, 1740, 245, 1778, 245,
+	1879, 245, 1954, 245, 1966, 245, 1976, 245, 1978, 245, 2023, 245, 2027, 245,
+	2042, 245, 2044, 245, 2047, 245, 2053, 245, 2054, 245, 2056, 245, 2065, 245,
+	2066, 245, 2068, 245, 2073, 245, 2076, 245, 2082, 245, 2085, 245, 2120, 245,
+	2121, 245, 2139, 245, 2156, 245, 2265, 245, 2296, 245, 2343, 245, 86, 246,
+	436, 246, 1732, 246, 1737, 246, 1740, 246, 1778, 246, 1879, 246, 1954, 246,
+	1966, 246, 1976, 246, 1978, 246, 2023, 246, 2027, 246, 2042, 246, 2044, 246,
+	2047, 246, 2053, 246, 2054, 246, 2056, 246, 2065, 246, 2066, 246, 2068, 246,
+	2073, 246, 2076, 246, 2082, 246, 2085, 246, 2120, 246, 2121, 246, 2139, 246,
+	2156, 246, 2265, 246, 2296, 246, 2343, 246, 86, 247, 436, 247, 1732, 247,
+	1737, 247, 1740, 247, 1778, 247, 1879, 247, 1954, 247, 1966, 247, 1976, 247,
+	1978, 247, 2023, 247, 2027, 247, 2042, 247, 2044, 247, 2047, 247, 2053, 247,
+	2054, 247, 2056, 247, 2065, 247, 2066, 247, 2068, 247, 2073, 247, 2076, 247,
+	2082, 247, 2085, 247, 2120, 247, 2121, 247, 2139, 247, 2156, 247, 2265, 247,
+	2296, 247, 2343, 247, 86, 248, 436, 248, 1732, 248, 1737, 248, 1740, 248,
+	1778, 248, 1879, 248, 1954, 248, 1966, 248, 1976, 248, 1978, 248, 2023, 248,
+	2027, 248, 2042, 248, 2044, 248, 2047, 248, 2053, 248, 2054, 248, 2056, 248,
+	2065, 248, 2066, 248, 2068, 248, 2073, 248, 2076, 248, 2082, 248, 2085, 248,
+	2120, 248, 2121, 248, 2139, 248, 2156, 248, 2265, 248, 2296, 248, 2343, 248,
+	86, 249, 436, 249, 1732, 249, 1737, 249, 1740, 249, 1778, 249, 1879, 249,
+	1954, 249, 1966, 249, 1976, 249, 1978, 249, 2023, 249, 2027, 249, 2042, 249,
+	2044, 249, 2047, 249, 2053, 249, 2054, 249, 2056, 249, 2065, 249, 2066, 249,
+	2068, 249, 2073, 249, 2076, 249, 2082, 249, 2085, 249, 2120, 249, 2121, 249,
+	2139, 249, 2156, 249, 2265, 249, 2296, 249, 2343, 249, 86, 250, 436, 250,
+	1732, 250, 1737, 250, 1740, 250, 1778, 250, 1879, 250, 1954, 250, 1966, 250,
+	1976, 250, 1978, 250, 2023, 250, 2027, 250, 2042, 250, 2044, 250, 2047, 250,
+	2053, 250, 2054, 250, 2056, 250, 2065, 250, 2066, 250, 2068, 250, 2073, 250,
+	2076, 250, 2082, 250, 2085, 250, 2120, 250, 2121, 250, 2139, 250, 2156, 250,
+	2265, 250, 2296, 250, 2343, 250, 86, 251, 436, 251, 1732, 251, 1737, 251,
+	1740, 251, 1778, 251, 1879, 251, 1954, 251, 1966, 251, 1976, 251, 1978, 251,
+	2023, 251, 2027, 251, 2042, 251, 2044, 251, 2047, 251, 2053, 251, 2054, 251,
+	2056, 251, 2065, 251, 2066, 251, 2068, 251, 2073, 251, 2076, 251, 2082, 251,
+	2085, 251, 2120, 251, 2121, 251, 2139, 251, 2156, 251, 2265, 251, 2296, 251,
+	2343, 251, 86, 252, 436, 252, 1732, 252, 1737, 252, 1740, 252, 1778, 252,
+	1879, 252, 1954, 252, 1966, 252, 1976, 252, 1978, 252, 2023, 252, 2027, 252,
+	2042, 252, 2044, 252, 2047, 252, 2053, 252, 2054, 252, 2056, 252, 2065, 252,
+	2066, 252, 2068, 252, 2073, 252, 2076, 252, 2082, 252, 2085, 252, 2120, 252,
+	2121, 252, 2139, 252, 2156, 252, 2265, 252, 2296, 252, 2343, 252, 86, 253,
+	436, 253, 1732, 253, 1737, 253, 1740, 253, 1778, 253, 1879, 253, 1954, 253,
+	1966, 253, 1976, 253, 1978, 253, 2023, 253, 2027, 253, 2042, 253, 2044, 253,
+	2047, 253, 2053, 253, 2054, 253, 2056, 253, 2065, 253, 2066, 253, 2068, 253,
+	2073, 253, 2076, 253, 2082, 253, 2085, 253, 2120, 253, 2121, 253, 2139, 253,
+	2156, 253, 2265, 253, 2296, 253, 2343, 253, 86, 254, 436, 254, 1732, 254,
+	1737, 254, 1740, 254, 1778, 254, 1879, 254, 1954, 254, 1966, 254, 1976, 254,
+	1978, 254, 2023, 254, 2027, 254, 2042, 254, 2044, 254, 2047, 254, 2053, 254,
+	2054, 254, 2056, 254, 2065, 254, 2066, 254, 2068, 254, 2073, 254, 2076, 254,
+	2082, 254, 2085, 254, 2120, 254, 2121, 254, 2139, 254, 2156, 254, 2265, 254,
+	2296, 254, 2343, 254, 86, 255, 436, 255, 1732, 255, 1737, 255, 1740, 255,
+	1778, 255, 1879, 255, 1954, 255, 1966, 255, 1976, 255, 1978, 255, 2023, 255,
+	2027, 255, 2042, 255, 2044, 255, 2047, 255, 2053, 255, 2054, 255, 2056, 255,
+	2065, 255, 2066, 255, 2068, 255, 2073, 255, 2076, 255, 2082, 255, 2085, 255,
+	2120, 255, 2121, 255, 2139, 255, 2156, 255, 2197, 2259, 2201, 2259, 2265,
+	255, 2296, 255, 2343, 255, 86, 256, 436, 256, 1732, 256, 1737, 256, 1740,
+	256, 1778, 256, 1879, 256, 1954, 256, 1966, 256, 1976, 256, 1978, 256, 2023,
+	256, 2027, 256, 2042, 256, 2044, 256, 2047, 256, 2053, 256, 2054, 256, 2056,
+	256, 2065, 256, 2066, 256, 2068, 256, 2073, 256, 2076, 256, 2082, 256, 2085,
+	256, 2120, 256, 2121, 256, 2139, 256, 2156, 256, 2197, 2260, 2201, 2260,
+	2265, 256, 2296, 256, 2343, 256, 86, 257, 436, 257, 1732, 257, 1737, 257,
+	1740, 257, 1778, 257, 1879, 257, 1954, 257, 1966, 257, 1976, 257, 1978, 257,
+	2023, 257, 2027, 257, 2042, 257, 2044, 257, 2047, 257, 2053, 257, 2054, 257,
+	2056, 257, 2065, 257, 2066, 257, 2068, 257, 2073, 257, 2076, 257, 2082, 257,
+	2085, 257, 2120, 257, 2121, 257, 2139, 257, 2156, 257, 2197, 2261, 2201,
+	2261, 2265, 257, 2296, 257, 2343, 257, 86, 258, 436, 258, 1732, 258, 1737,
+	258, 1740, 258, 1778, 258, 1879, 258, 1954, 258, 1966, 258, 1976, 258, 1978,
+	258, 2023, 258, 2027, 258, 2042, 258, 2044, 258, 2047, 258, 2053, 258, 2054,
+	258, 2056, 258, 2065, 258, 2066, 258, 2068, 258, 2073, 258, 2076, 258, 2082,
+	258, 2085, 258, 2120, 258, 2121, 258, 2139, 258, 2156, 258, 2265, 258, 2296,
+	258, 2343, 258, 86, 259, 436, 259, 1732, 259, 1737, 259, 1740, 259, 1778,
+	259, 1879, 259, 1954, 259, 1966, 259, 1976, 259, 1978, 259, 2023, 259, 2027,
+	259, 2042, 259, 2044, 259, 2047, 259, 2053, 259, 2054, 259, 2056, 259, 2065,
+	259, 2066, 259, 2068, 259, 2073, 259, 2076, 259, 2082, 259, 2085, 259, 2120,
+	259, 2121, 259, 2139, 259, 2156, 259, 2265, 259, 2296, 259, 2343, 259, 86,
+	260, 436, 260, 1732, 260, 1737, 260, 1740, 260, 1778, 260, 1879, 260, 1954,
+	260, 1966, 260, 1976, 260, 1978, 260, 2023, 260, 2027, 260, 2042, 260, 2044,
+	260, 2047, 260, 2053, 260, 2054, 260, 2056, 260, 2065, 260, 2066, 260, 2068,
+	260, 2073, 260, 2076, 260, 2082, 260, 2085, 260, 2120, 260, 2121, 260, 2139,
+	260, 2156, 260, 2265, 260, 2296, 260, 2343, 260, 334, 568, 567, 1161, 569,
+	1162, 1162, 1529, 1162, 1530, 1162, 1531, 1800, 1980, 1162, 1532, 1800,
+	1532, 1478, 1746, 1479, 1747, 1480, 1748, 1482, 1752, 1483, 1753, 1489,
+	1759, 1490, 1760, 1505, 1775, 1510, 1781, 1801, 1982, 1162, 1533, 1800,
+	1533, 1162, 1534, 1800, 1534, 1162, 1535, 1800, 1535, 1162, 1536, 1800,
+	1536, 1162, 1537, 1800, 1537, 1162, 1538, 1800, 1538, 1162, 1539, 1800,
+	1539, 1162, 1540, 1800, 1540, 1162, 1541, 1800, 1541, 1162, 1542, 1800,
+	1542, 1162, 1543, 1800, 1543, 1162, 1544, 1800, 1544, 1162, 1545, 1800,
+	1545, 1162, 1546, 1800, 1546, 1162, 1547, 1800, 1547, 1162, 1548, 1800,
+	1548, 1162, 1549, 1800, 1549, 1162, 1550, 1800, 1550, 1162, 1551, 1800,
+	1551, 1162, 1552, 1800, 1552, 1162, 1553, 1800, 1553, 1162, 1554, 1800,
+	1554, 1745, 1910, 2059, 2154, 1162, 1555, 1800, 1555, 1162, 1556, 1800,
+	1556, 1463, 1724, 1725, 1877, 1162, 1557, 1800, 1557, 1162, 1558, 1162,
+	1559, 1162, 1560, 1800, 1560, 1475, 1742, 1162, 1561, 1800, 1561, 1730,
+	1894, 1162, 1562, 1800, 1562, 2149, 2228, 1162, 1563, 1800, 1563, 1162,
+	1564, 1800, 1564, 1162, 1565, 1800, 1565, 1162, 1566, 1800, 1566, 1162,
+	1567, 1800, 1567, 1162, 1568, 1800, 1568, 1162, 1569, 1800, 1569, 1162,
+	1570, 1800, 1570, 1162, 1571, 1800, 1571, 1162, 1572, 1800, 1572, 1162,
+	1573, 1800, 1573, 1162, 1574, 1800, 1574, 1162, 1575, 1800, 1575, 1162,
+	1576, 1800, 1576, 1162, 1577, 1800, 1577, 1955, 2069, 1162, 1578, 1800,
+	1578, 1955, 2070, 2168, 2241, 1162, 1579, 1800, 1579, 1162, 1580, 1800,
+	1580, 1162, 1581, 1800, 1581, 2408, 2418, 2411, 2423, 2433, 2442, 1162,
+	1582, 1800, 1582, 1162, 1583, 1800, 1583, 1949, 2062, 1162, 1584, 1800,
+	1584, 1768, 1948, 2062, 2160, 2062, 2161, 1162, 1585, 1800, 1585, 2032,
+	2123, 2035, 2123, 2032, 2124, 2035, 2130, 1162, 1586, 1800, 1586, 1162,
+	1587, 1162, 1588, 1162, 1589, 1800, 1981, 1162, 1590, 1162, 1591, 1162,
+	1592, 2181, 2250, 1162, 1593, 2250, 2294, 1162, 1594, 2034, 2127, 2057,
+	2151, 2426, 2151, 2450, 2151, 2057, 2152, 2426, 2435, 2450, 2453, 1162,
+	1595, 1800, 1595, 1162, 1596, 1800, 1596, 1162, 1597, 1162, 1598, 1800,
+	1598, 2034, 2128, 1162, 1599, 1162, 1600, 1162, 1601, 489, 1151, 1452, 1151,
+	489, 1152, 1452, 1715, 112, 303, 271, 458, 489, 1153, 784, 1153, 785, 1153,
+	787, 1153, 799, 1153, 801, 1153, 802, 1153, 805, 1153, 809, 1153, 838, 1153,
+	839, 1153, 840, 1153, 841, 1153, 842, 1153, 843, 1153, 844, 1153, 847, 1153,
+	849, 1153, 850, 1153, 876, 1153, 925, 1153, 941, 1153, 942, 1153, 948, 1153,
+	994, 1153, 1013, 1153, 1056, 1153, 1057, 1153, 1058, 1153, 1070, 1153, 1071,
+	1153, 1075, 1153, 1076, 1153, 1104, 1153, 1105, 1153, 1106, 1153, 1107,
+	1153, 1116, 1153, 1130, 1153, 1452, 1153, 1457, 1153, 2122, 1153, 2311,
+	1153, 489, 1154, 784, 1292, 785, 1293, 787, 1295, 799, 1303, 801, 1305, 802,
+	1306, 805, 1311, 809, 1317, 838, 1325, 839, 1326, 840, 1327, 841, 1328, 842,
+	1329, 843, 1330, 844, 1331, 847, 1335, 849, 1338, 850, 1339, 876, 1343, 925,
+	1363, 941, 1366, 942, 1367, 948, 1370, 994, 1384, 1013, 1394, 1056, 1411,
+	1057, 1412, 1058, 1413, 1070, 1416, 1071, 1417, 1075, 1425, 1076, 1426,
+	1104, 1335, 1105, 1335, 1106, 1335, 1107, 1335, 1116, 1442, 1130, 1448,
+	1452, 1154, 1457, 1720, 489, 1155, 784, 1155, 785, 1155, 787, 1155, 799,
+	1155, 801, 1155, 802, 1155, 805, 1155, 809, 1155, 838, 1155, 839, 1155, 840,
+	1155, 841, 1155, 842, 1155, 843, 1155, 844, 1155, 847, 1155, 849, 1155, 850,
+	1155, 876, 1155, 925, 1155, 941, 1155, 942, 1155, 948, 1155, 994, 1155,
+	1013, 1155, 1056, 1155, 1057, 1155, 1058, 1155, 1070, 1155, 1071, 1155,
+	1075, 1155, 1076, 1155, 1104, 1155, 1105, 1155, 1106, 1155, 1107, 1155,
+	1116, 1155, 1130, 1155, 1452, 1155, 1457, 1155, 2122, 2212, 2311, 2344, 489,
+	1156, 784, 1156, 785, 1156, 787, 1156, 799, 1156, 801, 1156, 802, 1156, 805,
+	1156, 809, 1156, 838, 1156, 839, 1156, 840, 1156, 841, 1156, 842, 1156, 843,
+	1156, 844, 1156, 847, 1156, 849, 1156, 850, 1156, 876, 1156, 925, 1156, 941,
+	1156, 942, 1156, 948, 1156, 994, 1156, 1013, 1156, 1056, 1156, 1057, 1156,
+	1058, 1156, 1070, 1156, 1071, 1156, 1075, 1156, 1076, 1156, 1104, 1156,
+	1105, 1156, 1106, 1156, 1107, 1156, 1116, 1156, 1130, 1156, 1452, 1156,
+	1457, 1156, 2122, 1156, 2311, 1156, 47, 114, 115, 114, 1794, 1974, 1975,
+	2087, 2040, 1974, 2113, 1974, 2168, 1974, 2236, 1974, 2247, 1974, 2267,
+	1974, 2269, 1974, 2281, 1974, 2332, 1974, 2339, 1974, 2341, 1974, 2350,
+	1974, 2352, 1974, 2420, 1974, 112, 304, 489, 1157, 784, 1157, 785, 1157,
+	787, 1157, 799, 1157, 801, 1157, 802, 1157, 805, 1157, 809, 1157, 838, 1157,
+	839, 1157, 840, 1157, 841, 1157, 842, 1157, 843, 1157, 844, 1157, 847, 1157,
+	849, 1157, 850, 1157, 876, 1157, 925, 1157, 941, 1157, 942, 1157, 948, 1157,
+	994, 1157, 1013, 1157, 1056, 1157, 1057, 1157, 1058, 1157, 1070, 1157, 1071,
+	1157, 1075, 1157, 1076, 1157, 1104, 1157, 1105, 1157, 1106, 1157, 1107,
+	1157, 1116, 1157, 1130, 1157, 1452, 1157, 1457, 1157, 2122, 1157, 2311,
+	1157, 1455, 1717, 2427, 1717, 1455, 1718, 2427, 2436, 112, 305, 271, 459,
+	489, 1158, 784, 1158, 785, 1158, 787, 1158, 799, 1158, 801, 1158, 802, 1158,
+	805, 1158, 809, 1158, 838, 1158, 839, 1158, 840, 1158, 841, 1158, 842, 1158,
+	843, 1158, 844, 1158, 847, 1158, 849, 1158, 850, 1158, 876, 1158, 925, 1158,
+	941, 1158, 942, 1158, 948, 1158, 994, 1158, 1013, 1158, 1056, 1158, 1057,
+	1158, 1058, 1158, 1070, 1158, 1071, 1158, 1075, 1158, 1076, 1158, 1104,
+	1158, 1105, 1158, 1106, 1158, 1107, 1158, 1116, 1158, 1130, 1158, 1452,
+	1158, 1457, 1158, 2122, 1158, 2311, 1158, 112, 306, 271, 306, 489, 306, 784,
+	306, 785, 306, 787, 306, 799, 306, 801, 306, 802, 306, 805, 306, 809, 306,
+	838, 306, 839, 306, 840, 306, 841, 306, 842, 306, 843, 306, 844, 306, 847,
+	306, 849, 306, 850, 306, 876, 306, 925, 306, 941, 306, 942, 306, 948, 306,
+	994, 306, 1013, 306, 1056, 306, 1057, 306, 1058, 306, 1070, 306, 1071, 306,
+	1075, 306, 1076, 306, 1104, 306, 1105, 306, 1106, 306, 1107, 306, 1116, 306,
+	1130, 306, 1452, 306, 1457, 306, 2122, 306, 2311, 306, 461, 775, 461, 776,
+	461, 777, 1290, 1687, 112, 307, 271, 307, 489, 307, 784, 307, 785, 307, 787,
+	307, 799, 307, 801, 307, 802, 307, 805, 307, 809, 307, 838, 307, 839, 307,
+	840, 307, 841, 307, 842, 307, 843, 307, 844, 307, 847, 307, 849, 307, 850,
+	307, 876, 307, 925, 307, 941, 307, 942, 307, 948, 307, 994, 307, 1013, 307,
+	1056, 307, 1057, 307, 1058, 307, 1070, 307, 1071, 307, 1075, 307, 1076, 307,
+	1104, 307, 1105, 307, 1106, 307, 1107, 307, 1116, 307, 1130, 307, 1452, 307,
+	1457, 307, 2122, 307, 2311, 307, 482, 1059, 482, 1060, 482, 1061, 1414,
+	1708, 112, 308, 271, 308, 489, 308, 784, 308, 785, 308, 787, 308, 799, 308,
+	801, 308, 802, 308, 805, 308, 809, 308, 838, 308, 839, 308, 840, 308, 841,
+	308, 842, 308, 843, 308, 844, 308, 847, 308, 849, 308, 850, 308, 876, 308,
+	925, 308, 941, 308, 942, 308, 948, 308, 994, 308, 1013, 308, 1056, 308,
+	1057, 308, 1058, 308, 1070, 308, 1071, 308, 1075, 308, 1076, 308, 1104, 308,
+	1105, 308, 1106, 308, 1107, 308, 1116, 308, 1130, 308, 1452, 308, 1457, 308,
+	2122, 308, 2311, 308, 462, 788, 462, 789, 462, 790, 1296, 1688, 112, 309,
+	271, 309, 489, 309, 784, 309, 785, 309, 787, 309, 799, 309, 801, 309, 802,
+	309, 805, 309, 809, 309, 838, 309, 839, 309, 840, 309, 841, 309, 842, 309,
+	843, 309, 844, 309, 847, 309, 849, 309, 850, 309, 876, 309, 925, 309, 941,
+	309, 942, 309, 948, 309, 994, 309, 1013, 309, 1056, 309, 1057, 309, 1058,
+	309, 1070, 309, 1071, 309, 1075, 309, 1076, 309, 1104, 309, 1105, 309, 1106,
+	309, 1107, 309, 1116, 309, 1130, 309, 1452, 309, 1457, 309, 2122, 309, 2311,
+	309, 463, 815, 463, 816, 463, 817, 1323, 1689, 112, 310, 271, 310, 489, 310,
+	784, 310, 785, 310, 787, 310, 799, 310, 801, 310, 802, 310, 805, 310, 809,
+	310, 838, 310, 839, 310, 840, 310, 841, 310, 842, 310, 843, 310, 844, 310,
+	847, 310, 849, 310, 850, 310, 876, 310, 925, 310, 941, 310, 942, 310, 948,
+	310, 994, 310, 1013, 310, 1056, 310, 1057, 310, 1058, 310, 1070, 310, 1071,
+	310, 1075, 310, 1076, 310, 1104, 310, 1105, 310, 1106, 310, 1107, 310, 1116,
+	310, 1130, 310, 1452, 310, 1457, 310, 2122, 310, 2311, 310, 464, 851, 464,
+	852, 464, 853, 1340, 1690, 112, 311, 271, 311, 489, 311, 784, 311, 785, 311,
+	787, 311, 799, 311, 801, 311, 802, 311, 805, 311, 809, 311, 838, 311, 839,
+	311, 840, 311, 841, 311, 842, 311, 843, 311, 844, 311, 847, 311, 849, 311,
+	850, 311, 876, 311, 925, 311, 941, 311, 942, 311, 948, 311, 994, 311, 1013,
+	311, 1056, 311, 1057, 311, 1058, 311, 1070, 311, 1071, 311, 1075, 311, 1076,
+	311, 1104, 311, 1105, 311, 1106, 311, 1107, 311, 1116, 311, 1130, 311, 1452,
+	311, 1457, 311, 2122, 311, 2311, 311, 465, 877, 465, 878, 465, 879, 1344,
+	1691, 112, 312, 271, 312, 489, 312, 784, 312, 785, 312, 787, 312, 799, 312,
+	801, 312, 802, 312, 805, 312, 809, 312, 838, 312, 839, 312, 840, 312, 841,
+	312, 842, 312, 843, 312, 844, 312, 847, 312, 849, 312, 850, 312, 876, 312,
+	925, 312, 941, 312, 942, 312, 948, 312, 994, 312, 1013, 312, 1056, 312,
+	1057, 312, 1058, 312, 1070, 312, 1071, 312, 1075, 312, 1076, 312, 1104, 312,
+	1105, 312, 1106, 312, 1107, 312, 1116, 312, 1130, 312, 1452, 312, 1457, 312,
+	2122, 312, 2311, 312, 466, 895, 466, 896, 466, 897, 1348, 1692, 112, 313,
+	271, 313, 457, 696, 489, 313, 784, 313, 785, 313, 787, 313, 799, 313, 801,
+	313, 802, 313, 805, 313, 809, 313, 838, 313, 839, 313, 840, 313, 841, 313,
+	842, 313, 843, 313, 844, 313, 847, 313, 849, 313, 850, 313, 876, 313, 925,
+	313, 941, 313, 942, 313, 948, 313, 994, 313, 1013, 313, 1056, 313, 1057,
+	313, 1058, 313, 1070, 313, 1071, 313, 1075, 313, 1076, 313, 1104, 313, 1105,
+	313, 1106, 313, 1107, 313, 1116, 313, 1130, 313, 1267, 696, 1452, 313, 1457,
+	313, 2122, 313, 2311, 313, 467, 904, 467, 905, 467, 906, 1350, 1693, 112,
+	314, 271, 314, 489, 314, 784, 314, 785, 314, 787, 314, 799, 314, 801, 314,
+	802, 314, 805, 314, 809, 314, 838, 314, 839, 314, 840, 314, 841, 314, 842,
+	314, 843, 314, 844, 314, 847, 314, 849, 314, 850, 314, 876, 314, 925, 314,
+	941, 314, 942, 314, 948, 314, 994, 314, 1013, 314, 1056, 314, 1057, 314,
+	1058, 314, 1070, 314, 1071, 314, 1075, 314, 1076, 314, 1104, 314, 1105, 314,
+	1106, 314, 1107, 314, 1116, 314, 1130, 314, 1452, 314, 1457, 314, 2122, 314,
+	2311, 314, 468, 914, 468, 915, 468, 916, 1358, 1694, 112, 315, 271, 315,
+	489, 315, 784, 315, 785, 315, 787, 315, 799, 315, 801, 315, 802, 315, 805,
+	315, 809, 315, 838, 315, 839, 315, 840, 315, 841, 315, 842, 315, 843, 315,
+	844, 315, 847, 315, 849, 315, 850, 315, 876, 315, 925, 315, 941, 315, 942,
+	315, 948, 315, 994, 315, 1013, 315, 1056, 315, 1057, 315, 1058, 315, 1070,
+	315, 1071, 315, 1075, 315, 1076, 315, 1104, 315, 1105, 315, 1106, 315, 1107,
+	315, 1116, 315, 1130, 315, 1452, 315, 1457, 315, 2122, 315, 2311, 315, 469,
+	926, 469, 927, 469, 928, 1364, 1695, 112, 316, 271, 316, 489, 316, 784, 316,
+	785, 316, 787, 316, 799, 316, 801, 316, 802, 316, 805, 316, 809, 316, 838,
+	316, 839, 316, 840, 316, 841, 316, 842, 316, 843, 316, 844, 316, 847, 316,
+	849, 316, 850, 316, 876, 316, 925, 316, 941, 316, 942, 316, 948, 316, 994,
+	316, 1013, 316, 1056, 316, 1057, 316, 1058, 316, 1070, 316, 1071, 316, 1075,
+	316, 1076, 316, 1104, 316, 1105, 316, 1106, 316, 1107, 316, 1116, 316, 1130,
+	316, 1452, 316, 1457, 316, 2122, 316, 2311, 316, 470, 943, 470, 944, 470,
+	945, 1368, 1696, 112, 317, 271, 317, 489, 317, 784, 317, 785, 317, 787, 317,
+	799, 317, 801, 317, 802, 317, 805, 317, 809, 317, 838, 317, 839, 317, 840,
+	317, 841, 317, 842, 317, 843, 317, 844, 317, 847, 317, 849, 317, 850, 317,
+	876, 317, 925, 317, 941, 317, 942, 317, 948, 317, 994, 317, 1013, 317, 1056,
+	317, 1057, 317, 1058, 317, 1070, 317, 1071, 317, 1075, 317, 1076, 317, 1104,
+	317, 1105, 317, 1106, 317, 1107, 317, 1116, 317, 1130, 317, 1452, 317, 1457,
+	317, 2122, 317, 2311, 317, 471, 949, 471, 950, 471, 951, 1371, 1697, 112,
+	318, 271, 318, 489, 318, 784, 318, 785, 318, 787, 318, 799, 318, 801, 318,
+	802, 318, 805, 318, 809, 318, 838, 318, 839, 318, 840, 318, 841, 318, 842,
+	318, 843, 318, 844, 318, 847, 318, 849, 318, 850, 318, 876, 318, 925, 318,
+	941, 318, 942, 318, 948, 318, 994, 318, 1013, 318, 1056, 318, 1057, 318,
+	1058, 318, 1070, 318, 1071, 318, 1075, 318, 1076, 318, 1104, 318, 1105, 318,
+	1106, 318, 1107, 318, 1116, 318, 1130, 318, 1452, 318, 1457, 318, 2122, 318,
+	2311, 318, 472, 959, 472, 960, 472, 961, 1373, 1698, 112, 319, 271, 319,
+	489, 319, 784, 319, 785, 319, 787, 319, 799, 319, 801, 319, 802, 319, 805,
+	319, 809, 319, 838, 319, 839, 319, 840, 319, 841, 319, 842, 319, 843, 319,
+	844, 319, 847, 319, 849, 319, 850, 319, 876, 319, 925, 319, 941, 319, 942,
+	319, 948, 319, 994, 319, 1013, 319, 1056, 319, 1057, 319, 1058, 319, 1070,
+	319, 1071, 319, 1075, 319, 1076, 319, 1104, 319, 1105, 319, 1106, 319, 1107,
+	319, 1116, 319, 1130, 319, 1452, 319, 1457, 319, 2122, 319, 2311, 319, 473,
+	967, 473, 968, 473, 969, 1376, 1699, 112, 320, 271, 320, 489, 320, 784, 320,
+	785, 320, 787, 320, 799, 320, 801, 320, 802, 320, 805, 320, 809, 320, 838,
+	320, 839, 320, 840, 320, 841, 320, 842, 320, 843, 320, 844, 320, 847, 320,
+	849, 320, 850, 320, 876, 320, 925, 320, 941, 320, 942, 320, 948, 320, 994,
+	320, 1013, 320, 1056, 320, 1057, 320, 1058, 320, 1070, 320, 1071, 320, 1075,
+	320, 1076, 320, 1104, 320, 1105, 320, 1106, 320, 1107, 320, 1116, 320, 1130,
+	320, 1452, 320, 1457, 320, 2122, 320, 2311, 320, 474, 975, 474, 976, 474,
+	977, 1379, 1700, 112, 321, 271, 321, 489, 321, 784, 321, 785, 321, 787, 321,
+	799, 321, 801, 321, 802, 321, 805, 321, 809, 321, 838, 321, 839, 321, 840,
+	321, 841, 321, 842, 321, 843, 321, 844, 321, 847, 321, 849, 321, 850, 321,
+	876, 321, 925, 321, 941, 321, 942, 321, 948, 321, 994, 321, 1013, 321, 1056,
+	321, 1057, 321, 1058, 321, 1070, 321, 1071, 321, 1075, 321, 1076, 321, 1104,
+	321, 1105, 321, 1106, 321, 1107, 321, 1116, 321, 1130, 321, 1452, 321, 1457,
+	321, 2122, 321, 2311, 321, 475, 982, 475, 983, 475, 984, 1382, 1701, 112,
+	322, 271, 322, 489, 322, 784, 322, 785, 322, 787, 322, 799, 322, 801, 322,
+	802, 322, 805, 322, 809, 322, 838, 322, 839, 322, 840, 322, 841, 322, 842,
+	322, 843, 322, 844, 322, 847, 322, 849, 322, 850, 322, 876, 322, 925, 322,
+	941, 322, 942, 322, 948, 322, 994, 322, 1013, 322, 1056, 322, 1057, 322,
+	1058, 322, 1070, 322, 1071, 322, 1075, 322, 1076, 322, 1104, 322, 1105, 322,
+	1106, 322, 1107, 322, 1116, 322, 1130, 322, 1452, 322, 1457, 322, 2122, 322,
+	2311, 322, 476, 996, 476, 997, 476, 998, 1386, 1702, 112, 323, 271, 323,
+	489, 323, 784, 323, 785, 323, 787, 323, 799, 323, 801, 323, 802, 323, 805,
+	323, 809, 323, 838, 323, 839, 323, 840, 323, 841, 323, 842, 323, 843, 323,
+	844, 323, 847, 323, 849, 323, 850, 323, 876, 323, 925, 323, 941, 323, 942,
+	323, 948, 323, 994, 323, 1013, 323, 1056, 323, 1057, 323, 1058, 323, 1070,
+	323, 1071, 323, 1075, 323, 1076, 323, 1104, 323, 1105, 323, 1106, 323, 1107,
+	323, 1116, 323, 1130, 323, 1452, 323, 1457, 323, 2122, 323, 2311, 323, 477,
+	1006, 477, 1007, 477, 1008, 1392, 1703, 112, 324, 271, 324, 489, 324, 784,
+	324, 785, 324, 787, 324, 799, 324, 801, 324, 802, 324, 805, 324, 809, 324,
+	838, 324, 839, 324, 840, 324, 841, 324, 842, 324, 843, 324, 844, 324, 847,
+	324, 849, 324, 850, 324, 876, 324, 925, 324, 941, 324, 942, 324, 948, 324,
+	994, 324, 1013, 324, 1056, 324, 1057, 324, 1058, 324, 1070, 324, 1071, 324,
+	1075, 324, 1076, 324, 1104, 324, 1105, 324, 1106, 324, 1107, 324, 1116, 324,
+	1130, 324, 1452, 324, 1457, 324, 2122, 324, 2311, 324, 478, 1014, 478, 1015,
+	478, 1016, 1395, 1704, 112, 325, 271, 325, 489, 325, 784, 325, 785, 325,
+	787, 325, 799, 325, 801, 325, 802, 325, 805, 325, 809, 325, 838, 325, 839,
+	325, 840, 325, 841, 325, 842, 325, 843, 325, 844, 325, 847, 325, 849, 325,
+	850, 325, 876, 325, 925, 325, 941, 325, 942, 325, 948, 325, 994, 325, 1013,
+	325, 1056, 325, 1057, 325, 1058, 325, 1070, 325, 1071, 325, 1075, 325, 1076,
+	325, 1104, 325, 1105, 325, 1106, 325, 1107, 325, 1116, 325, 1130, 325, 1452,
+	325, 1457, 325, 2122, 325, 2311, 325, 479, 1025, 479, 1026, 479, 1027, 1401,
+	1705, 112, 326, 271, 326, 489, 326, 784, 326, 785, 326, 787, 326, 799, 326,
+	801, 326, 802, 326, 805, 326, 809, 326, 838, 326, 839, 326, 840, 326, 841,
+	326, 842, 326, 843, 326, 844, 326, 847, 326, 849, 326, 850, 326, 876, 326,
+	925, 326, 941, 326, 942, 326, 948, 326, 994, 326, 1013, 326, 1056, 326,
+	1057, 326, 1058, 326, 1070, 326, 1071, 326, 1075, 326, 1076, 326, 1104, 326,
+	1105, 326, 1106, 326, 1107, 326, 1116, 326, 1130, 326, 1452, 326, 1457, 326,
+	2122, 326, 2311, 326, 480, 1037, 480, 1038, 480, 1039, 1404, 1706, 112, 327,
+	271, 327, 489, 327, 784, 327, 785, 327, 787, 327, 799, 327, 801, 327, 802,
+	327, 805, 327, 809, 327, 838, 327, 839, 327, 840, 327, 841, 327, 842, 327,
+	843, 327, 844, 327, 847, 327, 849, 327, 850, 327, 876, 327, 925, 327, 941,
+	327, 942, 327, 948, 327, 994, 327, 1013, 327, 1056, 327, 1057, 327, 1058,
+	327, 1070, 327, 1071, 327, 1075, 327, 1076, 327, 1104, 327, 1105, 327, 1106,
+	327, 1107, 327, 1116, 327, 1130, 327, 1452, 327, 1457, 327, 2122, 327, 2311,
+	327, 481, 1046, 481, 1047, 481, 1048, 1409, 1707, 112, 328, 271, 328, 489,
+	328, 784, 328, 785, 328, 787, 328, 799, 328, 801, 328, 802, 328, 805, 328,
+	809, 328, 838, 328, 839, 328, 840, 328, 841, 328, 842, 328, 843, 328, 844,
+	328, 847, 328, 849, 328, 850, 328, 876, 328, 925, 328, 941, 328, 942, 328,
+	948, 328, 994, 328, 1013, 328, 1056, 328, 1057, 328, 1058, 328, 1070, 328,
+	1071, 328, 1075, 328, 1076, 328, 1104, 328, 1105, 328, 1106, 328, 1107, 328,
+	1116, 328, 1130, 328, 1452, 328, 1457, 328, 2122, 328, 2311, 328, 483, 1079,
+	483, 1080, 483, 1081, 1431, 1710, 112, 329, 271, 329, 489, 329, 784, 329,
+	785, 329, 787, 329, 799, 329, 801, 329, 802, 329, 805, 329, 809, 329, 838,
+	329, 839, 329, 840, 329, 841, 329, 842, 329, 843, 329, 844, 329, 847, 329,
+	849, 329, 850, 329, 876, 329, 925, 329, 941, 329, 942, 329, 948, 329, 994,
+	329, 1013, 329, 1056, 329, 1057, 329, 1058, 329, 1070, 329, 1071, 329, 1075,
+	329, 1076, 329, 1104, 329, 1105, 329, 1106, 329, 1107, 329, 1116, 329, 1130,
+	329, 1452, 329, 1457, 329, 2122, 329, 2311, 329, 484, 1108, 484, 1109, 484,
+	1110, 1437, 1711, 112, 330, 271, 330, 489, 330, 784, 330, 785, 330, 787,
+	330, 799, 330, 801, 330, 802, 330, 805, 330, 809, 330, 838, 330, 839, 330,
+	840, 330, 841, 330, 842, 330, 843, 330, 844, 330, 847, 330, 849, 330, 850,
+	330, 876, 330, 925, 330, 941, 330, 942, 330, 948, 330, 994, 330, 1013, 330,
+	1056, 330, 1057, 330, 1058, 330, 1070, 330, 1071, 330, 1075, 330, 1076, 330,
+	1104, 330, 1105, 330, 1106, 330, 1107, 330, 1116, 330, 1130, 330, 1452, 330,
+	1457, 330, 2122, 330, 2311, 330, 485, 1117, 485, 1118, 485, 1119, 1443,
+	1712, 112, 331, 271, 331, 489, 331, 784, 331, 785, 331, 787, 331, 799, 331,
+	801, 331, 802, 331, 805, 331, 809, 331, 838, 331, 839, 331, 840, 331, 841,
+	331, 842, 331, 843, 331, 844, 331, 847, 331, 849, 331, 850, 331, 876, 331,
+	925, 331, 941, 331, 942, 331, 948, 331, 994, 331, 1013, 331, 1056, 331,
+	1057, 331, 1058, 331, 1070, 331, 1071, 331, 1075, 331, 1076, 331, 1104, 331,
+	1105, 331, 1106, 331, 1107, 331, 1116, 331, 1130, 331, 1452, 331, 1457, 331,
+	2122, 331, 2311, 331, 486, 1124, 486, 1125, 486, 1126, 1446, 1713, 112, 332,
+	271, 332, 489, 332, 784, 332, 785, 332, 787, 332, 799, 332, 801, 332, 802,
+	332, 805, 332, 809, 332, 838, 332, 839, 332, 840, 332, 841, 332, 842, 332,
+	843, 332, 844, 332, 847, 332, 849, 332, 850, 332, 876, 332, 925, 332, 941,
+	332, 942, 332, 948, 332, 994, 332, 1013, 332, 1056, 332, 1057, 332, 1058,
+	332, 1070, 332, 1071, 332, 1075, 332, 1076, 332, 1104, 332, 1105, 332, 1106,
+	332, 1107, 332, 1116, 332, 1130, 332, 1452, 332, 1457, 332, 2122, 332, 2311,
+	332, 487, 1131, 487, 1132, 487, 1133, 1449, 1714, 112, 333, 271, 333, 489,
+	333, 784, 333, 785, 333, 787, 333, 799, 333, 801, 333, 802, 333, 805, 333,
+	809, 333, 838, 333, 839, 333, 840, 333, 841, 333, 842, 333, 843, 333, 844,
+	333, 847, 333, 849, 333, 850, 333, 876, 333, 925, 333, 941, 333, 942, 333,
+	948, 333, 994, 333, 1013, 333, 1056, 333, 1057, 333, 1058, 333, 1070, 333,
+	1071, 333, 1075, 333, 1076, 333, 1104, 333, 1105, 333, 1106, 333, 1107, 333,
+	1116, 333, 1130, 333, 1452, 333, 1457, 333, 2122, 333, 2311, 333, 488, 1141,
+	488, 1142, 488, 1143, 1453, 1716, 461, 778, 464, 854, 465, 880, 469, 929,
+	475, 985, 482, 1062, 1290, 778, 1340, 854, 1344, 880, 1364, 929, 1382, 985,
+	1414, 1062, 464, 855, 1340, 855, 464, 856, 465, 881, 469, 930, 475, 986,
+	483, 1082, 1340, 856, 1344, 881, 1364, 930, 1382, 986, 1431, 1082, 475, 987,
+	1382, 987, 464, 857, 1340, 857, 481, 1049, 1409, 1049, 464, 858, 465, 882,
+	1340, 858, 1344, 882, 485, 1120, 1443, 1120, 468, 917, 1358, 917, 468, 918,
+	1358, 918, 473, 970, 476, 999, 1376, 970, 1386, 999, 479, 1028, 1401, 1028,
+	483, 1083, 1431, 1083, 484, 1111, 1437, 1111, 463, 818, 1323, 818, 462, 791,
+	469, 931, 483, 1084, 1296, 791, 1364, 931, 1431, 1084, 468, 919, 1358, 919,
+	464, 859, 1340, 859, 464, 860, 1340, 860, 486, 1127, 487, 1134, 1446, 1127,
+	1449, 1134, 474, 978, 1379, 978, 465, 883, 1344, 883, 463, 819, 1323, 819,
+	464, 861, 471, 952, 1340, 861, 1371, 952, 463, 820, 1323, 820, 461, 779,
+	482, 1063, 1290, 779, 1414, 1063, 471, 953, 1371, 953, 463, 821, 1323, 821,
+	480, 1040, 1404, 1040, 470, 946, 1368, 946, 465, 884, 1344, 884, 462, 792,
+	463, 822, 464, 862, 465, 885, 469, 932, 471, 954, 472, 962, 473, 971, 474,
+	979, 475, 988, 478, 1017, 479, 1029, 481, 1050, 483, 1085, 1296, 792, 1323,
+	822, 1340, 862, 1344, 885, 1364, 932, 1371, 954, 1373, 962, 1376, 971, 1379,
+	979, 1382, 988, 1395, 1017, 1401, 1029, 1409, 1050, 1431, 1085, 468, 920,
+	1358, 920, 461, 780, 464, 863, 465, 886, 475, 989, 483, 1086, 485, 1121,
+	1290, 780, 1340, 863, 1344, 886, 1382, 989, 1431, 1086, 1443, 1121, 463,
+	823, 1323, 823, 481, 1051, 1409, 1051, 463, 824, 1323, 824, 488, 1144, 1453,
+	1144, 464, 864, 1340, 864, 463, 825, 1323, 825, 479, 1030, 1401, 1030, 476,
+	1000, 1386, 1000, 479, 1031, 1401, 1031, 469, 933, 483, 1087, 1364, 933,
+	1431, 1087, 476, 1001, 1386, 1001, 469, 934, 483, 1088, 1364, 934, 1431,
+	1088, 463, 826, 483, 1089, 1323, 826, 1431, 1089, 466, 898, 1348, 898, 479,
+	1032, 1401, 1032, 463, 827, 1323, 827, 462, 793, 464, 865, 465, 887, 469,
+	935, 471, 955, 472, 963, 473, 972, 475, 990, 476, 1002, 477, 1009, 478,
+	1018, 479, 1033, 481, 1052, 483, 1090, 1296, 793, 1340, 865, 1344, 887,
+	1364, 935, 1371, 955, 1373, 963, 1376, 972, 1382, 990, 1386, 1002, 1392,
+	1009, 1395, 1018, 1401, 1033, 1409, 1052, 1431, 1090, 469, 936, 483, 1091,
+	1364, 936, 1431, 1091, 484, 1112, 1437, 1112, 463, 828, 1323, 828, 461, 781,
+	462, 794, 464, 866, 465, 888, 466, 899, 469, 937, 471, 956, 472, 964, 475,
+	991, 477, 1010, 479, 1034, 480, 1041, 481, 1053, 482, 1064, 483, 1092, 486,
+	1128, 487, 1135, 1290, 781, 1296, 794, 1340, 866, 1344, 888, 1348, 899,
+	1364, 937, 1371, 956, 1373, 964, 1382, 991, 1392, 1010, 1401, 1034, 1404,
+	1041, 1409, 1053, 1414, 1064, 1431, 1092, 1446, 1128, 1449, 1135, 463, 829,
+	1323, 829, 478, 1019, 1395, 1019, 464, 867, 465, 889, 1340, 867, 1344, 889,
+	488, 1145, 1453, 1145, 463, 830, 1323, 830, 463, 831, 1323, 831, 464, 868,
+	1340, 868, 483, 1093, 1431, 1093, 463, 832, 1323, 832, 464, 869, 1340, 869,
+	463, 833, 1323, 833, 462, 795, 464, 870, 465, 890, 469, 938, 471, 957, 472,
+	965, 473, 973, 474, 980, 475, 992, 476, 1003, 479, 1035, 480, 1042, 483,
+	1094, 1296, 795, 1340, 870, 1344, 890, 1364, 938, 1371, 957, 1373, 965,
+	1376, 973, 1379, 980, 1382, 992, 1386, 1003, 1401, 1035, 1404, 1042, 1431,
+	1094, 483, 1095, 1431, 1095, 463, 834, 1323, 834, 481, 1054, 1409, 1054,
+	461, 782, 464, 871, 465, 891, 482, 1065, 1290, 782, 1340, 871, 1344, 891,
+	1414, 1065, 468, 921, 1358, 921, 483, 1096, 1431, 1096, 463, 835, 1323, 835,
+	463, 836, 1323, 836, 484, 1113, 1437, 1113, 482, 1066, 1414, 1066, 482,
+	1067, 1414, 1067, 482, 1068, 1414, 1068, 463, 837, 1323, 837, 461, 783, 464,
+	872, 465, 892, 471, 958, 482, 1069, 487, 1136, 488, 1146, 1290, 783, 1340,
+	872, 1344, 892, 1371, 958, 1414, 1069, 1449, 1136, 1453, 1146, 464, 873,
+	469, 939, 483, 1097, 1340, 873, 1364, 939, 1431, 1097, 483, 1098, 1431,
+	1098, 483, 1099, 1431, 1099, 469, 940, 475, 993, 481, 1055, 483, 1100, 486,
+	1129, 487, 1137, 1364, 940, 1382, 993, 1409, 1055, 1431, 1100, 1446, 1129,
+	1449, 1137, 477, 1011, 478, 1020, 1392, 1011, 1395, 1020, 485, 1122, 1443,
+	1122, 483, 1101, 1431, 1101, 484, 1114, 1437, 1114, 487, 1138, 1449, 1138,
+	466, 900, 1348, 900, 477, 1012, 1392, 1012, 470, 947, 1368, 947, 483, 1102,
+	1431, 1102, 483, 1103, 1431, 1103, 464, 874, 1340, 874, 847, 1336, 1104,
+	1433, 1105, 1434, 1106, 1435, 1107, 1436, 910, 1354, 771, 1282, 771, 1283,
+	771, 1284, 1686, 1869, 1073, 1421, 1073, 1422, 1073, 1423, 1709, 1870, 467,
+	907, 770, 1278, 1350, 907, 770, 1279, 1115, 1441, 804, 1310, 848, 1337,
+	1004, 1390, 467, 908, 1350, 908, 774, 1289, 1078, 1430, 798, 1302, 806,
+	1314, 76, 125, 85, 136, 129, 136, 351, 136, 449, 136, 623, 136, 624, 136,
+	628, 136, 1236, 136, 1611, 136, 1616, 136, 1627, 136, 1633, 136, 1642, 136,
+	1643, 136, 1644, 136, 1645, 136, 1652, 136, 1656, 136, 1659, 136, 1662, 136,
+	1665, 136, 1667, 136, 1670, 136, 1683, 136, 1768, 136, 1802, 136, 1803, 136,
+	1807, 136, 1808, 136, 1811, 136, 1812, 136, 1813, 136, 1861, 136, 1862, 136,
+	1899, 136, 1940, 136, 1946, 136, 1951, 136, 1955, 136, 2024, 136, 2025, 136,
+	2029, 136, 2049, 136, 2050, 136, 2051, 136, 2052, 136, 2060, 136, 2063, 136,
+	2071, 136, 2075, 136, 2078, 136, 2084, 136, 2086, 136, 2089, 136, 2091, 136,
+	2103, 136, 2107, 136, 2113, 2204, 2120, 136, 2156, 136, 2189, 136, 2255,
+	136, 2263, 136, 2265, 136, 2267, 2304, 2269, 2305, 2296, 136, 2297, 136,
+	2298, 136, 2339, 2386, 629, 1199, 1166, 1612, 1603, 1612, 1604, 1612, 1899,
+	1612, 1946, 1612, 2113, 2205, 2189, 1612, 2236, 2287, 2247, 2292, 2269,
+	2306, 2330, 2371, 2332, 2378, 2341, 2387, 2343, 1199, 2350, 2394, 2352,
+	2395, 2356, 2371, 2357, 2371, 2420, 2430, 460, 758, 2301, 758, 2330, 758,
+	2333, 758, 2334, 758, 2336, 758, 2356, 758, 2357, 758, 2383, 758, 2384, 758,
+	2385, 758, 2391, 758, 2393, 758, 2404, 758, 2424, 758, 460, 759, 629, 1200,
+	2301, 759, 2330, 759, 2333, 759, 2334, 759, 2336, 759, 2343, 1200, 2356,
+	759, 2357, 759, 2383, 759, 2384, 759, 2385, 759, 2391, 759, 2393, 759, 2404,
+	759, 2424, 759, 460, 760, 2301, 760, 2330, 760, 2333, 760, 2334, 760, 2336,
+	760, 2356, 760, 2357, 760, 2383, 760, 2384, 760, 2385, 760, 2391, 760, 2393,
+	760, 2404, 760, 2424, 760, 460, 761, 2301, 761, 2330, 761, 2333, 761, 2334,
+	761, 2336, 761, 2356, 761, 2357, 761, 2383, 761, 2384, 761, 2385, 761, 2391,
+	761, 2393, 761, 2404, 761, 2424, 761, 2273, 2312, 2285, 2312, 2355, 2312,
+	2273, 2313, 2285, 2318, 2355, 2396, 2273, 2314, 2285, 2314, 2345, 2390,
+	2355, 2314, 351, 629, 2310, 2343, 1499, 1770, 1516, 1787, 1751, 1930, 2271,
+	2307, 2286, 2319, 2291, 2323, 2348, 2392, 2392, 2409, 460, 762, 629, 1201,
+	2301, 762, 2330, 762, 2333, 762, 2334, 762, 2336, 762, 2343, 1201, 2356,
+	762, 2357, 762, 2383, 762, 2384, 762, 2385, 762, 2391, 762, 2393, 762, 2404,
+	762, 2424, 762, 460, 763, 629, 1202, 2301, 763, 2330, 763, 2333, 763, 2334,
+	763, 2336, 763, 2343, 1202, 2356, 763, 2357, 763, 2383, 763, 2384, 763,
+	2385, 763, 2391, 763, 2393, 763, 2404, 763, 2424, 763, 629, 1203, 2343,
+	1203, 341, 618, 570, 618, 571, 618, 1469, 618, 1497, 618, 1982, 618, 341,
+	619, 570, 619, 571, 619, 1469, 619, 1497, 619, 1982, 619, 341, 620, 570,
+	620, 571, 620, 1469, 620, 1497, 620, 1982, 620, 2330, 2372, 2332, 2379,
+	2356, 2372, 2357, 2372, 629, 1204, 1166, 1613, 1603, 1613, 1604, 1613, 1899,
+	1613, 1946, 1613, 2189, 1613, 2343, 1204, 629, 1205, 2343, 1205, 122, 340,
+	336, 340, 337, 340, 690, 340, 691, 340, 135, 352, 630, 1212, 1985, 352,
+	2093, 352, 2094, 352, 143, 362, 168, 362, 176, 362, 186, 362, 1465, 362,
+	1500, 362, 1509, 362, 1521, 362, 2032, 2125, 2035, 2125, 2213, 2274, 1738,
+	1904, 1739, 1905, 1746, 1919, 1747, 1919, 1748, 1919, 1752, 1919, 1753,
+	1919, 1759, 1919, 1760, 1919, 1775, 1919, 1781, 1919, 1982, 1919, 153, 390,
+	1747, 1921, 460, 764, 2301, 2335, 2330, 2373, 2333, 2382, 2334, 2335, 2336,
+	2335, 2356, 2373, 2357, 2373, 2383, 2382, 2384, 2382, 2385, 2335, 2391, 764,
+	2393, 764, 2404, 2382, 2424, 764, 460, 765, 2301, 765, 2330, 765, 2333, 765,
+	2334, 765, 2336, 765, 2356, 765, 2357, 765, 2383, 765, 2384, 765, 2385, 765,
+	2391, 765, 2393, 765, 2404, 765, 2424, 765, 163, 401, 1491, 401, 629, 1206,
+	2343, 1206, 164, 413, 1492, 1762, 1468, 1734, 1970, 2083, 2030, 2119, 2033,
+	2126, 2034, 2129, 2036, 2132, 2057, 2129, 2209, 2272, 2216, 2276, 2277,
+	2132, 2326, 2354, 2407, 2416, 2410, 2422, 2426, 2129, 2431, 2440, 2438,
+	2445, 2441, 2447, 2450, 2129, 2451, 2454, 19, 62, 47, 62, 104, 263, 19, 63,
+	47, 63, 104, 264, 2408, 2419, 2411, 2419, 2428, 2437, 2433, 2419, 358, 635,
+	418, 635, 425, 635, 429, 635, 1721, 635, 1773, 635, 1783, 635, 1789, 635,
+	135, 353, 1985, 353, 2093, 353, 2094, 353, 135, 354, 1985, 2095, 2093, 2190,
+	2094, 2191, 135, 355, 630, 1213, 1985, 355, 2093, 355, 2094, 355, 629, 1207,
+	2343, 1207, 629, 1208, 2343, 1208, 629, 1209, 2343, 1209, 2330, 2374, 2331,
+	2376, 2332, 2380, 2356, 2374, 2357, 2374, 460, 766, 629, 1210, 2301, 766,
+	2330, 766, 2333, 766, 2334, 766, 2336, 766, 2343, 1210, 2356, 766, 2357,
+	766, 2383, 766, 2384, 766, 2385, 766, 2391, 766, 2393, 766, 2404, 766, 2424,
+	766, 19, 64, 47, 64, 62, 64, 63, 64, 264, 64, 265, 64, 629, 1211, 2343,
+	1211, 1166, 1614, 1603, 1614, 1604, 1614, 1899, 1614, 1946, 1614, 2189,
+	1614, 1166, 1615, 1603, 1615, 1604, 1615, 1899, 1615, 1946, 1615, 2189,
+	1615, 2330, 2375, 2332, 2381, 2356, 2375, 2357, 2375, 1481, 1750, 2208,
+	1750, 2235, 1750, 2246, 1750, 2316, 1750, 1265, 1675, 1266, 1675, 1857,
+	2013, 193, 439, 194, 439, 195, 439, 359, 636, 360, 637, 367, 642, 371, 644,
+	372, 645, 373, 646, 391, 648, 392, 649, 393, 650, 394, 651, 395, 652, 396,
+	653, 397, 654, 398, 655, 399, 656, 414, 659, 415, 660, 416, 661, 420, 664,
+	421, 665, 423, 667, 424, 668, 426, 670, 427, 671, 428, 672, 430, 674, 432,
+	676, 433, 677, 434, 678, 435, 679, 442, 439, 634, 1214, 638, 1217, 647,
+	1226, 658, 1237, 662, 1241, 663, 1242, 666, 1245, 669, 1248, 673, 1252, 675,
+	1254, 681, 1259, 1216, 1628, 1223, 1635, 1225, 1638, 1227, 1640, 1228, 1641,
+	1234, 1646, 1235, 1647, 1238, 1650, 1239, 1651, 1243, 1655, 1246, 1658,
+	1249, 1661, 1251, 1663, 1256, 1668, 1257, 1669, 1626, 1814, 1629, 1817,
+	1639, 1824, 1648, 1833, 1649, 1834, 1653, 1838, 1654, 1839, 1657, 1842,
+	1660, 1845, 1664, 1849, 1666, 1851, 1835, 2000, 1843, 2005, 1846, 2007,
+	2196, 2256, 2197, 2262, 2201, 2262, 2250, 2295, 2362, 2400, 2363, 2401,
+	2364, 2402, 21, 102, 489, 1159, 784, 1159, 785, 1159, 787, 1159, 799, 1159,
+	801, 1159, 802, 1159, 805, 1159, 809, 1159, 838, 1159, 839, 1159, 840, 1159,
+	841, 1159, 842, 1159, 843, 1159, 844, 1159, 847, 1159, 849, 1159, 850, 1159,
+	876, 1159, 925, 1159, 941, 1159, 942, 1159, 948, 1159, 994, 1159, 1013,
+	1159, 1056, 1159, 1057, 1159, 1058, 1159, 1070, 1159, 1071, 1159, 1075,
+	1159, 1076, 1159, 1104, 1159, 1105, 1159, 1106, 1159, 1107, 1159, 1116,
+	1159, 1130, 1159, 1452, 1159, 1455, 1719, 1457, 1159, 1462, 1722, 1464,
+	1726, 1467, 1731, 1476, 1744, 1477, 1745, 1484, 1754, 1485, 1755, 1486,
+	1756, 1487, 1757, 1488, 1758, 1493, 1763, 1494, 1764, 1495, 1765, 1496,
+	1766, 1504, 1774, 1506, 1776, 1507, 1777, 1511, 1782, 1513, 1784, 1514,
+	1785, 1515, 1786, 1518, 1790, 1520, 1791, 1522, 1793, 1524, 1796, 1525,
+	1797, 1526, 1798, 1527, 1799, 1721, 1874, 1727, 1880, 1746, 1920, 1748,
+	1922, 1752, 1931, 1753, 1932, 1759, 1938, 1760, 1939, 1762, 1941, 1772,
+	1952, 1773, 1953, 1780, 1960, 1781, 1961, 1783, 1963, 1788, 1968, 1789,
+	1969, 1792, 1972, 1872, 2022, 1894, 2028, 1907, 2037, 1908, 2038, 1921,
+	2043, 1943, 2058, 1944, 2059, 1964, 2077, 1967, 2080, 2055, 2149, 2064,
+	2163, 2074, 2172, 2081, 2179, 2113, 2206, 2117, 2208, 2122, 1159, 2135,
+	2221, 2153, 2231, 2161, 2234, 2162, 2235, 2175, 2245, 2178, 2246, 2243,
+	2289, 2279, 2316, 2281, 2317, 2311, 1159, 2427, 1719, 1676, 1860, 1677,
+	1860, 2192, 1860, 2253, 1860, 2254, 1860, 2036, 2133, 2277, 2315, 2036,
+	2134, 2277, 2134, 65, 121, 116, 121, 117, 121, 446, 121, 447, 121, 1466,
+	1729, 1499, 1729, 1516, 1729, 1743, 1729, 1770, 1729, 1787, 1729, 460, 767,
+	2301, 767, 2330, 767, 2333, 767, 2334, 767, 2336, 767, 2356, 767, 2357, 767,
+	2383, 767, 2384, 767, 2385, 767, 2391, 767, 2393, 767, 2404, 767, 2424, 767,
+	460, 768, 2301, 768, 2330, 768, 2333, 768, 2334, 768, 2336, 768, 2356, 768,
+	2357, 768, 2383, 768, 2384, 768, 2385, 768, 2391, 768, 2393, 768, 2404, 768,
+	2424, 768, 19, 65, 47, 65, 62, 116, 63, 117, 264, 446, 265, 447, 65, 122,
+	116, 336, 117, 337, 446, 690, 447, 691, 122, 341, 336, 570, 337, 571, 690,
+	1265, 691, 1266, 1265, 1676, 1266, 1677, 1676, 1861, 1677, 1862, 2192, 2255,
+	2253, 2297, 2254, 2298, 85, 137, 129, 137, 351, 137, 449, 137, 623, 137,
+	624, 137, 628, 137, 1236, 137, 1611, 137, 1616, 137, 1627, 137, 1633, 137,
+	1642, 137, 1643, 137, 1644, 137, 1645, 137, 1652, 137, 1656, 137, 1659, 137,
+	1662, 137, 1665, 137, 1667, 137, 1670, 137, 1683, 137, 1768, 137, 1802, 137,
+	1803, 137, 1807, 137, 1808, 137, 1811, 137, 1812, 137, 1813, 137, 1861,
+	2017, 1862, 2019, 1899, 2031, 1940, 137, 1946, 2061, 1951, 137, 1955, 137,
+	2024, 137, 2025, 137, 2029, 137, 2049, 137, 2050, 137, 2051, 137, 2052, 137,
+	2060, 137, 2063, 137, 2071, 137, 2075, 137, 2078, 137, 2084, 137, 2086, 137,
+	2089, 137, 2091, 137, 2103, 137, 2107, 137, 2120, 137, 2156, 137, 2189,
+	2252, 2255, 2299, 2263, 137, 2265, 137, 2296, 137, 2297, 2328, 2298, 2329,
+	2017, 2106, 2019, 2108, 104, 265, 341, 621, 570, 1163, 571, 1164, 1469,
+	1735, 1497, 1767, 1982, 2092, 108, 271, 144, 366, 366, 641, 641, 1220, 1220,
+	1632, 143, 363, 168, 417, 176, 422, 186, 431, 1465, 1727, 1500, 1772, 1509,
+	1780, 1521, 1792, 163, 402, 1491, 1761, 2097, 2196, 334, 569, 567, 569,
+	1463, 1725, 1725, 1878, 1466, 1730, 1499, 1771, 1516, 1788, 1743, 1907,
+	1770, 1950, 1787, 1967, 1481, 1751, 2208, 2271, 2235, 2286, 2246, 2291,
+	2316, 2348, 1475, 1743, 1162, 1602, 1800, 1602, 1768, 1949, 135, 356, 1985,
+	356, 2093, 356, 2094, 356,
+}
+
+var tmRuleLen = []int8{
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 2, 2, 0, 2, 0, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 3, 4, 4, 3, 4, 4, 4, 1, 1, 1, 1, 1, 2, 1, 15, 14,
+	14, 13, 16, 15, 15, 14, 3, 0, 3, 2, 1, 1, 1, 1, 1, 1, 1, 13, 13, 3, 0, 1, 1,
+	1, 1, 1, 1, 1, 3, 2, 0, 4, 2, 0, 14, 14, 13, 2, 0, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 2, 2, 2, 2, 2, 1, 4, 2, 0, 6, 2, 0, 3, 1, 1, 0, 6, 1, 1, 4, 4, 1, 3, 1,
+	6, 8, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 4, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 3, 1, 2, 5, 7, 1, 1, 1, 5, 3, 2, 5, 4, 3, 1, 1, 1, 1, 1, 1, 8,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	3, 2, 5, 4, 3, 1, 3, 2, 1, 0, 3, 1, 1, 1, 6, 2, 2, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 4, 7, 2, 0, 6, 7, 6, 7, 6, 7, 7, 6, 6, 6, 6, 7, 7,
+	7, 6, 6, 6, 6, 8, 8, 5, 3, 0, 7, 8, 3, 0, 2, 1, 6, 6, 6, 6, 6, 6, 6, 6, 6,
+	6, 6, 6, 6, 7, 7, 8, 3, 2, 0, 1, 1, 1, 1, 3, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 4, 3, 2, 0, 6, 5, 6, 5,
+	6, 5, 6, 5, 6, 5, 6, 5, 6, 5, 6, 5, 6, 5, 5, 4, 5, 4, 6, 5, 6, 5, 6, 5, 6,
+	5, 5, 4, 5, 4, 5, 4, 5, 4, 7, 6, 7, 6, 4, 3, 3, 2, 6, 5, 11, 10, 9, 8, 9, 8,
+	7, 6, 9, 8, 7, 6, 7, 6, 5, 4, 1, 1, 8, 7, 6, 5, 11, 10, 9, 8, 8, 7, 6, 5,
+	11, 10, 9, 8, 4, 3, 14, 13, 12, 11, 1, 11, 10, 9, 8, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 7, 6, 3, 0, 5, 4, 5, 4, 5, 4, 5, 4, 5, 4, 5, 4, 5, 4, 5, 4,
+	5, 4, 5, 4, 5, 4, 5, 4, 5, 4, 6, 5, 7, 6, 3, 1, 5, 4, 5, 8, 7, 3, 2, 16, 15,
+	13, 12, 3, 1, 1, 1, 1, 5, 4, 2, 0, 5, 4, 1, 2, 1, 1, 7, 6, 3, 1, 1, 0, 7, 6,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 3, 1, 1, 1, 3, 2, 4, 3, 3, 2, 8, 7, 2, 0,
+	8, 7, 3, 7, 6, 3, 1, 1, 0, 18, 17, 15, 14, 19, 18, 16, 15, 3, 2, 9, 8, 1, 6,
+	5, 6, 5, 2, 1, 3, 1, 1, 0, 4, 1, 1, 1, 1, 1, 1, 1, 1, 2, 2, 1, 1, 1, 4, 3,
+	1, 1, 0, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 4, 3, 1, 1, 0, 1, 1, 1, 1, 1, 1, 4, 3, 1, 1, 0, 1, 1, 1,
+	1, 1, 1, 1, 1, 4, 3, 1, 1, 0, 1, 1, 1, 1, 1, 4, 3, 1, 1, 0, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 4, 3, 1, 1, 0, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 4, 3, 1, 1, 0, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 4, 3, 1, 1, 0, 1, 1, 1, 4, 3, 1, 1, 0, 1, 1, 1,
+	4, 3, 1, 1, 0, 1, 1, 1, 1, 1, 4, 3, 1, 1, 0, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 4, 3, 1, 1, 0, 1, 1, 4, 3, 1, 1, 0, 1, 1, 1, 1, 1, 1, 1, 4, 3, 1, 1,
+	0, 1, 1, 1, 1, 4, 3, 1, 1, 0, 1, 1, 1, 1, 4, 3, 1, 1, 0, 1, 1, 1, 4, 3, 1,
+	1, 0, 1, 1, 1, 1, 1, 1, 1, 1, 1, 4, 3, 1, 1, 0, 1, 1, 1, 1, 1, 4, 3, 1, 1,
+	0, 1, 1, 1, 1, 4, 3, 1, 1, 0, 1, 1, 1, 1, 4, 3, 1, 1, 0, 1, 1, 1, 1, 1, 1,
+	1, 1, 4, 3, 1, 1, 0, 1, 1, 1, 4, 3, 1, 1, 0, 1, 1, 1, 1, 1, 1, 1, 4, 3, 1,
+	1, 0, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 4,
+	3, 1, 1, 0, 1, 1, 1, 1, 4, 3, 1, 1, 0, 1, 1, 1, 4, 3, 1, 1, 0, 1, 1, 1, 4,
+	3, 1, 1, 0, 1, 1, 1, 1, 1, 4, 3, 1, 1, 0, 1, 1, 1, 2, 2, 2, 2, 2, 2, 2, 2,
+	2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+	2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+	4, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+	2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 1, 1, 1, 3, 1, 1, 1, 1, 3, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 4, 2, 4, 3, 4, 3,
+	4, 6, 3, 1, 1, 0, 3, 1, 3, 2, 2, 0, 1, 1, 1, 1, 1, 1, 1, 3, 1, 1, 4, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 2, 1, 4, 4, 4,
+	4, 1, 1, 1, 1, 2, 2, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 4, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 2, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 4, 1, 1, 3,
+	1, 1, 3, 1, 3, 2, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 4, 2, 4, 1, 1, 4, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	2, 4, 1, 4, 1, 1, 1, 2, 2, 1, 1, 1, 1, 2, 1, 1, 1, 1, 1, 4, 6, 1, 0, 1, 0,
+	1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1,
+	0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0,
+}
+
+var tmRuleSymbol = []int32{
+	503, 504, 505, 505, 505, 505, 505, 505, 505, 505, 505, 505, 505, 505, 505,
+	505, 505, 505, 505, 505, 505, 505, 505, 505, 505, 505, 505, 505, 505, 505,
+	505, 505, 505, 505, 505, 505, 505, 505, 505, 505, 505, 505, 505, 505, 505,
+	505, 505, 505, 505, 505, 505, 505, 505, 505, 505, 505, 505, 505, 505, 505,
+	505, 505, 505, 505, 505, 505, 505, 505, 505, 505, 505, 505, 505, 505, 505,
+	505, 505, 505, 506, 507, 508, 509, 510, 510, 511, 512, 513, 514, 515, 516,
+	517, 517, 518, 518, 519, 519, 519, 519, 519, 519, 519, 519, 519, 519, 519,
+	519, 520, 520, 520, 521, 522, 523, 524, 525, 525, 526, 527, 527, 527, 527,
+	527, 528, 528, 529, 529, 529, 529, 529, 529, 529, 529, 530, 530, 531, 531,
+	532, 532, 532, 532, 533, 534, 534, 535, 535, 536, 536, 537, 537, 538, 538,
+	538, 538, 538, 539, 540, 540, 541, 542, 542, 543, 543, 543, 544, 544, 545,
+	545, 545, 545, 545, 545, 545, 545, 545, 546, 547, 548, 549, 550, 550, 551,
+	552, 552, 553, 554, 554, 555, 555, 556, 556, 557, 558, 558, 559, 559, 560,
+	561, 561, 562, 563, 564, 564, 564, 565, 565, 566, 566, 566, 566, 566, 566,
+	566, 566, 566, 566, 567, 568, 569, 570, 571, 571, 571, 571, 571, 571, 571,
+	572, 573, 573, 573, 574, 574, 575, 576, 577, 578, 579, 579, 579, 579, 580,
+	580, 581, 582, 583, 583, 583, 584, 585, 586, 587, 588, 589, 589, 589, 589,
+	589, 589, 589, 589, 589, 589, 589, 589, 589, 589, 589, 589, 590, 591, 592,
+	593, 594, 595, 595, 595, 595, 596, 596, 597, 597, 598, 598, 599, 600, 601,
+	602, 603, 604, 605, 606, 606, 606, 606, 606, 606, 606, 606, 606, 606, 606,
+	606, 606, 606, 606, 606, 606, 606, 606, 606, 606, 606, 606, 606, 606, 606,
+	606, 606, 606, 606, 606, 606, 606, 606, 606, 606, 606, 606, 606, 606, 606,
+	607, 608, 609, 609, 610, 611, 612, 613, 614, 615, 616, 617, 618, 619, 620,
+	621, 622, 623, 624, 625, 626, 627, 628, 629, 630, 631, 631, 632, 633, 634,
+	634, 635, 636, 637, 638, 639, 640, 641, 642, 643, 644, 645, 646, 647, 648,
+	649, 650, 651, 652, 653, 654, 654, 655, 655, 655, 655, 656, 657, 657, 657,
+	657, 657, 657, 657, 657, 657, 657, 657, 657, 657, 657, 657, 657, 657, 657,
+	657, 657, 657, 657, 657, 657, 657, 657, 657, 657, 657, 657, 657, 657, 657,
+	657, 657, 657, 657, 657, 657, 657, 657, 657, 657, 657, 657, 657, 657, 657,
+	657, 657, 657, 657, 658, 658, 659, 659, 660, 660, 661, 661, 662, 662, 663,
+	663, 664, 664, 665, 665, 666, 666, 667, 667, 668, 668, 669, 669, 670, 670,
+	671, 671, 672, 672, 673, 673, 674, 674, 675, 675, 676, 676, 677, 677, 678,
+	678, 679, 679, 680, 680, 681, 681, 682, 682, 683, 683, 684, 684, 684, 684,
+	684, 684, 684, 684, 684, 684, 684, 684, 684, 684, 684, 684, 685, 686, 687,
+	687, 687, 687, 687, 687, 687, 687, 688, 688, 688, 688, 688, 688, 688, 688,
+	689, 689, 690, 690, 690, 690, 691, 692, 692, 692, 692, 693, 693, 693, 693,
+	693, 693, 693, 693, 693, 693, 693, 693, 693, 694, 694, 695, 695, 696, 696,
+	697, 697, 698, 698, 699, 699, 700, 700, 701, 701, 702, 702, 703, 703, 704,
+	704, 705, 705, 706, 706, 707, 707, 708, 708, 709, 709, 710, 710, 711, 711,
+	712, 712, 713, 714, 714, 715, 715, 716, 716, 716, 716, 717, 717, 718, 718,
+	718, 719, 719, 720, 720, 721, 721, 722, 723, 724, 724, 725, 725, 726, 726,
+	727, 727, 728, 728, 729, 729, 729, 729, 729, 729, 729, 729, 729, 729, 729,
+	730, 731, 731, 731, 732, 732, 732, 732, 733, 733, 734, 734, 735, 735, 736,
+	736, 737, 738, 738, 739, 739, 740, 740, 741, 741, 741, 741, 742, 742, 742,
+	742, 743, 743, 744, 744, 745, 746, 746, 747, 747, 748, 748, 749, 749, 750,
+	750, 751, 752, 752, 753, 753, 753, 753, 753, 753, 754, 755, 756, 756, 756,
+	757, 758, 758, 759, 759, 760, 760, 760, 760, 760, 760, 760, 760, 760, 760,
+	760, 760, 760, 760, 760, 760, 760, 760, 760, 760, 760, 760, 760, 760, 760,
+	760, 760, 760, 761, 762, 762, 763, 763, 764, 764, 764, 764, 764, 764, 765,
+	766, 766, 767, 767, 768, 768, 768, 768, 768, 768, 768, 768, 769, 770, 770,
+	771, 771, 772, 772, 772, 772, 772, 773, 774, 774, 775, 775, 776, 776, 776,
+	776, 776, 776, 776, 776, 776, 776, 776, 776, 776, 776, 776, 776, 776, 776,
+	776, 776, 777, 778, 778, 779, 779, 780, 780, 780, 780, 780, 780, 780, 780,
+	780, 780, 780, 780, 780, 780, 780, 780, 780, 780, 780, 780, 780, 781, 782,
+	782, 783, 783, 784, 784, 784, 784, 784, 784, 784, 784, 784, 784, 784, 784,
+	784, 785, 786, 786, 787, 787, 788, 788, 788, 789, 790, 790, 791, 791, 792,
+	792, 792, 793, 794, 794, 795, 795, 796, 796, 796, 796, 796, 797, 798, 798,
+	799, 799, 800, 800, 800, 800, 800, 800, 800, 800, 800, 800, 800, 800, 801,
+	802, 802, 803, 803, 804, 804, 805, 806, 806, 807, 807, 808, 808, 808, 808,
+	808, 808, 808, 809, 810, 810, 811, 811, 812, 812, 812, 812, 813, 814, 814,
+	815, 815, 816, 816, 816, 816, 817, 818, 818, 819, 819, 820, 820, 820, 821,
+	822, 822, 823, 823, 824, 824, 824, 824, 824, 824, 824, 824, 824, 825, 826,
+	826, 827, 827, 828, 828, 828, 828, 828, 829, 830, 830, 831, 831, 832, 832,
+	832, 832, 833, 834, 834, 835, 835, 836, 836, 836, 836, 837, 838, 838, 839,
+	839, 840, 840, 840, 840, 840, 840, 840, 840, 841, 842, 842, 843, 843, 844,
+	844, 844, 845, 846, 846, 847, 847, 848, 848, 848, 848, 848, 848, 848, 849,
+	850, 850, 851, 851, 852, 852, 852, 852, 852, 852, 852, 852, 852, 852, 852,
+	852, 852, 852, 852, 852, 852, 852, 852, 852, 852, 852, 853, 854, 854, 855,
+	855, 856, 856, 856, 856, 857, 858, 858, 859, 859, 860, 860, 860, 861, 862,
+	862, 863, 863, 864, 864, 864, 865, 866, 866, 867, 867, 868, 868, 868, 868,
+	868, 869, 870, 870, 871, 871, 872, 872, 872, 873, 874, 875, 876, 877, 878,
+	879, 880, 881, 882, 883, 884, 885, 886, 887, 888, 889, 890, 891, 892, 893,
+	894, 895, 896, 897, 898, 899, 900, 901, 902, 903, 904, 905, 906, 907, 908,
+	909, 910, 911, 912, 913, 914, 915, 916, 917, 918, 919, 920, 921, 922, 923,
+	924, 925, 926, 927, 928, 929, 930, 931, 932, 933, 934, 935, 936, 937, 938,
+	939, 940, 941, 942, 943, 944, 945, 946, 947, 948, 949, 950, 951, 952, 953,
+	954, 955, 956, 957, 958, 959, 960, 961, 962, 963, 964, 965, 966, 967, 968,
+	969, 969, 970, 971, 971, 972, 973, 973, 974, 974, 975, 976, 976, 977, 978,
+	978, 979, 979, 980, 980, 981, 981, 982, 983, 983, 984, 984, 985, 985, 986,
+	986, 987, 988, 988, 989, 990, 991, 992, 992, 993, 993, 994, 994, 994, 994,
+	995, 995, 996, 996, 997, 998, 998, 998, 998, 998, 998, 999, 1000, 1001,
+	1001, 1002, 1002, 1003, 1003, 1003, 1003, 1003, 1003, 1003, 1003, 1003,
+	1003, 1003, 1003, 1003, 1003, 1003, 1003, 1003, 1003, 1003, 1003, 1003,
+	1003, 1003, 1003, 1003, 1003, 1003, 1003, 1003, 1003, 1003, 1003, 1003,
+	1003, 1003, 1003, 1003, 1003, 1003, 1003, 1003, 1003, 1003, 1003, 1003,
+	1004, 1005, 1005, 1006, 1006, 1007, 1008, 1008, 1009, 1010, 1011, 1011,
+	1012, 1012, 1013, 1013, 1013, 1013, 1013, 1013, 1013, 1013, 1014, 1014,
+	1014, 1014, 1014, 1014, 1014, 1014, 1014, 1014, 1014, 1014, 1014, 1014,
+	1014, 1014, 1015, 1015, 1015, 1015, 1015, 1015, 1015, 1015, 1015, 1015,
+	1015, 1016, 1016, 1016, 1016, 1016, 1016, 1016, 1016, 1016, 1016, 1016,
+	1016, 1016, 1016, 1016, 1016, 1016, 1016, 1016, 1016, 1016, 1016, 1016,
+	1016, 1016, 1016, 1016, 1016, 1016, 1016, 1016, 1016, 1016, 1016, 1016,
+	1016, 1016, 1016, 1016, 1016, 1016, 1016, 1016, 1016, 1016, 1016, 1016,
+	1016, 1016, 1016, 1016, 1016, 1016, 1017, 1018, 1019, 1019, 1019, 1019,
+	1019, 1019, 1019, 1019, 1019, 1019, 1020, 1021, 1021, 1021, 1021, 1021,
+	1021, 1021, 1021, 1021, 1022, 1022, 1023, 1024, 1024, 1025, 1025, 1026,
+	1026, 1026, 1027, 1027, 1028, 1028, 1028, 1028, 1028, 1028, 1028, 1028,
+	1028, 1028, 1028, 1028, 1029, 1029, 1029, 1029, 1029, 1029, 1029, 1029,
+	1029, 1029, 1029, 1029, 1029, 1029, 1029, 1029, 1029, 1030, 1031, 1032,
+	1033, 1033, 1034, 1035, 1035, 1036, 1036, 1036, 1036, 1036, 1036, 1036,
+	1037, 1038, 1039, 1039, 1040, 1040, 1040, 1041, 1042, 1043, 1043, 1044,
+	1044, 1045, 1046, 1046, 1046, 1047, 1048, 1049, 1049, 1050, 1050, 1051,
+	1051, 1052, 1052, 1053, 1053, 1054, 1054, 1055, 1055, 1056, 1056, 1057,
+	1057, 1058, 1058, 1059, 1059, 1060, 1060, 1061, 1061, 1062, 1062, 1063,
+	1063, 1064, 1064, 1065, 1065, 1066, 1066, 1067, 1067, 1068, 1068, 1069,
+	1069, 1070, 1070, 1071, 1071, 1072, 1072, 1073, 1073, 1074, 1074, 1075,
+	1075,
+}
+
+var tmRuleType = [...]NodeType{
+	GlobalIdent,                   // GlobalIdent : global_ident_tok
+	LocalIdent,                    // LocalIdent : local_ident_tok
+	LabelIdent,                    // LabelIdent : label_ident_tok
+	LabelIdent,                    // LabelIdent : 'align:'
+	LabelIdent,                    // LabelIdent : 'arg:'
+	LabelIdent,                    // LabelIdent : 'attributes:'
+	LabelIdent,                    // LabelIdent : 'baseType:'
+	LabelIdent,                    // LabelIdent : 'cc:'
+	LabelIdent,                    // LabelIdent : 'checksum:'
+	LabelIdent,                    // LabelIdent : 'checksumkind:'
+	LabelIdent,                    // LabelIdent : 'column:'
+	LabelIdent,                    // LabelIdent : 'configMacros:'
+	LabelIdent,                    // LabelIdent : 'containingType:'
+	LabelIdent,                    // LabelIdent : 'count:'
+	LabelIdent,                    // LabelIdent : 'debugInfoForProfiling:'
+	LabelIdent,                    // LabelIdent : 'declaration:'
+	LabelIdent,                    // LabelIdent : 'directory:'
+	LabelIdent,                    // LabelIdent : 'discriminator:'
+	LabelIdent,                    // LabelIdent : 'dwarfAddressSpace:'
+	LabelIdent,                    // LabelIdent : 'dwoId:'
+	LabelIdent,                    // LabelIdent : 'elements:'
+	LabelIdent,                    // LabelIdent : 'emissionKind:'
+	LabelIdent,                    // LabelIdent : 'encoding:'
+	LabelIdent,                    // LabelIdent : 'entity:'
+	LabelIdent,                    // LabelIdent : 'enums:'
+	LabelIdent,                    // LabelIdent : 'exportSymbols:'
+	LabelIdent,                    // LabelIdent : 'expr:'
+	LabelIdent,                    // LabelIdent : 'extraData:'
+	LabelIdent,                    // LabelIdent : 'file:'
+	LabelIdent,                    // LabelIdent : 'filename:'
+	LabelIdent,                    // LabelIdent : 'flags:'
+	LabelIdent,                    // LabelIdent : 'getter:'
+	LabelIdent,                    // LabelIdent : 'globals:'
+	LabelIdent,                    // LabelIdent : 'header:'
+	LabelIdent,                    // LabelIdent : 'identifier:'
+	LabelIdent,                    // LabelIdent : 'imports:'
+	LabelIdent,                    // LabelIdent : 'includePath:'
+	LabelIdent,                    // LabelIdent : 'inlinedAt:'
+	LabelIdent,                    // LabelIdent : 'isDefinition:'
+	LabelIdent,                    // LabelIdent : 'isImplicitCode:'
+	LabelIdent,                    // LabelIdent : 'isLocal:'
+	LabelIdent,                    // LabelIdent : 'isOptimized:'
+	LabelIdent,                    // LabelIdent : 'isUnsigned:'
+	LabelIdent,                    // LabelIdent : 'apinotes:'
+	LabelIdent,                    // LabelIdent : 'language:'
+	LabelIdent,                    // LabelIdent : 'line:'
+	LabelIdent,                    // LabelIdent : 'linkageName:'
+	LabelIdent,                    // LabelIdent : 'lowerBound:'
+	LabelIdent,                    // LabelIdent : 'macros:'
+	LabelIdent,                    // LabelIdent : 'name:'
+	LabelIdent,                    // LabelIdent : 'nameTableKind:'
+	LabelIdent,                    // LabelIdent : 'nodes:'
+	LabelIdent,                    // LabelIdent : 'offset:'
+	LabelIdent,                    // LabelIdent : 'operands:'
+	LabelIdent,                    // LabelIdent : 'producer:'
+	LabelIdent,                    // LabelIdent : 'retainedNodes:'
+	LabelIdent,                    // LabelIdent : 'retainedTypes:'
+	LabelIdent,                    // LabelIdent : 'runtimeLang:'
+	LabelIdent,                    // LabelIdent : 'runtimeVersion:'
+	LabelIdent,                    // LabelIdent : 'scope:'
+	LabelIdent,                    // LabelIdent : 'scopeLine:'
+	LabelIdent,                    // LabelIdent : 'setter:'
+	LabelIdent,                    // LabelIdent : 'size:'
+	LabelIdent,                    // LabelIdent : 'source:'
+	LabelIdent,                    // LabelIdent : 'splitDebugFilename:'
+	LabelIdent,                    // LabelIdent : 'splitDebugInlining:'
+	LabelIdent,                    // LabelIdent : 'tag:'
+	LabelIdent,                    // LabelIdent : 'templateParams:'
+	LabelIdent,                    // LabelIdent : 'thisAdjustment:'
+	LabelIdent,                    // LabelIdent : 'thrownTypes:'
+	LabelIdent,                    // LabelIdent : 'type:'
+	LabelIdent,                    // LabelIdent : 'types:'
+	LabelIdent,                    // LabelIdent : 'unit:'
+	LabelIdent,                    // LabelIdent : 'value:'
+	LabelIdent,                    // LabelIdent : 'var:'
+	LabelIdent,                    // LabelIdent : 'virtualIndex:'
+	LabelIdent,                    // LabelIdent : 'virtuality:'
+	LabelIdent,                    // LabelIdent : 'vtableHolder:'
+	AttrGroupID,                   // AttrGroupID : attr_group_id_tok
+	ComdatName,                    // ComdatName : comdat_name_tok
+	MetadataName,                  // MetadataName : metadata_name_tok
+	MetadataID,                    // MetadataID : metadata_id_tok
+	BoolLit,                       // BoolLit : 'true'
+	BoolLit,                       // BoolLit : 'false'
+	IntLit,                        // IntLit : int_lit_tok
+	UintLit,                       // UintLit : int_lit_tok
+	FloatLit,                      // FloatLit : float_lit_tok
+	StringLit,                     // StringLit : string_lit_tok
+	NullLit,                       // NullLit : 'null'
+	Module,                        // Module : TargetDef_optlist TopLevelEntity_optlist
+	0,                             // TargetDef_optlist : TargetDef_optlist TargetDef
+	0,                             // TargetDef_optlist :
+	0,                             // TopLevelEntity_optlist : TopLevelEntity_optlist TopLevelEntity
+	0,                             // TopLevelEntity_optlist :
+	0,                             // TopLevelEntity : ModuleAsm
+	0,                             // TopLevelEntity : TypeDef
+	0,                             // TopLevelEntity : ComdatDef
+	0,                             // TopLevelEntity : GlobalDecl
+	0,                             // TopLevelEntity : IndirectSymbolDef
+	0,                             // TopLevelEntity : FuncDecl
+	0,                             // TopLevelEntity : FuncDef
+	0,                             // TopLevelEntity : AttrGroupDef
+	0,                             // TopLevelEntity : NamedMetadataDef
+	0,                             // TopLevelEntity : MetadataDef
+	0,                             // TopLevelEntity : UseListOrder
+	0,                             // TopLevelEntity : UseListOrderBB
+	0,                             // TargetDef : SourceFilename
+	0,                             // TargetDef : TargetDataLayout
+	0,                             // TargetDef : TargetTriple
+	SourceFilename,                // SourceFilename : 'source_filename' '=' StringLit
+	TargetDataLayout,              // TargetDataLayout : 'target' 'datalayout' '=' StringLit
+	TargetTriple,                  // TargetTriple : 'target' 'triple' '=' StringLit
+	ModuleAsm,                     // ModuleAsm : 'module' 'asm' StringLit
+	TypeDef,                       // TypeDef : LocalIdent '=' 'type' OpaqueType
+	TypeDef,                       // TypeDef : LocalIdent '=' 'type' Type
+	ComdatDef,                     // ComdatDef : ComdatName '=' 'comdat' SelectionKind
+	SelectionKind,                 // SelectionKind : 'any'
+	SelectionKind,                 // SelectionKind : 'exactmatch'
+	SelectionKind,                 // SelectionKind : 'largest'
+	SelectionKind,                 // SelectionKind : 'nodeduplicate'
+	SelectionKind,                 // SelectionKind : 'samesize'
+	0,                             // FuncAttribute_list : FuncAttribute_list FuncAttribute
+	0,                             // FuncAttribute_list : FuncAttribute
+	GlobalDecl,                    // GlobalDecl : GlobalIdent '=' ExternLinkage Preemptionopt Visibilityopt DLLStorageClassopt ThreadLocalopt UnnamedAddropt AddrSpaceopt ExternallyInitializedopt Immutable Type list_of_','_and_1_elements list_of_','_and_1_elements1 FuncAttribute_list
+	GlobalDecl,                    // GlobalDecl : GlobalIdent '=' ExternLinkage Preemptionopt Visibilityopt DLLStorageClassopt ThreadLocalopt UnnamedAddropt AddrSpaceopt ExternallyInitializedopt Immutable Type list_of_','_and_1_elements list_of_','_and_1_elements1
+	GlobalDecl,                    // GlobalDecl : GlobalIdent '=' ExternLinkage Preemptionopt Visibilityopt DLLStorageClassopt ThreadLocalopt UnnamedAddropt AddrSpaceopt ExternallyInitializedopt Immutable Type list_of_','_and_1_elements FuncAttribute_list
+	GlobalDecl,                    // GlobalDecl : GlobalIdent '=' ExternLinkage Preemptionopt Visibilityopt DLLStorageClassopt ThreadLocalopt UnnamedAddropt AddrSpaceopt ExternallyInitializedopt Immutable Type list_of_','_and_1_elements
+	GlobalDecl,                    // GlobalDecl : GlobalIdent '=' Linkageopt Preemptionopt Visibilityopt DLLStorageClassopt ThreadLocalopt UnnamedAddropt AddrSpaceopt ExternallyInitializedopt Immutable Type Constant list_of_','_and_1_elements list_of_','_and_1_elements1 FuncAttribute_list
+	GlobalDecl,                    // GlobalDecl : GlobalIdent '=' Linkageopt Preemptionopt Visibilityopt DLLStorageClassopt ThreadLocalopt UnnamedAddropt AddrSpaceopt ExternallyInitializedopt Immutable Type Constant list_of_','_and_1_elements list_of_','_and_1_elements1
+	GlobalDecl,                    // GlobalDecl : GlobalIdent '=' Linkageopt Preemptionopt Visibilityopt DLLStorageClassopt ThreadLocalopt UnnamedAddropt AddrSpaceopt ExternallyInitializedopt Immutable Type Constant list_of_','_and_1_elements FuncAttribute_list
+	GlobalDecl,                    // GlobalDecl : GlobalIdent '=' Linkageopt Preemptionopt Visibilityopt DLLStorageClassopt ThreadLocalopt UnnamedAddropt AddrSpaceopt ExternallyInitializedopt Immutable Type Constant list_of_','_and_1_elements
+	0,                             // list_of_','_and_1_elements : list_of_','_and_1_elements ',' GlobalField
+	0,                             // list_of_','_and_1_elements :
+	0,                             // list_of_','_and_1_elements1 : list_of_','_and_1_elements1 ',' MetadataAttachment
+	0,                             // list_of_','_and_1_elements1 : ',' MetadataAttachment
+	0,                             // GlobalField : Section
+	0,                             // GlobalField : Partition
+	0,                             // GlobalField : Comdat
+	0,                             // GlobalField : Align
+	ExternallyInitialized,         // ExternallyInitialized : 'externally_initialized'
+	Immutable,                     // Immutable : 'constant'
+	Immutable,                     // Immutable : 'global'
+	IndirectSymbolDef,             // IndirectSymbolDef : GlobalIdent '=' ExternLinkage Preemptionopt Visibilityopt DLLStorageClassopt ThreadLocalopt UnnamedAddropt IndirectSymbolKind Type ',' IndirectSymbol list_of_','_and_1_elements2
+	IndirectSymbolDef,             // IndirectSymbolDef : GlobalIdent '=' Linkageopt Preemptionopt Visibilityopt DLLStorageClassopt ThreadLocalopt UnnamedAddropt IndirectSymbolKind Type ',' IndirectSymbol list_of_','_and_1_elements2
+	0,                             // list_of_','_and_1_elements2 : list_of_','_and_1_elements2 ',' Partition
+	0,                             // list_of_','_and_1_elements2 :
+	IndirectSymbolKind,            // IndirectSymbolKind : 'alias'
+	IndirectSymbolKind,            // IndirectSymbolKind : 'ifunc'
+	0,                             // IndirectSymbol : TypeConst
+	0,                             // IndirectSymbol : BitCastExpr
+	0,                             // IndirectSymbol : GetElementPtrExpr
+	0,                             // IndirectSymbol : AddrSpaceCastExpr
+	0,                             // IndirectSymbol : IntToPtrExpr
+	FuncDecl,                      // FuncDecl : 'declare' MetadataAttachment_optlist FuncHeader
+	0,                             // MetadataAttachment_optlist : MetadataAttachment_optlist MetadataAttachment
+	0,                             // MetadataAttachment_optlist :
+	FuncDef,                       // FuncDef : 'define' FuncHeader MetadataAttachment_optlist FuncBody
+	0,                             // FuncHdrField_optlist : FuncHdrField_optlist FuncHdrField
+	0,                             // FuncHdrField_optlist :
+	FuncHeader,                    // FuncHeader : Linkage Preemptionopt Visibilityopt DLLStorageClassopt CallingConvopt ReturnAttribute_optlist Type GlobalIdent '(' Params ')' UnnamedAddropt AddrSpaceopt FuncHdrField_optlist
+	FuncHeader,                    // FuncHeader : ExternLinkage Preemptionopt Visibilityopt DLLStorageClassopt CallingConvopt ReturnAttribute_optlist Type GlobalIdent '(' Params ')' UnnamedAddropt AddrSpaceopt FuncHdrField_optlist
+	FuncHeader,                    // FuncHeader : Preemptionopt Visibilityopt DLLStorageClassopt CallingConvopt ReturnAttribute_optlist Type GlobalIdent '(' Params ')' UnnamedAddropt AddrSpaceopt FuncHdrField_optlist
+	0,                             // ReturnAttribute_optlist : ReturnAttribute_optlist ReturnAttribute
+	0,                             // ReturnAttribute_optlist :
+	0,                             // FuncHdrField : FuncAttribute
+	0,                             // FuncHdrField : Section
+	0,                             // FuncHdrField : Partition
+	0,                             // FuncHdrField : Comdat
+	0,                             // FuncHdrField : Align
+	0,                             // FuncHdrField : GC
+	0,                             // FuncHdrField : Prefix
+	0,                             // FuncHdrField : Prologue
+	0,                             // FuncHdrField : Personality
+	GCNode,                        // GC : 'gc' StringLit
+	Prefix,                        // Prefix : 'prefix' TypeConst
+	Prologue,                      // Prologue : 'prologue' TypeConst
+	Personality,                   // Personality : 'personality' TypeConst
+	0,                             // BasicBlock_list : BasicBlock_list BasicBlock
+	0,                             // BasicBlock_list : BasicBlock
+	FuncBody,                      // FuncBody : '{' BasicBlock_list UseListOrder_optlist '}'
+	0,                             // UseListOrder_optlist : UseListOrder_optlist UseListOrder
+	0,                             // UseListOrder_optlist :
+	AttrGroupDef,                  // AttrGroupDef : 'attributes' AttrGroupID '=' '{' FuncAttribute_optlist '}'
+	0,                             // FuncAttribute_optlist : FuncAttribute_optlist FuncAttribute
+	0,                             // FuncAttribute_optlist :
+	0,                             // MetadataNode_list_withsep : MetadataNode_list_withsep ',' MetadataNode
+	0,                             // MetadataNode_list_withsep : MetadataNode
+	0,                             // MetadataNode_list_withsep_opt : MetadataNode_list_withsep
+	0,                             // MetadataNode_list_withsep_opt :
+	NamedMetadataDef,              // NamedMetadataDef : MetadataName '=' '!' '{' MetadataNode_list_withsep_opt '}'
+	0,                             // MetadataNode : MetadataID
+	0,                             // MetadataNode : DIExpression
+	MetadataDef,                   // MetadataDef : MetadataID '=' Distinctopt MDTuple
+	MetadataDef,                   // MetadataDef : MetadataID '=' Distinctopt SpecializedMDNode
+	Distinct,                      // Distinct : 'distinct'
+	0,                             // UintLit_list_withsep : UintLit_list_withsep ',' UintLit
+	0,                             // UintLit_list_withsep : UintLit
+	UseListOrder,                  // UseListOrder : 'uselistorder' TypeValue ',' '{' UintLit_list_withsep '}'
+	UseListOrderBB,                // UseListOrderBB : 'uselistorder_bb' GlobalIdent ',' LocalIdent ',' '{' UintLit_list_withsep '}'
+	0,                             // Type : VoidType
+	0,                             // Type : FuncType
+	0,                             // Type : FirstClassType
+	0,                             // FirstClassType : ConcreteType
+	0,                             // FirstClassType : MetadataType
+	0,                             // ConcreteType : IntType
+	0,                             // ConcreteType : FloatType
+	0,                             // ConcreteType : PointerType
+	0,                             // ConcreteType : VectorType
+	0,                             // ConcreteType : LabelType
+	0,                             // ConcreteType : ArrayType
+	0,                             // ConcreteType : StructType
+	0,                             // ConcreteType : NamedType
+	0,                             // ConcreteType : MMXType
+	0,                             // ConcreteType : TokenType
+	VoidType,                      // VoidType : 'void'
+	FuncType,                      // FuncType : Type '(' Params ')'
+	IntType,                       // IntType : int_type_tok
+	FloatType,                     // FloatType : FloatKind
+	FloatKind,                     // FloatKind : 'half'
+	FloatKind,                     // FloatKind : 'bfloat'
+	FloatKind,                     // FloatKind : 'float'
+	FloatKind,                     // FloatKind : 'double'
+	FloatKind,                     // FloatKind : 'x86_fp80'
+	FloatKind,                     // FloatKind : 'fp128'
+	FloatKind,                     // FloatKind : 'ppc_fp128'
+	MMXType,                       // MMXType : 'x86_mmx'
+	PointerType,                   // PointerType : Type AddrSpaceopt '*'
+	PointerType,                   // PointerType : 'ptr'
+	PointerType,                   // PointerType : 'ptr' AddrSpace
+	VectorType,                    // VectorType : '<' UintLit 'x' Type '>'
+	ScalableVectorType,            // VectorType : '<' 'vscale' 'x' UintLit 'x' Type '>'
+	LabelType,                     // LabelType : 'label'
+	TokenType,                     // TokenType : 'token'
+	MetadataType,                  // MetadataType : 'metadata'
+	ArrayType,                     // ArrayType : '[' UintLit 'x' Type ']'
+	StructType,                    // StructType : '{' Type_list_withsep '}'
+	StructType,                    // StructType : '{' '}'
+	PackedStructType,              // StructType : '<' '{' Type_list_withsep '}' '>'
+	PackedStructType,              // StructType : '<' '{' '}' '>'
+	0,                             // Type_list_withsep : Type_list_withsep ',' Type
+	0,                             // Type_list_withsep : Type
+	OpaqueType,                    // OpaqueType : 'opaque'
+	NamedType,                     // NamedType : LocalIdent
+	0,                             // Value : Constant
+	0,                             // Value : LocalIdent
+	0,                             // Value : InlineAsm
+	InlineAsm,                     // InlineAsm : 'asm' SideEffectopt AlignStackTokopt IntelDialectopt Unwindopt StringLit ',' StringLit
+	SideEffect,                    // SideEffect : 'sideeffect'
+	AlignStackTok,                 // AlignStackTok : 'alignstack'
+	IntelDialect,                  // IntelDialect : 'inteldialect'
+	Unwind,                        // Unwind : 'unwind'
+	0,                             // Constant : BoolConst
+	0,                             // Constant : IntConst
+	0,                             // Constant : FloatConst
+	0,                             // Constant : NullConst
+	0,                             // Constant : NoneConst
+	0,                             // Constant : StructConst
+	0,                             // Constant : ArrayConst
+	0,                             // Constant : VectorConst
+	0,                             // Constant : ZeroInitializerConst
+	0,                             // Constant : GlobalIdent
+	0,                             // Constant : UndefConst
+	0,                             // Constant : PoisonConst
+	0,                             // Constant : BlockAddressConst
+	0,                             // Constant : DSOLocalEquivalentConst
+	0,                             // Constant : NoCFIConst
+	0,                             // Constant : ConstantExpr
+	BoolConst,                     // BoolConst : BoolLit
+	IntConst,                      // IntConst : IntLit
+	FloatConst,                    // FloatConst : FloatLit
+	NullConst,                     // NullConst : NullLit
+	NoneConst,                     // NoneConst : 'none'
+	StructConst,                   // StructConst : '{' TypeConst_list_withsep '}'
+	StructConst,                   // StructConst : '{' '}'
+	StructConst,                   // StructConst : '<' '{' TypeConst_list_withsep '}' '>'
+	StructConst,                   // StructConst : '<' '{' '}' '>'
+	0,                             // TypeConst_list_withsep : TypeConst_list_withsep ',' TypeConst
+	0,                             // TypeConst_list_withsep : TypeConst
+	ArrayConst,                    // ArrayConst : '[' TypeConst_list_withsep_opt ']'
+	CharArrayConst,                // ArrayConst : 'c' StringLit
+	0,                             // TypeConst_list_withsep_opt : TypeConst_list_withsep
+	0,                             // TypeConst_list_withsep_opt :
+	VectorConst,                   // VectorConst : '<' TypeConst_list_withsep_opt '>'
+	ZeroInitializerConst,          // ZeroInitializerConst : 'zeroinitializer'
+	UndefConst,                    // UndefConst : 'undef'
+	PoisonConst,                   // PoisonConst : 'poison'
+	BlockAddressConst,             // BlockAddressConst : 'blockaddress' '(' GlobalIdent ',' LocalIdent ')'
+	DSOLocalEquivalentConst,       // DSOLocalEquivalentConst : 'dso_local_equivalent' GlobalIdent
+	NoCFIConst,                    // NoCFIConst : 'no_cfi' GlobalIdent
+	0,                             // ConstantExpr : FNegExpr
+	0,                             // ConstantExpr : AddExpr
+	0,                             // ConstantExpr : FAddExpr
+	0,                             // ConstantExpr : SubExpr
+	0,                             // ConstantExpr : FSubExpr
+	0,                             // ConstantExpr : MulExpr
+	0,                             // ConstantExpr : FMulExpr
+	0,                             // ConstantExpr : UDivExpr
+	0,                             // ConstantExpr : SDivExpr
+	0,                             // ConstantExpr : FDivExpr
+	0,                             // ConstantExpr : URemExpr
+	0,                             // ConstantExpr : SRemExpr
+	0,                             // ConstantExpr : FRemExpr
+	0,                             // ConstantExpr : ShlExpr
+	0,                             // ConstantExpr : LShrExpr
+	0,                             // ConstantExpr : AShrExpr
+	0,                             // ConstantExpr : AndExpr
+	0,                             // ConstantExpr : OrExpr
+	0,                             // ConstantExpr : XorExpr
+	0,                             // ConstantExpr : ExtractElementExpr
+	0,                             // ConstantExpr : InsertElementExpr
+	0,                             // ConstantExpr : ShuffleVectorExpr
+	0,                             // ConstantExpr : ExtractValueExpr
+	0,                             // ConstantExpr : InsertValueExpr
+	0,                             // ConstantExpr : GetElementPtrExpr
+	0,                             // ConstantExpr : TruncExpr
+	0,                             // ConstantExpr : ZExtExpr
+	0,                             // ConstantExpr : SExtExpr
+	0,                             // ConstantExpr : FPTruncExpr
+	0,                             // ConstantExpr : FPExtExpr
+	0,                             // ConstantExpr : FPToUIExpr
+	0,                             // ConstantExpr : FPToSIExpr
+	0,                             // ConstantExpr : UIToFPExpr
+	0,                             // ConstantExpr : SIToFPExpr
+	0,                             // ConstantExpr : PtrToIntExpr
+	0,                             // ConstantExpr : IntToPtrExpr
+	0,                             // ConstantExpr : BitCastExpr
+	0,                             // ConstantExpr : AddrSpaceCastExpr
+	0,                             // ConstantExpr : ICmpExpr
+	0,                             // ConstantExpr : FCmpExpr
+	0,                             // ConstantExpr : SelectExpr
+	FNegExpr,                      // FNegExpr : 'fneg' '(' TypeConst ')'
+	AddExpr,                       // AddExpr : 'add' OverflowFlag_optlist '(' TypeConst ',' TypeConst ')'
+	0,                             // OverflowFlag_optlist : OverflowFlag_optlist OverflowFlag
+	0,                             // OverflowFlag_optlist :
+	FAddExpr,                      // FAddExpr : 'fadd' '(' TypeConst ',' TypeConst ')'
+	SubExpr,                       // SubExpr : 'sub' OverflowFlag_optlist '(' TypeConst ',' TypeConst ')'
+	FSubExpr,                      // FSubExpr : 'fsub' '(' TypeConst ',' TypeConst ')'
+	MulExpr,                       // MulExpr : 'mul' OverflowFlag_optlist '(' TypeConst ',' TypeConst ')'
+	FMulExpr,                      // FMulExpr : 'fmul' '(' TypeConst ',' TypeConst ')'
+	UDivExpr,                      // UDivExpr : 'udiv' Exactopt '(' TypeConst ',' TypeConst ')'
+	SDivExpr,                      // SDivExpr : 'sdiv' Exactopt '(' TypeConst ',' TypeConst ')'
+	FDivExpr,                      // FDivExpr : 'fdiv' '(' TypeConst ',' TypeConst ')'
+	URemExpr,                      // URemExpr : 'urem' '(' TypeConst ',' TypeConst ')'
+	SRemExpr,                      // SRemExpr : 'srem' '(' TypeConst ',' TypeConst ')'
+	FRemExpr,                      // FRemExpr : 'frem' '(' TypeConst ',' TypeConst ')'
+	ShlExpr,                       // ShlExpr : 'shl' OverflowFlag_optlist '(' TypeConst ',' TypeConst ')'
+	LShrExpr,                      // LShrExpr : 'lshr' Exactopt '(' TypeConst ',' TypeConst ')'
+	AShrExpr,                      // AShrExpr : 'ashr' Exactopt '(' TypeConst ',' TypeConst ')'
+	AndExpr,                       // AndExpr : 'and' '(' TypeConst ',' TypeConst ')'
+	OrExpr,                        // OrExpr : 'or' '(' TypeConst ',' TypeConst ')'
+	XorExpr,                       // XorExpr : 'xor' '(' TypeConst ',' TypeConst ')'
+	ExtractElementExpr,            // ExtractElementExpr : 'extractelement' '(' TypeConst ',' TypeConst ')'
+	InsertElementExpr,             // InsertElementExpr : 'insertelement' '(' TypeConst ',' TypeConst ',' TypeConst ')'
+	ShuffleVectorExpr,             // ShuffleVectorExpr : 'shufflevector' '(' TypeConst ',' TypeConst ',' TypeConst ')'
+	ExtractValueExpr,              // ExtractValueExpr : 'extractvalue' '(' TypeConst list_of_','_and_1_elements3 ')'
+	0,                             // list_of_','_and_1_elements3 : list_of_','_and_1_elements3 ',' UintLit
+	0,                             // list_of_','_and_1_elements3 :
+	InsertValueExpr,               // InsertValueExpr : 'insertvalue' '(' TypeConst ',' TypeConst list_of_','_and_1_elements3 ')'
+	GetElementPtrExpr,             // GetElementPtrExpr : 'getelementptr' InBoundsopt '(' Type ',' TypeConst list_of_','_and_1_elements4 ')'
+	0,                             // list_of_','_and_1_elements4 : list_of_','_and_1_elements4 ',' GEPIndex
+	0,                             // list_of_','_and_1_elements4 :
+	GEPIndex,                      // GEPIndex : InRangeopt TypeConst
+	InRange,                       // InRange : 'inrange'
+	TruncExpr,                     // TruncExpr : 'trunc' '(' TypeConst 'to' Type ')'
+	ZExtExpr,                      // ZExtExpr : 'zext' '(' TypeConst 'to' Type ')'
+	SExtExpr,                      // SExtExpr : 'sext' '(' TypeConst 'to' Type ')'
+	FPTruncExpr,                   // FPTruncExpr : 'fptrunc' '(' TypeConst 'to' Type ')'
+	FPExtExpr,                     // FPExtExpr : 'fpext' '(' TypeConst 'to' Type ')'
+	FPToUIExpr,                    // FPToUIExpr : 'fptoui' '(' TypeConst 'to' Type ')'
+	FPToSIExpr,                    // FPToSIExpr : 'fptosi' '(' TypeConst 'to' Type ')'
+	UIToFPExpr,                    // UIToFPExpr : 'uitofp' '(' TypeConst 'to' Type ')'
+	SIToFPExpr,                    // SIToFPExpr : 'sitofp' '(' TypeConst 'to' Type ')'
+	PtrToIntExpr,                  // PtrToIntExpr : 'ptrtoint' '(' TypeConst 'to' Type ')'
+	IntToPtrExpr,                  // IntToPtrExpr : 'inttoptr' '(' TypeConst 'to' Type ')'
+	BitCastExpr,                   // BitCastExpr : 'bitcast' '(' TypeConst 'to' Type ')'
+	AddrSpaceCastExpr,             // AddrSpaceCastExpr : 'addrspacecast' '(' TypeConst 'to' Type ')'
+	ICmpExpr,                      // ICmpExpr : 'icmp' IPred '(' TypeConst ',' TypeConst ')'
+	FCmpExpr,                      // FCmpExpr : 'fcmp' FPred '(' TypeConst ',' TypeConst ')'
+	SelectExpr,                    // SelectExpr : 'select' '(' TypeConst ',' TypeConst ',' TypeConst ')'
+	BasicBlock,                    // BasicBlock : LabelIdentopt Instruction_optlist Terminator
+	0,                             // Instruction_optlist : Instruction_optlist Instruction
+	0,                             // Instruction_optlist :
+	0,                             // Instruction : LocalDefInst
+	0,                             // Instruction : ValueInstruction
+	0,                             // Instruction : StoreInst
+	0,                             // Instruction : FenceInst
+	LocalDefInst,                  // LocalDefInst : LocalIdent '=' ValueInstruction
+	0,                             // ValueInstruction : FNegInst
+	0,                             // ValueInstruction : AddInst
+	0,                             // ValueInstruction : FAddInst
+	0,                             // ValueInstruction : SubInst
+	0,                             // ValueInstruction : FSubInst
+	0,                             // ValueInstruction : MulInst
+	0,                             // ValueInstruction : FMulInst
+	0,                             // ValueInstruction : UDivInst
+	0,                             // ValueInstruction : SDivInst
+	0,                             // ValueInstruction : FDivInst
+	0,                             // ValueInstruction : URemInst
+	0,                             // ValueInstruction : SRemInst
+	0,                             // ValueInstruction : FRemInst
+	0,                             // ValueInstruction : ShlInst
+	0,                             // ValueInstruction : LShrInst
+	0,                             // ValueInstruction : AShrInst
+	0,                             // ValueInstruction : AndInst
+	0,                             // ValueInstruction : OrInst
+	0,                             // ValueInstruction : XorInst
+	0,                             // ValueInstruction : ExtractElementInst
+	0,                             // ValueInstruction : InsertElementInst
+	0,                             // ValueInstruction : ShuffleVectorInst
+	0,                             // ValueInstruction : ExtractValueInst
+	0,                             // ValueInstruction : InsertValueInst
+	0,                             // ValueInstruction : AllocaInst
+	0,                             // ValueInstruction : LoadInst
+	0,                             // ValueInstruction : CmpXchgInst
+	0,                             // ValueInstruction : AtomicRMWInst
+	0,                             // ValueInstruction : GetElementPtrInst
+	0,                             // ValueInstruction : TruncInst
+	0,                             // ValueInstruction : ZExtInst
+	0,                             // ValueInstruction : SExtInst
+	0,                             // ValueInstruction : FPTruncInst
+	0,                             // ValueInstruction : FPExtInst
+	0,                             // ValueInstruction : FPToUIInst
+	0,                             // ValueInstruction : FPToSIInst
+	0,                             // ValueInstruction : UIToFPInst
+	0,                             // ValueInstruction : SIToFPInst
+	0,                             // ValueInstruction : PtrToIntInst
+	0,                             // ValueInstruction : IntToPtrInst
+	0,                             // ValueInstruction : BitCastInst
+	0,                             // ValueInstruction : AddrSpaceCastInst
+	0,                             // ValueInstruction : ICmpInst
+	0,                             // ValueInstruction : FCmpInst
+	0,                             // ValueInstruction : PhiInst
+	0,                             // ValueInstruction : SelectInst
+	0,                             // ValueInstruction : FreezeInst
+	0,                             // ValueInstruction : CallInst
+	0,                             // ValueInstruction : VAArgInst
+	0,                             // ValueInstruction : LandingPadInst
+	0,                             // ValueInstruction : CatchPadInst
+	0,                             // ValueInstruction : CleanupPadInst
+	FNegInst,                      // FNegInst : 'fneg' FastMathFlag_optlist TypeValue list_of_','_and_1_elements1
+	FNegInst,                      // FNegInst : 'fneg' FastMathFlag_optlist TypeValue
+	0,                             // FastMathFlag_optlist : FastMathFlag_optlist FastMathFlag
+	0,                             // FastMathFlag_optlist :
+	AddInst,                       // AddInst : 'add' OverflowFlag_optlist TypeValue ',' Value list_of_','_and_1_elements1
+	AddInst,                       // AddInst : 'add' OverflowFlag_optlist TypeValue ',' Value
+	FAddInst,                      // FAddInst : 'fadd' FastMathFlag_optlist TypeValue ',' Value list_of_','_and_1_elements1
+	FAddInst,                      // FAddInst : 'fadd' FastMathFlag_optlist TypeValue ',' Value
+	SubInst,                       // SubInst : 'sub' OverflowFlag_optlist TypeValue ',' Value list_of_','_and_1_elements1
+	SubInst,                       // SubInst : 'sub' OverflowFlag_optlist TypeValue ',' Value
+	FSubInst,                      // FSubInst : 'fsub' FastMathFlag_optlist TypeValue ',' Value list_of_','_and_1_elements1
+	FSubInst,                      // FSubInst : 'fsub' FastMathFlag_optlist TypeValue ',' Value
+	MulInst,                       // MulInst : 'mul' OverflowFlag_optlist TypeValue ',' Value list_of_','_and_1_elements1
+	MulInst,                       // MulInst : 'mul' OverflowFlag_optlist TypeValue ',' Value
+	FMulInst,                      // FMulInst : 'fmul' FastMathFlag_optlist TypeValue ',' Value list_of_','_and_1_elements1
+	FMulInst,                      // FMulInst : 'fmul' FastMathFlag_optlist TypeValue ',' Value
+	UDivInst,                      // UDivInst : 'udiv' Exactopt TypeValue ',' Value list_of_','_and_1_elements1
+	UDivInst,                      // UDivInst : 'udiv' Exactopt TypeValue ',' Value
+	SDivInst,                      // SDivInst : 'sdiv' Exactopt TypeValue ',' Value list_of_','_and_1_elements1
+	SDivInst,                      // SDivInst : 'sdiv' Exactopt TypeValue ',' Value
+	FDivInst,                      // FDivInst : 'fdiv' FastMathFlag_optlist TypeValue ',' Value list_of_','_and_1_elements1
+	FDivInst,                      // FDivInst : 'fdiv' FastMathFlag_optlist TypeValue ',' Value
+	URemInst,                      // URemInst : 'urem' TypeValue ',' Value list_of_','_and_1_elements1
+	URemInst,                      // URemInst : 'urem' TypeValue ',' Value
+	SRemInst,                      // SRemInst : 'srem' TypeValue ',' Value list_of_','_and_1_elements1
+	SRemInst,                      // SRemInst : 'srem' TypeValue ',' Value
+	FRemInst,                      // FRemInst : 'frem' FastMathFlag_optlist TypeValue ',' Value list_of_','_and_1_elements1
+	FRemInst,                      // FRemInst : 'frem' FastMathFlag_optlist TypeValue ',' Value
+	ShlInst,                       // ShlInst : 'shl' OverflowFlag_optlist TypeValue ',' Value list_of_','_and_1_elements1
+	ShlInst,                       // ShlInst : 'shl' OverflowFlag_optlist TypeValue ',' Value
+	LShrInst,                      // LShrInst : 'lshr' Exactopt TypeValue ',' Value list_of_','_and_1_elements1
+	LShrInst,                      // LShrInst : 'lshr' Exactopt TypeValue ',' Value
+	AShrInst,                      // AShrInst : 'ashr' Exactopt TypeValue ',' Value list_of_','_and_1_elements1
+	AShrInst,                      // AShrInst : 'ashr' Exactopt TypeValue ',' Value
+	AndInst,                       // AndInst : 'and' TypeValue ',' Value list_of_','_and_1_elements1
+	AndInst,                       // AndInst : 'and' TypeValue ',' Value
+	OrInst,                        // OrInst : 'or' TypeValue ',' Value list_of_','_and_1_elements1
+	OrInst,                        // OrInst : 'or' TypeValue ',' Value
+	XorInst,                       // XorInst : 'xor' TypeValue ',' Value list_of_','_and_1_elements1
+	XorInst,                       // XorInst : 'xor' TypeValue ',' Value
+	ExtractElementInst,            // ExtractElementInst : 'extractelement' TypeValue ',' TypeValue list_of_','_and_1_elements1
+	ExtractElementInst,            // ExtractElementInst : 'extractelement' TypeValue ',' TypeValue
+	InsertElementInst,             // InsertElementInst : 'insertelement' TypeValue ',' TypeValue ',' TypeValue list_of_','_and_1_elements1
+	InsertElementInst,             // InsertElementInst : 'insertelement' TypeValue ',' TypeValue ',' TypeValue
+	ShuffleVectorInst,             // ShuffleVectorInst : 'shufflevector' TypeValue ',' TypeValue ',' TypeValue list_of_','_and_1_elements1
+	ShuffleVectorInst,             // ShuffleVectorInst : 'shufflevector' TypeValue ',' TypeValue ',' TypeValue
+	ExtractValueInst,              // ExtractValueInst : 'extractvalue' TypeValue list_of_','_and_1_elements5 list_of_','_and_1_elements1
+	ExtractValueInst,              // ExtractValueInst : 'extractvalue' TypeValue list_of_','_and_1_elements5
+	0,                             // list_of_','_and_1_elements5 : list_of_','_and_1_elements5 ',' UintLit
+	0,                             // list_of_','_and_1_elements5 : ',' UintLit
+	InsertValueInst,               // InsertValueInst : 'insertvalue' TypeValue ',' TypeValue list_of_','_and_1_elements5 list_of_','_and_1_elements1
+	InsertValueInst,               // InsertValueInst : 'insertvalue' TypeValue ',' TypeValue list_of_','_and_1_elements5
+	AllocaInst,                    // AllocaInst : 'alloca' InAllocatokopt SwiftErroropt Type ',' TypeValue ',' Align ',' AddrSpace list_of_','_and_1_elements1
+	AllocaInst,                    // AllocaInst : 'alloca' InAllocatokopt SwiftErroropt Type ',' TypeValue ',' Align ',' AddrSpace
+	AllocaInst,                    // AllocaInst : 'alloca' InAllocatokopt SwiftErroropt Type ',' TypeValue ',' Align list_of_','_and_1_elements1
+	AllocaInst,                    // AllocaInst : 'alloca' InAllocatokopt SwiftErroropt Type ',' TypeValue ',' Align
+	AllocaInst,                    // AllocaInst : 'alloca' InAllocatokopt SwiftErroropt Type ',' TypeValue ',' AddrSpace list_of_','_and_1_elements1
+	AllocaInst,                    // AllocaInst : 'alloca' InAllocatokopt SwiftErroropt Type ',' TypeValue ',' AddrSpace
+	AllocaInst,                    // AllocaInst : 'alloca' InAllocatokopt SwiftErroropt Type ',' TypeValue list_of_','_and_1_elements1
+	AllocaInst,                    // AllocaInst : 'alloca' InAllocatokopt SwiftErroropt Type ',' TypeValue
+	AllocaInst,                    // AllocaInst : 'alloca' InAllocatokopt SwiftErroropt Type ',' Align ',' AddrSpace list_of_','_and_1_elements1
+	AllocaInst,                    // AllocaInst : 'alloca' InAllocatokopt SwiftErroropt Type ',' Align ',' AddrSpace
+	AllocaInst,                    // AllocaInst : 'alloca' InAllocatokopt SwiftErroropt Type ',' Align list_of_','_and_1_elements1
+	AllocaInst,                    // AllocaInst : 'alloca' InAllocatokopt SwiftErroropt Type ',' Align
+	AllocaInst,                    // AllocaInst : 'alloca' InAllocatokopt SwiftErroropt Type ',' AddrSpace list_of_','_and_1_elements1
+	AllocaInst,                    // AllocaInst : 'alloca' InAllocatokopt SwiftErroropt Type ',' AddrSpace
+	AllocaInst,                    // AllocaInst : 'alloca' InAllocatokopt SwiftErroropt Type list_of_','_and_1_elements1
+	AllocaInst,                    // AllocaInst : 'alloca' InAllocatokopt SwiftErroropt Type
+	InAllocatok,                   // InAllocatok : 'inalloca'
+	SwiftError,                    // SwiftError : 'swifterror'
+	LoadInst,                      // LoadInst : 'load' Volatileopt Type ',' TypeValue ',' Align list_of_','_and_1_elements1
+	LoadInst,                      // LoadInst : 'load' Volatileopt Type ',' TypeValue ',' Align
+	LoadInst,                      // LoadInst : 'load' Volatileopt Type ',' TypeValue list_of_','_and_1_elements1
+	LoadInst,                      // LoadInst : 'load' Volatileopt Type ',' TypeValue
+	LoadInst,                      // LoadInst : 'load' Atomic Volatileopt Type ',' TypeValue SyncScopeopt AtomicOrdering ',' Align list_of_','_and_1_elements1
+	LoadInst,                      // LoadInst : 'load' Atomic Volatileopt Type ',' TypeValue SyncScopeopt AtomicOrdering ',' Align
+	LoadInst,                      // LoadInst : 'load' Atomic Volatileopt Type ',' TypeValue SyncScopeopt AtomicOrdering list_of_','_and_1_elements1
+	LoadInst,                      // LoadInst : 'load' Atomic Volatileopt Type ',' TypeValue SyncScopeopt AtomicOrdering
+	StoreInst,                     // StoreInst : 'store' Volatileopt TypeValue ',' TypeValue ',' Align list_of_','_and_1_elements1
+	StoreInst,                     // StoreInst : 'store' Volatileopt TypeValue ',' TypeValue ',' Align
+	StoreInst,                     // StoreInst : 'store' Volatileopt TypeValue ',' TypeValue list_of_','_and_1_elements1
+	StoreInst,                     // StoreInst : 'store' Volatileopt TypeValue ',' TypeValue
+	StoreInst,                     // StoreInst : 'store' Atomic Volatileopt TypeValue ',' TypeValue SyncScopeopt AtomicOrdering ',' Align list_of_','_and_1_elements1
+	StoreInst,                     // StoreInst : 'store' Atomic Volatileopt TypeValue ',' TypeValue SyncScopeopt AtomicOrdering ',' Align
+	StoreInst,                     // StoreInst : 'store' Atomic Volatileopt TypeValue ',' TypeValue SyncScopeopt AtomicOrdering list_of_','_and_1_elements1
+	StoreInst,                     // StoreInst : 'store' Atomic Volatileopt TypeValue ',' TypeValue SyncScopeopt AtomicOrdering
+	FenceInst,                     // FenceInst : 'fence' SyncScopeopt AtomicOrdering list_of_','_and_1_elements1
+	FenceInst,                     // FenceInst : 'fence' SyncScopeopt AtomicOrdering
+	CmpXchgInst,                   // CmpXchgInst : 'cmpxchg' Weakopt Volatileopt TypeValue ',' TypeValue ',' TypeValue SyncScopeopt AtomicOrdering AtomicOrdering ',' Align list_of_','_and_1_elements1
+	CmpXchgInst,                   // CmpXchgInst : 'cmpxchg' Weakopt Volatileopt TypeValue ',' TypeValue ',' TypeValue SyncScopeopt AtomicOrdering AtomicOrdering ',' Align
+	CmpXchgInst,                   // CmpXchgInst : 'cmpxchg' Weakopt Volatileopt TypeValue ',' TypeValue ',' TypeValue SyncScopeopt AtomicOrdering AtomicOrdering list_of_','_and_1_elements1
+	CmpXchgInst,                   // CmpXchgInst : 'cmpxchg' Weakopt Volatileopt TypeValue ',' TypeValue ',' TypeValue SyncScopeopt AtomicOrdering AtomicOrdering
+	Weak,                          // Weak : 'weak'
+	AtomicRMWInst,                 // AtomicRMWInst : 'atomicrmw' Volatileopt AtomicOp TypeValue ',' TypeValue SyncScopeopt AtomicOrdering ',' Align list_of_','_and_1_elements1
+	AtomicRMWInst,                 // AtomicRMWInst : 'atomicrmw' Volatileopt AtomicOp TypeValue ',' TypeValue SyncScopeopt AtomicOrdering ',' Align
+	AtomicRMWInst,                 // AtomicRMWInst : 'atomicrmw' Volatileopt AtomicOp TypeValue ',' TypeValue SyncScopeopt AtomicOrdering list_of_','_and_1_elements1
+	AtomicRMWInst,                 // AtomicRMWInst : 'atomicrmw' Volatileopt AtomicOp TypeValue ',' TypeValue SyncScopeopt AtomicOrdering
+	AtomicOp,                      // AtomicOp : 'add'
+	AtomicOp,                      // AtomicOp : 'and'
+	AtomicOp,                      // AtomicOp : 'fadd'
+	AtomicOp,                      // AtomicOp : 'fsub'
+	AtomicOp,                      // AtomicOp : 'max'
+	AtomicOp,                      // AtomicOp : 'min'
+	AtomicOp,                      // AtomicOp : 'nand'
+	AtomicOp,                      // AtomicOp : 'or'
+	AtomicOp,                      // AtomicOp : 'sub'
+	AtomicOp,                      // AtomicOp : 'umax'
+	AtomicOp,                      // AtomicOp : 'umin'
+	AtomicOp,                      // AtomicOp : 'xchg'
+	AtomicOp,                      // AtomicOp : 'xor'
+	GetElementPtrInst,             // GetElementPtrInst : 'getelementptr' InBoundsopt Type ',' TypeValue list_of_','_and_1_elements6 list_of_','_and_1_elements1
+	GetElementPtrInst,             // GetElementPtrInst : 'getelementptr' InBoundsopt Type ',' TypeValue list_of_','_and_1_elements6
+	0,                             // list_of_','_and_1_elements6 : list_of_','_and_1_elements6 ',' TypeValue
+	0,                             // list_of_','_and_1_elements6 :
+	TruncInst,                     // TruncInst : 'trunc' TypeValue 'to' Type list_of_','_and_1_elements1
+	TruncInst,                     // TruncInst : 'trunc' TypeValue 'to' Type
+	ZExtInst,                      // ZExtInst : 'zext' TypeValue 'to' Type list_of_','_and_1_elements1
+	ZExtInst,                      // ZExtInst : 'zext' TypeValue 'to' Type
+	SExtInst,                      // SExtInst : 'sext' TypeValue 'to' Type list_of_','_and_1_elements1
+	SExtInst,                      // SExtInst : 'sext' TypeValue 'to' Type
+	FPTruncInst,                   // FPTruncInst : 'fptrunc' TypeValue 'to' Type list_of_','_and_1_elements1
+	FPTruncInst,                   // FPTruncInst : 'fptrunc' TypeValue 'to' Type
+	FPExtInst,                     // FPExtInst : 'fpext' TypeValue 'to' Type list_of_','_and_1_elements1
+	FPExtInst,                     // FPExtInst : 'fpext' TypeValue 'to' Type
+	FPToUIInst,                    // FPToUIInst : 'fptoui' TypeValue 'to' Type list_of_','_and_1_elements1
+	FPToUIInst,                    // FPToUIInst : 'fptoui' TypeValue 'to' Type
+	FPToSIInst,                    // FPToSIInst : 'fptosi' TypeValue 'to' Type list_of_','_and_1_elements1
+	FPToSIInst,                    // FPToSIInst : 'fptosi' TypeValue 'to' Type
+	UIToFPInst,                    // UIToFPInst : 'uitofp' TypeValue 'to' Type list_of_','_and_1_elements1
+	UIToFPInst,                    // UIToFPInst : 'uitofp' TypeValue 'to' Type
+	SIToFPInst,                    // SIToFPInst : 'sitofp' TypeValue 'to' Type list_of_','_and_1_elements1
+	SIToFPInst,                    // SIToFPInst : 'sitofp' TypeValue 'to' Type
+	PtrToIntInst,                  // PtrToIntInst : 'ptrtoint' TypeValue 'to' Type list_of_','_and_1_elements1
+	PtrToIntInst,                  // PtrToIntInst : 'ptrtoint' TypeValue 'to' Type
+	IntToPtrInst,                  // IntToPtrInst : 'inttoptr' TypeValue 'to' Type list_of_','_and_1_elements1
+	IntToPtrInst,                  // IntToPtrInst : 'inttoptr' TypeValue 'to' Type
+	BitCastInst,                   // BitCastInst : 'bitcast' TypeValue 'to' Type list_of_','_and_1_elements1
+	BitCastInst,                   // BitCastInst : 'bitcast' TypeValue 'to' Type
+	AddrSpaceCastInst,             // AddrSpaceCastInst : 'addrspacecast' TypeValue 'to' Type list_of_','_and_1_elements1
+	AddrSpaceCastInst,             // AddrSpaceCastInst : 'addrspacecast' TypeValue 'to' Type
+	ICmpInst,                      // ICmpInst : 'icmp' IPred TypeValue ',' Value list_of_','_and_1_elements1
+	ICmpInst,                      // ICmpInst : 'icmp' IPred TypeValue ',' Value
+	FCmpInst,                      // FCmpInst : 'fcmp' FastMathFlag_optlist FPred TypeValue ',' Value list_of_','_and_1_elements1
+	FCmpInst,                      // FCmpInst : 'fcmp' FastMathFlag_optlist FPred TypeValue ',' Value
+	0,                             // Inc_list_withsep : Inc_list_withsep ',' Inc
+	0,                             // Inc_list_withsep : Inc
+	PhiInst,                       // PhiInst : 'phi' FastMathFlag_optlist Type Inc_list_withsep list_of_','_and_1_elements1
+	PhiInst,                       // PhiInst : 'phi' FastMathFlag_optlist Type Inc_list_withsep
+	Inc,                           // Inc : '[' Value ',' LocalIdent ']'
+	SelectInst,                    // SelectInst : 'select' FastMathFlag_optlist TypeValue ',' TypeValue ',' TypeValue list_of_','_and_1_elements1
+	SelectInst,                    // SelectInst : 'select' FastMathFlag_optlist TypeValue ',' TypeValue ',' TypeValue
+	FreezeInst,                    // FreezeInst : 'freeze' TypeValue list_of_','_and_1_elements1
+	FreezeInst,                    // FreezeInst : 'freeze' TypeValue
+	CallInst,                      // CallInst : Tailopt 'call' FastMathFlag_optlist CallingConvopt ReturnAttribute_optlist AddrSpaceopt Type Value '(' Args ')' FuncAttribute_optlist '[' OperandBundle_list_withsep ']' list_of_','_and_1_elements1
+	CallInst,                      // CallInst : Tailopt 'call' FastMathFlag_optlist CallingConvopt ReturnAttribute_optlist AddrSpaceopt Type Value '(' Args ')' FuncAttribute_optlist '[' OperandBundle_list_withsep ']'
+	CallInst,                      // CallInst : Tailopt 'call' FastMathFlag_optlist CallingConvopt ReturnAttribute_optlist AddrSpaceopt Type Value '(' Args ')' FuncAttribute_optlist list_of_','_and_1_elements1
+	CallInst,                      // CallInst : Tailopt 'call' FastMathFlag_optlist CallingConvopt ReturnAttribute_optlist AddrSpaceopt Type Value '(' Args ')' FuncAttribute_optlist
+	0,                             // OperandBundle_list_withsep : OperandBundle_list_withsep ',' OperandBundle
+	0,                             // OperandBundle_list_withsep : OperandBundle
+	Tail,                          // Tail : 'musttail'
+	Tail,                          // Tail : 'notail'
+	Tail,                          // Tail : 'tail'
+	VAArgInst,                     // VAArgInst : 'va_arg' TypeValue ',' Type list_of_','_and_1_elements1
+	VAArgInst,                     // VAArgInst : 'va_arg' TypeValue ',' Type
+	0,                             // Clause_optlist : Clause_optlist Clause
+	0,                             // Clause_optlist :
+	LandingPadInst,                // LandingPadInst : 'landingpad' Type Cleanupopt Clause_optlist list_of_','_and_1_elements1
+	LandingPadInst,                // LandingPadInst : 'landingpad' Type Cleanupopt Clause_optlist
+	Cleanup,                       // Cleanup : 'cleanup'
+	Clause,                        // Clause : ClauseType TypeValue
+	ClauseType,                    // ClauseType : 'catch'
+	ClauseType,                    // ClauseType : 'filter'
+	CatchPadInst,                  // CatchPadInst : 'catchpad' 'within' LocalIdent '[' ExceptionArg_list_withsep_opt ']' list_of_','_and_1_elements1
+	CatchPadInst,                  // CatchPadInst : 'catchpad' 'within' LocalIdent '[' ExceptionArg_list_withsep_opt ']'
+	0,                             // ExceptionArg_list_withsep : ExceptionArg_list_withsep ',' ExceptionArg
+	0,                             // ExceptionArg_list_withsep : ExceptionArg
+	0,                             // ExceptionArg_list_withsep_opt : ExceptionArg_list_withsep
+	0,                             // ExceptionArg_list_withsep_opt :
+	CleanupPadInst,                // CleanupPadInst : 'cleanuppad' 'within' ExceptionPad '[' ExceptionArg_list_withsep_opt ']' list_of_','_and_1_elements1
+	CleanupPadInst,                // CleanupPadInst : 'cleanuppad' 'within' ExceptionPad '[' ExceptionArg_list_withsep_opt ']'
+	0,                             // Terminator : LocalDefTerm
+	0,                             // Terminator : ValueTerminator
+	0,                             // Terminator : RetTerm
+	0,                             // Terminator : BrTerm
+	0,                             // Terminator : CondBrTerm
+	0,                             // Terminator : SwitchTerm
+	0,                             // Terminator : IndirectBrTerm
+	0,                             // Terminator : ResumeTerm
+	0,                             // Terminator : CatchRetTerm
+	0,                             // Terminator : CleanupRetTerm
+	0,                             // Terminator : UnreachableTerm
+	LocalDefTerm,                  // LocalDefTerm : LocalIdent '=' ValueTerminator
+	0,                             // ValueTerminator : InvokeTerm
+	0,                             // ValueTerminator : CallBrTerm
+	0,                             // ValueTerminator : CatchSwitchTerm
+	RetTerm,                       // RetTerm : 'ret' VoidType list_of_','_and_1_elements1
+	RetTerm,                       // RetTerm : 'ret' VoidType
+	RetTerm,                       // RetTerm : 'ret' ConcreteType Value list_of_','_and_1_elements1
+	RetTerm,                       // RetTerm : 'ret' ConcreteType Value
+	BrTerm,                        // BrTerm : 'br' Label list_of_','_and_1_elements1
+	BrTerm,                        // BrTerm : 'br' Label
+	CondBrTerm,                    // CondBrTerm : 'br' IntType Value ',' Label ',' Label list_of_','_and_1_elements1
+	CondBrTerm,                    // CondBrTerm : 'br' IntType Value ',' Label ',' Label
+	0,                             // Case_optlist : Case_optlist Case
+	0,                             // Case_optlist :
+	SwitchTerm,                    // SwitchTerm : 'switch' TypeValue ',' Label '[' Case_optlist ']' list_of_','_and_1_elements1
+	SwitchTerm,                    // SwitchTerm : 'switch' TypeValue ',' Label '[' Case_optlist ']'
+	Case,                          // Case : TypeConst ',' Label
+	IndirectBrTerm,                // IndirectBrTerm : 'indirectbr' TypeValue ',' '[' Label_list_withsep_opt ']' list_of_','_and_1_elements1
+	IndirectBrTerm,                // IndirectBrTerm : 'indirectbr' TypeValue ',' '[' Label_list_withsep_opt ']'
+	0,                             // Label_list_withsep : Label_list_withsep ',' Label
+	0,                             // Label_list_withsep : Label
+	0,                             // Label_list_withsep_opt : Label_list_withsep
+	0,                             // Label_list_withsep_opt :
+	InvokeTerm,                    // InvokeTerm : 'invoke' CallingConvopt ReturnAttribute_optlist AddrSpaceopt Type Value '(' Args ')' FuncAttribute_optlist '[' OperandBundle_list_withsep ']' 'to' Label 'unwind' Label list_of_','_and_1_elements1
+	InvokeTerm,                    // InvokeTerm : 'invoke' CallingConvopt ReturnAttribute_optlist AddrSpaceopt Type Value '(' Args ')' FuncAttribute_optlist '[' OperandBundle_list_withsep ']' 'to' Label 'unwind' Label
+	InvokeTerm,                    // InvokeTerm : 'invoke' CallingConvopt ReturnAttribute_optlist AddrSpaceopt Type Value '(' Args ')' FuncAttribute_optlist 'to' Label 'unwind' Label list_of_','_and_1_elements1
+	InvokeTerm,                    // InvokeTerm : 'invoke' CallingConvopt ReturnAttribute_optlist AddrSpaceopt Type Value '(' Args ')' FuncAttribute_optlist 'to' Label 'unwind' Label
+	CallBrTerm,                    // CallBrTerm : 'callbr' CallingConvopt ReturnAttribute_optlist AddrSpaceopt Type Value '(' Args ')' FuncAttribute_optlist '[' OperandBundle_list_withsep ']' 'to' Label '[' Label_list_withsep_opt ']' list_of_','_and_1_elements1
+	CallBrTerm,                    // CallBrTerm : 'callbr' CallingConvopt ReturnAttribute_optlist AddrSpaceopt Type Value '(' Args ')' FuncAttribute_optlist '[' OperandBundle_list_withsep ']' 'to' Label '[' Label_list_withsep_opt ']'
+	CallBrTerm,                    // CallBrTerm : 'callbr' CallingConvopt ReturnAttribute_optlist AddrSpaceopt Type Value '(' Args ')' FuncAttribute_optlist 'to' Label '[' Label_list_withsep_opt ']' list_of_','_and_1_elements1
+	CallBrTerm,                    // CallBrTerm : 'callbr' CallingConvopt ReturnAttribute_optlist AddrSpaceopt Type Value '(' Args ')' FuncAttribute_optlist 'to' Label '[' Label_list_withsep_opt ']'
+	ResumeTerm,                    // ResumeTerm : 'resume' TypeValue list_of_','_and_1_elements1
+	ResumeTerm,                    // ResumeTerm : 'resume' TypeValue
+	CatchSwitchTerm,               // CatchSwitchTerm : 'catchswitch' 'within' ExceptionPad '[' Handlers ']' 'unwind' UnwindTarget list_of_','_and_1_elements1
+	CatchSwitchTerm,               // CatchSwitchTerm : 'catchswitch' 'within' ExceptionPad '[' Handlers ']' 'unwind' UnwindTarget
+	Handlers,                      // Handlers : Label_list_withsep
+	CatchRetTerm,                  // CatchRetTerm : 'catchret' 'from' Value 'to' Label list_of_','_and_1_elements1
+	CatchRetTerm,                  // CatchRetTerm : 'catchret' 'from' Value 'to' Label
+	CleanupRetTerm,                // CleanupRetTerm : 'cleanupret' 'from' Value 'unwind' UnwindTarget list_of_','_and_1_elements1
+	CleanupRetTerm,                // CleanupRetTerm : 'cleanupret' 'from' Value 'unwind' UnwindTarget
+	UnreachableTerm,               // UnreachableTerm : 'unreachable' list_of_','_and_1_elements1
+	UnreachableTerm,               // UnreachableTerm : 'unreachable'
+	0,                             // MDField_list_withsep : MDField_list_withsep ',' MDField
+	0,                             // MDField_list_withsep : MDField
+	0,                             // MDField_list_withsep_opt : MDField_list_withsep
+	0,                             // MDField_list_withsep_opt :
+	MDTuple,                       // MDTuple : '!' '{' MDField_list_withsep_opt '}'
+	0,                             // MDField : NullLit
+	0,                             // MDField : Metadata
+	0,                             // Metadata : TypeValue
+	0,                             // Metadata : MDString
+	0,                             // Metadata : MDTuple
+	0,                             // Metadata : MetadataID
+	0,                             // Metadata : DIArgList
+	0,                             // Metadata : SpecializedMDNode
+	MDString,                      // MDString : '!' StringLit
+	MetadataAttachment,            // MetadataAttachment : MetadataName MDNode
+	0,                             // MDNode : MDTuple
+	0,                             // MDNode : MetadataID
+	0,                             // MDNode : SpecializedMDNode
+	DIArgList,                     // DIArgList : '!DIArgList' '(' TypeValue_list_withsep_opt ')'
+	0,                             // TypeValue_list_withsep : TypeValue_list_withsep ',' TypeValue
+	0,                             // TypeValue_list_withsep : TypeValue
+	0,                             // TypeValue_list_withsep_opt : TypeValue_list_withsep
+	0,                             // TypeValue_list_withsep_opt :
+	0,                             // SpecializedMDNode : DIBasicType
+	0,                             // SpecializedMDNode : DICommonBlock
+	0,                             // SpecializedMDNode : DICompileUnit
+	0,                             // SpecializedMDNode : DICompositeType
+	0,                             // SpecializedMDNode : DIDerivedType
+	0,                             // SpecializedMDNode : DIEnumerator
+	0,                             // SpecializedMDNode : DIExpression
+	0,                             // SpecializedMDNode : DIFile
+	0,                             // SpecializedMDNode : DIGlobalVariable
+	0,                             // SpecializedMDNode : DIGlobalVariableExpression
+	0,                             // SpecializedMDNode : DIImportedEntity
+	0,                             // SpecializedMDNode : DILabel
+	0,                             // SpecializedMDNode : DILexicalBlock
+	0,                             // SpecializedMDNode : DILexicalBlockFile
+	0,                             // SpecializedMDNode : DILocalVariable
+	0,                             // SpecializedMDNode : DILocation
+	0,                             // SpecializedMDNode : DIMacro
+	0,                             // SpecializedMDNode : DIMacroFile
+	0,                             // SpecializedMDNode : DIModule
+	0,                             // SpecializedMDNode : DINamespace
+	0,                             // SpecializedMDNode : DIObjCProperty
+	0,                             // SpecializedMDNode : DIStringType
+	0,                             // SpecializedMDNode : DISubprogram
+	0,                             // SpecializedMDNode : DISubrange
+	0,                             // SpecializedMDNode : DISubroutineType
+	0,                             // SpecializedMDNode : DITemplateTypeParameter
+	0,                             // SpecializedMDNode : DITemplateValueParameter
+	0,                             // SpecializedMDNode : GenericDINode
+	DIBasicType,                   // DIBasicType : '!DIBasicType' '(' DIBasicTypeField_list_withsep_opt ')'
+	0,                             // DIBasicTypeField_list_withsep : DIBasicTypeField_list_withsep ',' DIBasicTypeField
+	0,                             // DIBasicTypeField_list_withsep : DIBasicTypeField
+	0,                             // DIBasicTypeField_list_withsep_opt : DIBasicTypeField_list_withsep
+	0,                             // DIBasicTypeField_list_withsep_opt :
+	0,                             // DIBasicTypeField : TagField
+	0,                             // DIBasicTypeField : NameField
+	0,                             // DIBasicTypeField : SizeField
+	0,                             // DIBasicTypeField : AlignField
+	0,                             // DIBasicTypeField : EncodingField
+	0,                             // DIBasicTypeField : FlagsField
+	DIStringType,                  // DIStringType : '!DIStringType' '(' DIStringTypeField_list_withsep_opt ')'
+	0,                             // DIStringTypeField_list_withsep : DIStringTypeField_list_withsep ',' DIStringTypeField
+	0,                             // DIStringTypeField_list_withsep : DIStringTypeField
+	0,                             // DIStringTypeField_list_withsep_opt : DIStringTypeField_list_withsep
+	0,                             // DIStringTypeField_list_withsep_opt :
+	0,                             // DIStringTypeField : TagField
+	0,                             // DIStringTypeField : NameField
+	0,                             // DIStringTypeField : StringLengthField
+	0,                             // DIStringTypeField : StringLengthExpressionField
+	0,                             // DIStringTypeField : StringLocationExpressionField
+	0,                             // DIStringTypeField : SizeField
+	0,                             // DIStringTypeField : AlignField
+	0,                             // DIStringTypeField : EncodingField
+	DICommonBlock,                 // DICommonBlock : '!DICommonBlock' '(' DICommonBlockField_list_withsep_opt ')'
+	0,                             // DICommonBlockField_list_withsep : DICommonBlockField_list_withsep ',' DICommonBlockField
+	0,                             // DICommonBlockField_list_withsep : DICommonBlockField
+	0,                             // DICommonBlockField_list_withsep_opt : DICommonBlockField_list_withsep
+	0,                             // DICommonBlockField_list_withsep_opt :
+	0,                             // DICommonBlockField : ScopeField
+	0,                             // DICommonBlockField : DeclarationField
+	0,                             // DICommonBlockField : NameField
+	0,                             // DICommonBlockField : FileField
+	0,                             // DICommonBlockField : LineField
+	DICompileUnit,                 // DICompileUnit : '!DICompileUnit' '(' DICompileUnitField_list_withsep_opt ')'
+	0,                             // DICompileUnitField_list_withsep : DICompileUnitField_list_withsep ',' DICompileUnitField
+	0,                             // DICompileUnitField_list_withsep : DICompileUnitField
+	0,                             // DICompileUnitField_list_withsep_opt : DICompileUnitField_list_withsep
+	0,                             // DICompileUnitField_list_withsep_opt :
+	0,                             // DICompileUnitField : LanguageField
+	0,                             // DICompileUnitField : FileField
+	0,                             // DICompileUnitField : ProducerField
+	0,                             // DICompileUnitField : IsOptimizedField
+	0,                             // DICompileUnitField : FlagsStringField
+	0,                             // DICompileUnitField : RuntimeVersionField
+	0,                             // DICompileUnitField : SplitDebugFilenameField
+	0,                             // DICompileUnitField : EmissionKindField
+	0,                             // DICompileUnitField : EnumsField
+	0,                             // DICompileUnitField : RetainedTypesField
+	0,                             // DICompileUnitField : GlobalsField
+	0,                             // DICompileUnitField : ImportsField
+	0,                             // DICompileUnitField : MacrosField
+	0,                             // DICompileUnitField : DwoIdField
+	0,                             // DICompileUnitField : SplitDebugInliningField
+	0,                             // DICompileUnitField : DebugInfoForProfilingField
+	0,                             // DICompileUnitField : NameTableKindField
+	0,                             // DICompileUnitField : RangesBaseAddressField
+	0,                             // DICompileUnitField : SysrootField
+	0,                             // DICompileUnitField : SDKField
+	DICompositeType,               // DICompositeType : '!DICompositeType' '(' DICompositeTypeField_list_withsep_opt ')'
+	0,                             // DICompositeTypeField_list_withsep : DICompositeTypeField_list_withsep ',' DICompositeTypeField
+	0,                             // DICompositeTypeField_list_withsep : DICompositeTypeField
+	0,                             // DICompositeTypeField_list_withsep_opt : DICompositeTypeField_list_withsep
+	0,                             // DICompositeTypeField_list_withsep_opt :
+	0,                             // DICompositeTypeField : TagField
+	0,                             // DICompositeTypeField : NameField
+	0,                             // DICompositeTypeField : ScopeField
+	0,                             // DICompositeTypeField : FileField
+	0,                             // DICompositeTypeField : LineField
+	0,                             // DICompositeTypeField : BaseTypeField
+	0,                             // DICompositeTypeField : SizeField
+	0,                             // DICompositeTypeField : AlignField
+	0,                             // DICompositeTypeField : OffsetField
+	0,                             // DICompositeTypeField : FlagsField
+	0,                             // DICompositeTypeField : ElementsField
+	0,                             // DICompositeTypeField : RuntimeLangField
+	0,                             // DICompositeTypeField : VtableHolderField
+	0,                             // DICompositeTypeField : TemplateParamsField
+	0,                             // DICompositeTypeField : IdentifierField
+	0,                             // DICompositeTypeField : DiscriminatorField
+	0,                             // DICompositeTypeField : DataLocationField
+	0,                             // DICompositeTypeField : AssociatedField
+	0,                             // DICompositeTypeField : AllocatedField
+	0,                             // DICompositeTypeField : RankField
+	0,                             // DICompositeTypeField : AnnotationsField
+	DIDerivedType,                 // DIDerivedType : '!DIDerivedType' '(' DIDerivedTypeField_list_withsep_opt ')'
+	0,                             // DIDerivedTypeField_list_withsep : DIDerivedTypeField_list_withsep ',' DIDerivedTypeField
+	0,                             // DIDerivedTypeField_list_withsep : DIDerivedTypeField
+	0,                             // DIDerivedTypeField_list_withsep_opt : DIDerivedTypeField_list_withsep
+	0,                             // DIDerivedTypeField_list_withsep_opt :
+	0,                             // DIDerivedTypeField : TagField
+	0,                             // DIDerivedTypeField : NameField
+	0,                             // DIDerivedTypeField : ScopeField
+	0,                             // DIDerivedTypeField : FileField
+	0,                             // DIDerivedTypeField : LineField
+	0,                             // DIDerivedTypeField : BaseTypeField
+	0,                             // DIDerivedTypeField : SizeField
+	0,                             // DIDerivedTypeField : AlignField
+	0,                             // DIDerivedTypeField : OffsetField
+	0,                             // DIDerivedTypeField : FlagsField
+	0,                             // DIDerivedTypeField : ExtraDataField
+	0,                             // DIDerivedTypeField : DwarfAddressSpaceField
+	0,                             // DIDerivedTypeField : AnnotationsField
+	DIEnumerator,                  // DIEnumerator : '!DIEnumerator' '(' DIEnumeratorField_list_withsep_opt ')'
+	0,                             // DIEnumeratorField_list_withsep : DIEnumeratorField_list_withsep ',' DIEnumeratorField
+	0,                             // DIEnumeratorField_list_withsep : DIEnumeratorField
+	0,                             // DIEnumeratorField_list_withsep_opt : DIEnumeratorField_list_withsep
+	0,                             // DIEnumeratorField_list_withsep_opt :
+	0,                             // DIEnumeratorField : NameField
+	0,                             // DIEnumeratorField : ValueIntField
+	0,                             // DIEnumeratorField : IsUnsignedField
+	DIExpression,                  // DIExpression : '!DIExpression' '(' DIExpressionField_list_withsep_opt ')'
+	0,                             // DIExpressionField_list_withsep : DIExpressionField_list_withsep ',' DIExpressionField
+	0,                             // DIExpressionField_list_withsep : DIExpressionField
+	0,                             // DIExpressionField_list_withsep_opt : DIExpressionField_list_withsep
+	0,                             // DIExpressionField_list_withsep_opt :
+	0,                             // DIExpressionField : UintLit
+	0,                             // DIExpressionField : DwarfAttEncoding
+	0,                             // DIExpressionField : DwarfOp
+	DIFile,                        // DIFile : '!DIFile' '(' DIFileField_list_withsep_opt ')'
+	0,                             // DIFileField_list_withsep : DIFileField_list_withsep ',' DIFileField
+	0,                             // DIFileField_list_withsep : DIFileField
+	0,                             // DIFileField_list_withsep_opt : DIFileField_list_withsep
+	0,                             // DIFileField_list_withsep_opt :
+	0,                             // DIFileField : FilenameField
+	0,                             // DIFileField : DirectoryField
+	0,                             // DIFileField : ChecksumkindField
+	0,                             // DIFileField : ChecksumField
+	0,                             // DIFileField : SourceField
+	DIGlobalVariable,              // DIGlobalVariable : '!DIGlobalVariable' '(' DIGlobalVariableField_list_withsep_opt ')'
+	0,                             // DIGlobalVariableField_list_withsep : DIGlobalVariableField_list_withsep ',' DIGlobalVariableField
+	0,                             // DIGlobalVariableField_list_withsep : DIGlobalVariableField
+	0,                             // DIGlobalVariableField_list_withsep_opt : DIGlobalVariableField_list_withsep
+	0,                             // DIGlobalVariableField_list_withsep_opt :
+	0,                             // DIGlobalVariableField : NameField
+	0,                             // DIGlobalVariableField : ScopeField
+	0,                             // DIGlobalVariableField : LinkageNameField
+	0,                             // DIGlobalVariableField : FileField
+	0,                             // DIGlobalVariableField : LineField
+	0,                             // DIGlobalVariableField : TypeField
+	0,                             // DIGlobalVariableField : IsLocalField
+	0,                             // DIGlobalVariableField : IsDefinitionField
+	0,                             // DIGlobalVariableField : TemplateParamsField
+	0,                             // DIGlobalVariableField : DeclarationField
+	0,                             // DIGlobalVariableField : AlignField
+	0,                             // DIGlobalVariableField : AnnotationsField
+	DIGlobalVariableExpression,    // DIGlobalVariableExpression : '!DIGlobalVariableExpression' '(' DIGlobalVariableExpressionField_list_withsep_opt ')'
+	0,                             // DIGlobalVariableExpressionField_list_withsep : DIGlobalVariableExpressionField_list_withsep ',' DIGlobalVariableExpressionField
+	0,                             // DIGlobalVariableExpressionField_list_withsep : DIGlobalVariableExpressionField
+	0,                             // DIGlobalVariableExpressionField_list_withsep_opt : DIGlobalVariableExpressionField_list_withsep
+	0,                             // DIGlobalVariableExpressionField_list_withsep_opt :
+	0,                             // DIGlobalVariableExpressionField : VarField
+	0,                             // DIGlobalVariableExpressionField : ExprField
+	DIImportedEntity,              // DIImportedEntity : '!DIImportedEntity' '(' DIImportedEntityField_list_withsep_opt ')'
+	0,                             // DIImportedEntityField_list_withsep : DIImportedEntityField_list_withsep ',' DIImportedEntityField
+	0,                             // DIImportedEntityField_list_withsep : DIImportedEntityField
+	0,                             // DIImportedEntityField_list_withsep_opt : DIImportedEntityField_list_withsep
+	0,                             // DIImportedEntityField_list_withsep_opt :
+	0,                             // DIImportedEntityField : TagField
+	0,                             // DIImportedEntityField : ScopeField
+	0,                             // DIImportedEntityField : EntityField
+	0,                             // DIImportedEntityField : FileField
+	0,                             // DIImportedEntityField : LineField
+	0,                             // DIImportedEntityField : NameField
+	0,                             // DIImportedEntityField : ElementsField
+	DILabel,                       // DILabel : '!DILabel' '(' DILabelField_list_withsep_opt ')'
+	0,                             // DILabelField_list_withsep : DILabelField_list_withsep ',' DILabelField
+	0,                             // DILabelField_list_withsep : DILabelField
+	0,                             // DILabelField_list_withsep_opt : DILabelField_list_withsep
+	0,                             // DILabelField_list_withsep_opt :
+	0,                             // DILabelField : ScopeField
+	0,                             // DILabelField : NameField
+	0,                             // DILabelField : FileField
+	0,                             // DILabelField : LineField
+	DILexicalBlock,                // DILexicalBlock : '!DILexicalBlock' '(' DILexicalBlockField_list_withsep_opt ')'
+	0,                             // DILexicalBlockField_list_withsep : DILexicalBlockField_list_withsep ',' DILexicalBlockField
+	0,                             // DILexicalBlockField_list_withsep : DILexicalBlockField
+	0,                             // DILexicalBlockField_list_withsep_opt : DILexicalBlockField_list_withsep
+	0,                             // DILexicalBlockField_list_withsep_opt :
+	0,                             // DILexicalBlockField : ScopeField
+	0,                             // DILexicalBlockField : FileField
+	0,                             // DILexicalBlockField : LineField
+	0,                             // DILexicalBlockField : ColumnField
+	DILexicalBlockFile,            // DILexicalBlockFile : '!DILexicalBlockFile' '(' DILexicalBlockFileField_list_withsep_opt ')'
+	0,                             // DILexicalBlockFileField_list_withsep : DILexicalBlockFileField_list_withsep ',' DILexicalBlockFileField
+	0,                             // DILexicalBlockFileField_list_withsep : DILexicalBlockFileField
+	0,                             // DILexicalBlockFileField_list_withsep_opt : DILexicalBlockFileField_list_withsep
+	0,                             // DILexicalBlockFileField_list_withsep_opt :
+	0,                             // DILexicalBlockFileField : ScopeField
+	0,                             // DILexicalBlockFileField : FileField
+	0,                             // DILexicalBlockFileField : DiscriminatorIntField
+	DILocalVariable,               // DILocalVariable : '!DILocalVariable' '(' DILocalVariableField_list_withsep_opt ')'
+	0,                             // DILocalVariableField_list_withsep : DILocalVariableField_list_withsep ',' DILocalVariableField
+	0,                             // DILocalVariableField_list_withsep : DILocalVariableField
+	0,                             // DILocalVariableField_list_withsep_opt : DILocalVariableField_list_withsep
+	0,                             // DILocalVariableField_list_withsep_opt :
+	0,                             // DILocalVariableField : ScopeField
+	0,                             // DILocalVariableField : NameField
+	0,                             // DILocalVariableField : ArgField
+	0,                             // DILocalVariableField : FileField
+	0,                             // DILocalVariableField : LineField
+	0,                             // DILocalVariableField : TypeField
+	0,                             // DILocalVariableField : FlagsField
+	0,                             // DILocalVariableField : AlignField
+	0,                             // DILocalVariableField : AnnotationsField
+	DILocation,                    // DILocation : '!DILocation' '(' DILocationField_list_withsep_opt ')'
+	0,                             // DILocationField_list_withsep : DILocationField_list_withsep ',' DILocationField
+	0,                             // DILocationField_list_withsep : DILocationField
+	0,                             // DILocationField_list_withsep_opt : DILocationField_list_withsep
+	0,                             // DILocationField_list_withsep_opt :
+	0,                             // DILocationField : LineField
+	0,                             // DILocationField : ColumnField
+	0,                             // DILocationField : ScopeField
+	0,                             // DILocationField : InlinedAtField
+	0,                             // DILocationField : IsImplicitCodeField
+	DIMacro,                       // DIMacro : '!DIMacro' '(' DIMacroField_list_withsep_opt ')'
+	0,                             // DIMacroField_list_withsep : DIMacroField_list_withsep ',' DIMacroField
+	0,                             // DIMacroField_list_withsep : DIMacroField
+	0,                             // DIMacroField_list_withsep_opt : DIMacroField_list_withsep
+	0,                             // DIMacroField_list_withsep_opt :
+	0,                             // DIMacroField : TypeMacinfoField
+	0,                             // DIMacroField : LineField
+	0,                             // DIMacroField : NameField
+	0,                             // DIMacroField : ValueStringField
+	DIMacroFile,                   // DIMacroFile : '!DIMacroFile' '(' DIMacroFileField_list_withsep_opt ')'
+	0,                             // DIMacroFileField_list_withsep : DIMacroFileField_list_withsep ',' DIMacroFileField
+	0,                             // DIMacroFileField_list_withsep : DIMacroFileField
+	0,                             // DIMacroFileField_list_withsep_opt : DIMacroFileField_list_withsep
+	0,                             // DIMacroFileField_list_withsep_opt :
+	0,                             // DIMacroFileField : TypeMacinfoField
+	0,                             // DIMacroFileField : LineField
+	0,                             // DIMacroFileField : FileField
+	0,                             // DIMacroFileField : NodesField
+	DIModule,                      // DIModule : '!DIModule' '(' DIModuleField_list_withsep_opt ')'
+	0,                             // DIModuleField_list_withsep : DIModuleField_list_withsep ',' DIModuleField
+	0,                             // DIModuleField_list_withsep : DIModuleField
+	0,                             // DIModuleField_list_withsep_opt : DIModuleField_list_withsep
+	0,                             // DIModuleField_list_withsep_opt :
+	0,                             // DIModuleField : ScopeField
+	0,                             // DIModuleField : NameField
+	0,                             // DIModuleField : ConfigMacrosField
+	0,                             // DIModuleField : IncludePathField
+	0,                             // DIModuleField : APINotesField
+	0,                             // DIModuleField : FileField
+	0,                             // DIModuleField : LineField
+	0,                             // DIModuleField : IsDeclField
+	DINamespace,                   // DINamespace : '!DINamespace' '(' DINamespaceField_list_withsep_opt ')'
+	0,                             // DINamespaceField_list_withsep : DINamespaceField_list_withsep ',' DINamespaceField
+	0,                             // DINamespaceField_list_withsep : DINamespaceField
+	0,                             // DINamespaceField_list_withsep_opt : DINamespaceField_list_withsep
+	0,                             // DINamespaceField_list_withsep_opt :
+	0,                             // DINamespaceField : ScopeField
+	0,                             // DINamespaceField : NameField
+	0,                             // DINamespaceField : ExportSymbolsField
+	DIObjCProperty,                // DIObjCProperty : '!DIObjCProperty' '(' DIObjCPropertyField_list_withsep_opt ')'
+	0,                             // DIObjCPropertyField_list_withsep : DIObjCPropertyField_list_withsep ',' DIObjCPropertyField
+	0,                             // DIObjCPropertyField_list_withsep : DIObjCPropertyField
+	0,                             // DIObjCPropertyField_list_withsep_opt : DIObjCPropertyField_list_withsep
+	0,                             // DIObjCPropertyField_list_withsep_opt :
+	0,                             // DIObjCPropertyField : NameField
+	0,                             // DIObjCPropertyField : FileField
+	0,                             // DIObjCPropertyField : LineField
+	0,                             // DIObjCPropertyField : SetterField
+	0,                             // DIObjCPropertyField : GetterField
+	0,                             // DIObjCPropertyField : AttributesField
+	0,                             // DIObjCPropertyField : TypeField
+	DISubprogram,                  // DISubprogram : '!DISubprogram' '(' DISubprogramField_list_withsep_opt ')'
+	0,                             // DISubprogramField_list_withsep : DISubprogramField_list_withsep ',' DISubprogramField
+	0,                             // DISubprogramField_list_withsep : DISubprogramField
+	0,                             // DISubprogramField_list_withsep_opt : DISubprogramField_list_withsep
+	0,                             // DISubprogramField_list_withsep_opt :
+	0,                             // DISubprogramField : ScopeField
+	0,                             // DISubprogramField : NameField
+	0,                             // DISubprogramField : LinkageNameField
+	0,                             // DISubprogramField : FileField
+	0,                             // DISubprogramField : LineField
+	0,                             // DISubprogramField : TypeField
+	0,                             // DISubprogramField : IsLocalField
+	0,                             // DISubprogramField : IsDefinitionField
+	0,                             // DISubprogramField : ScopeLineField
+	0,                             // DISubprogramField : ContainingTypeField
+	0,                             // DISubprogramField : VirtualityField
+	0,                             // DISubprogramField : VirtualIndexField
+	0,                             // DISubprogramField : ThisAdjustmentField
+	0,                             // DISubprogramField : FlagsField
+	0,                             // DISubprogramField : SPFlagsField
+	0,                             // DISubprogramField : IsOptimizedField
+	0,                             // DISubprogramField : UnitField
+	0,                             // DISubprogramField : TemplateParamsField
+	0,                             // DISubprogramField : DeclarationField
+	0,                             // DISubprogramField : RetainedNodesField
+	0,                             // DISubprogramField : ThrownTypesField
+	0,                             // DISubprogramField : AnnotationsField
+	DISubrange,                    // DISubrange : '!DISubrange' '(' DISubrangeField_list_withsep_opt ')'
+	0,                             // DISubrangeField_list_withsep : DISubrangeField_list_withsep ',' DISubrangeField
+	0,                             // DISubrangeField_list_withsep : DISubrangeField
+	0,                             // DISubrangeField_list_withsep_opt : DISubrangeField_list_withsep
+	0,                             // DISubrangeField_list_withsep_opt :
+	0,                             // DISubrangeField : CountField
+	0,                             // DISubrangeField : LowerBoundField
+	0,                             // DISubrangeField : UpperBoundField
+	0,                             // DISubrangeField : StrideField
+	DISubroutineType,              // DISubroutineType : '!DISubroutineType' '(' DISubroutineTypeField_list_withsep_opt ')'
+	0,                             // DISubroutineTypeField_list_withsep : DISubroutineTypeField_list_withsep ',' DISubroutineTypeField
+	0,                             // DISubroutineTypeField_list_withsep : DISubroutineTypeField
+	0,                             // DISubroutineTypeField_list_withsep_opt : DISubroutineTypeField_list_withsep
+	0,                             // DISubroutineTypeField_list_withsep_opt :
+	0,                             // DISubroutineTypeField : FlagsField
+	0,                             // DISubroutineTypeField : CCField
+	0,                             // DISubroutineTypeField : TypesField
+	DITemplateTypeParameter,       // DITemplateTypeParameter : '!DITemplateTypeParameter' '(' DITemplateTypeParameterField_list_withsep_opt ')'
+	0,                             // DITemplateTypeParameterField_list_withsep : DITemplateTypeParameterField_list_withsep ',' DITemplateTypeParameterField
+	0,                             // DITemplateTypeParameterField_list_withsep : DITemplateTypeParameterField
+	0,                             // DITemplateTypeParameterField_list_withsep_opt : DITemplateTypeParameterField_list_withsep
+	0,                             // DITemplateTypeParameterField_list_withsep_opt :
+	0,                             // DITemplateTypeParameterField : NameField
+	0,                             // DITemplateTypeParameterField : TypeField
+	0,                             // DITemplateTypeParameterField : DefaultedField
+	DITemplateValueParameter,      // DITemplateValueParameter : '!DITemplateValueParameter' '(' DITemplateValueParameterField_list_withsep_opt ')'
+	0,                             // DITemplateValueParameterField_list_withsep : DITemplateValueParameterField_list_withsep ',' DITemplateValueParameterField
+	0,                             // DITemplateValueParameterField_list_withsep : DITemplateValueParameterField
+	0,                             // DITemplateValueParameterField_list_withsep_opt : DITemplateValueParameterField_list_withsep
+	0,                             // DITemplateValueParameterField_list_withsep_opt :
+	0,                             // DITemplateValueParameterField : TagField
+	0,                             // DITemplateValueParameterField : NameField
+	0,                             // DITemplateValueParameterField : TypeField
+	0,                             // DITemplateValueParameterField : DefaultedField
+	0,                             // DITemplateValueParameterField : ValueField
+	GenericDINode,                 // GenericDINode : '!GenericDINode' '(' GenericDINodeField_list_withsep_opt ')'
+	0,                             // GenericDINodeField_list_withsep : GenericDINodeField_list_withsep ',' GenericDINodeField
+	0,                             // GenericDINodeField_list_withsep : GenericDINodeField
+	0,                             // GenericDINodeField_list_withsep_opt : GenericDINodeField_list_withsep
+	0,                             // GenericDINodeField_list_withsep_opt :
+	0,                             // GenericDINodeField : TagField
+	0,                             // GenericDINodeField : HeaderField
+	0,                             // GenericDINodeField : OperandsField
+	AlignField,                    // AlignField : 'align:' UintLit
+	AllocatedField,                // AllocatedField : 'allocated:' MDField
+	AnnotationsField,              // AnnotationsField : 'annotations:' MDField
+	ArgField,                      // ArgField : 'arg:' UintLit
+	AssociatedField,               // AssociatedField : 'associated:' MDField
+	AttributesField,               // AttributesField : 'attributes:' UintLit
+	BaseTypeField,                 // BaseTypeField : 'baseType:' MDField
+	CCField,                       // CCField : 'cc:' DwarfCC
+	ChecksumField,                 // ChecksumField : 'checksum:' StringLit
+	ChecksumkindField,             // ChecksumkindField : 'checksumkind:' ChecksumKind
+	ColumnField,                   // ColumnField : 'column:' IntLit
+	ConfigMacrosField,             // ConfigMacrosField : 'configMacros:' StringLit
+	ContainingTypeField,           // ContainingTypeField : 'containingType:' MDField
+	CountField,                    // CountField : 'count:' MDFieldOrInt
+	DebugInfoForProfilingField,    // DebugInfoForProfilingField : 'debugInfoForProfiling:' BoolLit
+	DeclarationField,              // DeclarationField : 'declaration:' MDField
+	DirectoryField,                // DirectoryField : 'directory:' StringLit
+	DiscriminatorField,            // DiscriminatorField : 'discriminator:' MDField
+	DataLocationField,             // DataLocationField : 'dataLocation:' MDField
+	DefaultedField,                // DefaultedField : 'defaulted:' BoolLit
+	DiscriminatorIntField,         // DiscriminatorIntField : 'discriminator:' UintLit
+	DwarfAddressSpaceField,        // DwarfAddressSpaceField : 'dwarfAddressSpace:' UintLit
+	DwoIdField,                    // DwoIdField : 'dwoId:' UintLit
+	ElementsField,                 // ElementsField : 'elements:' MDField
+	EmissionKindField,             // EmissionKindField : 'emissionKind:' EmissionKind
+	EncodingField,                 // EncodingField : 'encoding:' DwarfAttEncodingOrUint
+	EntityField,                   // EntityField : 'entity:' MDField
+	EnumsField,                    // EnumsField : 'enums:' MDField
+	ExportSymbolsField,            // ExportSymbolsField : 'exportSymbols:' BoolLit
+	ExprField,                     // ExprField : 'expr:' MDField
+	ExtraDataField,                // ExtraDataField : 'extraData:' MDField
+	FileField,                     // FileField : 'file:' MDField
+	FilenameField,                 // FilenameField : 'filename:' StringLit
+	FlagsField,                    // FlagsField : 'flags:' DIFlags
+	FlagsStringField,              // FlagsStringField : 'flags:' StringLit
+	GetterField,                   // GetterField : 'getter:' StringLit
+	GlobalsField,                  // GlobalsField : 'globals:' MDField
+	HeaderField,                   // HeaderField : 'header:' StringLit
+	IdentifierField,               // IdentifierField : 'identifier:' StringLit
+	ImportsField,                  // ImportsField : 'imports:' MDField
+	IncludePathField,              // IncludePathField : 'includePath:' StringLit
+	InlinedAtField,                // InlinedAtField : 'inlinedAt:' MDField
+	IsDeclField,                   // IsDeclField : 'isDecl:' BoolLit
+	IsDefinitionField,             // IsDefinitionField : 'isDefinition:' BoolLit
+	IsImplicitCodeField,           // IsImplicitCodeField : 'isImplicitCode:' BoolLit
+	IsLocalField,                  // IsLocalField : 'isLocal:' BoolLit
+	IsOptimizedField,              // IsOptimizedField : 'isOptimized:' BoolLit
+	IsUnsignedField,               // IsUnsignedField : 'isUnsigned:' BoolLit
+	APINotesField,                 // APINotesField : 'apinotes:' StringLit
+	LanguageField,                 // LanguageField : 'language:' DwarfLang
+	LineField,                     // LineField : 'line:' IntLit
+	LinkageNameField,              // LinkageNameField : 'linkageName:' StringLit
+	LowerBoundField,               // LowerBoundField : 'lowerBound:' MDFieldOrInt
+	MacrosField,                   // MacrosField : 'macros:' MDField
+	NameField,                     // NameField : 'name:' StringLit
+	NameTableKindField,            // NameTableKindField : 'nameTableKind:' NameTableKind
+	NodesField,                    // NodesField : 'nodes:' MDField
+	OffsetField,                   // OffsetField : 'offset:' UintLit
+	OperandsField,                 // OperandsField : 'operands:' '{' MDField_list_withsep_opt '}'
+	ProducerField,                 // ProducerField : 'producer:' StringLit
+	RangesBaseAddressField,        // RangesBaseAddressField : 'rangesBaseAddress:' BoolLit
+	RankField,                     // RankField : 'rank:' MDFieldOrInt
+	RetainedNodesField,            // RetainedNodesField : 'retainedNodes:' MDField
+	RetainedTypesField,            // RetainedTypesField : 'retainedTypes:' MDField
+	RuntimeLangField,              // RuntimeLangField : 'runtimeLang:' DwarfLang
+	RuntimeVersionField,           // RuntimeVersionField : 'runtimeVersion:' UintLit
+	ScopeField,                    // ScopeField : 'scope:' MDField
+	ScopeLineField,                // ScopeLineField : 'scopeLine:' IntLit
+	SDKField,                      // SDKField : 'sdk:' StringLit
+	SetterField,                   // SetterField : 'setter:' StringLit
+	SizeField,                     // SizeField : 'size:' UintLit
+	SourceField,                   // SourceField : 'source:' StringLit
+	SPFlagsField,                  // SPFlagsField : 'spFlags:' DISPFlags
+	SplitDebugFilenameField,       // SplitDebugFilenameField : 'splitDebugFilename:' StringLit
+	SplitDebugInliningField,       // SplitDebugInliningField : 'splitDebugInlining:' BoolLit
+	StrideField,                   // StrideField : 'stride:' MDFieldOrInt
+	StringLengthField,             // StringLengthField : 'stringLength:' MDField
+	StringLengthExpressionField,   // StringLengthExpressionField : 'stringLengthExpression:' MDField
+	StringLocationExpressionField, // StringLocationExpressionField : 'stringLocationExpression:' MDField
+	SysrootField,                  // SysrootField : 'sysroot:' StringLit
+	TagField,                      // TagField : 'tag:' DwarfTag
+	TemplateParamsField,           // TemplateParamsField : 'templateParams:' MDField
+	ThisAdjustmentField,           // ThisAdjustmentField : 'thisAdjustment:' IntLit
+	ThrownTypesField,              // ThrownTypesField : 'thrownTypes:' MDField
+	TypeField,                     // TypeField : 'type:' MDField
+	TypeMacinfoField,              // TypeMacinfoField : 'type:' DwarfMacinfo
+	TypesField,                    // TypesField : 'types:' MDField
+	UnitField,                     // UnitField : 'unit:' MDField
+	UpperBoundField,               // UpperBoundField : 'upperBound:' MDFieldOrInt
+	ValueField,                    // ValueField : 'value:' MDField
+	ValueIntField,                 // ValueIntField : 'value:' IntLit
+	ValueStringField,              // ValueStringField : 'value:' StringLit
+	VarField,                      // VarField : 'var:' MDField
+	VirtualIndexField,             // VirtualIndexField : 'virtualIndex:' UintLit
+	VirtualityField,               // VirtualityField : 'virtuality:' DwarfVirtuality
+	VtableHolderField,             // VtableHolderField : 'vtableHolder:' MDField
+	0,                             // MDFieldOrInt : MDField
+	0,                             // MDFieldOrInt : IntLit
+	ChecksumKind,                  // ChecksumKind : checksum_kind_tok
+	0,                             // DIFlag_list_withsep : DIFlag_list_withsep pipe_tok DIFlag
+	0,                             // DIFlag_list_withsep : DIFlag
+	DIFlags,                       // DIFlags : DIFlag_list_withsep
+	DIFlagEnum,                    // DIFlag : di_flag_tok
+	DIFlagInt,                     // DIFlag : UintLit
+	0,                             // DISPFlag_list_withsep : DISPFlag_list_withsep pipe_tok DISPFlag
+	0,                             // DISPFlag_list_withsep : DISPFlag
+	DISPFlags,                     // DISPFlags : DISPFlag_list_withsep
+	DISPFlagEnum,                  // DISPFlag : disp_flag_tok
+	DISPFlagInt,                   // DISPFlag : UintLit
+	DwarfAttEncodingEnum,          // DwarfAttEncoding : dwarf_att_encoding_tok
+	0,                             // DwarfAttEncodingOrUint : DwarfAttEncoding
+	DwarfAttEncodingInt,           // DwarfAttEncodingOrUint : UintLit
+	DwarfCCEnum,                   // DwarfCC : dwarf_cc_tok
+	DwarfCCInt,                    // DwarfCC : UintLit
+	DwarfLangEnum,                 // DwarfLang : dwarf_lang_tok
+	DwarfLangInt,                  // DwarfLang : UintLit
+	DwarfMacinfoEnum,              // DwarfMacinfo : dwarf_macinfo_tok
+	DwarfMacinfoInt,               // DwarfMacinfo : UintLit
+	DwarfOp,                       // DwarfOp : dwarf_op_tok
+	DwarfTagEnum,                  // DwarfTag : dwarf_tag_tok
+	DwarfTagInt,                   // DwarfTag : UintLit
+	DwarfVirtualityEnum,           // DwarfVirtuality : dwarf_virtuality_tok
+	DwarfVirtualityInt,            // DwarfVirtuality : UintLit
+	EmissionKindEnum,              // EmissionKind : emission_kind_tok
+	EmissionKindInt,               // EmissionKind : UintLit
+	NameTableKindEnum,             // NameTableKind : name_table_kind_tok
+	NameTableKindInt,              // NameTableKind : UintLit
+	AddrSpace,                     // AddrSpace : 'addrspace' '(' UintLit ')'
+	Align,                         // Align : 'align' UintLit
+	Align,                         // Align : 'align' '(' UintLit ')'
+	AlignPair,                     // AlignPair : 'align' '=' UintLit
+	AlignStack,                    // AlignStack : 'alignstack' '(' UintLit ')'
+	AlignStackPair,                // AlignStackPair : 'alignstack' '=' UintLit
+	AllocSize,                     // AllocSize : 'allocsize' '(' UintLit ')'
+	AllocSize,                     // AllocSize : 'allocsize' '(' UintLit ',' UintLit ')'
+	0,                             // Arg_list_withsep : Arg_list_withsep ',' Arg
+	0,                             // Arg_list_withsep : Arg
+	Args,                          // Args : '...'
+	Args,                          // Args :
+	Args,                          // Args : Arg_list_withsep ',' '...'
+	Args,                          // Args : Arg_list_withsep
+	Arg,                           // Arg : ConcreteType ParamAttribute_optlist Value
+	Arg,                           // Arg : MetadataType Metadata
+	0,                             // ParamAttribute_optlist : ParamAttribute_optlist ParamAttribute
+	0,                             // ParamAttribute_optlist :
+	Atomic,                        // Atomic : 'atomic'
+	AtomicOrdering,                // AtomicOrdering : 'acq_rel'
+	AtomicOrdering,                // AtomicOrdering : 'acquire'
+	AtomicOrdering,                // AtomicOrdering : 'monotonic'
+	AtomicOrdering,                // AtomicOrdering : 'release'
+	AtomicOrdering,                // AtomicOrdering : 'seq_cst'
+	AtomicOrdering,                // AtomicOrdering : 'unordered'
+	AttrPair,                      // AttrPair : StringLit '=' StringLit
+	AttrString,                    // AttrString : StringLit
+	Byval,                         // Byval : 'byval'
+	Byval,                         // Byval : 'byval' '(' Type ')'
+	0,                             // CallingConv : CallingConvEnum
+	0,                             // CallingConv : CallingConvInt
+	CallingConvEnum,               // CallingConvEnum : 'aarch64_sve_vector_pcs'
+	CallingConvEnum,               // CallingConvEnum : 'aarch64_vector_pcs'
+	CallingConvEnum,               // CallingConvEnum : 'amdgpu_cs'
+	CallingConvEnum,               // CallingConvEnum : 'amdgpu_es'
+	CallingConvEnum,               // CallingConvEnum : 'amdgpu_gfx'
+	CallingConvEnum,               // CallingConvEnum : 'amdgpu_gs'
+	CallingConvEnum,               // CallingConvEnum : 'amdgpu_hs'
+	CallingConvEnum,               // CallingConvEnum : 'amdgpu_kernel'
+	CallingConvEnum,               // CallingConvEnum : 'amdgpu_ls'
+	CallingConvEnum,               // CallingConvEnum : 'amdgpu_ps'
+	CallingConvEnum,               // CallingConvEnum : 'amdgpu_vs'
+	CallingConvEnum,               // CallingConvEnum : 'anyregcc'
+	CallingConvEnum,               // CallingConvEnum : 'arm_aapcs_vfpcc'
+	CallingConvEnum,               // CallingConvEnum : 'arm_aapcscc'
+	CallingConvEnum,               // CallingConvEnum : 'arm_apcscc'
+	CallingConvEnum,               // CallingConvEnum : 'avr_intrcc'
+	CallingConvEnum,               // CallingConvEnum : 'avr_signalcc'
+	CallingConvEnum,               // CallingConvEnum : 'ccc'
+	CallingConvEnum,               // CallingConvEnum : 'cfguard_checkcc'
+	CallingConvEnum,               // CallingConvEnum : 'coldcc'
+	CallingConvEnum,               // CallingConvEnum : 'cxx_fast_tlscc'
+	CallingConvEnum,               // CallingConvEnum : 'fastcc'
+	CallingConvEnum,               // CallingConvEnum : 'ghccc'
+	CallingConvEnum,               // CallingConvEnum : 'hhvm_ccc'
+	CallingConvEnum,               // CallingConvEnum : 'hhvmcc'
+	CallingConvEnum,               // CallingConvEnum : 'intel_ocl_bicc'
+	CallingConvEnum,               // CallingConvEnum : 'msp430_intrcc'
+	CallingConvEnum,               // CallingConvEnum : 'preserve_allcc'
+	CallingConvEnum,               // CallingConvEnum : 'preserve_mostcc'
+	CallingConvEnum,               // CallingConvEnum : 'ptx_device'
+	CallingConvEnum,               // CallingConvEnum : 'ptx_kernel'
+	CallingConvEnum,               // CallingConvEnum : 'spir_func'
+	CallingConvEnum,               // CallingConvEnum : 'spir_kernel'
+	CallingConvEnum,               // CallingConvEnum : 'swiftcc'
+	CallingConvEnum,               // CallingConvEnum : 'swifttailcc'
+	CallingConvEnum,               // CallingConvEnum : 'tailcc'
+	CallingConvEnum,               // CallingConvEnum : 'webkit_jscc'
+	CallingConvEnum,               // CallingConvEnum : 'win64cc'
+	CallingConvEnum,               // CallingConvEnum : 'x86_64_sysvcc'
+	CallingConvEnum,               // CallingConvEnum : 'x86_fastcallcc'
+	CallingConvEnum,               // CallingConvEnum : 'x86_intrcc'
+	CallingConvEnum,               // CallingConvEnum : 'x86_regcallcc'
+	CallingConvEnum,               // CallingConvEnum : 'x86_stdcallcc'
+	CallingConvEnum,               // CallingConvEnum : 'x86_thiscallcc'
+	CallingConvEnum,               // CallingConvEnum : 'x86_vectorcallcc'
+	CallingConvInt,                // CallingConvInt : 'cc' UintLit
+	Comdat,                        // Comdat : 'comdat'
+	Comdat,                        // Comdat : 'comdat' '(' ComdatName ')'
+	Dereferenceable,               // Dereferenceable : 'dereferenceable' '(' UintLit ')'
+	DereferenceableOrNull,         // Dereferenceable : 'dereferenceable_or_null' '(' UintLit ')'
+	ElementType,                   // ElementType : 'elementtype' '(' Type ')'
+	DLLStorageClass,               // DLLStorageClass : 'dllexport'
+	DLLStorageClass,               // DLLStorageClass : 'dllimport'
+	Ellipsis,                      // Ellipsis : '...'
+	Exact,                         // Exact : 'exact'
+	ExceptionArg,                  // ExceptionArg : ConcreteType Value
+	ExceptionArg,                  // ExceptionArg : MetadataType Metadata
+	0,                             // ExceptionPad : NoneConst
+	0,                             // ExceptionPad : LocalIdent
+	FastMathFlag,                  // FastMathFlag : 'afn'
+	FastMathFlag,                  // FastMathFlag : 'arcp'
+	FastMathFlag,                  // FastMathFlag : 'contract'
+	FastMathFlag,                  // FastMathFlag : 'fast'
+	FastMathFlag,                  // FastMathFlag : 'ninf'
+	FastMathFlag,                  // FastMathFlag : 'nnan'
+	FastMathFlag,                  // FastMathFlag : 'nsz'
+	FastMathFlag,                  // FastMathFlag : 'reassoc'
+	FPred,                         // FPred : 'false'
+	FPred,                         // FPred : 'oeq'
+	FPred,                         // FPred : 'oge'
+	FPred,                         // FPred : 'ogt'
+	FPred,                         // FPred : 'ole'
+	FPred,                         // FPred : 'olt'
+	FPred,                         // FPred : 'one'
+	FPred,                         // FPred : 'ord'
+	FPred,                         // FPred : 'true'
+	FPred,                         // FPred : 'ueq'
+	FPred,                         // FPred : 'uge'
+	FPred,                         // FPred : 'ugt'
+	FPred,                         // FPred : 'ule'
+	FPred,                         // FPred : 'ult'
+	FPred,                         // FPred : 'une'
+	FPred,                         // FPred : 'uno'
+	0,                             // FuncAttribute : AttrString
+	0,                             // FuncAttribute : AttrPair
+	0,                             // FuncAttribute : AttrGroupID
+	0,                             // FuncAttribute : AlignPair
+	0,                             // FuncAttribute : AlignStack
+	0,                             // FuncAttribute : AlignStackPair
+	0,                             // FuncAttribute : AllocSize
+	0,                             // FuncAttribute : FuncAttr
+	0,                             // FuncAttribute : Preallocated
+	0,                             // FuncAttribute : VScaleRange
+	0,                             // FuncAttribute : VScaleRangetok
+	FuncAttr,                      // FuncAttr : 'alwaysinline'
+	FuncAttr,                      // FuncAttr : 'argmemonly'
+	FuncAttr,                      // FuncAttr : 'builtin'
+	FuncAttr,                      // FuncAttr : 'cold'
+	FuncAttr,                      // FuncAttr : 'convergent'
+	FuncAttr,                      // FuncAttr : 'disable_sanitizer_instrumentation'
+	FuncAttr,                      // FuncAttr : 'hot'
+	FuncAttr,                      // FuncAttr : 'inaccessiblemem_or_argmemonly'
+	FuncAttr,                      // FuncAttr : 'inaccessiblememonly'
+	FuncAttr,                      // FuncAttr : 'inlinehint'
+	FuncAttr,                      // FuncAttr : 'jumptable'
+	FuncAttr,                      // FuncAttr : 'minsize'
+	FuncAttr,                      // FuncAttr : 'mustprogress'
+	FuncAttr,                      // FuncAttr : 'naked'
+	FuncAttr,                      // FuncAttr : 'nobuiltin'
+	FuncAttr,                      // FuncAttr : 'nocallback'
+	FuncAttr,                      // FuncAttr : 'nocf_check'
+	FuncAttr,                      // FuncAttr : 'noduplicate'
+	FuncAttr,                      // FuncAttr : 'nofree'
+	FuncAttr,                      // FuncAttr : 'noimplicitfloat'
+	FuncAttr,                      // FuncAttr : 'noinline'
+	FuncAttr,                      // FuncAttr : 'nomerge'
+	FuncAttr,                      // FuncAttr : 'nonlazybind'
+	FuncAttr,                      // FuncAttr : 'noprofile'
+	FuncAttr,                      // FuncAttr : 'norecurse'
+	FuncAttr,                      // FuncAttr : 'noredzone'
+	FuncAttr,                      // FuncAttr : 'noreturn'
+	FuncAttr,                      // FuncAttr : 'nosanitize_coverage'
+	FuncAttr,                      // FuncAttr : 'nosync'
+	FuncAttr,                      // FuncAttr : 'nounwind'
+	FuncAttr,                      // FuncAttr : 'null_pointer_is_valid'
+	FuncAttr,                      // FuncAttr : 'optforfuzzing'
+	FuncAttr,                      // FuncAttr : 'optnone'
+	FuncAttr,                      // FuncAttr : 'optsize'
+	FuncAttr,                      // FuncAttr : 'readnone'
+	FuncAttr,                      // FuncAttr : 'readonly'
+	FuncAttr,                      // FuncAttr : 'returns_twice'
+	FuncAttr,                      // FuncAttr : 'safestack'
+	FuncAttr,                      // FuncAttr : 'sanitize_address'
+	FuncAttr,                      // FuncAttr : 'sanitize_hwaddress'
+	FuncAttr,                      // FuncAttr : 'sanitize_memory'
+	FuncAttr,                      // FuncAttr : 'sanitize_memtag'
+	FuncAttr,                      // FuncAttr : 'sanitize_thread'
+	FuncAttr,                      // FuncAttr : 'shadowcallstack'
+	FuncAttr,                      // FuncAttr : 'speculatable'
+	FuncAttr,                      // FuncAttr : 'speculative_load_hardening'
+	FuncAttr,                      // FuncAttr : 'ssp'
+	FuncAttr,                      // FuncAttr : 'sspreq'
+	FuncAttr,                      // FuncAttr : 'sspstrong'
+	FuncAttr,                      // FuncAttr : 'strictfp'
+	FuncAttr,                      // FuncAttr : 'uwtable'
+	FuncAttr,                      // FuncAttr : 'willreturn'
+	FuncAttr,                      // FuncAttr : 'writeonly'
+	InBounds,                      // InBounds : 'inbounds'
+	InAlloca,                      // InAlloca : 'inalloca' '(' Type ')'
+	IPred,                         // IPred : 'eq'
+	IPred,                         // IPred : 'ne'
+	IPred,                         // IPred : 'sge'
+	IPred,                         // IPred : 'sgt'
+	IPred,                         // IPred : 'sle'
+	IPred,                         // IPred : 'slt'
+	IPred,                         // IPred : 'uge'
+	IPred,                         // IPred : 'ugt'
+	IPred,                         // IPred : 'ule'
+	IPred,                         // IPred : 'ult'
+	Label,                         // Label : LabelType LocalIdent
+	Linkage,                       // Linkage : 'appending'
+	Linkage,                       // Linkage : 'available_externally'
+	Linkage,                       // Linkage : 'common'
+	Linkage,                       // Linkage : 'internal'
+	Linkage,                       // Linkage : 'linkonce'
+	Linkage,                       // Linkage : 'linkonce_odr'
+	Linkage,                       // Linkage : 'private'
+	Linkage,                       // Linkage : 'weak'
+	Linkage,                       // Linkage : 'weak_odr'
+	ExternLinkage,                 // ExternLinkage : 'extern_weak'
+	ExternLinkage,                 // ExternLinkage : 'external'
+	OperandBundle,                 // OperandBundle : StringLit '(' TypeValue_list_withsep_opt ')'
+	OverflowFlag,                  // OverflowFlag : 'nsw'
+	OverflowFlag,                  // OverflowFlag : 'nuw'
+	0,                             // Param_list_withsep : Param_list_withsep ',' Param
+	0,                             // Param_list_withsep : Param
+	Params,                        // Params : Ellipsisopt
+	Params,                        // Params : Param_list_withsep ',' Ellipsis
+	Params,                        // Params : Param_list_withsep
+	Param,                         // Param : Type ParamAttribute_optlist LocalIdent
+	Param,                         // Param : Type ParamAttribute_optlist
+	0,                             // ParamAttribute : AttrString
+	0,                             // ParamAttribute : AttrPair
+	0,                             // ParamAttribute : Align
+	0,                             // ParamAttribute : AlignStack
+	0,                             // ParamAttribute : ByRefAttr
+	0,                             // ParamAttribute : Byval
+	0,                             // ParamAttribute : Dereferenceable
+	0,                             // ParamAttribute : ElementType
+	0,                             // ParamAttribute : InAlloca
+	0,                             // ParamAttribute : ParamAttr
+	0,                             // ParamAttribute : Preallocated
+	0,                             // ParamAttribute : StructRetAttr
+	ParamAttr,                     // ParamAttr : 'immarg'
+	ParamAttr,                     // ParamAttr : 'inreg'
+	ParamAttr,                     // ParamAttr : 'nest'
+	ParamAttr,                     // ParamAttr : 'noalias'
+	ParamAttr,                     // ParamAttr : 'nocapture'
+	ParamAttr,                     // ParamAttr : 'nofree'
+	ParamAttr,                     // ParamAttr : 'nonnull'
+	ParamAttr,                     // ParamAttr : 'noundef'
+	ParamAttr,                     // ParamAttr : 'readnone'
+	ParamAttr,                     // ParamAttr : 'readonly'
+	ParamAttr,                     // ParamAttr : 'returned'
+	ParamAttr,                     // ParamAttr : 'signext'
+	ParamAttr,                     // ParamAttr : 'swiftasync'
+	ParamAttr,                     // ParamAttr : 'swifterror'
+	ParamAttr,                     // ParamAttr : 'swiftself'
+	ParamAttr,                     // ParamAttr : 'writeonly'
+	ParamAttr,                     // ParamAttr : 'zeroext'
+	ByRefAttr,                     // ByRefAttr : 'byref' '(' Type ')'
+	Partition,                     // Partition : 'partition' StringLit
+	Preallocated,                  // Preallocated : 'preallocated' '(' Type ')'
+	Preemption,                    // Preemption : 'dso_local'
+	Preemption,                    // Preemption : 'dso_preemptable'
+	StructRetAttr,                 // StructRetAttr : 'sret' '(' Type ')'
+	0,                             // ReturnAttribute : Dereferenceable
+	0,                             // ReturnAttribute : ReturnAttr
+	ReturnAttr,                    // ReturnAttr : Align
+	ReturnAttr,                    // ReturnAttr : 'inreg'
+	ReturnAttr,                    // ReturnAttr : 'noalias'
+	ReturnAttr,                    // ReturnAttr : 'nonnull'
+	ReturnAttr,                    // ReturnAttr : 'noundef'
+	ReturnAttr,                    // ReturnAttr : 'signext'
+	ReturnAttr,                    // ReturnAttr : 'zeroext'
+	Section,                       // Section : 'section' StringLit
+	SyncScope,                     // SyncScope : 'syncscope' '(' StringLit ')'
+	ThreadLocal,                   // ThreadLocal : 'thread_local'
+	ThreadLocal,                   // ThreadLocal : 'thread_local' '(' TLSModel ')'
+	TLSModel,                      // TLSModel : 'initialexec'
+	TLSModel,                      // TLSModel : 'localdynamic'
+	TLSModel,                      // TLSModel : 'localexec'
+	TypeConst,                     // TypeConst : FirstClassType Constant
+	TypeValue,                     // TypeValue : FirstClassType Value
+	UnnamedAddr,                   // UnnamedAddr : 'local_unnamed_addr'
+	UnnamedAddr,                   // UnnamedAddr : 'unnamed_addr'
+	0,                             // UnwindTarget : UnwindToCaller
+	0,                             // UnwindTarget : Label
+	UnwindToCaller,                // UnwindToCaller : 'to' 'caller'
+	Visibility,                    // Visibility : 'default'
+	Visibility,                    // Visibility : 'hidden'
+	Visibility,                    // Visibility : 'protected'
+	Volatile,                      // Volatile : 'volatile'
+	VScaleRangetok,                // VScaleRangetok : 'vscale_range'
+	VScaleRange,                   // VScaleRange : 'vscale_range' '(' UintLit ')'
+	VScaleRange,                   // VScaleRange : 'vscale_range' '(' UintLit ',' UintLit ')'
+	0,                             // Preemptionopt : Preemption
+	0,                             // Preemptionopt :
+	0,                             // Visibilityopt : Visibility
+	0,                             // Visibilityopt :
+	0,                             // DLLStorageClassopt : DLLStorageClass
+	0,                             // DLLStorageClassopt :
+	0,                             // ThreadLocalopt : ThreadLocal
+	0,                             // ThreadLocalopt :
+	0,                             // UnnamedAddropt : UnnamedAddr
+	0,                             // UnnamedAddropt :
+	0,                             // AddrSpaceopt : AddrSpace
+	0,                             // AddrSpaceopt :
+	0,                             // ExternallyInitializedopt : ExternallyInitialized
+	0,                             // ExternallyInitializedopt :
+	0,                             // Linkageopt : Linkage
+	0,                             // Linkageopt :
+	0,                             // CallingConvopt : CallingConv
+	0,                             // CallingConvopt :
+	0,                             // Distinctopt : Distinct
+	0,                             // Distinctopt :
+	0,                             // SideEffectopt : SideEffect
+	0,                             // SideEffectopt :
+	0,                             // AlignStackTokopt : AlignStackTok
+	0,                             // AlignStackTokopt :
+	0,                             // IntelDialectopt : IntelDialect
+	0,                             // IntelDialectopt :
+	0,                             // Unwindopt : Unwind
+	0,                             // Unwindopt :
+	0,                             // Exactopt : Exact
+	0,                             // Exactopt :
+	0,                             // InBoundsopt : InBounds
+	0,                             // InBoundsopt :
+	0,                             // InRangeopt : InRange
+	0,                             // InRangeopt :
+	0,                             // LabelIdentopt : LabelIdent
+	0,                             // LabelIdentopt :
+	0,                             // InAllocatokopt : InAllocatok
+	0,                             // InAllocatokopt :
+	0,                             // SwiftErroropt : SwiftError
+	0,                             // SwiftErroropt :
+	0,                             // Volatileopt : Volatile
+	0,                             // Volatileopt :
+	0,                             // SyncScopeopt : SyncScope
+	0,                             // SyncScopeopt :
+	0,                             // Weakopt : Weak
+	0,                             // Weakopt :
+	0,                             // Tailopt : Tail
+	0,                             // Tailopt :
+	0,                             // Cleanupopt : Cleanup
+	0,                             // Cleanupopt :
+	0,                             // Ellipsisopt : Ellipsis
+	0,                             // Ellipsisopt :
+}
@@ -20,6 +20,9 @@ type Alias struct {
 
 	// Pointer type of aliasee.
 	Typ *types.PointerType
+	// (optional) Content type of alias; or nil to use the element type of Typ.
+	// Required if Typ is an opaque pointer type.
+	ContentType types.Type
 	// (optional) Linkage; zero value if not present.
 	Linkage enum.Linkage
 	// (optional) Preemption; zero value if not present.
@@ -90,7 +93,7 @@ func (a *Alias) LLString() string {
 		fmt.Fprintf(buf, " %s", a.UnnamedAddr)
 	}
 	buf.WriteString(" alias")
-	fmt.Fprintf(buf, " %s, ", a.Typ.ElemType)
+	fmt.Fprintf(buf, " %s, ", indirectContentType(a.ContentType, a.Type(), a.Ident()))
 	if expr, ok := a.Aliasee.(constant.Expression); ok {
 		buf.WriteString(expr.Ident())
 	} else {
@@ -0,0 +1,241 @@
+package ir
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/umaumax/llvm/ir/metadata"
+	"github.com/umaumax/llvm/ir/types"
+	"github.com/umaumax/llvm/ir/value"
+)
+
+// === [ Annotation writers ] ==================================================
+
+// AnnotationWriter is a hook which injects comments into the LLVM IR assembly
+// written by Module.WriteToOptions.
+//
+// Annotations written before functions and instructions, and after basic
+// blocks, are placed on lines of their own (e.g. "; comment\n"); annotations
+// written after basic block labels and instructions are placed at the end of
+// the same line (e.g. " ; comment").
+type AnnotationWriter interface {
+	// FuncAnnot writes an annotation before the given function definition or
+	// declaration.
+	FuncAnnot(w io.Writer, f *Func)
+	// BlockStartAnnot writes an annotation at the end of the label line of the
+	// given basic block.
+	BlockStartAnnot(w io.Writer, block *Block)
+	// BlockEndAnnot writes an annotation after the terminator of the given basic
+	// block.
+	BlockEndAnnot(w io.Writer, block *Block)
+	// InstAnnot writes an annotation before the given instruction or terminator.
+	InstAnnot(w io.Writer, inst LLStringer)
+	// InfoComment writes an annotation at the end of the line of the given
+	// instruction or terminator.
+	InfoComment(w io.Writer, inst LLStringer)
+}
+
+// NopAnnotationWriter is an annotation writer which writes no annotations. It
+// may be embedded by annotation writers which only implement a subset of the
+// hooks.
+type NopAnnotationWriter struct{}
+
+// FuncAnnot writes no annotation before the given function.
+func (NopAnnotationWriter) FuncAnnot(w io.Writer, f *Func) {}
+
+// BlockStartAnnot writes no annotation after the label of the given basic
+// block.
+func (NopAnnotationWriter) BlockStartAnnot(w io.Writer, block *Block) {}
+
+// BlockEndAnnot writes no annotation after the given basic block.
+func (NopAnnotationWriter) BlockEndAnnot(w io.Writer, block *Block) {}
+
+// InstAnnot writes no annotation before the given instruction.
+func (NopAnnotationWriter) InstAnnot(w io.Writer, inst LLStringer) {}
+
+// InfoComment writes no annotation after the given instruction.
+func (NopAnnotationWriter) InfoComment(w io.Writer, inst LLStringer) {}
+
+// MultiAnnotationWriter returns an annotation writer which invokes the hooks of
+// each of the given annotation writers in turn.
+func MultiAnnotationWriter(annotators ...AnnotationWriter) AnnotationWriter {
+	return multiAnnotationWriter(annotators)
+}
+
+// multiAnnotationWriter is a list of annotation writers.
+type multiAnnotationWriter []AnnotationWriter
+
+// FuncAnnot writes the annotations before the given function.
+func (as multiAnnotationWriter) FuncAnnot(w io.Writer, f *Func) {
+	for _, a := range as {
+		a.FuncAnnot(w, f)
+	}
+}
+
+// BlockStartAnnot writes the annotations after the label of the given basic
+// block.
+func (as multiAnnotationWriter) BlockStartAnnot(w io.Writer, block *Block) {
+	for _, a := range as {
+		a.BlockStartAnnot(w, block)
+	}
+}
+
+// BlockEndAnnot writes the annotations after the given basic block.
+func (as multiAnnotationWriter) BlockEndAnnot(w io.Writer, block *Block) {
+	for _, a := range as {
+		a.BlockEndAnnot(w, block)
+	}
+}
+
+// InstAnnot writes the annotations before the given instruction.
+func (as multiAnnotationWriter) InstAnnot(w io.Writer, inst LLStringer) {
+	for _, a := range as {
+		a.InstAnnot(w, inst)
+	}
+}
+
+// InfoComment writes the annotations after the given instruction.
+func (as multiAnnotationWriter) InfoComment(w io.Writer, inst LLStringer) {
+	for _, a := range as {
+		a.InfoComment(w, inst)
+	}
+}
+
+// --- [ Predecessors ] --------------------------------------------------------
+
+// PredsAnnotator is an annotation writer which annotates basic blocks with
+// their predecessors (e.g. "; preds = %entry, %loop").
+type PredsAnnotator struct {
+	NopAnnotationWriter
+	// preds maps from basic blocks of the current function to their
+	// predecessors.
+	preds map[*Block][]*Block
+}
+
+// NewPredsAnnotator returns a new annotation writer which annotates basic
+// blocks with their predecessors.
+func NewPredsAnnotator() *PredsAnnotator {
+	return &PredsAnnotator{}
+}
+
+// FuncAnnot records the predecessors of the basic blocks of the given function.
+func (a *PredsAnnotator) FuncAnnot(w io.Writer, f *Func) {
+	a.preds = make(map[*Block][]*Block)
+	for _, block := range f.Blocks {
+		if block.Term == nil {
+			continue
+		}
+		for _, succ := range block.Term.Succs() {
+			preds := a.preds[succ]
+			if len(preds) > 0 && preds[len(preds)-1] == block {
+				// Skip duplicate edges (e.g. switch cases with the same target).
+				continue
+			}
+			a.preds[succ] = append(preds, block)
+		}
+	}
+}
+
+// BlockStartAnnot writes the predecessors of the given basic block.
+func (a *PredsAnnotator) BlockStartAnnot(w io.Writer, block *Block) {
+	preds := a.preds[block]
+	if len(preds) == 0 {
+		return
+	}
+	var idents []string
+	for _, pred := range preds {
+		idents = append(idents, pred.Ident())
+	}
+	fmt.Fprintf(w, " ; preds = %s", strings.Join(idents, ", "))
+}
+
+// --- [ Use counts ] ----------------------------------------------------------
+
+// UsesAnnotator is an annotation writer which annotates the results of
+// instructions with their number of uses within the function (e.g.
+// "; uses = 2").
+type UsesAnnotator struct {
+	NopAnnotationWriter
+	// uses maps from results of instructions and terminators of the current
+	// function to their number of uses.
+	uses map[value.Value]int
+}
+
+// NewUsesAnnotator returns a new annotation writer which annotates the results
+// of instructions with their number of uses.
+func NewUsesAnnotator() *UsesAnnotator {
+	return &UsesAnnotator{}
+}
+
+// FuncAnnot records the number of uses of the local values of the given
+// function.
+func (a *UsesAnnotator) FuncAnnot(w io.Writer, f *Func) {
+	a.uses = make(map[value.Value]int)
+	count := func(ops []*value.Value) {
+		for _, op := range ops {
+			a.uses[*op]++
+		}
+	}
+	for _, block := range f.Blocks {
+		for _, inst := range block.Insts {
+			count(inst.Operands())
+		}
+		if block.Term != nil {
+			count(block.Term.Operands())
+		}
+	}
+}
+
+// InfoComment writes the number of uses of the result of the given
+// instruction.
+func (a *UsesAnnotator) InfoComment(w io.Writer, inst LLStringer) {
+	v, ok := inst.(value.Value)
+	if !ok || types.IsVoid(v.Type()) {
+		return
+	}
+	fmt.Fprintf(w, " ; uses = %d", a.uses[v])
+}
+
+// --- [ Debug locations ] -----------------------------------------------------
+
+// DebugLocAnnotator is an annotation writer which annotates instructions with
+// the source location of their !dbg attachment (e.g. "; foo.c:12:3").
+type DebugLocAnnotator struct {
+	NopAnnotationWriter
+}
+
+// NewDebugLocAnnotator returns a new annotation writer which annotates
+// instructions with their source location.
+func NewDebugLocAnnotator() *DebugLocAnnotator {
+	return &DebugLocAnnotator{}
+}
+
+// InfoComment writes the source location of the given instruction.
+func (a *DebugLocAnnotator) InfoComment(w io.Writer, inst LLStringer) {
+	if loc, ok := InstSourceLoc(inst); ok {
+		fmt.Fprintf(w, " ; %s", loc)
+	}
+}
+
+// scopeFile returns the source file of the given debug information scope; or
+// nil if not present.
+func scopeFile(scope metadata.Field) *metadata.DIFile {
+	switch scope := scope.(type) {
+	case *metadata.DIFile:
+		return scope
+	case *metadata.DISubprogram:
+		return scope.File
+	case *metadata.DILexicalBlock:
+		if scope.File != nil {
+			return scope.File
+		}
+		return scopeFile(scope.Scope)
+	case *metadata.DILexicalBlockFile:
+		if scope.File != nil {
+			return scope.File
+		}
+		return scopeFile(scope.Scope)
+	}
+	return nil
+}
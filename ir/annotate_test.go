@@ -0,0 +1,111 @@
+package ir_test
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/umaumax/llvm/asm"
+	"github.com/umaumax/llvm/ir"
+)
+
+func TestAnnotationWriter(t *testing.T) {
+	const input = "" +
+		"define i32 @f(i32 %n) !dbg !4 {\n" +
+		"entry:\n" +
+		"  br label %loop\n" +
+		"loop:\n" +
+		"  %i = phi i32 [ 0, %entry ], [ %j, %loop ]\n" +
+		"  %j = add i32 %i, 1, !dbg !6\n" +
+		"  %c = icmp slt i32 %j, %n\n" +
+		"  br i1 %c, label %loop, label %exit\n" +
+		"exit:\n" +
+		"  ret i32 %j\n" +
+		"}\n" +
+		"\n" +
+		"!llvm.dbg.cu = !{!0}\n" +
+		"!llvm.module.flags = !{!3}\n" +
+		"\n" +
+		"!0 = distinct !DICompileUnit(language: DW_LANG_C99, file: !1, emissionKind: FullDebug)\n" +
+		"!1 = !DIFile(filename: \"foo.c\", directory: \"/tmp\")\n" +
+		"!3 = !{i32 2, !\"Debug Info Version\", i32 3}\n" +
+		"!4 = distinct !DISubprogram(name: \"f\", scope: !1, file: !1, line: 1, type: !5, unit: !0)\n" +
+		"!5 = !DISubroutineType(types: !{})\n" +
+		"!6 = !DILocation(line: 3, column: 7, scope: !4)\n"
+	// Only compare function definitions, as metadata is written verbatim.
+	const want = "" +
+		"define i32 @f(i32 %n) !dbg !4 {\n" +
+		"entry:\n" +
+		"\tbr label %loop\n" +
+		"\n" +
+		"loop: ; preds = %entry, %loop\n" +
+		"\t%i = phi i32 [ 0, %entry ], [ %j, %loop ] ; uses = 1\n" +
+		"\t%j = add i32 %i, 1, !dbg !6 ; uses = 3 ; foo.c:3:7\n" +
+		"\t%c = icmp slt i32 %j, %n ; uses = 1\n" +
+		"\tbr i1 %c, label %loop, label %exit\n" +
+		"\n" +
+		"exit: ; preds = %loop\n" +
+		"\tret i32 %j\n" +
+		"}\n"
+	m, err := asm.ParseString("<input>", input)
+	if err != nil {
+		t.Fatalf("unable to parse input; %+v", err)
+	}
+	annotator := ir.MultiAnnotationWriter(ir.NewPredsAnnotator(), ir.NewUsesAnnotator(), ir.NewDebugLocAnnotator())
+	buf := &strings.Builder{}
+	if _, err := m.WriteToOptions(buf, &ir.WriteOptions{Annotator: annotator}); err != nil {
+		t.Fatalf("unable to write module; %+v", err)
+	}
+	got := buf.String()
+	if !strings.HasPrefix(got, want) {
+		t.Errorf("output mismatch; expected prefix %q, got %q", want, got)
+	}
+	// Annotated output is valid LLVM IR assembly.
+	if _, err := asm.ParseString("<output>", got); err != nil {
+		t.Errorf("unable to parse annotated output; %+v", err)
+	}
+}
+
+// lineAnnotator annotates functions, instructions and basic blocks with
+// comments on lines of their own.
+type lineAnnotator struct {
+	ir.NopAnnotationWriter
+}
+
+func (lineAnnotator) FuncAnnot(w io.Writer, f *ir.Func) {
+	fmt.Fprintf(w, "; function %s", f.Ident())
+}
+
+func (lineAnnotator) InstAnnot(w io.Writer, inst ir.LLStringer) {
+	if _, ok := inst.(ir.Terminator); ok {
+		fmt.Fprint(w, "\t; terminator\n")
+	}
+}
+
+func (lineAnnotator) BlockEndAnnot(w io.Writer, block *ir.Block) {
+	fmt.Fprintf(w, "\t; end of %s\n", block.Ident())
+}
+
+func TestAnnotationWriterLines(t *testing.T) {
+	const input = "define void @f() {\nentry:\n\tret void\n}\n"
+	const want = "" +
+		"; function @f\n" +
+		"define void @f() {\n" +
+		"entry:\n" +
+		"\t; terminator\n" +
+		"\tret void\n" +
+		"\t; end of %entry\n" +
+		"}\n"
+	m, err := asm.ParseString("<input>", input)
+	if err != nil {
+		t.Fatalf("unable to parse input; %+v", err)
+	}
+	buf := &strings.Builder{}
+	if _, err := m.WriteToOptions(buf, &ir.WriteOptions{Annotator: lineAnnotator{}}); err != nil {
+		t.Fatalf("unable to write module; %+v", err)
+	}
+	if got := buf.String(); got != want {
+		t.Errorf("output mismatch; expected %q, got %q", want, got)
+	}
+}
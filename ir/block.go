@@ -7,7 +7,6 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/umaumax/llvm/internal/enc"
 	"github.com/umaumax/llvm/ir/types"
 )
 
@@ -51,19 +50,39 @@ func (block *Block) Type() types.Type {
 // LLString returns the LLVM syntax representation of the basic block
 // definition.
 func (block *Block) LLString() string {
-	// Name=LabelIdentopt Insts=Instruction* Term=Terminator
 	buf := &strings.Builder{}
-	if block.IsUnnamed() {
-		fmt.Fprintf(buf, "; <label>:%d\n", block.LocalID)
-	} else {
-		fmt.Fprintf(buf, "%s\n", enc.Label(block.LocalName))
-	}
-	for _, inst := range block.Insts {
-		fmt.Fprintf(buf, "\t%s\n", inst.LLString())
+	w := newWriter(buf, nil)
+	w.writeBlock(block)
+	return buf.String()
+}
+
+// appendInst appends the given instruction to the basic block, and adds its
+// result to the symbol table of the parent function.
+func (block *Block) appendInst(inst Instruction) {
+	block.Insts = append(block.Insts, inst)
+	block.addLocal(inst)
+}
+
+// setTerm sets the terminator of the basic block, and adds its result to the
+// symbol table of the parent function.
+func (block *Block) setTerm(term Terminator) {
+	block.Term = term
+	block.addLocal(term)
+}
+
+// addLocal adds the result of the given instruction or terminator of the basic
+// block to the symbol table of the parent function, building the symbol table
+// if not yet built.
+func (block *Block) addLocal(inst interface{}) {
+	f := block.Parent
+	if f == nil {
+		return
 	}
-	if block.Term == nil {
-		panic(fmt.Sprintf("missing terminator in basic block %q.\ncurrent instructions:\n%s", block.Name(), buf.String()))
+	f.localSyms.mu.Lock()
+	defer f.localSyms.mu.Unlock()
+	if f.localSyms.build(f.indexLocals) {
+		// The instruction is already indexed.
+		return
 	}
-	fmt.Fprintf(buf, "\t%s", block.Term.LLString())
-	return buf.String()
+	f.addInst(&f.localSyms, inst, len(f.Blocks)-1)
 }
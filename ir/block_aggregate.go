@@ -12,7 +12,7 @@ import (
 // based on the given aggregate value and indicies.
 func (block *Block) NewExtractValue(x value.Value, indices ...uint64) *InstExtractValue {
 	inst := NewExtractValue(x, indices...)
-	block.Insts = append(block.Insts, inst)
+	block.appendInst(inst)
 	return inst
 }
 
@@ -22,6 +22,6 @@ func (block *Block) NewExtractValue(x value.Value, indices ...uint64) *InstExtra
 // on the given aggregate value, element and indicies.
 func (block *Block) NewInsertValue(x, elem value.Value, indices ...uint64) *InstInsertValue {
 	inst := NewInsertValue(x, elem, indices...)
-	block.Insts = append(block.Insts, inst)
+	block.appendInst(inst)
 	return inst
 }
@@ -13,7 +13,7 @@ import (
 // given source value and target type.
 func (block *Block) NewTrunc(from value.Value, to types.Type) *InstTrunc {
 	inst := NewTrunc(from, to)
-	block.Insts = append(block.Insts, inst)
+	block.appendInst(inst)
 	return inst
 }
 
@@ -23,7 +23,7 @@ func (block *Block) NewTrunc(from value.Value, to types.Type) *InstTrunc {
 // source value and target type.
 func (block *Block) NewZExt(from value.Value, to types.Type) *InstZExt {
 	inst := NewZExt(from, to)
-	block.Insts = append(block.Insts, inst)
+	block.appendInst(inst)
 	return inst
 }
 
@@ -33,7 +33,7 @@ func (block *Block) NewZExt(from value.Value, to types.Type) *InstZExt {
 // source value and target type.
 func (block *Block) NewSExt(from value.Value, to types.Type) *InstSExt {
 	inst := NewSExt(from, to)
-	block.Insts = append(block.Insts, inst)
+	block.appendInst(inst)
 	return inst
 }
 
@@ -43,7 +43,7 @@ func (block *Block) NewSExt(from value.Value, to types.Type) *InstSExt {
 // given source value and target type.
 func (block *Block) NewFPTrunc(from value.Value, to types.Type) *InstFPTrunc {
 	inst := NewFPTrunc(from, to)
-	block.Insts = append(block.Insts, inst)
+	block.appendInst(inst)
 	return inst
 }
 
@@ -53,7 +53,7 @@ func (block *Block) NewFPTrunc(from value.Value, to types.Type) *InstFPTrunc {
 // given source value and target type.
 func (block *Block) NewFPExt(from value.Value, to types.Type) *InstFPExt {
 	inst := NewFPExt(from, to)
-	block.Insts = append(block.Insts, inst)
+	block.appendInst(inst)
 	return inst
 }
 
@@ -63,7 +63,7 @@ func (block *Block) NewFPExt(from value.Value, to types.Type) *InstFPExt {
 // given source value and target type.
 func (block *Block) NewFPToUI(from value.Value, to types.Type) *InstFPToUI {
 	inst := NewFPToUI(from, to)
-	block.Insts = append(block.Insts, inst)
+	block.appendInst(inst)
 	return inst
 }
 
@@ -73,7 +73,7 @@ func (block *Block) NewFPToUI(from value.Value, to types.Type) *InstFPToUI {
 // given source value and target type.
 func (block *Block) NewFPToSI(from value.Value, to types.Type) *InstFPToSI {
 	inst := NewFPToSI(from, to)
-	block.Insts = append(block.Insts, inst)
+	block.appendInst(inst)
 	return inst
 }
 
@@ -83,7 +83,7 @@ func (block *Block) NewFPToSI(from value.Value, to types.Type) *InstFPToSI {
 // given source value and target type.
 func (block *Block) NewUIToFP(from value.Value, to types.Type) *InstUIToFP {
 	inst := NewUIToFP(from, to)
-	block.Insts = append(block.Insts, inst)
+	block.appendInst(inst)
 	return inst
 }
 
@@ -93,7 +93,7 @@ func (block *Block) NewUIToFP(from value.Value, to types.Type) *InstUIToFP {
 // given source value and target type.
 func (block *Block) NewSIToFP(from value.Value, to types.Type) *InstSIToFP {
 	inst := NewSIToFP(from, to)
-	block.Insts = append(block.Insts, inst)
+	block.appendInst(inst)
 	return inst
 }
 
@@ -103,7 +103,7 @@ func (block *Block) NewSIToFP(from value.Value, to types.Type) *InstSIToFP {
 // the given source value and target type.
 func (block *Block) NewPtrToInt(from value.Value, to types.Type) *InstPtrToInt {
 	inst := NewPtrToInt(from, to)
-	block.Insts = append(block.Insts, inst)
+	block.appendInst(inst)
 	return inst
 }
 
@@ -113,7 +113,7 @@ func (block *Block) NewPtrToInt(from value.Value, to types.Type) *InstPtrToInt {
 // the given source value and target type.
 func (block *Block) NewIntToPtr(from value.Value, to types.Type) *InstIntToPtr {
 	inst := NewIntToPtr(from, to)
-	block.Insts = append(block.Insts, inst)
+	block.appendInst(inst)
 	return inst
 }
 
@@ -123,7 +123,7 @@ func (block *Block) NewIntToPtr(from value.Value, to types.Type) *InstIntToPtr {
 // given source value and target type.
 func (block *Block) NewBitCast(from value.Value, to types.Type) *InstBitCast {
 	inst := NewBitCast(from, to)
-	block.Insts = append(block.Insts, inst)
+	block.appendInst(inst)
 	return inst
 }
 
@@ -133,6 +133,6 @@ func (block *Block) NewBitCast(from value.Value, to types.Type) *InstBitCast {
 // based on the given source value and target type.
 func (block *Block) NewAddrSpaceCast(from value.Value, to types.Type) *InstAddrSpaceCast {
 	inst := NewAddrSpaceCast(from, to)
-	block.Insts = append(block.Insts, inst)
+	block.appendInst(inst)
 	return inst
 }
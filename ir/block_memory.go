@@ -11,20 +11,24 @@ import (
 // ~~~ [ alloca ] ~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~
 
 // NewAlloca appends a new alloca instruction to the basic block based on the
-// given element type.
+// given element type. The result is of opaque pointer type if the type context
+// of the parent module is in opaque pointer mode.
 func (block *Block) NewAlloca(elemType types.Type) *InstAlloca {
 	inst := NewAlloca(elemType)
-	block.Insts = append(block.Insts, inst)
+	if block.Parent != nil && block.Parent.Parent != nil {
+		inst.Typ = block.Parent.Parent.TypeContext.Pointer(elemType, 0)
+	}
+	block.appendInst(inst)
 	return inst
 }
 
 // ~~~ [ load ] ~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~
 
 // NewLoad appends a new load instruction to the basic block based on the given
-// source address.
-func (block *Block) NewLoad(src value.Value) *InstLoad {
-	inst := NewLoad(src)
-	block.Insts = append(block.Insts, inst)
+// element type and source address.
+func (block *Block) NewLoad(elemType types.Type, src value.Value) *InstLoad {
+	inst := NewLoad(elemType, src)
+	block.appendInst(inst)
 	return inst
 }
 
@@ -34,7 +38,7 @@ func (block *Block) NewLoad(src value.Value) *InstLoad {
 // given source value and destination address.
 func (block *Block) NewStore(src, dst value.Value) *InstStore {
 	inst := NewStore(src, dst)
-	block.Insts = append(block.Insts, inst)
+	block.appendInst(inst)
 	return inst
 }
 
@@ -44,7 +48,7 @@ func (block *Block) NewStore(src, dst value.Value) *InstStore {
 // given atomic ordering.
 func (block *Block) NewFence(ordering enum.AtomicOrdering) *InstFence {
 	inst := NewFence(ordering)
-	block.Insts = append(block.Insts, inst)
+	block.appendInst(inst)
 	return inst
 }
 
@@ -55,7 +59,7 @@ func (block *Block) NewFence(ordering enum.AtomicOrdering) *InstFence {
 // orderings for success and failure.
 func (block *Block) NewCmpXchg(ptr, cmp, new value.Value, successOrdering, failureOrdering enum.AtomicOrdering) *InstCmpXchg {
 	inst := NewCmpXchg(ptr, cmp, new, successOrdering, failureOrdering)
-	block.Insts = append(block.Insts, inst)
+	block.appendInst(inst)
 	return inst
 }
 
@@ -65,16 +69,16 @@ func (block *Block) NewCmpXchg(ptr, cmp, new value.Value, successOrdering, failu
 // the given atomic operation, destination address, operand and atomic ordering.
 func (block *Block) NewAtomicRMW(op enum.AtomicOp, dst, x value.Value, ordering enum.AtomicOrdering) *InstAtomicRMW {
 	inst := NewAtomicRMW(op, dst, x, ordering)
-	block.Insts = append(block.Insts, inst)
+	block.appendInst(inst)
 	return inst
 }
 
 // ~~~ [ getelementptr ] ~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~
 
 // NewGetElementPtr appends a new getelementptr instruction to the basic block
-// based on the given source address and element indices.
-func (block *Block) NewGetElementPtr(src value.Value, indices ...value.Value) *InstGetElementPtr {
-	inst := NewGetElementPtr(src, indices...)
-	block.Insts = append(block.Insts, inst)
+// based on the given element type, source address and element indices.
+func (block *Block) NewGetElementPtr(elemType types.Type, src value.Value, indices ...value.Value) *InstGetElementPtr {
+	inst := NewGetElementPtr(elemType, src, indices...)
+	block.appendInst(inst)
 	return inst
 }
@@ -14,7 +14,7 @@ import (
 // integer comparison predicate and integer scalar or vector operands.
 func (block *Block) NewICmp(pred enum.IPred, x, y value.Value) *InstICmp {
 	inst := NewICmp(pred, x, y)
-	block.Insts = append(block.Insts, inst)
+	block.appendInst(inst)
 	return inst
 }
 
@@ -25,7 +25,7 @@ func (block *Block) NewICmp(pred enum.IPred, x, y value.Value) *InstICmp {
 // operands.
 func (block *Block) NewFCmp(pred enum.FPred, x, y value.Value) *InstFCmp {
 	inst := NewFCmp(pred, x, y)
-	block.Insts = append(block.Insts, inst)
+	block.appendInst(inst)
 	return inst
 }
 
@@ -35,7 +35,7 @@ func (block *Block) NewFCmp(pred enum.FPred, x, y value.Value) *InstFCmp {
 // incoming values.
 func (block *Block) NewPhi(incs ...*Incoming) *InstPhi {
 	inst := NewPhi(incs...)
-	block.Insts = append(block.Insts, inst)
+	block.appendInst(inst)
 	return inst
 }
 
@@ -45,7 +45,17 @@ func (block *Block) NewPhi(incs ...*Incoming) *InstPhi {
 // given selection condition and operands.
 func (block *Block) NewSelect(cond, x, y value.Value) *InstSelect {
 	inst := NewSelect(cond, x, y)
-	block.Insts = append(block.Insts, inst)
+	block.appendInst(inst)
+	return inst
+}
+
+// ~~~ [ freeze ] ~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~
+
+// NewFreeze appends a new freeze instruction to the basic block based on the
+// given operand.
+func (block *Block) NewFreeze(x value.Value) *InstFreeze {
+	inst := NewFreeze(x)
+	block.appendInst(inst)
 	return inst
 }
 
@@ -57,7 +67,7 @@ func (block *Block) NewSelect(cond, x, y value.Value) *InstSelect {
 // TODO: specify the set of underlying types of callee.
 func (block *Block) NewCall(callee value.Value, args ...value.Value) *InstCall {
 	inst := NewCall(callee, args...)
-	block.Insts = append(block.Insts, inst)
+	block.appendInst(inst)
 	return inst
 }
 
@@ -67,7 +77,7 @@ func (block *Block) NewCall(callee value.Value, args ...value.Value) *InstCall {
 // given variable argument list and argument type.
 func (block *Block) NewVAArg(vaList value.Value, argType types.Type) *InstVAArg {
 	inst := NewVAArg(vaList, argType)
-	block.Insts = append(block.Insts, inst)
+	block.appendInst(inst)
 	return inst
 }
 
@@ -77,7 +87,7 @@ func (block *Block) NewVAArg(vaList value.Value, argType types.Type) *InstVAArg
 // on the given result type and filter/catch clauses.
 func (block *Block) NewLandingPad(resultType types.Type, clauses ...*Clause) *InstLandingPad {
 	inst := NewLandingPad(resultType, clauses...)
-	block.Insts = append(block.Insts, inst)
+	block.appendInst(inst)
 	return inst
 }
 
@@ -87,7 +97,7 @@ func (block *Block) NewLandingPad(resultType types.Type, clauses ...*Clause) *In
 // the given exception scope and exception arguments.
 func (block *Block) NewCatchPad(scope *TermCatchSwitch, args ...value.Value) *InstCatchPad {
 	inst := NewCatchPad(scope, args...)
-	block.Insts = append(block.Insts, inst)
+	block.appendInst(inst)
 	return inst
 }
 
@@ -97,6 +107,6 @@ func (block *Block) NewCatchPad(scope *TermCatchSwitch, args ...value.Value) *In
 // on the given exception scope and exception arguments.
 func (block *Block) NewCleanupPad(scope ExceptionScope, args ...value.Value) *InstCleanupPad {
 	inst := NewCleanupPad(scope, args...)
-	block.Insts = append(block.Insts, inst)
+	block.appendInst(inst)
 	return inst
 }
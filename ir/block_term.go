@@ -13,7 +13,7 @@ import (
 // on the given return value. A nil return value indicates a void return.
 func (block *Block) NewRet(x value.Value) *TermRet {
 	term := NewRet(x)
-	block.Term = term
+	block.setTerm(term)
 	return term
 }
 
@@ -23,7 +23,7 @@ func (block *Block) NewRet(x value.Value) *TermRet {
 // terminator based on the given target basic block.
 func (block *Block) NewBr(target *Block) *TermBr {
 	term := NewBr(target)
-	block.Term = term
+	block.setTerm(term)
 	return term
 }
 
@@ -34,7 +34,7 @@ func (block *Block) NewBr(target *Block) *TermBr {
 // basic blocks.
 func (block *Block) NewCondBr(cond value.Value, targetTrue, targetFalse *Block) *TermCondBr {
 	term := NewCondBr(cond, targetTrue, targetFalse)
-	block.Term = term
+	block.setTerm(term)
 	return term
 }
 
@@ -45,7 +45,7 @@ func (block *Block) NewCondBr(cond value.Value, targetTrue, targetFalse *Block)
 // cases.
 func (block *Block) NewSwitch(x value.Value, targetDefault *Block, cases ...*Case) *TermSwitch {
 	term := NewSwitch(x, targetDefault, cases...)
-	block.Term = term
+	block.setTerm(term)
 	return term
 }
 
@@ -56,7 +56,7 @@ func (block *Block) NewSwitch(x value.Value, targetDefault *Block, cases ...*Cas
 // constant) and set of valid target basic blocks.
 func (block *Block) NewIndirectBr(addr constant.Constant, validTargets ...*Block) *TermIndirectBr {
 	term := NewIndirectBr(addr, validTargets...)
-	block.Term = term
+	block.setTerm(term)
 	return term
 }
 
@@ -69,7 +69,20 @@ func (block *Block) NewIndirectBr(addr constant.Constant, validTargets ...*Block
 // TODO: specify the set of underlying types of invokee.
 func (block *Block) NewInvoke(invokee value.Value, args []value.Value, normal, exception *Block) *TermInvoke {
 	term := NewInvoke(invokee, args, normal, exception)
-	block.Term = term
+	block.setTerm(term)
+	return term
+}
+
+// ~~~ [ callbr ] ~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~
+
+// NewCallBr sets the terminator of the basic block to a new callbr terminator
+// based on the given callee, function arguments and control flow return points
+// for normal and indirect execution.
+//
+// TODO: specify the set of underlying types of callee.
+func (block *Block) NewCallBr(callee value.Value, args []value.Value, normal *Block, indirectTargets ...*Block) *TermCallBr {
+	term := NewCallBr(callee, args, normal, indirectTargets...)
+	block.setTerm(term)
 	return term
 }
 
@@ -79,7 +92,7 @@ func (block *Block) NewInvoke(invokee value.Value, args []value.Value, normal, e
 // based on the given exception argument to propagate.
 func (block *Block) NewResume(x value.Value) *TermResume {
 	term := NewResume(x)
-	block.Term = term
+	block.setTerm(term)
 	return term
 }
 
@@ -90,7 +103,7 @@ func (block *Block) NewResume(x value.Value) *TermResume {
 // target.
 func (block *Block) NewCatchSwitch(scope ExceptionScope, handlers []*Block, unwindTarget UnwindTarget) *TermCatchSwitch {
 	term := NewCatchSwitch(scope, handlers, unwindTarget)
-	block.Term = term
+	block.setTerm(term)
 	return term
 }
 
@@ -100,7 +113,7 @@ func (block *Block) NewCatchSwitch(scope ExceptionScope, handlers []*Block, unwi
 // terminator based on the given exit catchpad and target basic block.
 func (block *Block) NewCatchRet(from *InstCatchPad, to *Block) *TermCatchRet {
 	term := NewCatchRet(from, to)
-	block.Term = term
+	block.setTerm(term)
 	return term
 }
 
@@ -110,7 +123,7 @@ func (block *Block) NewCatchRet(from *InstCatchPad, to *Block) *TermCatchRet {
 // terminator based on the given exit cleanuppad and unwind target.
 func (block *Block) NewCleanupRet(from *InstCleanupPad, to UnwindTarget) *TermCleanupRet {
 	term := NewCleanupRet(from, to)
-	block.Term = term
+	block.setTerm(term)
 	return term
 }
 
@@ -120,6 +133,6 @@ func (block *Block) NewCleanupRet(from *InstCleanupPad, to UnwindTarget) *TermCl
 // terminator.
 func (block *Block) NewUnreachable() *TermUnreachable {
 	term := NewUnreachable()
-	block.Term = term
+	block.setTerm(term)
 	return term
 }
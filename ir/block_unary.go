@@ -12,6 +12,6 @@ import (
 // operand.
 func (block *Block) NewFNeg(x value.Value) *InstFNeg {
 	inst := NewFNeg(x)
-	block.Insts = append(block.Insts, inst)
+	block.appendInst(inst)
 	return inst
 }
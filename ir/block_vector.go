@@ -12,7 +12,7 @@ import (
 // based on the given vector and element index.
 func (block *Block) NewExtractElement(x, index value.Value) *InstExtractElement {
 	inst := NewExtractElement(x, index)
-	block.Insts = append(block.Insts, inst)
+	block.appendInst(inst)
 	return inst
 }
 
@@ -22,7 +22,7 @@ func (block *Block) NewExtractElement(x, index value.Value) *InstExtractElement
 // based on the given vector, element and element index.
 func (block *Block) NewInsertElement(x, elem, index value.Value) *InstInsertElement {
 	inst := NewInsertElement(x, elem, index)
-	block.Insts = append(block.Insts, inst)
+	block.appendInst(inst)
 	return inst
 }
 
@@ -32,6 +32,6 @@ func (block *Block) NewInsertElement(x, elem, index value.Value) *InstInsertElem
 // based on the given vectors and shuffle mask.
 func (block *Block) NewShuffleVector(x, y, mask value.Value) *InstShuffleVector {
 	inst := NewShuffleVector(x, y, mask)
-	block.Insts = append(block.Insts, inst)
+	block.appendInst(inst)
 	return inst
 }
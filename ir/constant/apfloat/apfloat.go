@@ -0,0 +1,351 @@
+// Package apfloat implements arbitrary precision floating-point values in the
+// binary interchange formats of LLVM IR floating-point types.
+//
+// A floating-point value is stored as its bit pattern in a given format, which
+// ensures that every value, including NaN payloads and non-canonical ppc_fp128
+// pairs, survives a round-trip through the package bit-exactly. Arithmetic is
+// computed on exact rational values, which are rounded to the target format
+// according to a rounding mode.
+package apfloat
+
+import (
+	"math"
+	"math/big"
+)
+
+// Float is an arbitrary precision floating-point value of a given format. The
+// zero value is not valid; use the From* functions to create values. Floats
+// are immutable.
+type Float struct {
+	// Floating-point format.
+	sem *Semantics
+	// Bit pattern of the floating-point value.
+	bits *big.Int
+}
+
+// FromBits returns a new floating-point value of the given format based on the
+// given bit pattern. Only the low-order sem.Width() bits are used.
+//
+// The bit pattern of an IEEE 754 format has the sign bit as its most
+// significant bit. The bit pattern of a double-double (ppc_fp128) has the high
+// order double in the low 64 bits and the low order double in the high 64 bits.
+func FromBits(sem *Semantics, bits *big.Int) *Float {
+	mask := lowMask(sem.width)
+	return &Float{sem: sem, bits: new(big.Int).And(bits, mask)}
+}
+
+// FromFloat64 returns a new floating-point value of the given format based on
+// the given double precision floating-point value, rounded according to mode.
+func FromFloat64(sem *Semantics, x float64, mode RoundingMode) (*Float, big.Accuracy) {
+	bits := new(big.Int).SetUint64(math.Float64bits(x))
+	return FromBits(Double, bits).Convert(sem, mode)
+}
+
+// FromBig returns a new floating-point value of the given format based on the
+// given arbitrary precision floating-point value, rounded according to mode.
+func FromBig(sem *Semantics, x *big.Float, mode RoundingMode) (*Float, big.Accuracy) {
+	neg := x.Signbit()
+	switch {
+	case x.IsInf():
+		return Inf(sem, neg), big.Exact
+	case x.Sign() == 0:
+		return Zero(sem, neg), big.Exact
+	}
+	r, _ := x.Rat(nil)
+	return FromRat(sem, r, mode)
+}
+
+// FromRat returns a new floating-point value of the given format based on the
+// given rational number, rounded according to mode.
+func FromRat(sem *Semantics, r *big.Rat, mode RoundingMode) (*Float, big.Accuracy) {
+	if r.Sign() == 0 {
+		return Zero(sem, false), big.Exact
+	}
+	neg := r.Sign() < 0
+	return sem.round(neg, new(big.Rat).Abs(r), mode)
+}
+
+// FromInt returns a new floating-point value of the given format based on the
+// given integer, rounded according to mode.
+func FromInt(sem *Semantics, x *big.Int, mode RoundingMode) (*Float, big.Accuracy) {
+	return FromRat(sem, new(big.Rat).SetInt(x), mode)
+}
+
+// Zero returns a positive or negative zero of the given format.
+func Zero(sem *Semantics, neg bool) *Float {
+	if sem.doubleDouble {
+		return newDoubleDouble(Zero(Double, neg), Zero(Double, false))
+	}
+	return sem.encode(neg, 0, new(big.Int))
+}
+
+// Inf returns a positive or negative infinity of the given format.
+func Inf(sem *Semantics, neg bool) *Float {
+	if sem.doubleDouble {
+		return newDoubleDouble(Inf(Double, neg), Zero(Double, false))
+	}
+	mant := new(big.Int)
+	if sem.explicitInt {
+		mant.SetBit(mant, sem.precision-1, 1)
+	}
+	return sem.encode(neg, sem.expMask(), mant)
+}
+
+// NaN returns the default quiet NaN of the given format, with the given sign.
+func NaN(sem *Semantics, neg bool) *Float {
+	if sem.doubleDouble {
+		return newDoubleDouble(NaN(Double, neg), Zero(Double, false))
+	}
+	mant := new(big.Int).SetBit(new(big.Int), sem.quietBit(), 1)
+	if sem.explicitInt {
+		mant.SetBit(mant, sem.precision-1, 1)
+	}
+	return sem.encode(neg, sem.expMask(), mant)
+}
+
+// Semantics returns the format of the floating-point value.
+func (x *Float) Semantics() *Semantics {
+	return x.sem
+}
+
+// Bits returns the bit pattern of the floating-point value.
+func (x *Float) Bits() *big.Int {
+	return new(big.Int).Set(x.bits)
+}
+
+// Signbit reports whether the sign bit of the floating-point value is set.
+func (x *Float) Signbit() bool {
+	return x.decode().neg
+}
+
+// IsNaN reports whether the floating-point value is Not-a-Number.
+func (x *Float) IsNaN() bool {
+	return x.decode().class == classNaN
+}
+
+// IsInf reports whether the floating-point value is an infinity.
+func (x *Float) IsInf() bool {
+	return x.decode().class == classInf
+}
+
+// IsZero reports whether the floating-point value is a positive or negative
+// zero.
+func (x *Float) IsZero() bool {
+	return x.decode().class == classZero
+}
+
+// IsFinite reports whether the floating-point value is neither an infinity nor
+// NaN.
+func (x *Float) IsFinite() bool {
+	switch x.decode().class {
+	case classZero, classFinite:
+		return true
+	}
+	return false
+}
+
+// IsSignaling reports whether the floating-point value is a signaling NaN.
+func (x *Float) IsSignaling() bool {
+	d := x.decode()
+	if d.class != classNaN {
+		return false
+	}
+	if x.sem.doubleDouble {
+		hi, _ := x.pair()
+		return hi.IsSignaling()
+	}
+	return d.mant.Bit(x.sem.quietBit()) == 0
+}
+
+// Equal reports whether x and y have the same format and bit pattern.
+func (x *Float) Equal(y *Float) bool {
+	return x.sem == y.sem && x.bits.Cmp(y.bits) == 0
+}
+
+// Rat returns the exact value of the floating-point value as a rational number;
+// or nil if x is an infinity or NaN.
+func (x *Float) Rat() *big.Rat {
+	d := x.decode()
+	switch d.class {
+	case classInf, classNaN:
+		return nil
+	}
+	return d.rat()
+}
+
+// Big returns the exact value of the floating-point value as an arbitrary
+// precision floating-point value, and reports whether x is NaN. The sign of NaN
+// is stored in the returned zero value.
+func (x *Float) Big() (*big.Float, bool) {
+	d := x.decode()
+	switch d.class {
+	case classNaN:
+		z := new(big.Float)
+		if d.neg {
+			z.Neg(z)
+		}
+		return z, true
+	case classInf:
+		return new(big.Float).SetInf(d.neg), false
+	case classZero:
+		z := new(big.Float).SetPrec(uint(x.sem.precision))
+		if d.neg {
+			z.Neg(z)
+		}
+		return z, false
+	}
+	prec := uint(x.sem.precision)
+	if n := uint(d.mant.BitLen()); n > prec {
+		// Double-double values may require more bits than the nominal precision.
+		prec = n
+	}
+	z := new(big.Float).SetPrec(prec).SetInt(d.mant)
+	z.SetMantExp(z, d.exp)
+	if d.neg {
+		z.Neg(z)
+	}
+	return z, false
+}
+
+// Float64 returns the double precision floating-point value nearest to x.
+func (x *Float) Float64() float64 {
+	y, _ := x.Convert(Double, NearestEven)
+	return math.Float64frombits(y.bits.Uint64())
+}
+
+// Int returns the integer value of x, truncated towards zero; or nil if x is an
+// infinity or NaN.
+func (x *Float) Int() (*big.Int, big.Accuracy) {
+	r := x.Rat()
+	if r == nil {
+		return nil, big.Exact
+	}
+	z := new(big.Int).Quo(r.Num(), r.Denom())
+	if r.IsInt() {
+		return z, big.Exact
+	}
+	if r.Sign() < 0 {
+		return z, big.Above
+	}
+	return z, big.Below
+}
+
+// ### [ Helper functions ] ####################################################
+
+// class is the class of a floating-point value.
+type class uint8
+
+// Floating-point value classes.
+const (
+	classZero class = iota
+	classFinite
+	classInf
+	classNaN
+)
+
+// decoded is a decoded floating-point value, with value mant * 2^exp.
+type decoded struct {
+	class class
+	neg   bool
+	// Significand and exponent of finite values; for NaN, mant holds the
+	// significand field.
+	mant *big.Int
+	exp  int
+}
+
+// rat returns the exact value of the decoded finite floating-point value.
+func (d decoded) rat() *big.Rat {
+	r := new(big.Rat).SetInt(d.mant)
+	if d.exp >= 0 {
+		r.Num().Lsh(r.Num(), uint(d.exp))
+		r.SetFrac(r.Num(), big.NewInt(1))
+	} else {
+		r.SetFrac(d.mant, new(big.Int).Lsh(big.NewInt(1), uint(-d.exp)))
+	}
+	if d.neg {
+		r.Neg(r)
+	}
+	return r
+}
+
+// decode decodes the bit pattern of the floating-point value.
+func (x *Float) decode() decoded {
+	sem := x.sem
+	if sem.doubleDouble {
+		hi, lo := x.pair()
+		dhi := hi.decode()
+		switch dhi.class {
+		case classNaN, classInf:
+			return dhi
+		}
+		dlo := lo.decode()
+		r := dhi.rat()
+		if dlo.class == classFinite {
+			r.Add(r, dlo.rat())
+		}
+		if r.Sign() == 0 {
+			return decoded{class: classZero, neg: dhi.neg, mant: new(big.Int)}
+		}
+		return ratDecoded(r)
+	}
+	fracBits := sem.fracBits()
+	mant := new(big.Int).And(x.bits, lowMask(fracBits))
+	exp := int(new(big.Int).Rsh(x.bits, uint(fracBits)).Uint64() & uint64(sem.expMask()))
+	neg := x.bits.Bit(sem.width-1) == 1
+	d := decoded{neg: neg, mant: mant}
+	switch {
+	case exp == sem.expMask():
+		if sem.isInfMant(mant) {
+			d.class = classInf
+		} else {
+			d.class = classNaN
+		}
+		return d
+	case exp == 0:
+		if mant.Sign() == 0 {
+			d.class = classZero
+			return d
+		}
+		exp = 1
+	default:
+		if !sem.explicitInt {
+			mant.SetBit(mant, sem.precision-1, 1)
+		}
+	}
+	d.class = classFinite
+	d.exp = exp - sem.bias() - (sem.precision - 1)
+	return d
+}
+
+// ratDecoded returns the decoded floating-point value of the given non-zero
+// dyadic rational.
+func ratDecoded(r *big.Rat) decoded {
+	d := decoded{class: classFinite, neg: r.Sign() < 0}
+	den := r.Denom()
+	shift := den.BitLen() - 1
+	d.mant = new(big.Int).Abs(r.Num())
+	d.exp = -shift
+	return d
+}
+
+// pair returns the high and low order doubles of the double-double value.
+func (x *Float) pair() (hi, lo *Float) {
+	mask := lowMask(64)
+	hi = &Float{sem: Double, bits: new(big.Int).And(x.bits, mask)}
+	lo = &Float{sem: Double, bits: new(big.Int).Rsh(x.bits, 64)}
+	return hi, lo
+}
+
+// newDoubleDouble returns a new double-double value based on the given high and
+// low order doubles.
+func newDoubleDouble(hi, lo *Float) *Float {
+	bits := new(big.Int).Lsh(lo.bits, 64)
+	bits.Or(bits, hi.bits)
+	return &Float{sem: PPCDoubleDouble, bits: bits}
+}
+
+// lowMask returns a bit mask of the n low-order bits.
+func lowMask(n int) *big.Int {
+	mask := new(big.Int).Lsh(big.NewInt(1), uint(n))
+	return mask.Sub(mask, big.NewInt(1))
+}
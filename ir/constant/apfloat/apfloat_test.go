@@ -0,0 +1,120 @@
+package apfloat_test
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/umaumax/llvm/ir/constant/apfloat"
+)
+
+func TestArith(t *testing.T) {
+	values := []float64{0, math.Copysign(0, -1), 1, -1, 0.1, 1.0 / 3, 3.5, -1e300, 1e300, 5e-324, 2.2250738585072014e-308, math.Inf(1), math.Inf(-1), math.NaN()}
+	ops := []struct {
+		name string
+		f    func(x, y float64) float64
+		g    func(x, y *apfloat.Float) *apfloat.Float
+	}{
+		{"add", func(x, y float64) float64 { return x + y }, func(x, y *apfloat.Float) *apfloat.Float { z, _ := x.Add(y, apfloat.NearestEven); return z }},
+		{"sub", func(x, y float64) float64 { return x - y }, func(x, y *apfloat.Float) *apfloat.Float { z, _ := x.Sub(y, apfloat.NearestEven); return z }},
+		{"mul", func(x, y float64) float64 { return x * y }, func(x, y *apfloat.Float) *apfloat.Float { z, _ := x.Mul(y, apfloat.NearestEven); return z }},
+		{"quo", func(x, y float64) float64 { return x / y }, func(x, y *apfloat.Float) *apfloat.Float { z, _ := x.Quo(y, apfloat.NearestEven); return z }},
+		{"rem", math.Mod, func(x, y *apfloat.Float) *apfloat.Float { return x.Rem(y) }},
+	}
+	for _, op := range ops {
+		for _, a := range values {
+			for _, b := range values {
+				x, _ := apfloat.FromFloat64(apfloat.Double, a, apfloat.NearestEven)
+				y, _ := apfloat.FromFloat64(apfloat.Double, b, apfloat.NearestEven)
+				want := op.f(a, b)
+				got := op.g(x, y).Float64()
+				if math.IsNaN(want) && math.IsNaN(got) {
+					continue
+				}
+				if math.Float64bits(want) != math.Float64bits(got) {
+					t.Errorf("%s(%v, %v): result mismatch; expected %v, got %v", op.name, a, b, want, got)
+				}
+			}
+		}
+	}
+}
+
+func TestConvert(t *testing.T) {
+	golden := []struct {
+		sem  *apfloat.Semantics
+		x    float64
+		want string // bit pattern in hexadecimal
+		acc  big.Accuracy
+	}{
+		{sem: apfloat.Half, x: 1, want: "3c00", acc: big.Exact},
+		{sem: apfloat.Half, x: 65520, want: "7c00", acc: big.Above},
+		{sem: apfloat.Half, x: 5.960464477539063e-08, want: "1", acc: big.Exact},
+		{sem: apfloat.Single, x: 0.1, want: "3dcccccd", acc: big.Above},
+		{sem: apfloat.X86FP80, x: -2, want: "c0008000000000000000", acc: big.Exact},
+		{sem: apfloat.Quad, x: 1, want: "3fff0000000000000000000000000000", acc: big.Exact},
+		{sem: apfloat.PPCDoubleDouble, x: 1, want: "3ff0000000000000", acc: big.Exact},
+	}
+	for _, g := range golden {
+		f, acc := apfloat.FromFloat64(g.sem, g.x, apfloat.NearestEven)
+		if got := f.Bits().Text(16); got != g.want {
+			t.Errorf("%v %v: bit pattern mismatch; expected %s, got %s", g.sem, g.x, g.want, got)
+		}
+		if acc != g.acc {
+			t.Errorf("%v %v: accuracy mismatch; expected %v, got %v", g.sem, g.x, g.acc, acc)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	golden := []struct {
+		sem  *apfloat.Semantics
+		s    string
+		want string // bit pattern in hexadecimal
+	}{
+		{sem: apfloat.Single, s: "3.4028235e38", want: "7f7fffff"},
+		{sem: apfloat.Single, s: "1e39", want: "7f800000"},
+		{sem: apfloat.Double, s: "4.9406564584124654e-324", want: "1"},
+		{sem: apfloat.Double, s: "1e-400", want: "0"},
+		{sem: apfloat.Double, s: "-1e100000", want: "fff0000000000000"},
+		{sem: apfloat.X86FP80, s: "0.1", want: "3ffbcccccccccccccccd"},
+		{sem: apfloat.Quad, s: "0.1", want: "3ffb999999999999999999999999999a"},
+		{sem: apfloat.PPCDoubleDouble, s: "0.1", want: "bc5999999999999a3fb999999999999a"},
+	}
+	for _, g := range golden {
+		f, _, err := apfloat.Parse(g.sem, g.s, apfloat.NearestEven)
+		if err != nil {
+			t.Errorf("%v %q: unable to parse; %v", g.sem, g.s, err)
+			continue
+		}
+		if got := f.Bits().Text(16); got != g.want {
+			t.Errorf("%v %q: bit pattern mismatch; expected %s, got %s", g.sem, g.s, g.want, got)
+		}
+		// Round-trip through the shortest decimal representation.
+		if f.IsFinite() {
+			h, _, err := apfloat.Parse(g.sem, f.String(), apfloat.NearestEven)
+			if err != nil || !h.Equal(f) {
+				t.Errorf("%v %q: round-trip mismatch of %q", g.sem, g.s, f.String())
+			}
+		}
+	}
+}
+
+func TestNaN(t *testing.T) {
+	// Payloads are preserved across conversions, and made quiet by arithmetic.
+	snan := apfloat.FromBits(apfloat.Double, new(big.Int).SetUint64(0x7FF0000020000000))
+	if !snan.IsNaN() || !snan.IsSignaling() {
+		t.Fatalf("expected signaling NaN, got %s", snan.Bits().Text(16))
+	}
+	f, _ := snan.Convert(apfloat.Single, apfloat.NearestEven)
+	if got, want := f.Bits().Text(16), "7f800001"; got != want {
+		t.Errorf("NaN conversion mismatch; expected %s, got %s", want, got)
+	}
+	one, _ := apfloat.FromFloat64(apfloat.Double, 1, apfloat.NearestEven)
+	z, _ := one.Add(snan, apfloat.NearestEven)
+	if got, want := z.Bits().Text(16), "7ff8000020000000"; got != want {
+		t.Errorf("NaN propagation mismatch; expected %s, got %s", want, got)
+	}
+	if _, ordered := one.Cmp(snan); ordered {
+		t.Errorf("expected NaN to be unordered")
+	}
+}
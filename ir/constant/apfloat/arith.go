@@ -0,0 +1,245 @@
+package apfloat
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// Neg returns -x.
+func (x *Float) Neg() *Float {
+	bits := new(big.Int).Set(x.bits)
+	flip := func(i int) {
+		bits.SetBit(bits, i, bits.Bit(i)^1)
+	}
+	flip(x.sem.width - 1)
+	if x.sem.doubleDouble {
+		flip(63)
+	}
+	return &Float{sem: x.sem, bits: bits}
+}
+
+// Abs returns |x|.
+func (x *Float) Abs() *Float {
+	if x.Signbit() {
+		return x.Neg()
+	}
+	return x
+}
+
+// Add returns x + y, rounded according to mode.
+func (x *Float) Add(y *Float, mode RoundingMode) (*Float, big.Accuracy) {
+	mustMatch(x, y)
+	dx, dy := x.decode(), y.decode()
+	switch {
+	case dx.class == classNaN || dy.class == classNaN:
+		return propagateNaN(x, y), big.Exact
+	case dx.class == classInf && dy.class == classInf:
+		if dx.neg != dy.neg {
+			return NaN(x.sem, false), big.Exact
+		}
+		return x, big.Exact
+	case dx.class == classInf:
+		return x, big.Exact
+	case dy.class == classInf:
+		return y, big.Exact
+	case dx.class == classZero && dy.class == classZero:
+		if dx.neg == dy.neg {
+			return x, big.Exact
+		}
+		return Zero(x.sem, mode == TowardNegative), big.Exact
+	case dx.class == classZero:
+		return y.Convert(y.sem, mode)
+	case dy.class == classZero:
+		return x.Convert(x.sem, mode)
+	}
+	z := new(big.Rat).Add(dx.rat(), dy.rat())
+	if z.Sign() == 0 {
+		return Zero(x.sem, mode == TowardNegative), big.Exact
+	}
+	return FromRat(x.sem, z, mode)
+}
+
+// Sub returns x - y, rounded according to mode.
+func (x *Float) Sub(y *Float, mode RoundingMode) (*Float, big.Accuracy) {
+	if y.IsNaN() {
+		return x.Add(y, mode)
+	}
+	return x.Add(y.Neg(), mode)
+}
+
+// Mul returns x * y, rounded according to mode.
+func (x *Float) Mul(y *Float, mode RoundingMode) (*Float, big.Accuracy) {
+	mustMatch(x, y)
+	dx, dy := x.decode(), y.decode()
+	neg := dx.neg != dy.neg
+	switch {
+	case dx.class == classNaN || dy.class == classNaN:
+		return propagateNaN(x, y), big.Exact
+	case dx.class == classInf && dy.class == classZero, dx.class == classZero && dy.class == classInf:
+		return NaN(x.sem, false), big.Exact
+	case dx.class == classInf || dy.class == classInf:
+		return Inf(x.sem, neg), big.Exact
+	case dx.class == classZero || dy.class == classZero:
+		return Zero(x.sem, neg), big.Exact
+	}
+	z := new(big.Rat).Mul(dx.rat(), dy.rat())
+	return FromRat(x.sem, z, mode)
+}
+
+// Quo returns x / y, rounded according to mode.
+func (x *Float) Quo(y *Float, mode RoundingMode) (*Float, big.Accuracy) {
+	mustMatch(x, y)
+	dx, dy := x.decode(), y.decode()
+	neg := dx.neg != dy.neg
+	switch {
+	case dx.class == classNaN || dy.class == classNaN:
+		return propagateNaN(x, y), big.Exact
+	case dx.class == classInf && dy.class == classInf, dx.class == classZero && dy.class == classZero:
+		return NaN(x.sem, false), big.Exact
+	case dx.class == classInf || dy.class == classZero:
+		return Inf(x.sem, neg), big.Exact
+	case dx.class == classZero || dy.class == classInf:
+		return Zero(x.sem, neg), big.Exact
+	}
+	z := new(big.Rat).Quo(dx.rat(), dy.rat())
+	return FromRat(x.sem, z, mode)
+}
+
+// Rem returns the remainder of x / y, where the quotient is truncated towards
+// zero (as in C fmod and LLVM IR frem). The remainder is exact.
+func (x *Float) Rem(y *Float) *Float {
+	mustMatch(x, y)
+	dx, dy := x.decode(), y.decode()
+	switch {
+	case dx.class == classNaN || dy.class == classNaN:
+		return propagateNaN(x, y)
+	case dx.class == classInf || dy.class == classZero:
+		return NaN(x.sem, false)
+	case dx.class == classZero || dy.class == classInf:
+		return x
+	}
+	rx, ry := dx.rat(), dy.rat()
+	q := new(big.Rat).Quo(rx, ry)
+	t := new(big.Int).Quo(q.Num(), q.Denom())
+	z := new(big.Rat).Mul(new(big.Rat).SetInt(t), ry)
+	z.Sub(rx, z)
+	if z.Sign() == 0 {
+		return Zero(x.sem, dx.neg)
+	}
+	r, _ := FromRat(x.sem, z, NearestEven)
+	return r
+}
+
+// Cmp compares x and y and returns -1, 0 or +1 if x is less than, equal to or
+// greater than y respectively, and reports whether x and y are ordered (i.e.
+// neither is NaN). Positive and negative zero compare equal.
+func (x *Float) Cmp(y *Float) (int, bool) {
+	mustMatch(x, y)
+	dx, dy := x.decode(), y.decode()
+	switch {
+	case dx.class == classNaN || dy.class == classNaN:
+		return 0, false
+	case dx.class == classInf || dy.class == classInf:
+		sx, sy := infSign(dx), infSign(dy)
+		switch {
+		case sx < sy:
+			return -1, true
+		case sx > sy:
+			return 1, true
+		}
+		if dx.class == classInf && dy.class == classInf {
+			return 0, true
+		}
+	}
+	var rx, ry big.Rat
+	if dx.class == classFinite {
+		rx.Set(dx.rat())
+	}
+	if dy.class == classFinite {
+		ry.Set(dy.rat())
+	}
+	return rx.Cmp(&ry), true
+}
+
+// Convert returns x converted to the given floating-point format, rounded
+// according to mode.
+//
+// The payload of NaN values is preserved by truncating or extending its
+// low-order bits; a NaN payload which would be truncated to zero is made
+// quiet.
+func (x *Float) Convert(sem *Semantics, mode RoundingMode) (*Float, big.Accuracy) {
+	d := x.decode()
+	switch d.class {
+	case classNaN:
+		return convertNaN(x, sem), big.Exact
+	case classInf:
+		return Inf(sem, d.neg), big.Exact
+	case classZero:
+		return Zero(sem, d.neg), big.Exact
+	}
+	return sem.round(d.neg, new(big.Rat).Abs(d.rat()), mode)
+}
+
+// ### [ Helper functions ] ####################################################
+
+// mustMatch panics if x and y are of different floating-point formats.
+func mustMatch(x, y *Float) {
+	if x.sem != y.sem {
+		panic(fmt.Errorf("floating-point format mismatch; %v and %v", x.sem, y.sem))
+	}
+}
+
+// propagateNaN returns the first NaN operand of x and y, made quiet.
+func propagateNaN(x, y *Float) *Float {
+	if x.IsNaN() {
+		return quiet(x)
+	}
+	return quiet(y)
+}
+
+// quiet returns the given NaN with the quiet bit set.
+func quiet(x *Float) *Float {
+	if x.sem.doubleDouble {
+		hi, lo := x.pair()
+		return newDoubleDouble(quiet(hi), lo)
+	}
+	bits := new(big.Int).SetBit(x.bits, x.sem.quietBit(), 1)
+	return &Float{sem: x.sem, bits: bits}
+}
+
+// convertNaN returns the NaN x converted to the given floating-point format.
+func convertNaN(x *Float, sem *Semantics) *Float {
+	if x.sem.doubleDouble {
+		hi, _ := x.pair()
+		return convertNaN(hi, sem)
+	}
+	if sem.doubleDouble {
+		return newDoubleDouble(convertNaN(x, Double), Zero(Double, false))
+	}
+	d := x.decode()
+	payload := new(big.Int).And(d.mant, lowMask(x.sem.payloadBits()))
+	if n := sem.payloadBits() - x.sem.payloadBits(); n >= 0 {
+		payload.Lsh(payload, uint(n))
+	} else {
+		payload.Rsh(payload, uint(-n))
+	}
+	if payload.Sign() == 0 {
+		payload.SetBit(payload, sem.quietBit(), 1)
+	}
+	if sem.explicitInt {
+		payload.SetBit(payload, sem.precision-1, 1)
+	}
+	return sem.encode(d.neg, sem.expMask(), payload)
+}
+
+// infSign returns the sign of the given decoded value if infinite; and 0
+// otherwise.
+func infSign(d decoded) int {
+	if d.class != classInf {
+		return 0
+	}
+	if d.neg {
+		return -1
+	}
+	return 1
+}
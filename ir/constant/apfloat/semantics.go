@@ -0,0 +1,272 @@
+package apfloat
+
+import (
+	"math/big"
+)
+
+// Semantics specifies a binary floating-point format.
+type Semantics struct {
+	// Name of the format.
+	name string
+	// Size of the format in number of bits.
+	width int
+	// Number of bits of the significand, including the integer bit.
+	precision int
+	// Number of bits of the exponent.
+	expBits int
+	// explicitInt specifies whether the integer bit of the significand is stored
+	// explicitly (as in x86_fp80).
+	explicitInt bool
+	// doubleDouble specifies whether the format is a pair of double precision
+	// values, the value of which is their sum (as in ppc_fp128).
+	doubleDouble bool
+}
+
+// Floating-point formats.
+var (
+	// Half is the IEEE 754 binary16 format (half).
+	Half = &Semantics{name: "half", width: 16, precision: 11, expBits: 5}
+	// Single is the IEEE 754 binary32 format (float).
+	Single = &Semantics{name: "float", width: 32, precision: 24, expBits: 8}
+	// Double is the IEEE 754 binary64 format (double).
+	Double = &Semantics{name: "double", width: 64, precision: 53, expBits: 11}
+	// X86FP80 is the x87 80-bit extended precision format (x86_fp80).
+	X86FP80 = &Semantics{name: "x86_fp80", width: 80, precision: 64, expBits: 15, explicitInt: true}
+	// Quad is the IEEE 754 binary128 format (fp128).
+	Quad = &Semantics{name: "fp128", width: 128, precision: 113, expBits: 15}
+	// PPCDoubleDouble is the PowerPC double-double format (ppc_fp128).
+	PPCDoubleDouble = &Semantics{name: "ppc_fp128", width: 128, precision: 106, expBits: 11, doubleDouble: true}
+)
+
+// String returns the name of the floating-point format.
+func (sem *Semantics) String() string {
+	return sem.name
+}
+
+// Width returns the size of the floating-point format in number of bits.
+func (sem *Semantics) Width() int {
+	return sem.width
+}
+
+// Precision returns the number of bits of the significand of the floating-point
+// format, including the integer bit. The precision of double-double values is
+// nominal, as the sum of two doubles may represent values of larger precision.
+func (sem *Semantics) Precision() int {
+	return sem.precision
+}
+
+// RoundingMode specifies how values are rounded to a floating-point format.
+type RoundingMode uint8
+
+// Rounding modes.
+const (
+	// NearestEven rounds to the nearest value; ties to even.
+	NearestEven RoundingMode = iota
+	// NearestAway rounds to the nearest value; ties away from zero.
+	NearestAway
+	// TowardZero rounds towards zero.
+	TowardZero
+	// TowardPositive rounds towards positive infinity.
+	TowardPositive
+	// TowardNegative rounds towards negative infinity.
+	TowardNegative
+)
+
+// ### [ Helper functions ] ####################################################
+
+// bias returns the exponent bias of the floating-point format.
+func (sem *Semantics) bias() int {
+	return 1<<uint(sem.expBits-1) - 1
+}
+
+// expMask returns the value of the exponent field of infinities and NaNs.
+func (sem *Semantics) expMask() int {
+	return 1<<uint(sem.expBits) - 1
+}
+
+// fracBits returns the number of bits of the significand field.
+func (sem *Semantics) fracBits() int {
+	if sem.explicitInt {
+		return sem.precision
+	}
+	return sem.precision - 1
+}
+
+// quietBit returns the bit index of the quiet bit of NaNs in the significand
+// field.
+func (sem *Semantics) quietBit() int {
+	return sem.precision - 2
+}
+
+// payloadBits returns the number of bits of the NaN payload, including the
+// quiet bit.
+func (sem *Semantics) payloadBits() int {
+	return sem.precision - 1
+}
+
+// isInfMant reports whether the given significand field, of a value with all
+// exponent bits set, represents an infinity.
+func (sem *Semantics) isInfMant(mant *big.Int) bool {
+	if sem.explicitInt {
+		// The integer bit is set for infinities; pseudo-infinities with the
+		// integer bit unset are treated as NaN.
+		return mant.BitLen() == sem.precision && mant.TrailingZeroBits() == uint(sem.precision-1)
+	}
+	return mant.Sign() == 0
+}
+
+// encode returns the floating-point value with the given sign, exponent field
+// and significand field.
+func (sem *Semantics) encode(neg bool, exp int, mant *big.Int) *Float {
+	bits := new(big.Int).Lsh(big.NewInt(int64(exp)), uint(sem.fracBits()))
+	bits.Or(bits, mant)
+	if neg {
+		bits.SetBit(bits, sem.width-1, 1)
+	}
+	return &Float{sem: sem, bits: bits}
+}
+
+// round returns the value of the floating-point format nearest to the given
+// positive rational, rounded according to mode.
+func (sem *Semantics) round(neg bool, r *big.Rat, mode RoundingMode) (*Float, big.Accuracy) {
+	return sem.roundFrac(neg, r.Num(), r.Denom(), mode)
+}
+
+// roundFrac returns the value of the floating-point format nearest to the given
+// positive fraction num/den, rounded according to mode. The fraction need not
+// be normalized.
+func (sem *Semantics) roundFrac(neg bool, num, den *big.Int, mode RoundingMode) (*Float, big.Accuracy) {
+	if sem.doubleDouble {
+		return roundDoubleDouble(neg, new(big.Rat).SetFrac(num, den), mode)
+	}
+	p := sem.precision
+	emin := 1 - sem.bias()
+	emax := sem.bias()
+	// Locate exponent e, such that 2^e <= r < 2^(e+1).
+	e := num.BitLen() - den.BitLen()
+	if cmpPow2(num, den, e) < 0 {
+		e--
+	}
+	if e < emin {
+		// Subnormal.
+		e = emin
+	}
+	// Compute significand n = r * 2^(p-1-e), with remainder rem/d.
+	shift := p - 1 - e
+	n, d := new(big.Int), new(big.Int)
+	if shift >= 0 {
+		n.Lsh(num, uint(shift))
+		d.Set(den)
+	} else {
+		n.Set(num)
+		d.Lsh(den, uint(-shift))
+	}
+	rem := new(big.Int)
+	n.QuoRem(n, d, rem)
+	acc := big.Exact
+	if rem.Sign() != 0 {
+		var up bool
+		switch mode {
+		case NearestEven, NearestAway:
+			c := new(big.Int).Lsh(rem, 1).Cmp(d)
+			up = c > 0 || (c == 0 && (mode == NearestAway || n.Bit(0) == 1))
+		case TowardPositive:
+			up = !neg
+		case TowardNegative:
+			up = neg
+		}
+		if up {
+			n.Add(n, big.NewInt(1))
+		}
+		if up != neg {
+			acc = big.Above
+		} else {
+			acc = big.Below
+		}
+	}
+	if n.BitLen() > p {
+		// Significand overflow from rounding.
+		n.Rsh(n, 1)
+		e++
+	}
+	if e > emax {
+		// Overflow.
+		if roundsToInf(neg, mode) {
+			return Inf(sem, neg), accOf(neg, true)
+		}
+		return maxFinite(sem, neg), accOf(neg, false)
+	}
+	exp := 0
+	if n.BitLen() == p {
+		exp = e + sem.bias()
+		if !sem.explicitInt {
+			n.SetBit(n, p-1, 0)
+		}
+	}
+	return sem.encode(neg, exp, n), acc
+}
+
+// roundDoubleDouble returns the double-double value nearest to the given
+// positive rational, rounded according to mode. The high order double is the
+// given value rounded to double precision, and the low order double is the
+// remainder rounded to double precision.
+func roundDoubleDouble(neg bool, r *big.Rat, mode RoundingMode) (*Float, big.Accuracy) {
+	x := new(big.Rat).Set(r)
+	if neg {
+		x.Neg(x)
+	}
+	hi, acc := FromRat(Double, x, mode)
+	if hi.IsInf() || acc == big.Exact {
+		return newDoubleDouble(hi, Zero(Double, false)), acc
+	}
+	rem := new(big.Rat).Sub(x, hi.Rat())
+	lo, _ := FromRat(Double, rem, mode)
+	sum := new(big.Rat).Add(hi.Rat(), lo.Rat())
+	switch sum.Cmp(x) {
+	case -1:
+		acc = big.Below
+	case 0:
+		acc = big.Exact
+	case 1:
+		acc = big.Above
+	}
+	return newDoubleDouble(hi, lo), acc
+}
+
+// maxFinite returns the largest finite value of the floating-point format, with
+// the given sign.
+func maxFinite(sem *Semantics, neg bool) *Float {
+	mant := lowMask(sem.fracBits())
+	return sem.encode(neg, sem.expMask()-1, mant)
+}
+
+// roundsToInf reports whether an overflowing value with the given sign is
+// rounded to infinity by the given rounding mode.
+func roundsToInf(neg bool, mode RoundingMode) bool {
+	switch mode {
+	case NearestEven, NearestAway:
+		return true
+	case TowardPositive:
+		return !neg
+	case TowardNegative:
+		return neg
+	}
+	return false
+}
+
+// accOf returns the accuracy of a value with the given sign, which was rounded
+// up or down in magnitude.
+func accOf(neg, up bool) big.Accuracy {
+	if up != neg {
+		return big.Above
+	}
+	return big.Below
+}
+
+// cmpPow2 compares num/den to 2^e.
+func cmpPow2(num, den *big.Int, e int) int {
+	if e >= 0 {
+		return num.Cmp(new(big.Int).Lsh(den, uint(e)))
+	}
+	return new(big.Int).Lsh(num, uint(-e)).Cmp(den)
+}
@@ -0,0 +1,90 @@
+package apfloat
+
+import (
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// maxDecimalExp is the magnitude of the decimal exponent beyond which every
+// non-zero value of every floating-point format overflows or underflows.
+const maxDecimalExp = 5000
+
+// Parse returns the floating-point value of the given format nearest to the
+// given decimal floating-point string, rounded according to mode.
+//
+// The decimal string may be expressed in one of the following forms.
+//
+//    [+-]? [0-9]+ ([.] [0-9]*)?
+//    [+-]? [0-9]+ ([.] [0-9]*)? [eE] [+-]? [0-9]+
+func Parse(sem *Semantics, s string, mode RoundingMode) (*Float, big.Accuracy, error) {
+	if len(s) == 0 || strings.ContainsAny(s, "/xXpP_") {
+		return nil, big.Exact, errors.Errorf("invalid decimal floating-point literal %q", s)
+	}
+	neg := s[0] == '-'
+	mant, exp := s, 0
+	if pos := strings.IndexAny(s, "eE"); pos != -1 {
+		mant = s[:pos]
+		x, err := strconv.Atoi(s[pos+1:])
+		if err != nil {
+			return nil, big.Exact, errors.Errorf("invalid exponent of decimal floating-point literal %q", s)
+		}
+		exp = x
+	}
+	r, ok := new(big.Rat).SetString(mant)
+	if !ok {
+		return nil, big.Exact, errors.Errorf("invalid decimal floating-point literal %q", s)
+	}
+	if r.Sign() == 0 {
+		return Zero(sem, neg), big.Exact, nil
+	}
+	// Saturate exponents far beyond the range of every format, to prevent the
+	// computation of excessive powers of ten.
+	digits := len(strings.TrimLeft(strings.TrimLeft(mant, "+-"), "0."))
+	switch {
+	case exp > maxDecimalExp+digits:
+		exp = maxDecimalExp + digits
+	case exp < -maxDecimalExp-digits:
+		exp = -maxDecimalExp - digits
+	}
+	pow := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(abs(exp))), nil)
+	num := new(big.Int).Abs(r.Num())
+	den := new(big.Int).Set(r.Denom())
+	if exp >= 0 {
+		num.Mul(num, pow)
+	} else {
+		den.Mul(den, pow)
+	}
+	x, acc := sem.roundFrac(neg, num, den, mode)
+	return x, acc, nil
+}
+
+// String returns the shortest decimal representation of x which parses back to
+// x in its floating-point format; "NaN", "-NaN", "+Inf" or "-Inf" for special
+// values.
+func (x *Float) String() string {
+	return x.Text('g', -1)
+}
+
+// Text converts the floating-point value to a string according to the given
+// format and precision, as defined by big.Float.Text.
+func (x *Float) Text(format byte, prec int) string {
+	z, nan := x.Big()
+	if nan {
+		if z.Signbit() {
+			return "-NaN"
+		}
+		return "NaN"
+	}
+	return z.Text(format, prec)
+}
+
+// abs returns the absolute value of x.
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
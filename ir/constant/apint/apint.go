@@ -0,0 +1,181 @@
+// Package apint implements fixed-width arbitrary precision integers, with the
+// two's complement wrap-around semantics of LLVM IR integer types.
+//
+// An integer of bit width n stores a bit pattern of n bits, which may be
+// interpreted as either an unsigned or a signed (two's complement) value.
+// Arithmetic is computed modulo 2^n. Integers are immutable.
+package apint
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// MaxWidth is the maximum bit width of integers, as supported by LLVM IR
+// integer types.
+const MaxWidth = 1 << 23
+
+// Int is a fixed-width arbitrary precision integer. The zero value is not
+// valid; use the constructor functions to create values.
+type Int struct {
+	// Bit width of the integer.
+	width uint
+	// Bit pattern of the integer; 0 <= x < 2^width.
+	x *big.Int
+}
+
+// New returns a new integer of the given bit width based on the given 64-bit
+// integer, truncated or sign-extended to the bit width.
+func New(width uint, x int64) *Int {
+	return FromBig(width, big.NewInt(x))
+}
+
+// FromUint64 returns a new integer of the given bit width based on the given
+// unsigned 64-bit integer, truncated or zero-extended to the bit width.
+func FromUint64(width uint, x uint64) *Int {
+	return FromBig(width, new(big.Int).SetUint64(x))
+}
+
+// FromBig returns a new integer of the given bit width based on the given
+// arbitrary precision integer, the two's complement representation of which is
+// truncated or sign-extended to the bit width.
+func FromBig(width uint, x *big.Int) *Int {
+	checkWidth(width)
+	return &Int{width: width, x: wrap(width, new(big.Int).Set(x))}
+}
+
+// Zero returns the integer of the given bit width with no bits set.
+func Zero(width uint) *Int {
+	checkWidth(width)
+	return &Int{width: width, x: new(big.Int)}
+}
+
+// AllOnes returns the integer of the given bit width with every bit set; i.e.
+// the maximum unsigned value.
+func AllOnes(width uint) *Int {
+	checkWidth(width)
+	return &Int{width: width, x: mask(width)}
+}
+
+// SignedMin returns the minimum signed integer of the given bit width.
+func SignedMin(width uint) *Int {
+	checkWidth(width)
+	return &Int{width: width, x: new(big.Int).Lsh(big.NewInt(1), width-1)}
+}
+
+// SignedMax returns the maximum signed integer of the given bit width.
+func SignedMax(width uint) *Int {
+	checkWidth(width)
+	return &Int{width: width, x: mask(width - 1)}
+}
+
+// Width returns the bit width of the integer.
+func (x *Int) Width() uint {
+	return x.width
+}
+
+// Uint returns the unsigned value of the integer.
+func (x *Int) Uint() *big.Int {
+	return new(big.Int).Set(x.x)
+}
+
+// Int returns the signed (two's complement) value of the integer.
+func (x *Int) Int() *big.Int {
+	z := new(big.Int).Set(x.x)
+	if x.IsNegative() {
+		z.Sub(z, new(big.Int).Lsh(big.NewInt(1), x.width))
+	}
+	return z
+}
+
+// Uint64 returns the low-order 64 bits of the integer.
+func (x *Int) Uint64() uint64 {
+	return new(big.Int).And(x.x, mask(64)).Uint64()
+}
+
+// Int64 returns the low-order 64 bits of the integer, sign-extended from the
+// bit width if narrower than 64 bits.
+func (x *Int) Int64() int64 {
+	if x.width < 64 {
+		return x.Int().Int64()
+	}
+	return int64(x.Uint64())
+}
+
+// IsZero reports whether no bits of the integer are set.
+func (x *Int) IsZero() bool {
+	return x.x.Sign() == 0
+}
+
+// IsNegative reports whether the sign bit (i.e. the most significant bit) of
+// the integer is set.
+func (x *Int) IsNegative() bool {
+	return x.x.Bit(int(x.width-1)) == 1
+}
+
+// IsAllOnes reports whether every bit of the integer is set.
+func (x *Int) IsAllOnes() bool {
+	return x.x.Cmp(mask(x.width)) == 0
+}
+
+// Bit returns the value of the i'th bit of the integer.
+func (x *Int) Bit(i uint) uint {
+	return x.x.Bit(int(i))
+}
+
+// Equal reports whether x and y have the same bit width and bit pattern.
+func (x *Int) Equal(y *Int) bool {
+	return x.width == y.width && x.x.Cmp(y.x) == 0
+}
+
+// Cmp compares the unsigned values of x and y, and returns -1, 0 or +1 if x is
+// less than, equal to or greater than y respectively.
+func (x *Int) Cmp(y *Int) int {
+	mustMatch(x, y)
+	return x.x.Cmp(y.x)
+}
+
+// SCmp compares the signed values of x and y, and returns -1, 0 or +1 if x is
+// less than, equal to or greater than y respectively.
+func (x *Int) SCmp(y *Int) int {
+	mustMatch(x, y)
+	return x.Int().Cmp(y.Int())
+}
+
+// ### [ Helper functions ] ####################################################
+
+// checkWidth panics if the given bit width is not valid.
+func checkWidth(width uint) {
+	if width == 0 || width > MaxWidth {
+		panic(fmt.Errorf("invalid integer bit width %d; expected 1 <= width <= %d", width, uint(MaxWidth)))
+	}
+}
+
+// mustMatch panics if x and y are of different bit widths.
+func mustMatch(x, y *Int) {
+	if x.width != y.width {
+		panic(fmt.Errorf("integer bit width mismatch; %d and %d", x.width, y.width))
+	}
+}
+
+// mask returns a bit mask of the n low-order bits.
+func mask(n uint) *big.Int {
+	m := new(big.Int).Lsh(big.NewInt(1), n)
+	return m.Sub(m, big.NewInt(1))
+}
+
+// wrap stores the two's complement representation of x, truncated to the given
+// bit width, in x and returns x.
+func wrap(width uint, x *big.Int) *big.Int {
+	if x.Sign() >= 0 && uint(x.BitLen()) <= width {
+		return x
+	}
+	// And uses two's complement semantics for negative values.
+	return x.And(x, mask(width))
+}
+
+// newInt returns a new integer of the given bit width based on the given bit
+// pattern, which is wrapped to the bit width.
+func newInt(width uint, x *big.Int) *Int {
+	return &Int{width: width, x: wrap(width, x)}
+}
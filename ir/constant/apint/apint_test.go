@@ -0,0 +1,80 @@
+package apint_test
+
+import (
+	"testing"
+
+	"github.com/umaumax/llvm/ir/constant/apint"
+)
+
+func TestArith(t *testing.T) {
+	i8 := func(x int64) *apint.Int { return apint.New(8, x) }
+	golden := []struct {
+		name string
+		got  *apint.Int
+		want string // signed decimal
+	}{
+		{name: "add wrap", got: i8(127).Add(i8(1)), want: "-128"},
+		{name: "sub wrap", got: i8(-128).Sub(i8(1)), want: "127"},
+		{name: "mul wrap", got: i8(16).Mul(i8(16)), want: "0"},
+		{name: "neg min", got: i8(-128).Neg(), want: "-128"},
+		{name: "udiv", got: i8(-2).UDiv(i8(3)), want: "84"},
+		{name: "sdiv", got: i8(-7).SDiv(i8(2)), want: "-3"},
+		{name: "sdiv overflow", got: i8(-128).SDiv(i8(-1)), want: "-128"},
+		{name: "urem", got: i8(-1).URem(i8(10)), want: "5"},
+		{name: "srem", got: i8(-7).SRem(i8(2)), want: "-1"},
+		{name: "not", got: i8(0).Not(), want: "-1"},
+		{name: "shl", got: i8(3).Shl(6), want: "-64"},
+		{name: "shl overflow", got: i8(1).Shl(8), want: "0"},
+		{name: "lshr", got: i8(-128).LShr(7), want: "1"},
+		{name: "ashr", got: i8(-128).AShr(7), want: "-1"},
+		{name: "ashr overflow", got: i8(-128).AShr(9), want: "-1"},
+		{name: "rotl", got: i8(-127).RotL(1), want: "3"},
+		{name: "rotr", got: i8(3).RotR(1), want: "-127"},
+		{name: "trunc", got: apint.New(16, 0x1FF).Trunc(8), want: "-1"},
+		{name: "zext", got: i8(-1).ZExt(16), want: "255"},
+		{name: "sext", got: i8(-1).SExt(16), want: "-1"},
+	}
+	for _, g := range golden {
+		if got := g.got.String(); got != g.want {
+			t.Errorf("%s: result mismatch; expected %s, got %s", g.name, g.want, got)
+		}
+	}
+}
+
+func TestBitCounting(t *testing.T) {
+	x := apint.FromUint64(70, 0x00F0)
+	if got, want := x.LeadingZeros(), uint(62); got != want {
+		t.Errorf("leading zeros mismatch; expected %d, got %d", want, got)
+	}
+	if got, want := x.TrailingZeros(), uint(4); got != want {
+		t.Errorf("trailing zeros mismatch; expected %d, got %d", want, got)
+	}
+	if got, want := x.OnesCount(), uint(4); got != want {
+		t.Errorf("ones count mismatch; expected %d, got %d", want, got)
+	}
+	if got, want := x.ActiveBits(), uint(8); got != want {
+		t.Errorf("active bits mismatch; expected %d, got %d", want, got)
+	}
+	if got, want := apint.New(70, -129).MinSignedBits(), uint(9); got != want {
+		t.Errorf("min signed bits mismatch; expected %d, got %d", want, got)
+	}
+	if got, want := apint.Zero(70).TrailingZeros(), uint(70); got != want {
+		t.Errorf("trailing zeros mismatch; expected %d, got %d", want, got)
+	}
+}
+
+func TestText(t *testing.T) {
+	x, err := apint.Parse(128, "-1", 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := x.Text(16, false), "ffffffffffffffffffffffffffffffff"; got != want {
+		t.Errorf("unsigned text mismatch; expected %q, got %q", want, got)
+	}
+	if got, want := x.Text(10, true), "-1"; got != want {
+		t.Errorf("signed text mismatch; expected %q, got %q", want, got)
+	}
+	if !apint.SignedMax(128).Add(apint.New(128, 1)).Equal(apint.SignedMin(128)) {
+		t.Errorf("expected signed max + 1 to wrap around to signed min")
+	}
+}
@@ -0,0 +1,213 @@
+package apint
+
+import (
+	"math/big"
+	"math/bits"
+)
+
+// --- [ Arithmetic ] ----------------------------------------------------------
+
+// Add returns x + y, modulo 2^width.
+func (x *Int) Add(y *Int) *Int {
+	mustMatch(x, y)
+	return newInt(x.width, new(big.Int).Add(x.x, y.x))
+}
+
+// Sub returns x - y, modulo 2^width.
+func (x *Int) Sub(y *Int) *Int {
+	mustMatch(x, y)
+	return newInt(x.width, new(big.Int).Sub(x.x, y.x))
+}
+
+// Mul returns x * y, modulo 2^width.
+func (x *Int) Mul(y *Int) *Int {
+	mustMatch(x, y)
+	return newInt(x.width, new(big.Int).Mul(x.x, y.x))
+}
+
+// Neg returns -x, modulo 2^width.
+func (x *Int) Neg() *Int {
+	return newInt(x.width, new(big.Int).Neg(x.x))
+}
+
+// UDiv returns the unsigned quotient x / y, rounded towards zero. UDiv panics
+// if y is zero.
+func (x *Int) UDiv(y *Int) *Int {
+	mustMatch(x, y)
+	return newInt(x.width, new(big.Int).Quo(x.x, y.x))
+}
+
+// SDiv returns the signed quotient x / y, rounded towards zero, modulo
+// 2^width; i.e. the minimum signed integer divided by -1 wraps around to the
+// minimum signed integer. SDiv panics if y is zero.
+func (x *Int) SDiv(y *Int) *Int {
+	mustMatch(x, y)
+	return newInt(x.width, new(big.Int).Quo(x.Int(), y.Int()))
+}
+
+// URem returns the unsigned remainder of x / y. URem panics if y is zero.
+func (x *Int) URem(y *Int) *Int {
+	mustMatch(x, y)
+	return newInt(x.width, new(big.Int).Rem(x.x, y.x))
+}
+
+// SRem returns the signed remainder of x / y, which has the sign of x. SRem
+// panics if y is zero.
+func (x *Int) SRem(y *Int) *Int {
+	mustMatch(x, y)
+	return newInt(x.width, new(big.Int).Rem(x.Int(), y.Int()))
+}
+
+// --- [ Bitwise operations ] --------------------------------------------------
+
+// And returns x & y.
+func (x *Int) And(y *Int) *Int {
+	mustMatch(x, y)
+	return newInt(x.width, new(big.Int).And(x.x, y.x))
+}
+
+// Or returns x | y.
+func (x *Int) Or(y *Int) *Int {
+	mustMatch(x, y)
+	return newInt(x.width, new(big.Int).Or(x.x, y.x))
+}
+
+// Xor returns x ^ y.
+func (x *Int) Xor(y *Int) *Int {
+	mustMatch(x, y)
+	return newInt(x.width, new(big.Int).Xor(x.x, y.x))
+}
+
+// Not returns ^x; i.e. x with every bit inverted.
+func (x *Int) Not() *Int {
+	return newInt(x.width, new(big.Int).Xor(x.x, mask(x.width)))
+}
+
+// Shl returns x << n, modulo 2^width. The result is zero if n is greater than
+// or equal to the bit width.
+func (x *Int) Shl(n uint) *Int {
+	if n >= x.width {
+		return Zero(x.width)
+	}
+	return newInt(x.width, new(big.Int).Lsh(x.x, n))
+}
+
+// LShr returns the logical right shift x >> n, shifting in zeros. The result
+// is zero if n is greater than or equal to the bit width.
+func (x *Int) LShr(n uint) *Int {
+	if n >= x.width {
+		return Zero(x.width)
+	}
+	return newInt(x.width, new(big.Int).Rsh(x.x, n))
+}
+
+// AShr returns the arithmetic right shift x >> n, shifting in copies of the
+// sign bit. The result has every bit equal to the sign bit if n is greater
+// than or equal to the bit width.
+func (x *Int) AShr(n uint) *Int {
+	if n >= x.width {
+		n = x.width - 1
+	}
+	// Rsh of a negative big.Int rounds towards negative infinity, as required.
+	return newInt(x.width, new(big.Int).Rsh(x.Int(), n))
+}
+
+// RotL returns x rotated left by n bits, modulo the bit width.
+func (x *Int) RotL(n uint) *Int {
+	n %= x.width
+	if n == 0 {
+		return x
+	}
+	return x.Shl(n).Or(x.LShr(x.width - n))
+}
+
+// RotR returns x rotated right by n bits, modulo the bit width.
+func (x *Int) RotR(n uint) *Int {
+	n %= x.width
+	if n == 0 {
+		return x
+	}
+	return x.RotL(x.width - n)
+}
+
+// --- [ Bit counting ] --------------------------------------------------------
+
+// LeadingZeros returns the number of leading zero bits of x; the bit width if
+// x is zero.
+func (x *Int) LeadingZeros() uint {
+	return x.width - uint(x.x.BitLen())
+}
+
+// TrailingZeros returns the number of trailing zero bits of x; the bit width
+// if x is zero.
+func (x *Int) TrailingZeros() uint {
+	if x.IsZero() {
+		return x.width
+	}
+	return x.x.TrailingZeroBits()
+}
+
+// LeadingOnes returns the number of leading one bits of x.
+func (x *Int) LeadingOnes() uint {
+	return x.Not().LeadingZeros()
+}
+
+// OnesCount returns the number of one bits of x; i.e. the population count.
+func (x *Int) OnesCount() uint {
+	n := 0
+	for _, word := range x.x.Bits() {
+		n += bits.OnesCount(uint(word))
+	}
+	return uint(n)
+}
+
+// ActiveBits returns the minimum number of bits required to represent the
+// unsigned value of x.
+func (x *Int) ActiveBits() uint {
+	return uint(x.x.BitLen())
+}
+
+// MinSignedBits returns the minimum number of bits required to represent the
+// signed value of x, including the sign bit.
+func (x *Int) MinSignedBits() uint {
+	if x.IsNegative() {
+		return x.width - x.LeadingOnes() + 1
+	}
+	return x.ActiveBits() + 1
+}
+
+// --- [ Conversions ] ---------------------------------------------------------
+
+// Trunc returns x truncated to the given smaller or equal bit width.
+func (x *Int) Trunc(width uint) *Int {
+	if width > x.width {
+		panic("apint: truncation to larger bit width")
+	}
+	return FromBig(width, x.x)
+}
+
+// ZExt returns x zero-extended to the given larger or equal bit width.
+func (x *Int) ZExt(width uint) *Int {
+	if width < x.width {
+		panic("apint: zero-extension to smaller bit width")
+	}
+	return FromBig(width, x.x)
+}
+
+// SExt returns x sign-extended to the given larger or equal bit width.
+func (x *Int) SExt(width uint) *Int {
+	if width < x.width {
+		panic("apint: sign-extension to smaller bit width")
+	}
+	return FromBig(width, x.Int())
+}
+
+// ZExtOrTrunc returns x zero-extended or truncated to the given bit width.
+func (x *Int) ZExtOrTrunc(width uint) *Int {
+	return FromBig(width, x.x)
+}
+
+// SExtOrTrunc returns x sign-extended or truncated to the given bit width.
+func (x *Int) SExtOrTrunc(width uint) *Int {
+	return FromBig(width, x.Int())
+}
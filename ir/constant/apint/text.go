@@ -0,0 +1,35 @@
+package apint
+
+import (
+	"math/big"
+
+	"github.com/pkg/errors"
+)
+
+// Parse returns the integer of the given bit width based on the given integer
+// string in the given base (2 <= base <= 36), with an optional leading sign.
+// The value is truncated or sign-extended to the bit width.
+func Parse(width uint, s string, base int) (*Int, error) {
+	if base < 2 || base > 36 {
+		return nil, errors.Errorf("invalid base %d; expected 2 <= base <= 36", base)
+	}
+	x, ok := new(big.Int).SetString(s, base)
+	if !ok {
+		return nil, errors.Errorf("invalid base %d integer literal %q", base, s)
+	}
+	return FromBig(width, x), nil
+}
+
+// String returns the signed decimal representation of the integer.
+func (x *Int) String() string {
+	return x.Text(10, true)
+}
+
+// Text returns the representation of the integer in the given base (2 <= base
+// <= 36), as the signed or unsigned value.
+func (x *Int) Text(base int, signed bool) string {
+	if signed {
+		return x.Int().Text(base)
+	}
+	return x.x.Text(base)
+}
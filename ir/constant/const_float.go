@@ -2,16 +2,12 @@ package constant
 
 import (
 	"fmt"
-	"log"
 	"math"
 	"math/big"
-	"strconv"
 	"strings"
 
+	"github.com/umaumax/llvm/ir/constant/apfloat"
 	"github.com/umaumax/llvm/ir/types"
-	"github.com/mewmew/float"
-	"github.com/mewmew/float/binary16"
-	"github.com/mewmew/float/float80x86"
 	"github.com/pkg/errors"
 )
 
@@ -25,6 +21,11 @@ type Float struct {
 	X *big.Float
 	// NaN specifies whether the floating-point constant is Not-a-Number.
 	NaN bool
+	// (optional) Bit pattern of the floating-point constant, in the format of
+	// Typ; nil if uniquely determined by X and NaN. Used to preserve NaN
+	// payloads and non-canonical ppc_fp128 values, and ignored if inconsistent
+	// with X and NaN.
+	Bits *big.Int
 }
 
 // NewFloat returns a new floating-point constant based on the given
@@ -56,144 +57,69 @@ func NewFloat(typ *types.FloatType, x float64) *Float {
 //         0xL[0-9A-Fa-f]{32} // HexFP128
 //         0xM[0-9A-Fa-f]{32} // HexPPC128
 //         0xH[0-9A-Fa-f]{4}  // HexHalf
+//
+// The HexFP form specifies the bit pattern of a double precision value, which
+// is converted to the given floating-point type and is required to be exactly
+// representable in it. The HexFP128 and HexPPC128 forms specify the low-order
+// 64 bits of the bit pattern before the high-order 64 bits.
 func NewFloatFromString(typ *types.FloatType, s string) (*Float, error) {
-	// TODO: implement NewFloatFromString. return 0 for now.
-	if strings.HasPrefix(s, "0x") {
-		switch {
-		case strings.HasPrefix(s, "0xK"):
-			hex := s[len("0xK"):]
-			part1 := hex[:4]
-			part2 := hex[4:]
-			se, err := strconv.ParseUint(part1, 16, 16)
-			if err != nil {
-				return nil, errors.WithStack(err)
-			}
-			m, err := strconv.ParseUint(part2, 16, 64)
-			if err != nil {
-				return nil, errors.WithStack(err)
-			}
-			f := float80x86.NewFromBits(uint16(se), m)
-			x, nan := f.Big()
-			return &Float{Typ: typ, X: x, NaN: nan}, nil
-		case strings.HasPrefix(s, "0xL"):
-			//s = s[len("0xL"):]
-		case strings.HasPrefix(s, "0xM"):
-			//s = s[len("0xM"):]
-		case strings.HasPrefix(s, "0xH"):
-			hex := s[len("0xH"):]
-			bits, err := strconv.ParseUint(hex, 16, 16)
-			if err != nil {
-				return nil, errors.WithStack(err)
-			}
-			f := binary16.NewFromBits(uint16(bits))
-			x, nan := f.Big()
-			return &Float{Typ: typ, X: x, NaN: nan}, nil
-		default:
-			hex := s[len("0x"):]
-			bits, err := strconv.ParseUint(hex, 16, 64)
-			if err != nil {
-				return nil, errors.WithStack(err)
-			}
-			switch typ.Kind {
-			case types.FloatKindHalf:
-				// TODO: verify if this is a correct implementation. We should
-				// probably be using binary16.NewFromBits.
-				f16 := math.Float64frombits(bits)
-				if math.IsNaN(f16) {
-					f := &Float{Typ: typ, X: &big.Float{}, NaN: true}
-					// Store sign of NaN.
-					if math.Signbit(f16) {
-						f.X.SetFloat64(-1)
-					}
-					return f, nil
-				}
-				c := big.NewFloat(f16)
-				const precision = 11
-				c.SetPrec(precision)
-				return &Float{
-					Typ: typ,
-					X:   c,
-				}, nil
-			case types.FloatKindFloat:
-				// ref: https://groups.google.com/d/msg/llvm-dev/IlqV3TbSk6M/27dAggZOMb0J
-				//
-				// The exact bit representation of the float is laid out with the
-				// corresponding bitwise representation of a double:  the sign bit is
-				// copied over, the exponent is encoded in the larger width, and the 23
-				// bits of significand fills in the top 23 bits of significand in the
-				// double.  A double has 52 bits of significand, so this means that the
-				// last 29 bits of significand will always be ignored.  As an
-				// error-detection measure, the IR parser requires them to be zero.
-				f32 := math.Float64frombits(bits)
-				if math.IsNaN(f32) {
-					f := &Float{Typ: typ, X: &big.Float{}, NaN: true}
-					// Store sign of NaN.
-					if math.Signbit(f32) {
-						f.X.SetFloat64(-1)
-					}
-					return f, nil
-				}
-				x := big.NewFloat(f32)
-				const precision = 24
-				x.SetPrec(precision)
-				return &Float{Typ: typ, X: x}, nil
-			case types.FloatKindDouble:
-				f32 := math.Float64frombits(bits)
-				if math.IsNaN(f32) {
-					f := &Float{Typ: typ, X: &big.Float{}, NaN: true}
-					// Store sign of NaN.
-					if math.Signbit(f32) {
-						f.X.SetFloat64(-1)
-					}
-					return f, nil
-				}
-				x := big.NewFloat(f32)
-				return &Float{Typ: typ, X: x}, nil
-			default:
-				panic(fmt.Errorf("support for hexadecimal floating-point literal %q of kind %v not yet implemented", s, typ.Kind))
-			}
-		}
-		log.Printf("constant.NewFloatFromString(%q): not yet implemented", s)
-		return NewFloat(typ, 0), nil
-		//panic(fmt.Errorf("support for hexadecimal floating-point constant %q not yet implemented", s))
-	}
-	switch typ.Kind {
-	case types.FloatKindHalf:
-		const precision = 11
-		x, _, err := big.ParseFloat(s, 10, precision, big.ToNearestEven)
-		if err != nil {
-			return nil, errors.WithStack(err)
-		}
-		c := &Float{
-			Typ: typ,
-			X:   x,
-		}
-		return c, nil
-	case types.FloatKindFloat:
-		const precision = 24
-		x, _, err := big.ParseFloat(s, 10, precision, big.ToNearestEven)
+	sem := floatSemantics(typ.Kind)
+	if !strings.HasPrefix(s, "0x") {
+		f, _, err := apfloat.Parse(sem, s, apfloat.NearestEven)
 		if err != nil {
 			return nil, errors.WithStack(err)
 		}
-		c := &Float{
-			Typ: typ,
-			X:   x,
-		}
-		return c, nil
-	case types.FloatKindDouble:
-		const precision = 53
-		x, _, err := big.ParseFloat(s, 10, precision, big.ToNearestEven)
-		if err != nil {
-			return nil, errors.WithStack(err)
-		}
-		c := &Float{
-			Typ: typ,
-			X:   x,
+		return NewFloatFromAPFloat(typ, f), nil
+	}
+	prefix, want, hexSem := "0x", 16, apfloat.Double
+	switch {
+	case strings.HasPrefix(s, "0xK"):
+		prefix, want, hexSem = "0xK", 20, apfloat.X86FP80
+	case strings.HasPrefix(s, "0xL"):
+		prefix, want, hexSem = "0xL", 32, apfloat.Quad
+	case strings.HasPrefix(s, "0xM"):
+		prefix, want, hexSem = "0xM", 32, apfloat.PPCDoubleDouble
+	case strings.HasPrefix(s, "0xH"):
+		prefix, want, hexSem = "0xH", 4, apfloat.Half
+	}
+	hex := s[len(prefix):]
+	if len(hex) > want {
+		return nil, errors.Errorf("invalid hexadecimal floating-point literal %q; expected at most %d hexadecimal digits, got %d", s, want, len(hex))
+	}
+	if hexSem != apfloat.Double && hexSem != sem {
+		return nil, errors.Errorf("invalid hexadecimal floating-point literal %q for floating-point type %v", s, typ)
+	}
+	bits, ok := new(big.Int).SetString(hex, 16)
+	if !ok {
+		return nil, errors.Errorf("invalid hexadecimal floating-point literal %q", s)
+	}
+	if hexSem.Width() == 128 {
+		// Swap the low-order and high-order 64 bits.
+		bits = swapWords(bits)
+	}
+	f := apfloat.FromBits(hexSem, bits)
+	if hexSem != sem {
+		g, acc := f.Convert(sem, apfloat.NearestEven)
+		if acc != big.Exact {
+			return nil, errors.Errorf("hexadecimal floating-point literal %q not exactly representable in floating-point type %v", s, typ)
 		}
-		return c, nil
-	default:
-		panic(fmt.Errorf("support for floating-point kind %v not yet implemented", typ.Kind))
+		f = g
 	}
+	return NewFloatFromAPFloat(typ, f), nil
+}
+
+// NewFloatFromAPFloat returns a new floating-point constant based on the given
+// floating-point type and arbitrary precision floating-point value of the
+// corresponding format.
+func NewFloatFromAPFloat(typ *types.FloatType, f *apfloat.Float) *Float {
+	x, nan := f.Big()
+	c := &Float{Typ: typ, X: x, NaN: nan}
+	// Record bit pattern of values not uniquely determined by X and NaN; e.g.
+	// NaN payloads.
+	if !c.canonical().Equal(f) {
+		c.Bits = f.Bits()
+	}
+	return c
 }
 
 // String returns the LLVM syntax representation of the constant as a type-value
@@ -210,27 +136,28 @@ func (c *Float) Type() types.Type {
 // Ident returns the identifier associated with the constant.
 func (c *Float) Ident() string {
 	// FloatLit
-	// TODO: add support for hexadecimal format.
-	// TODO: add support for NaN, +-Inf.
-
+	f := c.APFloat()
 	switch c.Typ.Kind {
-	case types.FloatKindHalf:
-		if c.NaN || c.X.IsInf() || !float.IsExact16(c.X) {
-			var bits uint16
-			if c.NaN {
-				if c.X.Signbit() {
-					bits = binary16.NegNaN.Bits()
+	case types.FloatKindHalf, types.FloatKindFloat, types.FloatKindDouble:
+		if !c.NaN && !c.X.IsInf() && c.Bits == nil && c.isExact() {
+			// Insert decimal point if not present.
+			//    3e4 -> 3.0e4
+			//    42  -> 42.0
+			s := f.String()
+			if !strings.ContainsRune(s, '.') {
+				if pos := strings.IndexByte(s, 'e'); pos != -1 {
+					s = s[:pos] + ".0" + s[pos:]
 				} else {
-					bits = binary16.NaN.Bits()
+					s += ".0"
 				}
-			} else {
-				f, acc := binary16.NewFromBig(c.X)
-				// TODO: check acc.
-				_ = acc
-				bits = f.Bits()
 			}
-			return fmt.Sprintf("0xH%04X", bits)
+			return s
 		}
+	}
+	bits := f.Bits()
+	switch c.Typ.Kind {
+	case types.FloatKindHalf:
+		return fmt.Sprintf("0xH%04X", bits)
 	case types.FloatKindFloat:
 		// ref: https://groups.google.com/d/msg/llvm-dev/IlqV3TbSk6M/27dAggZOMb0J
 		//
@@ -241,90 +168,102 @@ func (c *Float) Ident() string {
 		// double.  A double has 52 bits of significand, so this means that the
 		// last 29 bits of significand will always be ignored.  As an
 		// error-detection measure, the IR parser requires them to be zero.
-		if c.NaN || c.X.IsInf() || !float.IsExact32(c.X) {
-			// Single precision.
-			//
-			//     1 bit:  sign
-			//     8 bits: exponent
-			//    23 bits: mantissa
-			//
-			//    bias: 127
-			var bits32 uint32
-			if c.NaN {
-				// TODO: handle sign bit.
-				bits32 = 0xFFFFFFFF
-			} else {
-				f, _ := c.X.Float32()
-				bits32 = math.Float32bits(f)
-			}
-			// 0b10000000000000000000000000000000
-			sign := uint64(bits32 & 0x80000000 >> 31)
-			// 0b01111111100000000000000000000000
-			const bias32 = 127
-			exp32 := (bits32 & 0x7F800000 >> 23)
-			// 0b00000000011111111111111111111111
-			mant := uint64(bits32 & 0x7FFFFF)
-			// Double precision.
-			//
-			//     1 bit:  sign
-			//    11 bits: exponent
-			//    52 bits: mantissa
-			//
-			//    bias: 1023
-			var bits64 uint64
-			bits64 |= sign << 63
-			const bias64 = 1023
-			var exp64 uint64
-			if exp32 == 0xFF {
-				// Keep every bit set in the exponent if such was the case for
-				// float32.
-				exp64 = 0x7FF
-			} else {
-				exp := uint64(exp32 - bias32)
-				exp64 = exp + bias64
-			}
-			bits64 |= exp64 << 52
-			bits64 |= mant << (52 - 23)
-			return fmt.Sprintf("0x%016X", bits64)
-		}
+		d, _ := f.Convert(apfloat.Double, apfloat.NearestEven)
+		return fmt.Sprintf("0x%016X", d.Bits())
 	case types.FloatKindDouble:
-		if c.NaN {
-			f := math.NaN()
-			if c.X != nil && c.X.Signbit() {
-				f = math.Copysign(f, -1)
-			}
-			bits := math.Float64bits(f)
-			return fmt.Sprintf("0x%X", bits)
-		}
-		if c.X.IsInf() || !float.IsExact64(c.X) {
-			f, _ := c.X.Float64()
-			bits := math.Float64bits(f)
-			// Note, to match Clang output we do not zero-pad the hexadecimal
-			// output.
-			return fmt.Sprintf("0x%X", bits)
-			//return fmt.Sprintf("0x%016X", bits)
-		}
+		// Note, to match Clang output we do not zero-pad the hexadecimal
+		// output.
+		return fmt.Sprintf("0x%X", bits)
 	case types.FloatKindX86_FP80:
-		// TODO: handle NaN.
-		f, acc := float80x86.NewFromBig(c.X)
-		// TODO: check acc.
-		_ = acc
-		se, m := f.Bits()
-		return fmt.Sprintf("0xK%04X%016X", se, m)
-		//case types.FloatKindFP128:
-		//case types.FloatKindPPCFP128:
+		return fmt.Sprintf("0xK%020X", bits)
+	case types.FloatKindFP128:
+		return fmt.Sprintf("0xL%032X", swapWords(bits))
+	case types.FloatKindPPC_FP128:
+		return fmt.Sprintf("0xM%032X", swapWords(bits))
+	default:
+		panic(fmt.Errorf("support for floating-point kind %v not yet implemented", c.Typ.Kind))
 	}
+}
 
-	// Insert decimal point if not present.
-	//    3e4 -> 3.0e4
-	//    42  -> 42.0
-	s := c.X.Text('g', -1)
-	if !strings.ContainsRune(s, '.') {
-		if pos := strings.IndexByte(s, 'e'); pos != -1 {
-			s = s[:pos] + ".0" + s[pos:]
-		} else {
-			s += ".0"
+// APFloat returns the arbitrary precision floating-point value of the constant,
+// in the format of its floating-point type.
+func (c *Float) APFloat() *apfloat.Float {
+	f := c.canonical()
+	if c.Bits != nil {
+		// Use the recorded bit pattern if consistent with X and NaN.
+		g := apfloat.FromBits(f.Semantics(), c.Bits)
+		if x, nan := g.Big(); nan == c.NaN && sameFloat(x, c.X) {
+			return g
 		}
 	}
-	return s
+	return f
+}
+
+// ### [ Helper functions ] ####################################################
+
+// canonical returns the floating-point value of X and NaN, rounded to the
+// format of the floating-point type of the constant.
+func (c *Float) canonical() *apfloat.Float {
+	sem := floatSemantics(c.Typ.Kind)
+	if c.NaN {
+		return apfloat.NaN(sem, c.X != nil && c.X.Signbit())
+	}
+	f, _ := apfloat.FromBig(sem, c.X, apfloat.NearestEven)
+	return f
+}
+
+// isExact reports whether X is exactly representable in the floating-point
+// type of the constant, and whether its shortest decimal representation is
+// exact.
+func (c *Float) isExact() bool {
+	sem := floatSemantics(c.Typ.Kind)
+	f, acc := apfloat.FromBig(sem, c.X, apfloat.NearestEven)
+	if acc != big.Exact {
+		return false
+	}
+	r, ok := new(big.Rat).SetString(f.Text('e', -1))
+	return ok && r.Cmp(f.Rat()) == 0
+}
+
+// floatSemantics returns the floating-point format of the given floating-point
+// kind.
+func floatSemantics(kind types.FloatKind) *apfloat.Semantics {
+	switch kind {
+	case types.FloatKindHalf:
+		return apfloat.Half
+	case types.FloatKindFloat:
+		return apfloat.Single
+	case types.FloatKindDouble:
+		return apfloat.Double
+	case types.FloatKindX86_FP80:
+		return apfloat.X86FP80
+	case types.FloatKindFP128:
+		return apfloat.Quad
+	case types.FloatKindPPC_FP128:
+		return apfloat.PPCDoubleDouble
+	default:
+		panic(fmt.Errorf("support for floating-point kind %v not yet implemented", kind))
+	}
+}
+
+// swapWords swaps the low-order and high-order 64 bits of the given 128-bit
+// integer.
+func swapWords(x *big.Int) *big.Int {
+	mask := new(big.Int).SetUint64(math.MaxUint64)
+	lo := new(big.Int).And(x, mask)
+	hi := new(big.Int).Rsh(x, 64)
+	hi.And(hi, mask)
+	return lo.Lsh(lo, 64).Or(lo, hi)
+}
+
+// sameFloat reports whether x and y have the same value and sign. A nil value
+// is treated as positive zero.
+func sameFloat(x, y *big.Float) bool {
+	if x == nil {
+		x = new(big.Float)
+	}
+	if y == nil {
+		y = new(big.Float)
+	}
+	return x.Cmp(y) == 0 && x.Signbit() == y.Signbit()
 }
@@ -0,0 +1,67 @@
+package constant_test
+
+import (
+	"testing"
+
+	"github.com/umaumax/llvm/ir/constant"
+	"github.com/umaumax/llvm/ir/types"
+)
+
+func TestNewFloatFromString(t *testing.T) {
+	golden := []struct {
+		typ  *types.FloatType
+		s    string
+		want string
+	}{
+		// Decimal literals.
+		{typ: types.Half, s: "1.5", want: "1.5"},
+		{typ: types.Float, s: "-0.0", want: "-0.0"},
+		{typ: types.Double, s: "3e4", want: "30000.0"},
+		{typ: types.Double, s: "0.1", want: "0x3FB999999999999A"},
+		{typ: types.X86_FP80, s: "1.0", want: "0xK3FFF8000000000000000"},
+		{typ: types.FP128, s: "0.1", want: "0xL999999999999999A3FFB999999999999"},
+		{typ: types.PPC_FP128, s: "0.1", want: "0xM3FB999999999999ABC5999999999999A"},
+		// Hexadecimal literals.
+		{typ: types.Half, s: "0xH2E66", want: "0xH2E66"},
+		{typ: types.Half, s: "0xH7E01", want: "0xH7E01"},
+		{typ: types.Float, s: "0x3FB99999A0000000", want: "0x3FB99999A0000000"},
+		{typ: types.Float, s: "0xFFF8000000000000", want: "0xFFF8000000000000"},
+		{typ: types.Double, s: "0x7FF0000000000001", want: "0x7FF0000000000001"},
+		{typ: types.Double, s: "0x0000000000000001", want: "0x1"},
+		{typ: types.X86_FP80, s: "0xK7FFFC000000000000001", want: "0xK7FFFC000000000000001"},
+		{typ: types.FP128, s: "0xL00000000000000003FFF000000000000", want: "0xL00000000000000003FFF000000000000"},
+		{typ: types.FP128, s: "0xL00000000000000017FFF800000000000", want: "0xL00000000000000017FFF800000000000"},
+		{typ: types.PPC_FP128, s: "0xM3FF00000000000003FF0000000000000", want: "0xM3FF00000000000003FF0000000000000"},
+		{typ: types.PPC_FP128, s: "0xM7FF80000000000010000000000000005", want: "0xM7FF80000000000010000000000000005"},
+		// Conversion of double precision hexadecimal literals.
+		{typ: types.FP128, s: "0x3FF0000000000000", want: "0xL00000000000000003FFF000000000000"},
+	}
+	for _, g := range golden {
+		c, err := constant.NewFloatFromString(g.typ, g.s)
+		if err != nil {
+			t.Errorf("unable to parse %v %q; %v", g.typ, g.s, err)
+			continue
+		}
+		if got := c.Ident(); got != g.want {
+			t.Errorf("%v %q: floating-point constant mismatch; expected %q, got %q", g.typ, g.s, g.want, got)
+		}
+	}
+}
+
+func TestNewFloatFromStringInvalid(t *testing.T) {
+	golden := []struct {
+		typ *types.FloatType
+		s   string
+	}{
+		// Not exactly representable as float.
+		{typ: types.Float, s: "0x3FB999999999999A"},
+		// Hexadecimal literal of other floating-point type.
+		{typ: types.Double, s: "0xK3FFF8000000000000000"},
+		{typ: types.FP128, s: "0xM3FF00000000000000000000000000000"},
+	}
+	for _, g := range golden {
+		if _, err := constant.NewFloatFromString(g.typ, g.s); err == nil {
+			t.Errorf("%v %q: expected error, got nil", g.typ, g.s)
+		}
+	}
+}
@@ -2,10 +2,10 @@ package constant
 
 import (
 	"fmt"
-	"log"
 	"math/big"
 	"strings"
 
+	"github.com/umaumax/llvm/ir/constant/apint"
 	"github.com/umaumax/llvm/ir/types"
 	"github.com/pkg/errors"
 )
@@ -16,7 +16,8 @@ import (
 type Int struct {
 	// Integer type.
 	Typ *types.IntType
-	// Integer constant.
+	// Integer constant. The two's complement representation of X is truncated
+	// or sign-extended to the bit width of Typ; see APInt.
 	X *big.Int
 }
 
@@ -60,33 +61,40 @@ func NewIntFromString(typ *types.IntType, s string) (*Int, error) {
 		return False, nil
 	}
 	// Hexadecimal integer literal.
-	switch {
-	case strings.HasPrefix(s, "u0x"):
-		s = s[len("u0x"):]
-		const base = 16
-		x, _ := (&big.Int{}).SetString(s, base)
-		if x == nil {
+	width := uint(typ.BitSize)
+	if strings.HasPrefix(s, "u0x") || strings.HasPrefix(s, "s0x") {
+		digits, ok := new(big.Int).SetString(s[len("u0x"):], 16)
+		if !ok || digits.Sign() < 0 {
 			return nil, errors.Errorf("unable to parse integer constant %q", s)
 		}
-		return &Int{Typ: typ, X: x}, nil
-	case strings.HasPrefix(s, "s0x"):
-		// TODO: figure out how to handle negative values. Use typ.BitSize.
-		// e.g. what value should s0x0012312 represent?
-		log.Printf("support for signed hexadecimal integers (%q) not yet implemented", s)
-		s = s[len("s0x"):]
-		const base = 16
-		x, _ := (&big.Int{}).SetString(s, base)
-		if x == nil {
-			return nil, errors.Errorf("unable to parse integer constant %q", s)
+		// The hexadecimal digits specify an integer of the minimum bit width
+		// required to represent its unsigned value, which is zero-extended (u0x)
+		// or sign-extended (s0x) to the bit width of the integer type, as done by
+		// LLVM; e.g. `i32 s0xFF` is -1.
+		x := apint.FromBig(width, digits)
+		if s[0] == 's' && digits.Sign() != 0 {
+			x = apint.FromBig(uint(digits.BitLen()), digits).SExtOrTrunc(width)
 		}
-		return &Int{Typ: typ, X: x}, nil
+		return NewIntFromAPInt(typ, x), nil
 	}
 	// Integer literal.
-	x, _ := (&big.Int{}).SetString(s, 10)
-	if x == nil {
+	x, err := apint.Parse(width, s, 10)
+	if err != nil {
 		return nil, errors.Errorf("unable to parse integer constant %q", s)
 	}
-	return &Int{Typ: typ, X: x}, nil
+	// Integer literals are truncated to the bit width of the integer type (e.g.
+	// `i1 -1` is true), as done by LLVM.
+	return NewIntFromAPInt(typ, x), nil
+}
+
+// NewIntFromAPInt returns a new integer constant based on the given integer
+// type and fixed-width integer of the same bit width.
+func NewIntFromAPInt(typ *types.IntType, x *apint.Int) *Int {
+	if typ.BitSize == 1 {
+		// Boolean constants are stored as 0 or 1.
+		return &Int{Typ: typ, X: x.Uint()}
+	}
+	return &Int{Typ: typ, X: x.Int()}
 }
 
 // String returns the LLVM syntax representation of the constant as a type-value
@@ -103,17 +111,20 @@ func (c *Int) Type() types.Type {
 // Ident returns the identifier associated with the constant.
 func (c *Int) Ident() string {
 	// IntLit
+	x := c.APInt()
 	if c.Typ.BitSize == 1 {
 		// "true"
 		// "false"
-		switch x := c.X.Int64(); x {
-		case 0:
+		if x.IsZero() {
 			return "false"
-		case 1:
-			return "true"
-		default:
-			panic(fmt.Errorf("invalid integer value of boolean type; expected 0 or 1, got %d", x))
 		}
+		return "true"
 	}
-	return c.X.String()
+	return x.String()
+}
+
+// APInt returns the fixed-width integer of the constant, the value of which is
+// truncated or sign-extended to the bit width of its integer type.
+func (c *Int) APInt() *apint.Int {
+	return apint.FromBig(uint(c.Typ.BitSize), c.X)
 }
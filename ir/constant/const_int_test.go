@@ -0,0 +1,36 @@
+package constant_test
+
+import (
+	"testing"
+
+	"github.com/umaumax/llvm/ir/constant"
+	"github.com/umaumax/llvm/ir/types"
+)
+
+func TestNewIntFromString(t *testing.T) {
+	golden := []struct {
+		typ  *types.IntType
+		s    string
+		want string
+	}{
+		{typ: types.I1, s: "-1", want: "true"},
+		{typ: types.I8, s: "255", want: "-1"},
+		{typ: types.I32, s: "s0xFF", want: "-1"},
+		{typ: types.I32, s: "s0x7F", want: "-1"},
+		{typ: types.I32, s: "s0x0", want: "0"},
+		{typ: types.I32, s: "u0xFF", want: "255"},
+		{typ: types.I64, s: "18446744073709551615", want: "-1"},
+		{typ: types.I128, s: "s0x80000000000000000000000000000000", want: "-170141183460469231731687303715884105728"},
+		{typ: types.NewInt(7), s: "100", want: "-28"},
+	}
+	for _, g := range golden {
+		c, err := constant.NewIntFromString(g.typ, g.s)
+		if err != nil {
+			t.Errorf("unable to parse %v %q; %v", g.typ, g.s, err)
+			continue
+		}
+		if got := c.Ident(); got != g.want {
+			t.Errorf("%v %q: integer constant mismatch; expected %q, got %q", g.typ, g.s, g.want, got)
+		}
+	}
+}
@@ -20,6 +20,9 @@ type Vector struct {
 // NewVector returns a new vector constant based on the given vector type and
 // elements. The vector type is infered from the type of the elements if t is
 // nil.
+//
+// Vector constants are of fixed-length vector type; use NewSplat for constants
+// of scalable vector type.
 func NewVector(t *types.VectorType, elems ...Constant) *Vector {
 	c := &Vector{
 		Elems: elems,
@@ -60,3 +63,26 @@ func (c *Vector) Ident() string {
 	buf.WriteString(">")
 	return buf.String()
 }
+
+// NewSplat returns a new constant of the given vector type, every element of
+// which is elem.
+//
+// Constants of fixed-length vector type are represented by vector constants.
+// Constants of scalable vector type (the number of elements of which is unknown
+// at compile time) are represented by a shufflevector expression broadcasting
+// the first element of a vector with a zeroinitializer mask, e.g.
+//
+//    shufflevector (<vscale x 4 x i32> insertelement (<vscale x 4 x i32> undef, i32 1, i32 0), <vscale x 4 x i32> undef, <vscale x 4 x i32> zeroinitializer)
+func NewSplat(t *types.VectorType, elem Constant) Constant {
+	if !t.Scalable {
+		elems := make([]Constant, t.Len)
+		for i := range elems {
+			elems[i] = elem
+		}
+		return NewVector(t, elems...)
+	}
+	undef := NewUndef(t)
+	x := NewInsertElement(undef, elem, NewInt(types.I32, 0))
+	maskType := types.NewScalableVector(t.Len, types.I32)
+	return NewShuffleVector(x, undef, NewZeroInitializer(maskType))
+}
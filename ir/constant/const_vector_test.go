@@ -0,0 +1,36 @@
+package constant_test
+
+import (
+	"testing"
+
+	"github.com/umaumax/llvm/ir/constant"
+	"github.com/umaumax/llvm/ir/types"
+)
+
+func TestNewSplat(t *testing.T) {
+	golden := []struct {
+		t    *types.VectorType
+		elem constant.Constant
+		want string
+	}{
+		{
+			t:    types.NewVector(2, types.I32),
+			elem: constant.NewInt(types.I32, 1),
+			want: "<2 x i32> <i32 1, i32 1>",
+		},
+		{
+			t:    types.NewScalableVector(4, types.I32),
+			elem: constant.NewInt(types.I32, 1),
+			want: "<vscale x 4 x i32> shufflevector (<vscale x 4 x i32> insertelement (<vscale x 4 x i32> undef, i32 1, i32 0), <vscale x 4 x i32> undef, <vscale x 4 x i32> zeroinitializer)",
+		},
+	}
+	for _, g := range golden {
+		c := constant.NewSplat(g.t, g.elem)
+		if got := c.String(); got != g.want {
+			t.Errorf("splat mismatch; expected %q, got %q", g.want, got)
+		}
+		if !c.Type().Equal(g.t) {
+			t.Errorf("splat type mismatch; expected %q, got %q", g.t, c.Type())
+		}
+	}
+}
@@ -30,9 +30,9 @@ type ExprGetElementPtr struct {
 }
 
 // NewGetElementPtr returns a new getelementptr expression based on the given
-// source address and element indices.
-func NewGetElementPtr(src Constant, indices ...Constant) *ExprGetElementPtr {
-	e := &ExprGetElementPtr{Src: src, Indices: indices}
+// element type, source address and element indices.
+func NewGetElementPtr(elemType types.Type, src Constant, indices ...Constant) *ExprGetElementPtr {
+	e := &ExprGetElementPtr{ElemType: elemType, Src: src, Indices: indices}
 	// Compute type.
 	e.Type()
 	return e
@@ -48,22 +48,15 @@ func (e *ExprGetElementPtr) String() string {
 func (e *ExprGetElementPtr) Type() types.Type {
 	// Cache element type if not present.
 	if e.ElemType == nil {
-		switch typ := e.Src.Type().(type) {
-		case *types.PointerType:
-			e.ElemType = typ.ElemType
-		case *types.VectorType:
-			t, ok := typ.ElemType.(*types.PointerType)
-			if !ok {
-				panic(fmt.Errorf("invalid vector element type; expected *types.Pointer, got %T", typ.ElemType))
-			}
-			e.ElemType = t.ElemType
-		default:
-			panic(fmt.Errorf("support for source type %T not yet implemented", typ))
+		t := gepSrcPointer(e.Src.Type())
+		if t.IsOpaque() {
+			panic(fmt.Errorf("unable to derive element type of getelementptr expression from source of opaque pointer type `%s`", e.Src.Type()))
 		}
+		e.ElemType = t.ElemType
 	}
 	// Cache type if not present.
 	if e.Typ == nil {
-		e.Typ = gepType(e.ElemType, e.Indices)
+		e.Typ = gepType(e.Src.Type(), e.ElemType, e.Indices)
 	}
 	return e.Typ
 }
@@ -122,10 +115,28 @@ func (index *Index) String() string {
 
 // ### [ Helper functions ] ####################################################
 
+// gepSrcPointer returns the pointer type of the given getelementptr source type;
+// which is either a pointer type or a vector of pointers type.
+func gepSrcPointer(srcType types.Type) *types.PointerType {
+	switch typ := srcType.(type) {
+	case *types.PointerType:
+		return typ
+	case *types.VectorType:
+		t, ok := typ.ElemType.(*types.PointerType)
+		if !ok {
+			panic(fmt.Errorf("invalid vector element type; expected *types.Pointer, got %T", typ.ElemType))
+		}
+		return t
+	default:
+		panic(fmt.Errorf("support for source type %T not yet implemented", typ))
+	}
+}
+
 // gepType returns the pointer type or vector of pointers type to the element at
 // the position in the type specified by the given indices, as calculated by the
-// getelementptr instruction.
-func gepType(elemType types.Type, indices []Constant) types.Type {
+// getelementptr instruction. The result is of opaque pointer type if the source
+// type is.
+func gepType(srcType, elemType types.Type, indices []Constant) types.Type {
 	e := elemType
 	for i, index := range indices {
 		// unpack inrange indices.
@@ -186,6 +197,10 @@ func gepType(elemType types.Type, indices []Constant) types.Type {
 	//    %113 = getelementptr inbounds %struct.fileinfo, %struct.fileinfo* %96, <2 x i64> %110, !dbg !4736
 	//    %116 = bitcast i8** %115 to <2 x %struct.fileinfo*>*, !dbg !4738
 	//    store <2 x %struct.fileinfo*> %113, <2 x %struct.fileinfo*>* %116, align 8, !dbg !4738, !tbaa !1793
+	ptr := types.NewPointer(e)
+	if src := gepSrcPointer(srcType); src.IsOpaque() {
+		ptr = types.NewOpaquePointer(src.AddrSpace)
+	}
 	if len(indices) > 0 {
 		index := indices[0]
 		// unpack inrange index.
@@ -193,8 +208,11 @@ func gepType(elemType types.Type, indices []Constant) types.Type {
 			index = idx.Constant
 		}
 		if t, ok := index.Type().(*types.VectorType); ok {
-			return types.NewVector(t.Len, types.NewPointer(e))
+			return &types.VectorType{Scalable: t.Scalable, Len: t.Len, ElemType: ptr}
 		}
 	}
-	return types.NewPointer(e)
+	if t, ok := srcType.(*types.VectorType); ok {
+		return &types.VectorType{Scalable: t.Scalable, Len: t.Len, ElemType: ptr}
+	}
+	return ptr
 }
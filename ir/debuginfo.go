@@ -0,0 +1,284 @@
+package ir
+
+import (
+	"fmt"
+
+	"github.com/umaumax/llvm/ir/metadata"
+	"github.com/umaumax/llvm/ir/value"
+)
+
+// === [ Debug info queries ] ==================================================
+
+// --- [ Source locations ] ----------------------------------------------------
+
+// SourceLoc is a source location, as resolved from a DILocation.
+type SourceLoc struct {
+	// Source file; or nil if not present.
+	File *metadata.DIFile
+	// Line number (1-based); or 0 if unknown.
+	Line int64
+	// Column number (1-based); or 0 if unknown.
+	Column int64
+	// Scope of the source location.
+	Scope metadata.Field
+	// Subprogram enclosing the scope; or nil if not present.
+	Subprogram *metadata.DISubprogram
+}
+
+// String returns the string representation of the source location (e.g.
+// "foo.c:12:3").
+func (loc SourceLoc) String() string {
+	if loc.File == nil {
+		return fmt.Sprintf("line %d:%d", loc.Line, loc.Column)
+	}
+	return fmt.Sprintf("%s:%d:%d", loc.File.Filename, loc.Line, loc.Column)
+}
+
+// NewSourceLoc returns the source location of the given DILocation.
+func NewSourceLoc(loc *metadata.DILocation) SourceLoc {
+	return SourceLoc{
+		File:       scopeFile(loc.Scope),
+		Line:       loc.Line,
+		Column:     loc.Column,
+		Scope:      loc.Scope,
+		Subprogram: ScopeSubprogram(loc.Scope),
+	}
+}
+
+// InlineChain returns the inlining chain of the given DILocation; the source
+// location of loc, followed by the source locations into which it was inlined
+// (i.e. the inlined-at locations), from innermost to outermost. The last source
+// location of the chain is within the function containing the instruction.
+func InlineChain(loc *metadata.DILocation) []SourceLoc {
+	var chain []SourceLoc
+	visited := make(map[*metadata.DILocation]bool)
+	for ; loc != nil && !visited[loc]; loc = loc.InlinedAt {
+		visited[loc] = true
+		chain = append(chain, NewSourceLoc(loc))
+	}
+	return chain
+}
+
+// ScopeSubprogram returns the subprogram enclosing the given debug information
+// scope; or nil if not present.
+func ScopeSubprogram(scope metadata.Field) *metadata.DISubprogram {
+	visited := make(map[metadata.Field]bool)
+	for scope != nil && !visited[scope] {
+		visited[scope] = true
+		switch s := scope.(type) {
+		case *metadata.DISubprogram:
+			return s
+		case *metadata.DILexicalBlock:
+			scope = s.Scope
+		case *metadata.DILexicalBlockFile:
+			scope = s.Scope
+		default:
+			return nil
+		}
+	}
+	return nil
+}
+
+// DebugLoc returns the DILocation of the !dbg metadata attachment; or nil if
+// not present.
+func (mds Metadata) DebugLoc() *metadata.DILocation {
+	for _, md := range mds {
+		if md.Name != "dbg" {
+			continue
+		}
+		if loc, ok := md.Node.(*metadata.DILocation); ok {
+			return loc
+		}
+	}
+	return nil
+}
+
+// InstSourceLoc returns the source location of the !dbg metadata attachment of
+// the given instruction or terminator, and a boolean indicating if present.
+func InstSourceLoc(inst interface{}) (SourceLoc, bool) {
+	md, ok := inst.(MetadataAttacher)
+	if !ok {
+		return SourceLoc{}, false
+	}
+	loc := Metadata(md.MDAttachments()).DebugLoc()
+	if loc == nil {
+		return SourceLoc{}, false
+	}
+	return NewSourceLoc(loc), true
+}
+
+// --- [ Subprograms ] ---------------------------------------------------------
+
+// Subprogram returns the subprogram of the !dbg metadata attachment of the
+// function; or nil if not present.
+func (f *Func) Subprogram() *metadata.DISubprogram {
+	for _, md := range f.Metadata {
+		if md.Name != "dbg" {
+			continue
+		}
+		if sp, ok := md.Node.(*metadata.DISubprogram); ok {
+			return sp
+		}
+	}
+	return nil
+}
+
+// --- [ Debug variable intrinsics ] -------------------------------------------
+
+// DbgVarIntrinsic is a call to a debug variable intrinsic (llvm.dbg.declare,
+// llvm.dbg.value or llvm.dbg.addr), which describes a source variable.
+type DbgVarIntrinsic struct {
+	// Call to the debug variable intrinsic.
+	Call *InstCall
+	// Name of the debug variable intrinsic (e.g. "llvm.dbg.value").
+	Intrinsic string
+	// Described IR value; the address of the variable for llvm.dbg.declare and
+	// llvm.dbg.addr, and the value of the variable for llvm.dbg.value. Nil if
+	// the IR value is not present (e.g. optimized out).
+	//
+	// Variadic llvm.dbg.value locations (!DIArgList, combined with
+	// DW_OP_LLVM_arg in the expression) are not supported; the asm parser
+	// rejects DIArgList metadata, and Value is nil for such intrinsics.
+	Value value.Value
+	// Source variable.
+	Variable *metadata.DILocalVariable
+	// (optional) DWARF expression applied to the IR value; or nil if not
+	// present.
+	Expr *metadata.DIExpression
+}
+
+// IsDeclare reports whether the debug variable intrinsic describes the address
+// of the variable (i.e. llvm.dbg.declare or llvm.dbg.addr).
+func (d *DbgVarIntrinsic) IsDeclare() bool {
+	return d.Intrinsic == "llvm.dbg.declare" || d.Intrinsic == "llvm.dbg.addr"
+}
+
+// NewDbgVarIntrinsic returns the debug variable intrinsic of the given
+// instruction, and a boolean indicating if the instruction is a well-formed call
+// to llvm.dbg.declare, llvm.dbg.value or llvm.dbg.addr.
+func NewDbgVarIntrinsic(inst Instruction) (*DbgVarIntrinsic, bool) {
+	call, ok := inst.(*InstCall)
+	if !ok {
+		return nil, false
+	}
+	callee, ok := call.Callee.(*Func)
+	if !ok {
+		return nil, false
+	}
+	switch name := callee.Name(); name {
+	case "llvm.dbg.declare", "llvm.dbg.value", "llvm.dbg.addr":
+		if len(call.Args) != 3 {
+			return nil, false
+		}
+		d := &DbgVarIntrinsic{Call: call, Intrinsic: name}
+		if v, ok := metadataArg(call.Args[0]).(value.Value); ok {
+			d.Value = v
+		}
+		v, ok := metadataArg(call.Args[1]).(*metadata.DILocalVariable)
+		if !ok {
+			return nil, false
+		}
+		d.Variable = v
+		d.Expr, _ = metadataArg(call.Args[2]).(*metadata.DIExpression)
+		return d, true
+	}
+	return nil, false
+}
+
+// DbgVarIntrinsics returns the debug variable intrinsics of the function, in
+// order of occurrence.
+func (f *Func) DbgVarIntrinsics() []*DbgVarIntrinsic {
+	var ds []*DbgVarIntrinsic
+	for _, block := range f.Blocks {
+		for _, inst := range block.Insts {
+			if d, ok := NewDbgVarIntrinsic(inst); ok {
+				ds = append(ds, d)
+			}
+		}
+	}
+	return ds
+}
+
+// DbgVarIntrinsicsOf returns the debug variable intrinsics of the function
+// which describe the given IR value, in order of occurrence.
+func (f *Func) DbgVarIntrinsicsOf(v value.Value) []*DbgVarIntrinsic {
+	var ds []*DbgVarIntrinsic
+	for _, d := range f.DbgVarIntrinsics() {
+		if d.Value == v {
+			ds = append(ds, d)
+		}
+	}
+	return ds
+}
+
+// metadataArg returns the metadata of the given metadata function argument; or
+// nil if not a metadata argument.
+func metadataArg(arg value.Value) metadata.Metadata {
+	if a, ok := arg.(*Arg); ok {
+		arg = a.Value
+	}
+	if md, ok := arg.(*metadata.Value); ok {
+		return md.Value
+	}
+	return nil
+}
+
+// --- [ Line tables ] ---------------------------------------------------------
+
+// LineEntry is an entry of the line table of a function.
+type LineEntry struct {
+	// Basic block of the instruction.
+	Block *Block
+	// First instruction or terminator of the entry.
+	Inst LLStringer
+	// Source location of the instruction.
+	Loc SourceLoc
+}
+
+// LineTable returns the line table of the function; a list of entries mapping
+// instructions to source locations, in instruction order. An entry is added for
+// each instruction (or terminator) with a !dbg metadata attachment whose
+// location (i.e. file, line, column, scope and inlined-at location) differs
+// from the location of the previous entry of the same basic block.
+//
+// As in DWARF line tables, the source location of inlined instructions is the
+// location within the inlined function; use InlineChain to resolve the
+// inlined-at locations.
+func (f *Func) LineTable() []LineEntry {
+	var entries []LineEntry
+	for _, block := range f.Blocks {
+		var prev *lineKey
+		add := func(inst LLStringer) {
+			md, ok := inst.(MetadataAttacher)
+			if !ok {
+				return
+			}
+			dbg := Metadata(md.MDAttachments()).DebugLoc()
+			if dbg == nil {
+				return
+			}
+			loc := NewSourceLoc(dbg)
+			key := lineKey{loc: loc, inlinedAt: dbg.InlinedAt}
+			if prev != nil && *prev == key {
+				return
+			}
+			prev = &key
+			entries = append(entries, LineEntry{Block: block, Inst: inst, Loc: loc})
+		}
+		for _, inst := range block.Insts {
+			add(inst)
+		}
+		if block.Term != nil {
+			add(block.Term)
+		}
+	}
+	return entries
+}
+
+// lineKey identifies the location of an entry of a line table.
+type lineKey struct {
+	// Source location.
+	loc SourceLoc
+	// Inlined-at location; or nil if not inlined.
+	inlinedAt *metadata.DILocation
+}
@@ -0,0 +1,94 @@
+package ir_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/umaumax/llvm/asm"
+	"github.com/umaumax/llvm/ir"
+)
+
+func TestFuncDebugInfo(t *testing.T) {
+	const input = "" +
+		"define i32 @f(i32 %a) !dbg !4 {\n" +
+		"entry:\n" +
+		"  %b = alloca i32\n" +
+		"  call void @llvm.dbg.declare(metadata i32* %b, metadata !8, metadata !DIExpression()), !dbg !7\n" +
+		"  call void @llvm.dbg.value(metadata i32 %a, metadata !9, metadata !DIExpression()), !dbg !7\n" +
+		"  store i32 %a, i32* %b, !dbg !7\n" +
+		"  %x = load i32, i32* %b, !dbg !7\n" +
+		"  %y = add i32 %x, 1, !dbg !12\n" +
+		"  %z = add i32 %y, 2, !dbg !14\n" +
+		"  %w = add i32 %z, 3, !dbg !16\n" +
+		"  ret i32 %w, !dbg !13\n" +
+		"}\n" +
+		"\n" +
+		"declare void @llvm.dbg.declare(metadata, metadata, metadata)\n" +
+		"\n" +
+		"declare void @llvm.dbg.value(metadata, metadata, metadata)\n" +
+		"\n" +
+		"!llvm.dbg.cu = !{!0}\n" +
+		"!llvm.module.flags = !{!3}\n" +
+		"\n" +
+		"!0 = distinct !DICompileUnit(language: DW_LANG_C99, file: !1, emissionKind: FullDebug)\n" +
+		"!1 = !DIFile(filename: \"foo.c\", directory: \"/tmp\")\n" +
+		"!2 = !DIFile(filename: \"bar.h\", directory: \"/tmp\")\n" +
+		"!3 = !{i32 2, !\"Debug Info Version\", i32 3}\n" +
+		"!4 = distinct !DISubprogram(name: \"f\", scope: !1, file: !1, line: 1, type: !5, unit: !0)\n" +
+		"!5 = !DISubroutineType(types: !{})\n" +
+		"!6 = distinct !DILexicalBlock(scope: !4, file: !1, line: 2, column: 3)\n" +
+		"!7 = !DILocation(line: 3, column: 7, scope: !6)\n" +
+		"!8 = !DILocalVariable(name: \"b\", scope: !6, file: !1, line: 3)\n" +
+		"!9 = !DILocalVariable(name: \"a\", arg: 1, scope: !4, file: !1, line: 1)\n" +
+		"!10 = distinct !DISubprogram(name: \"inc\", scope: !2, file: !2, line: 5, type: !5, unit: !0)\n" +
+		"!11 = !DILocation(line: 4, column: 2, scope: !4)\n" +
+		"!12 = !DILocation(line: 6, column: 10, scope: !10, inlinedAt: !11)\n" +
+		"!13 = !DILocation(line: 4, column: 2, scope: !4)\n" +
+		"!14 = !DILocation(line: 6, column: 10, scope: !10, inlinedAt: !15)\n" +
+		"!15 = !DILocation(line: 4, column: 9, scope: !4)\n" +
+		"!16 = !DILocation(line: 4, column: 2, scope: !6)\n"
+	m, err := asm.ParseString("<stdin>", input)
+	if err != nil {
+		t.Fatalf("unable to parse module; %+v", err)
+	}
+	f := m.Funcs[0]
+	if sp := f.Subprogram(); sp == nil || sp.Name != "f" {
+		t.Errorf("subprogram mismatch; expected %q, got %v", "f", sp)
+	}
+	// Source locations and inlining chains.
+	add := f.Blocks[0].Insts[5].(*ir.InstAdd)
+	var chain []string
+	for _, loc := range ir.InlineChain(add.DebugLoc()) {
+		chain = append(chain, fmt.Sprintf("%s (%s)", loc, loc.Subprogram.Name))
+	}
+	if got, want := strings.Join(chain, ", "), "bar.h:6:10 (inc), foo.c:4:2 (f)"; got != want {
+		t.Errorf("inlining chain mismatch; expected %q, got %q", want, got)
+	}
+	if loc, ok := ir.InstSourceLoc(f.Blocks[0].Insts[3]); !ok || loc.String() != "foo.c:3:7" || loc.Subprogram.Name != "f" {
+		t.Errorf("source location of store mismatch; got %v", loc)
+	}
+	// Debug variable intrinsics.
+	ds := f.DbgVarIntrinsics()
+	if len(ds) != 2 {
+		t.Fatalf("number of debug variable intrinsics mismatch; expected 2, got %d", len(ds))
+	}
+	b := f.Blocks[0].Insts[0].(*ir.InstAlloca)
+	if !ds[0].IsDeclare() || ds[0].Value != b || ds[0].Variable.Name != "b" {
+		t.Errorf("llvm.dbg.declare mismatch; got %+v", ds[0])
+	}
+	if ds[1].IsDeclare() || ds[1].Value != f.Params[0] || ds[1].Variable.Name != "a" {
+		t.Errorf("llvm.dbg.value mismatch; got %+v", ds[1])
+	}
+	if got := f.DbgVarIntrinsicsOf(f.Params[0]); len(got) != 1 || got[0].Variable.Name != "a" {
+		t.Errorf("debug variable intrinsics of %%a mismatch; got %v", got)
+	}
+	// Line table.
+	var lines []string
+	for _, entry := range f.LineTable() {
+		lines = append(lines, entry.Loc.String())
+	}
+	if got, want := strings.Join(lines, ", "), "foo.c:3:7, bar.h:6:10, bar.h:6:10, foo.c:4:2, foo.c:4:2"; got != want {
+		t.Errorf("line table mismatch; expected %q, got %q", want, got)
+	}
+}
@@ -0,0 +1,266 @@
+package ir
+
+import (
+	"strings"
+
+	"github.com/umaumax/llvm/ir/metadata"
+	"github.com/pkg/errors"
+)
+
+// --- [ Debug info verifier ] -------------------------------------------------
+
+// DebugInfoErrors is a list of debug information verification errors.
+type DebugInfoErrors []error
+
+// Error returns the error messages of the debug information verification
+// errors, one per line.
+func (es DebugInfoErrors) Error() string {
+	var ss []string
+	for _, e := range es {
+		ss = append(ss, e.Error())
+	}
+	return strings.Join(ss, "\n")
+}
+
+// VerifyDebugInfo verifies the consistency of the debug information of the
+// module, and returns the list of issues found (as DebugInfoErrors); or nil if
+// the debug information is consistent.
+//
+// The following properties are verified:
+//
+//    * the scope chain of every DILocation leads to a DISubprogram, and the
+//      compile unit of every DISubprogram definition is listed in !llvm.dbg.cu;
+//    * inlined-at chains of DILocations are acyclic, and the outermost
+//      DILocation of every instruction is within the DISubprogram of the
+//      function;
+//    * calls in functions with debug information to function definitions with
+//      debug information, and calls to debug variable intrinsics have a !dbg
+//      metadata attachment;
+//    * the variables of debug variable intrinsics belong to the DISubprogram of
+//      their !dbg location, and argument numbers of parameter variables are
+//      unique per DISubprogram;
+//    * DIExpression operations are well-formed;
+//    * type references resolve to DWARF types.
+func (m *Module) VerifyDebugInfo() error {
+	v := &diVerifier{cus: make(map[*metadata.DICompileUnit]bool), identifiers: make(map[string]bool)}
+	if named, ok := m.NamedMetadataDefs["llvm.dbg.cu"]; ok {
+		for _, node := range named.Nodes {
+			cu, ok := node.(*metadata.DICompileUnit)
+			if !ok {
+				v.errorf("!llvm.dbg.cu: invalid compile unit %s", node.Ident())
+				continue
+			}
+			v.cus[cu] = true
+		}
+	}
+	// Collect metadata nodes.
+	var nodes []metadata.Definition
+	m.walkMetadata(newMDWalker(nil, func(md metadata.Definition) bool {
+		nodes = append(nodes, md)
+		if t, ok := md.(*metadata.DICompositeType); ok && len(t.Identifier) > 0 {
+			v.identifiers[t.Identifier] = true
+		}
+		return true
+	}))
+	for _, md := range nodes {
+		v.verifyNode(md)
+	}
+	for _, f := range m.Funcs {
+		v.verifyFunc(f)
+	}
+	if len(v.errs) == 0 {
+		return nil
+	}
+	return v.errs
+}
+
+// diVerifier is a debug information verifier.
+type diVerifier struct {
+	// Compile units listed in !llvm.dbg.cu.
+	cus map[*metadata.DICompileUnit]bool
+	// Identifiers of composite types (used by type references).
+	identifiers map[string]bool
+	// Verification errors.
+	errs DebugInfoErrors
+}
+
+// errorf records a verification error based on the given format specifier and
+// arguments.
+func (v *diVerifier) errorf(format string, args ...interface{}) {
+	v.errs = append(v.errs, errors.Errorf(format, args...))
+}
+
+// verifyNode verifies the given metadata node.
+func (v *diVerifier) verifyNode(md metadata.Definition) {
+	switch md := md.(type) {
+	case *metadata.DISubprogram:
+		if md.Distinct && md.Unit == nil {
+			v.errorf("%s: subprogram definition %q has no compile unit", md.Ident(), md.Name)
+		} else if md.Unit != nil && !v.cus[md.Unit] {
+			v.errorf("%s: compile unit %s of subprogram %q not listed in !llvm.dbg.cu", md.Ident(), md.Unit.Ident(), md.Name)
+		}
+		if md.Type != nil {
+			if _, ok := md.Type.(*metadata.DISubroutineType); !ok {
+				v.errorf("%s: invalid type %s of subprogram %q; expected DISubroutineType", md.Ident(), md.Type, md.Name)
+			}
+		}
+	case *metadata.DILocalVariable:
+		if ScopeSubprogram(md.Scope) == nil {
+			v.errorf("%s: scope of local variable %q does not lead to a DISubprogram", md.Ident(), md.Name)
+		}
+		v.verifyTypeRef(md, md.Type, false)
+	case *metadata.DIGlobalVariable:
+		v.verifyTypeRef(md, md.Type, false)
+	case *metadata.DIDerivedType:
+		v.verifyTypeRef(md, md.BaseType, true)
+	case *metadata.DICompositeType:
+		v.verifyTypeRef(md, md.BaseType, false)
+	case *metadata.DISubroutineType:
+		if md.Types != nil {
+			for _, t := range md.Types.Fields {
+				v.verifyTypeRef(md, t, true)
+			}
+		}
+	case *metadata.DIExpression:
+		if err := md.Validate(); err != nil {
+			v.errorf("%s: %v", md.Ident(), err)
+		}
+	}
+}
+
+// verifyTypeRef verifies that the given type reference of the metadata node
+// resolves to a DWARF type. A nil type reference is valid; a null type
+// reference is valid if void is set.
+func (v *diVerifier) verifyTypeRef(md metadata.Definition, t metadata.Field, void bool) {
+	switch t := t.(type) {
+	case nil:
+		// not present.
+	case *metadata.DIBasicType, *metadata.DIDerivedType, *metadata.DICompositeType, *metadata.DISubroutineType:
+		// valid type.
+	case *metadata.NullLit:
+		if !void {
+			v.errorf("%s: invalid null type reference", md.Ident())
+		}
+	case *metadata.String:
+		if !v.identifiers[t.Value] {
+			v.errorf("%s: unable to resolve type identifier %q", md.Ident(), t.Value)
+		}
+	default:
+		v.errorf("%s: invalid type reference %s", md.Ident(), t)
+	}
+}
+
+// verifyFunc verifies the debug information of the given function.
+func (v *diVerifier) verifyFunc(f *Func) {
+	sp := f.Subprogram()
+	// args maps from (subprogram, inlined-at location, argument number) to
+	// parameter variables.
+	type argKey struct {
+		sp        *metadata.DISubprogram
+		inlinedAt *metadata.DILocation
+		arg       uint64
+	}
+	args := make(map[argKey]*metadata.DILocalVariable)
+	verifyInst := func(inst LLStringer) {
+		mds, ok := inst.(MetadataAttacher)
+		if !ok {
+			return
+		}
+		loc := Metadata(mds.MDAttachments()).DebugLoc()
+		if loc == nil {
+			if sp != nil && isCall(inst) {
+				v.errorf("%s: call %q has no !dbg location in function with debug info", f.Ident(), inst.LLString())
+			}
+			if d, ok := inst.(Instruction); ok {
+				if _, ok := NewDbgVarIntrinsic(d); ok {
+					v.errorf("%s: debug intrinsic %q has no !dbg location", f.Ident(), inst.LLString())
+				}
+			}
+			return
+		}
+		outer, ok := v.verifyLoc(loc)
+		if !ok {
+			v.errorf("%s: invalid !dbg location of %q", f.Ident(), inst.LLString())
+			return
+		}
+		if sp == nil {
+			v.errorf("%s: !dbg location of %q in function without DISubprogram", f.Ident(), inst.LLString())
+		} else if locSP := ScopeSubprogram(outer.Scope); locSP != sp {
+			v.errorf("%s: !dbg location of %q points at wrong subprogram %s; expected %s", f.Ident(), inst.LLString(), locSP.Ident(), sp.Ident())
+		}
+		d, ok := inst.(Instruction)
+		if !ok {
+			return
+		}
+		dbg, ok := NewDbgVarIntrinsic(d)
+		if !ok {
+			return
+		}
+		varSP := ScopeSubprogram(dbg.Variable.Scope)
+		if varSP != ScopeSubprogram(loc.Scope) {
+			v.errorf("%s: mismatched subprogram between variable %q and !dbg location of %q", f.Ident(), dbg.Variable.Name, inst.LLString())
+		}
+		if dbg.Variable.Arg != 0 {
+			key := argKey{sp: varSP, inlinedAt: loc.InlinedAt, arg: dbg.Variable.Arg}
+			if prev, ok := args[key]; ok && prev != dbg.Variable {
+				v.errorf("%s: conflicting parameter variables %q and %q for argument %d", f.Ident(), prev.Name, dbg.Variable.Name, dbg.Variable.Arg)
+			} else {
+				args[key] = dbg.Variable
+			}
+		}
+	}
+	for _, block := range f.Blocks {
+		for _, inst := range block.Insts {
+			verifyInst(inst)
+		}
+		if block.Term != nil {
+			verifyInst(block.Term)
+		}
+	}
+}
+
+// verifyLoc verifies the given DILocation and its inlined-at chain, and returns
+// the outermost location of the chain and a boolean indicating if the chain is
+// valid.
+func (v *diVerifier) verifyLoc(loc *metadata.DILocation) (*metadata.DILocation, bool) {
+	visited := make(map[*metadata.DILocation]bool)
+	for {
+		if visited[loc] {
+			v.errorf("%s: cyclic inlined-at chain", loc.Ident())
+			return nil, false
+		}
+		visited[loc] = true
+		if ScopeSubprogram(loc.Scope) == nil {
+			v.errorf("%s: scope chain does not lead to a DISubprogram", loc.Ident())
+			return nil, false
+		}
+		if loc.InlinedAt == nil {
+			return loc, true
+		}
+		loc = loc.InlinedAt
+	}
+}
+
+// isCall reports whether the given instruction or terminator is a call which
+// requires a !dbg location in functions with debug information; i.e. a call,
+// invoke or callbr to a function definition with a DISubprogram (calls through
+// function declarations, inline assembly and indirect calls are exempt, as
+// they cannot be inlined).
+func isCall(inst LLStringer) bool {
+	var callee interface{}
+	switch inst := inst.(type) {
+	case *InstCall:
+		callee = inst.Callee
+	case *TermInvoke:
+		callee = inst.Invokee
+	case *TermCallBr:
+		callee = inst.Callee
+	default:
+		return false
+	}
+	f, ok := callee.(*Func)
+	if !ok || len(f.Blocks) == 0 {
+		return false
+	}
+	return f.Subprogram() != nil
+}
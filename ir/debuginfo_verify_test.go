@@ -0,0 +1,104 @@
+package ir_test
+
+import (
+	"testing"
+
+	"github.com/umaumax/llvm/asm"
+	"github.com/umaumax/llvm/ir"
+)
+
+func TestModuleVerifyDebugInfo(t *testing.T) {
+	const header = "" +
+		"declare void @llvm.dbg.value(metadata, metadata, metadata)\n" +
+		"\n" +
+		"declare void @g()\n" +
+		"\n" +
+		"!llvm.dbg.cu = !{!0}\n" +
+		"!llvm.module.flags = !{!3}\n" +
+		"\n" +
+		"!0 = distinct !DICompileUnit(language: DW_LANG_C99, file: !1, emissionKind: FullDebug)\n" +
+		"!1 = !DIFile(filename: \"foo.c\", directory: \"/tmp\")\n" +
+		"!2 = !DIBasicType(name: \"int\", size: 32, encoding: DW_ATE_signed)\n" +
+		"!3 = !{i32 2, !\"Debug Info Version\", i32 3}\n" +
+		"!4 = distinct !DISubprogram(name: \"f\", scope: !1, file: !1, line: 1, type: !5, unit: !0)\n" +
+		"!5 = !DISubroutineType(types: !6)\n" +
+		"!6 = !{!2, !2, !2}\n" +
+		"!7 = !DILocation(line: 2, column: 3, scope: !4)\n" +
+		"!8 = !DILocalVariable(name: \"a\", arg: 1, scope: !4, file: !1, line: 1, type: !2)\n" +
+		"!9 = !DILocalVariable(name: \"b\", arg: 2, scope: !4, file: !1, line: 1, type: !2)\n"
+	golden := []struct {
+		input string
+		want  []string
+	}{
+		// Valid debug information.
+		{
+			input: header +
+				"define i32 @f(i32 %a, i32 %b) !dbg !4 {\n" +
+				"  call void @llvm.dbg.value(metadata i32 %a, metadata !8, metadata !DIExpression()), !dbg !7\n" +
+				"  call void @llvm.dbg.value(metadata i32 %b, metadata !9, metadata !DIExpression(DW_OP_plus_uconst, 4, DW_OP_stack_value)), !dbg !7\n" +
+				"  call void @g(), !dbg !7\n" +
+				"  ret i32 %a, !dbg !7\n" +
+				"}\n",
+		},
+		// Calls to function declarations need no !dbg location.
+		{
+			input: header +
+				"define i32 @f(i32 %a, i32 %b) !dbg !4 {\n" +
+				"  call void @g()\n" +
+				"  ret i32 %a, !dbg !7\n" +
+				"}\n",
+		},
+		// Invalid debug information.
+		{
+			input: header +
+				"!10 = distinct !DICompileUnit(language: DW_LANG_C99, file: !1)\n" +
+				"!11 = distinct !DISubprogram(name: \"h\", scope: !1, file: !1, line: 9, type: !12, unit: !10)\n" +
+				"!12 = !DISubroutineType(types: !{!\"unknown\"})\n" +
+				"!13 = !DILocation(line: 10, column: 1, scope: !11)\n" +
+				"!14 = !DILocation(line: 3, column: 1, scope: !1)\n" +
+				"!15 = !DILocalVariable(name: \"c\", arg: 1, scope: !4, file: !1, line: 1, type: !2)\n" +
+				"\n" +
+				"define i32 @f(i32 %a, i32 %b) !dbg !4 {\n" +
+				"  call void @llvm.dbg.value(metadata i32 %a, metadata !8, metadata !DIExpression(DW_OP_LLVM_fragment, 0)), !dbg !7\n" +
+				"  call void @llvm.dbg.value(metadata i32 %b, metadata !15, metadata !DIExpression()), !dbg !7\n" +
+				"  call void @llvm.dbg.value(metadata i32 %b, metadata !9, metadata !DIExpression())\n" +
+				"  %d = call i32 @f(i32 %a, i32 %b)\n" +
+				"  %c = add i32 %a, %b, !dbg !13\n" +
+				"  ret i32 %c, !dbg !14\n" +
+				"}\n",
+			want: []string{
+				"!DIExpression(DW_OP_LLVM_fragment, 0): DWARF operation DW_OP_LLVM_fragment at index 0 requires 2 operands; got 1",
+				"!11: compile unit !10 of subprogram \"h\" not listed in !llvm.dbg.cu",
+				"!12: unable to resolve type identifier \"unknown\"",
+				"@f: conflicting parameter variables \"a\" and \"c\" for argument 1",
+				"@f: debug intrinsic \"call void @llvm.dbg.value(metadata i32 %b, metadata !9, metadata !DIExpression())\" has no !dbg location",
+				"@f: call \"%d = call i32 @f(i32 %a, i32 %b)\" has no !dbg location in function with debug info",
+				"@f: !dbg location of \"%c = add i32 %a, %b, !dbg !13\" points at wrong subprogram !11; expected !4",
+				"!14: scope chain does not lead to a DISubprogram",
+				"@f: invalid !dbg location of \"ret i32 %c, !dbg !14\"",
+			},
+		},
+	}
+	for i, g := range golden {
+		m, err := asm.ParseString("<stdin>", g.input)
+		if err != nil {
+			t.Errorf("%d: unable to parse module; %+v", i, err)
+			continue
+		}
+		var got []string
+		if err := m.VerifyDebugInfo(); err != nil {
+			for _, e := range err.(ir.DebugInfoErrors) {
+				got = append(got, e.Error())
+			}
+		}
+		if len(got) != len(g.want) {
+			t.Errorf("%d: number of errors mismatch; expected %d, got %d: %q", i, len(g.want), len(got), got)
+			continue
+		}
+		for j := range got {
+			if got[j] != g.want[j] {
+				t.Errorf("%d: error %d mismatch; expected %q, got %q", i, j, g.want[j], got[j])
+			}
+		}
+	}
+}
@@ -0,0 +1,483 @@
+package ir
+
+import (
+	"github.com/umaumax/llvm/ir/constant"
+	"github.com/umaumax/llvm/ir/enum"
+	"github.com/umaumax/llvm/ir/metadata"
+	"github.com/umaumax/llvm/ir/types"
+	"github.com/umaumax/llvm/ir/value"
+)
+
+// === [ Debug info builder ] ==================================================
+
+// Module flag behaviours of the debug info module flags.
+const (
+	// Emit a warning on conflicting module flag values.
+	moduleFlagWarning = 2
+	// Take the maximum of conflicting module flag values.
+	moduleFlagMax = 7
+)
+
+// DIBuilder is a builder of debug information metadata, modelled after the
+// DIBuilder of LLVM.
+//
+// Metadata nodes created by the builder are appended to the metadata
+// definitions of the module (except DIExpression nodes, which are printed
+// inline). Finalize must be invoked once all debug information has been
+// created, to fill the lists of the compile unit and add the debug info module
+// flags.
+type DIBuilder struct {
+	// Module of the debug information.
+	Module *Module
+	// DWARF version of the "Dwarf Version" module flag; defaults to 4.
+	DwarfVersion int64
+
+	// Compile unit; or nil if not yet created.
+	cu *metadata.DICompileUnit
+	// Enumeration types, retained types and global variables of the compile
+	// unit.
+	enums, retainedTypes, globals []metadata.Field
+}
+
+// NewDIBuilder returns a new debug information builder for the given module.
+func NewDIBuilder(m *Module) *DIBuilder {
+	return &DIBuilder{Module: m, DwarfVersion: 4}
+}
+
+// CompileUnit returns the compile unit of the builder; or nil if not yet
+// created.
+func (b *DIBuilder) CompileUnit() *metadata.DICompileUnit {
+	return b.cu
+}
+
+// Finalize fills the enumeration types, retained types and global variables
+// lists of the compile unit, and adds the "Dwarf Version" and "Debug Info
+// Version" module flags to the module (if not already present).
+func (b *DIBuilder) Finalize() {
+	if b.cu != nil {
+		if len(b.enums) > 0 {
+			b.cu.Enums = b.newTuple(b.enums...)
+		}
+		if len(b.retainedTypes) > 0 {
+			b.cu.RetainedTypes = b.newTuple(b.retainedTypes...)
+		}
+		if len(b.globals) > 0 {
+			b.cu.Globals = b.newTuple(b.globals...)
+		}
+	}
+	b.addModuleFlag(moduleFlagMax, "Dwarf Version", b.DwarfVersion)
+	// Version of the debug information metadata format of LLVM.
+	const debugMetadataVersion = 3
+	b.addModuleFlag(moduleFlagWarning, "Debug Info Version", debugMetadataVersion)
+}
+
+// --- [ Scopes ] --------------------------------------------------------------
+
+// NewFile returns a new file based on the given file name and directory.
+func (b *DIBuilder) NewFile(filename, directory string) *metadata.DIFile {
+	md := &metadata.DIFile{
+		MetadataID: -1,
+		Filename:   filename,
+		Directory:  directory,
+	}
+	b.add(md)
+	return md
+}
+
+// NewCompileUnit returns a new compile unit based on the given source language,
+// file, producer and optimization flag, and adds it to the llvm.dbg.cu named
+// metadata of the module.
+//
+// The emission kind of the compile unit is full debug information.
+func (b *DIBuilder) NewCompileUnit(lang enum.DwarfLang, file *metadata.DIFile, producer string, isOptimized bool) *metadata.DICompileUnit {
+	md := &metadata.DICompileUnit{
+		MetadataID:   -1,
+		Distinct:     true,
+		Language:     lang,
+		File:         file,
+		Producer:     producer,
+		IsOptimized:  isOptimized,
+		EmissionKind: enum.EmissionKindFullDebug,
+	}
+	b.add(md)
+	b.cu = md
+	b.addNamed("llvm.dbg.cu", md)
+	return md
+}
+
+// NewFunction returns a new subprogram describing the given function, based on
+// the given scope, name, linkage name, file, line number, subroutine type,
+// scope line number, debug info flags and subprogram flags, and attaches it to
+// the function as !dbg.
+//
+// The subprogram is part of the compile unit of the builder if it is a
+// definition (i.e. spFlags includes enum.DISPFlagDefinition).
+func (b *DIBuilder) NewFunction(f *Func, scope metadata.Field, name, linkageName string, file *metadata.DIFile, line int64, typ *metadata.DISubroutineType, scopeLine int64, flags enum.DIFlag, spFlags enum.DISPFlag) *metadata.DISubprogram {
+	isDef := spFlags&enum.DISPFlagDefinition != 0
+	md := &metadata.DISubprogram{
+		MetadataID:  -1,
+		Distinct:    isDef,
+		Scope:       scope,
+		Name:        name,
+		LinkageName: linkageName,
+		File:        file,
+		Line:        line,
+		Type:        typ,
+		ScopeLine:   scopeLine,
+		Flags:       flags,
+		SPFlags:     spFlags,
+	}
+	if isDef {
+		md.Unit = b.cu
+	}
+	b.add(md)
+	if f != nil {
+		f.Metadata = append(f.Metadata, &metadata.Attachment{Name: "dbg", Node: md})
+	}
+	return md
+}
+
+// NewLexicalBlock returns a new lexical block based on the given scope, file,
+// line number and column.
+func (b *DIBuilder) NewLexicalBlock(scope metadata.Field, file *metadata.DIFile, line, column int64) *metadata.DILexicalBlock {
+	md := &metadata.DILexicalBlock{
+		MetadataID: -1,
+		Distinct:   true,
+		Scope:      scope,
+		File:       file,
+		Line:       line,
+		Column:     column,
+	}
+	b.add(md)
+	return md
+}
+
+// --- [ Types ] ---------------------------------------------------------------
+
+// NewBasicType returns a new basic type based on the given name, size in bits
+// and encoding.
+func (b *DIBuilder) NewBasicType(name string, size uint64, encoding enum.DwarfAttEncoding) *metadata.DIBasicType {
+	md := &metadata.DIBasicType{
+		MetadataID: -1,
+		Tag:        enum.DwarfTagBaseType,
+		Name:       name,
+		Size:       size,
+		Encoding:   encoding,
+	}
+	b.add(md)
+	return md
+}
+
+// NewPointerType returns a new pointer type based on the given pointee type and
+// size in bits.
+func (b *DIBuilder) NewPointerType(pointee metadata.Field, size uint64) *metadata.DIDerivedType {
+	return b.NewDerivedType(enum.DwarfTagPointerType, pointee, size)
+}
+
+// NewQualifiedType returns a new qualified type based on the given tag (e.g.
+// enum.DwarfTagConstType) and base type.
+func (b *DIBuilder) NewQualifiedType(tag enum.DwarfTag, baseType metadata.Field) *metadata.DIDerivedType {
+	return b.NewDerivedType(tag, baseType, 0)
+}
+
+// NewDerivedType returns a new derived type based on the given tag, base type
+// and size in bits.
+func (b *DIBuilder) NewDerivedType(tag enum.DwarfTag, baseType metadata.Field, size uint64) *metadata.DIDerivedType {
+	if baseType == nil {
+		// e.g. void*
+		baseType = &metadata.NullLit{}
+	}
+	md := &metadata.DIDerivedType{
+		MetadataID: -1,
+		Tag:        tag,
+		BaseType:   baseType,
+		Size:       size,
+	}
+	b.add(md)
+	return md
+}
+
+// NewTypedef returns a new typedef based on the given base type, name, file,
+// line number and scope.
+func (b *DIBuilder) NewTypedef(baseType metadata.Field, name string, file *metadata.DIFile, line int64, scope metadata.Field) *metadata.DIDerivedType {
+	md := b.NewDerivedType(enum.DwarfTagTypedef, baseType, 0)
+	md.Name = name
+	md.File = file
+	md.Line = line
+	md.Scope = scope
+	return md
+}
+
+// NewMemberType returns a new member type based on the given scope, name, file,
+// line number, size in bits, alignment in bits, offset in bits, debug info
+// flags and base type.
+func (b *DIBuilder) NewMemberType(scope metadata.Field, name string, file *metadata.DIFile, line int64, size, align, offset uint64, flags enum.DIFlag, baseType metadata.Field) *metadata.DIDerivedType {
+	md := b.NewDerivedType(enum.DwarfTagMember, baseType, size)
+	md.Scope = scope
+	md.Name = name
+	md.File = file
+	md.Line = line
+	md.Align = align
+	md.Offset = offset
+	md.Flags = flags
+	return md
+}
+
+// NewStructType returns a new structure type based on the given scope, name,
+// file, line number, size in bits, alignment in bits, debug info flags and
+// elements (e.g. member types).
+func (b *DIBuilder) NewStructType(scope metadata.Field, name string, file *metadata.DIFile, line int64, size, align uint64, flags enum.DIFlag, elems ...metadata.Field) *metadata.DICompositeType {
+	md := b.NewCompositeType(enum.DwarfTagStructureType, name, size, align, elems...)
+	md.Scope = scope
+	md.File = file
+	md.Line = line
+	md.Flags = flags
+	return md
+}
+
+// NewArrayType returns a new array type based on the given size in bits,
+// alignment in bits, element type and subscripts (e.g. subranges).
+func (b *DIBuilder) NewArrayType(size, align uint64, elemType metadata.Field, subscripts ...metadata.Field) *metadata.DICompositeType {
+	md := b.NewCompositeType(enum.DwarfTagArrayType, "", size, align, subscripts...)
+	md.BaseType = elemType
+	return md
+}
+
+// NewEnumerationType returns a new enumeration type based on the given scope,
+// name, file, line number, size in bits, alignment in bits, enumerators and
+// underlying type. The enumeration type is retained by the compile unit.
+func (b *DIBuilder) NewEnumerationType(scope metadata.Field, name string, file *metadata.DIFile, line int64, size, align uint64, enumerators []metadata.Field, underlyingType metadata.Field) *metadata.DICompositeType {
+	md := b.NewCompositeType(enum.DwarfTagEnumerationType, name, size, align, enumerators...)
+	md.Scope = scope
+	md.File = file
+	md.Line = line
+	md.BaseType = underlyingType
+	b.enums = append(b.enums, md)
+	return md
+}
+
+// NewEnumerator returns a new enumerator based on the given name and value.
+func (b *DIBuilder) NewEnumerator(name string, v int64, isUnsigned bool) *metadata.DIEnumerator {
+	md := &metadata.DIEnumerator{
+		MetadataID: -1,
+		Name:       name,
+		Value:      v,
+		IsUnsigned: isUnsigned,
+	}
+	b.add(md)
+	return md
+}
+
+// NewCompositeType returns a new composite type based on the given tag, name,
+// size in bits, alignment in bits and elements.
+func (b *DIBuilder) NewCompositeType(tag enum.DwarfTag, name string, size, align uint64, elems ...metadata.Field) *metadata.DICompositeType {
+	md := &metadata.DICompositeType{
+		MetadataID: -1,
+		Tag:        tag,
+		Name:       name,
+		Size:       size,
+		Align:      align,
+		Elements:   b.newTuple(elems...),
+	}
+	b.add(md)
+	return md
+}
+
+// NewSubrange returns a new subrange based on the given element count and
+// lower bound.
+func (b *DIBuilder) NewSubrange(count, lowerBound int64) *metadata.DISubrange {
+	md := &metadata.DISubrange{
+		MetadataID: -1,
+		Count:      metadata.IntLit(count),
+		LowerBound: lowerBound,
+	}
+	b.add(md)
+	return md
+}
+
+// NewSubroutineType returns a new subroutine type based on the given return
+// type (nil for void) and parameter types.
+func (b *DIBuilder) NewSubroutineType(retType metadata.Field, paramTypes ...metadata.Field) *metadata.DISubroutineType {
+	if retType == nil {
+		retType = &metadata.NullLit{}
+	}
+	md := &metadata.DISubroutineType{
+		MetadataID: -1,
+		Types:      b.newTuple(append([]metadata.Field{retType}, paramTypes...)...),
+	}
+	b.add(md)
+	return md
+}
+
+// RetainType retains the given type in the compile unit, even if unreferenced.
+func (b *DIBuilder) RetainType(typ metadata.Field) {
+	b.retainedTypes = append(b.retainedTypes, typ)
+}
+
+// --- [ Variables ] -----------------------------------------------------------
+
+// NewAutoVariable returns a new local variable based on the given scope, name,
+// file, line number and type.
+func (b *DIBuilder) NewAutoVariable(scope metadata.Field, name string, file *metadata.DIFile, line int64, typ metadata.Field) *metadata.DILocalVariable {
+	return b.NewParameterVariable(scope, name, 0, file, line, typ)
+}
+
+// NewParameterVariable returns a new parameter variable based on the given
+// scope, name, argument number (1-based), file, line number and type.
+func (b *DIBuilder) NewParameterVariable(scope metadata.Field, name string, arg uint64, file *metadata.DIFile, line int64, typ metadata.Field) *metadata.DILocalVariable {
+	md := &metadata.DILocalVariable{
+		MetadataID: -1,
+		Name:       name,
+		Arg:        arg,
+		Scope:      scope,
+		File:       file,
+		Line:       line,
+		Type:       typ,
+	}
+	b.add(md)
+	return md
+}
+
+// NewGlobalVariableExpression returns a new global variable expression
+// describing the given global variable, based on the given scope, name, linkage
+// name, file, line number, type and local flag, and attaches it to the global
+// variable as !dbg. The global variable is part of the compile unit of the
+// builder.
+func (b *DIBuilder) NewGlobalVariableExpression(g *Global, scope metadata.Field, name, linkageName string, file *metadata.DIFile, line int64, typ metadata.Field, isLocal bool) *metadata.DIGlobalVariableExpression {
+	v := &metadata.DIGlobalVariable{
+		MetadataID:   -1,
+		Distinct:     true,
+		Name:         name,
+		Scope:        scope,
+		LinkageName:  linkageName,
+		File:         file,
+		Line:         line,
+		Type:         typ,
+		IsLocal:      isLocal,
+		IsDefinition: true,
+	}
+	b.add(v)
+	md := &metadata.DIGlobalVariableExpression{
+		MetadataID: -1,
+		Var:        v,
+		Expr:       b.NewExpression(),
+	}
+	b.add(md)
+	b.globals = append(b.globals, md)
+	if g != nil {
+		g.Metadata = append(g.Metadata, &metadata.Attachment{Name: "dbg", Node: md})
+	}
+	return md
+}
+
+// NewExpression returns a new DWARF expression based on the given operations
+// (e.g. metadata.OpPlusUconst).
+func (b *DIBuilder) NewExpression(ops ...metadata.DIExprOp) *metadata.DIExpression {
+	return metadata.NewDIExpression(ops...)
+}
+
+// --- [ Locations ] -----------------------------------------------------------
+
+// NewLocation returns a new source location based on the given line number,
+// column, scope and (optional) inlined-at location.
+func (b *DIBuilder) NewLocation(line, column int64, scope metadata.Field, inlinedAt *metadata.DILocation) *metadata.DILocation {
+	md := &metadata.DILocation{
+		MetadataID: -1,
+		Line:       line,
+		Column:     column,
+		Scope:      scope,
+		InlinedAt:  inlinedAt,
+	}
+	b.add(md)
+	return md
+}
+
+// --- [ Intrinsics ] ----------------------------------------------------------
+
+// InsertDeclare appends a call to llvm.dbg.declare to the given basic block,
+// which declares that the variable is stored at the address of storage (e.g. an
+// alloca instruction), and attaches the given location as !dbg.
+func (b *DIBuilder) InsertDeclare(block *Block, storage value.Value, v *metadata.DILocalVariable, expr *metadata.DIExpression, loc *metadata.DILocation) *InstCall {
+	return b.insertDbgCall(block, "llvm.dbg.declare", storage, v, expr, loc)
+}
+
+// InsertValue appends a call to llvm.dbg.value to the given basic block, which
+// describes that the variable has the given value, and attaches the given
+// location as !dbg.
+func (b *DIBuilder) InsertValue(block *Block, val value.Value, v *metadata.DILocalVariable, expr *metadata.DIExpression, loc *metadata.DILocation) *InstCall {
+	return b.insertDbgCall(block, "llvm.dbg.value", val, v, expr, loc)
+}
+
+// insertDbgCall appends a call to the given debug intrinsic to the given basic
+// block.
+func (b *DIBuilder) insertDbgCall(block *Block, intrinsic string, val value.Value, v *metadata.DILocalVariable, expr *metadata.DIExpression, loc *metadata.DILocation) *InstCall {
+	if expr == nil {
+		expr = b.NewExpression()
+	}
+	callee := b.intrinsic(intrinsic)
+	inst := block.NewCall(callee, &metadata.Value{Value: val}, &metadata.Value{Value: v}, &metadata.Value{Value: expr})
+	if loc != nil {
+		inst.Metadata = append(inst.Metadata, &metadata.Attachment{Name: "dbg", Node: loc})
+	}
+	return inst
+}
+
+// intrinsic returns the declaration of the given debug intrinsic in the module,
+// adding it if not present.
+func (b *DIBuilder) intrinsic(name string) *Func {
+	if f := b.Module.Func(name); f != nil {
+		return f
+	}
+	f := b.Module.NewFunc(name, types.Void, NewParam("", types.Metadata), NewParam("", types.Metadata), NewParam("", types.Metadata))
+	f.FuncAttrs = append(f.FuncAttrs, enum.FuncAttrNoUnwind, enum.FuncAttrReadNone)
+	return f
+}
+
+// ### [ Helper functions ] ####################################################
+
+// add appends the given metadata definition to the metadata definitions of the
+// module.
+func (b *DIBuilder) add(md metadata.Definition) {
+	b.Module.MetadataDefs = append(b.Module.MetadataDefs, md)
+}
+
+// newTuple returns a new metadata tuple based on the given fields, which is
+// appended to the metadata definitions of the module.
+func (b *DIBuilder) newTuple(fields ...metadata.Field) *metadata.Tuple {
+	md := &metadata.Tuple{MetadataID: -1, Fields: fields}
+	b.add(md)
+	return md
+}
+
+// addNamed appends the given metadata node to the named metadata definition of
+// the module with the given name, creating it if not present.
+func (b *DIBuilder) addNamed(name string, node metadata.Node) {
+	named, ok := b.Module.NamedMetadataDefs[name]
+	if !ok {
+		named = &metadata.NamedDef{Name: name}
+		b.Module.NamedMetadataDefs[name] = named
+	}
+	named.Nodes = append(named.Nodes, node)
+}
+
+// addModuleFlag adds a module flag with the given behaviour, name and value to
+// the module, if a module flag with the same name is not already present.
+func (b *DIBuilder) addModuleFlag(behaviour int64, name string, v int64) {
+	if named, ok := b.Module.NamedMetadataDefs["llvm.module.flags"]; ok {
+		for _, node := range named.Nodes {
+			flag, ok := node.(*metadata.Tuple)
+			if !ok || len(flag.Fields) != 3 {
+				continue
+			}
+			if s, ok := flag.Fields[1].(*metadata.String); ok && s.Value == name {
+				return
+			}
+		}
+	}
+	flag := b.newTuple(
+		constant.NewInt(types.I32, behaviour),
+		&metadata.String{Value: name},
+		constant.NewInt(types.I32, v),
+	)
+	b.addNamed("llvm.module.flags", flag)
+}
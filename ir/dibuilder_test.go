@@ -0,0 +1,76 @@
+package ir_test
+
+import (
+	"testing"
+
+	"github.com/umaumax/llvm/ir"
+	"github.com/umaumax/llvm/ir/constant"
+	"github.com/umaumax/llvm/ir/enum"
+	"github.com/umaumax/llvm/ir/types"
+)
+
+func TestDIBuilder(t *testing.T) {
+	m := ir.NewModule()
+	b := ir.NewDIBuilder(m)
+	file := b.NewFile("foo.c", "/tmp")
+	cu := b.NewCompileUnit(enum.DwarfLangC99, file, "clang", false)
+	intType := b.NewBasicType("int", 32, enum.DwarfAttEncodingSigned)
+	// int x = 42;
+	g := m.NewGlobalDef("x", constant.NewInt(types.I32, 42))
+	b.NewGlobalVariableExpression(g, cu, "x", "", file, 1, intType, false)
+	// int f(int a) {
+	//    int b = a;
+	//    return b;
+	// }
+	a := ir.NewParam("a", types.I32)
+	f := m.NewFunc("f", types.I32, a)
+	sp := b.NewFunction(f, file, "f", "", file, 2, b.NewSubroutineType(intType, intType), 2, enum.DIFlagPrototyped, enum.DISPFlagDefinition)
+	entry := f.NewBlock("entry")
+	loc := b.NewLocation(3, 8, sp, nil)
+	b.InsertValue(entry, a, b.NewParameterVariable(sp, "a", 1, file, 2, intType), nil, loc)
+	bAddr := entry.NewAlloca(types.I32)
+	b.InsertDeclare(entry, bAddr, b.NewAutoVariable(sp, "b", file, 3, intType), nil, loc)
+	entry.NewStore(a, bAddr).Metadata = ir.Metadata{{Name: "dbg", Node: loc}}
+	load := entry.NewLoad(types.I32, bAddr)
+	ret := entry.NewRet(load)
+	ret.Metadata = ir.Metadata{{Name: "dbg", Node: b.NewLocation(4, 4, sp, nil)}}
+	b.Finalize()
+	const want = "" +
+		"@x = global i32 42, !dbg !4\n" +
+		"\n" +
+		"define i32 @f(i32 %a) !dbg !7 {\n" +
+		"entry:\n" +
+		"\tcall void @llvm.dbg.value(metadata i32 %a, metadata !9, metadata !DIExpression()), !dbg !8\n" +
+		"\t%0 = alloca i32\n" +
+		"\tcall void @llvm.dbg.declare(metadata i32* %0, metadata !10, metadata !DIExpression()), !dbg !8\n" +
+		"\tstore i32 %a, i32* %0, !dbg !8\n" +
+		"\t%1 = load i32, i32* %0\n" +
+		"\tret i32 %1, !dbg !11\n" +
+		"}\n" +
+		"\n" +
+		"declare void @llvm.dbg.value(metadata, metadata, metadata) nounwind readnone\n" +
+		"\n" +
+		"declare void @llvm.dbg.declare(metadata, metadata, metadata) nounwind readnone\n" +
+		"\n" +
+		"!llvm.dbg.cu = !{!1}\n" +
+		"!llvm.module.flags = !{!13, !14}\n" +
+		"\n" +
+		"!0 = !DIFile(filename: \"foo.c\", directory: \"/tmp\")\n" +
+		"!1 = distinct !DICompileUnit(language: DW_LANG_C99, file: !0, producer: \"clang\", emissionKind: FullDebug, globals: !12)\n" +
+		"!2 = !DIBasicType(tag: DW_TAG_base_type, name: \"int\", size: 32, encoding: DW_ATE_signed)\n" +
+		"!3 = distinct !DIGlobalVariable(name: \"x\", scope: !1, file: !0, line: 1, type: !2, isDefinition: true)\n" +
+		"!4 = !DIGlobalVariableExpression(var: !3, expr: !DIExpression())\n" +
+		"!5 = !{!2, !2}\n" +
+		"!6 = !DISubroutineType(types: !5)\n" +
+		"!7 = distinct !DISubprogram(name: \"f\", scope: !0, file: !0, line: 2, type: !6, scopeLine: 2, flags: DIFlagPrototyped, spFlags: DISPFlagDefinition, unit: !1)\n" +
+		"!8 = !DILocation(line: 3, column: 8, scope: !7)\n" +
+		"!9 = !DILocalVariable(name: \"a\", arg: 1, scope: !7, file: !0, line: 2, type: !2)\n" +
+		"!10 = !DILocalVariable(name: \"b\", scope: !7, file: !0, line: 3, type: !2)\n" +
+		"!11 = !DILocation(line: 4, column: 4, scope: !7)\n" +
+		"!12 = !{!4}\n" +
+		"!13 = !{i32 7, !\"Dwarf Version\", i32 4}\n" +
+		"!14 = !{i32 2, !\"Debug Info Version\", i32 3}\n"
+	if got := m.String(); got != want {
+		t.Errorf("output mismatch; expected %q, got %q", want, got)
+	}
+}
@@ -535,7 +535,12 @@ const (
 	DwarfOpGNUAddrIndex      DwarfOp = 0xFB // DW_OP_GNU_addr_index
 	DwarfOpGNUConstIndex     DwarfOp = 0xFC // DW_OP_GNU_const_index
 	// Only used in LLVM metadata.
-	DwarfOpLLVMFragment DwarfOp = 0x1000 // DW_OP_LLVM_fragment
+	DwarfOpLLVMFragment        DwarfOp = 0x1000 // DW_OP_LLVM_fragment
+	DwarfOpLLVMConvert         DwarfOp = 0x1001 // DW_OP_LLVM_convert
+	DwarfOpLLVMTagOffset       DwarfOp = 0x1002 // DW_OP_LLVM_tag_offset
+	DwarfOpLLVMEntryValue      DwarfOp = 0x1003 // DW_OP_LLVM_entry_value
+	DwarfOpLLVMImplicitPointer DwarfOp = 0x1004 // DW_OP_LLVM_implicit_pointer
+	DwarfOpLLVMArg             DwarfOp = 0x1005 // DW_OP_LLVM_arg
 )
 
 //go:generate stringer -linecomment -type DwarfTag
@@ -6,6 +6,10 @@ package enum
 // the metadata.DIExpressionField interface.
 func (DwarfOp) IsDIExpressionField() {}
 
+// IsDIExpressionField ensures that only DIExpression fields can be assigned to
+// the metadata.DIExpressionField interface.
+func (DwarfAttEncoding) IsDIExpressionField() {}
+
 // === [ ir.FuncAttribute ] ====================================================
 
 // IsFuncAttribute ensures that only function attributes can be assigned to the
@@ -0,0 +1,496 @@
+package ir
+
+import (
+	"math/big"
+	"reflect"
+
+	"github.com/umaumax/llvm/ir/constant"
+	"github.com/umaumax/llvm/ir/metadata"
+	"github.com/umaumax/llvm/ir/types"
+	"github.com/umaumax/llvm/ir/value"
+)
+
+// === [ Structural equality ] =================================================
+
+// EqualModule reports whether the given modules are structurally equal, modulo
+// the naming of local identifiers (function parameters, basic blocks and
+// results of instructions) and metadata IDs.
+//
+// Global identifiers (global variables, functions, aliases and IFuncs) and
+// type definitions are compared by name, and must be defined in the same order
+// in both modules.
+func EqualModule(a, b *Module) bool {
+	c := newComparer()
+	// Map local identifiers of all functions up front, as they may be referenced
+	// from outside of their function (e.g. blockaddress constants).
+	if len(a.Funcs) != len(b.Funcs) {
+		return false
+	}
+	for i := range a.Funcs {
+		if !c.mapLocals(a.Funcs[i], b.Funcs[i]) {
+			return false
+		}
+	}
+	// Type definitions.
+	if len(a.TypeDefs) != len(b.TypeDefs) {
+		return false
+	}
+	for i := range a.TypeDefs {
+		x, y := a.TypeDefs[i], b.TypeDefs[i]
+		if x.Name() != y.Name() || x.LLString() != y.LLString() {
+			return false
+		}
+	}
+	// Global variables.
+	if len(a.Globals) != len(b.Globals) {
+		return false
+	}
+	for i := range a.Globals {
+		if !c.equalFields(reflect.ValueOf(a.Globals[i]).Elem(), reflect.ValueOf(b.Globals[i]).Elem()) {
+			return false
+		}
+	}
+	// Functions.
+	for i := range a.Funcs {
+		if !c.equalFunc(a.Funcs[i], b.Funcs[i], false) {
+			return false
+		}
+	}
+	// Aliases.
+	if len(a.Aliases) != len(b.Aliases) {
+		return false
+	}
+	for i := range a.Aliases {
+		if !c.equalFields(reflect.ValueOf(a.Aliases[i]).Elem(), reflect.ValueOf(b.Aliases[i]).Elem()) {
+			return false
+		}
+	}
+	// IFuncs.
+	if len(a.IFuncs) != len(b.IFuncs) {
+		return false
+	}
+	for i := range a.IFuncs {
+		if !c.equalFields(reflect.ValueOf(a.IFuncs[i]).Elem(), reflect.ValueOf(b.IFuncs[i]).Elem()) {
+			return false
+		}
+	}
+	// Remaining module fields.
+	return c.equalFields(reflect.ValueOf(a).Elem(), reflect.ValueOf(b).Elem(), "TypeDefs", "Globals", "Funcs", "Aliases", "IFuncs")
+}
+
+// EqualFunc reports whether the given functions are structurally equal, modulo
+// the names of the functions, the naming of local identifiers (function
+// parameters, basic blocks and results of instructions) and metadata IDs.
+//
+// Two functions are equal if their signatures, attributes and bodies are
+// equal; where each local identifier of a is consistently renamed to the
+// corresponding local identifier of b (i.e. alpha-equivalence). References to
+// global identifiers are compared by name, with the exception of recursive
+// references to the function itself.
+func EqualFunc(a, b *Func) bool {
+	c := newComparer()
+	return c.equalFunc(a, b, true)
+}
+
+// EquivFunc reports whether the given functions are equivalent; i.e.
+// structurally equal as by EqualFunc, except that pointer types of the same
+// address space are considered equivalent (e.g. i8* and i32*), and that the
+// linkage-related properties of the functions (linkage, preemption, visibility,
+// DLL storage class and unnamed_addr) are ignored.
+func EquivFunc(a, b *Func) bool {
+	c := newComparer()
+	c.ptrEquiv = true
+	return c.equalFunc(a, b, true)
+}
+
+// EqualFuncHeader reports whether the headers of the given functions (i.e. the
+// signatures, parameters, attributes, linkage and other properties, but not the
+// bodies) are structurally equal, modulo the names of function parameters.
+func EqualFuncHeader(a, b *Func) bool {
+	if len(a.Params) != len(b.Params) {
+		return false
+	}
+	c := newComparer()
+	c.addLocal(a, b)
+	for i := range a.Params {
+		c.addLocal(a.Params[i], b.Params[i])
+	}
+	a.Type()
+	b.Type()
+	if !c.equalFields(reflect.ValueOf(a).Elem(), reflect.ValueOf(b).Elem(), "GlobalIdent", "Params", "Blocks", "UseListOrders") {
+		return false
+	}
+	for i := range a.Params {
+		if !c.equalFields(reflect.ValueOf(a.Params[i]).Elem(), reflect.ValueOf(b.Params[i]).Elem()) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualGlobal reports whether the given global variables are structurally
+// equal, modulo metadata IDs. References to global identifiers are compared by
+// name.
+func EqualGlobal(a, b *Global) bool {
+	c := newComparer()
+	return c.equalFields(reflect.ValueOf(a).Elem(), reflect.ValueOf(b).Elem())
+}
+
+// EqualAlias reports whether the given aliases are structurally equal, modulo
+// metadata IDs. References to global identifiers are compared by name.
+func EqualAlias(a, b *Alias) bool {
+	c := newComparer()
+	return c.equalFields(reflect.ValueOf(a).Elem(), reflect.ValueOf(b).Elem())
+}
+
+// EqualIFunc reports whether the given IFuncs are structurally equal, modulo
+// metadata IDs. References to global identifiers are compared by name.
+func EqualIFunc(a, b *IFunc) bool {
+	c := newComparer()
+	return c.equalFields(reflect.ValueOf(a).Elem(), reflect.ValueOf(b).Elem())
+}
+
+// EqualInst reports whether the given instructions or terminators are
+// structurally equal, modulo metadata IDs. Local operands (function parameters,
+// basic blocks and results of instructions and terminators) are compared using
+// eqLocal.
+func EqualInst(x, y interface{}, eqLocal func(a, b value.Value) bool) bool {
+	c := newComparer()
+	c.eqLocal = eqLocal
+	return c.equalLocal(x, y)
+}
+
+// EqualConst reports whether the given constants are structurally equal.
+// References to global identifiers are compared by name.
+func EqualConst(a, b constant.Constant) bool {
+	c := newComparer()
+	return c.equal(reflect.ValueOf(&a).Elem(), reflect.ValueOf(&b).Elem())
+}
+
+// comparer tracks the state of structural equality comparisons.
+type comparer struct {
+	// locals maps from local values of a to corresponding local values of b.
+	locals map[interface{}]interface{}
+	// rlocals maps from local values of b to corresponding local values of a.
+	rlocals map[interface{}]interface{}
+	// visited records pairs of pointers which are compared or being compared;
+	// used to handle shared and cyclic values (e.g. metadata nodes).
+	visited map[[2]interface{}]bool
+	// ptrEquiv specifies whether pointer types of the same address space are
+	// considered equivalent.
+	ptrEquiv bool
+	// (optional) eqLocal reports whether the given local values are equal; used
+	// in place of locals when comparing individual instructions.
+	eqLocal func(a, b value.Value) bool
+}
+
+// newComparer returns a new comparer.
+func newComparer() *comparer {
+	return &comparer{
+		locals:  make(map[interface{}]interface{}),
+		rlocals: make(map[interface{}]interface{}),
+		visited: make(map[[2]interface{}]bool),
+	}
+}
+
+// addLocal records x of a as corresponding to y of b.
+func (c *comparer) addLocal(x, y interface{}) {
+	c.locals[x] = y
+	c.rlocals[y] = x
+}
+
+// equalFunc reports whether the given functions are structurally equal. The
+// function names are ignored if ignoreName is set.
+func (c *comparer) equalFunc(a, b *Func, ignoreName bool) bool {
+	if ignoreName {
+		c.addLocal(a, b)
+	}
+	if !c.mapLocals(a, b) {
+		return false
+	}
+	// Compare function header.
+	a.Type()
+	b.Type()
+	skip := []string{"Params", "Blocks"}
+	if ignoreName {
+		skip = append(skip, "GlobalIdent")
+	}
+	if c.ptrEquiv {
+		skip = append(skip, linkageFields...)
+	}
+	if !c.equalFields(reflect.ValueOf(a).Elem(), reflect.ValueOf(b).Elem(), skip...) {
+		return false
+	}
+	// Compare function parameters.
+	for i := range a.Params {
+		if !c.equalFields(reflect.ValueOf(a.Params[i]).Elem(), reflect.ValueOf(b.Params[i]).Elem()) {
+			return false
+		}
+	}
+	// Compare function body.
+	for i := range a.Blocks {
+		x, y := a.Blocks[i], b.Blocks[i]
+		for j := range x.Insts {
+			if !c.equalLocal(x.Insts[j], y.Insts[j]) {
+				return false
+			}
+		}
+		if (x.Term == nil) != (y.Term == nil) {
+			return false
+		}
+		if x.Term != nil && !c.equalLocal(x.Term, y.Term) {
+			return false
+		}
+	}
+	return true
+}
+
+// mapLocals maps the local identifiers of a to the corresponding local
+// identifiers of b, based on position. The boolean return value indicates
+// success; i.e. that the functions have the same shape.
+func (c *comparer) mapLocals(a, b *Func) bool {
+	if len(a.Params) != len(b.Params) || len(a.Blocks) != len(b.Blocks) {
+		return false
+	}
+	for i := range a.Params {
+		c.addLocal(a.Params[i], b.Params[i])
+	}
+	for i := range a.Blocks {
+		x, y := a.Blocks[i], b.Blocks[i]
+		if len(x.Insts) != len(y.Insts) {
+			return false
+		}
+		c.addLocal(x, y)
+		for j := range x.Insts {
+			c.addLocal(x.Insts[j], y.Insts[j])
+		}
+		if x.Term != nil && y.Term != nil {
+			c.addLocal(x.Term, y.Term)
+		}
+	}
+	return true
+}
+
+// equalLocal reports whether the given instructions or terminators are
+// structurally equal.
+func (c *comparer) equalLocal(x, y interface{}) bool {
+	xv, yv := reflect.ValueOf(x), reflect.ValueOf(y)
+	if xv.Type() != yv.Type() {
+		return false
+	}
+	// Compute cached types.
+	if v, ok := x.(value.Value); ok {
+		v.Type()
+		y.(value.Value).Type()
+	}
+	return c.equalFields(xv.Elem(), yv.Elem())
+}
+
+// equalFields reports whether the fields of the given structures are equal.
+// The names of fields to skip may be optionally specified.
+func (c *comparer) equalFields(x, y reflect.Value, skip ...string) bool {
+	t := x.Type()
+loop:
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if skipField(field) {
+			continue
+		}
+		for _, name := range skip {
+			if field.Name == name {
+				continue loop
+			}
+		}
+		if !c.equal(x.Field(i), y.Field(i)) {
+			return false
+		}
+	}
+	return true
+}
+
+// equal reports whether the given values are structurally equal.
+func (c *comparer) equal(x, y reflect.Value) bool {
+	// Local values of differing types (e.g. results of different instructions)
+	// may be considered equal by eqLocal.
+	if c.eqLocal != nil && isLocalValue(x) {
+		if !isLocalValue(y) {
+			return false
+		}
+		return c.eqLocal(x.Interface().(value.Value), y.Interface().(value.Value))
+	}
+	if x.Type() != y.Type() {
+		return false
+	}
+	switch x.Kind() {
+	case reflect.Interface:
+		if x.IsNil() || y.IsNil() {
+			return x.IsNil() == y.IsNil()
+		}
+		return c.equal(unwrapIndex(x.Elem()), unwrapIndex(y.Elem()))
+	case reflect.Ptr:
+		if x.IsNil() || y.IsNil() {
+			return x.IsNil() == y.IsNil()
+		}
+		xi, yi := x.Interface(), y.Interface()
+		// Local identifiers.
+		if v, ok := c.locals[xi]; ok {
+			return v == yi
+		}
+		if _, ok := c.rlocals[yi]; ok {
+			return false
+		}
+		switch xi := xi.(type) {
+		case types.Type:
+			if c.ptrEquiv {
+				return equivType(xi, yi.(types.Type))
+			}
+			return xi.Equal(yi.(types.Type))
+		case *big.Int:
+			return xi.Cmp(yi.(*big.Int)) == 0
+		case *big.Float:
+			yi := yi.(*big.Float)
+			return xi.Cmp(yi) == 0 && xi.Signbit() == yi.Signbit()
+		case *Global, *Func, *Alias, *IFunc:
+			// Global identifiers are compared by name.
+			return xi.(value.Value).Ident() == yi.(value.Value).Ident()
+		}
+		key := [2]interface{}{xi, yi}
+		if c.visited[key] {
+			return true
+		}
+		c.visited[key] = true
+		// Compute cached types.
+		if v, ok := xi.(value.Value); ok {
+			v.Type()
+			yi.(value.Value).Type()
+		}
+		return c.equal(x.Elem(), y.Elem())
+	case reflect.Struct:
+		return c.equalFields(x, y)
+	case reflect.Slice, reflect.Array:
+		if x.Len() != y.Len() {
+			return false
+		}
+		for i := 0; i < x.Len(); i++ {
+			if !c.equal(x.Index(i), y.Index(i)) {
+				return false
+			}
+		}
+		return true
+	case reflect.Map:
+		if x.Len() != y.Len() {
+			return false
+		}
+		for _, key := range x.MapKeys() {
+			v := y.MapIndex(key)
+			if !v.IsValid() || !c.equal(x.MapIndex(key), v) {
+				return false
+			}
+		}
+		return true
+	default:
+		return x.Interface() == y.Interface()
+	}
+}
+
+// ### [ Helper functions ] ####################################################
+
+var (
+	// localIdentType is the reflection type of local identifiers.
+	localIdentType = reflect.TypeOf(LocalIdent{})
+	// metadataIDType is the reflection type of metadata IDs.
+	metadataIDType = reflect.TypeOf(metadata.MetadataID(0))
+)
+
+// linkageFields specifies the names of the linkage-related fields of functions,
+// which are ignored when comparing functions for equivalence.
+var linkageFields = []string{"Linkage", "Preemption", "Visibility", "DLLStorageClass", "UnnamedAddr"}
+
+// skipField reports whether the given structure field should be ignored by
+// structural comparison and hashing; i.e. unexported fields, parent pointers,
+// names of local identifiers and metadata IDs.
+func skipField(field reflect.StructField) bool {
+	switch {
+	case len(field.PkgPath) > 0:
+		// unexported field.
+		return true
+	case field.Name == "Parent":
+		return true
+	case field.Type == localIdentType, field.Type == metadataIDType:
+		return true
+	}
+	return false
+}
+
+// isLocalValue reports whether the given value is a local value; i.e. a
+// function parameter, basic block or the result of an instruction or
+// terminator.
+func isLocalValue(v reflect.Value) bool {
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return false
+	}
+	switch x := v.Interface().(type) {
+	case *Param, *Block, Instruction, Terminator:
+		_, ok := x.(value.Value)
+		return ok
+	}
+	return false
+}
+
+// unwrapIndex returns the constant of the given getelementptr index, if not
+// marked as inrange; as the parser wraps all constant getelementptr indices.
+func unwrapIndex(v reflect.Value) reflect.Value {
+	if index, ok := v.Interface().(*constant.Index); ok && !index.InRange {
+		return reflect.ValueOf(index.Constant)
+	}
+	return v
+}
+
+// equivType reports whether the given types are equivalent, considering
+// pointer types of the same address space as equivalent.
+func equivType(t, u types.Type) bool {
+	switch t := t.(type) {
+	case *types.PointerType:
+		u, ok := u.(*types.PointerType)
+		return ok && t.AddrSpace == u.AddrSpace
+	case *types.VectorType:
+		u, ok := u.(*types.VectorType)
+		return ok && t.Scalable == u.Scalable && t.Len == u.Len && equivType(t.ElemType, u.ElemType)
+	case *types.ArrayType:
+		u, ok := u.(*types.ArrayType)
+		return ok && t.Len == u.Len && equivType(t.ElemType, u.ElemType)
+	case *types.StructType:
+		u, ok := u.(*types.StructType)
+		if !ok {
+			return false
+		}
+		if len(t.TypeName) > 0 || len(u.TypeName) > 0 {
+			return t.TypeName == u.TypeName
+		}
+		if t.Packed != u.Packed || len(t.Fields) != len(u.Fields) {
+			return false
+		}
+		for i := range t.Fields {
+			if !equivType(t.Fields[i], u.Fields[i]) {
+				return false
+			}
+		}
+		return true
+	case *types.FuncType:
+		u, ok := u.(*types.FuncType)
+		if !ok || t.Variadic != u.Variadic || len(t.Params) != len(u.Params) {
+			return false
+		}
+		if !equivType(t.RetType, u.RetType) {
+			return false
+		}
+		for i := range t.Params {
+			if !equivType(t.Params[i], u.Params[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return t.Equal(u)
+	}
+}
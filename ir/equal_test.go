@@ -0,0 +1,92 @@
+package ir_test
+
+import (
+	"testing"
+
+	"github.com/umaumax/llvm/asm"
+	"github.com/umaumax/llvm/ir"
+	"github.com/umaumax/llvm/stress"
+)
+
+func TestEqualFunc(t *testing.T) {
+	golden := []struct {
+		a, b string
+		want bool
+	}{
+		// Renamed locals.
+		{
+			a:    "define i32 @f(i32 %x, i32 %y) {\nentry:\n\t%sum = add i32 %x, %y\n\tret i32 %sum\n}\n",
+			b:    "define i32 @g(i32, i32) {\n; <label>:2\n\t%3 = add i32 %0, %1\n\tret i32 %3\n}\n",
+			want: true,
+		},
+		// Swapped operands.
+		{
+			a:    "define i32 @f(i32 %x, i32 %y) {\n\t%sum = add i32 %x, %y\n\tret i32 %sum\n}\n",
+			b:    "define i32 @f(i32 %x, i32 %y) {\n\t%sum = add i32 %y, %x\n\tret i32 %sum\n}\n",
+			want: false,
+		},
+		// Different constants.
+		{
+			a:    "define i32 @f(i32 %x) {\n\t%y = add i32 %x, 1\n\tret i32 %y\n}\n",
+			b:    "define i32 @f(i32 %x) {\n\t%y = add i32 %x, 2\n\tret i32 %y\n}\n",
+			want: false,
+		},
+		// Recursive calls.
+		{
+			a:    "define void @f() {\n\tcall void @f()\n\tret void\n}\n",
+			b:    "define void @g() {\n\tcall void @g()\n\tret void\n}\n",
+			want: true,
+		},
+		// Loops with phi instructions and renamed basic blocks.
+		{
+			a:    "define i32 @f(i32 %n) {\nentry:\n\tbr label %loop\nloop:\n\t%i = phi i32 [ 0, %entry ], [ %j, %loop ]\n\t%j = add i32 %i, 1\n\t%c = icmp slt i32 %j, %n\n\tbr i1 %c, label %loop, label %exit\nexit:\n\tret i32 %j\n}\n",
+			b:    "define i32 @f(i32 %m) {\nbb0:\n\tbr label %bb1\nbb1:\n\t%a = phi i32 [ 0, %bb0 ], [ %b, %bb1 ]\n\t%b = add i32 %a, 1\n\t%c = icmp slt i32 %b, %m\n\tbr i1 %c, label %bb1, label %bb2\nbb2:\n\tret i32 %b\n}\n",
+			want: true,
+		},
+		// Different branch targets.
+		{
+			a:    "define void @f(i1 %c) {\n\tbr i1 %c, label %a, label %b\na:\n\tret void\nb:\n\tret void\n}\n",
+			b:    "define void @f(i1 %c) {\n\tbr i1 %c, label %b, label %a\na:\n\tret void\nb:\n\tret void\n}\n",
+			want: false,
+		},
+	}
+	for _, g := range golden {
+		a, err := asm.ParseString("", g.a)
+		if err != nil {
+			t.Errorf("unable to parse %q; %+v", g.a, err)
+			continue
+		}
+		b, err := asm.ParseString("", g.b)
+		if err != nil {
+			t.Errorf("unable to parse %q; %+v", g.b, err)
+			continue
+		}
+		f, g2 := a.Funcs[0], b.Funcs[0]
+		if got := ir.EqualFunc(f, g2); got != g.want {
+			t.Errorf("equality mismatch of `%s` and `%s`; expected %v, got %v", f, g2, g.want, got)
+		}
+		if g.want && ir.HashFunc(f) != ir.HashFunc(g2) {
+			t.Errorf("hash mismatch of equal functions `%s` and `%s`", f, g2)
+		}
+	}
+}
+
+func TestEqualModule(t *testing.T) {
+	for seed := int64(0); seed < 50; seed++ {
+		m := stress.Generate(seed, stress.DefaultConfig)
+		m2, err := asm.ParseString("", m.String())
+		if err != nil {
+			t.Errorf("seed %d: unable to parse generated module; %+v", seed, err)
+			continue
+		}
+		if !ir.EqualModule(m, m2) {
+			t.Errorf("seed %d: module mismatch after round-trip", seed)
+			continue
+		}
+		for i := range m.Funcs {
+			if ir.HashFunc(m.Funcs[i]) != ir.HashFunc(m2.Funcs[i]) {
+				t.Errorf("seed %d: hash mismatch of function %s after round-trip", seed, m.Funcs[i].Ident())
+			}
+		}
+	}
+}
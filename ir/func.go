@@ -74,6 +74,9 @@ type Func struct {
 
 	// mu prevents races on AssignIDs.
 	mu sync.Mutex
+	// localSyms maps from local identifiers to function parameters, basic blocks
+	// and the results of instructions and terminators.
+	localSyms symbolTable
 }
 
 // NewFunc returns a new function based on the given function name, return type
@@ -109,39 +112,9 @@ func (f *Func) Type() types.Type {
 // LLString returns the LLVM syntax representation of the function definition or
 // declaration.
 func (f *Func) LLString() string {
-	// Function declaration.
-	//
-	//    'declare' Metadata=MetadataAttachment* Header=FuncHeader
-	//
-	// Function definition.
-	//
-	//    'define' Header=FuncHeader Metadata=MetadataAttachment* Body=FuncBody
 	buf := &strings.Builder{}
-	if len(f.Blocks) == 0 {
-		// Function declaration.
-		buf.WriteString("declare")
-		for _, md := range f.Metadata {
-			fmt.Fprintf(buf, " %s", md)
-		}
-		if f.Linkage != enum.LinkageNone {
-			fmt.Fprintf(buf, " %s", f.Linkage)
-		}
-		buf.WriteString(headerString(f))
-		return buf.String()
-	}
-	// Function definition.
-	if err := f.AssignIDs(); err != nil {
-		panic(fmt.Errorf("unable to assign IDs of function %q; %v", f.Ident(), err))
-	}
-	buf.WriteString("define")
-	if f.Linkage != enum.LinkageNone {
-		fmt.Fprintf(buf, " %s", f.Linkage)
-	}
-	buf.WriteString(headerString(f))
-	for _, md := range f.Metadata {
-		fmt.Fprintf(buf, " %s", md)
-	}
-	fmt.Fprintf(buf, " %s", bodyString(f))
+	w := newWriter(buf, nil)
+	w.writeFunc(f)
 	return buf.String()
 }
 
@@ -153,61 +126,54 @@ func (f *Func) AssignIDs() error {
 	f.mu.Lock()
 	defer f.mu.Unlock()
 	id := int64(0)
-	setName := func(n local) error {
-		if n.IsUnnamed() {
-			if n.ID() != 0 && id != n.ID() {
-				want := strconv.FormatInt(id, 10)
-				got := strconv.FormatInt(n.ID(), 10)
-				return errors.Errorf("invalid local ID in function %q, expected %s, got %s", f.Ident(), enc.Local(want), enc.Local(got))
-			}
-			n.SetID(id)
-			id++
+	for _, n := range funcLocals(f) {
+		if !n.IsUnnamed() {
+			continue
 		}
-		return nil
+		if n.ID() != 0 && id != n.ID() {
+			want := strconv.FormatInt(id, 10)
+			got := strconv.FormatInt(n.ID(), 10)
+			return errors.Errorf("invalid local ID in function %q, expected %s, got %s", f.Ident(), enc.Local(want), enc.Local(got))
+		}
+		n.SetID(id)
+		id++
 	}
+	return nil
+}
+
+// ### [ Helper functions ] ####################################################
+
+// funcLocals returns the local variables of the given function in order of
+// definition; i.e. function parameters, basic blocks and the results of
+// instructions and terminators.
+func funcLocals(f *Func) []local {
+	var locals []local
 	for _, param := range f.Params {
-		// Assign local IDs to unnamed parameters of function definitions.
-		if err := setName(param); err != nil {
-			return errors.WithStack(err)
-		}
+		locals = append(locals, param)
 	}
-	for _, block := range f.Blocks {
-		// Assign local IDs to unnamed basic blocks.
-		if err := setName(block); err != nil {
-			return errors.WithStack(err)
-		}
-		for _, inst := range block.Insts {
-			n, ok := inst.(local)
-			if !ok {
-				continue
-			}
-			// Skip void instructions.
-			// TODO: Check if any other value instructions than call may have void
-			// type.
-			if isVoidValue(n) {
-				continue
-			}
-			// Assign local IDs to unnamed local variables.
-			if err := setName(n); err != nil {
-				return errors.WithStack(err)
-			}
-		}
-		n, ok := block.Term.(local)
+	add := func(v interface{}) {
+		n, ok := v.(local)
 		if !ok {
-			continue
+			return
 		}
+		// Skip void instructions.
+		// TODO: Check if any other value instructions than call may have void
+		// type.
 		if isVoidValue(n) {
-			continue
+			return
 		}
-		if err := setName(n); err != nil {
-			return errors.WithStack(err)
+		locals = append(locals, n)
+	}
+	for _, block := range f.Blocks {
+		locals = append(locals, block)
+		for _, inst := range block.Insts {
+			add(inst)
 		}
+		add(block.Term)
 	}
-	return nil
+	return locals
 }
 
-// ### [ Helper functions ] ####################################################
-
 // headerString returns the string representation of the function header.
 func headerString(f *Func) string {
 	// (Linkage | ExternLinkage)? Preemptionopt Visibilityopt DLLStorageClassopt
@@ -283,32 +249,11 @@ func headerString(f *Func) string {
 	return buf.String()
 }
 
-// bodyString returns the string representation of the function body.
-func bodyString(body *Func) string {
-	// '{' Blocks=Block+ UseListOrders=UseListOrder* '}'
-	buf := &strings.Builder{}
-	buf.WriteString("{\n")
-	for i, block := range body.Blocks {
-		if i != 0 {
-			buf.WriteString("\n")
-		}
-		fmt.Fprintf(buf, "%s\n", block.LLString())
-	}
-	if len(body.UseListOrders) > 0 {
-		buf.WriteString("\n")
-	}
-	for _, u := range body.UseListOrders {
-		fmt.Fprintf(buf, "\t%s\n", u)
-	}
-	buf.WriteString("}")
-	return buf.String()
-}
-
 // isVoidValue reports whether the given named value is a non-value (i.e. a call
-// instruction or invoke terminator with void-return type).
+// instruction, invoke terminator or callbr terminator with void-return type).
 func isVoidValue(n value.Named) bool {
 	switch n.(type) {
-	case *InstCall, *TermInvoke:
+	case *InstCall, *TermInvoke, *TermCallBr:
 		return n.Type().Equal(types.Void)
 	}
 	return false
@@ -1,12 +1,18 @@
 package ir
 
 // NewBlock appends a new basic block to the function based on the given label
-// name. An empty label name indicates an unnamed basic block.
+// name. An empty label name indicates an unnamed basic block. The label name is
+// uniqued by appending a numeric suffix (e.g. %x.1) if already used by another
+// local variable of the function.
 //
 // The Parent field of the block is set to f.
 func (f *Func) NewBlock(name string) *Block {
-	block := NewBlock(name)
+	f.localSyms.mu.Lock()
+	defer f.localSyms.mu.Unlock()
+	f.localSyms.build(f.indexLocals)
+	block := NewBlock(f.localSyms.uniqueLocalName(name, nil))
 	block.Parent = f
 	f.Blocks = append(f.Blocks, block)
+	f.addBlock(&f.localSyms, block, len(f.Blocks)-1)
 	return block
 }
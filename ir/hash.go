@@ -0,0 +1,325 @@
+package ir
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"hash/fnv"
+	"math"
+	"math/big"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/umaumax/llvm/ir/types"
+	"github.com/umaumax/llvm/ir/value"
+)
+
+// === [ Structural hashing ] ==================================================
+
+// HashFunc returns a structural hash of the given function. The hash is stable
+// across runs and consistent with EqualFunc; functions which are structurally
+// equal (modulo the names of the functions, the naming of local identifiers and
+// metadata IDs) have the same hash.
+func HashFunc(f *Func) uint64 {
+	h := newHasher()
+	return h.hashFunc(f)
+}
+
+// hashFunc returns the structural hash of the given function.
+func (h *hasher) hashFunc(f *Func) uint64 {
+	// Number local identifiers based on position.
+	h.addLocal(f)
+	for _, param := range f.Params {
+		h.addLocal(param)
+	}
+	for _, block := range f.Blocks {
+		h.addLocal(block)
+		for _, inst := range block.Insts {
+			h.addLocal(inst)
+		}
+		if block.Term != nil {
+			h.addLocal(block.Term)
+		}
+	}
+	d := newDigest()
+	// Hash function header.
+	f.Type()
+	skip := []string{"GlobalIdent", "Params", "Blocks"}
+	if h.ptrEquiv {
+		skip = append(skip, linkageFields...)
+	}
+	sum, _ := h.hashFields(reflect.ValueOf(f).Elem(), skip...)
+	d.writeUint64(sum)
+	// Hash function parameters.
+	d.writeUint64(uint64(len(f.Params)))
+	for _, param := range f.Params {
+		sum, _ := h.hashFields(reflect.ValueOf(param).Elem())
+		d.writeUint64(sum)
+	}
+	// Hash function body.
+	d.writeUint64(uint64(len(f.Blocks)))
+	for _, block := range f.Blocks {
+		d.writeUint64(uint64(len(block.Insts)))
+		for _, inst := range block.Insts {
+			d.writeUint64(h.hashLocal(inst))
+		}
+		if block.Term != nil {
+			d.writeUint64(h.hashLocal(block.Term))
+		}
+	}
+	return d.Sum64()
+}
+
+// HashFuncEquiv returns a structural hash of the given function, which is
+// consistent with EquivFunc; i.e. pointer types of the same address space are
+// considered equivalent and linkage-related properties are ignored.
+func HashFuncEquiv(f *Func) uint64 {
+	h := newHasher()
+	h.ptrEquiv = true
+	return h.hashFunc(f)
+}
+
+// noCycle indicates that no cyclic reference was encountered while hashing a
+// value.
+const noCycle = math.MaxInt32
+
+// hasher tracks the state of structural hashing.
+type hasher struct {
+	// locals maps from local values to their position in the function.
+	locals map[interface{}]int
+	// onStack maps from pointers being hashed to their depth on the stack; used
+	// to handle cyclic values (e.g. metadata nodes).
+	onStack map[interface{}]int
+	// depth is the current depth of the stack of pointers being hashed.
+	depth int
+	// memo maps from pointers to their hash; used to handle shared values.
+	memo map[interface{}]uint64
+	// ptrEquiv specifies whether pointer types of the same address space are
+	// considered equivalent.
+	ptrEquiv bool
+}
+
+// newHasher returns a new hasher.
+func newHasher() *hasher {
+	return &hasher{
+		locals:  make(map[interface{}]int),
+		onStack: make(map[interface{}]int),
+		memo:    make(map[interface{}]uint64),
+	}
+}
+
+// addLocal records the position of the given local value.
+func (h *hasher) addLocal(x interface{}) {
+	h.locals[x] = len(h.locals)
+}
+
+// hashLocal returns the structural hash of the given instruction or
+// terminator.
+func (h *hasher) hashLocal(x interface{}) uint64 {
+	// Compute cached types.
+	if v, ok := x.(value.Value); ok {
+		v.Type()
+	}
+	v := reflect.ValueOf(x)
+	d := newDigest()
+	d.writeString(v.Type().String())
+	sum, _ := h.hashFields(v.Elem())
+	d.writeUint64(sum)
+	return d.Sum64()
+}
+
+// hashFields returns the structural hash of the fields of the given structure,
+// and the minimum stack depth of cyclic references encountered. The names of
+// fields to skip may be optionally specified.
+func (h *hasher) hashFields(v reflect.Value, skip ...string) (uint64, int) {
+	d := newDigest()
+	min := noCycle
+	t := v.Type()
+loop:
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if skipField(field) {
+			continue
+		}
+		for _, name := range skip {
+			if field.Name == name {
+				continue loop
+			}
+		}
+		sum, m := h.hash(v.Field(i))
+		d.writeString(field.Name)
+		d.writeUint64(sum)
+		if m < min {
+			min = m
+		}
+	}
+	return d.Sum64(), min
+}
+
+// hash returns the structural hash of the given value, and the minimum stack
+// depth of cyclic references encountered.
+func (h *hasher) hash(v reflect.Value) (uint64, int) {
+	d := newDigest()
+	d.writeString(v.Type().String())
+	min := noCycle
+	switch v.Kind() {
+	case reflect.Interface:
+		if v.IsNil() {
+			d.writeString("nil")
+			break
+		}
+		sum, m := h.hash(unwrapIndex(v.Elem()))
+		d.writeUint64(sum)
+		min = m
+	case reflect.Ptr:
+		if v.IsNil() {
+			d.writeString("nil")
+			break
+		}
+		x := v.Interface()
+		// Local identifiers.
+		if i, ok := h.locals[x]; ok {
+			d.writeString("local")
+			d.writeUint64(uint64(i))
+			break
+		}
+		switch x := x.(type) {
+		case types.Type:
+			if h.ptrEquiv {
+				d.writeString(equivTypeString(x))
+			} else {
+				d.writeString(x.String())
+			}
+			return d.Sum64(), min
+		case *big.Int:
+			d.writeString(x.String())
+			return d.Sum64(), min
+		case *big.Float:
+			d.writeString(x.Text('p', 0))
+			if x.Signbit() {
+				d.writeString("-")
+			}
+			return d.Sum64(), min
+		case *Global, *Func, *Alias, *IFunc:
+			// Global identifiers are hashed by name.
+			d.writeString(x.(value.Value).Ident())
+			return d.Sum64(), min
+		}
+		if depth, ok := h.onStack[x]; ok {
+			// Cyclic reference; hash the relative depth of the referenced value.
+			d.writeString("cycle")
+			d.writeUint64(uint64(h.depth - depth))
+			return d.Sum64(), depth
+		}
+		if sum, ok := h.memo[x]; ok {
+			d.writeUint64(sum)
+			break
+		}
+		// Compute cached types.
+		if v, ok := x.(value.Value); ok {
+			v.Type()
+		}
+		depth := h.depth
+		h.onStack[x] = depth
+		h.depth++
+		sum, m := h.hash(v.Elem())
+		h.depth--
+		delete(h.onStack, x)
+		// Only memoize the hash of values which do not refer to values higher up
+		// on the stack, as the hash of such values depend on context.
+		if m >= depth {
+			h.memo[x] = sum
+		} else {
+			min = m
+		}
+		d.writeUint64(sum)
+	case reflect.Struct:
+		sum, m := h.hashFields(v)
+		d.writeUint64(sum)
+		min = m
+	case reflect.Slice, reflect.Array:
+		d.writeUint64(uint64(v.Len()))
+		for i := 0; i < v.Len(); i++ {
+			sum, m := h.hash(v.Index(i))
+			d.writeUint64(sum)
+			if m < min {
+				min = m
+			}
+		}
+	case reflect.Map:
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool {
+			return fmt.Sprint(keys[i].Interface()) < fmt.Sprint(keys[j].Interface())
+		})
+		d.writeUint64(uint64(len(keys)))
+		for _, key := range keys {
+			d.writeString(fmt.Sprint(key.Interface()))
+			sum, m := h.hash(v.MapIndex(key))
+			d.writeUint64(sum)
+			if m < min {
+				min = m
+			}
+		}
+	default:
+		d.writeString(fmt.Sprint(v.Interface()))
+	}
+	return d.Sum64(), min
+}
+
+// equivTypeString returns a string representation of the given type, in which
+// pointer types of the same address space are indistinguishable.
+func equivTypeString(t types.Type) string {
+	switch t := t.(type) {
+	case *types.PointerType:
+		return fmt.Sprintf("ptr %d", t.AddrSpace)
+	case *types.VectorType:
+		return fmt.Sprintf("<%v %d x %s>", t.Scalable, t.Len, equivTypeString(t.ElemType))
+	case *types.ArrayType:
+		return fmt.Sprintf("[%d x %s]", t.Len, equivTypeString(t.ElemType))
+	case *types.StructType:
+		if len(t.TypeName) > 0 {
+			return t.String()
+		}
+		buf := &strings.Builder{}
+		fmt.Fprintf(buf, "{%v", t.Packed)
+		for _, field := range t.Fields {
+			fmt.Fprintf(buf, ", %s", equivTypeString(field))
+		}
+		buf.WriteString("}")
+		return buf.String()
+	case *types.FuncType:
+		buf := &strings.Builder{}
+		fmt.Fprintf(buf, "%s (%v", equivTypeString(t.RetType), t.Variadic)
+		for _, param := range t.Params {
+			fmt.Fprintf(buf, ", %s", equivTypeString(param))
+		}
+		buf.WriteString(")")
+		return buf.String()
+	default:
+		return t.String()
+	}
+}
+
+// digest is a 64-bit FNV-1a hash digest.
+type digest struct {
+	hash.Hash64
+}
+
+// newDigest returns a new 64-bit FNV-1a hash digest.
+func newDigest() digest {
+	return digest{Hash64: fnv.New64a()}
+}
+
+// writeString writes the given length-prefixed string to the digest.
+func (d digest) writeString(s string) {
+	d.writeUint64(uint64(len(s)))
+	d.Write([]byte(s))
+}
+
+// writeUint64 writes the given 64-bit integer to the digest.
+func (d digest) writeUint64(x uint64) {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], x)
+	d.Write(buf[:])
+}
@@ -163,6 +163,10 @@ type FuncAttribute interface {
 type GlobalIdent struct {
 	GlobalName string
 	GlobalID   int64
+
+	// sym is the symbol table entry of the global identifier; or nil if not
+	// present in a symbol table.
+	sym *symbol
 }
 
 // Ident returns the identifier associated with the global identifier.
@@ -198,6 +202,7 @@ func (i GlobalIdent) Name() string {
 func (i *GlobalIdent) SetName(name string) {
 	i.GlobalName = name
 	i.GlobalID = 0
+	i.sym.rename(i, i.Name())
 }
 
 // ID returns the ID of the global identifier.
@@ -208,6 +213,7 @@ func (i GlobalIdent) ID() int64 {
 // SetID sets the ID of the global identifier.
 func (i *GlobalIdent) SetID(id int64) {
 	i.GlobalID = id
+	i.sym.rename(i, i.Name())
 }
 
 // IsUnnamed reports whether the global identifier is unnamed.
@@ -215,10 +221,20 @@ func (i GlobalIdent) IsUnnamed() bool {
 	return len(i.GlobalName) == 0
 }
 
+// setSymbol sets the symbol table entry of the global identifier.
+func (i *GlobalIdent) setSymbol(sym *symbol) {
+	i.sym = sym
+	sym.ident = i
+}
+
 // LocalIdent is a local identifier.
 type LocalIdent struct {
 	LocalName string
 	LocalID   int64
+
+	// sym is the symbol table entry of the local identifier; or nil if not
+	// present in a symbol table.
+	sym *symbol
 }
 
 // NewLocalIdent returns a new local identifier based on the given string. An
@@ -260,9 +276,12 @@ func (i LocalIdent) Name() string {
 }
 
 // SetName sets the name of the local identifier.
+//
+// If the local identifier is present in the symbol table of a function (see
+// Func.Local) and the name is already used by another local variable of the
+// function, the name is uniqued by appending a numeric suffix (e.g. %x.1).
 func (i *LocalIdent) SetName(name string) {
-	i.LocalName = name
-	i.LocalID = 0
+	i.sym.setLocalName(i, name)
 }
 
 // ID returns the ID of the local identifier.
@@ -273,6 +292,7 @@ func (i LocalIdent) ID() int64 {
 // SetID sets the ID of the local identifier.
 func (i *LocalIdent) SetID(id int64) {
 	i.LocalID = id
+	i.sym.rename(i, i.Name())
 }
 
 // IsUnnamed reports whether the local identifier is unnamed.
@@ -280,6 +300,12 @@ func (i LocalIdent) IsUnnamed() bool {
 	return len(i.LocalName) == 0
 }
 
+// setSymbol sets the symbol table entry of the local identifier.
+func (i *LocalIdent) setSymbol(sym *symbol) {
+	i.sym = sym
+	sym.ident = i
+}
+
 // Metadata is a list of metadata attachments.
 type Metadata []*metadata.Attachment
 
@@ -288,6 +314,11 @@ func (mds Metadata) MDAttachments() []*metadata.Attachment {
 	return mds
 }
 
+// SetMDAttachments sets the metadata attachments of the value.
+func (mds *Metadata) SetMDAttachments(attachments []*metadata.Attachment) {
+	*mds = attachments
+}
+
 // OperandBundle is an operand bundle.
 type OperandBundle struct {
 	Tag    string
@@ -443,6 +474,46 @@ func unquote(s string) string {
 	return string(enc.Unquote(s))
 }
 
+// argOperands returns a mutable list of the given function arguments. The
+// underlying value of *ir.Arg arguments is returned, so that replacing an
+// operand retains its parameter attributes.
+func argOperands(args []value.Value) []*value.Value {
+	ops := make([]*value.Value, 0, len(args))
+	for i := range args {
+		if arg, ok := args[i].(*Arg); ok {
+			ops = append(ops, &arg.Value)
+			continue
+		}
+		ops = append(ops, &args[i])
+	}
+	return ops
+}
+
+// padOperands returns a list containing the given exception pad or exception
+// scope as an operand; or nil if not present.
+//
+// Exception pads and scopes are stored in typed fields of instructions and
+// terminators, so the operand refers to a copy of the field; it reports the use
+// of the pad, but assigning to the operand does not update the instruction.
+func padOperands(pad value.Value) []*value.Value {
+	if pad == nil {
+		return nil
+	}
+	return []*value.Value{&pad}
+}
+
+// bundleOperands returns a mutable list of the input values of the given
+// operand bundles.
+func bundleOperands(bundles []*OperandBundle) []*value.Value {
+	var ops []*value.Value
+	for _, bundle := range bundles {
+		for i := range bundle.Inputs {
+			ops = append(ops, &bundle.Inputs[i])
+		}
+	}
+	return ops
+}
+
 // callingConvString returns the string representation of the given calling
 // convention.
 func callingConvString(callingConv enum.CallingConv) string {
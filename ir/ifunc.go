@@ -20,6 +20,9 @@ type IFunc struct {
 
 	// Pointer type of resolver.
 	Typ *types.PointerType
+	// (optional) Content type of IFunc; or nil to use the element type of Typ.
+	// Required if Typ is an opaque pointer type.
+	ContentType types.Type
 	// (optional) Linkage; zero value if not present.
 	Linkage enum.Linkage
 	// (optional) Preemption; zero value if not present.
@@ -91,9 +94,25 @@ func (i *IFunc) LLString() string {
 		fmt.Fprintf(buf, " %s", i.UnnamedAddr)
 	}
 	buf.WriteString(" ifunc")
-	fmt.Fprintf(buf, " %s, %s", i.Typ.ElemType, i.Resolver)
+	fmt.Fprintf(buf, " %s, %s", indirectContentType(i.ContentType, i.Type(), i.Ident()), i.Resolver)
 	if len(i.Partition) > 0 {
 		fmt.Fprintf(buf, ", partition %s", quote(i.Partition))
 	}
 	return buf.String()
 }
+
+// ### [ Helper functions ] ####################################################
+
+// indirectContentType returns the content type of the indirect symbol (alias or
+// IFunc) with the given identifier; i.e. the given content type if present, and
+// the element type of the given pointer type otherwise.
+func indirectContentType(contentType types.Type, typ types.Type, ident string) types.Type {
+	if contentType != nil {
+		return contentType
+	}
+	t := typ.(*types.PointerType)
+	if t.IsOpaque() {
+		panic(fmt.Errorf("missing content type of indirect symbol %s of opaque pointer type", ident))
+	}
+	return t.ElemType
+}
@@ -90,6 +90,14 @@ func (inst *InstAlloca) LLString() string {
 	return buf.String()
 }
 
+// Operands returns a mutable list of operands of the given instruction.
+func (inst *InstAlloca) Operands() []*value.Value {
+	if inst.NElems != nil {
+		return []*value.Value{&inst.NElems}
+	}
+	return nil
+}
+
 // ~~~ [ load ] ~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~
 
 // InstLoad is an LLVM IR load instruction.
@@ -117,9 +125,10 @@ type InstLoad struct {
 	Metadata
 }
 
-// NewLoad returns a new load instruction based on the given source address.
-func NewLoad(src value.Value) *InstLoad {
-	inst := &InstLoad{Src: src}
+// NewLoad returns a new load instruction based on the given element type and
+// source address.
+func NewLoad(elemType types.Type, src value.Value) *InstLoad {
+	inst := &InstLoad{Src: src, Typ: elemType}
 	// Compute type.
 	inst.Type()
 	return inst
@@ -139,6 +148,9 @@ func (inst *InstLoad) Type() types.Type {
 		if !ok {
 			panic(fmt.Errorf("invalid source type; expected *types.PointerType, got %T", inst.Src.Type()))
 		}
+		if t.IsOpaque() {
+			panic(fmt.Errorf("unable to derive element type of load from source of opaque pointer type `%s`", t))
+		}
 		inst.Typ = t.ElemType
 	}
 	return inst.Typ
@@ -181,6 +193,11 @@ func (inst *InstLoad) LLString() string {
 	return buf.String()
 }
 
+// Operands returns a mutable list of operands of the given instruction.
+func (inst *InstLoad) Operands() []*value.Value {
+	return []*value.Value{&inst.Src}
+}
+
 // ~~~ [ store ] ~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~
 
 // InstStore is an LLVM IR store instruction.
@@ -214,7 +231,7 @@ func NewStore(src, dst value.Value) *InstStore {
 	if !ok {
 		panic(fmt.Errorf("invalid store dst operand type; expected *types.Pointer, got %T", dst.Type()))
 	}
-	if !src.Type().Equal(dstPtrType.ElemType) {
+	if !dstPtrType.IsOpaque() && !src.Type().Equal(dstPtrType.ElemType) {
 		panic(fmt.Errorf("store operands are not compatible: src=%v; dst=%v", src.Type(), dst.Type()))
 	}
 	return &InstStore{Src: src, Dst: dst}
@@ -256,6 +273,11 @@ func (inst *InstStore) LLString() string {
 	return buf.String()
 }
 
+// Operands returns a mutable list of operands of the given instruction.
+func (inst *InstStore) Operands() []*value.Value {
+	return []*value.Value{&inst.Src, &inst.Dst}
+}
+
 // ~~~ [ fence ] ~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~
 
 // InstFence is an LLVM IR fence instruction.
@@ -292,6 +314,12 @@ func (inst *InstFence) LLString() string {
 	return buf.String()
 }
 
+// Operands returns a mutable list of operands of the given instruction.
+func (inst *InstFence) Operands() []*value.Value {
+	// no operands.
+	return nil
+}
+
 // ~~~ [ cmpxchg ] ~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~
 
 // InstCmpXchg is an LLVM IR cmpxchg instruction.
@@ -376,6 +404,11 @@ func (inst *InstCmpXchg) LLString() string {
 	return buf.String()
 }
 
+// Operands returns a mutable list of operands of the given instruction.
+func (inst *InstCmpXchg) Operands() []*value.Value {
+	return []*value.Value{&inst.Ptr, &inst.Cmp, &inst.New}
+}
+
 // ~~~ [ atomicrmw ] ~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~
 
 // InstAtomicRMW is an LLVM IR atomicrmw instruction.
@@ -422,11 +455,12 @@ func (inst *InstAtomicRMW) String() string {
 func (inst *InstAtomicRMW) Type() types.Type {
 	// Cache type if not present.
 	if inst.Typ == nil {
-		t, ok := inst.Dst.Type().(*types.PointerType)
-		if !ok {
+		if _, ok := inst.Dst.Type().(*types.PointerType); !ok {
 			panic(fmt.Errorf("invalid destination type; expected *types.PointerType, got %T", inst.Dst.Type()))
 		}
-		inst.Typ = t.ElemType
+		// The result type is the type of the operand, which is also known for
+		// destination addresses of opaque pointer type.
+		inst.Typ = inst.X.Type()
 	}
 	return inst.Typ
 }
@@ -452,6 +486,11 @@ func (inst *InstAtomicRMW) LLString() string {
 	return buf.String()
 }
 
+// Operands returns a mutable list of operands of the given instruction.
+func (inst *InstAtomicRMW) Operands() []*value.Value {
+	return []*value.Value{&inst.Dst, &inst.X}
+}
+
 // ~~~ [ getelementptr ] ~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~
 
 // InstGetElementPtr is an LLVM IR getelementptr instruction.
@@ -476,9 +515,9 @@ type InstGetElementPtr struct {
 }
 
 // NewGetElementPtr returns a new getelementptr instruction based on the given
-// source address and element indices.
-func NewGetElementPtr(src value.Value, indices ...value.Value) *InstGetElementPtr {
-	inst := &InstGetElementPtr{Src: src, Indices: indices}
+// element type, source address and element indices.
+func NewGetElementPtr(elemType types.Type, src value.Value, indices ...value.Value) *InstGetElementPtr {
+	inst := &InstGetElementPtr{ElemType: elemType, Src: src, Indices: indices}
 	// Compute type.
 	inst.Type()
 	return inst
@@ -494,22 +533,15 @@ func (inst *InstGetElementPtr) String() string {
 func (inst *InstGetElementPtr) Type() types.Type {
 	// Cache element type if not present.
 	if inst.ElemType == nil {
-		switch typ := inst.Src.Type().(type) {
-		case *types.PointerType:
-			inst.ElemType = typ.ElemType
-		case *types.VectorType:
-			t, ok := typ.ElemType.(*types.PointerType)
-			if !ok {
-				panic(fmt.Errorf("invalid vector element type; expected *types.Pointer, got %T", typ.ElemType))
-			}
-			inst.ElemType = t.ElemType
-		default:
-			panic(fmt.Errorf("invalid source type; expected *types.Pointer or *types.Vector, got %T", typ))
+		t := gepSrcPointer(inst.Src.Type())
+		if t.IsOpaque() {
+			panic(fmt.Errorf("unable to derive element type of getelementptr from source of opaque pointer type `%s`", inst.Src.Type()))
 		}
+		inst.ElemType = t.ElemType
 	}
 	// Cache type if not present.
 	if inst.Typ == nil {
-		inst.Typ = gepType(inst.ElemType, inst.Indices)
+		inst.Typ = gepType(inst.Src.Type(), inst.ElemType, inst.Indices)
 	}
 	return inst.Typ
 }
@@ -534,12 +566,40 @@ func (inst *InstGetElementPtr) LLString() string {
 	return buf.String()
 }
 
+// Operands returns a mutable list of operands of the given instruction.
+func (inst *InstGetElementPtr) Operands() []*value.Value {
+	ops := make([]*value.Value, 0, 1+len(inst.Indices))
+	ops = append(ops, &inst.Src)
+	for i := range inst.Indices {
+		ops = append(ops, &inst.Indices[i])
+	}
+	return ops
+}
+
 // ### [ Helper functions ] ####################################################
 
+// gepSrcPointer returns the pointer type of the given getelementptr source type;
+// which is either a pointer type or a vector of pointers type.
+func gepSrcPointer(srcType types.Type) *types.PointerType {
+	switch typ := srcType.(type) {
+	case *types.PointerType:
+		return typ
+	case *types.VectorType:
+		t, ok := typ.ElemType.(*types.PointerType)
+		if !ok {
+			panic(fmt.Errorf("invalid vector element type; expected *types.Pointer, got %T", typ.ElemType))
+		}
+		return t
+	default:
+		panic(fmt.Errorf("invalid source type; expected *types.Pointer or *types.Vector, got %T", typ))
+	}
+}
+
 // gepType returns the pointer type or vector of pointers type to the element at
 // the position in the type specified by the given indices, as calculated by the
-// getelementptr instruction.
-func gepType(elemType types.Type, indices []value.Value) types.Type {
+// getelementptr instruction. The result is of opaque pointer type if the source
+// type is.
+func gepType(srcType, elemType types.Type, indices []value.Value) types.Type {
 	e := elemType
 	for i, index := range indices {
 		if i == 0 {
@@ -596,10 +656,17 @@ func gepType(elemType types.Type, indices []value.Value) types.Type {
 	//    %113 = getelementptr inbounds %struct.fileinfo, %struct.fileinfo* %96, <2 x i64> %110, !dbg !4736
 	//    %116 = bitcast i8** %115 to <2 x %struct.fileinfo*>*, !dbg !4738
 	//    store <2 x %struct.fileinfo*> %113, <2 x %struct.fileinfo*>* %116, align 8, !dbg !4738, !tbaa !1793
+	ptr := types.NewPointer(e)
+	if src := gepSrcPointer(srcType); src.IsOpaque() {
+		ptr = types.NewOpaquePointer(src.AddrSpace)
+	}
 	if len(indices) > 0 {
 		if t, ok := indices[0].Type().(*types.VectorType); ok {
-			return types.NewVector(t.Len, types.NewPointer(e))
+			return &types.VectorType{Scalable: t.Scalable, Len: t.Len, ElemType: ptr}
 		}
 	}
-	return types.NewPointer(e)
+	if t, ok := srcType.(*types.VectorType); ok {
+		return &types.VectorType{Scalable: t.Scalable, Len: t.Len, ElemType: ptr}
+	}
+	return ptr
 }
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/umaumax/llvm/ir/constant"
+	"github.com/umaumax/llvm/ir/enum"
 	"github.com/umaumax/llvm/ir/types"
 	"github.com/pkg/errors"
 )
@@ -19,6 +20,8 @@ func TestTypeCheckStore(t *testing.T) {
 
 		{types.I64, types.I8Ptr,
 			"store operands are not compatible: src=i64; dst=i8*"},
+		{types.I64, types.Ptr,
+			"OK"},
 		{types.I8, types.I8,
 			"invalid store dst operand type; expected *types.Pointer, got *types.IntType"},
 	}
@@ -44,3 +47,58 @@ func TestTypeCheckStore(t *testing.T) {
 		})
 	}
 }
+
+func TestOpaquePointerInsts(t *testing.T) {
+	m := NewModule()
+	m.TypeContext.SetOpaquePointers(true)
+	list := m.NewTypeDef("list", types.NewStruct(types.I32, types.Ptr))
+	callee := m.NewFunc("callee", types.I32, NewParam("x", types.I32))
+	p := NewParam("p", types.Ptr)
+	q := NewParam("q", types.NewOpaquePointer(1))
+	f := m.NewFunc("f", types.I32, p, q)
+	entry := f.NewBlock("entry")
+	slot := entry.NewAlloca(types.I32)
+	gep := entry.NewGetElementPtr(list, p, constant.NewInt(types.I64, 0), constant.NewInt(types.I32, 1))
+	next := entry.NewLoad(types.Ptr, gep)
+	x := entry.NewLoad(types.I32, next)
+	entry.NewStore(x, p)
+	entry.NewStore(x, slot)
+	gep1 := entry.NewGetElementPtr(types.I8, q, constant.NewInt(types.I64, 4))
+	rmw := entry.NewAtomicRMW(enum.AtomicOpAdd, p, x, enum.AtomicOrderingSeqCst)
+	call := entry.NewCall(callee, rmw)
+	entry.NewRet(call)
+	golden := []struct {
+		v    interface{ Type() types.Type }
+		want string
+	}{
+		{v: callee, want: "ptr"},
+		{v: slot, want: "ptr"},
+		{v: gep, want: "ptr"},
+		{v: next, want: "ptr"},
+		{v: x, want: "i32"},
+		{v: gep1, want: "ptr addrspace(1)"},
+		{v: rmw, want: "i32"},
+		{v: call, want: "i32"},
+	}
+	for _, g := range golden {
+		if got := g.v.Type().String(); got != g.want {
+			t.Errorf("type mismatch; expected %q, got %q", g.want, got)
+		}
+	}
+	const want = `define i32 @f(ptr %p, ptr addrspace(1) %q) {
+entry:
+	%0 = alloca i32
+	%1 = getelementptr %list, ptr %p, i64 0, i32 1
+	%2 = load ptr, ptr %1
+	%3 = load i32, ptr %2
+	store i32 %3, ptr %p
+	store i32 %3, ptr %0
+	%4 = getelementptr i8, ptr addrspace(1) %q, i64 4
+	%5 = atomicrmw add ptr %p, i32 %3 seq_cst
+	%6 = call i32 @callee(i32 %5)
+	ret i32 %6
+}`
+	if got := f.LLString(); got != want {
+		t.Errorf("function mismatch; expected %q, got %q", want, got)
+	}
+}
@@ -53,7 +53,7 @@ func (inst *InstICmp) Type() types.Type {
 		case *types.IntType, *types.PointerType:
 			inst.Typ = types.I1
 		case *types.VectorType:
-			inst.Typ = types.NewVector(xType.Len, types.I1)
+			inst.Typ = &types.VectorType{Scalable: xType.Scalable, Len: xType.Len, ElemType: types.I1}
 		default:
 			panic(fmt.Errorf("invalid icmp operand type; expected *types.IntType, *types.PointerType or *types.VectorType, got %T", xType))
 		}
@@ -74,6 +74,11 @@ func (inst *InstICmp) LLString() string {
 	return buf.String()
 }
 
+// Operands returns a mutable list of operands of the given instruction.
+func (inst *InstICmp) Operands() []*value.Value {
+	return []*value.Value{&inst.X, &inst.Y}
+}
+
 // ~~~ [ fcmp ] ~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~
 
 // InstFCmp is an LLVM IR fcmp instruction.
@@ -118,7 +123,7 @@ func (inst *InstFCmp) Type() types.Type {
 		case *types.FloatType:
 			inst.Typ = types.I1
 		case *types.VectorType:
-			inst.Typ = types.NewVector(xType.Len, types.I1)
+			inst.Typ = &types.VectorType{Scalable: xType.Scalable, Len: xType.Len, ElemType: types.I1}
 		default:
 			panic(fmt.Errorf("invalid fcmp operand type; expected *types.FloatType or *types.VectorType, got %T", xType))
 		}
@@ -143,6 +148,11 @@ func (inst *InstFCmp) LLString() string {
 	return buf.String()
 }
 
+// Operands returns a mutable list of operands of the given instruction.
+func (inst *InstFCmp) Operands() []*value.Value {
+	return []*value.Value{&inst.X, &inst.Y}
+}
+
 // ~~~ [ phi ] ~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~
 
 // InstPhi is an LLVM IR phi instruction.
@@ -202,6 +212,15 @@ func (inst *InstPhi) LLString() string {
 	return buf.String()
 }
 
+// Operands returns a mutable list of operands of the given instruction.
+func (inst *InstPhi) Operands() []*value.Value {
+	ops := make([]*value.Value, 0, len(inst.Incs))
+	for _, inc := range inst.Incs {
+		ops = append(ops, &inc.X)
+	}
+	return ops
+}
+
 // ___ [ Incoming value ] ______________________________________________________
 
 // Incoming is an incoming value of a phi instruction.
@@ -286,6 +305,68 @@ func (inst *InstSelect) LLString() string {
 	return buf.String()
 }
 
+// Operands returns a mutable list of operands of the given instruction.
+func (inst *InstSelect) Operands() []*value.Value {
+	return []*value.Value{&inst.Cond, &inst.X, &inst.Y}
+}
+
+// ~~~ [ freeze ] ~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~
+
+// InstFreeze is an LLVM IR freeze instruction.
+type InstFreeze struct {
+	// Name of local variable associated with the result.
+	LocalIdent
+	// Operand.
+	X value.Value
+
+	// extra.
+
+	// Type of result produced by the instruction.
+	Typ types.Type
+	// (optional) Metadata.
+	Metadata
+}
+
+// NewFreeze returns a new freeze instruction based on the given operand.
+func NewFreeze(x value.Value) *InstFreeze {
+	inst := &InstFreeze{X: x}
+	// Compute type.
+	inst.Type()
+	return inst
+}
+
+// String returns the LLVM syntax representation of the instruction as a
+// type-value pair.
+func (inst *InstFreeze) String() string {
+	return fmt.Sprintf("%s %s", inst.Type(), inst.Ident())
+}
+
+// Type returns the type of the instruction.
+func (inst *InstFreeze) Type() types.Type {
+	// Cache type if not present.
+	if inst.Typ == nil {
+		inst.Typ = inst.X.Type()
+	}
+	return inst.Typ
+}
+
+// LLString returns the LLVM syntax representation of the instruction.
+func (inst *InstFreeze) LLString() string {
+	// 'freeze' X=TypeValue Metadata=(',' MetadataAttachment)+?
+	buf := &strings.Builder{}
+	fmt.Fprintf(buf, "%s = ", inst.Ident())
+	fmt.Fprintf(buf, "freeze %s", inst.X)
+	for _, md := range inst.Metadata {
+		fmt.Fprintf(buf, ", %s", md)
+	}
+	return buf.String()
+}
+
+// Operands returns a mutable list of operands of the given instruction.
+func (inst *InstFreeze) Operands() []*value.Value {
+	return []*value.Value{&inst.X}
+}
+
 // ~~~ [ call ] ~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~
 
 // InstCall is an LLVM IR call instruction.
@@ -347,14 +428,7 @@ func (inst *InstCall) String() string {
 func (inst *InstCall) Type() types.Type {
 	// Cache type if not present.
 	if inst.Typ == nil {
-		t, ok := inst.Callee.Type().(*types.PointerType)
-		if !ok {
-			panic(fmt.Errorf("invalid callee type; expected *types.PointerType, got %T", inst.Callee.Type()))
-		}
-		sig, ok := t.ElemType.(*types.FuncType)
-		if !ok {
-			panic(fmt.Errorf("invalid callee type; expected *types.FuncType, got %T", t.ElemType))
-		}
+		sig := calleeSig(inst.Callee)
 		if sig.Variadic {
 			inst.Typ = sig
 		} else {
@@ -428,6 +502,14 @@ func (inst *InstCall) LLString() string {
 	return buf.String()
 }
 
+// Operands returns a mutable list of operands of the given instruction.
+func (inst *InstCall) Operands() []*value.Value {
+	ops := []*value.Value{&inst.Callee}
+	ops = append(ops, argOperands(inst.Args)...)
+	ops = append(ops, bundleOperands(inst.OperandBundles)...)
+	return ops
+}
+
 // ~~~ [ va_arg ] ~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~
 
 // InstVAArg is an LLVM IR va_arg instruction.
@@ -475,6 +557,11 @@ func (inst *InstVAArg) LLString() string {
 	return buf.String()
 }
 
+// Operands returns a mutable list of operands of the given instruction.
+func (inst *InstVAArg) Operands() []*value.Value {
+	return []*value.Value{&inst.ArgList}
+}
+
 // ~~~ [ landingpad ] ~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~
 
 // InstLandingPad is an LLVM IR landingpad instruction.
@@ -531,6 +618,15 @@ func (inst *InstLandingPad) LLString() string {
 	return buf.String()
 }
 
+// Operands returns a mutable list of operands of the given instruction.
+func (inst *InstLandingPad) Operands() []*value.Value {
+	ops := make([]*value.Value, 0, len(inst.Clauses))
+	for _, clause := range inst.Clauses {
+		ops = append(ops, &clause.X)
+	}
+	return ops
+}
+
 // ___ [ Landingpad clause ] ___________________________________________________
 
 // Clause is a landingpad catch or filter clause.
@@ -610,6 +706,17 @@ func (inst *InstCatchPad) LLString() string {
 	return buf.String()
 }
 
+// Operands returns a mutable list of operands of the given instruction.
+//
+// The exception scope is included as a read-only operand (see padOperands).
+func (inst *InstCatchPad) Operands() []*value.Value {
+	var scope value.Value
+	if inst.Scope != nil {
+		scope = inst.Scope
+	}
+	return append(padOperands(scope), argOperands(inst.Args)...)
+}
+
 // ~~~ [ cleanuppad ] ~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~
 
 // InstCleanupPad is an LLVM IR cleanuppad instruction.
@@ -667,3 +774,34 @@ func (inst *InstCleanupPad) LLString() string {
 	}
 	return buf.String()
 }
+
+// Operands returns a mutable list of operands of the given instruction.
+//
+// The exception scope is included as a read-only operand (see padOperands).
+func (inst *InstCleanupPad) Operands() []*value.Value {
+	return append(padOperands(inst.Scope), argOperands(inst.Args)...)
+}
+
+// ### [ Helper functions ] ####################################################
+
+// calleeSig returns the function signature of the given callee (or invokee).
+// The signature of functions is known even if their type is an opaque pointer
+// type; other callees of opaque pointer type require the function type of the
+// call to be specified explicitly (i.e. Typ of call and invoke).
+func calleeSig(callee value.Value) *types.FuncType {
+	if f, ok := callee.(*Func); ok {
+		return f.Sig
+	}
+	t, ok := callee.Type().(*types.PointerType)
+	if !ok {
+		panic(fmt.Errorf("invalid callee type; expected *types.PointerType, got %T", callee.Type()))
+	}
+	if t.IsOpaque() {
+		panic(fmt.Errorf("unable to derive function type of callee %q of opaque pointer type `%s`", callee.Ident(), t))
+	}
+	sig, ok := t.ElemType.(*types.FuncType)
+	if !ok {
+		panic(fmt.Errorf("invalid callee type; expected *types.FuncType, got %T", t.ElemType))
+	}
+	return sig
+}
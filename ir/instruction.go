@@ -1,5 +1,7 @@
 package ir
 
+import "github.com/umaumax/llvm/ir/value"
+
 // === [ Instructions ] ========================================================
 
 // Instruction is an LLVM IR instruction. All instructions (except store and
@@ -95,6 +97,7 @@ package ir
 //    *ir.InstFCmp         // https://godoc.org/github.com/llir/llvm/ir#InstFCmp
 //    *ir.InstPhi          // https://godoc.org/github.com/llir/llvm/ir#InstPhi
 //    *ir.InstSelect       // https://godoc.org/github.com/llir/llvm/ir#InstSelect
+//    *ir.InstFreeze       // https://godoc.org/github.com/llir/llvm/ir#InstFreeze
 //    *ir.InstCall         // https://godoc.org/github.com/llir/llvm/ir#InstCall
 //    *ir.InstVAArg        // https://godoc.org/github.com/llir/llvm/ir#InstVAArg
 //    *ir.InstLandingPad   // https://godoc.org/github.com/llir/llvm/ir#InstLandingPad
@@ -102,6 +105,8 @@ package ir
 //    *ir.InstCleanupPad   // https://godoc.org/github.com/llir/llvm/ir#InstCleanupPad
 type Instruction interface {
 	LLStringer
+	// Operands returns a mutable list of operands of the given instruction.
+	Operands() []*value.Value
 	// isInstruction ensures that only instructions can be assigned to the
 	// instruction.Instruction interface.
 	isInstruction()
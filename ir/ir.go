@@ -1,9 +1,24 @@
 // Package ir declares the types used to represent LLVM IR modules.
 package ir
 
+import "github.com/umaumax/llvm/ir/metadata"
+
 // LLStringer is implemented by any value that has a LLString method, which
 // defines the LLVM syntax for that value.
 type LLStringer interface {
 	// LLString returns the LLVM syntax representation of the value.
 	LLString() string
 }
+
+// MetadataAttacher is implemented by values with metadata attachments; i.e.
+// global variables, functions, instructions and terminators, through the
+// embedded Metadata list.
+//
+// It allows passes to read and replace the attachments of any such value
+// without knowing its concrete type (e.g. when stripping debug info).
+type MetadataAttacher interface {
+	// MDAttachments returns the metadata attachments of the value.
+	MDAttachments() []*metadata.Attachment
+	// SetMDAttachments sets the metadata attachments of the value.
+	SetMDAttachments(mds []*metadata.Attachment)
+}
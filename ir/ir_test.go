@@ -104,6 +104,7 @@ var (
 	_ Instruction = (*InstFCmp)(nil)
 	_ Instruction = (*InstPhi)(nil)
 	_ Instruction = (*InstSelect)(nil)
+	_ Instruction = (*InstFreeze)(nil)
 	_ Instruction = (*InstCall)(nil)
 	_ Instruction = (*InstVAArg)(nil)
 	_ Instruction = (*InstLandingPad)(nil)
@@ -120,6 +121,7 @@ var (
 	_ Terminator = (*TermSwitch)(nil)
 	_ Terminator = (*TermIndirectBr)(nil)
 	_ Terminator = (*TermInvoke)(nil)
+	_ Terminator = (*TermCallBr)(nil)
 	_ Terminator = (*TermResume)(nil)
 	_ Terminator = (*TermCatchSwitch)(nil)
 	_ Terminator = (*TermCatchRet)(nil)
@@ -127,6 +129,78 @@ var (
 	_ Terminator = (*TermUnreachable)(nil)
 )
 
+// Assert that each value with metadata attachments implements the
+// ir.MetadataAttacher interface.
+var (
+	_ MetadataAttacher = (*Global)(nil)
+	_ MetadataAttacher = (*Func)(nil)
+	_ MetadataAttacher = (*InstAdd)(nil)
+	_ MetadataAttacher = (*InstFAdd)(nil)
+	_ MetadataAttacher = (*InstSub)(nil)
+	_ MetadataAttacher = (*InstFSub)(nil)
+	_ MetadataAttacher = (*InstMul)(nil)
+	_ MetadataAttacher = (*InstFMul)(nil)
+	_ MetadataAttacher = (*InstUDiv)(nil)
+	_ MetadataAttacher = (*InstSDiv)(nil)
+	_ MetadataAttacher = (*InstFDiv)(nil)
+	_ MetadataAttacher = (*InstURem)(nil)
+	_ MetadataAttacher = (*InstSRem)(nil)
+	_ MetadataAttacher = (*InstFRem)(nil)
+	_ MetadataAttacher = (*InstShl)(nil)
+	_ MetadataAttacher = (*InstLShr)(nil)
+	_ MetadataAttacher = (*InstAShr)(nil)
+	_ MetadataAttacher = (*InstAnd)(nil)
+	_ MetadataAttacher = (*InstOr)(nil)
+	_ MetadataAttacher = (*InstXor)(nil)
+	_ MetadataAttacher = (*InstExtractElement)(nil)
+	_ MetadataAttacher = (*InstInsertElement)(nil)
+	_ MetadataAttacher = (*InstShuffleVector)(nil)
+	_ MetadataAttacher = (*InstExtractValue)(nil)
+	_ MetadataAttacher = (*InstInsertValue)(nil)
+	_ MetadataAttacher = (*InstAlloca)(nil)
+	_ MetadataAttacher = (*InstLoad)(nil)
+	_ MetadataAttacher = (*InstStore)(nil)
+	_ MetadataAttacher = (*InstFence)(nil)
+	_ MetadataAttacher = (*InstCmpXchg)(nil)
+	_ MetadataAttacher = (*InstAtomicRMW)(nil)
+	_ MetadataAttacher = (*InstGetElementPtr)(nil)
+	_ MetadataAttacher = (*InstTrunc)(nil)
+	_ MetadataAttacher = (*InstZExt)(nil)
+	_ MetadataAttacher = (*InstSExt)(nil)
+	_ MetadataAttacher = (*InstFPTrunc)(nil)
+	_ MetadataAttacher = (*InstFPExt)(nil)
+	_ MetadataAttacher = (*InstFPToUI)(nil)
+	_ MetadataAttacher = (*InstFPToSI)(nil)
+	_ MetadataAttacher = (*InstUIToFP)(nil)
+	_ MetadataAttacher = (*InstSIToFP)(nil)
+	_ MetadataAttacher = (*InstPtrToInt)(nil)
+	_ MetadataAttacher = (*InstIntToPtr)(nil)
+	_ MetadataAttacher = (*InstBitCast)(nil)
+	_ MetadataAttacher = (*InstAddrSpaceCast)(nil)
+	_ MetadataAttacher = (*InstICmp)(nil)
+	_ MetadataAttacher = (*InstFCmp)(nil)
+	_ MetadataAttacher = (*InstPhi)(nil)
+	_ MetadataAttacher = (*InstSelect)(nil)
+	_ MetadataAttacher = (*InstFreeze)(nil)
+	_ MetadataAttacher = (*InstCall)(nil)
+	_ MetadataAttacher = (*InstVAArg)(nil)
+	_ MetadataAttacher = (*InstLandingPad)(nil)
+	_ MetadataAttacher = (*InstCatchPad)(nil)
+	_ MetadataAttacher = (*InstCleanupPad)(nil)
+	_ MetadataAttacher = (*TermRet)(nil)
+	_ MetadataAttacher = (*TermBr)(nil)
+	_ MetadataAttacher = (*TermCondBr)(nil)
+	_ MetadataAttacher = (*TermSwitch)(nil)
+	_ MetadataAttacher = (*TermIndirectBr)(nil)
+	_ MetadataAttacher = (*TermInvoke)(nil)
+	_ MetadataAttacher = (*TermCallBr)(nil)
+	_ MetadataAttacher = (*TermResume)(nil)
+	_ MetadataAttacher = (*TermCatchSwitch)(nil)
+	_ MetadataAttacher = (*TermCatchRet)(nil)
+	_ MetadataAttacher = (*TermCleanupRet)(nil)
+	_ MetadataAttacher = (*TermUnreachable)(nil)
+)
+
 // Assert that each value implements the value.Value interface.
 var (
 	// Constants.
@@ -202,6 +276,7 @@ var (
 	_ value.Named = (*InstFCmp)(nil)
 	_ value.Named = (*InstPhi)(nil)
 	_ value.Named = (*InstSelect)(nil)
+	_ value.Named = (*InstFreeze)(nil)
 	_ value.Named = (*InstCall)(nil)
 	_ value.Named = (*InstVAArg)(nil)
 	_ value.Named = (*InstLandingPad)(nil)
@@ -210,5 +285,6 @@ var (
 
 	// Terminators.
 	_ value.Named = (*TermInvoke)(nil)
+	_ value.Named = (*TermCallBr)(nil)
 	_ value.Named = (*TermCatchSwitch)(nil) // token result used by catchpad
 )
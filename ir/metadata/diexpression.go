@@ -0,0 +1,586 @@
+package metadata
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/umaumax/llvm/ir/enum"
+	"github.com/pkg/errors"
+)
+
+// === [ DWARF expressions ] ===================================================
+
+// --- [ Operations ] ----------------------------------------------------------
+
+// DIExprOp is a DWARF expression operation of a DIExpression; an operator and
+// its operands.
+type DIExprOp struct {
+	// DWARF expression operator.
+	Op enum.DwarfOp
+	// Operands of the operator.
+	Args []uint64
+}
+
+// String returns the LLVM syntax representation of the DWARF expression
+// operation (e.g. "DW_OP_plus_uconst, 4").
+func (op DIExprOp) String() string {
+	buf := &strings.Builder{}
+	buf.WriteString(op.Op.String())
+	for _, arg := range op.Args {
+		fmt.Fprintf(buf, ", %d", arg)
+	}
+	return buf.String()
+}
+
+// DwarfOpArity returns the number of operands of the given DWARF expression
+// operator, and a boolean indicating if the operator is supported.
+//
+// Block operands (e.g. the value of DW_OP_implicit_value) are represented by a
+// single integer operand in LLVM IR, and are thus counted as one operand.
+func DwarfOpArity(op enum.DwarfOp) (int, bool) {
+	switch {
+	case enum.DwarfOpLit0 <= op && op <= enum.DwarfOpLit31, enum.DwarfOpReg0 <= op && op <= enum.DwarfOpReg31:
+		return 0, true
+	case enum.DwarfOpBreg0 <= op && op <= enum.DwarfOpBreg31:
+		return 1, true
+	}
+	switch op {
+	case enum.DwarfOpDeref, enum.DwarfOpDup, enum.DwarfOpDrop, enum.DwarfOpOver, enum.DwarfOpSwap, enum.DwarfOpRot, enum.DwarfOpXderef, enum.DwarfOpAbs, enum.DwarfOpAnd, enum.DwarfOpDiv, enum.DwarfOpMinus, enum.DwarfOpMod, enum.DwarfOpMul, enum.DwarfOpNeg, enum.DwarfOpNot, enum.DwarfOpOr, enum.DwarfOpPlus, enum.DwarfOpShl, enum.DwarfOpShr, enum.DwarfOpShra, enum.DwarfOpXor, enum.DwarfOpEq, enum.DwarfOpGe, enum.DwarfOpGt, enum.DwarfOpLe, enum.DwarfOpLt, enum.DwarfOpNe, enum.DwarfOpNop, enum.DwarfOpPushObjectAddress, enum.DwarfOpFormTLSAddress, enum.DwarfOpCallFrameCFA, enum.DwarfOpStackValue, enum.DwarfOpGNUPushTLSAddress, enum.DwarfOpLLVMImplicitPointer:
+		return 0, true
+	case enum.DwarfOpAddr, enum.DwarfOpConst1u, enum.DwarfOpConst1s, enum.DwarfOpConst2u, enum.DwarfOpConst2s, enum.DwarfOpConst4u, enum.DwarfOpConst4s, enum.DwarfOpConst8u, enum.DwarfOpConst8s, enum.DwarfOpConstu, enum.DwarfOpConsts, enum.DwarfOpPick, enum.DwarfOpPlusUconst, enum.DwarfOpBra, enum.DwarfOpSkip, enum.DwarfOpRegx, enum.DwarfOpFbreg, enum.DwarfOpPiece, enum.DwarfOpDerefSize, enum.DwarfOpXderefSize, enum.DwarfOpCall2, enum.DwarfOpCall4, enum.DwarfOpCallRef, enum.DwarfOpAddrx, enum.DwarfOpConstx, enum.DwarfOpEntryValue, enum.DwarfOpConvert, enum.DwarfOpReinterpret, enum.DwarfOpGNUAddrIndex, enum.DwarfOpGNUConstIndex, enum.DwarfOpLLVMTagOffset, enum.DwarfOpLLVMEntryValue, enum.DwarfOpLLVMArg:
+		return 1, true
+	case enum.DwarfOpBregx, enum.DwarfOpBitPiece, enum.DwarfOpLLVMFragment, enum.DwarfOpRegvalType, enum.DwarfOpDerefType, enum.DwarfOpXderefType, enum.DwarfOpLLVMConvert:
+		return 2, true
+	case enum.DwarfOpImplicitValue:
+		// size, value
+		return 2, true
+	case enum.DwarfOpImplicitPointer:
+		// DIE reference, offset
+		return 2, true
+	case enum.DwarfOpConstType:
+		// base type, size, value
+		return 3, true
+	}
+	return 0, false
+}
+
+// ~~~ [ Builder ] ~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~
+
+// NewDIExpression returns a new DIExpression based on the given DWARF
+// expression operations.
+func NewDIExpression(ops ...DIExprOp) *DIExpression {
+	md := &DIExpression{MetadataID: -1}
+	md.Append(ops...)
+	return md
+}
+
+// Append appends the given DWARF expression operations to the DIExpression.
+func (md *DIExpression) Append(ops ...DIExprOp) {
+	for _, op := range ops {
+		md.Fields = append(md.Fields, op.Op)
+		for i, arg := range op.Args {
+			if op.Op == enum.DwarfOpLLVMConvert && i == 1 {
+				// Encoding of DW_OP_LLVM_convert (e.g. DW_ATE_signed).
+				md.Fields = append(md.Fields, enum.DwarfAttEncoding(arg))
+				continue
+			}
+			md.Fields = append(md.Fields, UintLit(arg))
+		}
+	}
+}
+
+// OpDeref returns a DW_OP_deref operation, which dereferences the address on
+// top of the stack.
+func OpDeref() DIExprOp {
+	return DIExprOp{Op: enum.DwarfOpDeref}
+}
+
+// OpPlusUconst returns a DW_OP_plus_uconst operation, which adds the given
+// constant to the value on top of the stack.
+func OpPlusUconst(x uint64) DIExprOp {
+	return DIExprOp{Op: enum.DwarfOpPlusUconst, Args: []uint64{x}}
+}
+
+// OpConstu returns a DW_OP_constu operation, which pushes the given unsigned
+// constant.
+func OpConstu(x uint64) DIExprOp {
+	return DIExprOp{Op: enum.DwarfOpConstu, Args: []uint64{x}}
+}
+
+// OpConsts returns a DW_OP_consts operation, which pushes the given signed
+// constant.
+func OpConsts(x int64) DIExprOp {
+	return DIExprOp{Op: enum.DwarfOpConsts, Args: []uint64{uint64(x)}}
+}
+
+// OpStackValue returns a DW_OP_stack_value operation, which specifies that the
+// value on top of the stack is the value of the variable (rather than its
+// address).
+func OpStackValue() DIExprOp {
+	return DIExprOp{Op: enum.DwarfOpStackValue}
+}
+
+// OpFragment returns a DW_OP_LLVM_fragment operation, which specifies that the
+// expression describes the fragment of the variable at the given offset and
+// size in bits.
+func OpFragment(offset, size uint64) DIExprOp {
+	return DIExprOp{Op: enum.DwarfOpLLVMFragment, Args: []uint64{offset, size}}
+}
+
+// OpOffset returns the DWARF expression operations which add the given signed
+// offset to the value on top of the stack; none if the offset is zero.
+func OpOffset(offset int64) []DIExprOp {
+	switch {
+	case offset > 0:
+		return []DIExprOp{OpPlusUconst(uint64(offset))}
+	case offset < 0:
+		return []DIExprOp{OpConstu(uint64(-offset)), {Op: enum.DwarfOpMinus}}
+	}
+	return nil
+}
+
+// ~~~ [ Decoding and validation ] ~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~
+
+// Ops returns the DWARF expression operations of the DIExpression.
+func (md *DIExpression) Ops() ([]DIExprOp, error) {
+	var ops []DIExprOp
+	for i := 0; i < len(md.Fields); {
+		op, ok := md.Fields[i].(enum.DwarfOp)
+		if !ok {
+			return nil, errors.Errorf("invalid DWARF operation %s at index %d; expected DW_OP_* operator", md.Fields[i], i)
+		}
+		n, ok := DwarfOpArity(op)
+		if !ok {
+			return nil, errors.Errorf("unsupported DWARF operation %s", op)
+		}
+		if i+1+n > len(md.Fields) {
+			return nil, errors.Errorf("DWARF operation %s at index %d requires %d operands; got %d", op, i, n, len(md.Fields)-i-1)
+		}
+		var args []uint64
+		for j := i + 1; j <= i+n; j++ {
+			switch arg := md.Fields[j].(type) {
+			case UintLit:
+				args = append(args, uint64(arg))
+			case enum.DwarfAttEncoding:
+				// Encoding of DW_OP_LLVM_convert.
+				args = append(args, uint64(arg))
+			default:
+				return nil, errors.Errorf("invalid operand %s of DWARF operation %s at index %d", md.Fields[j], op, j)
+			}
+		}
+		ops = append(ops, DIExprOp{Op: op, Args: args})
+		i += 1 + n
+	}
+	return ops, nil
+}
+
+// Validate reports an error if the DWARF expression operations of the
+// DIExpression are malformed; i.e. unknown operators, missing or invalid
+// operands, out-of-range DW_OP_pick indices, misplaced DW_OP_LLVM_fragment,
+// DW_OP_stack_value and DW_OP_LLVM_implicit_pointer operations, and
+// DW_OP_LLVM_entry_value operations not covering a single location operand.
+func (md *DIExpression) Validate() error {
+	ops, err := md.Ops()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	for i, op := range ops {
+		switch op.Op {
+		case enum.DwarfOpLLVMEntryValue:
+			// DW_OP_LLVM_entry_value must be the first operation, or immediately
+			// follow DW_OP_LLVM_arg 0, and covers exactly one operation (the
+			// location operand).
+			first := i == 0 || (i == 1 && ops[0].Op == enum.DwarfOpLLVMArg && ops[0].Args[0] == 0)
+			if !first {
+				return errors.Errorf("DWARF operation %s must be the first operation", op.Op)
+			}
+			if op.Args[0] != 1 {
+				return errors.Errorf("number of operations covered by DWARF operation %s must be 1; got %d", op.Op, op.Args[0])
+			}
+		case enum.DwarfOpLLVMImplicitPointer:
+			// DW_OP_LLVM_implicit_pointer may only be followed by
+			// DW_OP_LLVM_fragment.
+			if i != len(ops)-1 && ops[i+1].Op != enum.DwarfOpLLVMFragment {
+				return errors.Errorf("DWARF operation %s must be the last operation or be followed by %s", op.Op, enum.DwarfOpLLVMFragment)
+			}
+		case enum.DwarfOpPick:
+			// The index of DW_OP_pick is a 1-byte operand.
+			if op.Args[0] > maxPickIndex {
+				return errors.Errorf("index %d of DWARF operation %s out of range; expected at most %d", op.Args[0], op.Op, maxPickIndex)
+			}
+		case enum.DwarfOpLLVMFragment:
+			if i != len(ops)-1 {
+				return errors.Errorf("DWARF operation %s must be the last operation", op.Op)
+			}
+		case enum.DwarfOpStackValue:
+			// DW_OP_stack_value may only be followed by DW_OP_LLVM_fragment.
+			if i != len(ops)-1 && ops[i+1].Op != enum.DwarfOpLLVMFragment {
+				return errors.Errorf("DWARF operation %s must be the last operation or be followed by %s", op.Op, enum.DwarfOpLLVMFragment)
+			}
+		}
+	}
+	return nil
+}
+
+// maxPickIndex is the maximum index of DW_OP_pick operations.
+const maxPickIndex = 0xFF
+
+// ~~~ [ Fragments ] ~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~
+
+// Fragment returns the offset and size in bits of the variable fragment
+// described by the DIExpression, and a boolean indicating if the DIExpression
+// describes a fragment (i.e. ends with DW_OP_LLVM_fragment).
+func (md *DIExpression) Fragment() (offset, size uint64, ok bool) {
+	n := len(md.Fields)
+	if n < 3 || md.Fields[n-3] != enum.DwarfOpLLVMFragment {
+		return 0, 0, false
+	}
+	off, ok1 := md.Fields[n-2].(UintLit)
+	sz, ok2 := md.Fields[n-1].(UintLit)
+	if !ok1 || !ok2 {
+		return 0, 0, false
+	}
+	return uint64(off), uint64(sz), true
+}
+
+// NewFragment returns a new DIExpression describing the fragment at the given
+// offset and size in bits of the value described by the DIExpression. If the
+// DIExpression already describes a fragment, the new fragment is relative to
+// the existing fragment and must fit within it.
+func (md *DIExpression) NewFragment(offset, size uint64) (*DIExpression, error) {
+	fields := md.Fields
+	if off, sz, ok := md.Fragment(); ok {
+		if offset+size > sz {
+			return nil, errors.Errorf("fragment (offset %d, size %d) exceeds existing fragment (offset %d, size %d)", offset, size, off, sz)
+		}
+		offset += off
+		fields = fields[:len(fields)-3]
+	}
+	expr := &DIExpression{MetadataID: -1}
+	expr.Fields = append(expr.Fields, fields...)
+	expr.Append(OpFragment(offset, size))
+	return expr, nil
+}
+
+// --- [ Evaluator ] -----------------------------------------------------------
+
+// DIExprLocKind specifies the kind of location computed by a DWARF expression.
+type DIExprLocKind uint8
+
+// Location kinds.
+const (
+	// The variable is stored in memory at the computed address.
+	DIExprLocMemory DIExprLocKind = iota
+	// The variable has the computed value (DW_OP_stack_value).
+	DIExprLocValue
+	// The variable is stored in the computed register (DW_OP_reg*).
+	DIExprLocRegister
+	// The variable is a pointer to an object which has the computed value, but
+	// is not present in memory (DW_OP_LLVM_implicit_pointer).
+	DIExprLocImplicitPointer
+)
+
+// DIExprLoc is the location of a variable, as computed by a DWARF expression.
+type DIExprLoc struct {
+	// Location kind.
+	Kind DIExprLocKind
+	// Address of the variable (memory location), value of the variable (value
+	// location) or register number (register location).
+	Value uint64
+	// Offset and size in bits of the variable fragment; valid if IsFragment is
+	// set.
+	FragmentOffset, FragmentSize uint64
+	// Specifies whether the location describes a fragment of the variable.
+	IsFragment bool
+}
+
+// DIExprEvaluator evaluates DWARF expressions on a stack machine of 64-bit
+// values, using callbacks to access the registers and memory of the target.
+type DIExprEvaluator struct {
+	// (optional) Register returns the value of the given DWARF register; used
+	// by DW_OP_breg* and DW_OP_bregx.
+	Register func(reg uint64) (uint64, error)
+	// (optional) ReadMemory returns the value of size bytes of memory at the
+	// given address; used by DW_OP_deref and DW_OP_deref_size.
+	ReadMemory func(addr, size uint64) (uint64, error)
+	// (optional) FrameBase returns the frame base address; used by DW_OP_fbreg.
+	FrameBase func() (uint64, error)
+	// (optional) EntryValue returns the value at entry of the function of the
+	// location operand with the given current value; used by
+	// DW_OP_LLVM_entry_value.
+	EntryValue func(v uint64) (uint64, error)
+	// Size of addresses in bytes (read by DW_OP_deref); 8 if zero.
+	AddrSize uint64
+}
+
+// Eval evaluates the given DIExpression, and returns the computed location of
+// the variable. The stack initially holds the given base value; e.g. the value
+// described by llvm.dbg.value or the address described by llvm.dbg.declare.
+//
+// If the DIExpression refers to its location operands using DW_OP_LLVM_arg,
+// the stack is initially empty and base is location operand 0.
+func (e *DIExprEvaluator) Eval(expr *DIExpression, base uint64) (*DIExprLoc, error) {
+	return e.EvalArgs(expr, []uint64{base})
+}
+
+// EvalArgs evaluates the given DIExpression with the given location operands
+// (e.g. of a !DIArgList), and returns the computed location of the variable.
+// DW_OP_LLVM_arg N pushes location operand N. If the DIExpression contains no
+// DW_OP_LLVM_arg operations, the stack initially holds location operand 0.
+func (e *DIExprEvaluator) EvalArgs(expr *DIExpression, args []uint64) (*DIExprLoc, error) {
+	ops, err := expr.Ops()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	loc := &DIExprLoc{Kind: DIExprLocMemory}
+	var stack []uint64
+	if !hasArgOps(ops) {
+		if len(args) != 1 {
+			return nil, errors.Errorf("invalid number of location operands; expected 1, got %d", len(args))
+		}
+		stack = append(stack, args[0])
+	}
+	// pop pops the top n values of the stack, and returns them in push order.
+	pop := func(op enum.DwarfOp, n int) ([]uint64, error) {
+		if len(stack) < n {
+			return nil, errors.Errorf("stack underflow in DWARF operation %s; requires %d values, got %d", op, n, len(stack))
+		}
+		vs := append([]uint64(nil), stack[len(stack)-n:]...)
+		stack = stack[:len(stack)-n]
+		return vs, nil
+	}
+	push := func(vs ...uint64) {
+		stack = append(stack, vs...)
+	}
+	for i, op := range ops {
+		if loc.Kind != DIExprLocMemory && op.Op != enum.DwarfOpLLVMFragment {
+			return nil, errors.Errorf("DWARF operation %s follows location description %s", op.Op, ops[i-1].Op)
+		}
+		switch o := op.Op; {
+		case enum.DwarfOpLit0 <= o && o <= enum.DwarfOpLit31:
+			push(uint64(o - enum.DwarfOpLit0))
+		case enum.DwarfOpReg0 <= o && o <= enum.DwarfOpReg31:
+			loc.Kind = DIExprLocRegister
+			loc.Value = uint64(o - enum.DwarfOpReg0)
+		case enum.DwarfOpBreg0 <= o && o <= enum.DwarfOpBreg31:
+			v, err := e.register(o, uint64(o-enum.DwarfOpBreg0))
+			if err != nil {
+				return nil, errors.WithStack(err)
+			}
+			push(v + op.Args[0])
+		case o == enum.DwarfOpPick:
+			// Copy the stack entry at the given index (0 is the top entry).
+			if index := op.Args[0]; index >= uint64(len(stack)) {
+				return nil, errors.Errorf("index %d of DWARF operation %s out of bounds of stack with %d values", index, o, len(stack))
+			}
+			push(stack[len(stack)-1-int(op.Args[0])])
+		case o == enum.DwarfOpLLVMArg:
+			if index := op.Args[0]; index >= uint64(len(args)) {
+				return nil, errors.Errorf("index %d of DWARF operation %s out of bounds of %d location operands", index, o, len(args))
+			}
+			push(args[op.Args[0]])
+		default:
+			if err := e.evalOp(op, loc, pop, push); err != nil {
+				return nil, errors.WithStack(err)
+			}
+		}
+	}
+	if loc.Kind != DIExprLocRegister {
+		if len(stack) == 0 {
+			return nil, errors.New("empty stack at end of DWARF expression")
+		}
+		loc.Value = stack[len(stack)-1]
+	}
+	return loc, nil
+}
+
+// evalOp evaluates the given DWARF expression operation.
+func (e *DIExprEvaluator) evalOp(op DIExprOp, loc *DIExprLoc, pop func(op enum.DwarfOp, n int) ([]uint64, error), push func(vs ...uint64)) error {
+	// Arity of stack operands.
+	n := 0
+	switch op.Op {
+	case enum.DwarfOpDeref, enum.DwarfOpDerefSize, enum.DwarfOpDup, enum.DwarfOpDrop, enum.DwarfOpAbs, enum.DwarfOpNeg, enum.DwarfOpNot, enum.DwarfOpPlusUconst, enum.DwarfOpLLVMEntryValue:
+		n = 1
+	case enum.DwarfOpOver, enum.DwarfOpSwap, enum.DwarfOpAnd, enum.DwarfOpDiv, enum.DwarfOpMinus, enum.DwarfOpMod, enum.DwarfOpMul, enum.DwarfOpOr, enum.DwarfOpPlus, enum.DwarfOpShl, enum.DwarfOpShr, enum.DwarfOpShra, enum.DwarfOpXor, enum.DwarfOpEq, enum.DwarfOpGe, enum.DwarfOpGt, enum.DwarfOpLe, enum.DwarfOpLt, enum.DwarfOpNe:
+		n = 2
+	case enum.DwarfOpRot:
+		n = 3
+	}
+	vs, err := pop(op.Op, n)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	b2i := func(b bool) uint64 {
+		if b {
+			return 1
+		}
+		return 0
+	}
+	switch op.Op {
+	// Constants.
+	case enum.DwarfOpConst1u, enum.DwarfOpConst2u, enum.DwarfOpConst4u, enum.DwarfOpConst8u, enum.DwarfOpConstu, enum.DwarfOpAddr:
+		push(op.Args[0])
+	case enum.DwarfOpConst1s:
+		push(uint64(int64(int8(op.Args[0]))))
+	case enum.DwarfOpConst2s:
+		push(uint64(int64(int16(op.Args[0]))))
+	case enum.DwarfOpConst4s:
+		push(uint64(int64(int32(op.Args[0]))))
+	case enum.DwarfOpConst8s, enum.DwarfOpConsts:
+		push(op.Args[0])
+	// Stack operations.
+	case enum.DwarfOpDup:
+		push(vs[0], vs[0])
+	case enum.DwarfOpDrop:
+	case enum.DwarfOpOver:
+		push(vs[0], vs[1], vs[0])
+	case enum.DwarfOpSwap:
+		push(vs[1], vs[0])
+	case enum.DwarfOpRot:
+		// The top entry moves to the third position, the second and third
+		// entries move up.
+		push(vs[2], vs[0], vs[1])
+	// Arithmetic and logical operations.
+	case enum.DwarfOpAbs:
+		if x := int64(vs[0]); x < 0 {
+			push(uint64(-x))
+		} else {
+			push(vs[0])
+		}
+	case enum.DwarfOpNeg:
+		push(-vs[0])
+	case enum.DwarfOpNot:
+		push(^vs[0])
+	case enum.DwarfOpPlusUconst:
+		push(vs[0] + op.Args[0])
+	case enum.DwarfOpAnd:
+		push(vs[0] & vs[1])
+	case enum.DwarfOpOr:
+		push(vs[0] | vs[1])
+	case enum.DwarfOpXor:
+		push(vs[0] ^ vs[1])
+	case enum.DwarfOpPlus:
+		push(vs[0] + vs[1])
+	case enum.DwarfOpMinus:
+		push(vs[0] - vs[1])
+	case enum.DwarfOpMul:
+		push(vs[0] * vs[1])
+	case enum.DwarfOpDiv:
+		if vs[1] == 0 {
+			return errors.Errorf("division by zero in DWARF operation %s", op.Op)
+		}
+		push(uint64(int64(vs[0]) / int64(vs[1])))
+	case enum.DwarfOpMod:
+		if vs[1] == 0 {
+			return errors.Errorf("division by zero in DWARF operation %s", op.Op)
+		}
+		push(vs[0] % vs[1])
+	case enum.DwarfOpShl:
+		push(vs[0] << vs[1])
+	case enum.DwarfOpShr:
+		push(vs[0] >> vs[1])
+	case enum.DwarfOpShra:
+		push(uint64(int64(vs[0]) >> vs[1]))
+	// Relational operations (signed).
+	case enum.DwarfOpEq:
+		push(b2i(vs[0] == vs[1]))
+	case enum.DwarfOpNe:
+		push(b2i(vs[0] != vs[1]))
+	case enum.DwarfOpGe:
+		push(b2i(int64(vs[0]) >= int64(vs[1])))
+	case enum.DwarfOpGt:
+		push(b2i(int64(vs[0]) > int64(vs[1])))
+	case enum.DwarfOpLe:
+		push(b2i(int64(vs[0]) <= int64(vs[1])))
+	case enum.DwarfOpLt:
+		push(b2i(int64(vs[0]) < int64(vs[1])))
+	// Memory and registers.
+	case enum.DwarfOpDeref:
+		size := e.AddrSize
+		if size == 0 {
+			size = 8
+		}
+		v, err := e.readMemory(op.Op, vs[0], size)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		push(v)
+	case enum.DwarfOpDerefSize:
+		v, err := e.readMemory(op.Op, vs[0], op.Args[0])
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		push(v)
+	case enum.DwarfOpRegx:
+		loc.Kind = DIExprLocRegister
+		loc.Value = op.Args[0]
+	case enum.DwarfOpBregx:
+		v, err := e.register(op.Op, op.Args[0])
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		push(v + op.Args[1])
+	case enum.DwarfOpFbreg:
+		if e.FrameBase == nil {
+			return errors.Errorf("unable to evaluate DWARF operation %s; frame base not available", op.Op)
+		}
+		v, err := e.FrameBase()
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		push(v + op.Args[0])
+	case enum.DwarfOpLLVMEntryValue:
+		// The covered operation is the location operand on top of the stack
+		// (see DIExpression.Validate).
+		if e.EntryValue == nil {
+			return errors.Errorf("unable to evaluate DWARF operation %s; entry values not available", op.Op)
+		}
+		v, err := e.EntryValue(vs[0])
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		push(v)
+	// Location descriptions.
+	case enum.DwarfOpStackValue:
+		loc.Kind = DIExprLocValue
+	case enum.DwarfOpLLVMImplicitPointer:
+		loc.Kind = DIExprLocImplicitPointer
+	case enum.DwarfOpLLVMFragment:
+		loc.IsFragment = true
+		loc.FragmentOffset = op.Args[0]
+		loc.FragmentSize = op.Args[1]
+	case enum.DwarfOpNop, enum.DwarfOpLLVMTagOffset:
+		// DW_OP_LLVM_tag_offset specifies the memory tag offset of the variable,
+		// and does not affect its location.
+	default:
+		return errors.Errorf("unable to evaluate DWARF operation %s; not supported", op.Op)
+	}
+	return nil
+}
+
+// hasArgOps reports whether the given DWARF expression operations refer to
+// location operands using DW_OP_LLVM_arg.
+func hasArgOps(ops []DIExprOp) bool {
+	for _, op := range ops {
+		if op.Op == enum.DwarfOpLLVMArg {
+			return true
+		}
+	}
+	return false
+}
+
+// register returns the value of the given DWARF register.
+func (e *DIExprEvaluator) register(op enum.DwarfOp, reg uint64) (uint64, error) {
+	if e.Register == nil {
+		return 0, errors.Errorf("unable to evaluate DWARF operation %s; registers not available", op)
+	}
+	return e.Register(reg)
+}
+
+// readMemory returns the value of size bytes of memory at the given address.
+func (e *DIExprEvaluator) readMemory(op enum.DwarfOp, addr, size uint64) (uint64, error) {
+	if e.ReadMemory == nil {
+		return 0, errors.Errorf("unable to evaluate DWARF operation %s; memory not available", op)
+	}
+	return e.ReadMemory(addr, size)
+}
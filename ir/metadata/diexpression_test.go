@@ -0,0 +1,256 @@
+package metadata
+
+import (
+	"testing"
+
+	"github.com/umaumax/llvm/ir/enum"
+)
+
+func TestDIExpressionValidate(t *testing.T) {
+	golden := []struct {
+		fields []DIExpressionField
+		err    string
+	}{
+		{fields: nil},
+		{fields: []DIExpressionField{enum.DwarfOpPlusUconst, UintLit(4), enum.DwarfOpStackValue, enum.DwarfOpLLVMFragment, UintLit(0), UintLit(32)}},
+		{
+			fields: []DIExpressionField{UintLit(4)},
+			err:    "invalid DWARF operation 4 at index 0; expected DW_OP_* operator",
+		},
+		{
+			fields: []DIExpressionField{enum.DwarfOpDeref, enum.DwarfOpPlusUconst},
+			err:    "DWARF operation DW_OP_plus_uconst at index 1 requires 1 operands; got 0",
+		},
+		{
+			fields: []DIExpressionField{enum.DwarfOpBregx, UintLit(1), enum.DwarfOpDeref},
+			err:    "invalid operand DW_OP_deref of DWARF operation DW_OP_bregx at index 2",
+		},
+		{
+			fields: []DIExpressionField{enum.DwarfOpLLVMFragment, UintLit(0), UintLit(32), enum.DwarfOpDeref},
+			err:    "DWARF operation DW_OP_LLVM_fragment must be the last operation",
+		},
+		{
+			fields: []DIExpressionField{enum.DwarfOpStackValue, enum.DwarfOpDeref},
+			err:    "DWARF operation DW_OP_stack_value must be the last operation or be followed by DW_OP_LLVM_fragment",
+		},
+		{fields: []DIExpressionField{enum.DwarfOpLLVMConvert, UintLit(32), enum.DwarfAttEncodingSigned, enum.DwarfOpStackValue}},
+		{fields: []DIExpressionField{enum.DwarfOpImplicitValue, UintLit(4), UintLit(42)}},
+		{fields: []DIExpressionField{enum.DwarfOpConstType, UintLit(1), UintLit(4), UintLit(42), enum.DwarfOpStackValue}},
+		{fields: []DIExpressionField{enum.DwarfOpDup, enum.DwarfOpPick, UintLit(255)}},
+		{
+			fields: []DIExpressionField{enum.DwarfOpPick, UintLit(1 << 63)},
+			err:    "index 9223372036854775808 of DWARF operation DW_OP_pick out of range; expected at most 255",
+		},
+		// LLVM 14 operations.
+		{fields: []DIExpressionField{enum.DwarfOpLLVMEntryValue, UintLit(1), enum.DwarfOpStackValue}},
+		{fields: []DIExpressionField{enum.DwarfOpLLVMArg, UintLit(0), enum.DwarfOpLLVMEntryValue, UintLit(1), enum.DwarfOpStackValue}},
+		{fields: []DIExpressionField{enum.DwarfOpLLVMArg, UintLit(0), enum.DwarfOpLLVMArg, UintLit(1), enum.DwarfOpPlus, enum.DwarfOpStackValue}},
+		{fields: []DIExpressionField{enum.DwarfOpLLVMTagOffset, UintLit(128)}},
+		{fields: []DIExpressionField{enum.DwarfOpLLVMImplicitPointer, enum.DwarfOpLLVMFragment, UintLit(0), UintLit(64)}},
+		{
+			fields: []DIExpressionField{enum.DwarfOpDeref, enum.DwarfOpLLVMEntryValue, UintLit(1)},
+			err:    "DWARF operation DW_OP_LLVM_entry_value must be the first operation",
+		},
+		{
+			fields: []DIExpressionField{enum.DwarfOpLLVMEntryValue, UintLit(2)},
+			err:    "number of operations covered by DWARF operation DW_OP_LLVM_entry_value must be 1; got 2",
+		},
+		{
+			fields: []DIExpressionField{enum.DwarfOpLLVMImplicitPointer, enum.DwarfOpDeref},
+			err:    "DWARF operation DW_OP_LLVM_implicit_pointer must be the last operation or be followed by DW_OP_LLVM_fragment",
+		},
+	}
+	for i, g := range golden {
+		expr := &DIExpression{MetadataID: -1, Fields: g.fields}
+		got := ""
+		if err := expr.Validate(); err != nil {
+			got = err.Error()
+		}
+		if got != g.err {
+			t.Errorf("%d: error mismatch; expected %q, got %q", i, g.err, got)
+		}
+	}
+}
+
+func TestNewDIExpression(t *testing.T) {
+	ops := append([]DIExprOp{OpDeref()}, OpOffset(-8)...)
+	ops = append(ops, DIExprOp{Op: enum.DwarfOpLLVMConvert, Args: []uint64{32, uint64(enum.DwarfAttEncodingSigned)}}, OpStackValue())
+	expr := NewDIExpression(ops...)
+	const want = "!DIExpression(DW_OP_deref, DW_OP_constu, 8, DW_OP_minus, DW_OP_LLVM_convert, 32, DW_ATE_signed, DW_OP_stack_value)"
+	if got := expr.String(); got != want {
+		t.Errorf("expression mismatch; expected %q, got %q", want, got)
+	}
+	got, err := expr.Ops()
+	if err != nil {
+		t.Fatalf("unable to decode operations; %+v", err)
+	}
+	if len(got) != len(ops) {
+		t.Fatalf("number of operations mismatch; expected %d, got %d", len(ops), len(got))
+	}
+	for i := range ops {
+		if got[i].String() != ops[i].String() {
+			t.Errorf("operation %d mismatch; expected %q, got %q", i, ops[i], got[i])
+		}
+	}
+}
+
+func TestDIExpressionFragment(t *testing.T) {
+	expr := NewDIExpression(OpPlusUconst(4))
+	if _, _, ok := expr.Fragment(); ok {
+		t.Errorf("unexpected fragment of %s", expr)
+	}
+	frag, err := expr.NewFragment(32, 32)
+	if err != nil {
+		t.Fatalf("unable to create fragment; %+v", err)
+	}
+	// Fragment of fragment.
+	frag, err = frag.NewFragment(8, 16)
+	if err != nil {
+		t.Fatalf("unable to create fragment; %+v", err)
+	}
+	const want = "!DIExpression(DW_OP_plus_uconst, 4, DW_OP_LLVM_fragment, 40, 16)"
+	if got := frag.String(); got != want {
+		t.Errorf("fragment mismatch; expected %q, got %q", want, got)
+	}
+	if offset, size, ok := frag.Fragment(); !ok || offset != 40 || size != 16 {
+		t.Errorf("fragment mismatch; expected (40, 16), got (%d, %d)", offset, size)
+	}
+	if _, err := frag.NewFragment(8, 16); err == nil {
+		t.Errorf("expected error for fragment exceeding existing fragment")
+	}
+}
+
+func TestDIExprEvaluatorEval(t *testing.T) {
+	regs := map[uint64]uint64{6: 0x1000}
+	mem := map[uint64]uint64{0x1010: 0x2000, 0x2008: 42}
+	e := &DIExprEvaluator{
+		Register: func(reg uint64) (uint64, error) {
+			return regs[reg], nil
+		},
+		ReadMemory: func(addr, size uint64) (uint64, error) {
+			return mem[addr], nil
+		},
+	}
+	golden := []struct {
+		expr *DIExpression
+		base uint64
+		want DIExprLoc
+	}{
+		// Address of variable.
+		{
+			expr: NewDIExpression(),
+			base: 0x1010,
+			want: DIExprLoc{Kind: DIExprLocMemory, Value: 0x1010},
+		},
+		// Variable stored indirectly (e.g. by reference).
+		{
+			expr: NewDIExpression(OpDeref(), OpPlusUconst(8)),
+			base: 0x1010,
+			want: DIExprLoc{Kind: DIExprLocMemory, Value: 0x2008},
+		},
+		// Variable computed from value.
+		{
+			expr: NewDIExpression(OpConsts(-2), DIExprOp{Op: enum.DwarfOpMul}, OpStackValue(), OpFragment(0, 32)),
+			base: 21,
+			want: DIExprLoc{Kind: DIExprLocValue, Value: uint64(0xFFFFFFFFFFFFFFD6), IsFragment: true, FragmentSize: 32},
+		},
+		// Variable relative to register.
+		{
+			expr: NewDIExpression(DIExprOp{Op: enum.DwarfOpDrop}, DIExprOp{Op: enum.DwarfOpBreg6, Args: []uint64{0x10}}, OpDeref(), OpPlusUconst(8), OpDeref(), OpStackValue()),
+			want: DIExprLoc{Kind: DIExprLocValue, Value: 42},
+		},
+		// Variable in register.
+		{
+			expr: NewDIExpression(DIExprOp{Op: enum.DwarfOpReg3}),
+			want: DIExprLoc{Kind: DIExprLocRegister, Value: 3},
+		},
+		// Stack operations.
+		{
+			expr: NewDIExpression(OpConstu(3), DIExprOp{Op: enum.DwarfOpOver}, DIExprOp{Op: enum.DwarfOpPick, Args: []uint64{1}}, DIExprOp{Op: enum.DwarfOpRot}, DIExprOp{Op: enum.DwarfOpMinus}, DIExprOp{Op: enum.DwarfOpMinus}, OpStackValue()),
+			base: 10,
+			// [10] -> [10 3] -> [10 3 10] -> [10 3 10 3] -> [10 10 3 3] -> [10 10 0] -> [10 10]
+			want: DIExprLoc{Kind: DIExprLocValue, Value: 10},
+		},
+	}
+	for i, g := range golden {
+		got, err := e.Eval(g.expr, g.base)
+		if err != nil {
+			t.Errorf("%d: unable to evaluate %s; %+v", i, g.expr, err)
+			continue
+		}
+		if *got != g.want {
+			t.Errorf("%d: location of %s mismatch; expected %+v, got %+v", i, g.expr, g.want, *got)
+		}
+	}
+	// LLVM 14 operations.
+	e.EntryValue = func(v uint64) (uint64, error) {
+		return v + 100, nil
+	}
+	arg := func(index uint64) DIExprOp {
+		return DIExprOp{Op: enum.DwarfOpLLVMArg, Args: []uint64{index}}
+	}
+	entryValue := DIExprOp{Op: enum.DwarfOpLLVMEntryValue, Args: []uint64{1}}
+	goldenArgs := []struct {
+		expr *DIExpression
+		args []uint64
+		want DIExprLoc
+	}{
+		// Entry value of variable.
+		{
+			expr: NewDIExpression(entryValue, OpStackValue()),
+			args: []uint64{1},
+			want: DIExprLoc{Kind: DIExprLocValue, Value: 101},
+		},
+		{
+			expr: NewDIExpression(arg(0), entryValue, OpStackValue()),
+			args: []uint64{2},
+			want: DIExprLoc{Kind: DIExprLocValue, Value: 102},
+		},
+		// Variable computed from several location operands (!DIArgList).
+		{
+			expr: NewDIExpression(arg(1), arg(0), DIExprOp{Op: enum.DwarfOpMinus}, OpStackValue()),
+			args: []uint64{3, 10},
+			want: DIExprLoc{Kind: DIExprLocValue, Value: 7},
+		},
+		// Memory tagged variable.
+		{
+			expr: NewDIExpression(DIExprOp{Op: enum.DwarfOpLLVMTagOffset, Args: []uint64{128}}),
+			args: []uint64{0x1010},
+			want: DIExprLoc{Kind: DIExprLocMemory, Value: 0x1010},
+		},
+		// Pointer to variable not present in memory.
+		{
+			expr: NewDIExpression(DIExprOp{Op: enum.DwarfOpLLVMImplicitPointer}),
+			args: []uint64{42},
+			want: DIExprLoc{Kind: DIExprLocImplicitPointer, Value: 42},
+		},
+	}
+	for i, g := range goldenArgs {
+		got, err := e.EvalArgs(g.expr, g.args)
+		if err != nil {
+			t.Errorf("%d: unable to evaluate %s; %+v", i, g.expr, err)
+			continue
+		}
+		if *got != g.want {
+			t.Errorf("%d: location of %s mismatch; expected %+v, got %+v", i, g.expr, g.want, *got)
+		}
+	}
+	if _, err := e.EvalArgs(NewDIExpression(arg(1)), []uint64{0}); err == nil {
+		t.Errorf("expected error for out of bounds location operand")
+	}
+	e.EntryValue = nil
+	// Invalid expressions.
+	invalid := []*DIExpression{
+		NewDIExpression(entryValue, OpStackValue()),
+		NewDIExpression(DIExprOp{Op: enum.DwarfOpDrop}, DIExprOp{Op: enum.DwarfOpDup}),
+		NewDIExpression(OpStackValue(), OpDeref()),
+		NewDIExpression(DIExprOp{Op: enum.DwarfOpFbreg, Args: []uint64{8}}),
+		NewDIExpression(DIExprOp{Op: enum.DwarfOpDup}, DIExprOp{Op: enum.DwarfOpPick, Args: []uint64{2}}),
+		NewDIExpression(DIExprOp{Op: enum.DwarfOpPick, Args: []uint64{^uint64(0)}}),
+	}
+	for i, expr := range invalid {
+		if _, err := e.Eval(expr, 0); err == nil {
+			t.Errorf("%d: expected error for %s", i, expr)
+		}
+	}
+}
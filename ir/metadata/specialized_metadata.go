@@ -111,7 +111,7 @@ type DICompileUnit struct {
 	SplitDebugInlining    bool               // optional; zero value if not present.
 	DebugInfoForProfiling bool               // optional; zero value if not present.
 	NameTableKind         enum.NameTableKind // optional; zero value if not present.
-	DebugBaseAddress      bool               // optional; zero value if not present.
+	DebugBaseAddress      bool               // optional; zero value if not present; rangesBaseAddress field.
 }
 
 // String returns the LLVM syntax representation of the specialized metadata node.
@@ -204,7 +204,7 @@ func (md *DICompileUnit) LLString() string {
 		fields = append(fields, field)
 	}
 	if md.DebugBaseAddress {
-		field = fmt.Sprintf("debugBaseAddress: %t", md.DebugBaseAddress)
+		field = fmt.Sprintf("rangesBaseAddress: %t", md.DebugBaseAddress)
 		fields = append(fields, field)
 	}
 	fmt.Fprintf(buf, "!DICompileUnit(%s)", strings.Join(fields, ", "))
@@ -0,0 +1,438 @@
+package ir
+
+import (
+	"math"
+	"math/big"
+
+	"github.com/umaumax/llvm/ir/constant"
+	"github.com/umaumax/llvm/ir/metadata"
+	"github.com/umaumax/llvm/ir/types"
+	"github.com/pkg/errors"
+)
+
+// === [ Well-known metadata kinds ] ===========================================
+
+// MDAttachment returns the node of the metadata attachment with the given name
+// (without '!' prefix); or nil if not present.
+func (mds Metadata) MDAttachment(name string) metadata.MDNode {
+	for _, md := range mds {
+		if md.Name == name {
+			return md.Node
+		}
+	}
+	return nil
+}
+
+// SetMDAttachment sets the node of the metadata attachment with the given name
+// (without '!' prefix), replacing any existing metadata attachment of the same
+// name. The metadata attachment is removed if node is nil.
+func (mds *Metadata) SetMDAttachment(name string, node metadata.MDNode) {
+	for i, md := range *mds {
+		if md.Name != name {
+			continue
+		}
+		if node == nil {
+			*mds = append((*mds)[:i:i], (*mds)[i+1:]...)
+			if len(*mds) == 0 {
+				*mds = nil
+			}
+			return
+		}
+		(*mds)[i] = &metadata.Attachment{Name: name, Node: node}
+		return
+	}
+	if node != nil {
+		*mds = append(*mds, &metadata.Attachment{Name: name, Node: node})
+	}
+}
+
+// --- [ !prof ] ---------------------------------------------------------------
+
+// BranchWeights returns the branch weights of the !prof metadata attachment
+// (e.g. !{!"branch_weights", i32 20, i32 10}); or nil if not present.
+func (mds Metadata) BranchWeights() ([]uint32, error) {
+	node := mds.MDAttachment("prof")
+	if node == nil {
+		return nil, nil
+	}
+	fields, err := profFields(node, "branch_weights")
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if len(fields) == 0 {
+		return nil, errors.Errorf("invalid !prof branch weights %s; expected at least one weight", node.Ident())
+	}
+	var weights []uint32
+	for i, field := range fields {
+		x, err := mdUint(field)
+		if err != nil || x > math.MaxUint32 {
+			return nil, errors.Errorf("invalid branch weight %d of !prof %s; expected i32 constant", i, node.Ident())
+		}
+		weights = append(weights, uint32(x))
+	}
+	return weights, nil
+}
+
+// SetBranchWeights sets the branch weights of the !prof metadata attachment;
+// one weight per successor of the terminator (or per case of the switch, with
+// the default destination first). The metadata attachment is removed if no
+// weights are given.
+func (mds *Metadata) SetBranchWeights(weights ...uint32) {
+	if len(weights) == 0 {
+		mds.SetMDAttachment("prof", nil)
+		return
+	}
+	fields := []metadata.Field{&metadata.String{Value: "branch_weights"}}
+	for _, weight := range weights {
+		fields = append(fields, constant.NewInt(types.I32, int64(weight)))
+	}
+	mds.SetMDAttachment("prof", newMDTuple(fields...))
+}
+
+// EntryCount returns the function entry count of the !prof metadata attachment
+// (e.g. !{!"function_entry_count", i64 100}), and a boolean indicating if
+// present.
+func (mds Metadata) EntryCount() (uint64, bool, error) {
+	node := mds.MDAttachment("prof")
+	if node == nil {
+		return 0, false, nil
+	}
+	fields, err := profFields(node, "function_entry_count")
+	if err != nil {
+		return 0, false, errors.WithStack(err)
+	}
+	// The function entry count may be followed by the GUIDs of imported
+	// functions.
+	if len(fields) < 1 {
+		return 0, false, errors.Errorf("invalid !prof function entry count %s; expected entry count", node.Ident())
+	}
+	count, err := mdUint(fields[0])
+	if err != nil {
+		return 0, false, errors.Errorf("invalid !prof function entry count %s; expected i64 constant", node.Ident())
+	}
+	return count, true, nil
+}
+
+// SetEntryCount sets the function entry count of the !prof metadata attachment.
+func (mds *Metadata) SetEntryCount(count uint64) {
+	x := &constant.Int{Typ: types.I64, X: new(big.Int).SetUint64(count)}
+	mds.SetMDAttachment("prof", newMDTuple(&metadata.String{Value: "function_entry_count"}, x))
+}
+
+// profFields returns the fields following the name of the given !prof metadata
+// node, which must have the given kind name.
+func profFields(node metadata.MDNode, kind string) ([]metadata.Field, error) {
+	tuple, ok := node.(*metadata.Tuple)
+	if !ok || len(tuple.Fields) == 0 {
+		return nil, errors.Errorf("invalid !prof metadata %s; expected tuple with kind name", node.Ident())
+	}
+	name, ok := tuple.Fields[0].(*metadata.String)
+	if !ok {
+		return nil, errors.Errorf("invalid !prof metadata %s; expected kind name, got %s", node.Ident(), tuple.Fields[0])
+	}
+	if name.Value != kind {
+		return nil, errors.Errorf("invalid !prof metadata %s; expected kind %q, got %q", node.Ident(), kind, name.Value)
+	}
+	return tuple.Fields[1:], nil
+}
+
+// --- [ !range ] --------------------------------------------------------------
+
+// ValueRange is a half-open range [Low, High) of integer values, as specified
+// by !range metadata. The range wraps if High is less than Low.
+type ValueRange struct {
+	// Lower bound (inclusive).
+	Low *constant.Int
+	// Upper bound (exclusive).
+	High *constant.Int
+}
+
+// Ranges returns the value ranges of the !range metadata attachment (e.g.
+// !{i32 0, i32 10, i32 20, i32 30}); or nil if not present.
+func (mds Metadata) Ranges() ([]ValueRange, error) {
+	node := mds.MDAttachment("range")
+	if node == nil {
+		return nil, nil
+	}
+	tuple, ok := node.(*metadata.Tuple)
+	if !ok || len(tuple.Fields) == 0 || len(tuple.Fields)%2 != 0 {
+		return nil, errors.Errorf("invalid !range metadata %s; expected non-empty tuple of integer pairs", node.Ident())
+	}
+	var typ *types.IntType
+	var ranges []ValueRange
+	for i := 0; i < len(tuple.Fields); i += 2 {
+		low, lok := tuple.Fields[i].(*constant.Int)
+		high, hok := tuple.Fields[i+1].(*constant.Int)
+		if !lok || !hok {
+			return nil, errors.Errorf("invalid range %d of !range %s; expected integer constants", i/2, node.Ident())
+		}
+		if typ == nil {
+			typ = low.Typ
+		}
+		if !low.Typ.Equal(typ) || !high.Typ.Equal(typ) {
+			return nil, errors.Errorf("invalid range %d of !range %s; mismatched integer types", i/2, node.Ident())
+		}
+		if low.X.Cmp(high.X) == 0 {
+			return nil, errors.Errorf("invalid range %d of !range %s; empty range", i/2, node.Ident())
+		}
+		ranges = append(ranges, ValueRange{Low: low, High: high})
+	}
+	return ranges, nil
+}
+
+// SetRanges sets the value ranges of the !range metadata attachment. The
+// metadata attachment is removed if no ranges are given.
+func (mds *Metadata) SetRanges(ranges ...ValueRange) {
+	if len(ranges) == 0 {
+		mds.SetMDAttachment("range", nil)
+		return
+	}
+	var fields []metadata.Field
+	for _, r := range ranges {
+		fields = append(fields, r.Low, r.High)
+	}
+	mds.SetMDAttachment("range", newMDTuple(fields...))
+}
+
+// --- [ !tbaa ] ---------------------------------------------------------------
+
+// TBAAAccessTag is a struct-path TBAA access tag, as specified by !tbaa
+// metadata (e.g. !{!base, !access, i64 4}).
+type TBAAAccessTag struct {
+	// Base type descriptor of the access.
+	BaseType *metadata.Tuple
+	// Access type descriptor (scalar type) of the access.
+	AccessType *metadata.Tuple
+	// Offset in bytes of the access within the base type.
+	Offset uint64
+	// Memory is constant (e.g. never modified) if set.
+	Const bool
+}
+
+// TBAA returns the access tag of the !tbaa metadata attachment; or nil if not
+// present.
+//
+// Scalar (old-style) access tags (e.g. !{!"int", !root}) are decoded as access
+// tags with identical base and access type descriptors, and offset 0.
+func (mds Metadata) TBAA() (*TBAAAccessTag, error) {
+	node := mds.MDAttachment("tbaa")
+	if node == nil {
+		return nil, nil
+	}
+	return NewTBAAAccessTag(node)
+}
+
+// SetTBAA sets the access tag of the !tbaa metadata attachment. The metadata
+// attachment is removed if tag is nil.
+func (mds *Metadata) SetTBAA(tag *TBAAAccessTag) {
+	if tag == nil {
+		mds.SetMDAttachment("tbaa", nil)
+		return
+	}
+	mds.SetMDAttachment("tbaa", tag.MDNode())
+}
+
+// NewTBAAAccessTag returns the TBAA access tag of the given !tbaa metadata
+// node.
+func NewTBAAAccessTag(node metadata.MDNode) (*TBAAAccessTag, error) {
+	tuple, ok := node.(*metadata.Tuple)
+	if !ok || len(tuple.Fields) < 2 {
+		return nil, errors.Errorf("invalid !tbaa metadata %s; expected tuple with at least 2 fields", node.Ident())
+	}
+	// Scalar access tag.
+	if _, ok := tuple.Fields[0].(*metadata.String); ok {
+		tag := &TBAAAccessTag{BaseType: tuple, AccessType: tuple}
+		if len(tuple.Fields) >= 3 {
+			c, err := mdUint(tuple.Fields[2])
+			if err != nil {
+				return nil, errors.Errorf("invalid constant flag of !tbaa %s; expected integer constant", node.Ident())
+			}
+			tag.Const = c != 0
+		}
+		return tag, nil
+	}
+	// Struct-path access tag.
+	if len(tuple.Fields) < 3 || len(tuple.Fields) > 4 {
+		return nil, errors.Errorf("invalid !tbaa access tag %s; expected 3 or 4 fields, got %d", node.Ident(), len(tuple.Fields))
+	}
+	base, ok := tuple.Fields[0].(*metadata.Tuple)
+	if !ok {
+		return nil, errors.Errorf("invalid base type of !tbaa access tag %s; expected type descriptor, got %s", node.Ident(), tuple.Fields[0])
+	}
+	access, ok := tuple.Fields[1].(*metadata.Tuple)
+	if !ok {
+		return nil, errors.Errorf("invalid access type of !tbaa access tag %s; expected type descriptor, got %s", node.Ident(), tuple.Fields[1])
+	}
+	offset, err := mdUint(tuple.Fields[2])
+	if err != nil {
+		return nil, errors.Errorf("invalid offset of !tbaa access tag %s; expected integer constant", node.Ident())
+	}
+	tag := &TBAAAccessTag{BaseType: base, AccessType: access, Offset: offset}
+	if len(tuple.Fields) == 4 {
+		c, err := mdUint(tuple.Fields[3])
+		if err != nil {
+			return nil, errors.Errorf("invalid constant flag of !tbaa access tag %s; expected integer constant", node.Ident())
+		}
+		tag.Const = c != 0
+	}
+	return tag, nil
+}
+
+// MDNode returns the !tbaa metadata node of the struct-path TBAA access tag.
+func (tag *TBAAAccessTag) MDNode() *metadata.Tuple {
+	offset := &constant.Int{Typ: types.I64, X: new(big.Int).SetUint64(tag.Offset)}
+	fields := []metadata.Field{tag.BaseType, tag.AccessType, offset}
+	if tag.Const {
+		fields = append(fields, constant.NewInt(types.I64, 1))
+	}
+	return newMDTuple(fields...)
+}
+
+// --- [ !nonnull ] ------------------------------------------------------------
+
+// NonNull reports whether the !nonnull metadata attachment (i.e. !{}) is
+// present.
+func (mds Metadata) NonNull() (bool, error) {
+	node := mds.MDAttachment("nonnull")
+	if node == nil {
+		return false, nil
+	}
+	if tuple, ok := node.(*metadata.Tuple); !ok || len(tuple.Fields) != 0 {
+		return false, errors.Errorf("invalid !nonnull metadata %s; expected empty tuple", node.Ident())
+	}
+	return true, nil
+}
+
+// SetNonNull adds or removes the !nonnull metadata attachment.
+func (mds *Metadata) SetNonNull(nonnull bool) {
+	if !nonnull {
+		mds.SetMDAttachment("nonnull", nil)
+		return
+	}
+	mds.SetMDAttachment("nonnull", newMDTuple())
+}
+
+// --- [ !align ] --------------------------------------------------------------
+
+// ValueAlign returns the alignment in bytes of the !align metadata attachment
+// (i.e. the alignment of the loaded pointer value; e.g. !{i64 8}); or 0 if not
+// present.
+func (mds Metadata) ValueAlign() (uint64, error) {
+	node := mds.MDAttachment("align")
+	if node == nil {
+		return 0, nil
+	}
+	tuple, ok := node.(*metadata.Tuple)
+	if !ok || len(tuple.Fields) != 1 {
+		return 0, errors.Errorf("invalid !align metadata %s; expected tuple with 1 field", node.Ident())
+	}
+	align, err := mdUint(tuple.Fields[0])
+	if err != nil || align == 0 || align&(align-1) != 0 {
+		return 0, errors.Errorf("invalid !align metadata %s; expected power of two i64 constant", node.Ident())
+	}
+	return align, nil
+}
+
+// SetValueAlign sets the alignment in bytes of the !align metadata attachment.
+// The metadata attachment is removed if align is 0.
+func (mds *Metadata) SetValueAlign(align uint64) {
+	if align == 0 {
+		mds.SetMDAttachment("align", nil)
+		return
+	}
+	x := &constant.Int{Typ: types.I64, X: new(big.Int).SetUint64(align)}
+	mds.SetMDAttachment("align", newMDTuple(x))
+}
+
+// --- [ !llvm.loop ] ----------------------------------------------------------
+
+// LoopHint is a loop hint of !llvm.loop metadata (e.g.
+// !{!"llvm.loop.unroll.count", i32 4}).
+type LoopHint struct {
+	// Hint name; e.g. "llvm.loop.unroll.count".
+	Name string
+	// Hint arguments; e.g. i32 4.
+	Args []metadata.Field
+}
+
+// Int returns the integer value of the first argument of the loop hint, and a
+// boolean indicating if present.
+func (hint LoopHint) Int() (int64, bool) {
+	if len(hint.Args) == 0 {
+		return 0, false
+	}
+	x, ok := hint.Args[0].(*constant.Int)
+	if !ok || !x.X.IsInt64() {
+		return 0, false
+	}
+	return x.X.Int64(), true
+}
+
+// LoopHints returns the loop hints of the !llvm.loop metadata attachment; or
+// nil if not present. Source locations of the loop are skipped.
+func (mds Metadata) LoopHints() ([]LoopHint, error) {
+	node := mds.MDAttachment("llvm.loop")
+	if node == nil {
+		return nil, nil
+	}
+	loop, ok := node.(*metadata.Tuple)
+	if !ok || len(loop.Fields) == 0 || loop.Fields[0] != loop {
+		return nil, errors.Errorf("invalid !llvm.loop metadata %s; expected self-referential tuple", node.Ident())
+	}
+	var hints []LoopHint
+	for _, field := range loop.Fields[1:] {
+		switch field := field.(type) {
+		case *metadata.DILocation:
+			// skip source locations.
+		case *metadata.Tuple:
+			if len(field.Fields) == 0 {
+				return nil, errors.Errorf("invalid loop hint %s of !llvm.loop %s; expected hint name", field.Ident(), node.Ident())
+			}
+			name, ok := field.Fields[0].(*metadata.String)
+			if !ok {
+				return nil, errors.Errorf("invalid loop hint %s of !llvm.loop %s; expected hint name, got %s", field.Ident(), node.Ident(), field.Fields[0])
+			}
+			hints = append(hints, LoopHint{Name: name.Value, Args: field.Fields[1:]})
+		default:
+			return nil, errors.Errorf("invalid loop hint %s of !llvm.loop %s; expected tuple", field, node.Ident())
+		}
+	}
+	return hints, nil
+}
+
+// NewLoopID returns a new loop identifier (i.e. a distinct self-referential
+// tuple) of !llvm.loop metadata with the given loop hints. The loop identifier
+// and loop hints are appended to the metadata definitions of the module.
+func (m *Module) NewLoopID(hints ...LoopHint) *metadata.Tuple {
+	loop := &metadata.Tuple{MetadataID: -1, Distinct: true}
+	loop.Fields = append(loop.Fields, loop)
+	m.MetadataDefs = append(m.MetadataDefs, loop)
+	for _, hint := range hints {
+		fields := []metadata.Field{&metadata.String{Value: hint.Name}}
+		fields = append(fields, hint.Args...)
+		node := newMDTuple(fields...)
+		loop.Fields = append(loop.Fields, node)
+		m.MetadataDefs = append(m.MetadataDefs, node)
+	}
+	return loop
+}
+
+// ### [ Helper functions ] ####################################################
+
+// newMDTuple returns a new inline metadata tuple based on the given fields.
+func newMDTuple(fields ...metadata.Field) *metadata.Tuple {
+	return &metadata.Tuple{MetadataID: -1, Fields: fields}
+}
+
+// mdUint returns the unsigned integer value of the given integer constant
+// metadata field.
+func mdUint(field metadata.Field) (uint64, error) {
+	x, ok := field.(*constant.Int)
+	if !ok {
+		return 0, errors.Errorf("invalid integer constant metadata field %s", field)
+	}
+	if !x.X.IsUint64() {
+		return 0, errors.Errorf("integer constant %s out of range", x)
+	}
+	return x.X.Uint64(), nil
+}
@@ -0,0 +1,258 @@
+package ir_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/umaumax/llvm/asm"
+	"github.com/umaumax/llvm/ir"
+	"github.com/umaumax/llvm/ir/constant"
+	"github.com/umaumax/llvm/ir/metadata"
+	"github.com/umaumax/llvm/ir/types"
+)
+
+func TestMetadataKinds(t *testing.T) {
+	const input = "" +
+		"define i32 @f(i32* %p, i8** %q, i1 %c) !prof !0 {\n" +
+		"entry:\n" +
+		"  %x = load i32, i32* %p, !tbaa !1, !range !5\n" +
+		"  %y = load i8*, i8** %q, !tbaa !6, !nonnull !7, !align !8\n" +
+		"  br i1 %c, label %entry, label %exit, !prof !9, !llvm.loop !10\n" +
+		"\n" +
+		"exit:\n" +
+		"  ret i32 %x\n" +
+		"}\n" +
+		"\n" +
+		"!0 = !{!\"function_entry_count\", i64 100}\n" +
+		"!1 = !{!2, !4, i64 4}\n" +
+		"!2 = !{!\"S\", !4, i64 0, !4, i64 4}\n" +
+		"!3 = !{!\"Simple C/C++ TBAA\"}\n" +
+		"!4 = !{!\"int\", !3, i64 0}\n" +
+		"!5 = !{i32 0, i32 10, i32 20, i32 30}\n" +
+		"!6 = !{!\"any pointer\", !3}\n" +
+		"!7 = !{}\n" +
+		"!8 = !{i64 8}\n" +
+		"!9 = !{!\"branch_weights\", i32 20, i32 10}\n" +
+		"!10 = distinct !{!10, !11, !12}\n" +
+		"!11 = !{!\"llvm.loop.unroll.count\", i32 4}\n" +
+		"!12 = !{!\"llvm.loop.mustprogress\"}\n"
+	m, err := asm.ParseString("<stdin>", input)
+	if err != nil {
+		t.Fatalf("unable to parse module; %+v", err)
+	}
+	f := m.Funcs[0]
+	entry := f.Blocks[0]
+	x := entry.Insts[0].(*ir.InstLoad)
+	y := entry.Insts[1].(*ir.InstLoad)
+	br := entry.Term.(*ir.TermCondBr)
+	// !prof
+	count, ok, err := f.EntryCount()
+	if err != nil || !ok || count != 100 {
+		t.Errorf("entry count mismatch; expected 100, got %d (ok=%v, err=%v)", count, ok, err)
+	}
+	weights, err := br.BranchWeights()
+	if err != nil {
+		t.Errorf("unable to decode branch weights; %+v", err)
+	} else if got := fmt.Sprint(weights); got != "[20 10]" {
+		t.Errorf("branch weights mismatch; expected %q, got %q", "[20 10]", got)
+	}
+	if weights, err := x.BranchWeights(); err != nil || weights != nil {
+		t.Errorf("unexpected branch weights %v (err=%v)", weights, err)
+	}
+	// !tbaa
+	tag, err := x.TBAA()
+	if err != nil {
+		t.Fatalf("unable to decode TBAA access tag; %+v", err)
+	}
+	if tag.BaseType != m.MetadataDefs[2] || tag.AccessType != m.MetadataDefs[4] || tag.Offset != 4 || tag.Const {
+		t.Errorf("TBAA access tag mismatch; got %+v", tag)
+	}
+	tag, err = y.TBAA()
+	if err != nil {
+		t.Fatalf("unable to decode scalar TBAA access tag; %+v", err)
+	}
+	if tag.BaseType != m.MetadataDefs[6] || tag.AccessType != m.MetadataDefs[6] || tag.Offset != 0 {
+		t.Errorf("scalar TBAA access tag mismatch; got %+v", tag)
+	}
+	// !range
+	ranges, err := x.Ranges()
+	if err != nil {
+		t.Errorf("unable to decode ranges; %+v", err)
+	} else if got, want := fmt.Sprint(ranges), "[{i32 0 i32 10} {i32 20 i32 30}]"; got != want {
+		t.Errorf("ranges mismatch; expected %q, got %q", want, got)
+	}
+	// !nonnull and !align
+	if nonnull, err := y.NonNull(); err != nil || !nonnull {
+		t.Errorf("nonnull mismatch; expected true, got %v (err=%v)", nonnull, err)
+	}
+	if nonnull, err := x.NonNull(); err != nil || nonnull {
+		t.Errorf("nonnull mismatch; expected false, got %v (err=%v)", nonnull, err)
+	}
+	if align, err := y.Metadata.ValueAlign(); err != nil || align != 8 {
+		t.Errorf("align mismatch; expected 8, got %d (err=%v)", align, err)
+	}
+	// !llvm.loop
+	hints, err := br.LoopHints()
+	if err != nil {
+		t.Fatalf("unable to decode loop hints; %+v", err)
+	}
+	if len(hints) != 2 || hints[0].Name != "llvm.loop.unroll.count" || hints[1].Name != "llvm.loop.mustprogress" {
+		t.Fatalf("loop hints mismatch; got %v", hints)
+	}
+	if n, ok := hints[0].Int(); !ok || n != 4 {
+		t.Errorf("unroll count mismatch; expected 4, got %d", n)
+	}
+}
+
+func TestMetadataKindsSet(t *testing.T) {
+	m := ir.NewModule()
+	root := &metadata.Tuple{MetadataID: -1, Fields: []metadata.Field{&metadata.String{Value: "Simple C/C++ TBAA"}}}
+	i32 := &metadata.Tuple{MetadataID: -1, Fields: []metadata.Field{&metadata.String{Value: "int"}, root, constant.NewInt(types.I64, 0)}}
+	m.MetadataDefs = append(m.MetadataDefs, root, i32)
+	p := ir.NewParam("p", types.NewPointer(types.I32))
+	f := m.NewFunc("f", types.I32, p)
+	f.SetEntryCount(10)
+	entry := f.NewBlock("entry")
+	x := entry.NewLoad(types.I32, p)
+	x.SetTBAA(&ir.TBAAAccessTag{BaseType: i32, AccessType: i32, Const: true})
+	x.SetRanges(ir.ValueRange{Low: constant.NewInt(types.I32, 0), High: constant.NewInt(types.I32, 8)})
+	x.SetBranchWeights()
+	x.SetNonNull(false)
+	exit := f.NewBlock("exit")
+	br := entry.NewBr(exit)
+	br.SetMDAttachment("llvm.loop", m.NewLoopID(ir.LoopHint{Name: "llvm.loop.unroll.disable"}))
+	exit.NewRet(x)
+	const want = `define i32 @f(i32* %p) !prof !{!"function_entry_count", i64 10} {
+entry:
+	%0 = load i32, i32* %p, !tbaa !{!1, !1, i64 0, i64 1}, !range !{i32 0, i32 8}
+	br label %exit, !llvm.loop !2
+
+exit:
+	ret i32 %0
+}
+
+!0 = !{!"Simple C/C++ TBAA"}
+!1 = !{!"int", !0, i64 0}
+!2 = distinct !{!2, !3}
+!3 = !{!"llvm.loop.unroll.disable"}
+`
+	if got := m.String(); got != want {
+		t.Errorf("module mismatch; expected %q, got %q", want, got)
+	}
+	// Replace and remove.
+	x.SetRanges()
+	x.SetTBAA(nil)
+	if x.Metadata != nil {
+		t.Errorf("unexpected metadata attachments %v", x.Metadata)
+	}
+	f.SetEntryCount(20)
+	if count, ok, err := f.EntryCount(); err != nil || !ok || count != 20 {
+		t.Errorf("entry count mismatch; expected 20, got %d (ok=%v, err=%v)", count, ok, err)
+	}
+	if len(f.Metadata) != 1 {
+		t.Errorf("number of function metadata attachments mismatch; expected 1, got %d", len(f.Metadata))
+	}
+}
+
+func TestMetadataKindsMalformed(t *testing.T) {
+	tuple := func(fields ...metadata.Field) *metadata.Tuple {
+		return &metadata.Tuple{MetadataID: -1, Fields: fields}
+	}
+	str := func(s string) *metadata.String {
+		return &metadata.String{Value: s}
+	}
+	i32 := func(x int64) *constant.Int {
+		return constant.NewInt(types.I32, x)
+	}
+	golden := []struct {
+		name string
+		node metadata.MDNode
+		get  func(mds ir.Metadata) error
+		err  string
+	}{
+		{
+			name: "prof",
+			node: tuple(str("branch_weights")),
+			get: func(mds ir.Metadata) error {
+				_, err := mds.BranchWeights()
+				return err
+			},
+			err: `invalid !prof branch weights !{!"branch_weights"}; expected at least one weight`,
+		},
+		{
+			name: "prof",
+			node: tuple(str("VP"), i32(1)),
+			get: func(mds ir.Metadata) error {
+				_, err := mds.BranchWeights()
+				return err
+			},
+			err: `invalid !prof metadata !{!"VP", i32 1}; expected kind "branch_weights", got "VP"`,
+		},
+		{
+			name: "range",
+			node: tuple(i32(0), i32(10), i32(20)),
+			get: func(mds ir.Metadata) error {
+				_, err := mds.Ranges()
+				return err
+			},
+			err: "invalid !range metadata !{i32 0, i32 10, i32 20}; expected non-empty tuple of integer pairs",
+		},
+		{
+			name: "range",
+			node: tuple(i32(0), constant.NewInt(types.I64, 10)),
+			get: func(mds ir.Metadata) error {
+				_, err := mds.Ranges()
+				return err
+			},
+			err: "invalid range 0 of !range !{i32 0, i64 10}; mismatched integer types",
+		},
+		{
+			name: "tbaa",
+			node: tuple(tuple(str("int")), tuple(str("int")), str("0")),
+			get: func(mds ir.Metadata) error {
+				_, err := mds.TBAA()
+				return err
+			},
+			err: `invalid offset of !tbaa access tag !{!{!"int"}, !{!"int"}, !"0"}; expected integer constant`,
+		},
+		{
+			name: "nonnull",
+			node: tuple(i32(1)),
+			get: func(mds ir.Metadata) error {
+				_, err := mds.NonNull()
+				return err
+			},
+			err: "invalid !nonnull metadata !{i32 1}; expected empty tuple",
+		},
+		{
+			name: "align",
+			node: tuple(constant.NewInt(types.I64, 3)),
+			get: func(mds ir.Metadata) error {
+				_, err := mds.ValueAlign()
+				return err
+			},
+			err: "invalid !align metadata !{i64 3}; expected power of two i64 constant",
+		},
+		{
+			name: "llvm.loop",
+			node: tuple(tuple(str("llvm.loop.unroll.disable"))),
+			get: func(mds ir.Metadata) error {
+				_, err := mds.LoopHints()
+				return err
+			},
+			err: `invalid !llvm.loop metadata !{!{!"llvm.loop.unroll.disable"}}; expected self-referential tuple`,
+		},
+	}
+	for _, g := range golden {
+		var mds ir.Metadata
+		mds.SetMDAttachment(g.name, g.node)
+		err := g.get(mds)
+		if err == nil {
+			t.Errorf("!%s: expected error %q, got nil", g.name, g.err)
+			continue
+		}
+		if got := err.Error(); got != g.err {
+			t.Errorf("!%s: error mismatch; expected %q, got %q", g.name, g.err, got)
+		}
+	}
+}
@@ -6,7 +6,6 @@ import (
 	"strings"
 
 	"github.com/umaumax/llvm/internal/enc"
-	"github.com/umaumax/llvm/internal/natsort"
 	"github.com/umaumax/llvm/ir/enum"
 	"github.com/umaumax/llvm/ir/metadata"
 	"github.com/umaumax/llvm/ir/types"
@@ -52,12 +51,25 @@ type Module struct {
 	UseListOrders []*UseListOrder
 	// (optional) Basic block specific use-list order directives.
 	UseListOrderBBs []*UseListOrderBB
+	// (optional) Type uniquing context of the module; or nil if not present.
+	//
+	// If present, types of the module are interned by the type context, so that
+	// equal types are identical; and the New* methods of the module intern the
+	// types of the entities they create.
+	TypeContext *types.Context
+
+	// globalSyms maps from global identifiers to global variables, functions,
+	// aliases and IFuncs.
+	globalSyms symbolTable
+	// typeSyms maps from type names to type definitions.
+	typeSyms symbolTable
 }
 
 // NewModule returns a new LLVM IR module.
 func NewModule() *Module {
 	return &Module{
 		NamedMetadataDefs: make(map[string]*metadata.NamedDef),
+		TypeContext:       types.NewContext(),
 	}
 }
 
@@ -74,128 +86,7 @@ func (m *Module) String() string {
 // WriteTo write the string representation of the module in LLVM IR assembly
 // syntax to w.
 func (m *Module) WriteTo(w io.Writer) (n int64, err error) {
-	fw := &fmtWriter{w: w}
-	// Assign metadata IDs.
-	if err := m.AssignMetadataIDs(); err != nil {
-		panic(fmt.Errorf("unable to assign metadata IDs of module; %v", err))
-	}
-	// Source filename.
-	if len(m.SourceFilename) > 0 {
-		// 'source_filename' '=' Name=StringLit
-		fw.Fprintf("source_filename = %s\n", quote(m.SourceFilename))
-	}
-	// Data layout.
-	if len(m.DataLayout) > 0 {
-		// 'target' 'datalayout' '=' DataLayout=StringLit
-		fw.Fprintf("target datalayout = %s\n", quote(m.DataLayout))
-	}
-	// Target triple.
-	if len(m.TargetTriple) > 0 {
-		// 'target' 'triple' '=' TargetTriple=StringLit
-		fw.Fprintf("target triple = %s\n", quote(m.TargetTriple))
-	}
-	// Module-level inline assembly.
-	if len(m.ModuleAsms) > 0 && fw.size > 0 {
-		fw.Fprint("\n")
-	}
-	for _, asm := range m.ModuleAsms {
-		// 'module' 'asm' Asm=StringLit
-		fw.Fprintf("module asm %s\n", quote(asm))
-	}
-	// Type definitions.
-	if len(m.TypeDefs) > 0 && fw.size > 0 {
-		fw.Fprint("\n")
-	}
-	for _, t := range m.TypeDefs {
-		// Alias=LocalIdent '=' 'type' Typ=OpaqueType
-		//
-		// Alias=LocalIdent '=' 'type' Typ=Type
-		fw.Fprintf("%s = type %s\n", t, t.LLString())
-	}
-	// Comdat definitions.
-	if len(m.ComdatDefs) > 0 && fw.size > 0 {
-		fw.Fprint("\n")
-	}
-	for _, def := range m.ComdatDefs {
-		fw.Fprintln(def.LLString())
-	}
-	// Global declarations and definitions.
-	if len(m.Globals) > 0 && fw.size > 0 {
-		fw.Fprint("\n")
-	}
-	for _, g := range m.Globals {
-		fw.Fprintln(g.LLString())
-	}
-	// Aliases.
-	if len(m.Aliases) > 0 && fw.size > 0 {
-		fw.Fprint("\n")
-	}
-	for _, alias := range m.Aliases {
-		fw.Fprintln(alias.LLString())
-	}
-	// IFuncs.
-	if len(m.IFuncs) > 0 && fw.size > 0 {
-		fw.Fprint("\n")
-	}
-	for _, ifunc := range m.IFuncs {
-		fw.Fprintln(ifunc.LLString())
-	}
-	// Function declarations and definitions.
-	if len(m.Funcs) > 0 && fw.size > 0 {
-		fw.Fprint("\n")
-	}
-	for i, f := range m.Funcs {
-		if i != 0 {
-			fw.Fprint("\n")
-		}
-		fw.Fprintln(f.LLString())
-	}
-	// Attribute group definitions.
-	if len(m.AttrGroupDefs) > 0 && fw.size > 0 {
-		fw.Fprint("\n")
-	}
-	for _, a := range m.AttrGroupDefs {
-		fw.Fprintln(a.LLString())
-	}
-	// Named metadata definitions; output in natural sorting order.
-	var mdNames []string
-	for mdName := range m.NamedMetadataDefs {
-		mdNames = append(mdNames, mdName)
-	}
-	natsort.Strings(mdNames)
-	if len(m.NamedMetadataDefs) > 0 && fw.size > 0 {
-		fw.Fprint("\n")
-	}
-	for _, mdName := range mdNames {
-		// Name=MetadataName '=' '!' '{' MDNodes=(MetadataNode separator ',')* '}'
-		md := m.NamedMetadataDefs[mdName]
-		fw.Fprintf("%s = %s\n", md.Ident(), md.LLString())
-	}
-	// Metadata definitions.
-	if len(m.MetadataDefs) > 0 && fw.size > 0 {
-		fw.Fprint("\n")
-	}
-	for _, md := range m.MetadataDefs {
-		// ID=MetadataID '=' Distinctopt MDNode=MDTuple
-		//
-		// ID=MetadataID '=' Distinctopt MDNode=SpecializedMDNode
-		fw.Fprintf("%s = %s\n", md.Ident(), md.LLString())
-	}
-	// Use-list orders.
-	if len(m.UseListOrders) > 0 && fw.size > 0 {
-		fw.Fprint("\n")
-	}
-	for _, u := range m.UseListOrders {
-		fw.Fprintln(u)
-	}
-	// Basic block specific use-list orders.
-	if len(m.UseListOrderBBs) > 0 && fw.size > 0 {
-		fw.Fprint("\n")
-	}
-	for _, u := range m.UseListOrderBBs {
-		fw.Fprintln(u)
-	}
-	return fw.size, fw.err
+	return m.WriteToOptions(w, nil)
 }
 
 // ~~~ [ Comdat Definition ] ~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~
@@ -317,6 +208,9 @@ func (u *UseListOrderBB) String() string {
 
 // AssignMetadataIDs assigns metadata IDs to the unnamed metadata definitions of
 // the module.
+//
+// Note, only metadata definitions present in m.MetadataDefs are assigned IDs;
+// use CollectMetadata to include metadata nodes reachable from the module.
 func (m *Module) AssignMetadataIDs() error {
 	// Index used IDs.
 	used := make(map[int64]bool)
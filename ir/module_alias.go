@@ -1,11 +1,20 @@
 package ir
 
-import "github.com/umaumax/llvm/ir/constant"
+import (
+	"github.com/umaumax/llvm/ir/constant"
+	"github.com/umaumax/llvm/ir/types"
+)
 
 // NewAlias appends a new alias to the module based on the given alias name and
 // aliasee.
 func (m *Module) NewAlias(name string, aliasee constant.Constant) *Alias {
 	alias := NewAlias(name, aliasee)
+	if m.TypeContext != nil {
+		alias.Typ = m.TypeContext.Intern(alias.Typ).(*types.PointerType)
+	}
 	m.Aliases = append(m.Aliases, alias)
+	m.globalSyms.mu.Lock()
+	m.addAlias(&m.globalSyms, alias, len(m.Aliases)-1)
+	m.globalSyms.mu.Unlock()
 	return alias
 }
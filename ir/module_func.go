@@ -11,6 +11,16 @@ import "github.com/umaumax/llvm/ir/types"
 func (m *Module) NewFunc(name string, retType types.Type, params ...*Param) *Func {
 	f := NewFunc(name, retType, params...)
 	f.Parent = m
+	if m.TypeContext != nil {
+		f.Sig = m.TypeContext.Intern(f.Sig).(*types.FuncType)
+		f.Typ = m.TypeContext.Pointer(f.Sig, f.Typ.AddrSpace)
+		for i, param := range f.Params {
+			param.Typ = f.Sig.Params[i]
+		}
+	}
 	m.Funcs = append(m.Funcs, f)
+	m.globalSyms.mu.Lock()
+	m.addFunc(&m.globalSyms, f, len(m.Funcs)-1)
+	m.globalSyms.mu.Unlock()
 	return f
 }
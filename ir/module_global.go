@@ -11,7 +11,11 @@ import (
 // the given global variable name and content type.
 func (m *Module) NewGlobal(name string, contentType types.Type) *Global {
 	g := NewGlobal(name, contentType)
+	m.internGlobalTypes(g)
 	m.Globals = append(m.Globals, g)
+	m.globalSyms.mu.Lock()
+	m.addGlobal(&m.globalSyms, g, len(m.Globals)-1)
+	m.globalSyms.mu.Unlock()
 	return g
 }
 
@@ -19,6 +23,20 @@ func (m *Module) NewGlobal(name string, contentType types.Type) *Global {
 // the given global variable name and initial value.
 func (m *Module) NewGlobalDef(name string, init constant.Constant) *Global {
 	g := NewGlobalDef(name, init)
+	m.internGlobalTypes(g)
 	m.Globals = append(m.Globals, g)
+	m.globalSyms.mu.Lock()
+	m.addGlobal(&m.globalSyms, g, len(m.Globals)-1)
+	m.globalSyms.mu.Unlock()
 	return g
 }
+
+// internGlobalTypes interns the content type and type of the given global
+// variable in the type context of the module.
+func (m *Module) internGlobalTypes(g *Global) {
+	if m.TypeContext == nil {
+		return
+	}
+	g.ContentType = m.TypeContext.Intern(g.ContentType)
+	g.Typ = m.TypeContext.Pointer(g.ContentType, g.Typ.AddrSpace)
+}
@@ -1,11 +1,20 @@
 package ir
 
-import "github.com/umaumax/llvm/ir/constant"
+import (
+	"github.com/umaumax/llvm/ir/constant"
+	"github.com/umaumax/llvm/ir/types"
+)
 
 // NewIFunc appends a new indirect function to the module based on the given
 // IFunc name and resolver.
 func (m *Module) NewIFunc(name string, resolver constant.Constant) *IFunc {
 	ifunc := NewIFunc(name, resolver)
+	if m.TypeContext != nil {
+		ifunc.Typ = m.TypeContext.Intern(ifunc.Typ).(*types.PointerType)
+	}
 	m.IFuncs = append(m.IFuncs, ifunc)
+	m.globalSyms.mu.Lock()
+	m.addIFunc(&m.globalSyms, ifunc, len(m.IFuncs)-1)
+	m.globalSyms.mu.Unlock()
 	return ifunc
 }
@@ -0,0 +1,275 @@
+package ir
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/umaumax/llvm/ir/metadata"
+	"github.com/umaumax/llvm/ir/value"
+)
+
+// CollectMetadata collects the metadata nodes reachable from the module into
+// the metadata definitions of the module, merges structurally equal uniqued
+// (i.e. non-distinct) metadata nodes, and assigns metadata IDs in the slot
+// order of LLVM.
+//
+// Metadata nodes are reachable from named metadata definitions, metadata
+// attachments of global variables, functions and instructions, and metadata
+// operands of instructions (e.g. arguments of calls to llvm.dbg.value). Metadata
+// IDs are assigned in pre-order of first reference; from the metadata
+// attachments of global variables, named metadata definitions, and functions
+// (metadata attachments of the function, followed by metadata operands and
+// metadata attachments of each instruction).
+//
+// As in LLVM, DIExpression nodes not already present in the metadata
+// definitions of the module are printed inline rather than assigned an ID.
+// Unreachable metadata definitions of the module are retained, and assigned IDs
+// after reachable metadata nodes.
+func (m *Module) CollectMetadata() {
+	defs := make(map[metadata.Definition]bool)
+	for _, md := range m.MetadataDefs {
+		defs[md] = true
+	}
+	// isDef reports whether the given metadata node is output as a metadata
+	// definition; as opposed to inline.
+	isDef := func(md metadata.Definition) bool {
+		if _, ok := md.(*metadata.DIExpression); ok {
+			return defs[md]
+		}
+		return true
+	}
+	// Collect reachable metadata nodes, and assign temporary IDs used to
+	// compute structural keys of uniqued metadata nodes.
+	var nodes []metadata.Definition
+	m.walkMetadata(newMDWalker(nil, func(md metadata.Definition) bool {
+		if isDef(md) {
+			md.SetID(int64(len(nodes)))
+			nodes = append(nodes, md)
+		} else {
+			md.SetID(-1)
+		}
+		return true
+	}))
+	// Merge structurally equal uniqued metadata nodes.
+	canon := uniqueMetadata(nodes)
+	if len(canon) > 0 {
+		m.walkMetadata(newMDWalker(func(v reflect.Value, md metadata.Definition) {
+			if c, ok := canon[md]; ok && v.CanSet() {
+				v.Set(reflect.ValueOf(c))
+			}
+		}, nil))
+	}
+	// Assign metadata IDs in slot order.
+	var mdDefs []metadata.Definition
+	m.walkMetadata(newMDWalker(nil, func(md metadata.Definition) bool {
+		if _, ok := canon[md]; ok {
+			// Merged metadata node (only reachable from unreachable metadata
+			// definitions of the module being merged).
+			return false
+		}
+		if isDef(md) {
+			md.SetID(int64(len(mdDefs)))
+			mdDefs = append(mdDefs, md)
+		}
+		return true
+	}))
+	m.MetadataDefs = mdDefs
+}
+
+// RemoveUnusedMetadata removes the metadata definitions of the module which
+// are not reachable from named metadata definitions, metadata attachments and
+// metadata operands of instructions, and returns the number of removed metadata
+// definitions.
+func (m *Module) RemoveUnusedMetadata() int {
+	used := make(map[metadata.Definition]bool)
+	m.walkMetadataRoots(newMDWalker(nil, func(md metadata.Definition) bool {
+		used[md] = true
+		return true
+	}))
+	var mdDefs []metadata.Definition
+	for _, md := range m.MetadataDefs {
+		if used[md] {
+			mdDefs = append(mdDefs, md)
+		}
+	}
+	n := len(m.MetadataDefs) - len(mdDefs)
+	m.MetadataDefs = mdDefs
+	return n
+}
+
+// walkMetadata walks the metadata reachable from the module in slot order,
+// followed by unreachable metadata definitions of the module.
+func (m *Module) walkMetadata(w *mdWalker) {
+	m.walkMetadataRoots(w)
+	for _, md := range m.MetadataDefs {
+		w.walk(reflect.ValueOf(&md).Elem())
+	}
+}
+
+// walkMetadataRoots walks the metadata reachable from the module in slot order.
+func (m *Module) walkMetadataRoots(w *mdWalker) {
+	for _, g := range m.Globals {
+		w.walk(reflect.ValueOf(g.Metadata))
+	}
+	for _, mdName := range namedMetadataNames(m) {
+		w.walk(reflect.ValueOf(m.NamedMetadataDefs[mdName]))
+	}
+	// inst walks the metadata operands and metadata attachments of the given
+	// instruction or terminator.
+	inst := func(inst interface{ Operands() []*value.Value }) {
+		for _, op := range inst.Operands() {
+			w.walk(reflect.ValueOf(op).Elem())
+		}
+		if inst, ok := inst.(MetadataAttacher); ok {
+			w.walk(reflect.ValueOf(inst.MDAttachments()))
+		}
+	}
+	for _, f := range m.Funcs {
+		w.walk(reflect.ValueOf(f.Metadata))
+		for _, block := range f.Blocks {
+			for _, i := range block.Insts {
+				inst(i)
+			}
+			if block.Term != nil {
+				inst(block.Term)
+			}
+		}
+	}
+}
+
+// uniqueMetadata returns a mapping from uniqued metadata nodes to structurally
+// equal metadata nodes of the given list, which replace them. Operands of
+// metadata nodes are updated to refer to the replacement nodes.
+//
+// pre-condition: metadata nodes of the list have unique IDs.
+func uniqueMetadata(nodes []metadata.Definition) map[metadata.Definition]metadata.Definition {
+	const (
+		unvisited = iota
+		inProgress
+		done
+	)
+	canon := make(map[metadata.Definition]metadata.Definition)
+	keys := make(map[string]metadata.Definition)
+	state := make(map[metadata.Definition]int)
+	var visit func(md metadata.Definition)
+	visit = func(md metadata.Definition) {
+		if state[md] != unvisited {
+			return
+		}
+		state[md] = inProgress
+		// Replace operands by their structurally equal counterparts before
+		// computing the key of md. Operands of cyclic references which are in
+		// progress are identified by ID.
+		w := newMDWalker(func(v reflect.Value, op metadata.Definition) {
+			visit(op)
+			if c, ok := canon[op]; ok && v.CanSet() {
+				v.Set(reflect.ValueOf(c))
+			}
+		}, func(op metadata.Definition) bool {
+			// Only walk the operands of md; operands of operands are walked by
+			// visit.
+			return op == md
+		})
+		w.walk(reflect.ValueOf(md))
+		state[md] = done
+		s := md.LLString()
+		if strings.HasPrefix(s, "distinct ") {
+			return
+		}
+		key := fmt.Sprintf("%T %s", md, s)
+		if prev, ok := keys[key]; ok {
+			canon[md] = prev
+			return
+		}
+		keys[key] = md
+	}
+	for _, md := range nodes {
+		visit(md)
+	}
+	return canon
+}
+
+// mdWalker walks metadata reachable from values in pre-order; each metadata
+// node is walked once.
+type mdWalker struct {
+	// visited tracks visited metadata.
+	visited map[interface{}]bool
+	// (optional) ref is invoked for each reference to a metadata definition,
+	// before the metadata definition is walked. The value v referring to the
+	// metadata definition is settable if addressable.
+	ref func(v reflect.Value, md metadata.Definition)
+	// (optional) enter is invoked for each metadata definition on first visit,
+	// and reports whether to walk the operands of the metadata definition.
+	enter func(md metadata.Definition) bool
+}
+
+// newMDWalker returns a new metadata walker based on the given reference and
+// enter functions.
+func newMDWalker(ref func(v reflect.Value, md metadata.Definition), enter func(md metadata.Definition) bool) *mdWalker {
+	return &mdWalker{
+		visited: make(map[interface{}]bool),
+		ref:     ref,
+		enter:   enter,
+	}
+}
+
+// walk walks the metadata reachable from v.
+func (w *mdWalker) walk(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Interface:
+		if v.IsNil() {
+			return
+		}
+		if v.Elem().Kind() != reflect.Ptr {
+			w.walk(v.Elem())
+			return
+		}
+		w.ptr(v)
+	case reflect.Ptr:
+		w.ptr(v)
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			if len(t.Field(i).PkgPath) == 0 {
+				w.walk(v.Field(i))
+			}
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			w.walk(v.Index(i))
+		}
+	}
+}
+
+// ptr walks the metadata pointed to by v, which is a pointer or an interface
+// holding a pointer.
+func (w *mdWalker) ptr(v reflect.Value) {
+	p := v
+	if p.Kind() == reflect.Interface {
+		p = p.Elem()
+	}
+	// Only traverse metadata; e.g. stop at constants of metadata values.
+	if p.IsNil() || p.Type().Elem().PkgPath() != metadataPkgPath {
+		return
+	}
+	if md, ok := p.Interface().(metadata.Definition); ok && w.ref != nil {
+		w.ref(v, md)
+		// Walk replacement of md, if updated by ref.
+		p = v
+		if p.Kind() == reflect.Interface {
+			p = p.Elem()
+		}
+	}
+	x := p.Interface()
+	if w.visited[x] {
+		return
+	}
+	w.visited[x] = true
+	if md, ok := x.(metadata.Definition); ok && w.enter != nil {
+		if !w.enter(md) {
+			return
+		}
+	}
+	w.walk(p.Elem())
+}
@@ -0,0 +1,73 @@
+package ir_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/umaumax/llvm/ir"
+	"github.com/umaumax/llvm/ir/constant"
+	"github.com/umaumax/llvm/ir/enum"
+	"github.com/umaumax/llvm/ir/metadata"
+	"github.com/umaumax/llvm/ir/types"
+)
+
+func TestModuleCollectMetadata(t *testing.T) {
+	m := ir.NewModule()
+	// Metadata nodes which are not added to m.MetadataDefs.
+	file := &metadata.DIFile{MetadataID: -1, Filename: "foo.c", Directory: "/tmp"}
+	cu := &metadata.DICompileUnit{MetadataID: -1, Distinct: true, Language: enum.DwarfLangC99, File: file, EmissionKind: enum.EmissionKindFullDebug}
+	sp := &metadata.DISubprogram{MetadataID: -1, Distinct: true, Name: "f", File: file, Line: 1, IsDefinition: true, Unit: cu}
+	// Structurally equal uniqued metadata nodes.
+	newLoc := func() *metadata.DILocation {
+		return &metadata.DILocation{MetadataID: -1, Line: 2, Column: 3, Scope: sp}
+	}
+	flag := &metadata.Tuple{MetadataID: -1, Fields: []metadata.Field{
+		constant.NewInt(types.I32, 2),
+		&metadata.String{Value: "Debug Info Version"},
+		constant.NewInt(types.I32, 3),
+	}}
+	m.NamedMetadataDefs["llvm.dbg.cu"] = &metadata.NamedDef{Name: "llvm.dbg.cu", Nodes: []metadata.Node{cu}}
+	m.NamedMetadataDefs["llvm.module.flags"] = &metadata.NamedDef{Name: "llvm.module.flags", Nodes: []metadata.Node{flag}}
+	dbgValue := m.NewFunc("llvm.dbg.value", types.Void, ir.NewParam("", types.Metadata), ir.NewParam("", types.Metadata), ir.NewParam("", types.Metadata))
+	f := m.NewFunc("f", types.Void)
+	f.Metadata = append(f.Metadata, &metadata.Attachment{Name: "dbg", Node: sp})
+	entry := f.NewBlock("")
+	variable := &metadata.DILocalVariable{MetadataID: -1, Name: "x", Scope: sp, File: file, Line: 2}
+	expr := &metadata.DIExpression{MetadataID: -1}
+	call := entry.NewCall(dbgValue, &metadata.Value{Value: constant.NewInt(types.I32, 0)}, &metadata.Value{Value: variable}, &metadata.Value{Value: expr})
+	call.Metadata = append(call.Metadata, &metadata.Attachment{Name: "dbg", Node: newLoc()})
+	ret := entry.NewRet(nil)
+	ret.Metadata = append(ret.Metadata, &metadata.Attachment{Name: "dbg", Node: newLoc()})
+	buf := &strings.Builder{}
+	if _, err := m.WriteToOptions(buf, &ir.WriteOptions{CollectMetadata: true}); err != nil {
+		t.Fatalf("unable to write module; %+v", err)
+	}
+	const want = "" +
+		"declare void @llvm.dbg.value(metadata, metadata, metadata)\n" +
+		"\n" +
+		"define void @f() !dbg !3 {\n" +
+		"; <label>:0\n" +
+		"\tcall void @llvm.dbg.value(metadata i32 0, metadata !4, metadata !DIExpression()), !dbg !5\n" +
+		"\tret void, !dbg !5\n" +
+		"}\n" +
+		"\n" +
+		"!llvm.dbg.cu = !{!0}\n" +
+		"!llvm.module.flags = !{!2}\n" +
+		"\n" +
+		"!0 = distinct !DICompileUnit(language: DW_LANG_C99, file: !1, emissionKind: FullDebug)\n" +
+		"!1 = !DIFile(filename: \"foo.c\", directory: \"/tmp\")\n" +
+		"!2 = !{i32 2, !\"Debug Info Version\", i32 3}\n" +
+		"!3 = distinct !DISubprogram(name: \"f\", file: !1, line: 1, isDefinition: true, unit: !0)\n" +
+		"!4 = !DILocalVariable(name: \"x\", scope: !3, file: !1, line: 2)\n" +
+		"!5 = !DILocation(line: 2, column: 3, scope: !3)\n"
+	if got := buf.String(); got != want {
+		t.Errorf("output mismatch; expected %q, got %q", want, got)
+	}
+	// Structurally equal DILocation nodes are merged.
+	if len(m.MetadataDefs) != 6 {
+		t.Errorf("number of metadata definitions mismatch; expected 6, got %d", len(m.MetadataDefs))
+	}
+	if call.Metadata[0].Node != ret.Metadata[0].Node {
+		t.Errorf("DILocation of call and ret not merged")
+	}
+}
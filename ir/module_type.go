@@ -1,13 +1,101 @@
 package ir
 
-import "github.com/umaumax/llvm/ir/types"
+import (
+	"fmt"
+
+	"github.com/umaumax/llvm/ir/types"
+)
 
 // --- [ Type definitions ] ----------------------------------------------------
 
 // NewTypeDef appends a new type definition to the module based on the given
 // type name and underlying type.
+//
+// If the module has a type context, the type definition is added to the type
+// context. Should the type context already contain an identified struct type of
+// the same name (e.g. an opaque struct type created by NamedStruct), its body is
+// populated by the given struct type and the identified struct type of the type
+// context is returned.
 func (m *Module) NewTypeDef(name string, typ types.Type) types.Type {
+	if len(typ.Name()) == 0 && m.TypeContext.Contains(typ) {
+		// Interned types may be shared and must therefore not be named.
+		typ = copyType(typ)
+	}
 	typ.SetName(name)
+	typ = m.internTypeDef(typ)
 	m.TypeDefs = append(m.TypeDefs, typ)
+	m.typeSyms.mu.Lock()
+	m.addTypeDef(&m.typeSyms, typ, len(m.TypeDefs)-1)
+	m.typeSyms.mu.Unlock()
 	return typ
 }
+
+// ### [ Helper functions ] ####################################################
+
+// internTypeDef adds the given type definition to the type context of the
+// module, and returns the corresponding type of the type context.
+func (m *Module) internTypeDef(typ types.Type) types.Type {
+	if m.TypeContext == nil {
+		return typ
+	}
+	t, ok := typ.(*types.StructType)
+	if !ok {
+		return m.TypeContext.Intern(typ)
+	}
+	prev := m.TypeContext.Intern(t).(*types.StructType)
+	if prev != t {
+		prev.Packed = t.Packed
+		prev.Opaque = t.Opaque
+		prev.Fields = make([]types.Type, len(t.Fields))
+		for i, field := range t.Fields {
+			prev.Fields[i] = m.TypeContext.Intern(field)
+		}
+	}
+	return prev
+}
+
+// copyType returns a shallow copy of the given type.
+func copyType(typ types.Type) types.Type {
+	switch typ := typ.(type) {
+	case *types.VoidType:
+		t := *typ
+		return &t
+	case *types.FuncType:
+		t := *typ
+		t.Params = append([]types.Type(nil), typ.Params...)
+		return &t
+	case *types.IntType:
+		t := *typ
+		return &t
+	case *types.FloatType:
+		t := *typ
+		return &t
+	case *types.MMXType:
+		t := *typ
+		return &t
+	case *types.PointerType:
+		t := *typ
+		return &t
+	case *types.VectorType:
+		t := *typ
+		return &t
+	case *types.LabelType:
+		t := *typ
+		return &t
+	case *types.TokenType:
+		t := *typ
+		return &t
+	case *types.MetadataType:
+		t := *typ
+		return &t
+	case *types.ArrayType:
+		t := *typ
+		return &t
+	case *types.StructType:
+		t := *typ
+		t.Fields = append([]types.Type(nil), typ.Fields...)
+		return &t
+	default:
+		panic(fmt.Errorf("support for type %T not yet implemented", typ))
+	}
+}
@@ -0,0 +1,36 @@
+package ir_test
+
+import (
+	"testing"
+
+	"github.com/umaumax/llvm/ir"
+	"github.com/umaumax/llvm/ir/types"
+)
+
+func TestModuleNewTypeDefContext(t *testing.T) {
+	m := ir.NewModule()
+	ctx := m.TypeContext
+	// Forward reference to identified struct type.
+	fwd := ctx.NamedStruct("list")
+	list := m.NewTypeDef("list", types.NewStruct(types.NewInt(32), types.NewPointer(fwd)))
+	if list != fwd {
+		t.Errorf("identified struct type mismatch; expected %p, got %p", fwd, list)
+	}
+	if want, got := "{ i32, %list* }", list.LLString(); want != got {
+		t.Errorf("type definition mismatch; expected %q, got %q", want, got)
+	}
+	// Interned types are not named by NewTypeDef.
+	i8 := ctx.Int(8)
+	b := m.NewTypeDef("byte", i8)
+	if b == types.Type(i8) || len(i8.Name()) != 0 {
+		t.Errorf("interned type %v named by type definition", i8)
+	}
+	g := m.NewGlobal("g", types.NewPointer(types.NewInt(32)))
+	f := m.NewFunc("f", types.NewPointer(types.NewInt(32)), ir.NewParam("x", types.NewInt(8)))
+	if g.ContentType != f.Sig.RetType {
+		t.Errorf("type mismatch; expected identical types %v and %v", g.ContentType, f.Sig.RetType)
+	}
+	if want, got := types.Type(i8), f.Params[0].Typ; want != got {
+		t.Errorf("parameter type mismatch; expected %p, got %p", want, got)
+	}
+}
@@ -0,0 +1,410 @@
+package ir
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/umaumax/llvm/ir/types"
+	"github.com/umaumax/llvm/ir/value"
+)
+
+// === [ Symbol tables ] =======================================================
+
+// Func returns the function of the module with the given name (without '@'
+// prefix); or nil if not present.
+func (m *Module) Func(name string) *Func {
+	f, _ := m.lookupGlobal(name).(*Func)
+	return f
+}
+
+// Global returns the global variable of the module with the given name
+// (without '@' prefix); or nil if not present.
+func (m *Module) Global(name string) *Global {
+	g, _ := m.lookupGlobal(name).(*Global)
+	return g
+}
+
+// Alias returns the alias of the module with the given name (without '@'
+// prefix); or nil if not present.
+func (m *Module) Alias(name string) *Alias {
+	alias, _ := m.lookupGlobal(name).(*Alias)
+	return alias
+}
+
+// IFunc returns the IFunc of the module with the given name (without '@'
+// prefix); or nil if not present.
+func (m *Module) IFunc(name string) *IFunc {
+	ifunc, _ := m.lookupGlobal(name).(*IFunc)
+	return ifunc
+}
+
+// TypeDef returns the type definition of the module with the given name
+// (without '%' prefix); or nil if not present.
+//
+// Type definitions are recorded by name when added to the module, so renaming
+// a type definition of the module using SetName removes it from the symbol
+// table; set the name before adding the type definition.
+func (m *Module) TypeDef(name string) types.Type {
+	t, _ := m.typeSyms.lookup(name, m.indexTypeDefs).(types.Type)
+	return t
+}
+
+// lookupGlobal returns the global variable, function, alias or IFunc of the
+// module with the given name; or nil if not present.
+func (m *Module) lookupGlobal(name string) value.Named {
+	v, _ := m.globalSyms.lookup(name, m.indexGlobals).(value.Named)
+	return v
+}
+
+// indexGlobals indexes the global identifiers of the module.
+func (m *Module) indexGlobals(t *symbolTable) {
+	for i, g := range m.Globals {
+		m.addGlobal(t, g, i)
+	}
+	for i, f := range m.Funcs {
+		m.addFunc(t, f, i)
+	}
+	for i, alias := range m.Aliases {
+		m.addAlias(t, alias, i)
+	}
+	for i, ifunc := range m.IFuncs {
+		m.addIFunc(t, ifunc, i)
+	}
+}
+
+// addGlobal adds the global variable at index i of the module to the symbol
+// table.
+func (m *Module) addGlobal(t *symbolTable, g *Global, i int) {
+	t.add(g.Name(), g, &g.GlobalIdent, func() bool {
+		return hasIndex(len(m.Globals), &i, func(j int) bool { return m.Globals[j] == g })
+	})
+}
+
+// addFunc adds the function at index i of the module to the symbol table.
+func (m *Module) addFunc(t *symbolTable, f *Func, i int) {
+	t.add(f.Name(), f, &f.GlobalIdent, func() bool {
+		return hasIndex(len(m.Funcs), &i, func(j int) bool { return m.Funcs[j] == f })
+	})
+}
+
+// addAlias adds the alias at index i of the module to the symbol table.
+func (m *Module) addAlias(t *symbolTable, alias *Alias, i int) {
+	t.add(alias.Name(), alias, &alias.GlobalIdent, func() bool {
+		return hasIndex(len(m.Aliases), &i, func(j int) bool { return m.Aliases[j] == alias })
+	})
+}
+
+// addIFunc adds the IFunc at index i of the module to the symbol table.
+func (m *Module) addIFunc(t *symbolTable, ifunc *IFunc, i int) {
+	t.add(ifunc.Name(), ifunc, &ifunc.GlobalIdent, func() bool {
+		return hasIndex(len(m.IFuncs), &i, func(j int) bool { return m.IFuncs[j] == ifunc })
+	})
+}
+
+// indexTypeDefs indexes the type definitions of the module.
+func (m *Module) indexTypeDefs(t *symbolTable) {
+	for i, typ := range m.TypeDefs {
+		m.addTypeDef(t, typ, i)
+	}
+}
+
+// addTypeDef adds the type definition at index i of the module to the symbol
+// table.
+func (m *Module) addTypeDef(t *symbolTable, typ types.Type, i int) {
+	name := typ.Name()
+	t.add(name, typ, nil, func() bool {
+		return typ.Name() == name && hasIndex(len(m.TypeDefs), &i, func(j int) bool { return m.TypeDefs[j] == typ })
+	})
+}
+
+// Local returns the local variable (function parameter, basic block or result
+// of an instruction or terminator) of the function with the given name
+// (without '%' prefix); or nil if not present. Unnamed local variables are
+// looked up by ID (e.g. "3"), as assigned by AssignIDs.
+func (f *Func) Local(name string) value.Named {
+	v, _ := f.localSyms.lookup(name, f.indexLocals).(value.Named)
+	return v
+}
+
+// Block returns the basic block of the function with the given name (without
+// '%' prefix); or nil if not present. Unnamed basic blocks are looked up by ID
+// (e.g. "3"), as assigned by AssignIDs.
+func (f *Func) Block(name string) *Block {
+	block, _ := f.Local(name).(*Block)
+	return block
+}
+
+// indexLocals indexes the local identifiers of the function.
+func (f *Func) indexLocals(t *symbolTable) {
+	for i, param := range f.Params {
+		f.addParam(t, param, i)
+	}
+	for i, block := range f.Blocks {
+		f.addBlock(t, block, i)
+		for _, inst := range block.Insts {
+			f.addInst(t, inst, i)
+		}
+		if block.Term != nil {
+			f.addInst(t, block.Term, i)
+		}
+	}
+}
+
+// addParam adds the function parameter at index i of the function to the
+// symbol table.
+func (f *Func) addParam(t *symbolTable, param *Param, i int) {
+	t.add(param.Name(), param, &param.LocalIdent, func() bool {
+		return hasIndex(len(f.Params), &i, func(j int) bool { return f.Params[j] == param })
+	})
+}
+
+// addBlock adds the basic block at index i of the function to the symbol table.
+func (f *Func) addBlock(t *symbolTable, block *Block, i int) {
+	t.add(block.Name(), block, &block.LocalIdent, func() bool {
+		return hasIndex(len(f.Blocks), &i, func(j int) bool { return f.Blocks[j] == block })
+	})
+}
+
+// addInst adds the result of the given instruction or terminator of the basic
+// block at index i of the function to the symbol table. Instructions and
+// terminators without results are ignored.
+func (f *Func) addInst(t *symbolTable, inst interface{}, i int) {
+	n, ok := inst.(local)
+	if !ok || isVoidValue(n) {
+		return
+	}
+	ident, ok := inst.(symbolIdent)
+	if !ok {
+		return
+	}
+	t.add(n.Name(), n, ident, func() bool {
+		return hasIndex(len(f.Blocks), &i, func(j int) bool { return hasInst(f.Blocks[j], inst) })
+	})
+}
+
+// hasInst reports whether the given instruction or terminator belongs to the
+// basic block.
+func hasInst(block *Block, inst interface{}) bool {
+	if block.Term != nil && block.Term == inst {
+		return true
+	}
+	for _, v := range block.Insts {
+		if v == inst {
+			return true
+		}
+	}
+	return false
+}
+
+// hasIndex reports whether is reports true for an index below n. The search
+// starts at the index hint i, which is updated to the index found.
+func hasIndex(n int, i *int, is func(j int) bool) bool {
+	if 0 <= *i && *i < n && is(*i) {
+		return true
+	}
+	for j := 0; j < n; j++ {
+		if is(j) {
+			*i = j
+			return true
+		}
+	}
+	return false
+}
+
+// symbolTable is a symbol table which maps from names to values.
+//
+// The symbol table is built on first lookup, and then maintained incrementally:
+// values created by the New* methods of modules, functions and basic blocks are
+// added, values renamed using SetName or SetID are moved to their new name, and
+// values removed from their module or function are dropped when found by a
+// lookup. Values added to the module or function by other means after the
+// first lookup are not present in the symbol table.
+//
+// The symbol table of a function is also built when adding basic blocks or
+// instructions using New* methods, and local names set by Func.NewBlock or
+// LocalIdent.SetName are uniqued within the symbol table.
+type symbolTable struct {
+	// mu prevents races on lookups and updates of the symbol table.
+	mu sync.Mutex
+	// symbols maps from names to the symbols of the given name; nil if not yet
+	// built.
+	symbols map[string]map[*symbol]bool
+	// n is the number of symbols added to the symbol table.
+	n int
+	// suffixes maps from names to the last numeric suffix used to unique the
+	// name.
+	suffixes map[string]int
+}
+
+// symbol is a symbol table entry.
+type symbol struct {
+	// Symbol table of the symbol.
+	t *symbolTable
+	// Value associated with the symbol.
+	v interface{}
+	// Name of the symbol.
+	name string
+	// Order in which the symbol was added to the symbol table; the first symbol
+	// added takes precedence if several values share a name.
+	order int
+	// (optional) Identifier of the value; set by setSymbol.
+	ident symbolIdent
+	// present reports whether the value is still present in its module or
+	// function.
+	present func() bool
+	// removed specifies whether the symbol has been removed from the symbol
+	// table.
+	removed bool
+}
+
+// symbolIdent is an identifier of a value which keeps its symbol table entry up
+// to date when renamed.
+type symbolIdent interface {
+	// setSymbol sets the symbol table entry of the identifier.
+	setSymbol(sym *symbol)
+}
+
+// lookup returns the value of the given name; or nil if not present. The
+// symbol table is built using index on first lookup.
+func (t *symbolTable) lookup(name string, index func(t *symbolTable)) interface{} {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.build(index)
+	found := t.find(name, nil)
+	if found == nil {
+		return nil
+	}
+	return found.v
+}
+
+// build builds the symbol table using index, if not yet built. It reports
+// whether the symbol table was built by this call. The caller must hold t.mu.
+func (t *symbolTable) build(index func(t *symbolTable)) bool {
+	if t.symbols != nil {
+		return false
+	}
+	t.symbols = make(map[string]map[*symbol]bool)
+	index(t)
+	return true
+}
+
+// find returns the first added symbol of the given name, other than the
+// symbol skip; or nil if not present. Symbols of values no longer present are
+// removed. The caller must hold t.mu.
+func (t *symbolTable) find(name string, skip *symbol) *symbol {
+	var found *symbol
+	for sym := range t.symbols[name] {
+		if !sym.present() {
+			t.remove(sym)
+			sym.removed = true
+			continue
+		}
+		if sym == skip {
+			continue
+		}
+		if found == nil || sym.order < found.order {
+			found = sym
+		}
+	}
+	return found
+}
+
+// uniqueLocalName returns a local name based on the given name which is not
+// used by any symbol other than skip, by appending a numeric suffix if needed
+// (e.g. x.1). The caller must hold t.mu.
+func (t *symbolTable) uniqueLocalName(name string, skip *symbol) string {
+	// Symbols are recorded by the quoted name of local identifiers (see
+	// LocalIdent.Name).
+	used := func(name string) bool {
+		return t.find(LocalIdent{LocalName: name}.Name(), skip) != nil
+	}
+	if len(name) == 0 || !used(name) {
+		return name
+	}
+	if t.suffixes == nil {
+		t.suffixes = make(map[string]int)
+	}
+	for i := t.suffixes[name] + 1; ; i++ {
+		newName := fmt.Sprintf("%s.%d", name, i)
+		if !used(newName) {
+			t.suffixes[name] = i
+			return newName
+		}
+	}
+}
+
+// add adds the given value to the symbol table, if the symbol table has been
+// built. The caller must hold t.mu, unless called by index.
+func (t *symbolTable) add(name string, v interface{}, ident symbolIdent, present func() bool) {
+	if t.symbols == nil {
+		return
+	}
+	sym := &symbol{t: t, v: v, name: name, order: t.n, present: present}
+	t.n++
+	if ident != nil {
+		ident.setSymbol(sym)
+	}
+	t.insert(sym)
+}
+
+// insert inserts the given symbol into the symbol table.
+func (t *symbolTable) insert(sym *symbol) {
+	syms, ok := t.symbols[sym.name]
+	if !ok {
+		syms = make(map[*symbol]bool)
+		t.symbols[sym.name] = syms
+	}
+	syms[sym] = true
+}
+
+// remove removes the given symbol from the symbol table.
+func (t *symbolTable) remove(sym *symbol) {
+	syms := t.symbols[sym.name]
+	delete(syms, sym)
+	if len(syms) == 0 {
+		delete(t.symbols, sym.name)
+	}
+}
+
+// setLocalName sets the name of the given local identifier, and moves its
+// symbol to the new name. The name is uniqued within the symbol table, unless
+// sym is nil, not the symbol of ident (e.g. for copied values) or removed from
+// the symbol table.
+func (sym *symbol) setLocalName(ident *LocalIdent, name string) {
+	if sym == nil || sym.ident != ident {
+		ident.LocalName = name
+		ident.LocalID = 0
+		return
+	}
+	t := sym.t
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if !sym.removed && sym.present() {
+		name = t.uniqueLocalName(name, sym)
+	}
+	ident.LocalName = name
+	ident.LocalID = 0
+	if sym.removed {
+		return
+	}
+	t.remove(sym)
+	sym.name = ident.Name()
+	t.insert(sym)
+}
+
+// rename moves the symbol of the given identifier to the new name. It is a
+// no-op if sym is nil or not the symbol of ident (e.g. for copied values).
+func (sym *symbol) rename(ident symbolIdent, name string) {
+	if sym == nil || sym.ident != ident {
+		return
+	}
+	t := sym.t
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if sym.removed {
+		return
+	}
+	t.remove(sym)
+	sym.name = name
+	t.insert(sym)
+}
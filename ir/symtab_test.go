@@ -0,0 +1,229 @@
+package ir_test
+
+import (
+	"testing"
+
+	"github.com/umaumax/llvm/asm"
+	"github.com/umaumax/llvm/ir"
+	"github.com/umaumax/llvm/ir/constant"
+	"github.com/umaumax/llvm/ir/types"
+)
+
+func TestModuleSymbolTable(t *testing.T) {
+	m := ir.NewModule()
+	point := m.NewTypeDef("point", types.NewStruct(types.I32, types.I32))
+	g := m.NewGlobalDef("g", constant.NewInt(types.I32, 42))
+	f := m.NewFunc("f", types.Void)
+	alias := m.NewAlias("a", f)
+	if got := m.TypeDef("point"); got != point {
+		t.Errorf("type definition mismatch; expected %v, got %v", point, got)
+	}
+	if got := m.Global("g"); got != g {
+		t.Errorf("global mismatch; expected %v, got %v", g, got)
+	}
+	if got := m.Func("f"); got != f {
+		t.Errorf("function mismatch; expected %v, got %v", f, got)
+	}
+	if got := m.Alias("a"); got != alias {
+		t.Errorf("alias mismatch; expected %v, got %v", alias, got)
+	}
+	// Global identifiers share a namespace.
+	if got := m.Func("g"); got != nil {
+		t.Errorf("function mismatch; expected nil, got %v", got)
+	}
+	// Rename.
+	f.SetName("h")
+	if got := m.Func("f"); got != nil {
+		t.Errorf("function mismatch; expected nil, got %v", got)
+	}
+	if got := m.Func("h"); got != f {
+		t.Errorf("function mismatch; expected %v, got %v", f, got)
+	}
+	// Removal.
+	m.Globals = nil
+	if got := m.Global("g"); got != nil {
+		t.Errorf("global mismatch; expected nil, got %v", got)
+	}
+	// Insertion after first lookup.
+	g2 := m.NewGlobalDef("g2", constant.NewInt(types.I32, 0))
+	if got := m.Global("g2"); got != g2 {
+		t.Errorf("global mismatch; expected %v, got %v", g2, got)
+	}
+	// Values added by direct modification after the first lookup are not
+	// tracked.
+	g3 := ir.NewGlobalDef("g3", constant.NewInt(types.I32, 0))
+	m.Globals = append(m.Globals, g3)
+	if got := m.Global("g3"); got != nil {
+		t.Errorf("global mismatch; expected nil, got %v", got)
+	}
+}
+
+func TestFuncSymbolTable(t *testing.T) {
+	m := ir.NewModule()
+	x := ir.NewParam("x", types.I32)
+	f := m.NewFunc("f", types.I32, x)
+	entry := f.NewBlock("entry")
+	a := entry.NewAdd(x, constant.NewInt(types.I32, 1))
+	a.SetName("a")
+	b := entry.NewAdd(a, constant.NewInt(types.I32, 2))
+	entry.NewRet(b)
+	if err := f.AssignIDs(); err != nil {
+		t.Fatal(err)
+	}
+	if got := f.Local("x"); got != x {
+		t.Errorf("local mismatch; expected %v, got %v", x, got)
+	}
+	if got := f.Block("entry"); got != entry {
+		t.Errorf("block mismatch; expected %v, got %v", entry, got)
+	}
+	if got := f.Local("a"); got != a {
+		t.Errorf("local mismatch; expected %v, got %v", a, got)
+	}
+	// Unnamed local variables are looked up by ID.
+	if got := f.Local("0"); got != b {
+		t.Errorf("local mismatch; expected %v, got %v", b, got)
+	}
+	if got := f.Block("a"); got != nil {
+		t.Errorf("block mismatch; expected nil, got %v", got)
+	}
+	// Rename.
+	a.SetName("sum")
+	if got := f.Local("a"); got != nil {
+		t.Errorf("local mismatch; expected nil, got %v", got)
+	}
+	if got := f.Local("sum"); got != a {
+		t.Errorf("local mismatch; expected %v, got %v", a, got)
+	}
+	// Removal.
+	entry.Insts = entry.Insts[1:]
+	if got := f.Local("sum"); got != nil {
+		t.Errorf("local mismatch; expected nil, got %v", got)
+	}
+}
+
+func TestFuncSymbolTableIncremental(t *testing.T) {
+	m := ir.NewModule()
+	x := ir.NewParam("", types.I32)
+	f := m.NewFunc("f", types.I32, x)
+	// Build the symbol table before adding basic blocks and instructions.
+	if got := f.Local("entry"); got != nil {
+		t.Errorf("local mismatch; expected nil, got %v", got)
+	}
+	entry := f.NewBlock("entry")
+	a := entry.NewAdd(x, constant.NewInt(types.I32, 1))
+	b := entry.NewMul(a, constant.NewInt(types.I32, 2))
+	b.SetName("b")
+	entry.NewRet(b)
+	if got := f.Block("entry"); got != entry {
+		t.Errorf("block mismatch; expected %v, got %v", entry, got)
+	}
+	if got := f.Local("b"); got != b {
+		t.Errorf("local mismatch; expected %v, got %v", b, got)
+	}
+	// IDs assigned after the symbol table was built.
+	if err := f.AssignIDs(); err != nil {
+		t.Fatal(err)
+	}
+	if got := f.Local("0"); got != x {
+		t.Errorf("local mismatch; expected %v, got %v", x, got)
+	}
+	if got := f.Local("1"); got != a {
+		t.Errorf("local mismatch; expected %v, got %v", a, got)
+	}
+	// Removed values are not renamed into the symbol table.
+	entry.Insts = entry.Insts[1:]
+	if got := f.Local("b"); got != b {
+		t.Errorf("local mismatch; expected %v, got %v", b, got)
+	}
+	if got := f.Local("1"); got != nil {
+		t.Errorf("local mismatch; expected nil, got %v", got)
+	}
+	a.SetName("a")
+	if got := f.Local("a"); got != nil {
+		t.Errorf("local mismatch; expected nil, got %v", got)
+	}
+}
+
+func TestFuncSymbolTableParsed(t *testing.T) {
+	const src = `
+define i32 @f(i32 %x) {
+entry:
+	%y = add i32 %x, 1
+	br label %exit
+
+exit:
+	%0 = mul i32 %y, 2
+	ret i32 %0
+}
+`
+	m, err := asm.ParseString("<stdin>", src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := m.Func("f")
+	if f == nil {
+		t.Fatal("unable to locate function @f")
+	}
+	exit := f.Block("exit")
+	if exit == nil || exit != f.Blocks[1] {
+		t.Fatalf("block mismatch; expected %v, got %v", f.Blocks[1], exit)
+	}
+	y := f.Blocks[0].Insts[0].(*ir.InstAdd)
+	if got := f.Local("y"); got != y {
+		t.Errorf("local mismatch; expected %v, got %v", y, got)
+	}
+	z := exit.Insts[0].(*ir.InstMul)
+	if got := f.Local("0"); got != z {
+		t.Errorf("local mismatch; expected %v, got %v", z, got)
+	}
+}
+
+func TestFuncSymbolTableUniqueNames(t *testing.T) {
+	m := ir.NewModule()
+	x := ir.NewParam("x", types.I32)
+	f := m.NewFunc("f", types.I32, x)
+	entry := f.NewBlock("x")
+	a := entry.NewAdd(x, constant.NewInt(types.I32, 1))
+	a.SetName("x")
+	b := entry.NewAdd(a, constant.NewInt(types.I32, 2))
+	b.SetName("x.1")
+	c := entry.NewAdd(b, constant.NewInt(types.I32, 3))
+	c.SetName("x")
+	entry.NewRet(c)
+	const want = "" +
+		"define i32 @f(i32 %x) {\n" +
+		"x.1:\n" +
+		"\t%x.2 = add i32 %x, 1\n" +
+		"\t%x.1.1 = add i32 %x.2, 2\n" +
+		"\t%x.3 = add i32 %x.1.1, 3\n" +
+		"\tret i32 %x.3\n" +
+		"}"
+	if got := f.LLString(); got != want {
+		t.Errorf("function mismatch; expected %q, got %q", want, got)
+	}
+	if got := f.Local("x.2"); got != a {
+		t.Errorf("local mismatch; expected %v, got %v", a, got)
+	}
+}
+
+func TestFuncAssignIDsNamed(t *testing.T) {
+	// Local variables of the same name added by direct modification are not
+	// renamed by AssignIDs.
+	x := ir.NewParam("x", types.I32)
+	f := ir.NewFunc("f", types.I32, x)
+	entry := ir.NewBlock("")
+	y := ir.NewAdd(x, constant.NewInt(types.I32, 1))
+	y.SetName("x")
+	entry.Insts = append(entry.Insts, y)
+	entry.Term = ir.NewRet(y)
+	f.Blocks = append(f.Blocks, entry)
+	if err := f.AssignIDs(); err != nil {
+		t.Fatal(err)
+	}
+	if got := y.Name(); got != "x" {
+		t.Errorf("name mismatch; expected %q, got %q", "x", got)
+	}
+	if got := entry.ID(); got != 0 {
+		t.Errorf("ID mismatch; expected 0, got %d", got)
+	}
+}
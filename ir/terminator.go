@@ -26,6 +26,7 @@ import (
 //    *ir.TermSwitch        // https://godoc.org/github.com/llir/llvm/ir#TermSwitch
 //    *ir.TermIndirectBr    // https://godoc.org/github.com/llir/llvm/ir#TermIndirectBr
 //    *ir.TermInvoke        // https://godoc.org/github.com/llir/llvm/ir#TermInvoke
+//    *ir.TermCallBr        // https://godoc.org/github.com/llir/llvm/ir#TermCallBr
 //    *ir.TermResume        // https://godoc.org/github.com/llir/llvm/ir#TermResume
 //    *ir.TermCatchSwitch   // https://godoc.org/github.com/llir/llvm/ir#TermCatchSwitch
 //    *ir.TermCatchRet      // https://godoc.org/github.com/llir/llvm/ir#TermCatchRet
@@ -35,6 +36,8 @@ type Terminator interface {
 	LLStringer
 	// Succs returns the successor basic blocks of the terminator.
 	Succs() []*Block
+	// Operands returns a mutable list of operands of the given terminator.
+	Operands() []*value.Value
 }
 
 // --- [ ret ] -----------------------------------------------------------------
@@ -83,6 +86,14 @@ func (term *TermRet) LLString() string {
 	return buf.String()
 }
 
+// Operands returns a mutable list of operands of the given terminator.
+func (term *TermRet) Operands() []*value.Value {
+	if term.X != nil {
+		return []*value.Value{&term.X}
+	}
+	return nil
+}
+
 // --- [ br ] ------------------------------------------------------------------
 
 // TermBr is an unconditional LLVM IR br terminator.
@@ -124,6 +135,12 @@ func (term *TermBr) LLString() string {
 	return buf.String()
 }
 
+// Operands returns a mutable list of operands of the given terminator.
+func (term *TermBr) Operands() []*value.Value {
+	// no operands.
+	return nil
+}
+
 // --- [ conditional br ] ------------------------------------------------------
 
 // TermCondBr is a conditional LLVM IR br terminator.
@@ -170,6 +187,11 @@ func (term *TermCondBr) LLString() string {
 	return buf.String()
 }
 
+// Operands returns a mutable list of operands of the given terminator.
+func (term *TermCondBr) Operands() []*value.Value {
+	return []*value.Value{&term.Cond}
+}
+
 // --- [ switch ] --------------------------------------------------------------
 
 // TermSwitch is an LLVM IR switch terminator.
@@ -225,6 +247,11 @@ func (term *TermSwitch) LLString() string {
 	return buf.String()
 }
 
+// Operands returns a mutable list of operands of the given terminator.
+func (term *TermSwitch) Operands() []*value.Value {
+	return []*value.Value{&term.X}
+}
+
 // ~~~ [ Switch case ] ~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~
 
 // Case is a switch case.
@@ -293,6 +320,11 @@ func (term *TermIndirectBr) LLString() string {
 	return buf.String()
 }
 
+// Operands returns a mutable list of operands of the given terminator.
+func (term *TermIndirectBr) Operands() []*value.Value {
+	return []*value.Value{&term.Addr}
+}
+
 // --- [ invoke ] --------------------------------------------------------------
 
 // TermInvoke is an LLVM IR invoke terminator.
@@ -356,14 +388,7 @@ func (term *TermInvoke) String() string {
 func (term *TermInvoke) Type() types.Type {
 	// Cache type if not present.
 	if term.Typ == nil {
-		t, ok := term.Invokee.Type().(*types.PointerType)
-		if !ok {
-			panic(fmt.Errorf("invalid invokee type; expected *types.PointerType, got %T", term.Invokee.Type()))
-		}
-		sig, ok := t.ElemType.(*types.FuncType)
-		if !ok {
-			panic(fmt.Errorf("invalid invokee type; expected *types.FuncType, got %T", t.ElemType))
-		}
+		sig := calleeSig(term.Invokee)
 		if sig.Variadic {
 			term.Typ = sig
 		} else {
@@ -441,6 +466,170 @@ func (term *TermInvoke) LLString() string {
 	return buf.String()
 }
 
+// Operands returns a mutable list of operands of the given terminator.
+func (term *TermInvoke) Operands() []*value.Value {
+	ops := []*value.Value{&term.Invokee}
+	ops = append(ops, argOperands(term.Args)...)
+	ops = append(ops, bundleOperands(term.OperandBundles)...)
+	return ops
+}
+
+// --- [ callbr ] --------------------------------------------------------------
+
+// TermCallBr is an LLVM IR callbr terminator.
+type TermCallBr struct {
+	// Name of local variable associated with the result.
+	LocalIdent
+	// Callee function.
+	// TODO: specify the set of underlying types of Callee.
+	Callee value.Value
+	// Function arguments.
+	//
+	// Arg has one of the following underlying types:
+	//    value.Value
+	//    TODO: add metadata value?
+	Args []value.Value
+	// Normal control flow return point.
+	Normal *Block
+	// Indirect control flow return points.
+	IndirectTargets []*Block
+
+	// extra.
+
+	// Type of result produced by the terminator, or function signature of the
+	// callee (as used when callee is variadic).
+	Typ types.Type
+	// Successor basic blocks of the terminator.
+	Successors []*Block
+	// (optional) Calling convention; zero if not present.
+	CallingConv enum.CallingConv
+	// (optional) Return attributes.
+	ReturnAttrs []ReturnAttribute
+	// (optional) Address space; zero if not present.
+	AddrSpace types.AddrSpace
+	// (optional) Function attributes.
+	FuncAttrs []FuncAttribute
+	// (optional) Operand bundles.
+	OperandBundles []*OperandBundle
+	// (optional) Metadata.
+	Metadata
+}
+
+// NewCallBr returns a new callbr terminator based on the given callee, function
+// arguments and control flow return points for normal and indirect execution.
+//
+// TODO: specify the set of underlying types of callee.
+func NewCallBr(callee value.Value, args []value.Value, normal *Block, indirectTargets ...*Block) *TermCallBr {
+	term := &TermCallBr{Callee: callee, Args: args, Normal: normal, IndirectTargets: indirectTargets}
+	// Compute type.
+	term.Type()
+	return term
+}
+
+// String returns the LLVM syntax representation of the terminator as a type-
+// value pair.
+func (term *TermCallBr) String() string {
+	return fmt.Sprintf("%s %s", term.Type(), term.Ident())
+}
+
+// Type returns the type of the terminator.
+func (term *TermCallBr) Type() types.Type {
+	// Cache type if not present.
+	if term.Typ == nil {
+		sig := calleeSig(term.Callee)
+		if sig.Variadic {
+			term.Typ = sig
+		} else {
+			term.Typ = sig.RetType
+		}
+	}
+	if t, ok := term.Typ.(*types.FuncType); ok {
+		return t.RetType
+	}
+	return term.Typ
+}
+
+// Succs returns the successor basic blocks of the terminator.
+func (term *TermCallBr) Succs() []*Block {
+	// Cache successors if not present.
+	if term.Successors == nil {
+		term.Successors = append([]*Block{term.Normal}, term.IndirectTargets...)
+	}
+	return term.Successors
+}
+
+// LLString returns the LLVM syntax representation of the terminator.
+func (term *TermCallBr) LLString() string {
+	// 'callbr' CallingConvopt ReturnAttrs=ReturnAttribute* AddrSpaceopt Typ=Type
+	// Callee=Value '(' Args ')' FuncAttrs=FuncAttribute* OperandBundles=('['
+	// (OperandBundle separator ',')+ ']')? 'to' Normal=Label '['
+	// IndirectTargets=(Label separator ',')* ']' Metadata=(','
+	// MetadataAttachment)+?
+	buf := &strings.Builder{}
+	if !term.Type().Equal(types.Void) {
+		fmt.Fprintf(buf, "%s = ", term.Ident())
+	}
+	buf.WriteString("callbr")
+	if term.CallingConv != enum.CallingConvNone {
+		fmt.Fprintf(buf, " %s", callingConvString(term.CallingConv))
+	}
+	for _, attr := range term.ReturnAttrs {
+		fmt.Fprintf(buf, " %s", attr)
+	}
+	// (optional) Address space.
+	if term.AddrSpace != 0 {
+		fmt.Fprintf(buf, " %s", term.AddrSpace)
+	}
+	// Use function signature instead of return type for variadic functions.
+	typ := term.Type()
+	if t, ok := term.Typ.(*types.FuncType); ok {
+		if t.Variadic {
+			typ = t
+		}
+	}
+	fmt.Fprintf(buf, " %s %s(", typ, term.Callee.Ident())
+	for i, arg := range term.Args {
+		if i != 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(arg.String())
+	}
+	buf.WriteString(")")
+	for _, attr := range term.FuncAttrs {
+		fmt.Fprintf(buf, " %s", attr)
+	}
+	if len(term.OperandBundles) > 0 {
+		buf.WriteString(" [ ")
+		for i, operandBundle := range term.OperandBundles {
+			if i != 0 {
+				buf.WriteString(", ")
+			}
+			buf.WriteString(operandBundle.String())
+		}
+		buf.WriteString(" ]")
+	}
+	fmt.Fprintf(buf, "\n\t\tto %s [", term.Normal)
+	for i, target := range term.IndirectTargets {
+		if i != 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(target.String())
+	}
+	buf.WriteString("]")
+	for _, md := range term.Metadata {
+		fmt.Fprintf(buf, ", %s", md)
+	}
+	return buf.String()
+}
+
+// Operands returns a mutable list of operands of the given terminator.
+func (term *TermCallBr) Operands() []*value.Value {
+	ops := []*value.Value{&term.Callee}
+	ops = append(ops, argOperands(term.Args)...)
+	ops = append(ops, bundleOperands(term.OperandBundles)...)
+	return ops
+}
+
 // --- [ resume ] --------------------------------------------------------------
 
 // TermResume is an LLVM IR resume terminator.
@@ -477,6 +666,11 @@ func (term *TermResume) LLString() string {
 	return buf.String()
 }
 
+// Operands returns a mutable list of operands of the given terminator.
+func (term *TermResume) Operands() []*value.Value {
+	return []*value.Value{&term.X}
+}
+
 // --- [ catchswitch ] ---------------------------------------------------------
 
 // TermCatchSwitch is an LLVM IR catchswitch terminator.
@@ -549,6 +743,13 @@ func (term *TermCatchSwitch) LLString() string {
 	return buf.String()
 }
 
+// Operands returns a mutable list of operands of the given terminator.
+//
+// The exception scope is included as a read-only operand (see padOperands).
+func (term *TermCatchSwitch) Operands() []*value.Value {
+	return padOperands(term.Scope)
+}
+
 // --- [ catchret ] ------------------------------------------------------------
 
 // TermCatchRet is an LLVM IR catchret terminator.
@@ -593,6 +794,17 @@ func (term *TermCatchRet) LLString() string {
 	return buf.String()
 }
 
+// Operands returns a mutable list of operands of the given terminator.
+//
+// The exit catchpad is included as a read-only operand (see padOperands).
+func (term *TermCatchRet) Operands() []*value.Value {
+	var from value.Value
+	if term.From != nil {
+		from = term.From
+	}
+	return padOperands(from)
+}
+
 // --- [ cleanupret ] ----------------------------------------------------------
 
 // TermCleanupRet is an LLVM IR cleanupret terminator.
@@ -641,6 +853,17 @@ func (term *TermCleanupRet) LLString() string {
 	return buf.String()
 }
 
+// Operands returns a mutable list of operands of the given terminator.
+//
+// The exit cleanuppad is included as a read-only operand (see padOperands).
+func (term *TermCleanupRet) Operands() []*value.Value {
+	var from value.Value
+	if term.From != nil {
+		from = term.From
+	}
+	return padOperands(from)
+}
+
 // --- [ unreachable ] ---------------------------------------------------------
 
 // TermUnreachable is an LLVM IR unreachable terminator.
@@ -672,3 +895,9 @@ func (term *TermUnreachable) LLString() string {
 	}
 	return buf.String()
 }
+
+// Operands returns a mutable list of operands of the given terminator.
+func (term *TermUnreachable) Operands() []*value.Value {
+	// no operands.
+	return nil
+}
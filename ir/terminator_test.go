@@ -0,0 +1,71 @@
+package ir
+
+import (
+	"testing"
+
+	"github.com/umaumax/llvm/ir/constant"
+	"github.com/umaumax/llvm/ir/types"
+	"github.com/umaumax/llvm/ir/value"
+)
+
+func TestTermCallBr(t *testing.T) {
+	f := NewFunc("f", types.I32, NewParam("x", types.I32))
+	entry := f.NewBlock("entry")
+	normal := f.NewBlock("normal")
+	foo := f.NewBlock("foo")
+	bar := f.NewBlock("bar")
+	asm := NewInlineAsm(types.NewPointer(types.NewFunc(types.I32, types.I32)), "", "=r,r")
+	term := entry.NewCallBr(asm, []value.Value{f.Params[0]}, normal, foo, bar)
+	x := normal.NewFreeze(term)
+	normal.NewRet(x)
+	foo.NewRet(constant.NewInt(types.I32, 1))
+	bar.NewRet(constant.NewInt(types.I32, 2))
+	if err := f.AssignIDs(); err != nil {
+		t.Fatalf("unable to assign IDs; %v", err)
+	}
+	succs := term.Succs()
+	if len(succs) != 3 || succs[0] != normal || succs[1] != foo || succs[2] != bar {
+		t.Errorf("successors mismatch; expected [normal foo bar], got %v", succs)
+	}
+	const wantTerm = "%0 = callbr i32 asm \"\", \"=r,r\"(i32 %x)\n\t\tto label %normal [label %foo, label %bar]"
+	if got := term.LLString(); got != wantTerm {
+		t.Errorf("callbr mismatch; expected %q, got %q", wantTerm, got)
+	}
+	const wantInst = "%1 = freeze i32 %0"
+	if got := x.LLString(); got != wantInst {
+		t.Errorf("freeze mismatch; expected %q, got %q", wantInst, got)
+	}
+}
+
+func TestExceptionPadOperands(t *testing.T) {
+	f := NewFunc("f", types.Void)
+	entry := f.NewBlock("entry")
+	dispatch := f.NewBlock("dispatch")
+	handler := f.NewBlock("handler")
+	cleanup := f.NewBlock("cleanup")
+	exit := f.NewBlock("exit")
+	entry.NewBr(dispatch)
+	none := constant.None
+	cs := dispatch.NewCatchSwitch(none, []*Block{handler}, nil)
+	cp := handler.NewCatchPad(cs)
+	handler.NewCatchRet(cp, exit)
+	clp := cleanup.NewCleanupPad(none)
+	cleanup.NewCleanupRet(clp, nil)
+	exit.NewRet(nil)
+	golden := []struct {
+		inst interface{ Operands() []*value.Value }
+		want value.Value
+	}{
+		{inst: cs, want: none},
+		{inst: cp, want: cs},
+		{inst: handler.Term, want: cp},
+		{inst: clp, want: none},
+		{inst: cleanup.Term, want: clp},
+	}
+	for _, g := range golden {
+		ops := g.inst.Operands()
+		if len(ops) != 1 || *ops[0] != g.want {
+			t.Errorf("operands mismatch of %T; expected [%v], got %v", g.inst, g.want, ops)
+		}
+	}
+}
@@ -48,3 +48,38 @@ func TestModule(t *testing.T) {
 		}
 	}
 }
+
+func TestModuleTypeContext(t *testing.T) {
+	const src = `
+%list = type { i32, %list* }
+
+@x = global i32 0
+@y = global [2 x %list*] zeroinitializer
+
+declare i32* @f(i32, %list*)
+declare i32* @g(i32, %list*)
+`
+	m, err := asm.ParseString("<stdin>", src)
+	if err != nil {
+		t.Fatalf("unable to parse module; %+v", err)
+	}
+	ctx := m.TypeContext
+	list := ctx.NamedStruct("list")
+	if m.TypeDefs[0] != list {
+		t.Errorf("identified struct type mismatch; expected %p, got %p", list, m.TypeDefs[0])
+	}
+	x, y := m.Globals[0], m.Globals[1]
+	if want, got := ctx.Int(32), x.ContentType; want != got {
+		t.Errorf("content type mismatch; expected %p, got %p", want, got)
+	}
+	if want, got := ctx.Array(2, ctx.Pointer(list, 0)), y.ContentType; want != got {
+		t.Errorf("content type mismatch; expected %p, got %p", want, got)
+	}
+	f, g := m.Funcs[0], m.Funcs[1]
+	if f.Sig != g.Sig || f.Typ != g.Typ {
+		t.Errorf("function type mismatch; expected identical types of %q and %q", f.Ident(), g.Ident())
+	}
+	if want, got := x.Typ, f.Sig.RetType; want != got {
+		t.Errorf("return type mismatch; expected %p, got %p", want, got)
+	}
+}
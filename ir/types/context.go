@@ -0,0 +1,372 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// === [ Type contexts ] =======================================================
+
+// Context is a type uniquing context. Within a context, every type without a
+// type name is interned, so that structurally equal types are represented by
+// the same pointer, and every identified (named) struct type is unique by type
+// name. Types of the same context are therefore equal if and only if they are
+// identical (i.e. t == u).
+//
+// Named types other than struct types (e.g. %x = type i32) are not uniqued,
+// but their component types are interned.
+//
+// A nil context is valid and does not unique types; its methods return new
+// types, as created by the corresponding New* functions.
+//
+// In opaque pointer mode (see SetOpaquePointers), every pointer type of the
+// context is an opaque pointer type.
+//
+// Types interned by a context must not be modified, as they may be shared.
+type Context struct {
+	// mu prevents races on context access.
+	mu sync.Mutex
+	// ids maps from interned types, identified struct types and named types to
+	// unique IDs, used to form keys of composite types.
+	ids map[Type]int
+	// types maps from structural keys to interned types.
+	types map[string]Type
+	// structs maps from type names to identified struct types.
+	structs map[string]*StructType
+	// named tracks named types (other than identified struct types) whose
+	// component types have been interned.
+	named map[Type]bool
+	// opaquePointers specifies whether the context is in opaque pointer mode.
+	opaquePointers bool
+}
+
+// NewContext returns a new type uniquing context.
+func NewContext() *Context {
+	return &Context{
+		ids:     make(map[Type]int),
+		types:   make(map[string]Type),
+		structs: make(map[string]*StructType),
+		named:   make(map[Type]bool),
+	}
+}
+
+// SetOpaquePointers specifies whether the context is in opaque pointer mode; in
+// which case pointer types subsequently interned by the context (e.g. `i32*`)
+// are replaced by opaque pointer types of the same address space (e.g. `ptr`),
+// as written by LLVM 15 and later. Types interned before the mode is changed
+// are left as is.
+//
+// The default is typed pointer mode. The mode of a nil context cannot be
+// changed.
+func (ctx *Context) SetOpaquePointers(opaque bool) {
+	ctx.mu.Lock()
+	defer ctx.mu.Unlock()
+	ctx.opaquePointers = opaque
+}
+
+// OpaquePointers reports whether the context is in opaque pointer mode.
+func (ctx *Context) OpaquePointers() bool {
+	if ctx == nil {
+		return false
+	}
+	ctx.mu.Lock()
+	defer ctx.mu.Unlock()
+	return ctx.opaquePointers
+}
+
+// Int returns the integer type of the given bit size.
+func (ctx *Context) Int(bitSize uint64) *IntType {
+	return ctx.Intern(NewInt(bitSize)).(*IntType)
+}
+
+// Float returns the floating-point type of the given floating-point kind.
+func (ctx *Context) Float(kind FloatKind) *FloatType {
+	return ctx.Intern(&FloatType{Kind: kind}).(*FloatType)
+}
+
+// Pointer returns the pointer type of the given element type and address
+// space; or the opaque pointer type of the address space in opaque pointer
+// mode.
+func (ctx *Context) Pointer(elemType Type, addrSpace AddrSpace) *PointerType {
+	return ctx.Intern(&PointerType{ElemType: elemType, AddrSpace: addrSpace}).(*PointerType)
+}
+
+// Vector returns the vector type of the given vector length and element type.
+func (ctx *Context) Vector(len uint64, elemType Type) *VectorType {
+	return ctx.Intern(NewVector(len, elemType)).(*VectorType)
+}
+
+// ScalableVector returns the scalable vector type of the given minimum vector
+// length and element type.
+func (ctx *Context) ScalableVector(len uint64, elemType Type) *VectorType {
+	return ctx.Intern(NewScalableVector(len, elemType)).(*VectorType)
+}
+
+// Array returns the array type of the given array length and element type.
+func (ctx *Context) Array(len uint64, elemType Type) *ArrayType {
+	return ctx.Intern(NewArray(len, elemType)).(*ArrayType)
+}
+
+// Func returns the function type of the given return type and function
+// parameter types.
+func (ctx *Context) Func(retType Type, params ...Type) *FuncType {
+	return ctx.Intern(NewFunc(retType, params...)).(*FuncType)
+}
+
+// Struct returns the literal struct type of the given field types.
+func (ctx *Context) Struct(fields ...Type) *StructType {
+	return ctx.Intern(NewStruct(fields...)).(*StructType)
+}
+
+// NamedStruct returns the identified struct type of the given type name. An
+// opaque struct type is created and added to the context if not present.
+func (ctx *Context) NamedStruct(name string) *StructType {
+	if ctx == nil {
+		return &StructType{TypeName: name, Opaque: true}
+	}
+	ctx.mu.Lock()
+	defer ctx.mu.Unlock()
+	if t, ok := ctx.structs[name]; ok {
+		return t
+	}
+	t := &StructType{TypeName: name, Opaque: true}
+	ctx.structs[name] = t
+	ctx.newID(t)
+	return t
+}
+
+// Intern returns the type of the context which is equal to the given type.
+//
+// Types without a type name are replaced by their interned counterpart, which
+// is added to the context if not already present; t itself is left unmodified.
+// Identified struct types are replaced by the struct type of the same type name
+// in the context; t is added to the context if not present. Other named types
+// are returned as is, after their component types have been interned in place.
+func (ctx *Context) Intern(t Type) Type {
+	if ctx == nil {
+		return t
+	}
+	ctx.mu.Lock()
+	defer ctx.mu.Unlock()
+	return ctx.intern(t)
+}
+
+// Contains reports whether the given type is an interned type or identified
+// struct type of the context.
+func (ctx *Context) Contains(t Type) bool {
+	if ctx == nil || t == nil {
+		return false
+	}
+	ctx.mu.Lock()
+	defer ctx.mu.Unlock()
+	if t, ok := t.(*StructType); ok && len(t.TypeName) > 0 {
+		return ctx.structs[t.TypeName] == t
+	}
+	if len(t.Name()) > 0 {
+		return false
+	}
+	key, ok := ctx.key(t)
+	return ok && ctx.types[key] == t
+}
+
+// Equal reports whether t and u are of equal type. If both t and u are types of
+// the context (see Contains), they are equal if and only if t == u, and are not
+// compared structurally; otherwise, Equal is equivalent to types.Equal.
+func (ctx *Context) Equal(t, u Type) bool {
+	if t == u {
+		return true
+	}
+	if ctx.Contains(t) && ctx.Contains(u) {
+		return false
+	}
+	return Equal(t, u)
+}
+
+// intern returns the type of the context which is equal to the given type.
+//
+// pre-condition: ctx.mu is locked.
+func (ctx *Context) intern(t Type) Type {
+	if t == nil {
+		// Component type not yet populated (e.g. of type definitions being
+		// translated).
+		return nil
+	}
+	if len(t.Name()) > 0 {
+		if st, ok := t.(*StructType); ok {
+			if prev, ok := ctx.structs[st.TypeName]; ok {
+				return prev
+			}
+			// Add identified struct type before interning its fields, to handle
+			// recursive struct types.
+			ctx.structs[st.TypeName] = st
+			ctx.newID(st)
+			ctx.internComponents(st)
+			return st
+		}
+		if !ctx.named[t] {
+			ctx.named[t] = true
+			ctx.internComponents(t)
+		}
+		return t
+	}
+	if t, ok := t.(*PointerType); ok && ctx.opaquePointers && !t.IsOpaque() {
+		return ctx.intern(NewOpaquePointer(t.AddrSpace))
+	}
+	if key, ok := ctx.key(t); ok {
+		if prev, ok := ctx.types[key]; ok {
+			return prev
+		}
+	}
+	t = ctx.withInternedComponents(t)
+	key, _ := ctx.key(t)
+	if prev, ok := ctx.types[key]; ok {
+		return prev
+	}
+	ctx.types[key] = t
+	ctx.newID(t)
+	return t
+}
+
+// internComponents replaces the component types of the named type t by their
+// interned counterparts.
+//
+// pre-condition: ctx.mu is locked.
+func (ctx *Context) internComponents(t Type) {
+	switch t := t.(type) {
+	case *FuncType:
+		t.RetType = ctx.intern(t.RetType)
+		for i, param := range t.Params {
+			t.Params[i] = ctx.intern(param)
+		}
+	case *PointerType:
+		if ctx.opaquePointers {
+			t.ElemType = nil
+		} else {
+			t.ElemType = ctx.intern(t.ElemType)
+		}
+	case *VectorType:
+		t.ElemType = ctx.intern(t.ElemType)
+	case *ArrayType:
+		t.ElemType = ctx.intern(t.ElemType)
+	case *StructType:
+		for i, field := range t.Fields {
+			t.Fields[i] = ctx.intern(field)
+		}
+	}
+}
+
+// withInternedComponents returns a type without type name equal to t, the
+// component types of which are interned. The given type is returned if its
+// component types are already interned, and is otherwise left unmodified.
+//
+// pre-condition: ctx.mu is locked.
+func (ctx *Context) withInternedComponents(t Type) Type {
+	switch t := t.(type) {
+	case *FuncType:
+		retType := ctx.intern(t.RetType)
+		changed := retType != t.RetType
+		params := make([]Type, len(t.Params))
+		for i, param := range t.Params {
+			params[i] = ctx.intern(param)
+			changed = changed || params[i] != param
+		}
+		if changed {
+			return &FuncType{RetType: retType, Params: params, Variadic: t.Variadic}
+		}
+	case *PointerType:
+		if elemType := ctx.intern(t.ElemType); elemType != t.ElemType {
+			return &PointerType{ElemType: elemType, AddrSpace: t.AddrSpace}
+		}
+	case *VectorType:
+		if elemType := ctx.intern(t.ElemType); elemType != t.ElemType {
+			return &VectorType{Scalable: t.Scalable, Len: t.Len, ElemType: elemType}
+		}
+	case *ArrayType:
+		if elemType := ctx.intern(t.ElemType); elemType != t.ElemType {
+			return &ArrayType{Len: t.Len, ElemType: elemType}
+		}
+	case *StructType:
+		changed := false
+		fields := make([]Type, len(t.Fields))
+		for i, field := range t.Fields {
+			fields[i] = ctx.intern(field)
+			changed = changed || fields[i] != field
+		}
+		if changed {
+			return &StructType{Packed: t.Packed, Fields: fields, Opaque: t.Opaque}
+		}
+	}
+	return t
+}
+
+// key returns the structural key of the given type without type name, and
+// reports whether every component type of t is interned; i.e. whether the key
+// is valid.
+//
+// pre-condition: ctx.mu is locked.
+func (ctx *Context) key(t Type) (string, bool) {
+	valid := true
+	id := func(t Type) int {
+		if t == nil {
+			return -1
+		}
+		n, ok := ctx.ids[t]
+		if !ok {
+			if _, ok := t.(*StructType); ok || len(t.Name()) == 0 {
+				// Unregistered identified struct type or type not yet interned.
+				valid = false
+				return -1
+			}
+			n = ctx.newID(t)
+		}
+		return n
+	}
+	buf := &strings.Builder{}
+	switch t := t.(type) {
+	case *VoidType:
+		buf.WriteString("void")
+	case *FuncType:
+		fmt.Fprintf(buf, "func %d (", id(t.RetType))
+		for _, param := range t.Params {
+			fmt.Fprintf(buf, "%d,", id(param))
+		}
+		fmt.Fprintf(buf, ") %v", t.Variadic)
+	case *IntType:
+		fmt.Fprintf(buf, "i%d", t.BitSize)
+	case *FloatType:
+		buf.WriteString(t.Kind.String())
+	case *MMXType:
+		buf.WriteString("x86_mmx")
+	case *PointerType:
+		fmt.Fprintf(buf, "ptr %d %d", id(t.ElemType), t.AddrSpace)
+	case *VectorType:
+		fmt.Fprintf(buf, "vector %v %d %d", t.Scalable, t.Len, id(t.ElemType))
+	case *LabelType:
+		buf.WriteString("label")
+	case *TokenType:
+		buf.WriteString("token")
+	case *MetadataType:
+		buf.WriteString("metadata")
+	case *ArrayType:
+		fmt.Fprintf(buf, "array %d %d", t.Len, id(t.ElemType))
+	case *StructType:
+		fmt.Fprintf(buf, "struct %v %v (", t.Packed, t.Opaque)
+		for _, field := range t.Fields {
+			fmt.Fprintf(buf, "%d,", id(field))
+		}
+		buf.WriteString(")")
+	default:
+		panic(fmt.Errorf("support for type %T not yet implemented", t))
+	}
+	return buf.String(), valid
+}
+
+// newID assigns a new unique ID to the given type, and returns it.
+//
+// pre-condition: ctx.mu is locked.
+func (ctx *Context) newID(t Type) int {
+	n := len(ctx.ids)
+	ctx.ids[t] = n
+	return n
+}
@@ -0,0 +1,121 @@
+package types
+
+import "testing"
+
+func TestContextIntern(t *testing.T) {
+	ctx := NewContext()
+	golden := []struct {
+		t, u Type
+		want bool
+	}{
+		// Same structure.
+		{t: NewInt(32), u: I32, want: true},
+		{t: &FloatType{Kind: FloatKindDouble}, u: Double, want: true},
+		{t: NewPointer(NewInt(8)), u: I8Ptr, want: true},
+		{t: NewArray(4, NewPointer(I8)), u: NewArray(4, I8Ptr), want: true},
+		{t: NewVector(2, I32), u: ctx.Vector(2, NewInt(32)), want: true},
+		{t: NewFunc(Void, I32, I8Ptr), u: ctx.Func(Void, I32, NewPointer(I8)), want: true},
+		{t: NewStruct(I32, NewStruct(I8)), u: ctx.Struct(I32, ctx.Struct(I8)), want: true},
+		// Different structure.
+		{t: NewInt(32), u: I64, want: false},
+		{t: NewPointer(I8), u: &PointerType{ElemType: I8, AddrSpace: 1}, want: false},
+		{t: NewVector(2, I32), u: ctx.ScalableVector(2, I32), want: false},
+		{t: NewArray(4, I8), u: NewVector(4, I8), want: false},
+		{t: NewFunc(Void, I32), u: &FuncType{RetType: Void, Params: []Type{I32}, Variadic: true}, want: false},
+		{t: NewStruct(I32), u: &StructType{Packed: true, Fields: []Type{I32}}, want: false},
+		// Identified struct types.
+		{t: ctx.NamedStruct("foo"), u: &StructType{TypeName: "foo", Fields: []Type{I32}}, want: true},
+		{t: ctx.NamedStruct("foo"), u: ctx.NamedStruct("bar"), want: false},
+	}
+	for i, g := range golden {
+		t1, u1 := ctx.Intern(g.t), ctx.Intern(g.u)
+		if got := t1 == u1; got != g.want {
+			t.Errorf("%d: identity mismatch of %v and %v; expected %v, got %v", i, g.t, g.u, g.want, got)
+		}
+		if got := t1.Equal(u1); got != g.want {
+			t.Errorf("%d: equality mismatch of %v and %v; expected %v, got %v", i, g.t, g.u, g.want, got)
+		}
+		if got := ctx.Equal(t1, u1); got != g.want {
+			t.Errorf("%d: context equality mismatch of %v and %v; expected %v, got %v", i, g.t, g.u, g.want, got)
+		}
+		if got := ctx.Equal(g.t, g.u); got != g.want {
+			t.Errorf("%d: context equality mismatch of %v and %v; expected %v, got %v", i, g.t, g.u, g.want, got)
+		}
+		if !ctx.Contains(t1) || !ctx.Contains(u1) {
+			t.Errorf("%d: interned types %v and %v not contained in context", i, t1, u1)
+		}
+	}
+}
+
+func TestContextInternUnmodified(t *testing.T) {
+	ctx := NewContext()
+	i8 := ctx.Int(8)
+	// Interning a type with non-interned component types must leave the type
+	// unmodified.
+	elem := NewInt(8)
+	ptr := NewPointer(elem)
+	got := ctx.Intern(ptr).(*PointerType)
+	if ptr.ElemType != elem {
+		t.Errorf("element type of interned type modified; expected %p, got %p", elem, ptr.ElemType)
+	}
+	if got.ElemType != i8 {
+		t.Errorf("element type mismatch; expected %p, got %p", i8, got.ElemType)
+	}
+	if ctx.Contains(ptr) {
+		t.Errorf("non-interned type %v contained in context", ptr)
+	}
+}
+
+func TestContextRecursiveStruct(t *testing.T) {
+	// %list = type { i32, %list* }
+	ctx := NewContext()
+	list := &StructType{TypeName: "list"}
+	list.Fields = []Type{NewInt(32), NewPointer(list)}
+	if got := ctx.Intern(list); got != list {
+		t.Errorf("identified struct type mismatch; expected %p, got %p", list, got)
+	}
+	if want, got := ctx.Pointer(ctx.NamedStruct("list"), 0), list.Fields[1]; want != got {
+		t.Errorf("field type mismatch; expected %p, got %p", want, got)
+	}
+	if want, got := ctx.Int(32), list.Fields[0]; want != got {
+		t.Errorf("field type mismatch; expected %p, got %p", want, got)
+	}
+}
+
+func TestContextOpaquePointers(t *testing.T) {
+	ctx := NewContext()
+	i8Ptr := ctx.Pointer(I8, 0)
+	ctx.SetOpaquePointers(true)
+	if !ctx.OpaquePointers() {
+		t.Fatalf("opaque pointer mode not set")
+	}
+	golden := []struct {
+		t    Type
+		want string
+	}{
+		{t: ctx.Pointer(I8, 0), want: "ptr"},
+		{t: ctx.Pointer(I32, 1), want: "ptr addrspace(1)"},
+		{t: ctx.Intern(NewStruct(I8Ptr, I32)), want: "{ ptr, i32 }"},
+		{t: ctx.Func(I8Ptr, NewPointer(I8Ptr)), want: "ptr (ptr)"},
+		// Types interned before the mode is changed are left as is.
+		{t: i8Ptr, want: "i8*"},
+	}
+	for i, g := range golden {
+		if got := g.t.String(); got != g.want {
+			t.Errorf("%d: type mismatch; expected %q, got %q", i, g.want, got)
+		}
+	}
+	if want, got := ctx.Pointer(nil, 0), ctx.Pointer(I32, 0); want != got {
+		t.Errorf("opaque pointer type mismatch; expected %p, got %p", want, got)
+	}
+}
+
+func TestNilContext(t *testing.T) {
+	var ctx *Context
+	if ctx.Int(32) == ctx.Int(32) {
+		t.Errorf("nil context unexpectedly uniqued types")
+	}
+	if !ctx.Int(32).Equal(I32) {
+		t.Errorf("type mismatch; expected %v, got %v", I32, ctx.Int(32))
+	}
+}
@@ -39,6 +39,8 @@ var (
 	I32Ptr  = &PointerType{ElemType: I32}  // i32*
 	I64Ptr  = &PointerType{ElemType: I64}  // i64*
 	I128Ptr = &PointerType{ElemType: I128} // i128*
+	// Opaque pointer type.
+	Ptr = &PointerType{} // ptr
 )
 
 // Convenience functions.
@@ -115,8 +117,15 @@ func IsStruct(t Type) bool {
 	return ok
 }
 
-// Equal reports whether t and u are of equal type.
+// Equal reports whether t and u are of equal type. Identical types are equal;
+// other types are compared structurally.
+//
+// Types interned by the same type context are equal if and only if t == u; use
+// Context.Equal to skip the structural comparison of such types.
 func Equal(t, u Type) bool {
+	if t == u {
+		return true
+	}
 	return t.Equal(u)
 }
 
@@ -215,6 +224,9 @@ func NewFunc(retType Type, params ...Type) *FuncType {
 
 // Equal reports whether t and u are of equal type.
 func (t *FuncType) Equal(u Type) bool {
+	if t == u {
+		return true
+	}
 	if u, ok := u.(*FuncType); ok {
 		if !t.RetType.Equal(u.RetType) {
 			return false
@@ -430,27 +442,63 @@ func (t *MMXType) SetName(name string) {
 // --- [ Pointer types ] -------------------------------------------------------
 
 // PointerType is an LLVM IR pointer type.
+//
+// A pointer type without element type is an opaque pointer type (e.g. `ptr`).
+// Values of opaque pointer type carry no information about the type of the
+// memory they point to; instead, the instructions using them specify the type
+// explicitly (e.g. the element type of load and getelementptr, and the function
+// type of call).
 type PointerType struct {
 	// Type name; or empty if not present.
 	TypeName string
-	// Element type.
+	// Element type; or nil if opaque pointer type.
 	ElemType Type
 	// Address space; or zero value for default address space.
 	AddrSpace AddrSpace
 }
 
-// NewPointer returns a new pointer type based on the given element type.
+// NewPointer returns a new pointer type based on the given element type. An
+// opaque pointer type is returned if elemType is nil.
 func NewPointer(elemType Type) *PointerType {
 	return &PointerType{
 		ElemType: elemType,
 	}
 }
 
+// NewOpaquePointer returns a new opaque pointer type based on the given address
+// space.
+func NewOpaquePointer(addrSpace AddrSpace) *PointerType {
+	return &PointerType{
+		AddrSpace: addrSpace,
+	}
+}
+
+// IsOpaque reports whether the pointer type is an opaque pointer type; i.e.
+// whether it has no element type.
+func (t *PointerType) IsOpaque() bool {
+	return t.ElemType == nil
+}
+
 // Equal reports whether t and u are of equal type.
+//
+// Opaque pointer types are distinct from typed pointer types; i.e. `ptr` and
+// `i8*` are not equal.
 func (t *PointerType) Equal(u Type) bool {
-	// HACK: to prevent infinite loops (e.g. struct foo containing field of type
-	// pointer to foo).
-	return t.String() == u.String()
+	if t == u {
+		return true
+	}
+	if u, ok := u.(*PointerType); ok {
+		if t.AddrSpace != u.AddrSpace {
+			return false
+		}
+		if t.IsOpaque() || u.IsOpaque() {
+			return t.IsOpaque() && u.IsOpaque()
+		}
+		// Recursive types are terminated by identified struct types, which are
+		// compared by type name.
+		return t.ElemType.Equal(u.ElemType)
+	}
+	return false
 }
 
 // String returns the string representation of the pointer type.
@@ -464,8 +512,17 @@ func (t *PointerType) String() string {
 // LLString returns the LLVM syntax representation of the definition of the
 // type.
 func (t *PointerType) LLString() string {
+	// 'ptr' AddrSpaceopt
+	//
 	// Elem=Type AddrSpaceopt '*'
 	buf := &strings.Builder{}
+	if t.IsOpaque() {
+		buf.WriteString("ptr")
+		if t.AddrSpace != 0 {
+			fmt.Fprintf(buf, " %s", t.AddrSpace)
+		}
+		return buf.String()
+	}
 	buf.WriteString(t.ElemType.String())
 	if t.AddrSpace != 0 {
 		fmt.Fprintf(buf, " %s", t.AddrSpace)
@@ -499,9 +556,10 @@ func (a AddrSpace) String() string {
 type VectorType struct {
 	// Type name; or empty if not present.
 	TypeName string
-	// Scalable vector type.
+	// Scalable vector type; the number of elements is an unknown (runtime)
+	// multiple of Len, given by vscale.
 	Scalable bool
-	// Vector length.
+	// Vector length; or minimum vector length of scalable vector types.
 	Len uint64
 	// Element type.
 	ElemType Type
@@ -516,8 +574,21 @@ func NewVector(len uint64, elemType Type) *VectorType {
 	}
 }
 
+// NewScalableVector returns a new scalable vector type based on the given
+// minimum vector length and element type.
+func NewScalableVector(len uint64, elemType Type) *VectorType {
+	return &VectorType{
+		Scalable: true,
+		Len:      len,
+		ElemType: elemType,
+	}
+}
+
 // Equal reports whether t and u are of equal type.
 func (t *VectorType) Equal(u Type) bool {
+	if t == u {
+		return true
+	}
 	if u, ok := u.(*VectorType); ok {
 		if t.Scalable != u.Scalable {
 			return false
@@ -705,6 +776,9 @@ func NewArray(len uint64, elemType Type) *ArrayType {
 
 // Equal reports whether t and u are of equal type.
 func (t *ArrayType) Equal(u Type) bool {
+	if t == u {
+		return true
+	}
 	if u, ok := u.(*ArrayType); ok {
 		if t.Len != u.Len {
 			return false
@@ -763,6 +837,9 @@ func NewStruct(fields ...Type) *StructType {
 
 // Equal reports whether t and u are of equal type.
 func (t *StructType) Equal(u Type) bool {
+	if t == u {
+		return true
+	}
 	if u, ok := u.(*StructType); ok {
 		if len(t.TypeName) > 0 || len(u.TypeName) > 0 {
 			// Identified struct types are uniqued by type names, not by structural
@@ -293,6 +293,9 @@ func TestEqual(t *testing.T) {
 		{t: NewVector(5, I8), u: &VectorType{Len: 5, ElemType: I8}, want: true},
 		{t: NewVector(5, I8), u: NewVector(3, I8), want: false},
 		{t: NewVector(5, I8), u: I8, want: false},
+		{t: NewScalableVector(4, I32), u: &VectorType{Scalable: true, Len: 4, ElemType: I32}, want: true},
+		{t: NewScalableVector(4, I32), u: NewVector(4, I32), want: false},
+		{t: NewScalableVector(4, I32), u: NewScalableVector(2, I32), want: false},
 		{t: Label, u: &LabelType{}, want: true},
 		{t: Label, u: I8, want: false},
 		{t: Token, u: &TokenType{}, want: true},
@@ -395,3 +398,41 @@ var (
 	_ Type = (*ArrayType)(nil)
 	_ Type = (*StructType)(nil)
 )
+
+func TestPointerTypeString(t *testing.T) {
+	golden := []struct {
+		t    *PointerType
+		want string
+	}{
+		{t: I8Ptr, want: "i8*"},
+		{t: &PointerType{ElemType: I32, AddrSpace: 1}, want: "i32 addrspace(1)*"},
+		{t: NewPointer(I8Ptr), want: "i8**"},
+		{t: Ptr, want: "ptr"},
+		{t: NewOpaquePointer(3), want: "ptr addrspace(3)"},
+		{t: NewPointer(NewStruct(Ptr, I32)), want: "{ ptr, i32 }*"},
+	}
+	for _, g := range golden {
+		if got := g.t.String(); got != g.want {
+			t.Errorf("pointer type string mismatch; expected %q, got %q", g.want, got)
+		}
+	}
+}
+
+func TestPointerTypeEqual(t *testing.T) {
+	golden := []struct {
+		t, u *PointerType
+		want bool
+	}{
+		{t: Ptr, u: NewPointer(nil), want: true},
+		{t: Ptr, u: NewOpaquePointer(1), want: false},
+		{t: Ptr, u: I8Ptr, want: false},
+		{t: I8Ptr, u: NewPointer(NewInt(8)), want: true},
+		{t: I8Ptr, u: I32Ptr, want: false},
+		{t: I8Ptr, u: &PointerType{ElemType: I8, AddrSpace: 1}, want: false},
+	}
+	for _, g := range golden {
+		if got := g.t.Equal(g.u); got != g.want {
+			t.Errorf("pointer type equality mismatch of %v and %v; expected %v, got %v", g.t, g.u, g.want, got)
+		}
+	}
+}
@@ -36,6 +36,7 @@ type Value interface {
 //    TODO: add named metadata value?
 //    ir.Instruction        // https://godoc.org/github.com/llir/llvm/ir#Instruction (except store and fence)
 //    *ir.TermInvoke        // https://godoc.org/github.com/llir/llvm/ir#TermInvoke
+//    *ir.TermCallBr        // https://godoc.org/github.com/llir/llvm/ir#TermCallBr
 //    *ir.TermCatchSwitch   // https://godoc.org/github.com/llir/llvm/ir#TermCatchSwitch (token result used by catchpad)
 type Named interface {
 	Value
@@ -0,0 +1,545 @@
+package ir
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/umaumax/llvm/internal/enc"
+	"github.com/umaumax/llvm/internal/natsort"
+	"github.com/umaumax/llvm/ir/enum"
+	"github.com/umaumax/llvm/ir/metadata"
+)
+
+// === [ Writer ] ==============================================================
+
+// WriteOptions specifies options for writing modules in LLVM IR assembly
+// syntax.
+//
+// Note, renumbering of local identifiers, metadata definitions and attribute
+// group definitions updates the IDs of the module being written.
+type WriteOptions struct {
+	// Renumber unnamed local identifiers (function parameters, basic blocks and
+	// instructions) in order of definition, discarding previously assigned IDs.
+	RenumberLocals bool
+	// Renumber metadata definitions in order of first reference, and write
+	// metadata definitions in order of ID.
+	RenumberMetadata bool
+	// Collect metadata nodes reachable from the module into the metadata
+	// definitions of the module before assigning metadata IDs, merging
+	// structurally equal uniqued metadata nodes (see Module.CollectMetadata).
+	CollectMetadata bool
+	// Omit metadata attachments (e.g. !dbg) of global variables, functions and
+	// instructions.
+	StripMetadataAttachments bool
+	// Renumber attribute group definitions in order of first use, and write
+	// attribute group definitions in order of ID.
+	SortAttrGroups bool
+	// Write global variables, aliases, IFuncs and functions in order of name.
+	SortGlobals bool
+	// Omit use-list order directives.
+	DropUseListOrders bool
+	// (optional) Annotation writer used to inject comments into the output of
+	// functions, basic blocks and instructions.
+	Annotator AnnotationWriter
+}
+
+// CanonicalWriteOptions returns options for writing modules in canonical form;
+// i.e. the output is independent of the order in which global variables and
+// functions were added to the module, and the order in which IDs were assigned.
+func CanonicalWriteOptions() *WriteOptions {
+	return &WriteOptions{
+		RenumberLocals:    true,
+		RenumberMetadata:  true,
+		SortAttrGroups:    true,
+		SortGlobals:       true,
+		DropUseListOrders: true,
+	}
+}
+
+// WriteToOptions writes the string representation of the module in LLVM IR
+// assembly syntax to w, based on the given write options. A nil opts is
+// equivalent to the zero value of WriteOptions.
+func (m *Module) WriteToOptions(w io.Writer, opts *WriteOptions) (n int64, err error) {
+	mw := newWriter(w, opts)
+	mw.writeModule(m)
+	return mw.fw.size, mw.fw.err
+}
+
+// writer writes LLVM IR assembly based on write options.
+type writer struct {
+	// Underlying writer.
+	fw *fmtWriter
+	// Write options.
+	opts *WriteOptions
+}
+
+// newWriter returns a new writer which writes to w based on the given write
+// options.
+func newWriter(w io.Writer, opts *WriteOptions) *writer {
+	if opts == nil {
+		opts = &WriteOptions{}
+	}
+	return &writer{fw: &fmtWriter{w: w}, opts: opts}
+}
+
+// writeModule writes the given module.
+func (w *writer) writeModule(m *Module) {
+	fw := w.fw
+	// Order of global variables, aliases, IFuncs and functions.
+	globals, aliases, ifuncs, funcs := m.Globals, m.Aliases, m.IFuncs, m.Funcs
+	if w.opts.SortGlobals {
+		globals = append([]*Global(nil), globals...)
+		sort.SliceStable(globals, func(i, j int) bool {
+			return natsort.Less(globals[i].Name(), globals[j].Name())
+		})
+		aliases = append([]*Alias(nil), aliases...)
+		sort.SliceStable(aliases, func(i, j int) bool {
+			return natsort.Less(aliases[i].Name(), aliases[j].Name())
+		})
+		ifuncs = append([]*IFunc(nil), ifuncs...)
+		sort.SliceStable(ifuncs, func(i, j int) bool {
+			return natsort.Less(ifuncs[i].Name(), ifuncs[j].Name())
+		})
+		funcs = append([]*Func(nil), funcs...)
+		sort.SliceStable(funcs, func(i, j int) bool {
+			return natsort.Less(funcs[i].Name(), funcs[j].Name())
+		})
+	}
+	// Assign attribute group IDs.
+	if w.opts.SortAttrGroups {
+		renumberAttrGroups(m, globals, funcs)
+	}
+	// Assign metadata IDs.
+	if w.opts.CollectMetadata {
+		m.CollectMetadata()
+	}
+	if w.opts.RenumberMetadata {
+		w.renumberMetadata(m, globals, funcs)
+	} else if err := m.AssignMetadataIDs(); err != nil {
+		panic(fmt.Errorf("unable to assign metadata IDs of module; %v", err))
+	}
+	// Source filename.
+	if len(m.SourceFilename) > 0 {
+		// 'source_filename' '=' Name=StringLit
+		fw.Fprintf("source_filename = %s\n", quote(m.SourceFilename))
+	}
+	// Data layout.
+	if len(m.DataLayout) > 0 {
+		// 'target' 'datalayout' '=' DataLayout=StringLit
+		fw.Fprintf("target datalayout = %s\n", quote(m.DataLayout))
+	}
+	// Target triple.
+	if len(m.TargetTriple) > 0 {
+		// 'target' 'triple' '=' TargetTriple=StringLit
+		fw.Fprintf("target triple = %s\n", quote(m.TargetTriple))
+	}
+	// Module-level inline assembly.
+	if len(m.ModuleAsms) > 0 && fw.size > 0 {
+		fw.Fprint("\n")
+	}
+	for _, asm := range m.ModuleAsms {
+		// 'module' 'asm' Asm=StringLit
+		fw.Fprintf("module asm %s\n", quote(asm))
+	}
+	// Type definitions.
+	if len(m.TypeDefs) > 0 && fw.size > 0 {
+		fw.Fprint("\n")
+	}
+	for _, t := range m.TypeDefs {
+		// Alias=LocalIdent '=' 'type' Typ=OpaqueType
+		//
+		// Alias=LocalIdent '=' 'type' Typ=Type
+		fw.Fprintf("%s = type %s\n", t, t.LLString())
+	}
+	// Comdat definitions.
+	if len(m.ComdatDefs) > 0 && fw.size > 0 {
+		fw.Fprint("\n")
+	}
+	for _, def := range m.ComdatDefs {
+		fw.Fprintln(def.LLString())
+	}
+	// Global declarations and definitions.
+	if len(globals) > 0 && fw.size > 0 {
+		fw.Fprint("\n")
+	}
+	for _, g := range globals {
+		if w.opts.StripMetadataAttachments && len(g.Metadata) > 0 {
+			// Shallow copy of global variable without metadata attachments.
+			gcopy := *g
+			gcopy.Metadata = nil
+			g = &gcopy
+		}
+		fw.Fprintln(g.LLString())
+	}
+	// Aliases.
+	if len(aliases) > 0 && fw.size > 0 {
+		fw.Fprint("\n")
+	}
+	for _, alias := range aliases {
+		fw.Fprintln(alias.LLString())
+	}
+	// IFuncs.
+	if len(ifuncs) > 0 && fw.size > 0 {
+		fw.Fprint("\n")
+	}
+	for _, ifunc := range ifuncs {
+		fw.Fprintln(ifunc.LLString())
+	}
+	// Function declarations and definitions.
+	if len(funcs) > 0 && fw.size > 0 {
+		fw.Fprint("\n")
+	}
+	for i, f := range funcs {
+		if i != 0 {
+			fw.Fprint("\n")
+		}
+		if w.opts.Annotator != nil {
+			w.writeAnnotLines(func(aw io.Writer) {
+				w.opts.Annotator.FuncAnnot(aw, f)
+			})
+		}
+		w.writeFunc(f)
+		fw.Fprint("\n")
+	}
+	// Attribute group definitions.
+	attrGroupDefs := m.AttrGroupDefs
+	if w.opts.SortAttrGroups {
+		attrGroupDefs = append([]*AttrGroupDef(nil), attrGroupDefs...)
+		sort.SliceStable(attrGroupDefs, func(i, j int) bool {
+			return attrGroupDefs[i].ID < attrGroupDefs[j].ID
+		})
+	}
+	if len(attrGroupDefs) > 0 && fw.size > 0 {
+		fw.Fprint("\n")
+	}
+	for _, a := range attrGroupDefs {
+		fw.Fprintln(a.LLString())
+	}
+	// Named metadata definitions; output in natural sorting order.
+	mdNames := namedMetadataNames(m)
+	if len(m.NamedMetadataDefs) > 0 && fw.size > 0 {
+		fw.Fprint("\n")
+	}
+	for _, mdName := range mdNames {
+		// Name=MetadataName '=' '!' '{' MDNodes=(MetadataNode separator ',')* '}'
+		md := m.NamedMetadataDefs[mdName]
+		fw.Fprintf("%s = %s\n", md.Ident(), md.LLString())
+	}
+	// Metadata definitions.
+	mdDefs := m.MetadataDefs
+	if w.opts.RenumberMetadata {
+		mdDefs = append([]metadata.Definition(nil), mdDefs...)
+		sort.SliceStable(mdDefs, func(i, j int) bool {
+			return mdDefs[i].ID() < mdDefs[j].ID()
+		})
+	}
+	if len(mdDefs) > 0 && fw.size > 0 {
+		fw.Fprint("\n")
+	}
+	for _, md := range mdDefs {
+		// ID=MetadataID '=' Distinctopt MDNode=MDTuple
+		//
+		// ID=MetadataID '=' Distinctopt MDNode=SpecializedMDNode
+		fw.Fprintf("%s = %s\n", md.Ident(), md.LLString())
+	}
+	if w.opts.DropUseListOrders {
+		return
+	}
+	// Use-list orders.
+	if len(m.UseListOrders) > 0 && fw.size > 0 {
+		fw.Fprint("\n")
+	}
+	for _, u := range m.UseListOrders {
+		fw.Fprintln(u)
+	}
+	// Basic block specific use-list orders.
+	if len(m.UseListOrderBBs) > 0 && fw.size > 0 {
+		fw.Fprint("\n")
+	}
+	for _, u := range m.UseListOrderBBs {
+		fw.Fprintln(u)
+	}
+}
+
+// writeFunc writes the given function definition or declaration.
+func (w *writer) writeFunc(f *Func) {
+	// Function declaration.
+	//
+	//    'declare' Metadata=MetadataAttachment* Header=FuncHeader
+	//
+	// Function definition.
+	//
+	//    'define' Header=FuncHeader Metadata=MetadataAttachment* Body=FuncBody
+	fw := w.fw
+	if len(f.Blocks) == 0 {
+		// Function declaration.
+		fw.Fprint("declare")
+		if !w.opts.StripMetadataAttachments {
+			for _, md := range f.Metadata {
+				fw.Fprintf(" %s", md)
+			}
+		}
+		if f.Linkage != enum.LinkageNone {
+			fw.Fprintf(" %s", f.Linkage)
+		}
+		fw.Fprint(headerString(f))
+		return
+	}
+	// Function definition.
+	if w.opts.RenumberLocals {
+		resetIDs(f)
+	}
+	if err := f.AssignIDs(); err != nil {
+		panic(fmt.Errorf("unable to assign IDs of function %q; %v", f.Ident(), err))
+	}
+	fw.Fprint("define")
+	if f.Linkage != enum.LinkageNone {
+		fw.Fprintf(" %s", f.Linkage)
+	}
+	fw.Fprint(headerString(f))
+	if !w.opts.StripMetadataAttachments {
+		for _, md := range f.Metadata {
+			fw.Fprintf(" %s", md)
+		}
+	}
+	// '{' Blocks=Block+ UseListOrders=UseListOrder* '}'
+	fw.Fprint(" {\n")
+	for i, block := range f.Blocks {
+		if i != 0 {
+			fw.Fprint("\n")
+		}
+		w.writeBlock(block)
+		fw.Fprint("\n")
+	}
+	if !w.opts.DropUseListOrders {
+		if len(f.UseListOrders) > 0 {
+			fw.Fprint("\n")
+		}
+		for _, u := range f.UseListOrders {
+			fw.Fprintf("\t%s\n", u)
+		}
+	}
+	fw.Fprint("}")
+}
+
+// writeBlock writes the given basic block.
+func (w *writer) writeBlock(block *Block) {
+	// Name=LabelIdentopt Insts=Instruction* Term=Terminator
+	fw := w.fw
+	a := w.opts.Annotator
+	if block.IsUnnamed() {
+		fw.Fprintf("; <label>:%d", block.LocalID)
+	} else {
+		fw.Fprint(enc.Label(block.LocalName))
+	}
+	if a != nil {
+		fw.Fprint(annotation(func(aw io.Writer) {
+			a.BlockStartAnnot(aw, block)
+		}))
+	}
+	fw.Fprint("\n")
+	for _, inst := range block.Insts {
+		w.writeInst(inst)
+		fw.Fprint("\n")
+	}
+	if block.Term == nil {
+		panic(fmt.Sprintf("missing terminator in basic block %q", block.Name()))
+	}
+	w.writeInst(block.Term)
+	if a != nil {
+		annot := annotation(func(aw io.Writer) {
+			a.BlockEndAnnot(aw, block)
+		})
+		if len(annot) > 0 {
+			fw.Fprintf("\n%s", strings.TrimSuffix(annot, "\n"))
+		}
+	}
+}
+
+// writeInst writes the given instruction or terminator, without trailing
+// newline.
+func (w *writer) writeInst(inst LLStringer) {
+	fw := w.fw
+	a := w.opts.Annotator
+	if a != nil {
+		w.writeAnnotLines(func(aw io.Writer) {
+			a.InstAnnot(aw, inst)
+		})
+	}
+	fw.Fprintf("\t%s", w.instString(inst))
+	if a != nil {
+		fw.Fprint(annotation(func(aw io.Writer) {
+			a.InfoComment(aw, inst)
+		}))
+	}
+}
+
+// writeAnnotLines writes the annotation of the given hook on lines of its own;
+// i.e. a trailing newline is added to non-empty annotations if not present.
+func (w *writer) writeAnnotLines(hook func(aw io.Writer)) {
+	s := annotation(hook)
+	if len(s) == 0 {
+		return
+	}
+	w.fw.Fprint(s)
+	if !strings.HasSuffix(s, "\n") {
+		w.fw.Fprint("\n")
+	}
+}
+
+// instString returns the LLVM syntax representation of the given instruction
+// or terminator.
+func (w *writer) instString(inst LLStringer) string {
+	if w.opts.StripMetadataAttachments {
+		inst = withoutMetadata(inst)
+	}
+	return inst.LLString()
+}
+
+// renumberMetadata assigns IDs to the metadata definitions of the given module
+// in order of first reference; named metadata definitions first, followed by
+// the given global variables and functions in output order.
+func (w *writer) renumberMetadata(m *Module, globals []*Global, funcs []*Func) {
+	defs := make(map[metadata.Definition]bool)
+	for _, md := range m.MetadataDefs {
+		defs[md] = true
+	}
+	id := int64(0)
+	visit := newMDWalker(nil, func(md metadata.Definition) bool {
+		if defs[md] {
+			md.SetID(id)
+			id++
+		}
+		return true
+	}).walk
+	attachments := func(mds []*metadata.Attachment) {
+		if !w.opts.StripMetadataAttachments {
+			visit(reflect.ValueOf(mds))
+		}
+	}
+	for _, mdName := range namedMetadataNames(m) {
+		visit(reflect.ValueOf(m.NamedMetadataDefs[mdName]))
+	}
+	for _, g := range globals {
+		attachments(g.Metadata)
+	}
+	for _, f := range funcs {
+		attachments(f.Metadata)
+		for _, block := range f.Blocks {
+			for _, inst := range block.Insts {
+				for _, op := range inst.Operands() {
+					visit(reflect.ValueOf(op).Elem())
+				}
+				if inst, ok := inst.(MetadataAttacher); ok {
+					attachments(inst.MDAttachments())
+				}
+			}
+			if block.Term != nil {
+				for _, op := range block.Term.Operands() {
+					visit(reflect.ValueOf(op).Elem())
+				}
+				if term, ok := block.Term.(MetadataAttacher); ok {
+					attachments(term.MDAttachments())
+				}
+			}
+		}
+	}
+	// Unreferenced metadata definitions.
+	for _, md := range m.MetadataDefs {
+		visit(reflect.ValueOf(md))
+	}
+}
+
+// renumberAttrGroups assigns IDs to the attribute group definitions of the
+// given module in order of first use by the given global variables and
+// functions (and the call instructions and terminators of the functions),
+// followed by the unused attribute group definitions.
+func renumberAttrGroups(m *Module, globals []*Global, funcs []*Func) {
+	ids := make(map[*AttrGroupDef]int64)
+	use := func(attrs []FuncAttribute) {
+		for _, attr := range attrs {
+			if def, ok := attr.(*AttrGroupDef); ok {
+				if _, ok := ids[def]; !ok {
+					ids[def] = int64(len(ids))
+				}
+			}
+		}
+	}
+	for _, g := range globals {
+		use(g.FuncAttrs)
+	}
+	for _, f := range funcs {
+		use(f.FuncAttrs)
+		for _, block := range f.Blocks {
+			for _, inst := range block.Insts {
+				if inst, ok := inst.(*InstCall); ok {
+					use(inst.FuncAttrs)
+				}
+			}
+			switch term := block.Term.(type) {
+			case *TermInvoke:
+				use(term.FuncAttrs)
+			case *TermCallBr:
+				use(term.FuncAttrs)
+			}
+		}
+	}
+	for _, def := range m.AttrGroupDefs {
+		if _, ok := ids[def]; !ok {
+			ids[def] = int64(len(ids))
+		}
+	}
+	for def, id := range ids {
+		def.ID = id
+	}
+}
+
+// ### [ Helper functions ] ####################################################
+
+// annotation returns the annotation written by the given hook.
+func annotation(hook func(aw io.Writer)) string {
+	buf := &strings.Builder{}
+	hook(buf)
+	return buf.String()
+}
+
+// metadataPkgPath is the import path of the metadata package.
+var metadataPkgPath = reflect.TypeOf(metadata.Tuple{}).PkgPath()
+
+// namedMetadataNames returns the names of the named metadata definitions of the
+// given module, in natural sorting order.
+func namedMetadataNames(m *Module) []string {
+	var mdNames []string
+	for mdName := range m.NamedMetadataDefs {
+		mdNames = append(mdNames, mdName)
+	}
+	natsort.Strings(mdNames)
+	return mdNames
+}
+
+// resetIDs resets the IDs of unnamed local identifiers of the given function,
+// so that IDs are reassigned in order of definition.
+func resetIDs(f *Func) {
+	for _, n := range funcLocals(f) {
+		if n.IsUnnamed() {
+			n.SetID(0)
+		}
+	}
+}
+
+// withoutMetadata returns a shallow copy of the given instruction or terminator
+// without metadata attachments; or the instruction itself if it has no
+// metadata attachments.
+func withoutMetadata(inst LLStringer) LLStringer {
+	if md, ok := inst.(MetadataAttacher); !ok || len(md.MDAttachments()) == 0 {
+		return inst
+	}
+	v := reflect.ValueOf(inst).Elem()
+	c := reflect.New(v.Type())
+	c.Elem().Set(v)
+	c.Elem().FieldByName("Metadata").Set(reflect.Zero(reflect.TypeOf(Metadata(nil))))
+	return c.Interface().(LLStringer)
+}
@@ -0,0 +1,179 @@
+package ir_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/umaumax/llvm/asm"
+	"github.com/umaumax/llvm/ir"
+	"github.com/umaumax/llvm/ir/constant"
+	"github.com/umaumax/llvm/ir/types"
+)
+
+func TestModuleWriteToOptions(t *testing.T) {
+	const input = "" +
+		"@b = global i32 0, !dbg !3\n" +
+		"@a = global i32 1\n" +
+		"\n" +
+		"define void @g() #1 {\n" +
+		"  ret void, !dbg !5\n" +
+		"}\n" +
+		"\n" +
+		"define i32 @f(i32) #0 !dbg !4 {\n" +
+		"  %2 = add i32 %0, 1, !tbaa !1\n" +
+		"  ret i32 %2\n" +
+		"}\n" +
+		"\n" +
+		"attributes #1 = { nounwind }\n" +
+		"attributes #0 = { noinline }\n" +
+		"\n" +
+		"!llvm.ident = !{!2}\n" +
+		"\n" +
+		"!1 = !{!\"int\"}\n" +
+		"!2 = !{!\"clang\"}\n" +
+		"!3 = !{!\"b\"}\n" +
+		"!4 = !{!\"f\"}\n" +
+		"!5 = !{!\"g\", !1}\n"
+	golden := []struct {
+		opts *ir.WriteOptions
+		want string
+	}{
+		{
+			opts: ir.CanonicalWriteOptions(),
+			want: "" +
+				"@a = global i32 1\n" +
+				"@b = global i32 0, !dbg !1\n" +
+				"\n" +
+				"define i32 @f(i32) #0 !dbg !2 {\n" +
+				"; <label>:1\n" +
+				"\t%2 = add i32 %0, 1, !tbaa !3\n" +
+				"\tret i32 %2\n" +
+				"}\n" +
+				"\n" +
+				"define void @g() #1 {\n" +
+				"; <label>:0\n" +
+				"\tret void, !dbg !4\n" +
+				"}\n" +
+				"\n" +
+				"attributes #0 = { noinline }\n" +
+				"attributes #1 = { nounwind }\n" +
+				"\n" +
+				"!llvm.ident = !{!0}\n" +
+				"\n" +
+				"!0 = !{!\"clang\"}\n" +
+				"!1 = !{!\"b\"}\n" +
+				"!2 = !{!\"f\"}\n" +
+				"!3 = !{!\"int\"}\n" +
+				"!4 = !{!\"g\", !3}\n",
+		},
+		{
+			opts: &ir.WriteOptions{StripMetadataAttachments: true, RenumberMetadata: true},
+			want: "" +
+				"@b = global i32 0\n" +
+				"@a = global i32 1\n" +
+				"\n" +
+				"define void @g() #1 {\n" +
+				"; <label>:0\n" +
+				"\tret void\n" +
+				"}\n" +
+				"\n" +
+				"define i32 @f(i32) #0 {\n" +
+				"; <label>:1\n" +
+				"\t%2 = add i32 %0, 1\n" +
+				"\tret i32 %2\n" +
+				"}\n" +
+				"\n" +
+				"attributes #0 = { noinline }\n" +
+				"attributes #1 = { nounwind }\n" +
+				"\n" +
+				"!llvm.ident = !{!0}\n" +
+				"\n" +
+				"!0 = !{!\"clang\"}\n" +
+				"!1 = !{!\"int\"}\n" +
+				"!2 = !{!\"b\"}\n" +
+				"!3 = !{!\"f\"}\n" +
+				"!4 = !{!\"g\", !1}\n",
+		},
+	}
+	for _, g := range golden {
+		m, err := asm.ParseString("<input>", input)
+		if err != nil {
+			t.Fatalf("unable to parse input; %+v", err)
+		}
+		buf := &strings.Builder{}
+		if _, err := m.WriteToOptions(buf, g.opts); err != nil {
+			t.Errorf("unable to write module; %+v", err)
+			continue
+		}
+		got := buf.String()
+		if got != g.want {
+			t.Errorf("output mismatch with options %+v; expected %q, got %q", g.opts, g.want, got)
+		}
+	}
+}
+
+func TestModuleWriteToOptionsSortAttrGroups(t *testing.T) {
+	const input = "" +
+		"declare void @c() #0\n" +
+		"\n" +
+		"define void @b() #2 {\n" +
+		"  call void @c() #1\n" +
+		"  ret void\n" +
+		"}\n" +
+		"\n" +
+		"attributes #0 = { nounwind }\n" +
+		"attributes #1 = { cold }\n" +
+		"attributes #2 = { noinline }\n" +
+		"attributes #3 = { readnone }\n"
+	m, err := asm.ParseString("<input>", input)
+	if err != nil {
+		t.Fatalf("unable to parse input; %+v", err)
+	}
+	buf := &strings.Builder{}
+	if _, err := m.WriteToOptions(buf, ir.CanonicalWriteOptions()); err != nil {
+		t.Fatalf("unable to write module; %+v", err)
+	}
+	const want = "" +
+		"define void @b() #0 {\n" +
+		"; <label>:0\n" +
+		"\tcall void @c() #1\n" +
+		"\tret void\n" +
+		"}\n" +
+		"\n" +
+		"declare void @c() #2\n" +
+		"\n" +
+		"attributes #0 = { noinline }\n" +
+		"attributes #1 = { cold }\n" +
+		"attributes #2 = { nounwind }\n" +
+		"attributes #3 = { readnone }\n"
+	if got := buf.String(); got != want {
+		t.Errorf("output mismatch; expected %q, got %q", want, got)
+	}
+}
+
+func TestModuleWriteToOptionsRenumberLocals(t *testing.T) {
+	m := ir.NewModule()
+	x := ir.NewParam("x", types.I32)
+	f := m.NewFunc("f", types.I32, x)
+	entry := f.NewBlock("")
+	add := entry.NewAdd(x, constant.NewInt(types.I32, 1))
+	entry.NewRet(add)
+	// Assign IDs and insert an unnamed instruction before the numbered one.
+	_ = m.String()
+	mul := ir.NewMul(x, constant.NewInt(types.I32, 2))
+	entry.Insts = append([]ir.Instruction{mul}, entry.Insts...)
+	buf := &strings.Builder{}
+	if _, err := m.WriteToOptions(buf, &ir.WriteOptions{RenumberLocals: true}); err != nil {
+		t.Fatalf("unable to write module; %+v", err)
+	}
+	const want = "" +
+		"define i32 @f(i32 %x) {\n" +
+		"; <label>:0\n" +
+		"\t%1 = mul i32 %x, 2\n" +
+		"\t%2 = add i32 %x, 1\n" +
+		"\tret i32 %2\n" +
+		"}\n"
+	if got := buf.String(); got != want {
+		t.Errorf("output mismatch; expected %q, got %q", want, got)
+	}
+}
@@ -0,0 +1,196 @@
+package stress
+
+import (
+	"fmt"
+
+	"github.com/umaumax/llvm/ir"
+	"github.com/umaumax/llvm/ir/constant"
+	"github.com/umaumax/llvm/ir/enum"
+	"github.com/umaumax/llvm/ir/types"
+)
+
+// constant returns a random constant of the given type, with constant
+// expressions nested at most depth levels deep.
+func (gen *generator) constant(t types.Type, depth int) constant.Constant {
+	if gen.rnd.Intn(16) == 0 {
+		return constant.NewUndef(t)
+	}
+	switch t := t.(type) {
+	case *types.IntType:
+		return gen.intConst(t, depth)
+	case *types.FloatType:
+		return gen.floatConst(t, depth)
+	case *types.PointerType:
+		return gen.pointerConst(t, depth)
+	case *types.VectorType:
+		if gen.rnd.Intn(4) == 0 {
+			return constant.NewZeroInitializer(t)
+		}
+		elems := make([]constant.Constant, t.Len)
+		for i := range elems {
+			elems[i] = gen.constant(t.ElemType, depth-1)
+		}
+		return constant.NewVector(t, elems...)
+	case *types.ArrayType:
+		if gen.rnd.Intn(4) == 0 {
+			return constant.NewZeroInitializer(t)
+		}
+		elems := make([]constant.Constant, t.Len)
+		for i := range elems {
+			elems[i] = gen.constant(t.ElemType, depth-1)
+		}
+		return constant.NewArray(t, elems...)
+	case *types.StructType:
+		if gen.rnd.Intn(4) == 0 {
+			return constant.NewZeroInitializer(t)
+		}
+		fields := make([]constant.Constant, len(t.Fields))
+		for i, field := range t.Fields {
+			fields[i] = gen.constant(field, depth-1)
+		}
+		return constant.NewStruct(t, fields...)
+	default:
+		panic(fmt.Errorf("support for constant of type %T not yet implemented", t))
+	}
+}
+
+// intConst returns a random integer constant of the given type.
+func (gen *generator) intConst(t *types.IntType, depth int) constant.Constant {
+	if depth > 0 && gen.rnd.Intn(4) == 0 {
+		x := gen.intConst(t, depth-1)
+		y := gen.intConst(t, depth-1)
+		switch gen.rnd.Intn(7) {
+		case 0:
+			return constant.NewAdd(x, y)
+		case 1:
+			return constant.NewSub(x, y)
+		case 2:
+			return constant.NewMul(x, y)
+		case 3:
+			return constant.NewAnd(x, y)
+		case 4:
+			return constant.NewOr(x, y)
+		case 5:
+			return constant.NewXor(x, y)
+		default:
+			if g := gen.pickGlobal(); g != nil && t.BitSize == 64 {
+				return constant.NewPtrToInt(g, t)
+			}
+			return constant.NewAdd(x, y)
+		}
+	}
+	return gen.intLit(t)
+}
+
+// intLit returns a random integer literal of the given type.
+func (gen *generator) intLit(t *types.IntType) *constant.Int {
+	if t.BitSize == 1 {
+		return constant.NewBool(gen.rnd.Intn(2) == 0)
+	}
+	bits := t.BitSize
+	if bits > 16 {
+		bits = 16
+	}
+	n := int64(1) << (bits - 1)
+	return constant.NewInt(t, gen.rnd.Int63n(2*n)-n)
+}
+
+// floatConst returns a random floating-point constant of the given type.
+func (gen *generator) floatConst(t *types.FloatType, depth int) constant.Constant {
+	if depth > 0 && gen.rnd.Intn(4) == 0 {
+		x := gen.floatConst(t, depth-1)
+		y := gen.floatConst(t, depth-1)
+		switch gen.rnd.Intn(3) {
+		case 0:
+			return constant.NewFAdd(x, y)
+		case 1:
+			return constant.NewFSub(x, y)
+		default:
+			return constant.NewFMul(x, y)
+		}
+	}
+	// Use floating-point values which are exactly representable in all
+	// floating-point types.
+	x := float64(gen.rnd.Intn(64)-32) / 4
+	return constant.NewFloat(t, x)
+}
+
+// pointerConst returns a random pointer constant of the given type.
+func (gen *generator) pointerConst(t *types.PointerType, depth int) constant.Constant {
+	if depth <= 0 || gen.rnd.Intn(3) == 0 {
+		return constant.NewNull(t)
+	}
+	// Global variable or function of the same type.
+	var candidates []constant.Constant
+	for _, g := range gen.globals {
+		if g.Type().Equal(t) {
+			candidates = append(candidates, g)
+		}
+	}
+	for _, f := range gen.funcs {
+		if f.Type().Equal(t) {
+			candidates = append(candidates, f)
+		}
+	}
+	if len(candidates) > 0 && gen.rnd.Intn(2) == 0 {
+		return candidates[gen.rnd.Intn(len(candidates))]
+	}
+	g := gen.pickGlobal()
+	if g == nil {
+		return constant.NewNull(t)
+	}
+	// Address of element of global variable.
+	if g, ok := g.(*ir.Global); ok && gen.rnd.Intn(2) == 0 {
+		indices, elemType := gen.constIndices(g.ContentType)
+		c := constant.Constant(constant.NewGetElementPtr(g.ContentType, g, indices...))
+		if !types.NewPointer(elemType).Equal(t) {
+			c = constant.NewBitCast(c, t)
+		}
+		return c
+	}
+	return constant.NewBitCast(g, t)
+}
+
+// constIndices returns random constant getelementptr indices into the given
+// element type, and the type of the indexed element.
+func (gen *generator) constIndices(elemType types.Type) ([]constant.Constant, types.Type) {
+	indices := []constant.Constant{constant.NewInt(types.I64, 0)}
+	for gen.rnd.Intn(3) != 0 {
+		switch t := elemType.(type) {
+		case *types.ArrayType:
+			indices = append(indices, constant.NewInt(types.I64, gen.rnd.Int63n(int64(t.Len))))
+			elemType = t.ElemType
+		case *types.StructType:
+			i := gen.rnd.Intn(len(t.Fields))
+			indices = append(indices, constant.NewInt(types.I32, int64(i)))
+			elemType = t.Fields[i]
+		default:
+			return indices, elemType
+		}
+	}
+	return indices, elemType
+}
+
+// pickGlobal returns a random global variable or function; or nil if the
+// module has neither.
+func (gen *generator) pickGlobal() constant.Constant {
+	n := len(gen.globals) + len(gen.funcs)
+	if n == 0 {
+		return nil
+	}
+	i := gen.rnd.Intn(n)
+	if i < len(gen.globals) {
+		return gen.globals[i]
+	}
+	return gen.funcs[i-len(gen.globals)]
+}
+
+// randIPred returns a random integer comparison predicate.
+func (gen *generator) randIPred() enum.IPred {
+	return enum.IPred(gen.rnd.Intn(int(enum.IPredULT) + 1))
+}
+
+// randFPred returns a random floating-point comparison predicate.
+func (gen *generator) randFPred() enum.FPred {
+	return enum.FPred(gen.rnd.Intn(int(enum.FPredUNO) + 1))
+}
@@ -0,0 +1,99 @@
+package stress
+
+// cfg is a control flow graph of basic blocks identified by index, with the
+// entry basic block at index 0.
+type cfg struct {
+	// Successors of each basic block.
+	succs [][]int
+	// Predecessors of each basic block.
+	preds [][]int
+	// Basic blocks in reverse postorder.
+	rpo []int
+	// Immediate dominator of each basic block; the entry basic block is its own
+	// immediate dominator.
+	idom []int
+}
+
+// newCFG returns a new control flow graph based on the given successors of
+// each basic block. Every basic block must be reachable from the entry basic
+// block.
+func newCFG(succs [][]int) *cfg {
+	c := &cfg{
+		succs: succs,
+		preds: make([][]int, len(succs)),
+	}
+	for i, ss := range succs {
+		for _, s := range ss {
+			c.preds[s] = append(c.preds[s], i)
+		}
+	}
+	c.computeRPO()
+	c.computeDom()
+	return c
+}
+
+// computeRPO computes the reverse postorder of the basic blocks.
+func (c *cfg) computeRPO() {
+	visited := make([]bool, len(c.succs))
+	var post []int
+	var visit func(i int)
+	visit = func(i int) {
+		visited[i] = true
+		for _, s := range c.succs[i] {
+			if !visited[s] {
+				visit(s)
+			}
+		}
+		post = append(post, i)
+	}
+	visit(0)
+	for i := len(post) - 1; i >= 0; i-- {
+		c.rpo = append(c.rpo, post[i])
+	}
+}
+
+// computeDom computes the immediate dominators of the basic blocks.
+//
+// ref: Cooper, Harvey and Kennedy, A Simple, Fast Dominance Algorithm
+func (c *cfg) computeDom() {
+	order := make([]int, len(c.succs))
+	for i, b := range c.rpo {
+		order[b] = i
+	}
+	c.idom = make([]int, len(c.succs))
+	for i := range c.idom {
+		c.idom[i] = -1
+	}
+	c.idom[0] = 0
+	intersect := func(a, b int) int {
+		for a != b {
+			for order[a] > order[b] {
+				a = c.idom[a]
+			}
+			for order[b] > order[a] {
+				b = c.idom[b]
+			}
+		}
+		return a
+	}
+	for changed := true; changed; {
+		changed = false
+		for _, b := range c.rpo[1:] {
+			newIdom := -1
+			for _, p := range c.preds[b] {
+				if c.idom[p] == -1 {
+					continue
+				}
+				if newIdom == -1 {
+					newIdom = p
+				} else {
+					newIdom = intersect(p, newIdom)
+				}
+			}
+			if c.idom[b] != newIdom {
+				c.idom[b] = newIdom
+				changed = true
+			}
+		}
+	}
+}
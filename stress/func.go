@@ -0,0 +1,478 @@
+package stress
+
+import (
+	"github.com/umaumax/llvm/ir"
+	"github.com/umaumax/llvm/ir/constant"
+	"github.com/umaumax/llvm/ir/types"
+	"github.com/umaumax/llvm/ir/value"
+)
+
+// funcGen is a generator of function bodies.
+type funcGen struct {
+	*generator
+	// Function being generated.
+	f *ir.Func
+	// Control flow graph of the function.
+	graph *cfg
+	// Basic blocks of the function.
+	blocks []*ir.Block
+	// Values defined in each basic block, in order of definition.
+	defs [][]value.Value
+	// Phi instructions of each basic block.
+	phis [][]*ir.InstPhi
+	// Index of the basic block being generated.
+	cur int
+}
+
+// funcBody generates a random function body for the given function.
+//
+// The control flow graph is generated first; a spanning tree rooted at the
+// entry basic block (ensuring reachability) with random extra edges (forward
+// and backward). Basic blocks are then populated in reverse postorder, so that
+// the definitions of all dominating basic blocks are known when a basic block
+// is populated. Incoming values of phi instructions are assigned last, once
+// the values available at the end of each predecessor are known.
+func (gen *generator) funcBody(f *ir.Func) {
+	n := 1 + gen.rnd.Intn(gen.cfg.MaxBlocks)
+	succs := make([][]int, n)
+	addEdge := func(from, to int) {
+		for _, s := range succs[from] {
+			if s == to {
+				return
+			}
+		}
+		succs[from] = append(succs[from], to)
+	}
+	for i := 1; i < n; i++ {
+		addEdge(gen.rnd.Intn(i), i)
+	}
+	if n > 1 {
+		for i, nextra := 0, gen.rnd.Intn(n); i < nextra; i++ {
+			// The entry basic block may not have predecessors.
+			addEdge(gen.rnd.Intn(n), 1+gen.rnd.Intn(n-1))
+		}
+	}
+	fg := &funcGen{
+		generator: gen,
+		f:         f,
+		graph:     newCFG(succs),
+		defs:      make([][]value.Value, n),
+		phis:      make([][]*ir.InstPhi, n),
+	}
+	for i := 0; i < n; i++ {
+		fg.blocks = append(fg.blocks, f.NewBlock(""))
+	}
+	for _, b := range fg.graph.rpo {
+		fg.cur = b
+		fg.block(b)
+	}
+	// Assign incoming values of phi instructions.
+	for b, phis := range fg.phis {
+		for _, phi := range phis {
+			for _, p := range fg.graph.preds[b] {
+				x := gen.pick(fg.available(p, phi.Typ))
+				if x == nil || gen.rnd.Intn(4) == 0 {
+					x = gen.constant(phi.Typ, 1)
+				}
+				phi.Incs = append(phi.Incs, ir.NewIncoming(x, fg.blocks[p]))
+			}
+		}
+	}
+}
+
+// block populates the given basic block with random instructions and a
+// terminator.
+func (fg *funcGen) block(b int) {
+	block := fg.blocks[b]
+	if len(fg.graph.preds[b]) > 0 {
+		for i, n := 0, fg.rnd.Intn(fg.cfg.MaxPhis+1); i < n; i++ {
+			phi := &ir.InstPhi{Typ: fg.typ(fg.cfg.MaxTypeDepth)}
+			block.Insts = append(block.Insts, phi)
+			fg.phis[b] = append(fg.phis[b], phi)
+			fg.define(phi)
+		}
+	}
+	for i, n := 0, fg.rnd.Intn(fg.cfg.MaxInsts+1); i < n; i++ {
+		if v := fg.inst(block); v != nil && !types.IsVoid(v.Type()) {
+			fg.define(v)
+		}
+	}
+	fg.term(b)
+}
+
+// define records the definition of the given value in the current basic
+// block.
+func (fg *funcGen) define(v value.Value) {
+	fg.defs[fg.cur] = append(fg.defs[fg.cur], v)
+}
+
+// available returns the values of the given type available at the end of the
+// given basic block; or all available values if t is nil.
+func (fg *funcGen) available(b int, t types.Type) []value.Value {
+	var vs []value.Value
+	add := func(v value.Value) {
+		if t == nil || v.Type().Equal(t) {
+			vs = append(vs, v)
+		}
+	}
+	for _, param := range fg.f.Params {
+		add(param)
+	}
+	for _, g := range fg.globals {
+		add(g)
+	}
+	for _, f := range fg.funcs {
+		add(f)
+	}
+	for {
+		for _, v := range fg.defs[b] {
+			add(v)
+		}
+		if b == 0 {
+			break
+		}
+		b = fg.graph.idom[b]
+	}
+	return vs
+}
+
+// operand returns a random value of the given type available in the current
+// basic block.
+func (fg *funcGen) operand(t types.Type) value.Value {
+	if fg.rnd.Intn(4) != 0 {
+		if v := fg.pick(fg.available(fg.cur, t)); v != nil {
+			return v
+		}
+	}
+	return fg.constant(t, 1)
+}
+
+// pickValue returns a random value available in the current basic block which
+// satisfies the given predicate; or nil if no such value is available.
+func (fg *funcGen) pickValue(pred func(t types.Type) bool) value.Value {
+	var vs []value.Value
+	for _, v := range fg.available(fg.cur, nil) {
+		if pred(v.Type()) {
+			vs = append(vs, v)
+		}
+	}
+	return fg.pick(vs)
+}
+
+// pickType returns the type of a random value available in the current basic
+// block which satisfies the given predicate; or a type returned by fallback if
+// no such value is available.
+func (fg *funcGen) pickType(pred func(t types.Type) bool, fallback func() types.Type) types.Type {
+	if fg.rnd.Intn(4) != 0 {
+		if v := fg.pickValue(pred); v != nil {
+			return v.Type()
+		}
+	}
+	return fallback()
+}
+
+// inst appends a random instruction to the given basic block, and returns the
+// value it produces; or nil if no instruction was appended.
+func (fg *funcGen) inst(block *ir.Block) value.Value {
+	switch fg.rnd.Intn(13) {
+	case 0:
+		// Integer binary and bitwise instructions.
+		t := fg.pickType(isIntOrIntVector, func() types.Type { return fg.intType() })
+		x, y := fg.operand(t), fg.operand(t)
+		switch fg.rnd.Intn(13) {
+		case 0:
+			return block.NewAdd(x, y)
+		case 1:
+			return block.NewSub(x, y)
+		case 2:
+			return block.NewMul(x, y)
+		case 3:
+			return block.NewUDiv(x, y)
+		case 4:
+			return block.NewSDiv(x, y)
+		case 5:
+			return block.NewURem(x, y)
+		case 6:
+			return block.NewSRem(x, y)
+		case 7:
+			return block.NewShl(x, y)
+		case 8:
+			return block.NewLShr(x, y)
+		case 9:
+			return block.NewAShr(x, y)
+		case 10:
+			return block.NewAnd(x, y)
+		case 11:
+			return block.NewOr(x, y)
+		default:
+			return block.NewXor(x, y)
+		}
+	case 1:
+		// Floating-point unary and binary instructions.
+		t := fg.pickType(isFloatOrFloatVector, func() types.Type { return fg.floatType() })
+		x, y := fg.operand(t), fg.operand(t)
+		switch fg.rnd.Intn(6) {
+		case 0:
+			return block.NewFAdd(x, y)
+		case 1:
+			return block.NewFSub(x, y)
+		case 2:
+			return block.NewFMul(x, y)
+		case 3:
+			return block.NewFDiv(x, y)
+		case 4:
+			return block.NewFRem(x, y)
+		default:
+			return block.NewFNeg(x)
+		}
+	case 2:
+		// Comparison instructions.
+		if fg.rnd.Intn(2) == 0 {
+			t := fg.pickType(isFloatOrFloatVector, func() types.Type { return fg.floatType() })
+			return block.NewFCmp(fg.randFPred(), fg.operand(t), fg.operand(t))
+		}
+		t := fg.pickType(func(t types.Type) bool {
+			return isIntOrIntVector(t) || types.IsPointer(t)
+		}, func() types.Type { return fg.intType() })
+		return block.NewICmp(fg.randIPred(), fg.operand(t), fg.operand(t))
+	case 3:
+		// Select instruction.
+		t := fg.pickType(isSized, func() types.Type { return fg.typ(fg.cfg.MaxTypeDepth) })
+		return block.NewSelect(fg.operand(types.I1), fg.operand(t), fg.operand(t))
+	case 4:
+		return fg.cast(block)
+	case 5:
+		// Alloca instruction.
+		return block.NewAlloca(fg.typ(fg.cfg.MaxTypeDepth))
+	case 6:
+		// Load instruction.
+		src := fg.pickValue(isSizedPointer)
+		if src == nil {
+			return nil
+		}
+		return block.NewLoad(src.Type().(*types.PointerType).ElemType, src)
+	case 7:
+		// Store instruction.
+		dst := fg.pickValue(isSizedPointer)
+		if dst == nil {
+			return nil
+		}
+		elemType := dst.Type().(*types.PointerType).ElemType
+		block.NewStore(fg.operand(elemType), dst)
+		return nil
+	case 8:
+		return fg.gep(block)
+	case 9:
+		// Call instruction.
+		callee := fg.funcs[fg.rnd.Intn(len(fg.funcs))]
+		var args []value.Value
+		for _, param := range callee.Params {
+			args = append(args, fg.operand(param.Typ))
+		}
+		return block.NewCall(callee, args...)
+	case 10:
+		// Vector instructions.
+		x := fg.pickValue(types.IsVector)
+		if x == nil {
+			return nil
+		}
+		t := x.Type().(*types.VectorType)
+		index := constant.NewInt(types.I32, fg.rnd.Int63n(int64(t.Len)))
+		switch fg.rnd.Intn(3) {
+		case 0:
+			return block.NewExtractElement(x, index)
+		case 1:
+			return block.NewInsertElement(x, fg.operand(t.ElemType), index)
+		default:
+			var mask []constant.Constant
+			for i, n := 0, 1+fg.rnd.Intn(4); i < n; i++ {
+				mask = append(mask, constant.NewInt(types.I32, fg.rnd.Int63n(int64(2*t.Len))))
+			}
+			maskType := types.NewVector(uint64(len(mask)), types.I32)
+			return block.NewShuffleVector(x, fg.operand(t), constant.NewVector(maskType, mask...))
+		}
+	case 11:
+		// Aggregate instructions.
+		x := fg.pickValue(isAggregate)
+		if x == nil {
+			return nil
+		}
+		indices, elemType := fg.aggregateIndices(x.Type())
+		if fg.rnd.Intn(2) == 0 {
+			return block.NewExtractValue(x, indices...)
+		}
+		return block.NewInsertValue(x, fg.operand(elemType), indices...)
+	default:
+		// Extra integer arithmetic, to increase the number of available integer
+		// values.
+		t := fg.intType()
+		return block.NewAdd(fg.operand(t), fg.operand(t))
+	}
+}
+
+// cast appends a random conversion instruction to the given basic block, and
+// returns the value it produces; or nil if no instruction was appended.
+func (fg *funcGen) cast(block *ir.Block) value.Value {
+	from := fg.pickValue(func(t types.Type) bool {
+		return isIntOrIntVector(t) || isFloatOrFloatVector(t) || types.IsPointer(t)
+	})
+	if from == nil {
+		return nil
+	}
+	fromType := from.Type()
+	switch elem := scalar(fromType).(type) {
+	case *types.IntType:
+		switch fg.rnd.Intn(3) {
+		case 0:
+			to := fg.intType()
+			toType := sameShape(fromType, to)
+			switch {
+			case to.BitSize < elem.BitSize:
+				return block.NewTrunc(from, toType)
+			case to.BitSize > elem.BitSize && fg.rnd.Intn(2) == 0:
+				return block.NewZExt(from, toType)
+			case to.BitSize > elem.BitSize:
+				return block.NewSExt(from, toType)
+			}
+			return nil
+		case 1:
+			toType := sameShape(fromType, fg.floatType())
+			if fg.rnd.Intn(2) == 0 {
+				return block.NewUIToFP(from, toType)
+			}
+			return block.NewSIToFP(from, toType)
+		default:
+			if types.IsVector(fromType) {
+				return nil
+			}
+			return block.NewIntToPtr(from, types.NewPointer(fg.typ(fg.cfg.MaxTypeDepth-1)))
+		}
+	case *types.FloatType:
+		switch fg.rnd.Intn(2) {
+		case 0:
+			switch elem.Kind {
+			case types.FloatKindFloat:
+				return block.NewFPExt(from, sameShape(fromType, types.Double))
+			case types.FloatKindDouble:
+				return block.NewFPTrunc(from, sameShape(fromType, types.Float))
+			}
+			return nil
+		default:
+			toType := sameShape(fromType, fg.intType())
+			if fg.rnd.Intn(2) == 0 {
+				return block.NewFPToUI(from, toType)
+			}
+			return block.NewFPToSI(from, toType)
+		}
+	case *types.PointerType:
+		if fg.rnd.Intn(2) == 0 {
+			return block.NewPtrToInt(from, fg.intType())
+		}
+		return block.NewBitCast(from, types.NewPointer(fg.typ(fg.cfg.MaxTypeDepth-1)))
+	}
+	return nil
+}
+
+// gep appends a random getelementptr instruction to the given basic block, and
+// returns the value it produces; or nil if no instruction was appended.
+func (fg *funcGen) gep(block *ir.Block) value.Value {
+	src := fg.pickValue(isSizedPointer)
+	if src == nil {
+		return nil
+	}
+	srcElemType := src.Type().(*types.PointerType).ElemType
+	elemType := srcElemType
+	indices := []value.Value{fg.operand(types.I64)}
+	for fg.rnd.Intn(3) != 0 {
+		switch t := elemType.(type) {
+		case *types.ArrayType:
+			indices = append(indices, fg.operand(types.I64))
+			elemType = t.ElemType
+			continue
+		case *types.StructType:
+			i := fg.rnd.Intn(len(t.Fields))
+			indices = append(indices, constant.NewInt(types.I32, int64(i)))
+			elemType = t.Fields[i]
+			continue
+		}
+		break
+	}
+	inst := block.NewGetElementPtr(srcElemType, src, indices...)
+	inst.InBounds = fg.rnd.Intn(2) == 0
+	return inst
+}
+
+// aggregateIndices returns random extractvalue and insertvalue indices into
+// the given aggregate type, and the type of the indexed element.
+func (fg *funcGen) aggregateIndices(t types.Type) ([]uint64, types.Type) {
+	var indices []uint64
+	for {
+		switch tt := t.(type) {
+		case *types.ArrayType:
+			i := uint64(fg.rnd.Int63n(int64(tt.Len)))
+			indices = append(indices, i)
+			t = tt.ElemType
+		case *types.StructType:
+			i := fg.rnd.Intn(len(tt.Fields))
+			indices = append(indices, uint64(i))
+			t = tt.Fields[i]
+		default:
+			return indices, t
+		}
+		if !isAggregate(t) || fg.rnd.Intn(2) == 0 {
+			return indices, t
+		}
+	}
+}
+
+// term sets a random terminator of the given basic block, based on its
+// successors.
+func (fg *funcGen) term(b int) {
+	block := fg.blocks[b]
+	succs := fg.graph.succs[b]
+	switch len(succs) {
+	case 0:
+		retType := fg.f.Sig.RetType
+		if types.IsVoid(retType) {
+			block.NewRet(nil)
+			return
+		}
+		block.NewRet(fg.operand(retType))
+	case 1:
+		block.NewBr(fg.blocks[succs[0]])
+	case 2:
+		block.NewCondBr(fg.operand(types.I1), fg.blocks[succs[0]], fg.blocks[succs[1]])
+	default:
+		t := fg.intType()
+		for t.BitSize < 8 {
+			t = fg.intType()
+		}
+		var cases []*ir.Case
+		seen := make(map[int64]bool)
+		for _, s := range succs[1:] {
+			x := fg.intLit(t)
+			for seen[x.X.Int64()] {
+				x = fg.intLit(t)
+			}
+			seen[x.X.Int64()] = true
+			cases = append(cases, ir.NewCase(x, fg.blocks[s]))
+		}
+		block.NewSwitch(fg.operand(t), fg.blocks[succs[0]], cases...)
+	}
+}
+
+// isSizedPointer reports whether the given type is a pointer to a sized type.
+func isSizedPointer(t types.Type) bool {
+	p, ok := t.(*types.PointerType)
+	return ok && isSized(p.ElemType)
+}
+
+// isAggregate reports whether the given type is an aggregate type (array or
+// structure type).
+func isAggregate(t types.Type) bool {
+	switch t.(type) {
+	case *types.ArrayType, *types.StructType:
+		return true
+	}
+	return false
+}
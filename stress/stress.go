@@ -0,0 +1,162 @@
+// Package stress implements a generator of random well-typed LLVM IR modules.
+//
+// The generated modules are valid LLVM IR; every value is of the expected type
+// and every use of an SSA value is dominated by its definition. The generator
+// is deterministic, the same seed and configuration always produce the same
+// module, which makes it suitable for fuzzing the round-trip of the asm package
+// (print -> parse -> print) and for stress testing back-ends.
+//
+// Example usage:
+//
+//    m := stress.Generate(seed, stress.DefaultConfig)
+//    fmt.Println(m)
+package stress
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/umaumax/llvm/ir"
+	"github.com/umaumax/llvm/ir/enum"
+	"github.com/umaumax/llvm/ir/types"
+	"github.com/umaumax/llvm/ir/value"
+)
+
+// Config specifies the shape of generated modules.
+type Config struct {
+	// Maximum number of type definitions.
+	MaxTypeDefs int
+	// Maximum number of global variables.
+	MaxGlobals int
+	// Maximum number of functions.
+	MaxFuncs int
+	// Maximum number of function parameters.
+	MaxParams int
+	// Maximum number of basic blocks per function.
+	MaxBlocks int
+	// Maximum number of phi instructions per basic block.
+	MaxPhis int
+	// Maximum number of non-phi instructions per basic block.
+	MaxInsts int
+	// Maximum nesting depth of derived types (pointer, vector, array and
+	// structure types).
+	MaxTypeDepth int
+	// Maximum nesting depth of constant expressions.
+	MaxExprDepth int
+}
+
+// DefaultConfig is the default configuration of the generator.
+var DefaultConfig = Config{
+	MaxTypeDefs:  3,
+	MaxGlobals:   8,
+	MaxFuncs:     6,
+	MaxParams:    4,
+	MaxBlocks:    8,
+	MaxPhis:      3,
+	MaxInsts:     12,
+	MaxTypeDepth: 2,
+	MaxExprDepth: 2,
+}
+
+// Generate returns a new random LLVM IR module based on the given seed and
+// configuration.
+func Generate(seed int64, cfg Config) *ir.Module {
+	gen := newGenerator(seed, cfg)
+	return gen.module()
+}
+
+// generator is a random LLVM IR module generator.
+type generator struct {
+	// Configuration of the generator.
+	cfg Config
+	// Pseudo-random number source.
+	rnd *rand.Rand
+	// Module being generated.
+	m *ir.Module
+	// Global variables of the module.
+	globals []*ir.Global
+	// Functions of the module.
+	funcs []*ir.Func
+}
+
+// newGenerator returns a new generator based on the given seed and
+// configuration.
+func newGenerator(seed int64, cfg Config) *generator {
+	return &generator{
+		cfg: cfg,
+		rnd: rand.New(rand.NewSource(seed)),
+		m:   ir.NewModule(),
+	}
+}
+
+// module generates a new random module.
+func (gen *generator) module() *ir.Module {
+	// Type definitions.
+	for i, n := 0, gen.rnd.Intn(gen.cfg.MaxTypeDefs+1); i < n; i++ {
+		var fields []types.Type
+		for j, nfields := 0, 1+gen.rnd.Intn(4); j < nfields; j++ {
+			fields = append(fields, gen.typ(gen.cfg.MaxTypeDepth-1))
+		}
+		name := fmt.Sprintf("T%d", i)
+		gen.m.NewTypeDef(name, types.NewStruct(fields...))
+	}
+	// Global variable declarations. Global variables are created before their
+	// initializers, so that initializers may refer to any global variable.
+	for i, n := 0, gen.rnd.Intn(gen.cfg.MaxGlobals+1); i < n; i++ {
+		name := fmt.Sprintf("g%d", i)
+		g := gen.m.NewGlobal(name, gen.typ(gen.cfg.MaxTypeDepth))
+		gen.globals = append(gen.globals, g)
+	}
+	// Function declarations. Function signatures are created before function
+	// bodies, so that calls may refer to any function.
+	for i, n := 0, 1+gen.rnd.Intn(gen.cfg.MaxFuncs); i < n; i++ {
+		var retType types.Type = types.Void
+		if gen.rnd.Intn(4) != 0 {
+			retType = gen.typ(gen.cfg.MaxTypeDepth)
+		}
+		var params []*ir.Param
+		for j, nparams := 0, gen.rnd.Intn(gen.cfg.MaxParams+1); j < nparams; j++ {
+			params = append(params, ir.NewParam("", gen.typ(gen.cfg.MaxTypeDepth)))
+		}
+		name := fmt.Sprintf("f%d", i)
+		f := gen.m.NewFunc(name, retType, params...)
+		gen.funcs = append(gen.funcs, f)
+	}
+	// Global variable definitions.
+	for _, g := range gen.globals {
+		switch gen.rnd.Intn(8) {
+		case 0:
+			// external global variable declaration.
+			g.Linkage = enum.LinkageExternal
+			continue
+		case 1:
+			g.Linkage = enum.LinkageInternal
+		case 2:
+			g.Linkage = enum.LinkagePrivate
+		}
+		g.Immutable = gen.rnd.Intn(4) == 0
+		g.Init = gen.constant(g.ContentType, gen.cfg.MaxExprDepth)
+	}
+	// Function definitions.
+	for _, f := range gen.funcs {
+		if gen.rnd.Intn(6) == 0 {
+			// external function declaration.
+			continue
+		}
+		if gen.rnd.Intn(4) == 0 {
+			f.Linkage = enum.LinkageInternal
+		}
+		gen.funcBody(f)
+	}
+	return gen.m
+}
+
+// ### [ Helper functions ] ####################################################
+
+// pick returns a random value from the given list of values; or nil if empty.
+func (gen *generator) pick(vs []value.Value) value.Value {
+	if len(vs) == 0 {
+		return nil
+	}
+	return vs[gen.rnd.Intn(len(vs))]
+}
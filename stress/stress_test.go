@@ -0,0 +1,37 @@
+package stress
+
+import (
+	"testing"
+
+	"github.com/umaumax/llvm/asm"
+)
+
+func TestRoundTrip(t *testing.T) {
+	n := int64(500)
+	if testing.Short() {
+		n = 50
+	}
+	for seed := int64(0); seed < n; seed++ {
+		m := Generate(seed, DefaultConfig)
+		want := m.String()
+		m2, err := asm.ParseString("", want)
+		if err != nil {
+			t.Errorf("seed %d: unable to parse generated module; %+v\n%s", seed, err, want)
+			continue
+		}
+		got := m2.String()
+		if want != got {
+			t.Errorf("seed %d: module mismatch after round-trip; expected `%s`, got `%s`", seed, want, got)
+		}
+	}
+}
+
+func TestGenerateDeterministic(t *testing.T) {
+	for seed := int64(0); seed < 10; seed++ {
+		want := Generate(seed, DefaultConfig).String()
+		got := Generate(seed, DefaultConfig).String()
+		if want != got {
+			t.Errorf("seed %d: module mismatch; expected `%s`, got `%s`", seed, want, got)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package stress
+
+import (
+	"github.com/umaumax/llvm/ir/types"
+)
+
+// intTypes is the set of integer types used by the generator.
+var intTypes = []*types.IntType{
+	types.I1,
+	types.I8,
+	types.I16,
+	types.I32,
+	types.I64,
+	types.NewInt(7),
+	types.NewInt(33),
+}
+
+// floatTypes is the set of floating-point types used by the generator.
+var floatTypes = []*types.FloatType{
+	types.Float,
+	types.Double,
+}
+
+// typ returns a random first-class type, with derived types nested at most
+// depth levels deep.
+func (gen *generator) typ(depth int) types.Type {
+	n := 2
+	if depth > 0 {
+		n = 7
+	}
+	switch gen.rnd.Intn(n) {
+	case 0:
+		return gen.intType()
+	case 1:
+		return gen.floatType()
+	case 2:
+		return types.NewPointer(gen.typ(depth - 1))
+	case 3:
+		return types.NewVector(uint64(1+gen.rnd.Intn(4)), gen.scalarType())
+	case 4:
+		return types.NewArray(uint64(1+gen.rnd.Intn(4)), gen.typ(depth-1))
+	case 5:
+		var fields []types.Type
+		for i, n := 0, 1+gen.rnd.Intn(3); i < n; i++ {
+			fields = append(fields, gen.typ(depth-1))
+		}
+		return types.NewStruct(fields...)
+	default:
+		if len(gen.m.TypeDefs) == 0 {
+			return gen.intType()
+		}
+		return gen.m.TypeDefs[gen.rnd.Intn(len(gen.m.TypeDefs))]
+	}
+}
+
+// intType returns a random integer type.
+func (gen *generator) intType() *types.IntType {
+	return intTypes[gen.rnd.Intn(len(intTypes))]
+}
+
+// floatType returns a random floating-point type.
+func (gen *generator) floatType() *types.FloatType {
+	return floatTypes[gen.rnd.Intn(len(floatTypes))]
+}
+
+// scalarType returns a random integer or floating-point type.
+func (gen *generator) scalarType() types.Type {
+	if gen.rnd.Intn(2) == 0 {
+		return gen.intType()
+	}
+	return gen.floatType()
+}
+
+// isSized reports whether the given type may be loaded from or stored to
+// memory.
+func isSized(t types.Type) bool {
+	switch t.(type) {
+	case *types.VoidType, *types.FuncType, *types.LabelType, *types.MetadataType, *types.TokenType:
+		return false
+	}
+	return true
+}
+
+// isIntOrIntVector reports whether the given type is an integer type or a
+// vector of integers type.
+func isIntOrIntVector(t types.Type) bool {
+	if v, ok := t.(*types.VectorType); ok {
+		t = v.ElemType
+	}
+	return types.IsInt(t)
+}
+
+// isFloatOrFloatVector reports whether the given type is a floating-point type
+// or a vector of floating-point type.
+func isFloatOrFloatVector(t types.Type) bool {
+	if v, ok := t.(*types.VectorType); ok {
+		t = v.ElemType
+	}
+	return types.IsFloat(t)
+}
+
+// cmpType returns the result type of a comparison of values of the given type.
+func cmpType(t types.Type) types.Type {
+	if v, ok := t.(*types.VectorType); ok {
+		return types.NewVector(v.Len, types.I1)
+	}
+	return types.I1
+}
+
+// sameShape returns the type with the same shape as t (scalar or vector of the
+// same length) but with the given element type.
+func sameShape(t types.Type, elemType types.Type) types.Type {
+	if v, ok := t.(*types.VectorType); ok {
+		return types.NewVector(v.Len, elemType)
+	}
+	return elemType
+}
+
+// scalar returns the element type of the given vector type, or the type itself
+// if not a vector type.
+func scalar(t types.Type) types.Type {
+	if v, ok := t.(*types.VectorType); ok {
+		return v.ElemType
+	}
+	return t
+}
@@ -0,0 +1,178 @@
+package transform
+
+import (
+	"reflect"
+
+	"github.com/umaumax/llvm/ir"
+	"github.com/umaumax/llvm/ir/constant"
+	"github.com/umaumax/llvm/ir/value"
+)
+
+// replaceAllUses replaces all uses of old with new in the given module; in
+// operands of instructions and terminators, and in constants (initializers of
+// global variables, aliasees, IFunc resolvers and function prefixes, prologues
+// and personalities).
+func replaceAllUses(m *ir.Module, old value.Value, new constant.Constant) {
+	repl := func(c constant.Constant) constant.Constant {
+		if c == old {
+			return new
+		}
+		return c
+	}
+	mapConsts(m, repl)
+	for _, f := range m.Funcs {
+		for _, block := range f.Blocks {
+			for _, inst := range block.Insts {
+				replaceOperands(inst.Operands(), repl)
+			}
+			if block.Term != nil {
+				replaceOperands(block.Term.Operands(), repl)
+			}
+		}
+	}
+}
+
+// replaceOperands replaces the constant operands of the given instruction or
+// terminator, as mapped by repl (recursively).
+func replaceOperands(ops []*value.Value, repl func(c constant.Constant) constant.Constant) {
+	for _, op := range ops {
+		if c, ok := (*op).(constant.Constant); ok {
+			*op = mapConst(c, repl, make(map[constant.Constant]bool))
+		}
+	}
+}
+
+// mapConsts maps the constants of global variables, aliases, IFuncs and
+// function headers of the given module, as mapped by repl (recursively).
+func mapConsts(m *ir.Module, repl func(c constant.Constant) constant.Constant) {
+	visited := make(map[constant.Constant]bool)
+	mapOpt := func(c constant.Constant) constant.Constant {
+		if c == nil {
+			return nil
+		}
+		return mapConst(c, repl, visited)
+	}
+	for _, g := range m.Globals {
+		g.Init = mapOpt(g.Init)
+	}
+	for _, alias := range m.Aliases {
+		alias.Aliasee = mapOpt(alias.Aliasee)
+	}
+	for _, ifunc := range m.IFuncs {
+		ifunc.Resolver = mapOpt(ifunc.Resolver)
+	}
+	for _, f := range m.Funcs {
+		f.Prefix = mapOpt(f.Prefix)
+		f.Prologue = mapOpt(f.Prologue)
+		f.Personality = mapOpt(f.Personality)
+	}
+}
+
+// mapConst returns the constant c as mapped by repl; where the operands of
+// constant expressions and aggregate constants are mapped recursively (in
+// place).
+func mapConst(c constant.Constant, repl func(c constant.Constant) constant.Constant, visited map[constant.Constant]bool) constant.Constant {
+	if new := repl(c); new != c {
+		return new
+	}
+	switch c.(type) {
+	case *ir.Global, *ir.Func, *ir.Alias, *ir.IFunc:
+		// Global identifiers are not traversed.
+		return c
+	}
+	if visited[c] {
+		return c
+	}
+	visited[c] = true
+	v := reflect.ValueOf(c)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return c
+	}
+	v = v.Elem()
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Field(i)
+		if !field.CanSet() {
+			continue
+		}
+		switch field.Kind() {
+		case reflect.Interface:
+			mapConstField(field, repl, visited)
+		case reflect.Slice:
+			if field.Type().Elem().Kind() != reflect.Interface {
+				continue
+			}
+			for j := 0; j < field.Len(); j++ {
+				mapConstField(field.Index(j), repl, visited)
+			}
+		}
+	}
+	return c
+}
+
+// mapConstField maps the constant stored in the given interface value, as
+// mapped by repl (recursively).
+func mapConstField(field reflect.Value, repl func(c constant.Constant) constant.Constant, visited map[constant.Constant]bool) {
+	if field.IsNil() {
+		return
+	}
+	c, ok := field.Interface().(constant.Constant)
+	if !ok {
+		return
+	}
+	new := mapConst(c, repl, visited)
+	if new != c && reflect.TypeOf(new).AssignableTo(field.Type()) {
+		field.Set(reflect.ValueOf(new))
+	}
+}
+
+// isAddressTaken reports whether the address of the given function is taken;
+// i.e. whether the function is used other than as the callee of direct calls.
+func isAddressTaken(m *ir.Module, f *ir.Func) bool {
+	taken := false
+	find := func(c constant.Constant) constant.Constant {
+		if c == f {
+			taken = true
+		}
+		return c
+	}
+	mapConsts(m, find)
+	for _, g := range m.Funcs {
+		for _, block := range g.Blocks {
+			for _, inst := range block.Insts {
+				ops := inst.Operands()
+				if call, ok := inst.(*ir.InstCall); ok && call.Callee == f {
+					// Skip callee of direct call.
+					ops = ops[1:]
+				}
+				replaceOperands(ops, find)
+			}
+			if block.Term != nil {
+				ops := block.Term.Operands()
+				switch term := block.Term.(type) {
+				case *ir.TermInvoke:
+					if term.Invokee == f {
+						// Skip invokee of direct invoke.
+						ops = ops[1:]
+					}
+				case *ir.TermCallBr:
+					if term.Callee == f {
+						// Skip callee of direct callbr.
+						ops = ops[1:]
+					}
+				}
+				replaceOperands(ops, find)
+			}
+		}
+	}
+	return taken
+}
+
+// removeFunc removes the given function from the module.
+func removeFunc(m *ir.Module, f *ir.Func) {
+	for i, g := range m.Funcs {
+		if g == f {
+			m.Funcs = append(m.Funcs[:i], m.Funcs[i+1:]...)
+			return
+		}
+	}
+}
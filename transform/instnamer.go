@@ -0,0 +1,50 @@
+package transform
+
+import (
+	"github.com/umaumax/llvm/ir"
+	"github.com/umaumax/llvm/ir/types"
+	"github.com/umaumax/llvm/ir/value"
+)
+
+// NameInsts assigns names to the unnamed local variables of the function
+// definitions of the given module (instnamer); function parameters are named
+// %arg, basic blocks %bb and results of instructions and terminators %tmp.
+// Names are uniqued by appending a numeric suffix (e.g. %tmp, %tmp.1).
+//
+// Named local variables make the output easier to read and edit by hand, as
+// inserting or removing an unnamed local variable renumbers all subsequent
+// unnamed local variables of the function.
+func NameInsts(m *ir.Module) error {
+	for _, f := range m.Funcs {
+		if len(f.Blocks) == 0 {
+			continue
+		}
+		// Build the symbol table of the function, so that the names assigned
+		// below are uniqued (see LocalIdent.SetName).
+		f.Local("")
+		for _, param := range f.Params {
+			nameLocal(param, "arg")
+		}
+		for _, block := range f.Blocks {
+			nameLocal(block, "bb")
+			for _, inst := range block.Insts {
+				nameLocal(inst, "tmp")
+			}
+			nameLocal(block.Term, "tmp")
+		}
+	}
+	return nil
+}
+
+// nameLocal assigns the given name to the local variable if unnamed. Void
+// values (e.g. calls to void functions) and non-values are left unnamed.
+func nameLocal(v interface{}, name string) {
+	n, ok := v.(interface {
+		value.Named
+		IsUnnamed() bool
+	})
+	if !ok || !n.IsUnnamed() || types.IsVoid(n.Type()) {
+		return
+	}
+	n.SetName(name)
+}
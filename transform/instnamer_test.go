@@ -0,0 +1,54 @@
+package transform_test
+
+import (
+	"testing"
+
+	"github.com/umaumax/llvm/asm"
+	"github.com/umaumax/llvm/transform"
+)
+
+func TestNameInsts(t *testing.T) {
+	const input = "" +
+		"declare void @g(i32)\n" +
+		"\n" +
+		"define i32 @f(i32, i32 %tmp) {\n" +
+		"  %2 = add i32 %0, %tmp\n" +
+		"  call void @g(i32 %2)\n" +
+		"  %3 = icmp eq i32 %2, 0\n" +
+		"  br i1 %3, label %4, label %5\n" +
+		"; <label>:4\n" +
+		"  ret i32 %2\n" +
+		"; <label>:5\n" +
+		"  ret i32 0\n" +
+		"}\n"
+	const want = "" +
+		"declare void @g(i32)\n" +
+		"\n" +
+		"define i32 @f(i32 %arg, i32 %tmp) {\n" +
+		"bb:\n" +
+		"\t%tmp.1 = add i32 %arg, %tmp\n" +
+		"\tcall void @g(i32 %tmp.1)\n" +
+		"\t%tmp.2 = icmp eq i32 %tmp.1, 0\n" +
+		"\tbr i1 %tmp.2, label %bb.1, label %bb.2\n" +
+		"\n" +
+		"bb.1:\n" +
+		"\tret i32 %tmp.1\n" +
+		"\n" +
+		"bb.2:\n" +
+		"\tret i32 0\n" +
+		"}\n"
+	m, err := asm.ParseString("<input>", input)
+	if err != nil {
+		t.Fatalf("unable to parse input; %+v", err)
+	}
+	if err := transform.NameInsts(m); err != nil {
+		t.Fatalf("unable to name instructions; %+v", err)
+	}
+	got := m.String()
+	if got != want {
+		t.Errorf("module mismatch; expected %q, got %q", want, got)
+	}
+	if _, err := asm.ParseString("<output>", got); err != nil {
+		t.Errorf("unable to parse output; %+v", err)
+	}
+}
@@ -0,0 +1,160 @@
+package transform
+
+import (
+	"github.com/umaumax/llvm/ir"
+	"github.com/umaumax/llvm/ir/constant"
+	"github.com/umaumax/llvm/ir/enum"
+	"github.com/umaumax/llvm/ir/types"
+	"github.com/umaumax/llvm/ir/value"
+)
+
+// MergeFuncs merges equivalent function definitions of the given module, and
+// returns the number of merged functions.
+//
+// Functions are equivalent if they are structurally equal up to naming, where
+// pointer types of the same address space are considered equivalent (see
+// ir.EquivFunc). Of each set of equivalent functions, the first in module order
+// is kept as the canonical function, and every other function F is replaced as
+// follows:
+//
+//    * if F has local linkage and its address is not taken, direct calls to F
+//      are redirected to the canonical function and F is removed;
+//    * if F has unnamed_addr (i.e. its address is not significant), F is
+//      replaced by an alias of the canonical function;
+//    * otherwise, the body of F is replaced by a thunk which tail calls the
+//      canonical function.
+//
+// Functions with interposable linkage (e.g. weak or linkonce) are not merged,
+// as their definition may be replaced at link time.
+func MergeFuncs(m *ir.Module) int {
+	// Group candidate functions by structural hash, in module order.
+	var hashes []uint64
+	groups := make(map[uint64][]*ir.Func)
+	for _, f := range m.Funcs {
+		if !isMergeable(f) {
+			continue
+		}
+		h := ir.HashFuncEquiv(f)
+		if _, ok := groups[h]; !ok {
+			hashes = append(hashes, h)
+		}
+		groups[h] = append(groups[h], f)
+	}
+	// Merge equivalent functions of each group.
+	n := 0
+	for _, h := range hashes {
+		fs := groups[h]
+		for len(fs) > 1 {
+			g := fs[0]
+			var rest []*ir.Func
+			for _, f := range fs[1:] {
+				if !ir.EquivFunc(g, f) {
+					rest = append(rest, f)
+					continue
+				}
+				if mergeFunc(m, f, g) {
+					n++
+				}
+			}
+			fs = rest
+		}
+	}
+	return n
+}
+
+// mergeFunc replaces the function f by the equivalent canonical function g,
+// and reports whether f was merged.
+func mergeFunc(m *ir.Module, f, g *ir.Func) bool {
+	// Redirect direct calls.
+	if isLocalLinkage(f.Linkage) && !isAddressTaken(m, f) {
+		removeFunc(m, f)
+		replaceAllUses(m, f, castTo(g, f.Type()))
+		return true
+	}
+	// Replace by alias.
+	if f.UnnamedAddr == enum.UnnamedAddrUnnamedAddr {
+		alias := &ir.Alias{
+			GlobalIdent:     f.GlobalIdent,
+			Aliasee:         castTo(g, f.Type()),
+			Linkage:         f.Linkage,
+			Preemption:      f.Preemption,
+			Visibility:      f.Visibility,
+			DLLStorageClass: f.DLLStorageClass,
+			UnnamedAddr:     f.UnnamedAddr,
+		}
+		alias.Type()
+		removeFunc(m, f)
+		m.Aliases = append(m.Aliases, alias)
+		replaceAllUses(m, f, alias)
+		return true
+	}
+	// Replace by thunk. Variadic arguments may not be forwarded by a thunk.
+	if f.Sig.Variadic {
+		return false
+	}
+	makeThunk(f, g)
+	return true
+}
+
+// makeThunk replaces the body of f with a tail call to g, converting the
+// arguments and return value between equivalent pointer types as needed. The
+// parameter and return attributes of g (e.g. byval, sret and zeroext) are
+// copied to the call, as they affect the ABI of the call.
+func makeThunk(f, g *ir.Func) {
+	block := ir.NewBlock("")
+	block.Parent = f
+	var args []value.Value
+	for i, param := range f.Params {
+		var arg value.Value = param
+		if want := g.Sig.Params[i]; !param.Type().Equal(want) {
+			arg = block.NewBitCast(param, want)
+		}
+		if attrs := g.Params[i].Attrs; len(attrs) > 0 {
+			arg = ir.NewArg(arg, append([]ir.ParamAttribute(nil), attrs...)...)
+		}
+		args = append(args, arg)
+	}
+	call := block.NewCall(g, args...)
+	call.Tail = enum.TailTail
+	call.CallingConv = g.CallingConv
+	call.ReturnAttrs = append([]ir.ReturnAttribute(nil), g.ReturnAttrs...)
+	if types.IsVoid(f.Sig.RetType) {
+		block.NewRet(nil)
+	} else {
+		var ret value.Value = call
+		if !call.Type().Equal(f.Sig.RetType) {
+			ret = block.NewBitCast(call, f.Sig.RetType)
+		}
+		block.NewRet(ret)
+	}
+	f.Blocks = []*ir.Block{block}
+	f.UseListOrders = nil
+}
+
+// isMergeable reports whether the given function is a candidate for merging.
+func isMergeable(f *ir.Func) bool {
+	if len(f.Blocks) == 0 {
+		// Function declaration.
+		return false
+	}
+	switch f.Linkage {
+	case enum.LinkageWeak, enum.LinkageLinkOnce, enum.LinkageExternWeak, enum.LinkageCommon, enum.LinkageAvailableExternally:
+		// Interposable function definition.
+		return false
+	}
+	return true
+}
+
+// isLocalLinkage reports whether the given linkage is local to the module.
+func isLocalLinkage(linkage enum.Linkage) bool {
+	return linkage == enum.LinkageInternal || linkage == enum.LinkagePrivate
+}
+
+// castTo returns the given constant converted to type t (if not already of
+// type t).
+func castTo(c constant.Constant, t types.Type) constant.Constant {
+	if c.Type().Equal(t) {
+		return c
+	}
+	return constant.NewBitCast(c, t)
+}
@@ -0,0 +1,156 @@
+package transform_test
+
+import (
+	"testing"
+
+	"github.com/umaumax/llvm/asm"
+	"github.com/umaumax/llvm/transform"
+)
+
+func TestMergeFuncs(t *testing.T) {
+	const input = "" +
+		"define i32 @g(i32 %x) {\n" +
+		"  %y = add i32 %x, 1\n" +
+		"  ret i32 %y\n" +
+		"}\n" +
+		"\n" +
+		"define internal i32 @local(i32 %a) {\n" +
+		"  %b = add i32 %a, 1\n" +
+		"  ret i32 %b\n" +
+		"}\n" +
+		"\n" +
+		"define i32 @unnamed(i32 %a) unnamed_addr {\n" +
+		"  %b = add i32 %a, 1\n" +
+		"  ret i32 %b\n" +
+		"}\n" +
+		"\n" +
+		"define i32 @external(i32 %a) {\n" +
+		"  %b = add i32 %a, 1\n" +
+		"  ret i32 %b\n" +
+		"}\n" +
+		"\n" +
+		"define i32* @p32(i32* %p) {\n" +
+		"  %q = icmp eq i32* %p, null\n" +
+		"  %r = select i1 %q, i32* null, i32* %p\n" +
+		"  ret i32* %r\n" +
+		"}\n" +
+		"\n" +
+		"define i8* @p8(i8* %p) {\n" +
+		"  %q = icmp eq i8* %p, null\n" +
+		"  %r = select i1 %q, i8* null, i8* %p\n" +
+		"  ret i8* %r\n" +
+		"}\n" +
+		"\n" +
+		"define weak i32 @weak(i32 %a) {\n" +
+		"  %b = add i32 %a, 1\n" +
+		"  ret i32 %b\n" +
+		"}\n" +
+		"\n" +
+		"define i32 @main() {\n" +
+		"  %r = call i32 @local(i32 1)\n" +
+		"  ret i32 %r\n" +
+		"}\n"
+	const want = "" +
+		"@unnamed = unnamed_addr alias i32 (i32), i32 (i32)* @g\n" +
+		"\n" +
+		"define i32 @g(i32 %x) {\n" +
+		"; <label>:0\n" +
+		"\t%y = add i32 %x, 1\n" +
+		"\tret i32 %y\n" +
+		"}\n" +
+		"\n" +
+		"define i32 @external(i32 %a) {\n" +
+		"; <label>:0\n" +
+		"\t%1 = tail call i32 @g(i32 %a)\n" +
+		"\tret i32 %1\n" +
+		"}\n" +
+		"\n" +
+		"define i32* @p32(i32* %p) {\n" +
+		"; <label>:0\n" +
+		"\t%q = icmp eq i32* %p, null\n" +
+		"\t%r = select i1 %q, i32* null, i32* %p\n" +
+		"\tret i32* %r\n" +
+		"}\n" +
+		"\n" +
+		"define i8* @p8(i8* %p) {\n" +
+		"; <label>:0\n" +
+		"\t%1 = bitcast i8* %p to i32*\n" +
+		"\t%2 = tail call i32* @p32(i32* %1)\n" +
+		"\t%3 = bitcast i32* %2 to i8*\n" +
+		"\tret i8* %3\n" +
+		"}\n" +
+		"\n" +
+		"define weak i32 @weak(i32 %a) {\n" +
+		"; <label>:0\n" +
+		"\t%b = add i32 %a, 1\n" +
+		"\tret i32 %b\n" +
+		"}\n" +
+		"\n" +
+		"define i32 @main() {\n" +
+		"; <label>:0\n" +
+		"\t%r = call i32 @g(i32 1)\n" +
+		"\tret i32 %r\n" +
+		"}\n"
+	m, err := asm.ParseString("<input>", input)
+	if err != nil {
+		t.Fatalf("unable to parse input; %+v", err)
+	}
+	// @local, @unnamed and @external are merged with @g; @p8 is merged with
+	// @p32. The interposable @weak is left as is.
+	if n := transform.MergeFuncs(m); n != 4 {
+		t.Errorf("number of merged functions mismatch; expected 4, got %d", n)
+	}
+	got := m.String()
+	if got != want {
+		t.Errorf("module mismatch; expected %q, got %q", want, got)
+	}
+	if _, err := asm.ParseString("<output>", got); err != nil {
+		t.Errorf("unable to parse output; %+v", err)
+	}
+}
+
+func TestMergeFuncsAttrs(t *testing.T) {
+	const input = "" +
+		"%T = type { i32, i32 }\n" +
+		"\n" +
+		"define zeroext i8 @g(%T* byval(%T) %p, i8 signext %c, i32 inreg %x) {\n" +
+		"  %q = getelementptr %T, %T* %p, i64 0, i32 1\n" +
+		"  store i32 %x, i32* %q\n" +
+		"  ret i8 %c\n" +
+		"}\n" +
+		"\n" +
+		"define zeroext i8 @f(%T* byval(%T) %p, i8 signext %c, i32 inreg %x) {\n" +
+		"  %q = getelementptr %T, %T* %p, i64 0, i32 1\n" +
+		"  store i32 %x, i32* %q\n" +
+		"  ret i8 %c\n" +
+		"}\n"
+	const want = "" +
+		"%T = type { i32, i32 }\n" +
+		"\n" +
+		"define zeroext i8 @g(%T* byval(%T) %p, i8 signext %c, i32 inreg %x) {\n" +
+		"; <label>:0\n" +
+		"\t%q = getelementptr %T, %T* %p, i64 0, i32 1\n" +
+		"\tstore i32 %x, i32* %q\n" +
+		"\tret i8 %c\n" +
+		"}\n" +
+		"\n" +
+		"define zeroext i8 @f(%T* byval(%T) %p, i8 signext %c, i32 inreg %x) {\n" +
+		"; <label>:0\n" +
+		"\t%1 = tail call zeroext i8 @g(%T* byval(%T) %p, i8 signext %c, i32 inreg %x)\n" +
+		"\tret i8 %1\n" +
+		"}\n"
+	m, err := asm.ParseString("<input>", input)
+	if err != nil {
+		t.Fatalf("unable to parse input; %+v", err)
+	}
+	if n := transform.MergeFuncs(m); n != 1 {
+		t.Errorf("number of merged functions mismatch; expected 1, got %d", n)
+	}
+	got := m.String()
+	if got != want {
+		t.Errorf("module mismatch; expected %q, got %q", want, got)
+	}
+	if _, err := asm.ParseString("<output>", got); err != nil {
+		t.Errorf("unable to parse output; %+v", err)
+	}
+}
@@ -0,0 +1,268 @@
+package transform
+
+import (
+	"strings"
+
+	"github.com/umaumax/llvm/ir"
+	"github.com/umaumax/llvm/ir/enum"
+	"github.com/umaumax/llvm/ir/metadata"
+)
+
+// StripDebugInfo removes the debug information of the given module (strip-debug),
+// and reports whether the module was modified.
+//
+// The following debug information is removed:
+//
+//    * !dbg metadata attachments of global variables, functions, instructions
+//      and terminators;
+//    * calls to debug intrinsics (e.g. llvm.dbg.declare and llvm.dbg.value),
+//      and their declarations if unused;
+//    * source locations of loop metadata (!llvm.loop);
+//    * named metadata definitions of debug information (e.g. !llvm.dbg.cu);
+//    * metadata definitions no longer referenced.
+//
+// Module flags (e.g. "Debug Info Version") are retained.
+func StripDebugInfo(m *ir.Module) bool {
+	changed := removeDbgIntrinsics(m, false)
+	strip := func(v interface{}) {
+		x, ok := v.(ir.MetadataAttacher)
+		if !ok {
+			return
+		}
+		for _, md := range x.MDAttachments() {
+			if md.Name == "llvm.loop" {
+				if stripLoopLocs(md.Node) {
+					changed = true
+				}
+			}
+		}
+		if removeAttachments(x, "dbg") > 0 {
+			changed = true
+		}
+	}
+	for _, g := range m.Globals {
+		strip(g)
+	}
+	for _, f := range m.Funcs {
+		strip(f)
+		for _, block := range f.Blocks {
+			for _, inst := range block.Insts {
+				strip(inst)
+			}
+			strip(block.Term)
+		}
+	}
+	for name := range m.NamedMetadataDefs {
+		if strings.HasPrefix(name, "llvm.dbg.") {
+			delete(m.NamedMetadataDefs, name)
+			changed = true
+		}
+	}
+	if m.RemoveUnusedMetadata() > 0 {
+		changed = true
+	}
+	return changed
+}
+
+// StripNonLineTableDebugInfo removes the debug information of the given module
+// which is not required to emit line tables (strip-nonlinetable-debuginfo), and
+// reports whether the module was modified.
+//
+// Source locations of instructions, subprograms and their scopes are retained.
+// Debug information of variables and types is removed; i.e. calls to debug
+// variable intrinsics (e.g. llvm.dbg.declare and llvm.dbg.value), !dbg metadata
+// attachments of global variables, subprogram types and retained nodes, and the
+// lists of types, global variables, imported entities and macros of compile
+// units. The emission kind of compile units is set to line tables only.
+func StripNonLineTableDebugInfo(m *ir.Module) bool {
+	changed := removeDbgIntrinsics(m, true)
+	for _, g := range m.Globals {
+		if removeAttachments(&g.Metadata, "dbg") > 0 {
+			changed = true
+		}
+	}
+	// Compile units.
+	if named, ok := m.NamedMetadataDefs["llvm.dbg.cu"]; ok {
+		for _, node := range named.Nodes {
+			cu, ok := node.(*metadata.DICompileUnit)
+			if !ok {
+				continue
+			}
+			if cu.EmissionKind == enum.EmissionKindLineTablesOnly && cu.Enums == nil && cu.RetainedTypes == nil && cu.Globals == nil && cu.Imports == nil && cu.Macros == nil {
+				continue
+			}
+			cu.EmissionKind = enum.EmissionKindLineTablesOnly
+			cu.Enums = nil
+			cu.RetainedTypes = nil
+			cu.Globals = nil
+			cu.Imports = nil
+			cu.Macros = nil
+			changed = true
+		}
+	}
+	// Subprograms of functions and scopes of source locations (including
+	// inlined subprograms).
+	var emptyType *metadata.DISubroutineType
+	visited := make(map[*metadata.DISubprogram]bool)
+	stripSubprogram := func(sp *metadata.DISubprogram) {
+		if sp == nil || visited[sp] {
+			return
+		}
+		visited[sp] = true
+		if t, ok := sp.Type.(*metadata.DISubroutineType); ok && t == emptyType {
+			return
+		}
+		if emptyType == nil {
+			types := &metadata.Tuple{MetadataID: -1}
+			emptyType = &metadata.DISubroutineType{MetadataID: -1, Types: types}
+			m.MetadataDefs = append(m.MetadataDefs, types, emptyType)
+		}
+		sp.Type = emptyType
+		sp.ContainingType = nil
+		sp.TemplateParams = nil
+		sp.Declaration = nil
+		sp.RetainedNodes = nil
+		sp.ThrownTypes = nil
+		changed = true
+	}
+	stripLoc := func(inst interface{}) {
+		x, ok := inst.(ir.MetadataAttacher)
+		if !ok {
+			return
+		}
+		for loc := ir.Metadata(x.MDAttachments()).DebugLoc(); loc != nil; loc = loc.InlinedAt {
+			stripSubprogram(ir.ScopeSubprogram(loc.Scope))
+		}
+	}
+	for _, f := range m.Funcs {
+		stripSubprogram(f.Subprogram())
+		for _, block := range f.Blocks {
+			for _, inst := range block.Insts {
+				stripLoc(inst)
+			}
+			stripLoc(block.Term)
+		}
+	}
+	if m.RemoveUnusedMetadata() > 0 {
+		changed = true
+	}
+	return changed
+}
+
+// StripMetadataKinds removes the metadata attachments of the given kinds (e.g.
+// "tbaa", "prof" and "range") from the global variables, functions,
+// instructions and terminators of the given module, and returns the number of
+// removed metadata attachments. Metadata definitions no longer referenced are
+// removed.
+func StripMetadataKinds(m *ir.Module, kinds ...string) int {
+	n := 0
+	remove := func(v interface{}) {
+		if x, ok := v.(ir.MetadataAttacher); ok {
+			n += removeAttachments(x, kinds...)
+		}
+	}
+	for _, g := range m.Globals {
+		remove(g)
+	}
+	for _, f := range m.Funcs {
+		remove(f)
+		for _, block := range f.Blocks {
+			for _, inst := range block.Insts {
+				remove(inst)
+			}
+			remove(block.Term)
+		}
+	}
+	if n > 0 {
+		m.RemoveUnusedMetadata()
+	}
+	return n
+}
+
+// removeDbgIntrinsics removes calls to debug intrinsics from the function
+// definitions of the given module, and the declarations of unused debug
+// intrinsics. If varsOnly is set, only calls to debug variable intrinsics (e.g.
+// llvm.dbg.value) are removed, and calls to llvm.dbg.label are retained.
+func removeDbgIntrinsics(m *ir.Module, varsOnly bool) bool {
+	isDbg := func(f *ir.Func) bool {
+		name := f.Name()
+		if varsOnly && name == "llvm.dbg.label" {
+			return false
+		}
+		return strings.HasPrefix(name, "llvm.dbg.")
+	}
+	changed := false
+	for _, f := range m.Funcs {
+		for _, block := range f.Blocks {
+			insts := block.Insts[:0]
+			for _, inst := range block.Insts {
+				if call, ok := inst.(*ir.InstCall); ok {
+					if callee, ok := call.Callee.(*ir.Func); ok && isDbg(callee) {
+						changed = true
+						continue
+					}
+				}
+				insts = append(insts, inst)
+			}
+			block.Insts = insts
+		}
+	}
+	for i := 0; i < len(m.Funcs); i++ {
+		f := m.Funcs[i]
+		if len(f.Blocks) == 0 && isDbg(f) && !isAddressTaken(m, f) {
+			removeFunc(m, f)
+			i--
+			changed = true
+		}
+	}
+	return changed
+}
+
+// removeAttachments removes the metadata attachments of the given kinds from
+// the value, and returns the number of removed metadata attachments.
+func removeAttachments(v ir.MetadataAttacher, kinds ...string) int {
+	n := 0
+	mds := v.MDAttachments()
+	kept := mds[:0]
+	for _, md := range mds {
+		if containsString(kinds, md.Name) {
+			n++
+			continue
+		}
+		kept = append(kept, md)
+	}
+	if len(kept) == 0 {
+		kept = nil
+	}
+	v.SetMDAttachments(kept)
+	return n
+}
+
+// stripLoopLocs removes the source locations (DILocation) of the given loop
+// metadata, and reports whether any were removed.
+func stripLoopLocs(node metadata.MDNode) bool {
+	loop, ok := node.(*metadata.Tuple)
+	if !ok {
+		return false
+	}
+	fields := loop.Fields[:0]
+	for _, field := range loop.Fields {
+		if _, ok := field.(*metadata.DILocation); ok {
+			continue
+		}
+		fields = append(fields, field)
+	}
+	changed := len(fields) != len(loop.Fields)
+	loop.Fields = fields
+	return changed
+}
+
+// containsString reports whether the given list of strings contains s.
+func containsString(ss []string, s string) bool {
+	for _, x := range ss {
+		if x == s {
+			return true
+		}
+	}
+	return false
+}
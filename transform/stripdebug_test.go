@@ -0,0 +1,195 @@
+package transform_test
+
+import (
+	"testing"
+
+	"github.com/umaumax/llvm/asm"
+	"github.com/umaumax/llvm/ir"
+	"github.com/umaumax/llvm/transform"
+)
+
+// stripInput is the input module of the strip tests.
+const stripInput = "" +
+	"@g = global i32 0, !dbg !13\n" +
+	"\n" +
+	"define i32 @f(i32 %a, i32* %p) !dbg !4 {\n" +
+	"entry:\n" +
+	"  call void @llvm.dbg.value(metadata i32 %a, metadata !8, metadata !DIExpression()), !dbg !9\n" +
+	"  %x = load i32, i32* %p, !dbg !9, !tbaa !16, !range !19\n" +
+	"  br label %loop, !dbg !9\n" +
+	"loop:\n" +
+	"  %i = phi i32 [ 0, %entry ], [ %j, %loop ]\n" +
+	"  %j = add i32 %i, %x, !dbg !10\n" +
+	"  %c = icmp slt i32 %j, %a, !dbg !10\n" +
+	"  br i1 %c, label %loop, label %exit, !dbg !10, !llvm.loop !11\n" +
+	"exit:\n" +
+	"  ret i32 %j, !dbg !10\n" +
+	"}\n" +
+	"\n" +
+	"declare void @llvm.dbg.value(metadata, metadata, metadata)\n" +
+	"\n" +
+	"!llvm.dbg.cu = !{!0}\n" +
+	"!llvm.module.flags = !{!3}\n" +
+	"\n" +
+	"!0 = distinct !DICompileUnit(language: DW_LANG_C99, file: !1, emissionKind: FullDebug, globals: !12)\n" +
+	"!1 = !DIFile(filename: \"foo.c\", directory: \"/tmp\")\n" +
+	"!2 = !DIBasicType(name: \"int\", size: 32, encoding: DW_ATE_signed)\n" +
+	"!3 = !{i32 2, !\"Debug Info Version\", i32 3}\n" +
+	"!4 = distinct !DISubprogram(name: \"f\", scope: !1, file: !1, line: 1, type: !5, isDefinition: true, unit: !0, retainedNodes: !7)\n" +
+	"!5 = !DISubroutineType(types: !6)\n" +
+	"!6 = !{!2, !2}\n" +
+	"!7 = !{!8}\n" +
+	"!8 = !DILocalVariable(name: \"a\", arg: 1, scope: !4, file: !1, line: 1, type: !2)\n" +
+	"!9 = !DILocation(line: 2, column: 3, scope: !4)\n" +
+	"!10 = !DILocation(line: 3, column: 5, scope: !4)\n" +
+	"!11 = distinct !{!11, !9, !10, !20}\n" +
+	"!12 = !{!13}\n" +
+	"!13 = !DIGlobalVariableExpression(var: !14, expr: !DIExpression())\n" +
+	"!14 = distinct !DIGlobalVariable(name: \"g\", scope: !0, file: !1, line: 1, type: !2, isLocal: false, isDefinition: true)\n" +
+	"!15 = !{!\"int\", !17}\n" +
+	"!16 = !{!15, !15, i64 0}\n" +
+	"!17 = !{!\"omnipotent char\", !18}\n" +
+	"!18 = !{!\"Simple C/C++ TBAA\"}\n" +
+	"!19 = !{i32 0, i32 10}\n" +
+	"!20 = !{!\"llvm.loop.mustprogress\"}\n"
+
+func TestStripDebugInfo(t *testing.T) {
+	const want = "" +
+		"@g = global i32 0\n" +
+		"\n" +
+		"define i32 @f(i32 %a, i32* %p) {\n" +
+		"entry:\n" +
+		"\t%x = load i32, i32* %p, !tbaa !16, !range !19\n" +
+		"\tbr label %loop\n" +
+		"\n" +
+		"loop:\n" +
+		"\t%i = phi i32 [ 0, %entry ], [ %j, %loop ]\n" +
+		"\t%j = add i32 %i, %x\n" +
+		"\t%c = icmp slt i32 %j, %a\n" +
+		"\tbr i1 %c, label %loop, label %exit, !llvm.loop !11\n" +
+		"\n" +
+		"exit:\n" +
+		"\tret i32 %j\n" +
+		"}\n" +
+		"\n" +
+		"!llvm.module.flags = !{!3}\n" +
+		"\n" +
+		"!3 = !{i32 2, !\"Debug Info Version\", i32 3}\n" +
+		"!11 = distinct !{!11, !20}\n" +
+		"!15 = !{!\"int\", !17}\n" +
+		"!16 = !{!15, !15, i64 0}\n" +
+		"!17 = !{!\"omnipotent char\", !18}\n" +
+		"!18 = !{!\"Simple C/C++ TBAA\"}\n" +
+		"!19 = !{i32 0, i32 10}\n" +
+		"!20 = !{!\"llvm.loop.mustprogress\"}\n"
+	testStrip(t, want, func(m *ir.Module) bool {
+		return transform.StripDebugInfo(m)
+	})
+}
+
+func TestStripNonLineTableDebugInfo(t *testing.T) {
+	const want = "" +
+		"@g = global i32 0\n" +
+		"\n" +
+		"define i32 @f(i32 %a, i32* %p) !dbg !4 {\n" +
+		"entry:\n" +
+		"\t%x = load i32, i32* %p, !dbg !9, !tbaa !16, !range !19\n" +
+		"\tbr label %loop, !dbg !9\n" +
+		"\n" +
+		"loop:\n" +
+		"\t%i = phi i32 [ 0, %entry ], [ %j, %loop ]\n" +
+		"\t%j = add i32 %i, %x, !dbg !10\n" +
+		"\t%c = icmp slt i32 %j, %a, !dbg !10\n" +
+		"\tbr i1 %c, label %loop, label %exit, !dbg !10, !llvm.loop !11\n" +
+		"\n" +
+		"exit:\n" +
+		"\tret i32 %j, !dbg !10\n" +
+		"}\n" +
+		"\n" +
+		"!llvm.dbg.cu = !{!0}\n" +
+		"!llvm.module.flags = !{!3}\n" +
+		"\n" +
+		"!0 = distinct !DICompileUnit(language: DW_LANG_C99, file: !1, emissionKind: LineTablesOnly)\n" +
+		"!1 = !DIFile(filename: \"foo.c\", directory: \"/tmp\")\n" +
+		"!3 = !{i32 2, !\"Debug Info Version\", i32 3}\n" +
+		"!4 = distinct !DISubprogram(name: \"f\", scope: !1, file: !1, line: 1, type: !5, isDefinition: true, unit: !0)\n" +
+		"!9 = !DILocation(line: 2, column: 3, scope: !4)\n" +
+		"!10 = !DILocation(line: 3, column: 5, scope: !4)\n" +
+		"!11 = distinct !{!11, !9, !10, !20}\n" +
+		"!15 = !{!\"int\", !17}\n" +
+		"!16 = !{!15, !15, i64 0}\n" +
+		"!17 = !{!\"omnipotent char\", !18}\n" +
+		"!18 = !{!\"Simple C/C++ TBAA\"}\n" +
+		"!19 = !{i32 0, i32 10}\n" +
+		"!20 = !{!\"llvm.loop.mustprogress\"}\n" +
+		"!2 = !{}\n" +
+		"!5 = !DISubroutineType(types: !2)\n"
+	testStrip(t, want, func(m *ir.Module) bool {
+		return transform.StripNonLineTableDebugInfo(m)
+	})
+}
+
+func TestStripMetadataKinds(t *testing.T) {
+	const want = "" +
+		"@g = global i32 0, !dbg !13\n" +
+		"\n" +
+		"define i32 @f(i32 %a, i32* %p) !dbg !4 {\n" +
+		"entry:\n" +
+		"\tcall void @llvm.dbg.value(metadata i32 %a, metadata !8, metadata !DIExpression()), !dbg !9\n" +
+		"\t%x = load i32, i32* %p, !dbg !9\n" +
+		"\tbr label %loop, !dbg !9\n" +
+		"\n" +
+		"loop:\n" +
+		"\t%i = phi i32 [ 0, %entry ], [ %j, %loop ]\n" +
+		"\t%j = add i32 %i, %x, !dbg !10\n" +
+		"\t%c = icmp slt i32 %j, %a, !dbg !10\n" +
+		"\tbr i1 %c, label %loop, label %exit, !dbg !10, !llvm.loop !11\n" +
+		"\n" +
+		"exit:\n" +
+		"\tret i32 %j, !dbg !10\n" +
+		"}\n" +
+		"\n" +
+		"declare void @llvm.dbg.value(metadata, metadata, metadata)\n" +
+		"\n" +
+		"!llvm.dbg.cu = !{!0}\n" +
+		"!llvm.module.flags = !{!3}\n" +
+		"\n" +
+		"!0 = distinct !DICompileUnit(language: DW_LANG_C99, file: !1, emissionKind: FullDebug, globals: !12)\n" +
+		"!1 = !DIFile(filename: \"foo.c\", directory: \"/tmp\")\n" +
+		"!2 = !DIBasicType(name: \"int\", size: 32, encoding: DW_ATE_signed)\n" +
+		"!3 = !{i32 2, !\"Debug Info Version\", i32 3}\n" +
+		"!4 = distinct !DISubprogram(name: \"f\", scope: !1, file: !1, line: 1, type: !5, isDefinition: true, unit: !0, retainedNodes: !7)\n" +
+		"!5 = !DISubroutineType(types: !6)\n" +
+		"!6 = !{!2, !2}\n" +
+		"!7 = !{!8}\n" +
+		"!8 = !DILocalVariable(name: \"a\", arg: 1, scope: !4, file: !1, line: 1, type: !2)\n" +
+		"!9 = !DILocation(line: 2, column: 3, scope: !4)\n" +
+		"!10 = !DILocation(line: 3, column: 5, scope: !4)\n" +
+		"!11 = distinct !{!11, !9, !10, !20}\n" +
+		"!12 = !{!13}\n" +
+		"!13 = !DIGlobalVariableExpression(var: !14, expr: !DIExpression())\n" +
+		"!14 = distinct !DIGlobalVariable(name: \"g\", scope: !0, file: !1, line: 1, type: !2, isDefinition: true)\n" +
+		"!20 = !{!\"llvm.loop.mustprogress\"}\n"
+	testStrip(t, want, func(m *ir.Module) bool {
+		return transform.StripMetadataKinds(m, "tbaa", "range") == 2
+	})
+}
+
+// testStrip checks that strip modifies the input module and that the output
+// module matches want.
+func testStrip(t *testing.T, want string, strip func(m *ir.Module) bool) {
+	m, err := asm.ParseString("<input>", stripInput)
+	if err != nil {
+		t.Fatalf("unable to parse input; %+v", err)
+	}
+	if !strip(m) {
+		t.Errorf("module not modified")
+	}
+	got := m.String()
+	if got != want {
+		t.Errorf("module mismatch; expected %q, got %q", want, got)
+	}
+	if _, err := asm.ParseString("<output>", got); err != nil {
+		t.Errorf("unable to parse output; %+v", err)
+	}
+}
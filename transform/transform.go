@@ -0,0 +1,2 @@
+// Package transform implements transformation passes on LLVM IR modules.
+package transform